@@ -46,6 +46,12 @@ type BackupSpec struct {
 	// when backup resource is removed. The default value is false.
 	// +optional
 	KeepDataOnDeletion bool `json:"keepDataOnDeletion,omitempty"`
+
+	// Cancel requests the cancellation of a backup in progress. A backup
+	// which was not started yet is not started. Deleting a backup in
+	// progress cancels it as well.
+	// +optional
+	Cancel bool `json:"cancel,omitempty"`
 }
 
 // BackupType is presently defined as a free formatted string.
@@ -30,7 +30,9 @@ type CredentialSpec struct {
 	// +optional
 	Password string `json:"password,omitempty"`
 
-	// A reference to a k8s secret.
+	// A reference to a k8s secret. The password is read from the
+	// "password" key of the secret, which must be in the namespace of
+	// the resource. A change of the secret changes the password.
 	// +optional
 	SecretRef *corev1.SecretReference `json:"secretRef,omitempty"`
 
@@ -15,6 +15,7 @@
 package v1alpha1
 
 import (
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 )
@@ -42,6 +43,10 @@ const (
 	// Patching service provides software and database patching.
 	Patching Service = "Patching"
 
+	// ArchiveLogShipping service continuously ships archived redo logs
+	// to object storage.
+	ArchiveLogShipping Service = "ArchiveLogShipping"
+
 	// ManuallySetUpStandby means that operator will skip DB creation during
 	// provisioning, instance will be ready for users to manually set up standby.
 	ManuallySetUpStandby InstanceMode = "ManuallySetUpStandby"
@@ -115,6 +120,12 @@ type InstanceSpec struct {
 	// +optional
 	MinMemoryForDBContainer string `json:"minMemoryForDBContainer,omitempty"`
 
+	// DatabaseResources are the compute resources of the database container,
+	// they take precedence over MinMemoryForDBContainer. A change restarts the
+	// database within a maintenance window.
+	// +optional
+	DatabaseResources corev1.ResourceRequirements `json:"databaseResources,omitempty"`
+
 	// MaintenanceWindow specifies the time windows during which database downtimes are allowed for maintenance.
 	// +optional
 	MaintenanceWindow *MaintenanceWindowSpec `json:"maintenanceWindow,omitempty"`
@@ -31,9 +31,33 @@ type TimeRange struct {
 	Duration *metav1.Duration `json:"duration,omitempty"`
 }
 
+// RecurringWindow defines a window of time which recurs on a schedule.
+// Both schedule and duration are required.
+//+kubebuilder:object:generate=true
+type RecurringWindow struct {
+	// Schedule of the start times of the window in cron format, for example
+	// "0 2 * * SAT" for every Saturday at 2am.
+	// See godoc.org/github.com/robfig/cron for the supported syntax.
+	// +required
+	Schedule string `json:"schedule,omitempty"`
+
+	// Duration of each maintenance window.
+	// +required
+	Duration *metav1.Duration `json:"duration,omitempty"`
+
+	// TimeZone of the schedule as an IANA time zone name, for example
+	// "America/New_York". Defaults to UTC.
+	// +optional
+	TimeZone string `json:"timeZone,omitempty"`
+}
+
 // MaintenanceWindowSpec defines the time ranges during which maintenance may be started on a database.
 //+kubebuilder:object:generate=true
 type MaintenanceWindowSpec struct {
 	// Maintenance time ranges.
 	TimeRanges []TimeRange `json:"timeRanges,omitempty"`
+
+	// Recurring maintenance windows.
+	// +optional
+	RecurringWindows []RecurringWindow `json:"recurringWindows,omitempty"`
 }
@@ -51,6 +51,7 @@ const (
 	BackupInProgress BackupPhase = "InProgress"
 	BackupFailed     BackupPhase = "Failed"
 	BackupSucceeded  BackupPhase = "Succeeded"
+	BackupCancelled  BackupPhase = "Cancelled"
 )
 
 type RestorePhase string
@@ -201,6 +201,7 @@ func (in *InstanceSpec) DeepCopyInto(out *InstanceSpec) {
 			(*out)[key] = val
 		}
 	}
+	in.DatabaseResources.DeepCopyInto(&out.DatabaseResources)
 	if in.MaintenanceWindow != nil {
 		in, out := &in.MaintenanceWindow, &out.MaintenanceWindow
 		*out = new(MaintenanceWindowSpec)
@@ -250,6 +251,13 @@ func (in *MaintenanceWindowSpec) DeepCopyInto(out *MaintenanceWindowSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.RecurringWindows != nil {
+		in, out := &in.RecurringWindows, &out.RecurringWindows
+		*out = make([]RecurringWindow, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceWindowSpec.
@@ -262,6 +270,26 @@ func (in *MaintenanceWindowSpec) DeepCopy() *MaintenanceWindowSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RecurringWindow) DeepCopyInto(out *RecurringWindow) {
+	*out = *in
+	if in.Duration != nil {
+		in, out := &in.Duration, &out.Duration
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RecurringWindow.
+func (in *RecurringWindow) DeepCopy() *RecurringWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(RecurringWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TimeRange) DeepCopyInto(out *TimeRange) {
 	*out = *in
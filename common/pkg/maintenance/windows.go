@@ -18,6 +18,8 @@ import (
 	"errors"
 	"time"
 
+	"github.com/robfig/cron"
+
 	commonv1alpha1 "github.com/GoogleCloudPlatform/elcarro-oracle-operator/common/api/v1alpha1"
 )
 
@@ -44,6 +46,59 @@ func timeRangeIsValid(tr *commonv1alpha1.TimeRange) bool {
 	return tr != nil && tr.Start != nil && tr.Duration != nil
 }
 
+// recurringWindowSchedule returns the schedule of the start times of the
+// recurring window in its time zone.
+func recurringWindowSchedule(rw *commonv1alpha1.RecurringWindow) (cron.Schedule, *time.Location, error) {
+	if rw == nil || rw.Schedule == "" || rw.Duration == nil {
+		return nil, nil, errors.New("schedule and duration are required")
+	}
+	loc, err := time.LoadLocation(rw.TimeZone)
+	if err != nil {
+		return nil, nil, err
+	}
+	sched, err := cron.ParseStandard(rw.Schedule)
+	if err != nil {
+		return nil, nil, err
+	}
+	return sched, loc, nil
+}
+
+// recurringWindowIsValid verifies if fields on RecurringWindow are correctly set.
+// In particular, Schedule should be a valid cron expression, Duration should be
+// set and TimeZone, if set, should be a known time zone.
+func recurringWindowIsValid(rw *commonv1alpha1.RecurringWindow) bool {
+	_, _, err := recurringWindowSchedule(rw)
+	return err == nil
+}
+
+// recurringWindowCurrentOrNext returns the start time of the occurrence of the
+// recurring window which contains the specified time or, if there is none, of
+// the next occurrence.
+func recurringWindowCurrentOrNext(rw *commonv1alpha1.RecurringWindow, t time.Time) (time.Time, bool) {
+	sched, loc, err := recurringWindowSchedule(rw)
+	if err != nil {
+		return time.Time{}, false
+	}
+	// The first start after t-duration is the start of the current occurrence
+	// if it is not after t.
+	start := sched.Next(t.Add(-rw.Duration.Duration).In(loc))
+	if start.IsZero() {
+		return time.Time{}, false
+	}
+	if start.After(t) {
+		start = sched.Next(t.In(loc))
+	}
+	return start, !start.IsZero()
+}
+
+// recurringWindowInRange returns true iff the specified time lies in an
+// occurrence of the recurring window.
+// The range check is inclusive for start-time and exclusive for end-time.
+func recurringWindowInRange(rw *commonv1alpha1.RecurringWindow, t time.Time) bool {
+	start, ok := recurringWindowCurrentOrNext(rw, t)
+	return ok && !start.After(t)
+}
+
 // HasValidTimeRanges validates that there are non-zero time-ranges  and all time-ranges specified are valid.
 func HasValidTimeRanges(mw *commonv1alpha1.MaintenanceWindowSpec) bool {
 	if mw == nil || len(mw.TimeRanges) == 0 {
@@ -59,14 +114,45 @@ func HasValidTimeRanges(mw *commonv1alpha1.MaintenanceWindowSpec) bool {
 	return true
 }
 
-// InRange returns true iff the specified time is in any one of the time ranges.
+// HasValidWindows validates that there are non-zero time-ranges or recurring
+// windows and all of them are valid.
+func HasValidWindows(mw *commonv1alpha1.MaintenanceWindowSpec) bool {
+	if mw == nil || len(mw.TimeRanges)+len(mw.RecurringWindows) == 0 {
+		return false
+	}
+
+	if len(mw.TimeRanges) > 0 && !HasValidTimeRanges(mw) {
+		return false
+	}
+
+	for _, rw := range mw.RecurringWindows {
+		if !recurringWindowIsValid(&rw) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// InRange returns true iff the specified time is in any one of the time ranges
+// or recurring windows.
 func InRange(mw *commonv1alpha1.MaintenanceWindowSpec, t time.Time) bool {
+	if mw == nil {
+		return false
+	}
+
 	for _, tr := range mw.TimeRanges {
 		if timeRangeInRange(&tr, t) {
 			return true
 		}
 	}
 
+	for _, rw := range mw.RecurringWindows {
+		if recurringWindowInRange(&rw, t) {
+			return true
+		}
+	}
+
 	return false
 }
 
@@ -78,6 +164,10 @@ var NoFutureWindows = errors.New("no future windows")
 // coupled with the duration of that window.
 // If no future windows are available, NoFutureWindows error is returned.
 func NextWindow(mw *commonv1alpha1.MaintenanceWindowSpec, t time.Time) (*time.Time, *time.Duration, error) {
+	if mw == nil {
+		return nil, nil, NoFutureWindows
+	}
+
 	var min *time.Time
 	var d *time.Duration
 	for _, tr := range mw.TimeRanges {
@@ -86,18 +176,26 @@ func NextWindow(mw *commonv1alpha1.MaintenanceWindowSpec, t time.Time) (*time.Ti
 		}
 
 		trStart := tr.Start.Rfc3339Copy().Time
-		if t.Before(trStart) {
-			if min == nil {
-				min = &trStart
-				d = &tr.Duration.Duration
-			}
-			if min.After(trStart) {
+		if t.Before(trStart) || timeRangeInRange(&tr, t) {
+			if min == nil || min.After(trStart) {
 				min = &trStart
 				d = &tr.Duration.Duration
 			}
 		}
 	}
 
+	for _, rw := range mw.RecurringWindows {
+		rwStart, ok := recurringWindowCurrentOrNext(&rw, t)
+		if !ok {
+			continue
+		}
+
+		if min == nil || min.After(rwStart) {
+			min = &rwStart
+			d = &rw.Duration.Duration
+		}
+	}
+
 	if min != nil {
 		return min, d, nil
 	}
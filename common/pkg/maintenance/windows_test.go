@@ -317,3 +317,249 @@ func TestNextWindow(t *testing.T) {
 		})
 	}
 }
+
+func TestRecurringWindowIsValid(t *testing.T) {
+	var tests = []struct {
+		name string
+		rw   commonv1alpha1.RecurringWindow
+		want bool
+	}{
+		{
+			name: "valid window",
+			rw:   commonv1alpha1.RecurringWindow{Schedule: "0 2 * * SAT", Duration: &v1.Duration{Duration: time.Hour}},
+			want: true,
+		},
+		{
+			name: "valid window with time zone",
+			rw:   commonv1alpha1.RecurringWindow{Schedule: "0 2 * * SAT", Duration: &v1.Duration{Duration: time.Hour}, TimeZone: "America/New_York"},
+			want: true,
+		},
+		{
+			name: "missing schedule",
+			rw:   commonv1alpha1.RecurringWindow{Duration: &v1.Duration{Duration: time.Hour}},
+			want: false,
+		},
+		{
+			name: "missing duration",
+			rw:   commonv1alpha1.RecurringWindow{Schedule: "0 2 * * SAT"},
+			want: false,
+		},
+		{
+			name: "invalid schedule",
+			rw:   commonv1alpha1.RecurringWindow{Schedule: "0 2 * SAT", Duration: &v1.Duration{Duration: time.Hour}},
+			want: false,
+		},
+		{
+			name: "unknown time zone",
+			rw:   commonv1alpha1.RecurringWindow{Schedule: "0 2 * * SAT", Duration: &v1.Duration{Duration: time.Hour}, TimeZone: "Mars/Olympus_Mons"},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		testname := fmt.Sprintf("TestRecurringWindowIsValid %s", tt.name)
+		t.Run(testname, func(t *testing.T) {
+			act := recurringWindowIsValid(&tt.rw)
+			if act != tt.want {
+				t.Errorf("got %v, want %v", act, tt.want)
+			}
+		})
+	}
+}
+
+func TestHasValidWindows(t *testing.T) {
+	validTr := commonv1alpha1.TimeRange{
+		Start:    &v1.Time{Time: time.Now()},
+		Duration: &v1.Duration{Duration: time.Minute * 20},
+	}
+	invalidTr := commonv1alpha1.TimeRange{
+		Start: &v1.Time{Time: time.Now().Add(time.Hour)},
+	}
+	validRw := commonv1alpha1.RecurringWindow{Schedule: "0 2 * * SAT", Duration: &v1.Duration{Duration: time.Hour}}
+	invalidRw := commonv1alpha1.RecurringWindow{Schedule: "0 2 * * SAT"}
+
+	var tests = []struct {
+		name string
+		spec *commonv1alpha1.MaintenanceWindowSpec
+		want bool
+	}{
+		{
+			name: "nil spec",
+			spec: nil,
+			want: false,
+		},
+		{
+			name: "no windows",
+			spec: &commonv1alpha1.MaintenanceWindowSpec{},
+			want: false,
+		},
+		{
+			name: "one valid time range",
+			spec: &commonv1alpha1.MaintenanceWindowSpec{TimeRanges: []commonv1alpha1.TimeRange{validTr}},
+			want: true,
+		},
+		{
+			name: "one valid recurring window",
+			spec: &commonv1alpha1.MaintenanceWindowSpec{RecurringWindows: []commonv1alpha1.RecurringWindow{validRw}},
+			want: true,
+		},
+		{
+			name: "valid time range and recurring window",
+			spec: &commonv1alpha1.MaintenanceWindowSpec{TimeRanges: []commonv1alpha1.TimeRange{validTr}, RecurringWindows: []commonv1alpha1.RecurringWindow{validRw}},
+			want: true,
+		},
+		{
+			name: "invalid time range and valid recurring window",
+			spec: &commonv1alpha1.MaintenanceWindowSpec{TimeRanges: []commonv1alpha1.TimeRange{invalidTr}, RecurringWindows: []commonv1alpha1.RecurringWindow{validRw}},
+			want: false,
+		},
+		{
+			name: "valid time range and invalid recurring window",
+			spec: &commonv1alpha1.MaintenanceWindowSpec{TimeRanges: []commonv1alpha1.TimeRange{validTr}, RecurringWindows: []commonv1alpha1.RecurringWindow{invalidRw}},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		testname := fmt.Sprintf("TestHasValidWindows %s", tt.name)
+		t.Run(testname, func(t *testing.T) {
+			act := HasValidWindows(tt.spec)
+			if act != tt.want {
+				t.Errorf("got %v, want %v", act, tt.want)
+			}
+		})
+	}
+}
+
+func TestInRangeRecurring(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skipf("time zone database is not available: %v", err)
+	}
+	// Every Saturday from 2am to 4am New York time, 2021-05-01 is a Saturday.
+	mw := &commonv1alpha1.MaintenanceWindowSpec{
+		RecurringWindows: []commonv1alpha1.RecurringWindow{
+			{
+				Schedule: "0 2 * * SAT",
+				Duration: &v1.Duration{Duration: 2 * time.Hour},
+				TimeZone: "America/New_York",
+			},
+		},
+	}
+	start := time.Date(2021, 5, 1, 2, 0, 0, 0, newYork)
+	var tests = []struct {
+		name string
+		when time.Time
+		want bool
+	}{
+		{
+			name: "time before window",
+			when: start.Add(-time.Minute),
+			want: false,
+		},
+		{
+			name: "at start of window",
+			when: start,
+			want: true,
+		},
+		{
+			name: "time in window",
+			when: start.Add(time.Hour),
+			want: true,
+		},
+		{
+			name: "time in window in another time zone",
+			when: start.Add(time.Hour).UTC(),
+			want: true,
+		},
+		{
+			name: "end of window",
+			when: start.Add(2 * time.Hour),
+			want: false,
+		},
+		{
+			name: "time in window a week later",
+			when: start.AddDate(0, 0, 7).Add(90 * time.Minute),
+			want: true,
+		},
+		{
+			name: "same time of another day",
+			when: start.AddDate(0, 0, 1).Add(time.Hour),
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		testname := fmt.Sprintf("TestInRangeRecurring %s", tt.name)
+		t.Run(testname, func(t *testing.T) {
+			act := InRange(mw, tt.when)
+			if act != tt.want {
+				t.Errorf("got %v, want %v", act, tt.want)
+			}
+		})
+	}
+}
+
+func TestNextWindowRecurring(t *testing.T) {
+	// Every day from 2am to 3am UTC, and an absolute time range.
+	d1 := time.Hour
+	d2 := 30 * time.Minute
+	s2 := time.Date(2021, 5, 1, 12, 0, 0, 0, time.UTC)
+	mw := &commonv1alpha1.MaintenanceWindowSpec{
+		TimeRanges: []commonv1alpha1.TimeRange{
+			{
+				Start:    &v1.Time{Time: s2},
+				Duration: &v1.Duration{Duration: d2},
+			},
+		},
+		RecurringWindows: []commonv1alpha1.RecurringWindow{
+			{
+				Schedule: "0 2 * * *",
+				Duration: &v1.Duration{Duration: d1},
+			},
+		},
+	}
+	var tests = []struct {
+		name         string
+		when         time.Time
+		wantStart    time.Time
+		wantDuration time.Duration
+	}{
+		{
+			name:         "before recurring window",
+			when:         time.Date(2021, 5, 1, 1, 0, 0, 0, time.UTC),
+			wantStart:    time.Date(2021, 5, 1, 2, 0, 0, 0, time.UTC),
+			wantDuration: d1,
+		},
+		{
+			name:         "in recurring window",
+			when:         time.Date(2021, 5, 1, 2, 30, 0, 0, time.UTC),
+			wantStart:    time.Date(2021, 5, 1, 2, 0, 0, 0, time.UTC),
+			wantDuration: d1,
+		},
+		{
+			name:         "before time range",
+			when:         time.Date(2021, 5, 1, 3, 0, 0, 0, time.UTC),
+			wantStart:    s2,
+			wantDuration: d2,
+		},
+		{
+			name:         "in time range",
+			when:         s2.Add(d2 / 2),
+			wantStart:    s2,
+			wantDuration: d2,
+		},
+		{
+			name:         "after time range",
+			when:         s2.Add(d2),
+			wantStart:    time.Date(2021, 5, 2, 2, 0, 0, 0, time.UTC),
+			wantDuration: d1,
+		},
+	}
+	for _, tt := range tests {
+		testname := fmt.Sprintf("TestNextWindowRecurring %s", tt.name)
+		t.Run(testname, func(t *testing.T) {
+			aStart, aDuration, aErr := NextWindow(mw, tt.when)
+			if aErr != nil || !aStart.Equal(tt.wantStart) || *aDuration != tt.wantDuration {
+				t.Errorf("got (%v, %v, %v), want (%v, %v, nil)", aStart, aDuration, aErr, tt.wantStart, tt.wantDuration)
+			}
+		})
+	}
+}
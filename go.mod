@@ -7,6 +7,7 @@ require (
 	cloud.google.com/go v0.81.0
 	cloud.google.com/go/storage v1.10.0
 	github.com/Microsoft/go-winio v0.4.14 // indirect
+	github.com/aws/aws-sdk-go v1.35.24
 	github.com/bazelbuild/rules_go v0.27.0
 	github.com/containerd/containerd v1.3.0 // indirect
 	github.com/docker/distribution v2.7.1+incompatible // indirect
@@ -15,11 +15,18 @@
 package v1alpha1
 
 import (
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	commonv1alpha1 "github.com/GoogleCloudPlatform/elcarro-oracle-operator/common/api/v1alpha1"
 )
 
+// CloneNamespacesAnnotation is the name of the annotation allowing the
+// Instances of other namespaces to restore a clone from a Backup. It holds a
+// comma separated list of namespaces, or * for all namespaces. A Backup
+// without the annotation can only be restored in its own namespace.
+const CloneNamespacesAnnotation = "oracle.db.anthosapis.com/clone-namespaces"
+
 // BackupSpec defines the desired state of Backup.
 type BackupSpec struct {
 	// Backup specs that are common across all database engines.
@@ -70,6 +77,23 @@ type BackupSpec struct {
 	// +optional
 	Level int32 `json:"level,omitempty"`
 
+	// For a level 1 Physical backup, optionally back up the blocks changed
+	// since the last level 0 backup (cumulative) instead of the last level 0
+	// or level 1 backup (differential). The default is false.
+	// +optional
+	Cumulative bool `json:"cumulative,omitempty"`
+
+	// For a Physical backup of image copies (Backupset false) at the
+	// Instance level, optionally keep a single set of image copies of the
+	// datafiles up to date instead of taking new ones: a level 1 backup
+	// "for recover of copy" is taken and applied to the copies, which are
+	// created on the first such backup. The copies are shared by all the
+	// incrementally updated backups of the Instance and kept in LocalPath,
+	// they can be switched to by a restore with SwitchToCopy for a near
+	// instant recovery. Not supported with GcsPath. The default is false.
+	// +optional
+	IncrementallyUpdated bool `json:"incrementallyUpdated,omitempty"`
+
 	// For a Physical backup, optionally specify filesperset.
 	// The default depends on a type of backup, generally 64.
 	// +optional
@@ -93,11 +117,37 @@ type BackupSpec struct {
 	LocalPath string `json:"localPath,omitempty"`
 
 	// If set up ahead of time, the backup sets of a physical backup can be
-	// optionally transferred to a GCS bucket.
+	// optionally transferred to a GCS bucket, an S3 compatible bucket or a
+	// filesystem mounted into the database container, e.g. gs://bucket/dir,
+	// s3://bucket/dir or file:///mnt/backups/dir.
 	// A user is to ensure proper write access to the bucket from within the
 	// Oracle Operator.
 	// +optional
 	GcsPath string `json:"gcsPath,omitempty"`
+
+	// StorageCredentialsSecretRef is an optional reference to a Secret in
+	// the same namespace holding the credentials to access GcsPath,
+	// e.g. serviceAccountKey for gs:// or accessKeyId, secretAccessKey,
+	// region and endpoint for s3:// locations. If omitted, the default
+	// credentials of the database container are used.
+	// +optional
+	StorageCredentialsSecretRef *corev1.LocalObjectReference `json:"storageCredentialsSecretRef,omitempty"`
+
+	// For a Physical backup, optionally verify that the backup can be
+	// restored once it has been taken, by running RMAN RESTORE VALIDATE and
+	// RESTORE PREVIEW against its backup pieces. The outcome is reported by
+	// the Verified condition. The default is false.
+	// +optional
+	Verify bool `json:"verify,omitempty"`
+}
+
+// SCNRange is a range of system change numbers of a database.
+type SCNRange struct {
+	// MinSCN is the lowest SCN of the range.
+	MinSCN int64 `json:"minSCN"`
+
+	// MaxSCN is the highest SCN of the range.
+	MaxSCN int64 `json:"maxSCN"`
 }
 
 // BackupStatus defines the observed state of Backup.
@@ -107,6 +157,18 @@ type BackupStatus struct {
 
 	BackupID   string `json:"backupid,omitempty"`
 	BackupTime string `json:"backuptime,omitempty"`
+
+	// BackupTag is the RMAN tag of the pieces of a physical backup, they are
+	// deleted by it along with the Backup. Backups taken before the tags
+	// were recorded have none, their pieces are kept on deletion.
+	// +optional
+	BackupTag string `json:"backupTag,omitempty"`
+
+	// VerifiedSCNRange is the range of SCNs a verified physical backup can
+	// be recovered to, from the checkpoint of its datafiles up to the end of
+	// the archived redo logs backed up along with them.
+	// +optional
+	VerifiedSCNRange *SCNRange `json:"verifiedSCNRange,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -120,6 +182,7 @@ type BackupStatus struct {
 // +kubebuilder:printcolumn:JSONPath=".status.phase",name="Phase",type="string"
 // +kubebuilder:printcolumn:JSONPath=".status.backupid",name="Backup ID",type="string"
 // +kubebuilder:printcolumn:JSONPath=".status.backuptime",name="Backup Time",type="string"
+// +kubebuilder:printcolumn:JSONPath=`.status.conditions[?(@.type=="Verified")].status`,name="Verified",type="string"
 // +kubebuilder:printcolumn:JSONPath=`.status.conditions[?(@.type=="Ready")].status`,name="ReadyStatus",type="string",priority=1
 // +kubebuilder:printcolumn:JSONPath=`.status.conditions[?(@.type=="Ready")].reason`,name="ReadyReason",type="string",priority=1
 // +kubebuilder:printcolumn:JSONPath=`.status.conditions[?(@.type=="Ready")].message`,name="ReadyMessage",type="string",priority=1
@@ -34,6 +34,34 @@ type BackupRetentionPolicy struct {
 	BackupRetention *int32 `json:"backupRetention,omitempty"`
 }
 
+// IncrementalBackupType is the type of the level 1 backups of a
+// BackupStrategy.
+// +kubebuilder:validation:Enum=Differential;Cumulative
+type IncrementalBackupType string
+
+const (
+	// DifferentialIncremental backups contain the blocks changed since the
+	// last level 0 or level 1 backup.
+	DifferentialIncremental IncrementalBackupType = "Differential"
+	// CumulativeIncremental backups contain the blocks changed since the
+	// last level 0 backup.
+	CumulativeIncremental IncrementalBackupType = "Cumulative"
+)
+
+// BackupStrategy is an incremental-forever strategy of Physical backups:
+// level 0 backups are created on the FullBackupSchedule and level 1
+// backups on the Schedule of the BackupSchedule.
+type BackupStrategy struct {
+	// FullBackupSchedule is a cron-style expression of the schedule on which
+	// level 0 Backups will be created, e.g. weekly "0 0 * * 0".
+	FullBackupSchedule string `json:"fullBackupSchedule"`
+
+	// IncrementalType is the type of the level 1 Backups created on the
+	// Schedule of the BackupSchedule. The default is Differential.
+	// +optional
+	IncrementalType IncrementalBackupType `json:"incrementalType,omitempty"`
+}
+
 // BackupHistoryRecord is a historical record of a Backup.
 type BackupHistoryRecord struct {
 	// BackupName is the name of the Backup that gets created.
@@ -47,6 +75,10 @@ type BackupHistoryRecord struct {
 	// Phase tells the state of the Backup.
 	// +optional
 	Phase commonv1alpha1.BackupPhase `json:"phase,omitempty"`
+
+	// Verified tells the status of the verification of the Backup, if any.
+	// +optional
+	Verified metav1.ConditionStatus `json:"verified,omitempty"`
 }
 
 // BackupScheduleSpec defines the desired state of BackupSchedule.
@@ -75,6 +107,23 @@ type BackupScheduleSpec struct {
 	// backups produced from this BackupSchedule.
 	// +optional
 	BackupRetentionPolicy *BackupRetentionPolicy `json:"backupRetentionPolicy,omitempty"`
+
+	// BackupStrategy turns on an incremental-forever strategy for Physical
+	// backups, the Backups created on the Schedule are level 1 incremental
+	// backups of the level 0 Backups created on its FullBackupSchedule.
+	// The retention policy keeps the level 0 Backups the retained level 1
+	// Backups depend on.
+	// +optional
+	BackupStrategy *BackupStrategy `json:"backupStrategy,omitempty"`
+
+	// VerifyEvery turns on the verification of every Nth successful Physical
+	// Backup created from this BackupSchedule, see BackupSpec.Verify.
+	// It is capped by the BackupRetention, so that the counted backups are
+	// kept around. The default of 0 only verifies the backups for which
+	// BackupSpec.Verify is set.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	VerifyEvery int32 `json:"verifyEvery,omitempty"`
 }
 
 // BackupScheduleStatus defines the observed state of BackupSchedule.
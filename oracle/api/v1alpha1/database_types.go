@@ -15,6 +15,8 @@
 package v1alpha1
 
 import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	commonv1alpha1 "github.com/GoogleCloudPlatform/elcarro-oracle-operator/common/api/v1alpha1"
@@ -37,11 +39,82 @@ type DatabaseSpec struct {
 	// +optional
 	AdminPasswordGsmSecretRef *commonv1alpha1.GsmSecretReference `json:"adminPasswordGsmSecretRef,omitempty"`
 
+	// AdminPasswordSecretRef is a reference to a k8s secret in the namespace
+	// of the Database holding the password for the sys admin of the database
+	// in its "password" key. A change of the secret changes the password.
+	// +optional
+	AdminPasswordSecretRef *corev1.SecretReference `json:"adminPasswordSecretRef,omitempty"`
+
 	// Users specifies an optional list of users to be created in this database.
 	// +optional
 	Users []UserSpec `json:"users"`
+
+	// UserDeletionPolicy defines what happens to the users removed from the
+	// list of users, which the operator created for this Database before
+	// (see status.managedUsers). Users created outside of the operator, e.g.
+	// by a Data Pump import or a DBA, and Oracle maintained users are never
+	// locked or dropped. Available options are:
+	// - Retain: the users are left as is and reported in the UserReady
+	//           condition.
+	// - Lock: the accounts of the users are locked, their schemas are kept.
+	// - Drop: the users are dropped, which fails for users owning objects.
+	// - DropCascade: the users are dropped including their schema objects.
+	// If not specified, the default of Retain is assumed.
+	// +kubebuilder:validation:Enum=Retain;Lock;Drop;DropCascade
+	// +optional
+	UserDeletionPolicy UserDeletionPolicy `json:"userDeletionPolicy,omitempty"`
+
+	// Tablespaces specifies an optional list of tablespaces to be created in
+	// this database. Tablespaces removed from the list are not dropped.
+	// +optional
+	Tablespaces []TablespaceSpec `json:"tablespaces,omitempty"`
+
+	// Roles specifies an optional list of custom roles to be created in this
+	// database. Roles are created before the users, which may be granted
+	// them. Roles removed from the list are not dropped.
+	// +optional
+	Roles []RoleSpec `json:"roles,omitempty"`
+
+	// Profiles specifies an optional list of profiles to be created in this
+	// database, which limit the passwords and the logins of the users they
+	// are assigned to. Profiles are created before the users. Profiles
+	// removed from the list are not dropped.
+	// +optional
+	Profiles []ProfileSpec `json:"profiles,omitempty"`
+
+	// DeletionPolicy defines what happens to the PDB when the Database
+	// resource is deleted. Available options are:
+	// - Drop: the PDB is dropped including its datafiles.
+	// - Unplug: the PDB is unplugged into an XML manifest next to its
+	//           datafiles, which are kept, so that it can be plugged in again.
+	// - Retain: the PDB is left in the CDB.
+	// If not specified, the default of Retain is assumed, so that deleting
+	// a Database resource never removes data unless asked to.
+	// +kubebuilder:validation:Enum=Drop;Unplug;Retain
+	// +optional
+	DeletionPolicy DatabaseDeletionPolicy `json:"deletionPolicy,omitempty"`
 }
 
+// DatabaseDeletionPolicy defines what happens to a PDB on deletion.
+type DatabaseDeletionPolicy string
+
+const (
+	DatabaseDeletionPolicyDrop   DatabaseDeletionPolicy = "Drop"
+	DatabaseDeletionPolicyUnplug DatabaseDeletionPolicy = "Unplug"
+	DatabaseDeletionPolicyRetain DatabaseDeletionPolicy = "Retain"
+)
+
+// UserDeletionPolicy defines what happens to the users of a PDB which are not
+// in the spec.
+type UserDeletionPolicy string
+
+const (
+	UserDeletionPolicyRetain      UserDeletionPolicy = "Retain"
+	UserDeletionPolicyLock        UserDeletionPolicy = "Lock"
+	UserDeletionPolicyDrop        UserDeletionPolicy = "Drop"
+	UserDeletionPolicyDropCascade UserDeletionPolicy = "DropCascade"
+)
+
 // UserSpec defines the desired state of the Database Users.
 type UserSpec struct {
 	// User specs that are common across all database engines.
@@ -50,11 +123,213 @@ type UserSpec struct {
 	// Privileges specifies an optional list of privileges to grant to the user.
 	// +optional
 	Privileges []PrivilegeSpec `json:"privileges"`
+
+	// Quotas specifies an optional list of storage quotas of the user on
+	// tablespaces. If any quota is specified, the quotas of the user on
+	// other tablespaces are revoked.
+	// +optional
+	Quotas []TablespaceQuota `json:"quotas,omitempty"`
+
+	// ObjectPrivileges specifies an optional list of privileges on objects
+	// of other schemas to grant to the user. If any object privilege is
+	// specified, the other object privileges of the user are revoked.
+	// +optional
+	ObjectPrivileges []ObjectPrivilegeSpec `json:"objectPrivileges,omitempty"`
+
+	// PasswordRotation specifies an optional policy to rotate the password
+	// of the user. It can't be combined with the other password options.
+	// +optional
+	PasswordRotation *PasswordRotationSpec `json:"passwordRotation,omitempty"`
+
+	// Profile is the name of the profile assigned to the user, either one
+	// of the profiles of the Database or an existing one like DEFAULT.
+	// If not specified, the profile of the user is left as is.
+	// +kubebuilder:validation:Pattern=`^[A-Za-z][A-Za-z0-9_$#]{0,29}$`
+	// +optional
+	Profile string `json:"profile,omitempty"`
+
+	// AccountStatus locks or unlocks the account of the user. An account
+	// locked for a time after too many failed logins is not unlocked, it is
+	// unlocked by the database after the password lock time of its profile.
+	// If not specified, the account is left as is.
+	// +kubebuilder:validation:Enum=Locked;Unlocked
+	// +optional
+	AccountStatus AccountStatus `json:"accountStatus,omitempty"`
+}
+
+// AccountStatus defines whether the account of a user is locked.
+type AccountStatus string
+
+const (
+	AccountStatusLocked   AccountStatus = "Locked"
+	AccountStatusUnlocked AccountStatus = "Unlocked"
+)
+
+// PasswordRotatedAtAnnotation records on the secret of a user with a
+// password rotation policy when its password was last rotated.
+const PasswordRotatedAtAnnotation = "oracle.db.anthosapis.com/password-rotated-at"
+
+// PasswordRotationSpec defines how the password of a user is rotated. The
+// operator generates a new password every interval and writes it to the
+// "password" key of the secret, which is created if it doesn't exist. The
+// password in the secret is then applied to the user like the password of a
+// secretRef. Until it is applied, the previous password is kept in the
+// "previous-password" key, and restored if the new one can't be applied.
+type PasswordRotationSpec struct {
+	// Interval between two rotations of the password, at least 1h.
+	Interval metav1.Duration `json:"interval"`
+
+	// SecretRef references the secret the password is written to. The
+	// secret must be in the namespace of the Database.
+	SecretRef corev1.SecretReference `json:"secretRef"`
 }
 
 // PrivilegeSpec defines the desired state of roles and privileges.
 type PrivilegeSpec string
 
+// ObjectPrivilegeSpec defines a privilege on a schema object, for example
+// SELECT on SCOTT.EMP.
+type ObjectPrivilegeSpec struct {
+	// Privilege is the object privilege, for example SELECT, INSERT,
+	// UPDATE, DELETE or EXECUTE.
+	// +kubebuilder:validation:Pattern=`^[A-Za-z][A-Za-z ]*$`
+	Privilege string `json:"privilege"`
+
+	// Schema is the owner of the object.
+	Schema string `json:"schema"`
+
+	// Object is the name of the table, view, sequence, procedure or other
+	// object of the schema.
+	Object string `json:"object"`
+}
+
+// RoleSpec defines the desired state of a custom role.
+type RoleSpec struct {
+	// Name of the role.
+	// +kubebuilder:validation:Pattern=`^[A-Za-z][A-Za-z0-9_$#]{0,29}$`
+	Name string `json:"name"`
+
+	// Privileges specifies an optional list of system privileges and roles
+	// to grant to the role. The other system privileges and roles of the
+	// role are revoked.
+	// +optional
+	Privileges []PrivilegeSpec `json:"privileges,omitempty"`
+
+	// ObjectPrivileges specifies an optional list of privileges on objects
+	// to grant to the role. If any object privilege is specified, the other
+	// object privileges of the role are revoked.
+	// +optional
+	ObjectPrivileges []ObjectPrivilegeSpec `json:"objectPrivileges,omitempty"`
+}
+
+// ProfileSpec defines the desired state of a profile. Limits which are not
+// specified are left as is, new profiles use the limits of the DEFAULT
+// profile for them.
+type ProfileSpec struct {
+	// Name of the profile.
+	// +kubebuilder:validation:Pattern=`^[A-Za-z][A-Za-z0-9_$#]{0,29}$`
+	Name string `json:"name"`
+
+	// FailedLoginAttempts is the number of failed logins before the account
+	// is locked.
+	// +optional
+	FailedLoginAttempts ProfileLimit `json:"failedLoginAttempts,omitempty"`
+
+	// PasswordLifeTime is the number of days a password can be used for.
+	// +optional
+	PasswordLifeTime ProfileLimit `json:"passwordLifeTime,omitempty"`
+
+	// PasswordGraceTime is the number of days after the password expired
+	// during which logins are still allowed with a warning.
+	// +optional
+	PasswordGraceTime ProfileLimit `json:"passwordGraceTime,omitempty"`
+
+	// PasswordLockTime is the number of days an account stays locked after
+	// too many failed logins.
+	// +optional
+	PasswordLockTime ProfileLimit `json:"passwordLockTime,omitempty"`
+
+	// PasswordReuseTime is the number of days before a password can be
+	// reused.
+	// +optional
+	PasswordReuseTime ProfileLimit `json:"passwordReuseTime,omitempty"`
+
+	// PasswordReuseMax is the number of password changes before a password
+	// can be reused.
+	// +optional
+	PasswordReuseMax ProfileLimit `json:"passwordReuseMax,omitempty"`
+
+	// InactiveAccountTime is the number of days without a login before the
+	// account is locked.
+	// +optional
+	InactiveAccountTime ProfileLimit `json:"inactiveAccountTime,omitempty"`
+
+	// PasswordVerifyFunction is the name of the function verifying the
+	// complexity of new passwords, NULL for none or DEFAULT.
+	// +kubebuilder:validation:Pattern=`^[A-Za-z][A-Za-z0-9_$#]*$`
+	// +optional
+	PasswordVerifyFunction string `json:"passwordVerifyFunction,omitempty"`
+}
+
+// ProfileLimit is the limit of a profile resource: a number, UNLIMITED or
+// DEFAULT for the limit of the DEFAULT profile. Times are in days and
+// may be fractions of a day, like 0.5.
+// +kubebuilder:validation:Pattern=`^([0-9]+(\.[0-9]+)?|UNLIMITED|DEFAULT)$`
+type ProfileLimit string
+
+// TablespaceSpec defines the desired state of a tablespace.
+type TablespaceSpec struct {
+	// Name of the tablespace.
+	// +kubebuilder:validation:Pattern=`^[A-Za-z][A-Za-z0-9_$#]{0,29}$`
+	Name string `json:"name"`
+
+	// InitialSize is the size of the datafile the tablespace is created
+	// with. It is not changed for an existing tablespace.
+	// If not specified, the default of 100Mi is assumed.
+	// +optional
+	InitialSize *resource.Quantity `json:"initialSize,omitempty"`
+
+	// AutoExtend allows the datafiles of the tablespace to grow as needed.
+	// +optional
+	AutoExtend bool `json:"autoExtend,omitempty"`
+
+	// MaxSize is the size the datafiles of the tablespace may grow to if
+	// AutoExtend is set. If not specified, the growth is unlimited.
+	// +optional
+	MaxSize *resource.Quantity `json:"maxSize,omitempty"`
+
+	// Bigfile creates the tablespace with a single, large datafile.
+	// It is not changed for an existing tablespace.
+	// +optional
+	Bigfile bool `json:"bigfile,omitempty"`
+}
+
+// TablespaceQuota defines the storage quota of a user on a tablespace.
+type TablespaceQuota struct {
+	// Tablespace is the name of the tablespace.
+	Tablespace string `json:"tablespace"`
+
+	// Size is the space the user may allocate in the tablespace; 0 revokes
+	// the quota. If not specified, the quota is unlimited.
+	// +optional
+	Size *resource.Quantity `json:"size,omitempty"`
+}
+
+// TablespaceStatus defines the observed usage of a tablespace.
+type TablespaceStatus struct {
+	// Name of the tablespace.
+	Name string `json:"name"`
+
+	// Size is the space allocated by the datafiles of the tablespace.
+	Size resource.Quantity `json:"size"`
+
+	// Used is the space used by segments in the tablespace.
+	Used resource.Quantity `json:"used"`
+
+	// MaxSize is the size the datafiles of the tablespace may grow to.
+	MaxSize resource.Quantity `json:"maxSize"`
+}
+
 // DatabaseStatus defines the observed state of Database.
 type DatabaseStatus struct {
 	// Database status that is common across all database engines.
@@ -63,9 +338,17 @@ type DatabaseStatus struct {
 	// List of user names.
 	UserNames []string `json:"usernames,omitempty"`
 
+	// ManagedUsers lists the users the operator created for this Database
+	// and didn't drop since, which the UserDeletionPolicy applies to once
+	// they are removed from the spec.
+	// +optional
+	ManagedUsers []string `json:"managedUsers,omitempty"`
+
 	// UserResourceVersions is a map of username to user resource version
-	// (plaintext or GSM). For GSM Resource version, use format:
+	// (plaintext, GSM or k8s secret). For GSM Resource version, use format:
 	// "projects/{ProjectId}/secrets/{SecretId}/versions/{Version}".
+	// For a k8s secret, use format:
+	// "namespaces/{Namespace}/secrets/{Name}/versions/{ResourceVersion}".
 	UserResourceVersions map[string]string `json:"UserResourceVersions,omitempty"`
 
 	// ObservedGeneration is the latest generation observed by the controller.
@@ -75,6 +358,22 @@ type DatabaseStatus struct {
 	// IsChangeApplied indicates whether database changes have been applied
 	// +optional
 	IsChangeApplied metav1.ConditionStatus `json:"isChangeApplied,omitempty"`
+
+	// Tablespaces reports the usage of the tablespaces in the spec.
+	// +optional
+	Tablespaces []TablespaceStatus `json:"tablespaces,omitempty"`
+
+	// PasswordRotationTimes is a map of username to the time the password
+	// of the user was last rotated, for the users with a rotation policy.
+	// +optional
+	PasswordRotationTimes map[string]metav1.Time `json:"passwordRotationTimes,omitempty"`
+
+	// UserAccountStatuses is a map of username to the account status of the
+	// user in the database, for example OPEN, LOCKED or EXPIRED(GRACE). With
+	// the Lock user deletion policy, it includes the locked users which are
+	// not in the spec.
+	// +optional
+	UserAccountStatuses map[string]string `json:"userAccountStatuses,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -15,6 +15,7 @@
 package v1alpha1
 
 import (
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -45,6 +46,9 @@ type ExportSpec struct {
 	ExportObjects []string `json:"exportObjects,omitempty"`
 
 	// GcsPath is a full path in GCS bucket to transfer exported files to.
+	// An S3 compatible bucket or a filesystem mounted into the database
+	// container can be used as well, e.g. s3://bucket/export.dmp or
+	// file:///mnt/exports/export.dmp.
 	// A user is to ensure proper write access to the bucket from within the
 	// Oracle Operator.
 	// +required
@@ -56,6 +60,14 @@ type ExportSpec struct {
 	// +optional
 	GcsLogPath string `json:"gcsLogPath,omitempty"`
 
+	// StorageCredentialsSecretRef is an optional reference to a Secret in
+	// the same namespace holding the credentials to access GcsPath and
+	// GcsLogPath, e.g. serviceAccountKey for gs:// or accessKeyId,
+	// secretAccessKey, region and endpoint for s3:// locations. If omitted,
+	// the default credentials of the database container are used.
+	// +optional
+	StorageCredentialsSecretRef *corev1.LocalObjectReference `json:"storageCredentialsSecretRef,omitempty"`
+
 	// FlashbackTime is an optional time. If this time is set, the SCN that most
 	// closely matches the time is found, and this SCN is used to enable the
 	// Flashback utility. The export operation is performed with data that is
@@ -64,6 +76,13 @@ type ExportSpec struct {
 	// +kubebuilder:validation:Format=date-time
 	// +optional
 	FlashbackTime *metav1.Time `json:"flashbackTime,omitempty"`
+
+	// Cancel requests the cancellation of an export in progress, the Data
+	// Pump job is stopped and the partial dump file is removed. An export
+	// which was not started yet is not started. Deleting an export in
+	// progress cancels it as well.
+	// +optional
+	Cancel bool `json:"cancel,omitempty"`
 }
 
 // ExportStatus defines the observed state of Export.
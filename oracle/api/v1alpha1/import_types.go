@@ -15,6 +15,7 @@
 package v1alpha1
 
 import (
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -35,6 +36,9 @@ type ImportSpec struct {
 	Type string `json:"type,omitempty"`
 
 	// GcsPath is a full path to the input file in GCS containing import data.
+	// An S3 compatible bucket or a filesystem mounted into the database
+	// container can be used as well, e.g. s3://bucket/import.dmp or
+	// file:///mnt/imports/import.dmp.
 	// A user is to ensure proper write access to the bucket from within the
 	// Oracle Operator.
 	// +required
@@ -45,6 +49,20 @@ type ImportSpec struct {
 	// Oracle Operator.
 	// +optional
 	GcsLogPath string `json:"gcsLogPath,omitempty"`
+
+	// StorageCredentialsSecretRef is an optional reference to a Secret in
+	// the same namespace holding the credentials to access GcsPath and
+	// GcsLogPath, e.g. serviceAccountKey for gs:// or accessKeyId,
+	// secretAccessKey, region and endpoint for s3:// locations. If omitted,
+	// the default credentials of the database container are used.
+	// +optional
+	StorageCredentialsSecretRef *corev1.LocalObjectReference `json:"storageCredentialsSecretRef,omitempty"`
+
+	// Cancel requests the cancellation of an import in progress, the Data
+	// Pump job is stopped. An import which was not started yet is not
+	// started. Deleting an import in progress cancels it as well.
+	// +optional
+	Cancel bool `json:"cancel,omitempty"`
 }
 
 // ImportStatus defines the observed state of Import.
@@ -15,6 +15,8 @@
 package v1alpha1
 
 import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	commonv1alpha1 "github.com/GoogleCloudPlatform/elcarro-oracle-operator/common/api/v1alpha1"
@@ -77,7 +79,7 @@ type InstanceSpec struct {
 	CharacterSet string `json:"characterSet,omitempty"`
 
 	// MemoryPercent represents the percentage of memory that should be allocated
-	// for Oracle SGA (default is 25%).
+	// for Oracle SGA and PGA combined (default is 25%).
 	// +optional
 	// +kubebuilder:validation:Minimum=0
 	// +kubebuilder:validation:Maximum=100
@@ -87,6 +89,147 @@ type InstanceSpec struct {
 	// Service created to expose a connection to database.
 	// +optional
 	DBNetworkServiceOptions *DBNetworkServiceOptions `json:"dbNetworkServiceOptions,omitempty"`
+
+	// ArchiveLogShipping configures where archived redo logs are shipped to.
+	// It only takes effect if the ArchiveLogShipping service is enabled.
+	// +optional
+	ArchiveLogShipping *ArchiveLogShippingSpec `json:"archiveLogShipping,omitempty"`
+
+	// Replication makes the Instance a Data Guard physical standby of
+	// another Instance in the same namespace.
+	// +optional
+	Replication *ReplicationSpec `json:"replication,omitempty"`
+
+	// FileVolumes are mounted as /mnt/<name> into the database container
+	// and hold the file:// locations of backups, exports and imports, e.g.
+	// file:///mnt/<name>/path. A change of the volumes of a ready Instance is
+	// rolled out like a change of the database resources.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	FileVolumes []FileVolume `json:"fileVolumes,omitempty"`
+}
+
+// FileVolume is a volume for file:// locations. Exactly one of
+// PersistentVolumeClaim and NFS must be set.
+type FileVolume struct {
+	// Name of the volume, which is mounted as /mnt/<name>.
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	// +kubebuilder:validation:MaxLength=50
+	Name string `json:"name"`
+
+	// PersistentVolumeClaim is an existing claim in the namespace of the
+	// Instance. It must allow the ReadWriteMany access mode to be mounted by
+	// several Instances.
+	// +optional
+	PersistentVolumeClaim *corev1.PersistentVolumeClaimVolumeSource `json:"persistentVolumeClaim,omitempty"`
+
+	// NFS is an NFS share.
+	// +optional
+	NFS *corev1.NFSVolumeSource `json:"nfs,omitempty"`
+}
+
+// ReplicationTransportMode is a redo transport mode of a standby.
+type ReplicationTransportMode string
+
+const (
+	// ReplicationTransportAsync ships redo asynchronously (ASYNC NOAFFIRM).
+	ReplicationTransportAsync ReplicationTransportMode = "Async"
+	// ReplicationTransportSync ships redo synchronously (SYNC AFFIRM).
+	ReplicationTransportSync ReplicationTransportMode = "Sync"
+)
+
+// RoleTransitionType is a Data Guard role transition.
+type RoleTransitionType string
+
+const (
+	// Switchover swaps the roles of the primary and the standby.
+	Switchover RoleTransitionType = "Switchover"
+	// Failover turns the standby into a primary without the involvement of
+	// the primary. The primary is shut down first if it can be reached, and
+	// whenever it comes back, until it is rebuilt as a standby.
+	Failover RoleTransitionType = "Failover"
+)
+
+// DatabaseRole is a Data Guard role of a database.
+type DatabaseRole string
+
+const (
+	// PrimaryRole is the role of a database open for read and write.
+	PrimaryRole DatabaseRole = "Primary"
+	// PhysicalStandbyRole is the role of a database applying the redo of
+	// a primary.
+	PhysicalStandbyRole DatabaseRole = "PhysicalStandby"
+)
+
+// ReplicationSpec defines the Data Guard physical standby attributes.
+type ReplicationSpec struct {
+	// PrimaryInstance is the name of the Instance the standby is built from
+	// with RMAN active duplication. The standby Instance must use the same
+	// CDBName and a different DBUniqueName.
+	// +required
+	PrimaryInstance string `json:"primaryInstance"`
+
+	// PasswordSecretRef selects the key of a Secret that holds the SYS
+	// password of the primary database. It is used to duplicate the primary
+	// and to authenticate the redo transport.
+	// +required
+	PasswordSecretRef corev1.SecretKeySelector `json:"passwordSecretRef"`
+
+	// TransportMode is the redo transport mode, Async by default.
+	// +optional
+	// +kubebuilder:validation:Enum=Async;Sync
+	TransportMode ReplicationTransportMode `json:"transportMode,omitempty"`
+
+	// RoleTransition requests a switchover or a failover to this Instance.
+	// A switchover can be requested again to switch the roles back.
+	// The load balancer Service of each Instance follows the role, so that
+	// the Service of the original primary always reaches the primary database.
+	// +optional
+	RoleTransition *RoleTransitionSpec `json:"roleTransition,omitempty"`
+}
+
+// RoleTransitionSpec defines a requested role transition.
+type RoleTransitionSpec struct {
+	// Type of the role transition.
+	// +required
+	// +kubebuilder:validation:Enum=Switchover;Failover
+	Type RoleTransitionType `json:"type"`
+
+	// Request version as a date-time to avoid accidental triggering of
+	// a role transition when reapplying an older version of a resource file.
+	// A role transition is only performed if RequestTime is later than the
+	// last handled one.
+	// +required
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Format=date-time
+	RequestTime metav1.Time `json:"requestTime"`
+}
+
+// ArchiveLogShippingSpec defines the archived redo log shipping attributes.
+type ArchiveLogShippingSpec struct {
+	// GcsPath is the location the archived redo logs are uploaded to, a GCS
+	// bucket, an S3 compatible bucket or a filesystem mounted into the
+	// database container, e.g. gs://bucket/archivelogs,
+	// s3://bucket/archivelogs or file:///mnt/archivelogs. Logs are stored
+	// under a per incarnation prefix.
+	// +required
+	// +kubebuilder:validation:Pattern=`^(gs|s3|file)://.+$`
+	GcsPath string `json:"gcsPath"`
+
+	// StorageCredentialsSecretRef is an optional reference to a Secret in
+	// the same namespace holding the credentials to access GcsPath, see the
+	// Backup resource for the supported keys. If omitted, the default
+	// credentials of the database container are used.
+	// +optional
+	StorageCredentialsSecretRef *corev1.LocalObjectReference `json:"storageCredentialsSecretRef,omitempty"`
+
+	// IntervalMinutes is how often new archived redo logs are shipped.
+	// Optional field defaulting to 5 minutes.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	IntervalMinutes int32 `json:"intervalMinutes,omitempty"`
 }
 
 // RestoreSpec defines optional restore and recovery attributes.
@@ -101,6 +244,45 @@ type RestoreSpec struct {
 	// +required
 	BackupID string `json:"backupId,omitempty"`
 
+	// BackupNamespace is the namespace of the backup to restore from.
+	// It defaults to the namespace of the Instance. A backup of another
+	// Instance, possibly in another namespace, is restored into a copy
+	// (clone) of that Instance: the CDB is renamed to the CDBName of this
+	// Instance and gets a new DBID. A clone into a new Instance doesn't
+	// require the Force flag. A Backup in another namespace must allow a
+	// clone into the namespace of this Instance with the
+	// oracle.db.anthosapis.com/clone-namespaces annotation.
+	// +optional
+	BackupNamespace string `json:"backupNamespace,omitempty"`
+
+	// StorageCredentialsSecretRef is an optional reference to a Secret in
+	// the namespace of the Instance holding the credentials to access the
+	// storage location of a Physical backup in another namespace, see the
+	// Backup resource for the supported keys. The Secrets of another
+	// namespace are never read. If omitted, the default credentials of the
+	// database container are used. A backup in the namespace of the
+	// Instance is accessed with the Secret of the Backup.
+	// +optional
+	StorageCredentialsSecretRef *corev1.LocalObjectReference `json:"storageCredentialsSecretRef,omitempty"`
+
+	// DatabaseName is the name of a Database (PDB) of the Instance to
+	// restore and recover alone from a Physical backup of the Instance or
+	// of that Database. The CDB and the other Databases stay open.
+	// +optional
+	DatabaseName string `json:"databaseName,omitempty"`
+
+	// SwitchToCopy restores an incrementally updated Physical backup of the
+	// Instance by switching the database to its image copies, which stay on
+	// the backup disk and become the datafiles of the database, instead of
+	// copying the datafiles back. The database is recovered from the SCN of
+	// the copies, so a point-in-time recovery target can't be earlier.
+	// Only the latest incrementally updated backup of the Instance can be
+	// given, since all of them share the same image copies. The former
+	// datafiles are left on the data disk as datafile copies, to be deleted
+	// with RMAN once no longer needed.
+	// +optional
+	SwitchToCopy bool `json:"switchToCopy,omitempty"`
+
 	// Similar to a (physical) backup, optionally indicate a degree
 	// of parallelism, also known as DOP.
 	// +optional
@@ -122,6 +304,34 @@ type RestoreSpec struct {
 	// +optional
 	Force bool `json:"force,omitempty"`
 
+	// UntilTime requests a point-in-time recovery of a physical backup up to
+	// the given timestamp (interpreted in UTC). At most one of UntilTime,
+	// UntilSCN and UntilRestorePoint can be set. If none is set, a database
+	// is recovered up to the last SCN in the last archived redo log.
+	// +optional
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Format=date-time
+	UntilTime *metav1.Time `json:"untilTime,omitempty"`
+
+	// UntilSCN requests a point-in-time recovery of a physical backup up to,
+	// but not including, the given SCN.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	UntilSCN int64 `json:"untilSCN,omitempty"`
+
+	// UntilRestorePoint requests a point-in-time recovery of a physical backup
+	// up to the SCN of the given restore point. The restore point must be
+	// recorded in the control file of the backup.
+	// +optional
+	// +kubebuilder:validation:Pattern=`^[A-Za-z][A-Za-z0-9_$#]*$`
+	UntilRestorePoint string `json:"untilRestorePoint,omitempty"`
+
+	// Cancel requests the cancellation of a restore from a Physical backup
+	// in progress. The database of a cancelled restore is not usable until
+	// it's restored again with a later RequestTime.
+	// +optional
+	Cancel bool `json:"cancel,omitempty"`
+
 	// Request version as a date-time to avoid accidental triggering of
 	// a restore operation when reapplying an older version of a resource file.
 	// If at least one restore operation has occurred, any further restore
@@ -172,12 +382,179 @@ type InstanceStatus struct {
 	// CurrentServiceImage stores the image name used by the database instance.
 	CurrentServiceImage string `json:"currentServiceImage,omitempty"`
 
+	// LastFailedServiceImage is the service image of the last failed
+	// patching, it is used to avoid getting into the failed patching loop.
+	// +optional
+	LastFailedServiceImage string `json:"lastFailedServiceImage,omitempty"`
+
+	// Patching tracks the progress of an ongoing patching.
+	// +optional
+	Patching *PatchingStatus `json:"patching,omitempty"`
+
+	// DatabaseResources describes the compute resources rolled out to the
+	// database container and the resulting memory split of the database.
+	// +optional
+	DatabaseResources *DatabaseResourcesStatus `json:"databaseResources,omitempty"`
+
 	// CurrentParameters stores the last successfully set instance parameters.
 	CurrentParameters map[string]string `json:"currentParameters,omitempty"`
 
 	// LastFailedParameterUpdate is used to avoid getting into the failed
 	// parameter update loop.
 	LastFailedParameterUpdate map[string]string `json:"lastFailedParameterUpdate,omitempty"`
+
+	// ArchiveLogShipping tracks the progress of the archived redo log shipping.
+	// +optional
+	ArchiveLogShipping *ArchiveLogShippingStatus `json:"archiveLogShipping,omitempty"`
+
+	// Replication describes the Data Guard role and lag of a standby.
+	// +optional
+	Replication *ReplicationStatus `json:"replication,omitempty"`
+
+	// AuditLogAnchor is the last recorded head of the audit log of the
+	// Database Daemon, which anchors its hash chain outside of the database
+	// Pod.
+	// +optional
+	AuditLogAnchor *AuditLogAnchor `json:"auditLogAnchor,omitempty"`
+}
+
+// AuditLogAnchor identifies an entry of the audit log of the Database Daemon.
+// An audit log which doesn't contain the entry with this hash was rewritten
+// or truncated.
+type AuditLogAnchor struct {
+	// Seq is the sequence number of the entry.
+	Seq int64 `json:"seq"`
+
+	// Hash is the hash of the entry.
+	Hash string `json:"hash"`
+
+	// Time is when the entry was anchored.
+	// +optional
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Format=date-time
+	Time *metav1.Time `json:"time,omitempty"`
+}
+
+// ReplicationStatus describes the observed Data Guard state of an Instance.
+type ReplicationStatus struct {
+	// Role is the current Data Guard role of the database.
+	// +optional
+	Role DatabaseRole `json:"role,omitempty"`
+
+	// DBUniqueName is the unique name of the database of the Instance.
+	// +optional
+	DBUniqueName string `json:"dbUniqueName,omitempty"`
+
+	// PrimaryDBUniqueName is the unique name of the database of the
+	// PrimaryInstance.
+	// +optional
+	PrimaryDBUniqueName string `json:"primaryDBUniqueName,omitempty"`
+
+	// ApplyLagSeconds is how far the standby lags behind the primary in
+	// applying redo, as reported by v$dataguard_stats.
+	// +optional
+	ApplyLagSeconds int64 `json:"applyLagSeconds,omitempty"`
+
+	// TransportLagSeconds is how far the standby lags behind the primary in
+	// receiving redo, as reported by v$dataguard_stats.
+	// +optional
+	TransportLagSeconds int64 `json:"transportLagSeconds,omitempty"`
+
+	// ApplyRunning is true if the managed recovery process is running.
+	// +optional
+	ApplyRunning bool `json:"applyRunning,omitempty"`
+
+	// LastRoleTransitionType is the type of the last handled role transition.
+	// +optional
+	LastRoleTransitionType RoleTransitionType `json:"lastRoleTransitionType,omitempty"`
+
+	// LastRoleTransitionTime is the RequestTime of the last handled role
+	// transition, whether it succeeded or not.
+	// +optional
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Format=date-time
+	LastRoleTransitionTime *metav1.Time `json:"lastRoleTransitionTime,omitempty"`
+
+	// ReinstateRequired is set once the standby has taken over the primary
+	// role with a failover. The database of the PrimaryInstance has diverged
+	// from it and is kept shut down until the PrimaryInstance is deleted and
+	// rebuilt as a standby. No further role transitions are performed.
+	// +optional
+	ReinstateRequired bool `json:"reinstateRequired,omitempty"`
+}
+
+// DatabaseResourcesStatus describes the compute resources and the file
+// volumes of the database container and the memory split of the database.
+type DatabaseResourcesStatus struct {
+	// Resources of the database container which are rolled out.
+	// +optional
+	Resources corev1.ResourceRequirements `json:"resources,omitempty"`
+
+	// MemoryPercent from which the SGA and PGA sizes were computed.
+	// +optional
+	MemoryPercent int `json:"memoryPercent,omitempty"`
+
+	// FileVolumes of the database container which are rolled out.
+	// +optional
+	FileVolumes []FileVolume `json:"fileVolumes,omitempty"`
+
+	// SGATarget is the effective sga_target of the database.
+	// +optional
+	SGATarget *resource.Quantity `json:"sgaTarget,omitempty"`
+
+	// PGAAggregateTarget is the effective pga_aggregate_target of the
+	// database.
+	// +optional
+	PGAAggregateTarget *resource.Quantity `json:"pgaAggregateTarget,omitempty"`
+}
+
+// PatchingStatus describes an ongoing patching of the service image.
+type PatchingStatus struct {
+	// ServiceImage is the patched service image being rolled out.
+	ServiceImage string `json:"serviceImage,omitempty"`
+
+	// PreviousServiceImage is the service image before the patching, it is
+	// restored by a rollback.
+	PreviousServiceImage string `json:"previousServiceImage,omitempty"`
+
+	// BackupName is the name of the Snapshot Backup taken before the
+	// patching, the database is restored from it by a rollback.
+	BackupName string `json:"backupName,omitempty"`
+}
+
+// ArchiveLogShippingStatus describes the last shipped archived redo log.
+type ArchiveLogShippingStatus struct {
+	// Incarnation is the resetlogs id of the database incarnation the last
+	// shipped log belongs to.
+	// +optional
+	Incarnation int64 `json:"incarnation,omitempty"`
+
+	// LastShippedSequence is the sequence number of the last shipped log.
+	// +optional
+	LastShippedSequence int64 `json:"lastShippedSequence,omitempty"`
+
+	// LastShippedSCN is the highest SCN covered by the shipped logs.
+	// +optional
+	LastShippedSCN int64 `json:"lastShippedSCN,omitempty"`
+
+	// LastShippedTime is the time of the highest SCN covered by the shipped
+	// logs, which is the current recovery point.
+	// +optional
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Format=date-time
+	LastShippedTime *metav1.Time `json:"lastShippedTime,omitempty"`
+
+	// InProgress is true while a shipping round runs in the database
+	// container.
+	// +optional
+	InProgress bool `json:"inProgress,omitempty"`
+
+	// LastRunTime is the time the last shipping round completed, the next
+	// round starts a shipping interval later.
+	// +optional
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Format=date-time
+	LastRunTime *metav1.Time `json:"lastRunTime,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -190,6 +567,8 @@ type InstanceStatus struct {
 // +kubebuilder:printcolumn:JSONPath=".status.url",name="URL",type="string"
 // +kubebuilder:printcolumn:JSONPath=".status.databasenames",name="DB Names",type="string"
 // +kubebuilder:printcolumn:JSONPath=".status.backupid",name="Backup ID",type="string"
+// +kubebuilder:printcolumn:JSONPath=".status.replication.role",name="Role",type="string",priority=1
+// +kubebuilder:printcolumn:JSONPath=".status.replication.applyLagSeconds",name="Apply Lag",type="integer",priority=1
 // +kubebuilder:printcolumn:JSONPath=`.status.conditions[?(@.type=="Ready")].status`,name="ReadyStatus",type="string"
 // +kubebuilder:printcolumn:JSONPath=`.status.conditions[?(@.type=="Ready")].reason`,name="ReadyReason",type="string"
 // +kubebuilder:printcolumn:JSONPath=`.status.conditions[?(@.type=="Ready")].message`,name="ReadyMessage",type="string",priority=1
@@ -22,10 +22,73 @@ package v1alpha1
 
 import (
 	apiv1alpha1 "github.com/GoogleCloudPlatform/elcarro-oracle-operator/common/api/v1alpha1"
-	"k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArchiveLogShippingSpec) DeepCopyInto(out *ArchiveLogShippingSpec) {
+	*out = *in
+	if in.StorageCredentialsSecretRef != nil {
+		in, out := &in.StorageCredentialsSecretRef, &out.StorageCredentialsSecretRef
+		*out = new(v1.LocalObjectReference)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArchiveLogShippingSpec.
+func (in *ArchiveLogShippingSpec) DeepCopy() *ArchiveLogShippingSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ArchiveLogShippingSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArchiveLogShippingStatus) DeepCopyInto(out *ArchiveLogShippingStatus) {
+	*out = *in
+	if in.LastShippedTime != nil {
+		in, out := &in.LastShippedTime, &out.LastShippedTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastRunTime != nil {
+		in, out := &in.LastRunTime, &out.LastRunTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArchiveLogShippingStatus.
+func (in *ArchiveLogShippingStatus) DeepCopy() *ArchiveLogShippingStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ArchiveLogShippingStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuditLogAnchor) DeepCopyInto(out *AuditLogAnchor) {
+	*out = *in
+	if in.Time != nil {
+		in, out := &in.Time, &out.Time
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuditLogAnchor.
+func (in *AuditLogAnchor) DeepCopy() *AuditLogAnchor {
+	if in == nil {
+		return nil
+	}
+	out := new(AuditLogAnchor)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Backup) DeepCopyInto(out *Backup) {
 	*out = *in
@@ -199,6 +262,11 @@ func (in *BackupScheduleSpec) DeepCopyInto(out *BackupScheduleSpec) {
 		*out = new(BackupRetentionPolicy)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.BackupStrategy != nil {
+		in, out := &in.BackupStrategy, &out.BackupStrategy
+		*out = new(BackupStrategy)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupScheduleSpec.
@@ -220,7 +288,7 @@ func (in *BackupScheduleStatus) DeepCopyInto(out *BackupScheduleStatus) {
 	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
-		*out = make([]v1.Condition, len(*in))
+		*out = make([]metav1.Condition, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -263,6 +331,11 @@ func (in *BackupSpec) DeepCopyInto(out *BackupSpec) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.StorageCredentialsSecretRef != nil {
+		in, out := &in.StorageCredentialsSecretRef, &out.StorageCredentialsSecretRef
+		*out = new(v1.LocalObjectReference)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupSpec.
@@ -279,6 +352,11 @@ func (in *BackupSpec) DeepCopy() *BackupSpec {
 func (in *BackupStatus) DeepCopyInto(out *BackupStatus) {
 	*out = *in
 	in.BackupStatus.DeepCopyInto(&out.BackupStatus)
+	if in.VerifiedSCNRange != nil {
+		in, out := &in.VerifiedSCNRange, &out.VerifiedSCNRange
+		*out = new(SCNRange)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupStatus.
@@ -291,6 +369,21 @@ func (in *BackupStatus) DeepCopy() *BackupStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupStrategy) DeepCopyInto(out *BackupStrategy) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupStrategy.
+func (in *BackupStrategy) DeepCopy() *BackupStrategy {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupStrategy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Config) DeepCopyInto(out *Config) {
 	*out = *in
@@ -642,6 +735,39 @@ func (in *DatabaseList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatabaseResourcesStatus) DeepCopyInto(out *DatabaseResourcesStatus) {
+	*out = *in
+	in.Resources.DeepCopyInto(&out.Resources)
+	if in.FileVolumes != nil {
+		in, out := &in.FileVolumes, &out.FileVolumes
+		*out = make([]FileVolume, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.SGATarget != nil {
+		in, out := &in.SGATarget, &out.SGATarget
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.PGAAggregateTarget != nil {
+		in, out := &in.PGAAggregateTarget, &out.PGAAggregateTarget
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatabaseResourcesStatus.
+func (in *DatabaseResourcesStatus) DeepCopy() *DatabaseResourcesStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DatabaseResourcesStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DatabaseSpec) DeepCopyInto(out *DatabaseSpec) {
 	*out = *in
@@ -651,6 +777,11 @@ func (in *DatabaseSpec) DeepCopyInto(out *DatabaseSpec) {
 		*out = new(apiv1alpha1.GsmSecretReference)
 		**out = **in
 	}
+	if in.AdminPasswordSecretRef != nil {
+		in, out := &in.AdminPasswordSecretRef, &out.AdminPasswordSecretRef
+		*out = new(v1.SecretReference)
+		**out = **in
+	}
 	if in.Users != nil {
 		in, out := &in.Users, &out.Users
 		*out = make([]UserSpec, len(*in))
@@ -658,6 +789,25 @@ func (in *DatabaseSpec) DeepCopyInto(out *DatabaseSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Tablespaces != nil {
+		in, out := &in.Tablespaces, &out.Tablespaces
+		*out = make([]TablespaceSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Roles != nil {
+		in, out := &in.Roles, &out.Roles
+		*out = make([]RoleSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Profiles != nil {
+		in, out := &in.Profiles, &out.Profiles
+		*out = make([]ProfileSpec, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatabaseSpec.
@@ -679,6 +829,11 @@ func (in *DatabaseStatus) DeepCopyInto(out *DatabaseStatus) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.ManagedUsers != nil {
+		in, out := &in.ManagedUsers, &out.ManagedUsers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	if in.UserResourceVersions != nil {
 		in, out := &in.UserResourceVersions, &out.UserResourceVersions
 		*out = make(map[string]string, len(*in))
@@ -686,6 +841,27 @@ func (in *DatabaseStatus) DeepCopyInto(out *DatabaseStatus) {
 			(*out)[key] = val
 		}
 	}
+	if in.Tablespaces != nil {
+		in, out := &in.Tablespaces, &out.Tablespaces
+		*out = make([]TablespaceStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PasswordRotationTimes != nil {
+		in, out := &in.PasswordRotationTimes, &out.PasswordRotationTimes
+		*out = make(map[string]metav1.Time, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+	if in.UserAccountStatuses != nil {
+		in, out := &in.UserAccountStatuses, &out.UserAccountStatuses
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatabaseStatus.
@@ -765,6 +941,11 @@ func (in *ExportSpec) DeepCopyInto(out *ExportSpec) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.StorageCredentialsSecretRef != nil {
+		in, out := &in.StorageCredentialsSecretRef, &out.StorageCredentialsSecretRef
+		*out = new(v1.LocalObjectReference)
+		**out = **in
+	}
 	if in.FlashbackTime != nil {
 		in, out := &in.FlashbackTime, &out.FlashbackTime
 		*out = (*in).DeepCopy()
@@ -786,7 +967,7 @@ func (in *ExportStatus) DeepCopyInto(out *ExportStatus) {
 	*out = *in
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
-		*out = make([]v1.Condition, len(*in))
+		*out = make([]metav1.Condition, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -818,6 +999,31 @@ func (in *FieldResourceTimestampStrategy) DeepCopy() *FieldResourceTimestampStra
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FileVolume) DeepCopyInto(out *FileVolume) {
+	*out = *in
+	if in.PersistentVolumeClaim != nil {
+		in, out := &in.PersistentVolumeClaim, &out.PersistentVolumeClaim
+		*out = new(v1.PersistentVolumeClaimVolumeSource)
+		**out = **in
+	}
+	if in.NFS != nil {
+		in, out := &in.NFS, &out.NFS
+		*out = new(v1.NFSVolumeSource)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FileVolume.
+func (in *FileVolume) DeepCopy() *FileVolume {
+	if in == nil {
+		return nil
+	}
+	out := new(FileVolume)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FinishableStrategy) DeepCopyInto(out *FinishableStrategy) {
 	*out = *in
@@ -848,7 +1054,7 @@ func (in *Import) DeepCopyInto(out *Import) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
+	in.Spec.DeepCopyInto(&out.Spec)
 	in.Status.DeepCopyInto(&out.Status)
 }
 
@@ -905,6 +1111,11 @@ func (in *ImportList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ImportSpec) DeepCopyInto(out *ImportSpec) {
 	*out = *in
+	if in.StorageCredentialsSecretRef != nil {
+		in, out := &in.StorageCredentialsSecretRef, &out.StorageCredentialsSecretRef
+		*out = new(v1.LocalObjectReference)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImportSpec.
@@ -922,7 +1133,7 @@ func (in *ImportStatus) DeepCopyInto(out *ImportStatus) {
 	*out = *in
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
-		*out = make([]v1.Condition, len(*in))
+		*out = make([]metav1.Condition, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -1022,6 +1233,23 @@ func (in *InstanceSpec) DeepCopyInto(out *InstanceSpec) {
 		*out = new(DBNetworkServiceOptions)
 		**out = **in
 	}
+	if in.ArchiveLogShipping != nil {
+		in, out := &in.ArchiveLogShipping, &out.ArchiveLogShipping
+		*out = new(ArchiveLogShippingSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Replication != nil {
+		in, out := &in.Replication, &out.Replication
+		*out = new(ReplicationSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.FileVolumes != nil {
+		in, out := &in.FileVolumes, &out.FileVolumes
+		*out = make([]FileVolume, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceSpec.
@@ -1047,6 +1275,16 @@ func (in *InstanceStatus) DeepCopyInto(out *InstanceStatus) {
 		in, out := &in.LastRestoreTime, &out.LastRestoreTime
 		*out = (*in).DeepCopy()
 	}
+	if in.Patching != nil {
+		in, out := &in.Patching, &out.Patching
+		*out = new(PatchingStatus)
+		**out = **in
+	}
+	if in.DatabaseResources != nil {
+		in, out := &in.DatabaseResources, &out.DatabaseResources
+		*out = new(DatabaseResourcesStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.CurrentParameters != nil {
 		in, out := &in.CurrentParameters, &out.CurrentParameters
 		*out = make(map[string]string, len(*in))
@@ -1061,6 +1299,21 @@ func (in *InstanceStatus) DeepCopyInto(out *InstanceStatus) {
 			(*out)[key] = val
 		}
 	}
+	if in.ArchiveLogShipping != nil {
+		in, out := &in.ArchiveLogShipping, &out.ArchiveLogShipping
+		*out = new(ArchiveLogShippingStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Replication != nil {
+		in, out := &in.Replication, &out.Replication
+		*out = new(ReplicationStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AuditLogAnchor != nil {
+		in, out := &in.AuditLogAnchor, &out.AuditLogAnchor
+		*out = new(AuditLogAnchor)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceStatus.
@@ -1073,6 +1326,53 @@ func (in *InstanceStatus) DeepCopy() *InstanceStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObjectPrivilegeSpec) DeepCopyInto(out *ObjectPrivilegeSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectPrivilegeSpec.
+func (in *ObjectPrivilegeSpec) DeepCopy() *ObjectPrivilegeSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ObjectPrivilegeSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PasswordRotationSpec) DeepCopyInto(out *PasswordRotationSpec) {
+	*out = *in
+	out.Interval = in.Interval
+	out.SecretRef = in.SecretRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PasswordRotationSpec.
+func (in *PasswordRotationSpec) DeepCopy() *PasswordRotationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PasswordRotationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PatchingStatus) DeepCopyInto(out *PatchingStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PatchingStatus.
+func (in *PatchingStatus) DeepCopy() *PatchingStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PatchingStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PendingTrigger) DeepCopyInto(out *PendingTrigger) {
 	*out = *in
@@ -1089,6 +1389,21 @@ func (in *PendingTrigger) DeepCopy() *PendingTrigger {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProfileSpec) DeepCopyInto(out *ProfileSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProfileSpec.
+func (in *ProfileSpec) DeepCopy() *ProfileSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ProfileSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Release) DeepCopyInto(out *Release) {
 	*out = *in
@@ -1178,6 +1493,46 @@ func (in *ReleaseStatus) DeepCopy() *ReleaseStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicationSpec) DeepCopyInto(out *ReplicationSpec) {
+	*out = *in
+	in.PasswordSecretRef.DeepCopyInto(&out.PasswordSecretRef)
+	if in.RoleTransition != nil {
+		in, out := &in.RoleTransition, &out.RoleTransition
+		*out = new(RoleTransitionSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationSpec.
+func (in *ReplicationSpec) DeepCopy() *ReplicationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicationStatus) DeepCopyInto(out *ReplicationStatus) {
+	*out = *in
+	if in.LastRoleTransitionTime != nil {
+		in, out := &in.LastRoleTransitionTime, &out.LastRoleTransitionTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationStatus.
+func (in *ReplicationStatus) DeepCopy() *ReplicationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ResourceRetention) DeepCopyInto(out *ResourceRetention) {
 	*out = *in
@@ -1227,6 +1582,15 @@ func (in *ResourceTimestampStrategy) DeepCopy() *ResourceTimestampStrategy {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RestoreSpec) DeepCopyInto(out *RestoreSpec) {
 	*out = *in
+	if in.StorageCredentialsSecretRef != nil {
+		in, out := &in.StorageCredentialsSecretRef, &out.StorageCredentialsSecretRef
+		*out = new(v1.LocalObjectReference)
+		**out = **in
+	}
+	if in.UntilTime != nil {
+		in, out := &in.UntilTime, &out.UntilTime
+		*out = (*in).DeepCopy()
+	}
 	in.RequestTime.DeepCopyInto(&out.RequestTime)
 }
 
@@ -1240,6 +1604,62 @@ func (in *RestoreSpec) DeepCopy() *RestoreSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RoleSpec) DeepCopyInto(out *RoleSpec) {
+	*out = *in
+	if in.Privileges != nil {
+		in, out := &in.Privileges, &out.Privileges
+		*out = make([]PrivilegeSpec, len(*in))
+		copy(*out, *in)
+	}
+	if in.ObjectPrivileges != nil {
+		in, out := &in.ObjectPrivileges, &out.ObjectPrivileges
+		*out = make([]ObjectPrivilegeSpec, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoleSpec.
+func (in *RoleSpec) DeepCopy() *RoleSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RoleSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RoleTransitionSpec) DeepCopyInto(out *RoleTransitionSpec) {
+	*out = *in
+	in.RequestTime.DeepCopyInto(&out.RequestTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoleTransitionSpec.
+func (in *RoleTransitionSpec) DeepCopy() *RoleTransitionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RoleTransitionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SCNRange) DeepCopyInto(out *SCNRange) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SCNRange.
+func (in *SCNRange) DeepCopy() *SCNRange {
+	if in == nil {
+		return nil
+	}
+	out := new(SCNRange)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *StringFieldStrategy) DeepCopyInto(out *StringFieldStrategy) {
 	*out = *in
@@ -1260,6 +1680,69 @@ func (in *StringFieldStrategy) DeepCopy() *StringFieldStrategy {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TablespaceQuota) DeepCopyInto(out *TablespaceQuota) {
+	*out = *in
+	if in.Size != nil {
+		in, out := &in.Size, &out.Size
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TablespaceQuota.
+func (in *TablespaceQuota) DeepCopy() *TablespaceQuota {
+	if in == nil {
+		return nil
+	}
+	out := new(TablespaceQuota)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TablespaceSpec) DeepCopyInto(out *TablespaceSpec) {
+	*out = *in
+	if in.InitialSize != nil {
+		in, out := &in.InitialSize, &out.InitialSize
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.MaxSize != nil {
+		in, out := &in.MaxSize, &out.MaxSize
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TablespaceSpec.
+func (in *TablespaceSpec) DeepCopy() *TablespaceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TablespaceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TablespaceStatus) DeepCopyInto(out *TablespaceStatus) {
+	*out = *in
+	out.Size = in.Size.DeepCopy()
+	out.Used = in.Used.DeepCopy()
+	out.MaxSize = in.MaxSize.DeepCopy()
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TablespaceStatus.
+func (in *TablespaceStatus) DeepCopy() *TablespaceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TablespaceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TimestampFieldStrategy) DeepCopyInto(out *TimestampFieldStrategy) {
 	*out = *in
@@ -1301,6 +1784,23 @@ func (in *UserSpec) DeepCopyInto(out *UserSpec) {
 		*out = make([]PrivilegeSpec, len(*in))
 		copy(*out, *in)
 	}
+	if in.Quotas != nil {
+		in, out := &in.Quotas, &out.Quotas
+		*out = make([]TablespaceQuota, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ObjectPrivileges != nil {
+		in, out := &in.ObjectPrivileges, &out.ObjectPrivileges
+		*out = make([]ObjectPrivilegeSpec, len(*in))
+		copy(*out, *in)
+	}
+	if in.PasswordRotation != nil {
+		in, out := &in.PasswordRotation, &out.PasswordRotation
+		*out = new(PasswordRotationSpec)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserSpec.
@@ -0,0 +1,52 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Verifies a copy of the audit log of the Database Daemon against the anchor
+// recorded in the status of its Instance.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/GoogleCloudPlatform/elcarro-oracle-operator/oracle/pkg/agents/common/audit"
+)
+
+var (
+	logFile = flag.String("log", "", "the copy of the audit log to verify")
+	seq     = flag.Int64("seq", 0, "the seq of the anchor, status.auditLogAnchor.seq of the Instance")
+	hash    = flag.String("hash", "", "the hash of the anchor, status.auditLogAnchor.hash of the Instance")
+)
+
+func main() {
+	flag.Parse()
+	if *logFile == "" {
+		fmt.Fprintln(os.Stderr, "--log is required")
+		os.Exit(2)
+	}
+	f, err := os.Open(*logFile)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	defer f.Close()
+
+	n, err := audit.Verify(f, audit.Head{Seq: *seq, Hash: *hash})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "verified %d entries: %v\n", n, err)
+		os.Exit(1)
+	}
+	fmt.Printf("verified %d entries\n", n)
+}
@@ -23,6 +23,8 @@ import (
 	"google.golang.org/grpc"
 	"k8s.io/klog/v2"
 
+	"github.com/GoogleCloudPlatform/elcarro-oracle-operator/oracle/pkg/agents/common/audit"
+	"github.com/GoogleCloudPlatform/elcarro-oracle-operator/oracle/pkg/agents/common/mtls"
 	pb "github.com/GoogleCloudPlatform/elcarro-oracle-operator/oracle/pkg/agents/config_agent/protos"
 	ca "github.com/GoogleCloudPlatform/elcarro-oracle-operator/oracle/pkg/agents/config_agent/server"
 	"github.com/GoogleCloudPlatform/elcarro-oracle-operator/oracle/pkg/agents/consts"
@@ -31,6 +33,7 @@ import (
 var port = flag.Int("port", consts.DefaultConfigAgentPort, "The tcp port of a Config Agent server.")
 var dbservice = flag.String("dbservice", "", "The DB service.")
 var dbport = flag.Int("dbport", 0, "The DB service port.")
+var tlsCertDir = flag.String("tls_cert_dir", "", "The directory of the certificates for the mutual TLS with the operator and the Database Daemon. The connections are not encrypted if it is empty.")
 
 func main() {
 	klog.InitFlags(nil)
@@ -48,11 +51,21 @@ func main() {
 		os.Exit(1)
 	}
 
-	grpcSvr := grpc.NewServer()
+	// The caller is forwarded to the Database Daemon for its audit log.
+	opts := []grpc.ServerOption{grpc.UnaryInterceptor(audit.UnaryServerInterceptor())}
+	if *tlsCertDir != "" {
+		creds, err := mtls.NewServerCredentials(*tlsCertDir)
+		if err != nil {
+			klog.ErrorS(err, "Config Agent failed to load the TLS certificates", "dir", *tlsCertDir)
+			os.Exit(1)
+		}
+		opts = append(opts, grpc.Creds(creds))
+	}
+	grpcSvr := grpc.NewServer(opts...)
 
-	pb.RegisterConfigAgentServer(grpcSvr, &ca.ConfigServer{DBService: *dbservice, DBPort: *dbport})
+	pb.RegisterConfigAgentServer(grpcSvr, &ca.ConfigServer{DBService: *dbservice, DBPort: *dbport, TLSCertDir: *tlsCertDir})
 
-	klog.InfoS("Starting Config Agent", "hostname", hostname, "port", *port)
+	klog.InfoS("Starting Config Agent", "hostname", hostname, "port", *port, "mTLS", *tlsCertDir != "")
 	if err := grpcSvr.Serve(lis); err != nil {
 		klog.ErrorS(err, "Config Agent failed to start")
 		os.Exit(1)
@@ -33,6 +33,7 @@ import (
 	"google.golang.org/grpc"
 	"k8s.io/klog/v2"
 
+	"github.com/GoogleCloudPlatform/elcarro-oracle-operator/oracle/pkg/agents/common/mtls"
 	"github.com/GoogleCloudPlatform/elcarro-oracle-operator/oracle/pkg/agents/consts"
 	dbdpb "github.com/GoogleCloudPlatform/elcarro-oracle-operator/oracle/pkg/agents/oracle"
 	"github.com/GoogleCloudPlatform/elcarro-oracle-operator/oracle/pkg/database/dbdaemon"
@@ -44,6 +45,7 @@ const (
 )
 
 var cdbNameFromYaml = flag.String("cdb_name", "GCLOUD", "Name of the CDB to create")
+var tlsCertDir = flag.String("tls_cert_dir", "", "The directory of the certificates for the mutual TLS with the Config Agent and local clients. The connections are not encrypted if it is empty.")
 
 // A user running this program should not be root and
 // a primary group should be either dba or oinstall.
@@ -121,7 +123,16 @@ func main() {
 		os.Exit(exitErrorCode)
 	}
 
-	grpcSvr := grpc.NewServer()
+	var opts []grpc.ServerOption
+	if *tlsCertDir != "" {
+		creds, err := mtls.NewServerCredentials(*tlsCertDir)
+		if err != nil {
+			klog.ErrorS(err, "failed to load the TLS certificates", "dir", *tlsCertDir)
+			os.Exit(exitErrorCode)
+		}
+		opts = append(opts, grpc.Creds(creds))
+	}
+	grpcSvr := grpc.NewServer(opts...)
 	dbdaemonServer, err := dbdaemon.New(context.Background(), *cdbNameFromYaml)
 	if err != nil {
 		klog.ErrorS(err, "failed to execute dbdaemon.New")
@@ -129,6 +140,6 @@ func main() {
 	}
 	dbdpb.RegisterDatabaseDaemonServer(grpcSvr, dbdaemonServer)
 
-	klog.InfoS("Starting a Database Daemon...", "host", hostname, "listenerAddr", lis.Addr())
+	klog.InfoS("Starting a Database Daemon...", "host", hostname, "listenerAddr", lis.Addr(), "mTLS", *tlsCertDir != "")
 	grpcSvr.Serve(lis)
 }
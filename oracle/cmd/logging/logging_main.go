@@ -39,6 +39,7 @@ import (
 const (
 	logTypeAlert    = "ALERT"
 	logTypeListener = "LISTENER"
+	logTypeAudit    = "AUDIT"
 
 	alertLogPathQuery = `select value from v$diag_info where name = 'Diag Trace'`
 	databaseNameQuery = `select name from v$database`
@@ -47,7 +48,7 @@ const (
 )
 
 var (
-	logType      = flag.String("logType", "", "the log file to stream. Currently supports: ALERT, LISTENER")
+	logType      = flag.String("logType", "", "the log file to stream. Currently supports: ALERT, LISTENER, AUDIT")
 	debugLogger  = flag.Bool("debugLogger", false, "enable to get debug logs from the logging sidecar")
 	pollInterval = flag.Duration("pollInterval", 180*time.Second, "time interval to query for updates to log locations (total time to tail a new log might be 2x poll interval)")
 
@@ -76,7 +77,7 @@ func main() {
 		logger = tail.DefaultLogger
 	}
 
-	if *logType != logTypeAlert && *logType != logTypeListener {
+	if *logType != logTypeAlert && *logType != logTypeListener && *logType != logTypeAudit {
 		logger.Fatalf("unrecognized log type: %v", *logType)
 	}
 
@@ -216,6 +217,8 @@ func pollForPathUpdates(ctx context.Context, logType string) {
 					continue
 				}
 				newLogFilePath = filepath.Join(alertLogBase, fmt.Sprintf("alert_%s.log", dbName))
+			} else if logType == logTypeAudit {
+				newLogFilePath = fmt.Sprintf(consts.AuditLogFile, consts.DataMount)
 			}
 
 			latestLogFilePathLock.Lock()
@@ -0,0 +1,279 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controllers
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1alpha1 "github.com/GoogleCloudPlatform/elcarro-oracle-operator/oracle/api/v1alpha1"
+	"github.com/GoogleCloudPlatform/elcarro-oracle-operator/oracle/pkg/agents/common/mtls"
+	"github.com/GoogleCloudPlatform/elcarro-oracle-operator/oracle/pkg/agents/consts"
+)
+
+const (
+	// AgentTLSSecretName is a string template for the names of the Secrets
+	// with the certificates of the database Pods, which are used by the
+	// Database Daemon and its clients in the Pod.
+	AgentTLSSecretName = "%s-agent-tls"
+	// ConfigAgentTLSSecretName is a string template for the names of the
+	// Secrets with the certificates of the Config Agent.
+	ConfigAgentTLSSecretName = "%s-config-agent-tls"
+	// OperatorTLSSecretName is a string template for the names of the Secrets
+	// with the client certificates of the operator. They are not mounted
+	// into any Pod.
+	OperatorTLSSecretName = "%s-operator-tls"
+	// AgentCASecretName is a string template for the names of the Secrets
+	// with the CA which signs the certificates of the agents.
+	AgentCASecretName = "%s-agent-ca"
+
+	agentTLSVolume = "agent-tls"
+	caKeyFile      = "ca.key"
+
+	agentCAValidity      = 10 * 365 * 24 * time.Hour
+	agentCARenewBefore   = 365 * 24 * time.Hour
+	agentCertValidity    = 90 * 24 * time.Hour
+	agentCertRenewBefore = 30 * 24 * time.Hour
+)
+
+// AgentTLSDisabled turns off the mutual TLS between the operator, the Config
+// Agent and the Database Daemon. It can be set via a flag by the program
+// importing the library and is meant for development clusters only.
+var AgentTLSDisabled = false
+
+// ReconcileAgentTLS issues the CA and the certificates of the agents and
+// of the operator for inst and renews them before they expire. Each
+// component gets its own certificate, whose role identifies it to the
+// others, see mtls.Role. The CA certificates in the CA Secret are trusted; a
+// renewed CA is added in front of the previous one, so that certificates
+// issued by either are accepted until the previous one expires.
+func ReconcileAgentTLS(ctx context.Context, c client.Client, scheme *runtime.Scheme, inst *v1alpha1.Instance) error {
+	now := time.Now()
+
+	caSecret := &corev1.Secret{}
+	caKey := types.NamespacedName{Namespace: inst.Namespace, Name: fmt.Sprintf(AgentCASecretName, inst.Name)}
+	if err := c.Get(ctx, caKey, caSecret); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return err
+		}
+		caSecret = &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: caKey.Name, Namespace: caKey.Namespace}}
+	}
+	if caSecret.Data == nil || mtls.ExpiresBefore(caSecret.Data[mtls.CAFile], now.Add(agentCARenewBefore)) {
+		certPEM, keyPEM, err := mtls.NewCA(fmt.Sprintf("%s.%s agent CA", inst.Name, inst.Namespace), now.Add(agentCAValidity))
+		if err != nil {
+			return err
+		}
+		caSecret.Data = map[string][]byte{
+			mtls.CAFile: append(certPEM, mtls.UnexpiredCertificates(caSecret.Data[mtls.CAFile], now)...),
+			caKeyFile:   keyPEM,
+		}
+		if err := applySecret(ctx, c, scheme, inst, caSecret); err != nil {
+			return fmt.Errorf("failed to save the agent CA: %v", err)
+		}
+	}
+
+	for _, cert := range []struct {
+		secretName string
+		role       string
+		hosts      []string
+	}{
+		{secretName: AgentTLSSecretName, role: mtls.RoleDatabase, hosts: agentTLSHosts(inst)},
+		{secretName: ConfigAgentTLSSecretName, role: mtls.RoleConfigAgent, hosts: agentTLSHosts(inst)},
+		{secretName: OperatorTLSSecretName, role: mtls.RoleOperator},
+	} {
+		if err := reconcileCertificate(ctx, c, scheme, inst, caSecret, fmt.Sprintf(cert.secretName, inst.Name), cert.role, cert.hosts, now); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// reconcileCertificate issues the certificate of role in the Secret name
+// if it's missing, of another role, not issued by the current CA or about
+// to expire.
+func reconcileCertificate(ctx context.Context, c client.Client, scheme *runtime.Scheme, inst *v1alpha1.Instance, caSecret *corev1.Secret, name, role string, hosts []string, now time.Time) error {
+	tlsSecret := &corev1.Secret{}
+	tlsKey := types.NamespacedName{Namespace: inst.Namespace, Name: name}
+	if err := c.Get(ctx, tlsKey, tlsSecret); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return err
+		}
+		tlsSecret = &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: tlsKey.Name, Namespace: tlsKey.Namespace}}
+	}
+	if tlsSecret.Data != nil &&
+		bytes.Equal(tlsSecret.Data[mtls.CAFile], caSecret.Data[mtls.CAFile]) &&
+		mtls.Role(tlsSecret.Data[mtls.CertFile]) == role &&
+		!mtls.ExpiresBefore(tlsSecret.Data[mtls.CertFile], now.Add(agentCertRenewBefore)) {
+		return nil
+	}
+	certPEM, keyPEM, err := mtls.IssueCertificate(caSecret.Data[mtls.CAFile], caSecret.Data[caKeyFile],
+		fmt.Sprintf("%s.%s %s", inst.Name, inst.Namespace, role), role, hosts, now.Add(agentCertValidity))
+	if err != nil {
+		return err
+	}
+	tlsSecret.Type = corev1.SecretTypeTLS
+	tlsSecret.Data = map[string][]byte{
+		mtls.CAFile:   caSecret.Data[mtls.CAFile],
+		mtls.CertFile: certPEM,
+		mtls.KeyFile:  keyPEM,
+	}
+	if err := applySecret(ctx, c, scheme, inst, tlsSecret); err != nil {
+		return fmt.Errorf("failed to save the %s certificate: %v", role, err)
+	}
+	return nil
+}
+
+// applySecret creates or updates a Secret owned by inst.
+func applySecret(ctx context.Context, c client.Client, scheme *runtime.Scheme, inst *v1alpha1.Instance, secret *corev1.Secret) error {
+	if secret.ResourceVersion != "" {
+		return c.Update(ctx, secret)
+	}
+	if err := ctrl.SetControllerReference(inst, secret, scheme); err != nil {
+		return err
+	}
+	return c.Create(ctx, secret)
+}
+
+// agentTLSHosts returns the names under which the agents of inst are
+// reached: the Services of the Config Agent and of the Database Daemon, and
+// localhost for the clients in the database pod.
+func agentTLSHosts(inst *v1alpha1.Instance) []string {
+	hosts := []string{consts.Localhost, "127.0.0.1"}
+	for _, svc := range []string{fmt.Sprintf(AgentSvcName, inst.Name), fmt.Sprintf(DbdaemonSvcName, inst.Name)} {
+		hosts = append(hosts, svc, fmt.Sprintf(SvcEndpoint, svc, inst.Namespace), fmt.Sprintf("%s.%s.svc", svc, inst.Namespace))
+	}
+	return hosts
+}
+
+// DialConfigAgent connects to the Config Agent of an Instance at address. It
+// presents the certificate of the operator and verifies the certificate of
+// the Config Agent, unless AgentTLSDisabled is set or the Config Agent
+// doesn't serve the mutual TLS yet.
+func DialConfigAgent(ctx context.Context, r client.Reader, namespace, instName, address string, opts ...grpc.DialOption) (*grpc.ClientConn, error) {
+	tls, err := configAgentTLSEnabled(ctx, r, namespace, instName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check the mutual TLS of the Config Agent: %w", err)
+	}
+	credsOpt := grpc.WithInsecure()
+	if tls {
+		secret := &corev1.Secret{}
+		if err := r.Get(ctx, types.NamespacedName{Namespace: namespace, Name: fmt.Sprintf(OperatorTLSSecretName, instName)}, secret); err != nil {
+			return nil, fmt.Errorf("failed to get the operator certificate: %w", err)
+		}
+		serverName := fmt.Sprintf(SvcEndpoint, fmt.Sprintf(AgentSvcName, instName), namespace)
+		creds, err := mtls.NewClientCredentialsFromPEM(secret.Data[mtls.CAFile], secret.Data[mtls.CertFile], secret.Data[mtls.KeyFile], serverName)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load the operator certificate: %w", err)
+		}
+		credsOpt = grpc.WithTransportCredentials(creds)
+	}
+	return grpc.DialContext(ctx, address, append([]grpc.DialOption{credsOpt}, opts...)...)
+}
+
+// +kubebuilder:rbac:groups=apps,resources=replicasets,verbs=get;list;watch
+
+// configAgentTLSEnabled returns true if the Config Agent of an Instance serves
+// the mutual TLS. The agents of an Instance created before the mutual TLS
+// was introduced get the certificates once the Instance controller rolls them
+// out, which is complete when all the running replicas of the Config Agent
+// Deployment have the certificates.
+func configAgentTLSEnabled(ctx context.Context, r client.Reader, namespace, instName string) (bool, error) {
+	if AgentTLSDisabled {
+		return false, nil
+	}
+	deployment := &appsv1.Deployment{}
+	if err := r.Get(ctx, types.NamespacedName{Namespace: namespace, Name: fmt.Sprintf(AgentDeploymentName, instName)}, deployment); err != nil {
+		if apierrors.IsNotFound(err) {
+			return true, nil
+		}
+		return false, err
+	}
+	if deployment.Spec.Selector == nil {
+		return HasAgentTLS(deployment.Spec.Template.Spec), nil
+	}
+
+	var replicaSets appsv1.ReplicaSetList
+	if err := r.List(ctx, &replicaSets, client.InNamespace(namespace), client.MatchingLabels(deployment.Spec.Selector.MatchLabels)); err != nil {
+		return false, err
+	}
+	running := false
+	for i := range replicaSets.Items {
+		rs := &replicaSets.Items[i]
+		if !metav1.IsControlledBy(rs, deployment) || rs.Status.Replicas == 0 {
+			continue
+		}
+		if !HasAgentTLS(rs.Spec.Template.Spec) {
+			return false, nil
+		}
+		running = true
+	}
+	if !running {
+		return HasAgentTLS(deployment.Spec.Template.Spec), nil
+	}
+	return true, nil
+}
+
+// HasAgentTLS returns true if the certificates of the agents are mounted in
+// a pod spec.
+func HasAgentTLS(spec corev1.PodSpec) bool {
+	return AgentTLSSecret(spec) != ""
+}
+
+// AgentTLSSecret returns the name of the Secret of the agent certificate
+// mounted in a pod spec, empty if none is mounted.
+func AgentTLSSecret(spec corev1.PodSpec) string {
+	for _, v := range spec.Volumes {
+		if v.Name == agentTLSVolume && v.Secret != nil {
+			return v.Secret.SecretName
+		}
+	}
+	return ""
+}
+
+// addAgentTLS mounts the certificate in the Secret secretName into the
+// containers and returns the volume of the certificate, or nil if the
+// mutual TLS is disabled.
+func addAgentTLS(secretName string, containers []corev1.Container) *corev1.Volume {
+	if AgentTLSDisabled {
+		return nil
+	}
+	for i := range containers {
+		containers[i].VolumeMounts = append(containers[i].VolumeMounts, corev1.VolumeMount{Name: agentTLSVolume, MountPath: consts.AgentTLSDir, ReadOnly: true})
+	}
+	return &corev1.Volume{
+		Name:         agentTLSVolume,
+		VolumeSource: corev1.VolumeSource{Secret: &corev1.SecretVolumeSource{SecretName: secretName}},
+	}
+}
+
+// agentTLSArgs returns the arguments of an agent server for the mutual TLS.
+func agentTLSArgs() []string {
+	if AgentTLSDisabled {
+		return nil
+	}
+	return []string{fmt.Sprintf("--tls_cert_dir=%s", consts.AgentTLSDir)}
+}
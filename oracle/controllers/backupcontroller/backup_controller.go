@@ -17,6 +17,7 @@ package backupcontroller
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/go-logr/logr"
@@ -30,11 +31,13 @@ import (
 	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 
 	commonv1alpha1 "github.com/GoogleCloudPlatform/elcarro-oracle-operator/common/api/v1alpha1"
 	v1alpha1 "github.com/GoogleCloudPlatform/elcarro-oracle-operator/oracle/api/v1alpha1"
 	"github.com/GoogleCloudPlatform/elcarro-oracle-operator/oracle/controllers"
 	capb "github.com/GoogleCloudPlatform/elcarro-oracle-operator/oracle/pkg/agents/config_agent/protos"
+	"github.com/GoogleCloudPlatform/elcarro-oracle-operator/oracle/pkg/agents/consts"
 	"github.com/GoogleCloudPlatform/elcarro-oracle-operator/oracle/pkg/k8s"
 )
 
@@ -61,6 +64,7 @@ type BackupReconciler struct {
 // +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
 // +kubebuilder:rbac:groups="",resources=persistentvolumeclaims,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups="",resources=persistentvolumes,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch
 
 func backupSubType(st string) capb.PhysicalBackupRequest_Type {
 	switch st {
@@ -172,6 +176,8 @@ func (r *BackupReconciler) updateBackupStatus(ctx context.Context, backup *v1alp
 		backup.Status.Phase = commonv1alpha1.BackupInProgress
 	} else if k8s.ConditionReasonEquals(readyCond, k8s.BackupFailed) {
 		backup.Status.Phase = commonv1alpha1.BackupFailed
+	} else if k8s.ConditionReasonEquals(readyCond, k8s.BackupCancelled) {
+		backup.Status.Phase = commonv1alpha1.BackupCancelled
 	} else if k8s.ConditionReasonEquals(readyCond, k8s.BackupReady) {
 		backup.Status.Phase = commonv1alpha1.BackupSucceeded
 		if err := r.Status().Update(ctx, backup); err != nil {
@@ -199,16 +205,35 @@ func (r *BackupReconciler) Reconcile(_ context.Context, req ctrl.Request) (resul
 		return ctrl.Result{}, client.IgnoreNotFound(err)
 	}
 
+	if !backup.DeletionTimestamp.IsZero() {
+		return r.reconcileDelete(ctx, &backup, log)
+	}
+	if !controllerutil.ContainsFinalizer(&backup, controllers.FinalizerName) {
+		controllerutil.AddFinalizer(&backup, controllers.FinalizerName)
+		if err := r.Update(ctx, &backup); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
 	// Check if the Backup object is already reconciled
 	readyCond := k8s.FindCondition(backup.Status.Conditions, k8s.Ready)
 	namespace := req.NamespacedName.Namespace
-	if k8s.ConditionReasonEquals(readyCond, k8s.BackupReady) || k8s.ConditionReasonEquals(readyCond, k8s.BackupFailed) {
+	if k8s.ConditionReasonEquals(readyCond, k8s.BackupReady) && backup.Spec.Verify {
+		return r.reconcileVerify(ctx, &backup, log)
+	}
+	if k8s.ConditionReasonEquals(readyCond, k8s.BackupReady) || k8s.ConditionReasonEquals(readyCond, k8s.BackupFailed) || k8s.ConditionReasonEquals(readyCond, k8s.BackupCancelled) {
 		log.Info("Backup reconciler: nothing to do, backup status", "readyCond", readyCond, "Status", backup.Status)
 		return ctrl.Result{}, nil
 	}
 
 	// Verify preflight conditions
 	var inst v1alpha1.Instance
+	if backup.Spec.Cancel && readyCond == nil {
+		log.Info("backup was cancelled before it started")
+		r.Recorder.Event(&backup, corev1.EventTypeNormal, k8s.BackupCancelled, "Backup was cancelled before it started")
+		backup.Status.Conditions = k8s.Upsert(backup.Status.Conditions, k8s.Ready, v1.ConditionFalse, k8s.BackupCancelled, "Backup was cancelled before it started")
+		return ctrl.Result{}, r.updateBackupStatus(ctx, &backup, &inst)
+	}
 	// skip backupPreflightCheck if backup is ready
 	if !k8s.ConditionStatusEquals(readyCond, v1.ConditionTrue) {
 		if err := r.backupPreflightCheck(ctx, req, &backup, &inst); err != nil {
@@ -222,7 +247,16 @@ func (r *BackupReconciler) Reconcile(_ context.Context, req ctrl.Request) (resul
 	}
 
 	if k8s.ConditionReasonEquals(readyCond, k8s.BackupInProgress) {
-		if backup.Spec.Type == "Snapshot" {
+		if backup.Spec.Type == "Snapshot" && backup.Spec.Cancel {
+			if err := r.deleteSnapshots(ctx, &backup, log); err != nil {
+				return ctrl.Result{}, err
+			}
+			r.Recorder.Event(&backup, corev1.EventTypeNormal, k8s.BackupCancelled, "Backup was cancelled")
+			backup.Status.Conditions = k8s.Upsert(backup.Status.Conditions, k8s.Ready, v1.ConditionFalse, k8s.BackupCancelled, "Backup was cancelled")
+			if err := r.updateBackupStatus(ctx, &backup, &inst); err != nil {
+				return ctrl.Result{}, err
+			}
+		} else if backup.Spec.Type == "Snapshot" {
 			if err := r.backupInProgress(ctx, backup, namespace, &inst); err != nil {
 				return ctrl.Result{}, err
 			}
@@ -235,7 +269,11 @@ func (r *BackupReconciler) Reconcile(_ context.Context, req ctrl.Request) (resul
 			}
 			if operation.Done {
 				log.Info("LRO is DONE", "id", id)
-				if operation.GetError() != nil {
+				if controllers.IsLROCancelled(operation) {
+					r.Recorder.Event(&backup, corev1.EventTypeNormal, k8s.BackupCancelled, "Backup was cancelled")
+					backup.Status.Conditions = k8s.Upsert(backup.Status.Conditions, k8s.Ready, v1.ConditionFalse, k8s.BackupCancelled, "Backup was cancelled")
+					r.deleteCancelledBackupPieces(ctx, &backup, log)
+				} else if operation.GetError() != nil {
 					log.Error(fmt.Errorf(operation.GetError().GetMessage()), "backup failed")
 					r.Recorder.Event(&backup, corev1.EventTypeWarning, "BackupFailed", operation.GetError().GetMessage())
 					backup.Status.Conditions = k8s.Upsert(backup.Status.Conditions, k8s.Ready, v1.ConditionFalse, k8s.BackupFailed, operation.GetError().GetMessage())
@@ -248,8 +286,20 @@ func (r *BackupReconciler) Reconcile(_ context.Context, req ctrl.Request) (resul
 					return ctrl.Result{}, err
 				}
 				_ = controllers.DeleteLROOperation(r.ClientFactory, ctx, r, req.Namespace, id, backup.Spec.Instance)
+			} else if backup.Spec.Cancel {
+				log.Info("cancelling the LRO", "id", id)
+				if err := controllers.CancelLROOperation(r.ClientFactory, ctx, r, req.Namespace, id, backup.Spec.Instance); err != nil {
+					return ctrl.Result{}, err
+				}
+				return ctrl.Result{RequeueAfter: 10 * time.Second}, nil
 			} else {
 				log.Info("LRO is in progress", "id", id)
+				if progress := controllers.LROProgress(operation); progress != "" && progress != readyCond.Message {
+					backup.Status.Conditions = k8s.Upsert(backup.Status.Conditions, k8s.Ready, v1.ConditionFalse, k8s.BackupInProgress, progress)
+					if err := r.updateBackupStatus(ctx, &backup, &inst); err != nil {
+						log.Error(err, "failed to update the backup progress", "backup", backup)
+					}
+				}
 				return ctrl.Result{RequeueAfter: time.Minute}, nil
 			}
 		}
@@ -363,19 +413,29 @@ func (r *BackupReconciler) Reconcile(_ context.Context, req ctrl.Request) (resul
 		}
 		defer closeConn()
 
+		creds, err := controllers.GetStorageCredentials(ctx, r, namespace, backup.Spec.StorageCredentialsSecretRef)
+		if err != nil {
+			log.Error(err, "failed to get storage credentials")
+			return ctrl.Result{}, err
+		}
+
 		resp, err := caClient.PhysicalBackup(ctxBackup, &capb.PhysicalBackupRequest{
-			BackupSubType: backupSubType(backup.Spec.Subtype),
-			BackupItems:   backup.Spec.BackupItems,
-			Backupset:     *backup.Spec.Backupset,
-			CheckLogical:  backup.Spec.CheckLogical,
-			Compressed:    backup.Spec.Compressed,
-			Dop:           backup.Spec.Dop,
-			Level:         backup.Spec.Level,
-			Filesperset:   backup.Spec.Filesperset,
-			SectionSize:   backup.Spec.SectionSize,
-			LocalPath:     backup.Spec.LocalPath,
-			GcsPath:       backup.Spec.GcsPath,
-			LroInput:      &capb.LROInput{OperationId: lroOperationID(&backup)},
+			BackupSubType:        backupSubType(backup.Spec.Subtype),
+			BackupItems:          backup.Spec.BackupItems,
+			Backupset:            *backup.Spec.Backupset,
+			CheckLogical:         backup.Spec.CheckLogical,
+			Compressed:           backup.Spec.Compressed,
+			Dop:                  backup.Spec.Dop,
+			Level:                backup.Spec.Level,
+			Cumulative:           backup.Spec.Cumulative,
+			IncrementallyUpdated: backup.Spec.IncrementallyUpdated,
+			Filesperset:          backup.Spec.Filesperset,
+			SectionSize:          backup.Spec.SectionSize,
+			LocalPath:            backup.Spec.LocalPath,
+			GcsPath:              backup.Spec.GcsPath,
+			LroInput:             &capb.LROInput{OperationId: lroOperationID(&backup)},
+			StorageCredentials:   creds,
+			BackupTag:            backupTag(&backup),
 		})
 		if err != nil {
 			if !controllers.IsAlreadyExistsError(err) {
@@ -403,6 +463,9 @@ func (r *BackupReconciler) Reconcile(_ context.Context, req ctrl.Request) (resul
 
 	backup.Status.BackupID = backupID
 	backup.Status.BackupTime = time.Now().Format("20060102150405")
+	if backup.Spec.Type != "Snapshot" {
+		backup.Status.BackupTag = backupTag(&backup)
+	}
 	if err := r.updateBackupStatus(ctx, &backup, &inst); err != nil {
 		return ctrl.Result{}, err
 	}
@@ -412,6 +475,177 @@ func (r *BackupReconciler) Reconcile(_ context.Context, req ctrl.Request) (resul
 	return ctrl.Result{}, nil
 }
 
+// reconcileDelete deletes the data of a deleted Backup unless
+// KeepDataOnDeletion is set and removes the finalizer once done.
+func (r *BackupReconciler) reconcileDelete(ctx context.Context, backup *v1alpha1.Backup, log logr.Logger) (ctrl.Result, error) {
+	if !controllerutil.ContainsFinalizer(backup, controllers.FinalizerName) {
+		return ctrl.Result{}, nil
+	}
+
+	readyCond := k8s.FindCondition(backup.Status.Conditions, k8s.Ready)
+	if readyCond != nil && !k8s.ConditionReasonEquals(readyCond, k8s.DeleteInProgress) {
+		backup.Status.Conditions = k8s.Upsert(backup.Status.Conditions, k8s.Ready, readyCond.Status, k8s.DeleteInProgress, fmt.Sprintf("Deleting backup, keepDataOnDeletion: %t", backup.Spec.KeepDataOnDeletion))
+		if err := r.Status().Update(ctx, backup); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	if backup.Spec.Type != commonv1alpha1.BackupTypeSnapshot && backup.Status.Phase == commonv1alpha1.BackupInProgress {
+		if result, err := r.cancelBackupInProgress(ctx, backup, log); err != nil || !result.IsZero() {
+			return result, err
+		}
+	}
+
+	switch {
+	case backup.Spec.Type == commonv1alpha1.BackupTypeSnapshot:
+		if err := r.deleteSnapshots(ctx, backup, log); err != nil {
+			return ctrl.Result{}, err
+		}
+	case backup.Spec.KeepDataOnDeletion:
+		log.Info("keeping the backup data", "gcsPath", backup.Spec.GcsPath, "localPath", backup.Spec.LocalPath)
+	case readyCond == nil:
+		log.Info("backup was not started, nothing to delete")
+	default:
+		if result, err := r.deleteBackupPieces(ctx, backup, readyCond, log); err != nil || !result.IsZero() {
+			return result, err
+		}
+	}
+
+	controllerutil.RemoveFinalizer(backup, controllers.FinalizerName)
+	return ctrl.Result{}, r.Update(ctx, backup)
+}
+
+// deleteSnapshots deletes the volume snapshots of a deleted Backup or, if
+// KeepDataOnDeletion is set, releases them from its ownership so that they
+// are not garbage collected.
+func (r *BackupReconciler) deleteSnapshots(ctx context.Context, backup *v1alpha1.Backup, log logr.Logger) error {
+	var volSnaps snapv1.VolumeSnapshotList
+	if err := r.List(ctx, &volSnaps, client.InNamespace(backup.Namespace)); err != nil {
+		return err
+	}
+	for i := range volSnaps.Items {
+		vs := &volSnaps.Items[i]
+		if !v1.IsControlledBy(vs, backup) {
+			continue
+		}
+		if !backup.Spec.KeepDataOnDeletion {
+			log.Info("deleting volume snapshot", "name", vs.Name)
+			if err := r.Delete(ctx, vs); client.IgnoreNotFound(err) != nil {
+				return err
+			}
+			continue
+		}
+		var refs []v1.OwnerReference
+		for _, ref := range vs.OwnerReferences {
+			if ref.UID != backup.UID {
+				refs = append(refs, ref)
+			}
+		}
+		vs.OwnerReferences = refs
+		log.Info("keeping volume snapshot", "name", vs.Name)
+		if err := r.Update(ctx, vs); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// cancelBackupInProgress cancels the RMAN backup of a deleted physical
+// Backup in progress and waits for it to stop.
+func (r *BackupReconciler) cancelBackupInProgress(ctx context.Context, backup *v1alpha1.Backup, log logr.Logger) (ctrl.Result, error) {
+	var inst v1alpha1.Instance
+	if err := r.Get(ctx, types.NamespacedName{Namespace: backup.Namespace, Name: backup.Spec.Instance}, &inst); err != nil || !inst.DeletionTimestamp.IsZero() {
+		// The backup stopped with the instance.
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+	id := lroOperationID(backup)
+	operation, err := controllers.GetLROOperation(r.ClientFactory, ctx, r, backup.Namespace, id, backup.Spec.Instance)
+	if err != nil && !controllers.IsNotFoundError(err) {
+		return ctrl.Result{}, err
+	}
+	if err == nil && !operation.Done {
+		log.Info("cancelling the backup in progress before deleting it")
+		if err := controllers.CancelLROOperation(r.ClientFactory, ctx, r, backup.Namespace, id, backup.Spec.Instance); err != nil {
+			return ctrl.Result{}, err
+		}
+		return ctrl.Result{RequeueAfter: 10 * time.Second}, nil
+	}
+	_ = controllers.DeleteLROOperation(r.ClientFactory, ctx, r, backup.Namespace, id, backup.Spec.Instance)
+	return ctrl.Result{}, nil
+}
+
+// deleteCancelledBackupPieces deletes the RMAN backup pieces a cancelled
+// physical Backup took before it was stopped, unless KeepDataOnDeletion is
+// set. A failure is only logged, the pieces are deleted again with the
+// Backup.
+func (r *BackupReconciler) deleteCancelledBackupPieces(ctx context.Context, backup *v1alpha1.Backup, log logr.Logger) {
+	if backup.Spec.KeepDataOnDeletion {
+		return
+	}
+	resp, err := r.deleteBackup(ctx, backup)
+	if err != nil {
+		log.Error(err, "failed to delete the backup pieces of the cancelled backup")
+		return
+	}
+	log.Info("deleted the backup pieces of the cancelled backup", "count", resp.GetDeletedCount())
+}
+
+// deleteBackup deletes the RMAN backup pieces with the tag of a physical
+// Backup.
+func (r *BackupReconciler) deleteBackup(ctx context.Context, backup *v1alpha1.Backup) (*capb.DeleteBackupResponse, error) {
+	creds, err := controllers.GetStorageCredentials(ctx, r, backup.Namespace, backup.Spec.StorageCredentialsSecretRef)
+	if err != nil {
+		return nil, err
+	}
+
+	caClient, closeConn, err := r.ClientFactory.New(ctx, r, backup.Namespace, backup.Spec.Instance)
+	if err != nil {
+		return nil, err
+	}
+	defer closeConn()
+
+	return caClient.DeleteBackup(ctx, &capb.DeleteBackupRequest{
+		BackupTag:          backupTag(backup),
+		GcsPath:            backup.Spec.GcsPath,
+		StorageCredentials: creds,
+	})
+}
+
+// deleteBackupPieces deletes the RMAN backup pieces of a deleted physical
+// Backup. The pieces can only be found by the RMAN tag recorded in the Backup
+// status and deleted through its Instance. Without either, e.g. for a Backup
+// taken before the tags were recorded, the pieces are left in place and a
+// warning event names their location, so that they can be deleted manually.
+func (r *BackupReconciler) deleteBackupPieces(ctx context.Context, backup *v1alpha1.Backup, readyCond *v1.Condition, log logr.Logger) (ctrl.Result, error) {
+	if backup.Status.BackupTag == "" {
+		log.Info("backup has no RMAN tag, can't delete backup pieces")
+		r.Recorder.Eventf(backup, corev1.EventTypeWarning, k8s.DeleteFailed, "Backup has no RMAN tag, backup pieces in %s were not deleted", backupLocation(backup))
+		return ctrl.Result{}, nil
+	}
+
+	var inst v1alpha1.Instance
+	if err := r.Get(ctx, types.NamespacedName{Namespace: backup.Namespace, Name: backup.Spec.Instance}, &inst); err != nil || !inst.DeletionTimestamp.IsZero() {
+		if client.IgnoreNotFound(err) != nil {
+			return ctrl.Result{}, err
+		}
+		log.Info("instance is gone or being deleted, can't delete backup pieces", "instance", backup.Spec.Instance)
+		r.Recorder.Eventf(backup, corev1.EventTypeWarning, k8s.DeleteFailed, "Instance %q is gone, backup pieces with RMAN tag %s in %s were not deleted", backup.Spec.Instance, backup.Status.BackupTag, backupLocation(backup))
+		return ctrl.Result{}, nil
+	}
+
+	resp, err := r.deleteBackup(ctx, backup)
+	if err != nil {
+		r.Recorder.Eventf(backup, corev1.EventTypeWarning, k8s.DeleteFailed, "Failed to delete backup pieces: %v", err)
+		backup.Status.Conditions = k8s.Upsert(backup.Status.Conditions, k8s.Ready, readyCond.Status, k8s.DeleteFailed, err.Error())
+		if updateErr := r.Status().Update(ctx, backup); updateErr != nil {
+			log.Error(updateErr, "failed to update backup status")
+		}
+		return ctrl.Result{}, err
+	}
+	r.Recorder.Eventf(backup, corev1.EventTypeNormal, k8s.DeleteInProgress, "Deleted %d backup pieces", resp.GetDeletedCount())
+	return ctrl.Result{}, nil
+}
+
 func (r *BackupReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	mgr.GetFieldIndexer().IndexField(
 		context.TODO(),
@@ -450,6 +684,28 @@ func lroOperationID(backup *v1alpha1.Backup) string {
 	return fmt.Sprintf("Backup_%s", backup.GetUID())
 }
 
+// backupTag returns the RMAN tag of the pieces of a physical backup,
+// RMAN tags are limited to 30 characters.
+func backupTag(backup *v1alpha1.Backup) string {
+	tag := "EL_" + strings.ToUpper(strings.ReplaceAll(string(backup.GetUID()), "-", ""))
+	if len(tag) > 30 {
+		tag = tag[:30]
+	}
+	return tag
+}
+
+// backupLocation returns where the pieces of a physical backup are stored.
+func backupLocation(backup *v1alpha1.Backup) string {
+	switch {
+	case backup.Spec.GcsPath != "":
+		return backup.Spec.GcsPath
+	case backup.Spec.LocalPath != "":
+		return backup.Spec.LocalPath
+	default:
+		return consts.DefaultRMANDir
+	}
+}
+
 var preflightCheck = func(ctx context.Context, r *BackupReconciler, namespace, instName string) error {
 	// Confirm that an external LB is ready.
 	svc := &corev1.Service{}
@@ -17,6 +17,7 @@ package backupcontroller
 import (
 	"context"
 	"fmt"
+	"strings"
 	"testing"
 	"time"
 
@@ -26,12 +27,15 @@ import (
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	commonv1alpha1 "github.com/GoogleCloudPlatform/elcarro-oracle-operator/common/api/v1alpha1"
 	v1alpha1 "github.com/GoogleCloudPlatform/elcarro-oracle-operator/oracle/api/v1alpha1"
+	"github.com/GoogleCloudPlatform/elcarro-oracle-operator/oracle/controllers"
 	"github.com/GoogleCloudPlatform/elcarro-oracle-operator/oracle/controllers/testhelpers"
+	capb "github.com/GoogleCloudPlatform/elcarro-oracle-operator/oracle/pkg/agents/config_agent/protos"
 	"github.com/GoogleCloudPlatform/elcarro-oracle-operator/oracle/pkg/k8s"
 )
 
@@ -329,8 +333,221 @@ var _ = Describe("Backup controller", func() {
 			Expect(inst.Status.BackupID).Should(Equal(""))
 		})
 	})
+
+	Context("Backup verification", func() {
+		It("Should verify RMAN backup", func() {
+			oldFunc := preflightCheck
+			preflightCheck = func(ctx context.Context, r *BackupReconciler, namespace, instName string) error {
+				return nil
+			}
+			defer func() { preflightCheck = oldFunc }()
+
+			fakeConfigAgentClient := fakeClientFactory.Caclient
+			fakeConfigAgentClient.SetNextGetOperationStatus(testhelpers.StatusRunning)
+			fakeConfigAgentClient.SetDescribeBackup(&capb.DescribeBackupResponse{MinScn: 1000, MaxScn: 1200})
+
+			By("By creating a RMAN type backup of the instance to verify")
+			backup := &v1alpha1.Backup{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: Namespace,
+					Name:      BackupName,
+				},
+				Spec: v1alpha1.BackupSpec{
+					BackupSpec: commonv1alpha1.BackupSpec{
+						Instance: instance.Name,
+						Type:     commonv1alpha1.BackupTypePhysical,
+					},
+					GcsPath: "gs://bucket/rman",
+					Verify:  true,
+				},
+			}
+			objKey := client.ObjectKey{Namespace: Namespace, Name: BackupName}
+			testhelpers.K8sCreateWithRetry(k8sClient, ctx, backup)
+
+			By("By checking that the verification was started")
+			Eventually(func() (string, error) {
+				return getConditionReason(ctx, objKey, k8s.Verified)
+			}, timeout, interval).Should(Equal(k8s.VerifyInProgress))
+			Expect(fakeConfigAgentClient.VerifyBackupCalledCnt()).Should(Equal(1))
+			var createdBackup v1alpha1.Backup
+			Expect(k8sClient.Get(ctx, objKey, &createdBackup)).Should(Succeed())
+			req := fakeConfigAgentClient.VerifyBackupRequest()
+			Expect(req.GetBackupTag()).Should(Equal(backupTag(&createdBackup)))
+			Expect(req.GetGcsPath()).Should(Equal("gs://bucket/rman"))
+
+			By("By checking that the backup is Verified on verification LRO completion")
+			fakeConfigAgentClient.SetNextGetOperationStatus(testhelpers.StatusDone)
+			Expect(triggerReconcile(ctx, objKey)).Should(Succeed())
+			Eventually(func() (string, error) {
+				return getConditionReason(ctx, objKey, k8s.Verified)
+			}, timeout, interval).Should(Equal(k8s.VerifyComplete))
+			Expect(k8sClient.Get(ctx, objKey, &createdBackup)).Should(Succeed())
+			Expect(createdBackup.Status.VerifiedSCNRange).Should(Equal(&v1alpha1.SCNRange{MinSCN: 1000, MaxSCN: 1200}))
+		})
+
+		It("Should mark unsuccessful verification as Failed", func() {
+			oldFunc := preflightCheck
+			preflightCheck = func(ctx context.Context, r *BackupReconciler, namespace, instName string) error {
+				return nil
+			}
+			defer func() { preflightCheck = oldFunc }()
+
+			fakeConfigAgentClient := fakeClientFactory.Caclient
+			fakeConfigAgentClient.SetNextGetOperationStatus(testhelpers.StatusDoneWithError)
+
+			backup := &v1alpha1.Backup{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: Namespace,
+					Name:      BackupName,
+				},
+				Spec: v1alpha1.BackupSpec{
+					BackupSpec: commonv1alpha1.BackupSpec{
+						Instance: instance.Name,
+						Type:     commonv1alpha1.BackupTypePhysical,
+					},
+					Verify: true,
+				},
+			}
+			objKey := client.ObjectKey{Namespace: Namespace, Name: BackupName}
+			testhelpers.K8sCreateWithRetry(k8sClient, ctx, backup)
+
+			Eventually(func() (string, error) {
+				return getConditionReason(ctx, objKey, k8s.Verified)
+			}, timeout, interval).Should(Equal(k8s.VerifyFailed))
+			Expect(getConditionReason(ctx, objKey, k8s.Ready)).Should(Equal(k8s.BackupReady))
+			Expect(fakeConfigAgentClient.DescribeBackupCalledCnt()).Should(Equal(0))
+		})
+	})
+
+	Context("Backup deletion", func() {
+		var backup *v1alpha1.Backup
+		objKey := client.ObjectKey{Namespace: Namespace, Name: BackupName}
+		oldFunc := preflightCheck
+
+		AfterEach(func() {
+			preflightCheck = oldFunc
+		})
+
+		BeforeEach(func() {
+			preflightCheck = func(ctx context.Context, r *BackupReconciler, namespace, instName string) error {
+				return nil
+			}
+
+			backup = &v1alpha1.Backup{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: Namespace,
+					Name:      BackupName,
+				},
+				Spec: v1alpha1.BackupSpec{
+					BackupSpec: commonv1alpha1.BackupSpec{
+						Instance: instance.Name,
+					},
+				},
+			}
+		})
+
+		// createAndDeleteBackup waits for the backup to be taken and deletes it.
+		createAndDeleteBackup := func(wantReason string) v1alpha1.Backup {
+			testhelpers.K8sCreateWithRetry(k8sClient, ctx, backup)
+			Eventually(func() (string, error) {
+				return getConditionReason(ctx, objKey, k8s.Ready)
+			}, timeout, interval).Should(Equal(wantReason))
+
+			var createdBackup v1alpha1.Backup
+			Expect(k8sClient.Get(ctx, objKey, &createdBackup)).Should(Succeed())
+			Expect(createdBackup.Finalizers).Should(ContainElement(controllers.FinalizerName))
+
+			By("By deleting the backup")
+			Expect(k8sClient.Delete(ctx, &createdBackup)).Should(Succeed())
+			Eventually(func() bool {
+				return errors.IsNotFound(k8sClient.Get(ctx, objKey, &v1alpha1.Backup{}))
+			}, timeout, interval).Should(BeTrue())
+			return createdBackup
+		}
+
+		It("Should delete RMAN backup pieces", func() {
+			backup.Spec.Type = commonv1alpha1.BackupTypePhysical
+			backup.Spec.GcsPath = "gs://bucket/rman"
+			createdBackup := createAndDeleteBackup(k8s.BackupReady)
+
+			// The deletion may be retried on a stale cache.
+			Expect(fakeClientFactory.Caclient.DeleteBackupCalledCnt()).Should(BeNumerically(">=", 1))
+			Expect(createdBackup.Status.BackupTag).Should(Equal(backupTag(&createdBackup)))
+			req := fakeClientFactory.Caclient.DeleteBackupRequest()
+			Expect(req.GetBackupTag()).Should(Equal(backupTag(&createdBackup)))
+			Expect(req.GetGcsPath()).Should(Equal("gs://bucket/rman"))
+		})
+
+		It("Should keep RMAN backup pieces of a backup without a tag", func() {
+			backup.Spec.Type = commonv1alpha1.BackupTypePhysical
+			testhelpers.K8sCreateWithRetry(k8sClient, ctx, backup)
+			Eventually(func() (string, error) {
+				return getConditionReason(ctx, objKey, k8s.Ready)
+			}, timeout, interval).Should(Equal(k8s.BackupReady))
+
+			By("By removing the tag as for a backup taken before the tags were recorded")
+			Expect(retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+				var createdBackup v1alpha1.Backup
+				if err := k8sClient.Get(ctx, objKey, &createdBackup); err != nil {
+					return err
+				}
+				createdBackup.Status.BackupTag = ""
+				return k8sClient.Status().Update(ctx, &createdBackup)
+			})).Should(Succeed())
+
+			By("By deleting the backup")
+			var createdBackup v1alpha1.Backup
+			Expect(k8sClient.Get(ctx, objKey, &createdBackup)).Should(Succeed())
+			Expect(k8sClient.Delete(ctx, &createdBackup)).Should(Succeed())
+			Eventually(func() bool {
+				return errors.IsNotFound(k8sClient.Get(ctx, objKey, &v1alpha1.Backup{}))
+			}, timeout, interval).Should(BeTrue())
+
+			Expect(fakeClientFactory.Caclient.DeleteBackupCalledCnt()).Should(Equal(0))
+		})
+
+		It("Should keep RMAN backup pieces if KeepDataOnDeletion is set", func() {
+			backup.Spec.Type = commonv1alpha1.BackupTypePhysical
+			backup.Spec.KeepDataOnDeletion = true
+			createAndDeleteBackup(k8s.BackupReady)
+
+			Expect(fakeClientFactory.Caclient.DeleteBackupCalledCnt()).Should(Equal(0))
+		})
+
+		It("Should delete volume snapshots", func() {
+			backup.Spec.Type = commonv1alpha1.BackupTypeSnapshot
+			createdBackup := createAndDeleteBackup(k8s.BackupInProgress)
+
+			Expect(getSnapshots(ctx, createdBackup.Status.BackupID)).Should(BeEmpty())
+		})
+
+		It("Should keep volume snapshots if KeepDataOnDeletion is set", func() {
+			backup.Spec.Type = commonv1alpha1.BackupTypeSnapshot
+			backup.Spec.KeepDataOnDeletion = true
+			createdBackup := createAndDeleteBackup(k8s.BackupInProgress)
+
+			snapshots := getSnapshots(ctx, createdBackup.Status.BackupID)
+			Expect(snapshots).Should(HaveLen(2))
+			for _, vs := range snapshots {
+				Expect(metav1.IsControlledBy(&vs, &createdBackup)).Should(BeFalse())
+			}
+		})
+	})
 })
 
+// getSnapshots returns the volume snapshots of a backup.
+func getSnapshots(ctx context.Context, backupID string) []snapv1.VolumeSnapshot {
+	var snapshots snapv1.VolumeSnapshotList
+	Expect(k8sClient.List(ctx, &snapshots)).Should(Succeed())
+	var found []snapv1.VolumeSnapshot
+	for _, vs := range snapshots.Items {
+		if strings.HasPrefix(vs.Name, backupID+"-") {
+			found = append(found, vs)
+		}
+	}
+	return found
+}
+
 func getConditionReason(ctx context.Context, objKey client.ObjectKey, condType string) (string, error) {
 	var backup v1alpha1.Backup
 	if err := k8sClient.Get(ctx, objKey, &backup); err != nil {
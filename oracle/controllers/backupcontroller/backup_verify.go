@@ -0,0 +1,127 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backupcontroller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	commonv1alpha1 "github.com/GoogleCloudPlatform/elcarro-oracle-operator/common/api/v1alpha1"
+	v1alpha1 "github.com/GoogleCloudPlatform/elcarro-oracle-operator/oracle/api/v1alpha1"
+	"github.com/GoogleCloudPlatform/elcarro-oracle-operator/oracle/controllers"
+	capb "github.com/GoogleCloudPlatform/elcarro-oracle-operator/oracle/pkg/agents/config_agent/protos"
+	"github.com/GoogleCloudPlatform/elcarro-oracle-operator/oracle/pkg/k8s"
+)
+
+// reconcileVerify verifies a successful physical backup with RMAN and reports
+// the outcome by the Verified condition. A backup is verified once.
+func (r *BackupReconciler) reconcileVerify(ctx context.Context, backup *v1alpha1.Backup, log logr.Logger) (ctrl.Result, error) {
+	verifiedCond := k8s.FindCondition(backup.Status.Conditions, k8s.Verified)
+	switch {
+	case k8s.ConditionReasonEquals(verifiedCond, k8s.VerifyComplete) || k8s.ConditionReasonEquals(verifiedCond, k8s.VerifyFailed):
+		log.Info("Backup reconciler: nothing to do, backup verification status", "verifiedCond", verifiedCond)
+		return ctrl.Result{}, nil
+	case backup.Spec.Type != commonv1alpha1.BackupTypePhysical:
+		backup.Status.Conditions = k8s.Upsert(backup.Status.Conditions, k8s.Verified, v1.ConditionFalse, k8s.VerifyFailed, "only Physical backups can be verified")
+		return ctrl.Result{}, r.Status().Update(ctx, backup)
+	case verifiedCond == nil:
+		return r.startVerify(ctx, backup, log)
+	}
+
+	id := lroVerifyOperationID(backup)
+	operation, err := controllers.GetLROOperation(r.ClientFactory, ctx, r, backup.Namespace, id, backup.Spec.Instance)
+	if err != nil {
+		log.Error(err, "GetLROOperation error")
+		return ctrl.Result{}, err
+	}
+	if !operation.Done {
+		log.Info("verification LRO is in progress", "id", id)
+		return ctrl.Result{RequeueAfter: time.Minute}, nil
+	}
+
+	if operation.GetError() != nil {
+		log.Error(fmt.Errorf(operation.GetError().GetMessage()), "backup verification failed")
+		r.Recorder.Event(backup, corev1.EventTypeWarning, k8s.VerifyFailed, operation.GetError().GetMessage())
+		backup.Status.Conditions = k8s.Upsert(backup.Status.Conditions, k8s.Verified, v1.ConditionFalse, k8s.VerifyFailed, operation.GetError().GetMessage())
+	} else {
+		caClient, closeConn, err := r.ClientFactory.New(ctx, r, backup.Namespace, backup.Spec.Instance)
+		if err != nil {
+			log.Error(err, "failed to create config agent client")
+			return ctrl.Result{}, err
+		}
+		defer closeConn()
+
+		resp, err := caClient.DescribeBackup(ctx, &capb.DescribeBackupRequest{BackupTag: backupTag(backup)})
+		if err != nil {
+			return ctrl.Result{}, fmt.Errorf("failed on DescribeBackup gRPC call: %v", err)
+		}
+		backup.Status.VerifiedSCNRange = &v1alpha1.SCNRange{MinSCN: resp.GetMinScn(), MaxSCN: resp.GetMaxScn()}
+		msg := fmt.Sprintf("Backup can be recovered to SCN %d through %d", resp.GetMinScn(), resp.GetMaxScn())
+		r.Recorder.Event(backup, corev1.EventTypeNormal, k8s.VerifyComplete, msg)
+		backup.Status.Conditions = k8s.Upsert(backup.Status.Conditions, k8s.Verified, v1.ConditionTrue, k8s.VerifyComplete, msg)
+	}
+	if err := r.Status().Update(ctx, backup); err != nil {
+		return ctrl.Result{}, err
+	}
+	_ = controllers.DeleteLROOperation(r.ClientFactory, ctx, r, backup.Namespace, id, backup.Spec.Instance)
+	return ctrl.Result{}, nil
+}
+
+// startVerify starts the RMAN verification of a physical backup.
+func (r *BackupReconciler) startVerify(ctx context.Context, backup *v1alpha1.Backup, log logr.Logger) (ctrl.Result, error) {
+	creds, err := controllers.GetStorageCredentials(ctx, r, backup.Namespace, backup.Spec.StorageCredentialsSecretRef)
+	if err != nil {
+		log.Error(err, "failed to get storage credentials")
+		return ctrl.Result{}, err
+	}
+
+	caClient, closeConn, err := r.ClientFactory.New(ctx, r, backup.Namespace, backup.Spec.Instance)
+	if err != nil {
+		log.Error(err, "failed to create config agent client")
+		return ctrl.Result{}, err
+	}
+	defer closeConn()
+
+	if _, err := caClient.VerifyBackup(ctx, &capb.VerifyBackupRequest{
+		BackupTag:          backupTag(backup),
+		Dop:                backup.Spec.Dop,
+		GcsPath:            backup.Spec.GcsPath,
+		LroInput:           &capb.LROInput{OperationId: lroVerifyOperationID(backup)},
+		StorageCredentials: creds,
+	}); err != nil {
+		if !controllers.IsAlreadyExistsError(err) {
+			r.Recorder.Eventf(backup, corev1.EventTypeWarning, k8s.VerifyFailed, "Failed to start the verification: %v", err)
+			return ctrl.Result{}, fmt.Errorf("failed on VerifyBackup gRPC call: %v", err)
+		}
+		log.Info("operation already exists")
+	}
+
+	log.Info("backup verification started")
+	backup.Status.Conditions = k8s.Upsert(backup.Status.Conditions, k8s.Verified, v1.ConditionFalse, k8s.VerifyInProgress, "")
+	if err := r.Status().Update(ctx, backup); err != nil {
+		return ctrl.Result{}, err
+	}
+	return ctrl.Result{RequeueAfter: time.Minute}, nil
+}
+
+func lroVerifyOperationID(backup *v1alpha1.Backup) string {
+	return fmt.Sprintf("VerifyBackup_%s", backup.GetUID())
+}
@@ -60,10 +60,12 @@ type cronAnythingControl interface {
 	Create(cron *v1alpha1.CronAnything) error
 	Get(name, namespace string) (*v1alpha1.CronAnything, error)
 	Update(cron *v1alpha1.CronAnything) error
+	Delete(cron *v1alpha1.CronAnything) error
 }
 
 type backupControl interface {
 	List(cronAnythingName string) ([]*v1alpha1.Backup, error)
+	Update(backup *v1alpha1.Backup) error
 	Delete(backup *v1alpha1.Backup) error
 }
 
@@ -82,7 +84,7 @@ type BackupScheduleReconciler struct {
 // +kubebuilder:rbac:groups=oracle.db.anthosapis.com,resources=backupschedules,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=oracle.db.anthosapis.com,resources=backupschedules/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=oracle.db.anthosapis.com,resources=cronanythings,verbs=get;list;watch;create;update;patch;delete
-// +kubebuilder:rbac:groups=oracle.db.anthosapis.com,resources=backups,verbs=list;delete
+// +kubebuilder:rbac:groups=oracle.db.anthosapis.com,resources=backups,verbs=list;update;delete
 
 // Reconcile is a generic reconcile function for BackupSchedule resources.
 func (r *BackupScheduleReconciler) Reconcile(_ context.Context, req ctrl.Request) (ctrl.Result, error) {
@@ -92,6 +94,11 @@ func (r *BackupScheduleReconciler) Reconcile(_ context.Context, req ctrl.Request
 		return ctrl.Result{}, client.IgnoreNotFound(err)
 	}
 
+	backupBytes, err := getBackupBytes(backupSchedule)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
 	cron, err := r.lookupCron(backupSchedule)
 	if err != nil && !errors.IsNotFound(err) {
 		return ctrl.Result{}, err
@@ -99,19 +106,28 @@ func (r *BackupScheduleReconciler) Reconcile(_ context.Context, req ctrl.Request
 
 	if errors.IsNotFound(err) {
 		log.Info("No cron found for backup schedule. Creating new one", "backupSchedule", backupSchedule.Namespace+"/"+backupSchedule.Name)
-		err := r.createCron(backupSchedule)
+		err := r.createCron(backupSchedule, r.getCronName(backupSchedule), backupSchedule.Spec.Schedule, backupBytes)
 		return reconcile.Result{}, err
 	}
 
-	err = r.updateCron(backupSchedule, cron)
+	err = r.updateCron(backupSchedule, cron, backupSchedule.Spec.Schedule, backupBytes)
 	if err != nil {
 		return reconcile.Result{}, err
 	}
 
+	cronNames := []string{cron.Name}
+	fullCron, err := r.reconcileFullCron(backupSchedule)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	if fullCron != nil {
+		cronNames = append(cronNames, fullCron.Name)
+	}
+
 	var backups []*v1alpha1.Backup
 
 	err = retry.RetryOnConflict(retry.DefaultBackoff, func() error {
-		backups, err = r.getSortedBackupsForCron(cron)
+		backups, err = r.getSortedBackupsForCrons(cronNames...)
 		if err != nil {
 			return err
 		}
@@ -126,6 +142,10 @@ func (r *BackupScheduleReconciler) Reconcile(_ context.Context, req ctrl.Request
 		return reconcile.Result{}, err
 	}
 
+	if err := r.verifyBackups(&backupSchedule.Spec, backups); err != nil {
+		return reconcile.Result{}, err
+	}
+
 	return ctrl.Result{}, r.pruneBackups(backupSchedule.Spec.BackupRetentionPolicy, backups)
 }
 
@@ -137,16 +157,41 @@ func (r *BackupScheduleReconciler) lookupCron(backupSchedule *v1alpha1.BackupSch
 	return cron, nil
 }
 
-func (r *BackupScheduleReconciler) createCron(backupSchedule *v1alpha1.BackupSchedule) error {
-	name := r.getCronName(backupSchedule)
-	triggerDeadlineSeconds := defaultTriggerDeadlineSeconds
-	if backupSchedule.Spec.StartingDeadlineSeconds != nil {
-		triggerDeadlineSeconds = *backupSchedule.Spec.StartingDeadlineSeconds
+// reconcileFullCron creates, updates or deletes the cron of the level 0
+// Backups of the BackupStrategy. It returns the cron, nil if there is no
+// BackupStrategy.
+func (r *BackupScheduleReconciler) reconcileFullCron(backupSchedule *v1alpha1.BackupSchedule) (*v1alpha1.CronAnything, error) {
+	name := r.getFullCronName(backupSchedule)
+	cron, err := r.cronAnythingCtrl.Get(name, backupSchedule.Namespace)
+	if err != nil && !errors.IsNotFound(err) {
+		return nil, err
 	}
+	exists := err == nil
 
-	backupBytes, err := getBackupBytes(backupSchedule)
+	strategy := backupStrategy(&backupSchedule.Spec)
+	if strategy == nil {
+		if exists {
+			r.Log.Info("deleting the cron of the level 0 backups", "cron", name)
+			return nil, client.IgnoreNotFound(r.cronAnythingCtrl.Delete(cron))
+		}
+		return nil, nil
+	}
+
+	backupBytes, err := getFullBackupBytes(backupSchedule)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	if !exists {
+		r.Log.Info("creating the cron of the level 0 backups", "cron", name)
+		return nil, r.createCron(backupSchedule, name, strategy.FullBackupSchedule, backupBytes)
+	}
+	return cron, r.updateCron(backupSchedule, cron, strategy.FullBackupSchedule, backupBytes)
+}
+
+func (r *BackupScheduleReconciler) createCron(backupSchedule *v1alpha1.BackupSchedule, name, schedule string, backupBytes []byte) error {
+	triggerDeadlineSeconds := defaultTriggerDeadlineSeconds
+	if backupSchedule.Spec.StartingDeadlineSeconds != nil {
+		triggerDeadlineSeconds = *backupSchedule.Spec.StartingDeadlineSeconds
 	}
 
 	cron := &v1alpha1.CronAnything{
@@ -155,7 +200,7 @@ func (r *BackupScheduleReconciler) createCron(backupSchedule *v1alpha1.BackupSch
 			Namespace: backupSchedule.Namespace,
 		},
 		Spec: v1alpha1.CronAnythingSpec{
-			Schedule:               backupSchedule.Spec.Schedule,
+			Schedule:               schedule,
 			TriggerDeadlineSeconds: &triggerDeadlineSeconds,
 			ConcurrencyPolicy:      v1alpha1.ForbidConcurrent,
 			FinishableStrategy: &v1alpha1.FinishableStrategy{
@@ -165,6 +210,7 @@ func (r *BackupScheduleReconciler) createCron(backupSchedule *v1alpha1.BackupSch
 					FinishedValues: []string{
 						k8s.BackupReady,
 						k8s.BackupFailed,
+						k8s.BackupCancelled,
 					},
 				},
 			},
@@ -176,7 +222,7 @@ func (r *BackupScheduleReconciler) createCron(backupSchedule *v1alpha1.BackupSch
 		},
 	}
 
-	err = controllerutil.SetControllerReference(backupSchedule, cron, r.scheme)
+	err := controllerutil.SetControllerReference(backupSchedule, cron, r.scheme)
 	if err != nil {
 		return err
 	}
@@ -188,12 +234,7 @@ func (r *BackupScheduleReconciler) createCron(backupSchedule *v1alpha1.BackupSch
 	return nil
 }
 
-func (r *BackupScheduleReconciler) updateCron(backupSchedule *v1alpha1.BackupSchedule, cron *v1alpha1.CronAnything) error {
-	backupBytes, err := getBackupBytes(backupSchedule)
-	if err != nil {
-		return err
-	}
-
+func (r *BackupScheduleReconciler) updateCron(backupSchedule *v1alpha1.BackupSchedule, cron *v1alpha1.CronAnything, schedule string, backupBytes []byte) error {
 	return retry.RetryOnConflict(retry.DefaultBackoff, func() error {
 		freshCron, err := r.cronAnythingCtrl.Get(cron.Name, cron.Namespace)
 		if err != nil {
@@ -205,7 +246,7 @@ func (r *BackupScheduleReconciler) updateCron(backupSchedule *v1alpha1.BackupSch
 			return err
 		}
 
-		scheduleEqual := backupSchedule.Spec.Schedule == freshCron.Spec.Schedule
+		scheduleEqual := schedule == freshCron.Spec.Schedule
 		startingDeadlineSecondsEqual := compareInt64Pointers(backupSchedule.Spec.StartingDeadlineSeconds, freshCron.Spec.TriggerDeadlineSeconds)
 
 		r.Log.Info("backup schedule diff", "templateUnchanged", templatesEqual, "scheduleUnchanged", scheduleEqual, "StartingDeadlineSecondsUnchanged", startingDeadlineSecondsEqual)
@@ -213,7 +254,7 @@ func (r *BackupScheduleReconciler) updateCron(backupSchedule *v1alpha1.BackupSch
 		if templatesEqual && scheduleEqual && startingDeadlineSecondsEqual {
 			return nil
 		}
-		freshCron.Spec.Schedule = backupSchedule.Spec.Schedule
+		freshCron.Spec.Schedule = schedule
 		freshCron.Spec.Template.Raw = backupBytes
 		freshCron.Spec.TriggerDeadlineSeconds = backupSchedule.Spec.StartingDeadlineSeconds
 		return r.cronAnythingCtrl.Update(freshCron)
@@ -227,6 +268,7 @@ func (r *BackupScheduleReconciler) updateHistory(backupSchedule *v1alpha1.Backup
 			BackupName:   backup.GetName(),
 			CreationTime: backup.GetCreationTimestamp(),
 			Phase:        backup.Status.Phase,
+			Verified:     verifiedStatus(backup),
 		})
 	}
 	backupTotal := int32(len(newBackupHistory))
@@ -238,25 +280,100 @@ func (r *BackupScheduleReconciler) updateHistory(backupSchedule *v1alpha1.Backup
 	return r.backupScheduleCtrl.UpdateStatus(backupSchedule)
 }
 
-func (r *BackupScheduleReconciler) pruneBackups(retention *v1alpha1.BackupRetentionPolicy, sortedBackups []*v1alpha1.Backup) error {
-	max := defaultRetention
+// verifiedStatus returns the status of the Verified condition of a backup.
+func verifiedStatus(backup *v1alpha1.Backup) metav1.ConditionStatus {
+	if cond := k8s.FindCondition(backup.Status.Conditions, k8s.Verified); cond != nil {
+		return cond.Status
+	}
+	return ""
+}
+
+// verifyBackups requests the verification of every Nth successful physical
+// backup, counting the successful backups since the last one for which a
+// verification was requested. Only the backups kept by the retention policy
+// are counted.
+func (r *BackupScheduleReconciler) verifyBackups(spec *v1alpha1.BackupScheduleSpec, sortedBackups []*v1alpha1.Backup) error {
+	every := spec.VerifyEvery
+	if every == 0 || spec.BackupSpec.Type != commonv1alpha1.BackupTypePhysical || spec.BackupSpec.Verify {
+		return nil
+	}
+	max := retentionCount(spec.BackupRetentionPolicy)
+	if max > 0 && every > max {
+		every = max
+	}
+
+	var succeeded []*v1alpha1.Backup
+	for _, backup := range sortedBackups {
+		if max > 0 && int32(len(succeeded)) == max {
+			break
+		}
+		if backup.Status.Phase == commonv1alpha1.BackupSucceeded {
+			succeeded = append(succeeded, backup)
+		}
+	}
+
+	var unverified int32
+	for i := len(succeeded) - 1; i >= 0; i-- {
+		backup := succeeded[i]
+		if backup.Spec.Verify {
+			unverified = 0
+			continue
+		}
+		if unverified++; unverified < every {
+			continue
+		}
+		r.Log.Info("requesting backup verification", "backup", backup.Name)
+		backup.Spec.Verify = true
+		if err := r.backupCtrl.Update(backup); err != nil {
+			return err
+		}
+		unverified = 0
+	}
+	return nil
+}
+
+// retentionCount returns the number of successful backups to keep around,
+// 0 means all of them.
+func retentionCount(retention *v1alpha1.BackupRetentionPolicy) int32 {
 	if retention != nil && retention.BackupRetention != nil {
-		max = *retention.BackupRetention
+		return *retention.BackupRetention
 	}
+	return defaultRetention
+}
+
+// pruneBackups deletes the backups beyond the retention count of successful
+// backups. The level 0 backup the oldest retained incremental backup depends
+// on is kept, along with the backups in between, so that the retained
+// incremental chains can be restored.
+func (r *BackupScheduleReconciler) pruneBackups(retention *v1alpha1.BackupRetentionPolicy, sortedBackups []*v1alpha1.Backup) error {
+	max := retentionCount(retention)
 	if max == 0 {
 		return nil
 	}
 
+	// hasOlderFull[i] tells whether a successful level 0 backup older than
+	// sortedBackups[i] exists, which an incremental backup depends on.
+	hasOlderFull := make([]bool, len(sortedBackups))
+	for i := len(sortedBackups) - 2; i >= 0; i-- {
+		older := sortedBackups[i+1]
+		hasOlderFull[i] = hasOlderFull[i+1] || (older.Status.Phase == commonv1alpha1.BackupSucceeded && older.Spec.Level == 0)
+	}
+
 	count := max
-	for _, backup := range sortedBackups {
-		if count <= 0 {
+	needsFull := false
+	for i, backup := range sortedBackups {
+		if count <= 0 && !needsFull {
 			r.Log.Info("deleting backup", "backup", backup)
 			if err := r.backupCtrl.Delete(backup); err != nil {
 				return err
 			}
+			continue
 		}
-		if backup.Status.Phase == commonv1alpha1.BackupSucceeded && count > 0 {
-			count -= 1
+		if backup.Status.Phase == commonv1alpha1.BackupSucceeded {
+			needsFull = backup.Spec.Level > 0 && hasOlderFull[i]
+			if count > 0 {
+				count -= 1
+			}
 		}
 	}
 	return nil
@@ -290,10 +407,22 @@ func (r *BackupScheduleReconciler) getCronName(backupSchedule *v1alpha1.BackupSc
 	return fmt.Sprintf("%s-cron", backupSchedule.Name)
 }
 
-func (r *BackupScheduleReconciler) getSortedBackupsForCron(cron *v1alpha1.CronAnything) ([]*v1alpha1.Backup, error) {
-	backupList, err := r.backupCtrl.List(cron.Name)
-	if err != nil {
-		return nil, err
+func (r *BackupScheduleReconciler) getFullCronName(backupSchedule *v1alpha1.BackupSchedule) string {
+	return fmt.Sprintf("%s-full-cron", backupSchedule.Name)
+}
+
+func (r *BackupScheduleReconciler) getSortedBackupsForCrons(cronNames ...string) ([]*v1alpha1.Backup, error) {
+	var backupList []*v1alpha1.Backup
+	for _, name := range cronNames {
+		backups, err := r.backupCtrl.List(name)
+		if err != nil {
+			return nil, err
+		}
+		if backupList == nil {
+			backupList = backups
+			continue
+		}
+		backupList = append(backupList, backups...)
 	}
 
 	sort.Slice(backupList, func(i, j int) bool {
@@ -304,8 +433,37 @@ func (r *BackupScheduleReconciler) getSortedBackupsForCron(cron *v1alpha1.CronAn
 	return backupList, nil
 }
 
+// backupStrategy returns the BackupStrategy of a BackupSchedule, which only
+// applies to Physical backups.
+func backupStrategy(spec *v1alpha1.BackupScheduleSpec) *v1alpha1.BackupStrategy {
+	if spec.BackupSpec.Type != commonv1alpha1.BackupTypePhysical {
+		return nil
+	}
+	return spec.BackupStrategy
+}
+
+// getBackupBytes returns the template of the Backups created on the
+// Schedule, level 1 Backups with a BackupStrategy.
 func getBackupBytes(backupSchedule *v1alpha1.BackupSchedule) ([]byte, error) {
-	specBytes, err := json.Marshal(backupSchedule.Spec.BackupSpec)
+	spec := backupSchedule.Spec.BackupSpec
+	if strategy := backupStrategy(&backupSchedule.Spec); strategy != nil {
+		spec.Level = 1
+		spec.Cumulative = strategy.IncrementalType == v1alpha1.CumulativeIncremental
+	}
+	return getBackupTemplateBytes(spec)
+}
+
+// getFullBackupBytes returns the template of the level 0 Backups created
+// on the FullBackupSchedule of a BackupStrategy.
+func getFullBackupBytes(backupSchedule *v1alpha1.BackupSchedule) ([]byte, error) {
+	spec := backupSchedule.Spec.BackupSpec
+	spec.Level = 0
+	spec.Cumulative = false
+	return getBackupTemplateBytes(spec)
+}
+
+func getBackupTemplateBytes(backupSpec v1alpha1.BackupSpec) ([]byte, error) {
+	specBytes, err := json.Marshal(backupSpec)
 	if err != nil {
 		return nil, err
 	}
@@ -16,6 +16,8 @@ package backupschedulecontroller
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"strings"
 	"testing"
 	"time"
@@ -39,6 +41,9 @@ const (
 	testBackupScheduleName = "test-backup-schedule"
 	testNamespace          = "db"
 	testSchedule           = "* * * * *" // At every minute
+	testFullSchedule       = "0 0 * * 0" // Weekly
+	testCronName           = testBackupScheduleName + "-cron"
+	testFullCronName       = testBackupScheduleName + "-full-cron"
 )
 
 func TestReconcileWithNoBackupSchedule(t *testing.T) {
@@ -95,6 +100,7 @@ spec:
       finishedValues:
       - BackupReady
       - BackupFailed
+      - BackupCancelled
     type: StringField
   resourceBaseName: test-backup-schedule-cron
   resourceTimestampFormat: 20060102-150405
@@ -142,6 +148,7 @@ spec:
       finishedValues:
       - BackupReady
       - BackupFailed
+      - BackupCancelled
     type: StringField
   resourceBaseName: test-backup-schedule-cron
   resourceTimestampFormat: 20060102-150405
@@ -318,6 +325,9 @@ template:
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			cronAnythingCtrl.get = func(name, namespace string) (*v1alpha1.CronAnything, error) {
+				if name == testFullCronName {
+					return nil, notFound(name)
+				}
 				return &v1alpha1.CronAnything{
 					Spec: *tc.oldCronSpec,
 				}, nil
@@ -378,6 +388,9 @@ func TestReconcileWithBackupPrune(t *testing.T) {
 	}
 
 	cronAnythingCtrl.get = func(name, namespace string) (*v1alpha1.CronAnything, error) {
+		if name == testFullCronName {
+			return nil, notFound(name)
+		}
 		return &v1alpha1.CronAnything{
 			Spec: v1alpha1.CronAnythingSpec{
 				Template: runtime.RawExtension{Raw: backupBytes},
@@ -434,6 +447,282 @@ func TestReconcileWithBackupPrune(t *testing.T) {
 	}
 }
 
+func TestReconcileWithBackupStrategy(t *testing.T) {
+	reconciler, backupScheduleCtrl, cronAnythingCtrl, backupCtrl := newTestBackupScheduleReconciler()
+	schedule := v1alpha1.BackupSchedule{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      testBackupScheduleName,
+			Namespace: testNamespace,
+		},
+		Spec: v1alpha1.BackupScheduleSpec{
+			BackupSpec: v1alpha1.BackupSpec{
+				BackupSpec: commonv1alpha1.BackupSpec{
+					Instance: "mydb1",
+					Type:     commonv1alpha1.BackupTypePhysical,
+				},
+				Subtype: "Instance",
+			},
+			Schedule: testSchedule,
+			BackupStrategy: &v1alpha1.BackupStrategy{
+				FullBackupSchedule: testFullSchedule,
+				IncrementalType:    v1alpha1.CumulativeIncremental,
+			},
+		},
+	}
+	backupScheduleCtrl.get = func(_, _ string) (*v1alpha1.BackupSchedule, error) {
+		return &schedule, nil
+	}
+	backupScheduleCtrl.updateStatus = func(backupSchedule *v1alpha1.BackupSchedule) error {
+		return nil
+	}
+	var listed []string
+	backupCtrl.list = func(cronAnythingName string) ([]*v1alpha1.Backup, error) {
+		listed = append(listed, cronAnythingName)
+		return nil, nil
+	}
+
+	templateSpec := func(t *testing.T, cron *v1alpha1.CronAnything) v1alpha1.BackupSpec {
+		var backup v1alpha1.Backup
+		if err := json.Unmarshal(cron.Spec.Template.Raw, &backup); err != nil {
+			t.Fatalf("failed to parse the template of %s: %v", cron.Name, err)
+		}
+		return backup.Spec
+	}
+
+	t.Run("create the level 0 cron", func(t *testing.T) {
+		var gotUpdated, gotCreated *v1alpha1.CronAnything
+		cronAnythingCtrl.get = func(name, namespace string) (*v1alpha1.CronAnything, error) {
+			if name == testFullCronName {
+				return nil, notFound(name)
+			}
+			return &v1alpha1.CronAnything{
+				ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+				Spec: v1alpha1.CronAnythingSpec{
+					Schedule: testSchedule,
+					Template: runtime.RawExtension{Raw: []byte(`{"spec":{}}`)},
+				},
+			}, nil
+		}
+		cronAnythingCtrl.update = func(cron *v1alpha1.CronAnything) error {
+			gotUpdated = cron
+			return nil
+		}
+		cronAnythingCtrl.create = func(cron *v1alpha1.CronAnything) error {
+			gotCreated = cron
+			return nil
+		}
+
+		if _, err := reconciler.Reconcile(context.Background(), reconcile.Request{
+			NamespacedName: types.NamespacedName{Name: testBackupScheduleName, Namespace: testNamespace},
+		}); err != nil {
+			t.Fatalf("reconciler.Reconcile want nil, got %v", err)
+		}
+		if gotUpdated == nil || gotCreated == nil {
+			t.Fatalf("reconciler.Reconcile got updated cron %v, created cron %v, want both", gotUpdated, gotCreated)
+		}
+		if spec := templateSpec(t, gotUpdated); spec.Level != 1 || !spec.Cumulative {
+			t.Errorf("reconciler.Reconcile got level %d cumulative %v backups on the schedule, want level 1 cumulative", spec.Level, spec.Cumulative)
+		}
+		if gotCreated.Name != testFullCronName || gotCreated.Spec.Schedule != testFullSchedule {
+			t.Errorf("reconciler.Reconcile got cron %s on %q, want %s on %q", gotCreated.Name, gotCreated.Spec.Schedule, testFullCronName, testFullSchedule)
+		}
+		if spec := templateSpec(t, gotCreated); spec.Level != 0 || spec.Cumulative {
+			t.Errorf("reconciler.Reconcile got level %d cumulative %v backups on the full schedule, want level 0", spec.Level, spec.Cumulative)
+		}
+	})
+
+	t.Run("list the backups of both crons", func(t *testing.T) {
+		listed = nil
+		cronAnythingCtrl.get = func(name, namespace string) (*v1alpha1.CronAnything, error) {
+			backupBytes, err := getBackupBytes(&schedule)
+			if name == testFullCronName {
+				backupBytes, err = getFullBackupBytes(&schedule)
+			}
+			return &v1alpha1.CronAnything{
+				ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+				Spec:       v1alpha1.CronAnythingSpec{Template: runtime.RawExtension{Raw: backupBytes}},
+			}, err
+		}
+		cronAnythingCtrl.update = func(cron *v1alpha1.CronAnything) error {
+			return nil
+		}
+
+		if _, err := reconciler.Reconcile(context.Background(), reconcile.Request{
+			NamespacedName: types.NamespacedName{Name: testBackupScheduleName, Namespace: testNamespace},
+		}); err != nil {
+			t.Fatalf("reconciler.Reconcile want nil, got %v", err)
+		}
+		if diff := cmp.Diff([]string{testCronName, testFullCronName}, listed); diff != "" {
+			t.Errorf("reconciler.Reconcile got unexpected crons listed: -want +got %v", diff)
+		}
+	})
+
+	t.Run("delete the level 0 cron", func(t *testing.T) {
+		schedule.Spec.BackupStrategy = nil
+		var gotDeleted string
+		cronAnythingCtrl.delete = func(cron *v1alpha1.CronAnything) error {
+			gotDeleted = cron.Name
+			return nil
+		}
+
+		if _, err := reconciler.Reconcile(context.Background(), reconcile.Request{
+			NamespacedName: types.NamespacedName{Name: testBackupScheduleName, Namespace: testNamespace},
+		}); err != nil {
+			t.Fatalf("reconciler.Reconcile want nil, got %v", err)
+		}
+		if gotDeleted != testFullCronName {
+			t.Errorf("reconciler.Reconcile got cron %q deleted, want %q", gotDeleted, testFullCronName)
+		}
+	})
+}
+
+func TestPruneBackupsWithIncrementals(t *testing.T) {
+	reconciler, _, _, backupCtrl := newTestBackupScheduleReconciler()
+
+	testCases := []struct {
+		name        string
+		retention   int32
+		levels      []int32
+		failed      []int
+		wantDeleted []int
+	}{
+		{
+			name:        "keep the level 0 of the retained incrementals",
+			retention:   2,
+			levels:      []int32{1, 1, 1, 0, 1, 0},
+			wantDeleted: []int{4, 5},
+		},
+		{
+			name:        "retained level 0",
+			retention:   3,
+			levels:      []int32{1, 1, 0, 1, 0},
+			wantDeleted: []int{3, 4},
+		},
+		{
+			name:        "skip a failed level 0",
+			retention:   1,
+			levels:      []int32{1, 0, 1, 0, 0},
+			failed:      []int{1},
+			wantDeleted: []int{4},
+		},
+		{
+			name:        "incrementals without a level 0",
+			retention:   1,
+			levels:      []int32{1, 1, 1},
+			wantDeleted: []int{1, 2},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// pruneBackups takes the backups sorted from the newest to the
+			// oldest.
+			backups := makeSortedBackups(t, len(tc.levels))
+			for i, b := range backups {
+				b.Name = fmt.Sprintf("backup-%d", i)
+				b.Spec.Level = tc.levels[i]
+			}
+			for _, i := range tc.failed {
+				backups[i].Status.Phase = commonv1alpha1.BackupFailed
+			}
+			var gotDeleted []string
+			backupCtrl.delete = func(backup *v1alpha1.Backup) error {
+				gotDeleted = append(gotDeleted, backup.Name)
+				return nil
+			}
+			retention := &v1alpha1.BackupRetentionPolicy{BackupRetention: pointer.Int32Ptr(tc.retention)}
+			if err := reconciler.pruneBackups(retention, backups); err != nil {
+				t.Fatalf("reconciler.pruneBackups want nil, got %v", err)
+			}
+			var wantDeleted []string
+			for _, i := range tc.wantDeleted {
+				wantDeleted = append(wantDeleted, fmt.Sprintf("backup-%d", i))
+			}
+			if diff := cmp.Diff(wantDeleted, gotDeleted); diff != "" {
+				t.Errorf("reconciler.pruneBackups got unexpected backups deleted: -want +got %v", diff)
+			}
+		})
+	}
+}
+
+func TestVerifyBackups(t *testing.T) {
+	reconciler, _, _, backupCtrl := newTestBackupScheduleReconciler()
+
+	testCases := []struct {
+		name         string
+		verifyEvery  int32
+		retention    int32
+		verified     []int
+		wantVerified []int
+	}{
+		{
+			name:         "every backup",
+			verifyEvery:  1,
+			retention:    7,
+			wantVerified: []int{0, 1, 2, 3, 4},
+		},
+		{
+			name:         "every 2nd backup",
+			verifyEvery:  2,
+			retention:    7,
+			wantVerified: []int{1, 3},
+		},
+		{
+			name:         "every 2nd backup since the last verified one",
+			verifyEvery:  2,
+			retention:    7,
+			verified:     []int{2},
+			wantVerified: []int{0, 3},
+		},
+		{
+			name:         "capped by the retention",
+			verifyEvery:  5,
+			retention:    2,
+			wantVerified: []int{0},
+		},
+		{
+			name:        "disabled",
+			verifyEvery: 0,
+			retention:   7,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			backups := makeSortedBackups(t, 5)
+			for i, b := range backups {
+				b.Name = fmt.Sprintf("backup-%d", i)
+			}
+			for _, i := range tc.verified {
+				backups[i].Spec.Verify = true
+			}
+			var gotVerified []string
+			backupCtrl.update = func(backup *v1alpha1.Backup) error {
+				if backup.Spec.Verify {
+					gotVerified = append(gotVerified, backup.Name)
+				}
+				return nil
+			}
+			spec := &v1alpha1.BackupScheduleSpec{
+				BackupSpec: v1alpha1.BackupSpec{
+					BackupSpec: commonv1alpha1.BackupSpec{Type: commonv1alpha1.BackupTypePhysical},
+				},
+				BackupRetentionPolicy: &v1alpha1.BackupRetentionPolicy{BackupRetention: pointer.Int32Ptr(tc.retention)},
+				VerifyEvery:           tc.verifyEvery,
+			}
+			if err := reconciler.verifyBackups(spec, backups); err != nil {
+				t.Fatalf("reconciler.verifyBackups want nil, got %v", err)
+			}
+			// Backups are verified from the oldest to the newest.
+			var wantVerified []string
+			for i := len(tc.wantVerified) - 1; i >= 0; i-- {
+				wantVerified = append(wantVerified, fmt.Sprintf("backup-%d", tc.wantVerified[i]))
+			}
+			if diff := cmp.Diff(wantVerified, gotVerified); diff != "" {
+				t.Errorf("reconciler.verifyBackups got unexpected backups verified: -want +got %v", diff)
+			}
+		})
+	}
+}
+
 func TestUpdateBackupHistory(t *testing.T) {
 	reconciler, backupScheduleCtrl, _, _ := newTestBackupScheduleReconciler()
 	testCases := []struct {
@@ -507,6 +796,10 @@ func newTestBackupScheduleReconciler() (reconciler *BackupScheduleReconciler,
 	}, backupScheduleCtrl, cronAnythingCtrl, backupCtrl
 }
 
+func notFound(name string) error {
+	return errors.NewNotFound(schema.GroupResource{Group: "oracle.db.anthosapis.com", Resource: "CronAnything"}, name)
+}
+
 func timeFromStr(t *testing.T, dateStr string) time.Time {
 	date, err := time.Parse("2006-01-02T15:04:05Z", dateStr)
 	if err != nil {
@@ -561,15 +854,22 @@ func (f *fakeCronAnythingControl) Get(name, namespace string) (*v1alpha1.CronAny
 func (f *fakeCronAnythingControl) Update(cron *v1alpha1.CronAnything) error {
 	return f.update(cron)
 }
+func (f *fakeCronAnythingControl) Delete(cron *v1alpha1.CronAnything) error {
+	return f.delete(cron)
+}
 
 type fakeBackupControl struct {
 	list   func(cronAnythingName string) ([]*v1alpha1.Backup, error)
+	update func(backup *v1alpha1.Backup) error
 	delete func(backup *v1alpha1.Backup) error
 }
 
 func (f *fakeBackupControl) List(cronAnythingName string) ([]*v1alpha1.Backup, error) {
 	return f.list(cronAnythingName)
 }
+func (f *fakeBackupControl) Update(backup *v1alpha1.Backup) error {
+	return f.update(backup)
+}
 func (f *fakeBackupControl) Delete(backup *v1alpha1.Backup) error {
 	return f.delete(backup)
 }
@@ -64,6 +64,10 @@ func (r *realCronAnythingControl) Update(cron *v1alpha1.CronAnything) error {
 	return r.client.Update(context.TODO(), cron)
 }
 
+func (r *realCronAnythingControl) Delete(cron *v1alpha1.CronAnything) error {
+	return r.client.Delete(context.TODO(), cron)
+}
+
 type realBackupControl struct {
 	client client.Client
 }
@@ -87,6 +91,10 @@ func (r *realBackupControl) List(cronAnythingName string) ([]*v1alpha1.Backup, e
 	return backups, nil
 }
 
+func (r *realBackupControl) Update(backup *v1alpha1.Backup) error {
+	return r.client.Update(context.TODO(), backup)
+}
+
 func (r *realBackupControl) Delete(backup *v1alpha1.Backup) error {
 	return r.client.Delete(context.TODO(), backup)
 }
@@ -20,7 +20,6 @@ import (
 	"time"
 
 	"github.com/go-logr/logr"
-	"google.golang.org/grpc"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -40,6 +39,10 @@ const (
 
 	RestoreInProgress = "Restore" + StatusInProgress
 	CreateInProgress  = "Create" + StatusInProgress
+
+	// FinalizerName is the finalizer of the resources whose database
+	// objects are cleaned up by a controller before they are deleted.
+	FinalizerName = "oracle.db.anthosapis.com/finalizer"
 )
 
 var (
@@ -143,13 +146,32 @@ func (g *GrpcConfigAgentClientFactory) New(ctx context.Context, r client.Reader,
 	if err := r.Get(ctx, types.NamespacedName{Name: fmt.Sprintf(AgentSvcName, instName), Namespace: namespace}, agentSvc); err != nil {
 		return nil, nil, err
 	}
-	conn, err := grpc.Dial(fmt.Sprintf("%s:%d", agentSvc.Spec.ClusterIP, consts.DefaultConfigAgentPort), grpc.WithInsecure())
+	conn, err := DialConfigAgent(ctx, r, namespace, instName, fmt.Sprintf("%s:%d", agentSvc.Spec.ClusterIP, consts.DefaultConfigAgentPort))
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to create a conn via gRPC.Dial: %w", err)
 	}
 	return capb.NewConfigAgentClient(conn), func() { _ = conn.Close() }, nil
 }
 
+// GetStorageCredentials returns the data of the Secret referenced by ref as
+// credentials of a backup, export or import storage location.
+// It returns nil if ref is not set, in which case the default credentials
+// of the database container are used.
+func GetStorageCredentials(ctx context.Context, r client.Reader, namespace string, ref *corev1.LocalObjectReference) (map[string]string, error) {
+	if ref == nil || ref.Name == "" {
+		return nil, nil
+	}
+	secret := &corev1.Secret{}
+	if err := r.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: namespace}, secret); err != nil {
+		return nil, fmt.Errorf("failed to get storage credentials secret %s/%s: %w", namespace, ref.Name, err)
+	}
+	creds := make(map[string]string, len(secret.Data))
+	for k, v := range secret.Data {
+		creds[k] = string(v)
+	}
+	return creds, nil
+}
+
 // Contains check whether given "elem" presents in "array"
 func Contains(array []string, elem string) bool {
 	for _, v := range array {
@@ -16,6 +16,14 @@ package controllers
 
 import (
 	"testing"
+	"time"
+
+	lropb "google.golang.org/genproto/googleapis/longrunning"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	dbdpb "github.com/GoogleCloudPlatform/elcarro-oracle-operator/oracle/pkg/agents/oracle"
 )
 
 func TestGetPVCNameAndMount(t *testing.T) {
@@ -59,3 +67,55 @@ func TestGetPVCNameAndMount(t *testing.T) {
 		})
 	}
 }
+
+func TestLROProgress(t *testing.T) {
+	eta := time.Date(2021, 6, 1, 10, 25, 0, 0, time.UTC)
+	metadata := func(m proto.Message) *anypb.Any {
+		a, err := anypb.New(m)
+		if err != nil {
+			t.Fatalf("anypb.New(%v) failed: %v", m, err)
+		}
+		return a
+	}
+	testCases := []struct {
+		name      string
+		operation *lropb.Operation
+		want      string
+	}{
+		{
+			name:      "no metadata",
+			operation: &lropb.Operation{},
+			want:      "",
+		},
+		{
+			name: "with ETA",
+			operation: &lropb.Operation{Metadata: metadata(&dbdpb.OperationProgress{
+				PercentComplete:         42,
+				EstimatedCompletionTime: timestamppb.New(eta),
+			})},
+			want: "42% complete, estimated completion at 2021-06-01T10:25:00Z",
+		},
+		{
+			name:      "with step",
+			operation: &lropb.Operation{Metadata: metadata(&dbdpb.OperationProgress{PercentComplete: 40, Message: "Copying database files"})},
+			want:      "Copying database files: 40% complete",
+		},
+		{
+			name:      "done",
+			operation: &lropb.Operation{Done: true, Metadata: metadata(&dbdpb.OperationProgress{PercentComplete: 99})},
+			want:      "",
+		},
+		{
+			name:      "other metadata",
+			operation: &lropb.Operation{Metadata: metadata(&timestamppb.Timestamp{})},
+			want:      "",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := LROProgress(tc.operation); got != tc.want {
+				t.Errorf("LROProgress() = %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
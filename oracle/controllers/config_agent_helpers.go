@@ -16,9 +16,14 @@ package controllers
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	lropb "google.golang.org/genproto/googleapis/longrunning"
+	"google.golang.org/grpc/codes"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	dbdpb "github.com/GoogleCloudPlatform/elcarro-oracle-operator/oracle/pkg/agents/oracle"
 )
 
 // GetLROOperation returns LRO operation for the specified namespace instance and operation id.
@@ -43,3 +48,63 @@ func DeleteLROOperation(caClientFactory ConfigAgentClientFactory, ctx context.Co
 	_, err = caClient.DeleteOperation(ctx, &lropb.DeleteOperationRequest{Name: id})
 	return err
 }
+
+// CancelLROOperation starts the cancellation of the LRO operation for the
+// specified namespace instance and operation id. The operation is done once
+// its work is stopped, see IsLROCancelled.
+func CancelLROOperation(caClientFactory ConfigAgentClientFactory, ctx context.Context, r client.Reader, namespace, id, instName string) error {
+	caClient, closeConn, err := caClientFactory.New(ctx, r, namespace, instName)
+	if err != nil {
+		return err
+	}
+	defer closeConn()
+
+	_, err = caClient.CancelOperation(ctx, &lropb.CancelOperationRequest{Name: id})
+	return err
+}
+
+// IsLROCancelled returns whether a done LRO operation was cancelled.
+func IsLROCancelled(operation *lropb.Operation) bool {
+	return operation.GetDone() && operation.GetError().GetCode() == int32(codes.Canceled)
+}
+
+// DeleteAllLROOperations deletes all LRO operations of the specified namespace instance.
+func DeleteAllLROOperations(caClientFactory ConfigAgentClientFactory, ctx context.Context, r client.Reader, namespace, instName string) error {
+	caClient, closeConn, err := caClientFactory.New(ctx, r, namespace, instName)
+	if err != nil {
+		return err
+	}
+	defer closeConn()
+
+	resp, err := caClient.ListOperations(ctx, &lropb.ListOperationsRequest{})
+	if err != nil {
+		return err
+	}
+	for _, op := range resp.GetOperations() {
+		if _, err := caClient.DeleteOperation(ctx, &lropb.DeleteOperationRequest{Name: op.GetName()}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// LROProgress returns the progress of a running LRO operation for a
+// condition message, e.g. "42% complete, estimated completion at
+// 2021-06-01T10:25:00Z". It's empty if the operation reports no progress.
+func LROProgress(operation *lropb.Operation) string {
+	if operation.GetDone() || operation.GetMetadata() == nil {
+		return ""
+	}
+	progress := &dbdpb.OperationProgress{}
+	if err := operation.GetMetadata().UnmarshalTo(progress); err != nil {
+		return ""
+	}
+	msg := fmt.Sprintf("%d%% complete", progress.GetPercentComplete())
+	if step := progress.GetMessage(); step != "" {
+		msg = fmt.Sprintf("%s: %s", step, msg)
+	}
+	if eta := progress.GetEstimatedCompletionTime(); eta != nil {
+		msg = fmt.Sprintf("%s, estimated completion at %s", msg, eta.AsTime().UTC().Format(time.RFC3339))
+	}
+	return msg
+}
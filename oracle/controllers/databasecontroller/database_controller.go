@@ -17,9 +17,11 @@ package databasecontroller
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
@@ -27,6 +29,7 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
@@ -37,6 +40,7 @@ import (
 	v1alpha1 "github.com/GoogleCloudPlatform/elcarro-oracle-operator/oracle/api/v1alpha1"
 	"github.com/GoogleCloudPlatform/elcarro-oracle-operator/oracle/controllers"
 	"github.com/GoogleCloudPlatform/elcarro-oracle-operator/oracle/pkg/agents/common/sql"
+	capb "github.com/GoogleCloudPlatform/elcarro-oracle-operator/oracle/pkg/agents/config_agent/protos"
 	"github.com/GoogleCloudPlatform/elcarro-oracle-operator/oracle/pkg/k8s"
 )
 
@@ -99,6 +103,7 @@ func (r *DatabaseReconciler) updateIsChangeApplied(ctx context.Context, db *v1al
 // +kubebuilder:rbac:groups=database.oracle.db.anthosapis.com,resources=databases/status,verbs=get;update;patch
 
 // +kubebuilder:rbac:groups=core,resources=services,verbs=list;watch;get;patch
+// +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch;create;update
 // +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
 // +kubebuilder:rbac:groups="",resources=pods,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups="",resources=pods/status,verbs=get;update;patch
@@ -118,6 +123,16 @@ func (r *DatabaseReconciler) Reconcile(_ context.Context, req ctrl.Request) (ctr
 		return ctrl.Result{}, client.IgnoreNotFound(err)
 	}
 
+	if !db.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, r.reconcileDelete(ctx, &db, log)
+	}
+	if !controllerutil.ContainsFinalizer(&db, controllers.FinalizerName) {
+		controllerutil.AddFinalizer(&db, controllers.FinalizerName)
+		if err := r.Update(ctx, &db); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
 	if err := validateSpec(&db); err != nil {
 		return ctrl.Result{}, r.handlePreflightCheckError(ctx, &db, err)
 	}
@@ -157,7 +172,7 @@ func (r *DatabaseReconciler) Reconcile(_ context.Context, req ctrl.Request) (ctr
 
 	// CDBName is specified in Instance specs
 	cdbName := inst.Spec.CDBName
-	istatus, err := CheckStatusInstanceFunc(ctx, db.Spec.Instance, cdbName, svc.Spec.ClusterIP, DBDomain, log)
+	istatus, err := CheckStatusInstanceFunc(ctx, r, db.Namespace, db.Spec.Instance, cdbName, svc.Spec.ClusterIP, DBDomain, log)
 	if err != nil {
 		log.Error(err, "preflight check failed", "check the database instance status", "failed")
 		return ctrl.Result{}, err
@@ -185,6 +200,24 @@ func (r *DatabaseReconciler) Reconcile(_ context.Context, req ctrl.Request) (ctr
 		return ctrl.Result{}, err
 	}
 
+	// Tablespaces are synced before the users, whose quotas may refer to them.
+	if err := SyncTablespaces(ctx, r, &db, log); err != nil {
+		return ctrl.Result{}, err
+	}
+	// Roles are synced before the users, who may be granted them.
+	if err := SyncRoles(ctx, r, &db, log); err != nil {
+		return ctrl.Result{}, err
+	}
+	// Profiles are synced before the users, who may be assigned them.
+	if err := SyncProfiles(ctx, r, &db, log); err != nil {
+		return ctrl.Result{}, err
+	}
+	// New passwords are written to the secrets before the users are synced.
+	nextRotation, err := RotatePasswords(ctx, r, &db, log)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
 	r.Recorder.Eventf(&db, corev1.EventTypeNormal, k8s.CreatedDatabase, fmt.Sprintf("Created new database %q", db.Spec.Name))
 	db.Status.Phase = commonv1alpha1.DatabaseReady
 	db.Status.Conditions = k8s.Upsert(db.Status.Conditions, k8s.Ready, v1.ConditionTrue, k8s.CreateComplete, "")
@@ -193,15 +226,23 @@ func (r *DatabaseReconciler) Reconcile(_ context.Context, req ctrl.Request) (ctr
 	}
 
 	if alreadyExists {
-		if err := SyncUsers(ctx, r, &db, svc.Spec.ClusterIP, cdbName, log); err != nil {
+		err := SyncUsers(ctx, r, &db, svc.Spec.ClusterIP, cdbName, log)
+		if finishErr := FinishPasswordRotations(ctx, r, &db, err, log); finishErr != nil {
+			return ctrl.Result{}, finishErr
+		}
+		if err != nil {
 			log.Error(err, "failed to sync database")
 			return ctrl.Result{}, err
 		}
-		return ctrl.Result{}, nil
+		return ctrl.Result{RequeueAfter: nextRotation}, nil
 	}
 
 	log.V(1).Info("[DEBUG] create users", "Database", db.Spec.Name, "Users/Privs", db.Spec.Users)
-	if err := NewUsers(ctx, r, &db, svc.Spec.ClusterIP, DBDomain, cdbName, log); err != nil {
+	err = NewUsers(ctx, r, &db, svc.Spec.ClusterIP, DBDomain, cdbName, log)
+	if finishErr := FinishPasswordRotations(ctx, r, &db, err, log); finishErr != nil {
+		return ctrl.Result{}, finishErr
+	}
+	if err != nil {
 		return ctrl.Result{}, err
 	}
 
@@ -223,7 +264,126 @@ func (r *DatabaseReconciler) Reconcile(_ context.Context, req ctrl.Request) (ctr
 
 	log.Info("reconciling database: DONE")
 
-	return ctrl.Result{}, nil
+	return ctrl.Result{RequeueAfter: nextRotation}, nil
+}
+
+// reconcileDelete cleans up the PDB of a deleted Database according to its
+// deletion policy and removes the finalizer once the PDB is gone.
+func (r *DatabaseReconciler) reconcileDelete(ctx context.Context, db *v1alpha1.Database, log logr.Logger) error {
+	if !controllerutil.ContainsFinalizer(db, controllers.FinalizerName) {
+		return nil
+	}
+
+	policy := db.Spec.DeletionPolicy
+	if policy == "" {
+		policy = v1alpha1.DatabaseDeletionPolicyRetain
+	}
+
+	var inst v1alpha1.Instance
+	err := r.Get(ctx, types.NamespacedName{Namespace: db.Namespace, Name: db.Spec.Instance}, &inst)
+	switch {
+	case apierrors.IsNotFound(err):
+		log.Info("instance not found, nothing to clean up", "instance", db.Spec.Instance)
+	case err != nil:
+		return err
+	case !inst.DeletionTimestamp.IsZero():
+		log.Info("instance is being deleted, nothing to clean up", "instance", db.Spec.Instance)
+	case !controllers.Contains(inst.Status.DatabaseNames, db.Spec.Name):
+		log.Info("database was not created, nothing to clean up", "dbName", db.Spec.Name)
+	case policy == v1alpha1.DatabaseDeletionPolicyRetain:
+		log.Info("retaining the PDB of the deleted database", "dbName", db.Spec.Name)
+	default:
+		if err := r.deletePDB(ctx, db, &inst, policy, log); err != nil {
+			return err
+		}
+	}
+
+	controllerutil.RemoveFinalizer(db, controllers.FinalizerName)
+	return r.Update(ctx, db)
+}
+
+// deletePDB drops or unplugs the PDB of the deleted Database and removes it
+// from the database names of the Instance.
+func (r *DatabaseReconciler) deletePDB(ctx context.Context, db *v1alpha1.Database, inst *v1alpha1.Instance, policy v1alpha1.DatabaseDeletionPolicy, log logr.Logger) error {
+	if db.Status.Phase != commonv1alpha1.DatabaseDeleting {
+		db.Status.Phase = commonv1alpha1.DatabaseDeleting
+		db.Status.Conditions = k8s.Upsert(db.Status.Conditions, k8s.Ready, v1.ConditionFalse, k8s.DeleteInProgress, fmt.Sprintf("Deleting PDB %q, deletion policy %s", db.Spec.Name, policy))
+		if err := r.Status().Update(ctx, db); err != nil {
+			return err
+		}
+	}
+
+	caClient, closeConn, err := r.ClientFactory.New(ctx, r, db.Namespace, db.Spec.Instance)
+	if err != nil {
+		return err
+	}
+	defer closeConn()
+
+	resp, err := caClient.DeleteDatabase(ctx, &capb.DeleteDatabaseRequest{
+		Name:    db.Spec.Name,
+		CdbName: inst.Spec.CDBName,
+		Unplug:  policy == v1alpha1.DatabaseDeletionPolicyUnplug,
+	})
+	if err != nil {
+		r.Recorder.Eventf(db, corev1.EventTypeWarning, k8s.DeleteFailed, "Failed to delete PDB %q: %v", db.Spec.Name, err)
+		db.Status.Conditions = k8s.Upsert(db.Status.Conditions, k8s.Ready, v1.ConditionFalse, k8s.DeleteFailed, err.Error())
+		if updateErr := r.Status().Update(ctx, db); updateErr != nil {
+			log.Error(updateErr, "failed to update database status")
+		}
+		return err
+	}
+	if resp.GetManifestPath() != "" {
+		r.Recorder.Eventf(db, corev1.EventTypeNormal, k8s.DeleteInProgress, "Unplugged PDB %q into %s", db.Spec.Name, resp.GetManifestPath())
+	} else {
+		r.Recorder.Eventf(db, corev1.EventTypeNormal, k8s.DeleteInProgress, "Dropped PDB %q", db.Spec.Name)
+	}
+
+	var names []string
+	for _, name := range inst.Status.DatabaseNames {
+		if name != db.Spec.Name {
+			names = append(names, name)
+		}
+	}
+	inst.Status.DatabaseNames = names
+	return r.Status().Update(ctx, inst)
+}
+
+// secretToDatabases returns the Databases with passwords in a secret, so
+// that a change of the secret changes the passwords.
+func (r *DatabaseReconciler) secretToDatabases(obj client.Object) []ctrl.Request {
+	var dbs v1alpha1.DatabaseList
+	if err := r.List(context.Background(), &dbs, client.InNamespace(obj.GetNamespace())); err != nil {
+		r.Log.Error(err, "failed to list databases for a secret", "secret", obj.GetName())
+		return nil
+	}
+	var requests []ctrl.Request
+	for _, db := range dbs.Items {
+		if usesSecret(&db, obj.GetName()) {
+			requests = append(requests, reconcile.Request{
+				NamespacedName: types.NamespacedName{
+					Name:      db.Name,
+					Namespace: db.Namespace,
+				}})
+		}
+	}
+	if len(requests) > 0 {
+		r.Log.Info("Secret event triggered reconcile ", "requests", requests)
+	}
+	return requests
+}
+
+// usesSecret returns true if the admin or a user of the Database has a
+// password in the secret of the given name.
+func usesSecret(db *v1alpha1.Database, name string) bool {
+	if ref := db.Spec.AdminPasswordSecretRef; ref != nil && ref.Name == name {
+		return true
+	}
+	for _, u := range db.Spec.Users {
+		if ref := userSecretRef(u); ref != nil && ref.Name == name {
+			return true
+		}
+	}
+	return false
 }
 
 func (r *DatabaseReconciler) instanceToDatabases(obj client.Object) []ctrl.Request {
@@ -279,6 +439,11 @@ func (r *DatabaseReconciler) SetupWithManager(mgr ctrl.Manager) error {
 			handler.EnqueueRequestsFromMapFunc(r.instanceToDatabases),
 			builder.WithPredicates(databaseInstanceReadyPredicate),
 		).
+		// A change of a password secret changes the password.
+		Watches(
+			&source.Kind{Type: &corev1.Secret{}},
+			handler.EnqueueRequestsFromMapFunc(r.secretToDatabases),
+		).
 		Complete(r)
 }
 
@@ -297,12 +462,21 @@ func (r *DatabaseReconciler) handlePreflightCheckError(ctx context.Context, db *
 func validateSpec(db *v1alpha1.Database) error {
 	// Currently only support validate db spec for user credentials.
 	// no sensitive information is logged underlying.
-	if (db.Spec.AdminPassword != "") && (db.Spec.AdminPasswordGsmSecretRef != nil) {
-		return fmt.Errorf("resources/validateSpec: invalid database admin password spec; you can only specify either admin_password or adminPasswordGsmSecretRef")
+	if countSet(db.Spec.AdminPassword != "", db.Spec.AdminPasswordGsmSecretRef != nil, db.Spec.AdminPasswordSecretRef != nil) > 1 {
+		return fmt.Errorf("resources/validateSpec: invalid database admin password spec; you can only specify one of admin_password, adminPasswordGsmSecretRef and adminPasswordSecretRef")
+	}
+	if err := validateSecretRef(db, db.Spec.AdminPasswordSecretRef); err != nil {
+		return fmt.Errorf("resources/validateSpec: invalid adminPasswordSecretRef: %w", err)
 	}
 	for _, u := range db.Spec.Users {
-		if (u.Password != "") && (u.GsmSecretRef != nil) {
-			return fmt.Errorf("resources/validateSpec: invalid database user password spec for user %q; you can only specify either password or GsmSecretRef", u.Name)
+		if countSet(u.Password != "", u.GsmSecretRef != nil, u.SecretRef != nil, u.PasswordRotation != nil) > 1 {
+			return fmt.Errorf("resources/validateSpec: invalid database user password spec for user %q; you can only specify one of password, GsmSecretRef, SecretRef and PasswordRotation", u.Name)
+		}
+		if err := validateSecretRef(db, userSecretRef(u)); err != nil {
+			return fmt.Errorf("resources/validateSpec: invalid secretRef for user %q: %w", u.Name, err)
+		}
+		if u.PasswordRotation != nil && u.PasswordRotation.Interval.Duration < minPasswordRotationInterval {
+			return fmt.Errorf("resources/validateSpec: password rotation interval %v of user %q is shorter than %v", u.PasswordRotation.Interval.Duration, u.Name, minPasswordRotationInterval)
 		}
 	}
 
@@ -328,7 +502,99 @@ func validateSpec(db *v1alpha1.Database) error {
 				return fmt.Errorf("resources/validateSpec: invalid privilege %q for user %q", privilege, u.Name)
 			}
 		}
+		if err := validateObjectPrivileges(u.ObjectPrivileges); err != nil {
+			return fmt.Errorf("resources/validateSpec: user %q: %w", u.Name, err)
+		}
+		if _, err := sql.ObjectName(u.Profile); err != nil {
+			return fmt.Errorf("resources/validateSpec: invalid profile %q for user %q: %w", u.Profile, u.Name, err)
+		}
+		for _, q := range u.Quotas {
+			if _, err := sql.ObjectName(q.Tablespace); err != nil {
+				return fmt.Errorf("resources/validateSpec: invalid quota tablespace %q for user %q: %w", q.Tablespace, u.Name, err)
+			}
+			if q.Size != nil && q.Size.Sign() < 0 {
+				return fmt.Errorf("resources/validateSpec: invalid quota size %s on tablespace %q for user %q", q.Size.String(), q.Tablespace, u.Name)
+			}
+		}
+	}
+	for _, role := range db.Spec.Roles {
+		if _, err := sql.ObjectName(role.Name); err != nil {
+			return fmt.Errorf("resources/validateSpec: invalid role %q: %w", role.Name, err)
+		}
+		for _, privilege := range role.Privileges {
+			if !sql.IsPrivilege(string(privilege)) {
+				return fmt.Errorf("resources/validateSpec: invalid privilege %q for role %q", privilege, role.Name)
+			}
+		}
+		if err := validateObjectPrivileges(role.ObjectPrivileges); err != nil {
+			return fmt.Errorf("resources/validateSpec: role %q: %w", role.Name, err)
+		}
+	}
+	for _, p := range db.Spec.Profiles {
+		if _, err := sql.ObjectName(p.Name); err != nil {
+			return fmt.Errorf("resources/validateSpec: invalid profile %q: %w", p.Name, err)
+		}
+		limits := profileLimits(p)
+		if len(limits) == 0 {
+			return fmt.Errorf("resources/validateSpec: profile %q has no limits", p.Name)
+		}
+		for resource, limit := range limits {
+			if !sql.IsProfileLimit(resource, limit) {
+				return fmt.Errorf("resources/validateSpec: invalid limit %q of %s for profile %q", limit, resource, p.Name)
+			}
+		}
+	}
+	for _, t := range db.Spec.Tablespaces {
+		if t.InitialSize != nil && t.InitialSize.Sign() <= 0 {
+			return fmt.Errorf("resources/validateSpec: invalid initial size %s of tablespace %q", t.InitialSize.String(), t.Name)
+		}
+		if t.MaxSize != nil && (t.MaxSize.Sign() <= 0 || (t.InitialSize != nil && t.MaxSize.Cmp(*t.InitialSize) < 0)) {
+			return fmt.Errorf("resources/validateSpec: max size %s of tablespace %q is smaller than its initial size", t.MaxSize.String(), t.Name)
+		}
 	}
 
 	return nil
 }
+
+// validateObjectPrivileges checks that object privileges can be granted
+// safely.
+func validateObjectPrivileges(privs []v1alpha1.ObjectPrivilegeSpec) error {
+	for _, p := range privs {
+		if !sql.IsPrivilege(p.Privilege) || strings.Contains(p.Privilege, ",") {
+			return fmt.Errorf("invalid object privilege %q", p.Privilege)
+		}
+		if _, err := sql.ObjectName(p.Schema); err != nil {
+			return fmt.Errorf("invalid schema %q of object privilege %q: %w", p.Schema, p.Privilege, err)
+		}
+		if _, err := sql.ObjectName(p.Object); err != nil {
+			return fmt.Errorf("invalid object %q of object privilege %q: %w", p.Object, p.Privilege, err)
+		}
+	}
+	return nil
+}
+
+// validateSecretRef checks that a password secret, if any, is in the
+// namespace of the Database.
+func validateSecretRef(db *v1alpha1.Database, ref *corev1.SecretReference) error {
+	if ref == nil {
+		return nil
+	}
+	if ref.Name == "" {
+		return fmt.Errorf("secret name is not set")
+	}
+	if ref.Namespace != "" && ref.Namespace != db.Namespace {
+		return fmt.Errorf("secret %s/%s is not in the namespace of the database %q", ref.Namespace, ref.Name, db.Namespace)
+	}
+	return nil
+}
+
+// countSet returns the number of true values.
+func countSet(values ...bool) int {
+	n := 0
+	for _, v := range values {
+		if v {
+			n++
+		}
+	}
+	return n
+}
@@ -16,6 +16,8 @@ package databasecontroller
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"testing"
 	"time"
 
@@ -24,14 +26,17 @@ import (
 	. "github.com/onsi/gomega"
 	appsv1 "k8s.io/api/apps/v1"
 	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	_ "k8s.io/client-go/plugin/pkg/client/auth/gcp"
+	"k8s.io/client-go/util/retry"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	commonv1alpha1 "github.com/GoogleCloudPlatform/elcarro-oracle-operator/common/api/v1alpha1"
 	v1alpha1 "github.com/GoogleCloudPlatform/elcarro-oracle-operator/oracle/api/v1alpha1"
+	"github.com/GoogleCloudPlatform/elcarro-oracle-operator/oracle/controllers"
 	"github.com/GoogleCloudPlatform/elcarro-oracle-operator/oracle/controllers/testhelpers"
 	"github.com/GoogleCloudPlatform/elcarro-oracle-operator/oracle/pkg/k8s"
 )
@@ -48,7 +53,7 @@ var (
 func TestDatabaseController(t *testing.T) {
 	// Mock function returns.
 	skipLBCheckForTest = true
-	CheckStatusInstanceFunc = func(ctx context.Context, instName, cdbName, clusterIP, DBDomain string, log logr.Logger) (string, error) {
+	CheckStatusInstanceFunc = func(ctx context.Context, r client.Reader, namespace, instName, cdbName, clusterIP, DBDomain string, log logr.Logger) (string, error) {
 		return "Ready", nil
 	}
 	fakeClientFactory = &testhelpers.FakeClientFactory{}
@@ -90,114 +95,139 @@ var _ = Describe("Database controller", func() {
 	createdSvc := &v1.Service{}
 	createdDatabase := &v1alpha1.Database{}
 
-	// Currently we only have one create database tests, after each
-	// serves as finally resource clean-up.
+	// After each serves as finally resource clean-up, the database
+	// may have been deleted by the test already.
 	AfterEach(func() {
 		testhelpers.K8sDeleteWithRetry(k8sClient, ctx, createdInstance)
 		testhelpers.K8sDeleteWithRetry(k8sClient, ctx, createdNs)
 		testhelpers.K8sDeleteWithRetry(k8sClient, ctx, createdPod)
 		testhelpers.K8sDeleteWithRetry(k8sClient, ctx, createdAgentSvc)
 		testhelpers.K8sDeleteWithRetry(k8sClient, ctx, createdSvc)
-		testhelpers.K8sDeleteWithRetry(k8sClient, ctx, createdDatabase)
+		Eventually(func() error {
+			return client.IgnoreNotFound(k8sClient.Delete(ctx, createdDatabase))
+		}, timeout, interval).Should(Succeed())
 	})
 
-	Context("Setup database with manager", func() {
-		It("Should create a database successfully", func() {
-			By("By creating a namespace")
+	// createDatabase creates a database along with the instance, pod and
+	// services it depends on in a new namespace.
+	createDatabase := func(namespace string) client.ObjectKey {
+		quota := resource.MustParse("10Mi")
+		maxSize := resource.MustParse("1Gi")
+		By("By creating a namespace")
 
-			ns := &v1.Namespace{
-				TypeMeta: metav1.TypeMeta{Kind: "namespace"},
-				ObjectMeta: metav1.ObjectMeta{
-					Name: Namespace,
-				},
-			}
-			testhelpers.K8sCreateAndGet(k8sClient, ctx, client.ObjectKey{Name: Namespace}, ns, createdNs)
-
-			By("By creating a new instance")
-			instance := &v1alpha1.Instance{
-				ObjectMeta: metav1.ObjectMeta{
-					Name:      instanceName,
-					Namespace: Namespace,
-					Labels:    map[string]string{"instance": instanceName},
-				},
-			}
-			testhelpers.K8sCreateAndGet(k8sClient, ctx, client.ObjectKey{Namespace: Namespace, Name: instanceName}, instance, createdInstance)
+		ns := &v1.Namespace{
+			TypeMeta: metav1.TypeMeta{Kind: "namespace"},
+			ObjectMeta: metav1.ObjectMeta{
+				Name: namespace,
+			},
+		}
+		testhelpers.K8sCreateAndGet(k8sClient, ctx, client.ObjectKey{Name: namespace}, ns, createdNs)
 
-			var sts appsv1.StatefulSetList
-			Expect(k8sClient.List(ctx, &sts, client.InNamespace(Namespace))).Should(Succeed())
-			Expect(len(sts.Items) == 1)
+		By("By creating a new instance")
+		instance := &v1alpha1.Instance{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      instanceName,
+				Namespace: namespace,
+				Labels:    map[string]string{"instance": instanceName},
+			},
+		}
+		testhelpers.K8sCreateAndGet(k8sClient, ctx, client.ObjectKey{Namespace: namespace, Name: instanceName}, instance, createdInstance)
 
-			By("By creating a pod")
-			pod := &v1.Pod{
-				ObjectMeta: metav1.ObjectMeta{
-					Name:      podName,
-					Namespace: Namespace,
-					Labels:    map[string]string{"instance": instanceName},
-				},
-				Spec: v1.PodSpec{
-					Containers: []v1.Container{
-						{
-							Name:    "oracledb",
-							Image:   "image",
-							Command: []string{"cmd"},
-							Resources: v1.ResourceRequirements{
-								Requests: v1.ResourceList{
-									v1.ResourceCPU: resource.MustParse("0m"),
-								},
+		var sts appsv1.StatefulSetList
+		Expect(k8sClient.List(ctx, &sts, client.InNamespace(namespace))).Should(Succeed())
+		Expect(len(sts.Items) == 1)
+
+		By("By creating a pod")
+		pod := &v1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      podName,
+				Namespace: namespace,
+				Labels:    map[string]string{"instance": instanceName},
+			},
+			Spec: v1.PodSpec{
+				Containers: []v1.Container{
+					{
+						Name:    "oracledb",
+						Image:   "image",
+						Command: []string{"cmd"},
+						Resources: v1.ResourceRequirements{
+							Requests: v1.ResourceList{
+								v1.ResourceCPU: resource.MustParse("0m"),
 							},
 						},
 					},
 				},
-			}
-			testhelpers.K8sCreateAndGet(k8sClient, ctx, client.ObjectKey{Name: podName, Namespace: Namespace}, pod, createdPod)
+			},
+		}
+		testhelpers.K8sCreateAndGet(k8sClient, ctx, client.ObjectKey{Name: podName, Namespace: namespace}, pod, createdPod)
+
+		By("By creating a service")
+		svc := &v1.Service{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      svcName,
+				Namespace: namespace,
+			},
+			Spec: v1.ServiceSpec{Ports: []v1.ServicePort{{Port: 8787}}},
+		}
+		objKey := client.ObjectKey{Name: svcName, Namespace: namespace}
+		testhelpers.K8sCreateAndGet(k8sClient, ctx, objKey, svc, createdSvc)
+
+		By("By creating an agent service")
+		AgentSvc := &v1.Service{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      svcAgentName,
+				Namespace: namespace,
+			},
+			Spec: v1.ServiceSpec{Ports: []v1.ServicePort{{Port: 8788}}},
+		}
+		testhelpers.K8sCreateAndGet(k8sClient, ctx, client.ObjectKey{Name: svcAgentName, Namespace: namespace}, AgentSvc, createdAgentSvc)
 
-			By("By creating a service")
-			svc := &v1.Service{
-				ObjectMeta: metav1.ObjectMeta{
-					Name:      svcName,
-					Namespace: Namespace,
+		By("By creating/reconciling a database")
+		// Note that reconcile will be called automatically
+		// by kubernetes runtime on database creation.
+		database := &v1alpha1.Database{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: namespace,
+				Name:      DatabaseName,
+				Labels:    map[string]string{"instance": instanceName},
+			},
+			Spec: v1alpha1.DatabaseSpec{
+				DatabaseSpec: commonv1alpha1.DatabaseSpec{
+					Name:     DatabaseName,
+					Instance: instanceName,
 				},
-				Spec: v1.ServiceSpec{Ports: []v1.ServicePort{{Port: 8787}}},
-			}
-			objKey := client.ObjectKey{Name: svcName, Namespace: Namespace}
-			testhelpers.K8sCreateAndGet(k8sClient, ctx, objKey, svc, createdSvc)
-
-			By("By creating an agent service")
-			AgentSvc := &v1.Service{
-				ObjectMeta: metav1.ObjectMeta{
-					Name:      svcAgentName,
-					Namespace: Namespace,
+				AdminPassword: adminPassword,
+				Users: []v1alpha1.UserSpec{
+					{UserSpec: commonv1alpha1.UserSpec{Name: userName, CredentialSpec: commonv1alpha1.CredentialSpec{Password: password}}, Privileges: []v1alpha1.PrivilegeSpec{privileges}, Quotas: []v1alpha1.TablespaceQuota{{Tablespace: "app", Size: &quota}}},
+					{UserSpec: commonv1alpha1.UserSpec{Name: "testUser2", CredentialSpec: commonv1alpha1.CredentialSpec{Password: password}}, Privileges: []v1alpha1.PrivilegeSpec{privileges}, ObjectPrivileges: []v1alpha1.ObjectPrivilegeSpec{{Privilege: "select", Schema: "hr", Object: "departments"}}},
+					{UserSpec: commonv1alpha1.UserSpec{Name: "testUser3", CredentialSpec: commonv1alpha1.CredentialSpec{Password: password}}, Privileges: []v1alpha1.PrivilegeSpec{privileges}, Profile: "app_users", AccountStatus: v1alpha1.AccountStatusLocked},
+					{UserSpec: commonv1alpha1.UserSpec{Name: "testUser4", CredentialSpec: commonv1alpha1.CredentialSpec{Password: password}}, Privileges: []v1alpha1.PrivilegeSpec{privileges}},
 				},
-				Spec: v1.ServiceSpec{Ports: []v1.ServicePort{{Port: 8788}}},
-			}
-			testhelpers.K8sCreateAndGet(k8sClient, ctx, client.ObjectKey{Name: svcAgentName, Namespace: Namespace}, AgentSvc, createdAgentSvc)
-
-			By("By creating/reconciling a database")
-			// Note that reconcile will be called automatically
-			// by kubernetes runtime on database creation.
-			database := &v1alpha1.Database{
-				ObjectMeta: metav1.ObjectMeta{
-					Namespace: Namespace,
-					Name:      DatabaseName,
-					Labels:    map[string]string{"instance": instanceName},
+				Tablespaces: []v1alpha1.TablespaceSpec{
+					{Name: "app", AutoExtend: true, MaxSize: &maxSize},
 				},
-				Spec: v1alpha1.DatabaseSpec{
-					DatabaseSpec: commonv1alpha1.DatabaseSpec{
-						Name:     DatabaseName,
-						Instance: instanceName,
-					},
-					AdminPassword: adminPassword,
-					Users: []v1alpha1.UserSpec{
-						{UserSpec: commonv1alpha1.UserSpec{Name: userName, CredentialSpec: commonv1alpha1.CredentialSpec{Password: password}}, Privileges: []v1alpha1.PrivilegeSpec{privileges}},
-						{UserSpec: commonv1alpha1.UserSpec{Name: "testUser2", CredentialSpec: commonv1alpha1.CredentialSpec{Password: password}}, Privileges: []v1alpha1.PrivilegeSpec{privileges}},
-						{UserSpec: commonv1alpha1.UserSpec{Name: "testUser3", CredentialSpec: commonv1alpha1.CredentialSpec{Password: password}}, Privileges: []v1alpha1.PrivilegeSpec{privileges}},
-						{UserSpec: commonv1alpha1.UserSpec{Name: "testUser4", CredentialSpec: commonv1alpha1.CredentialSpec{Password: password}}, Privileges: []v1alpha1.PrivilegeSpec{privileges}},
+				Roles: []v1alpha1.RoleSpec{
+					{
+						Name:             "app_reader",
+						Privileges:       []v1alpha1.PrivilegeSpec{"create session"},
+						ObjectPrivileges: []v1alpha1.ObjectPrivilegeSpec{{Privilege: "select", Schema: "hr", Object: "employees"}},
 					},
 				},
-			}
-			DbObjKey := client.ObjectKey{Namespace: Namespace, Name: DatabaseName}
-			testhelpers.K8sCreateAndGet(k8sClient, ctx, DbObjKey, database, createdDatabase)
+				Profiles: []v1alpha1.ProfileSpec{
+					{Name: "app_users", FailedLoginAttempts: "5", PasswordLifeTime: "90"},
+				},
+			},
+		}
+		DbObjKey := client.ObjectKey{Namespace: namespace, Name: DatabaseName}
+		testhelpers.K8sCreateAndGet(k8sClient, ctx, DbObjKey, database, createdDatabase)
 
+		return DbObjKey
+	}
+
+	Context("Setup database with manager", func() {
+		It("Should create a database successfully", func() {
+			DbObjKey := createDatabase(Namespace)
+			var sts appsv1.StatefulSetList
 			Expect(k8sClient.List(ctx, &sts, client.InNamespace(DatabaseName))).Should(Succeed())
 			Expect(len(sts.Items) == 1)
 
@@ -218,10 +248,287 @@ var _ = Describe("Database controller", func() {
 				return getUserNames(ctx, DbObjKey)
 			}, timeout, interval).Should(Equal([]string{userName, "testUser2", "testUser3", "..."}))
 
+			By("checking the managed users")
+			Eventually(func() ([]string, error) {
+				var database v1alpha1.Database
+				err := k8sClient.Get(ctx, DbObjKey, &database)
+				return database.Status.ManagedUsers, err
+			}, timeout, interval).Should(Equal([]string{"JOICE", "TESTUSER2", "TESTUSER3", "TESTUSER4"}))
+
 			By("checking user ready status")
 			Eventually(func() (metav1.ConditionStatus, error) {
 				return getConditionStatus(ctx, DbObjKey, k8s.UserReady)
 			}, timeout, interval).Should(Equal(metav1.ConditionTrue))
+
+			By("checking the tablespaces and quotas")
+			Expect(fakeClientFactory.Caclient.SyncTablespacesCalledCnt()).Should(BeNumerically(">=", 1))
+			tablespaces := fakeClientFactory.Caclient.SyncTablespacesRequest().GetTablespaces()
+			Expect(tablespaces).Should(HaveLen(1))
+			Expect(tablespaces[0].GetInitialSizeBytes()).Should(Equal(int64(100 * 1024 * 1024)))
+			Expect(tablespaces[0].GetMaxSizeBytes()).Should(Equal(int64(1024 * 1024 * 1024)))
+			Expect(fakeClientFactory.Caclient.CreateUsersRequest().GetGrantPrivsCmd()).Should(ContainElement(`alter user "JOICE" quota 10485760 on "APP"`))
+			Eventually(func() ([]v1alpha1.TablespaceStatus, error) {
+				var database v1alpha1.Database
+				err := k8sClient.Get(ctx, DbObjKey, &database)
+				return database.Status.Tablespaces, err
+			}, timeout, interval).Should(HaveLen(1))
+
+			By("checking the roles and object privileges")
+			Expect(fakeClientFactory.Caclient.SyncRolesCalledCnt()).Should(BeNumerically(">=", 1))
+			roles := fakeClientFactory.Caclient.SyncRolesRequest().GetRoles()
+			Expect(roles).Should(HaveLen(1))
+			Expect(roles[0].GetName()).Should(Equal("app_reader"))
+			Expect(roles[0].GetPrivileges()).Should(Equal([]string{"create session"}))
+			Expect(roles[0].GetObjectPrivileges()).Should(HaveLen(1))
+			Expect(fakeClientFactory.Caclient.CreateUsersRequest().GetGrantPrivsCmd()).Should(ContainElement(`grant SELECT on "HR"."DEPARTMENTS" to "TESTUSER2"`))
+
+			By("checking the profiles and account statuses")
+			Expect(fakeClientFactory.Caclient.SyncProfilesCalledCnt()).Should(BeNumerically(">=", 1))
+			profiles := fakeClientFactory.Caclient.SyncProfilesRequest().GetProfiles()
+			Expect(profiles).Should(HaveLen(1))
+			Expect(profiles[0].GetName()).Should(Equal("app_users"))
+			Expect(profiles[0].GetLimits()).Should(Equal(map[string]string{"FAILED_LOGIN_ATTEMPTS": "5", "PASSWORD_LIFE_TIME": "90"}))
+			Expect(fakeClientFactory.Caclient.CreateUsersRequest().GetGrantPrivsCmd()).Should(ContainElements(`alter user "TESTUSER3" profile "APP_USERS"`, `alter user "TESTUSER3" account lock`))
+		})
+	})
+
+	Context("Passwords in secrets", func() {
+		BeforeEach(func() {
+			fakeClientFactory.Reset()
+		})
+
+		It("Should create a user with the password of a secret and follow its changes", func() {
+			DbObjKey := createDatabase(testhelpers.RandName("ns3"))
+			Eventually(func() (commonv1alpha1.DatabasePhase, error) {
+				return getPhase(ctx, DbObjKey)
+			}, timeout, interval).Should(Equal(commonv1alpha1.DatabaseReady))
+
+			By("creating a password secret")
+			secret := &v1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Namespace: DbObjKey.Namespace, Name: "app-user"},
+				Data:       map[string][]byte{"password": []byte("s3cret")},
+			}
+			Expect(k8sClient.Create(ctx, secret)).Should(Succeed())
+
+			By("adding a user with a password in the secret")
+			testhelpers.K8sGetAndUpdateWithRetry(k8sClient, ctx, DbObjKey, &v1alpha1.Database{}, func(obj *client.Object) {
+				db := (*obj).(*v1alpha1.Database)
+				db.Spec.Users = append(db.Spec.Users, v1alpha1.UserSpec{
+					UserSpec: commonv1alpha1.UserSpec{
+						Name:           "appUser",
+						CredentialSpec: commonv1alpha1.CredentialSpec{SecretRef: &v1.SecretReference{Name: "app-user"}},
+					},
+					Privileges: []v1alpha1.PrivilegeSpec{privileges},
+				})
+			})
+
+			wantVersion := func() string {
+				Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(secret), secret)).Should(Succeed())
+				return fmt.Sprintf("namespaces/%s/secrets/app-user/versions/%s", DbObjKey.Namespace, secret.ResourceVersion)
+			}
+			getVersion := func() (string, error) {
+				var database v1alpha1.Database
+				err := k8sClient.Get(ctx, DbObjKey, &database)
+				return database.Status.UserResourceVersions["appUser"], err
+			}
+			Eventually(getVersion, timeout, interval).Should(Equal(wantVersion()))
+			Expect(fakeClientFactory.Caclient.CreateUsersRequest().GetCreateUsersCmd()).Should(ContainElement(`create user "APPUSER" identified by "s3cret"`))
+
+			By("changing the password in the secret")
+			secret.Data["password"] = []byte("n3wsecret")
+			Expect(k8sClient.Update(ctx, secret)).Should(Succeed())
+			Eventually(getVersion, timeout, interval).Should(Equal(wantVersion()))
+			Eventually(func() []string {
+				return fakeClientFactory.Caclient.CreateUsersRequest().GetCreateUsersCmd()
+			}, timeout, interval).Should(ContainElement(`create user "APPUSER" identified by "n3wsecret"`))
+		})
+	})
+
+	Context("Password rotation", func() {
+		BeforeEach(func() {
+			fakeClientFactory.Reset()
+		})
+
+		It("Should generate a password into the secret and rotate it", func() {
+			DbObjKey := createDatabase(testhelpers.RandName("ns4"))
+			Eventually(func() (commonv1alpha1.DatabasePhase, error) {
+				return getPhase(ctx, DbObjKey)
+			}, timeout, interval).Should(Equal(commonv1alpha1.DatabaseReady))
+
+			By("adding a user with a password rotation policy")
+			testhelpers.K8sGetAndUpdateWithRetry(k8sClient, ctx, DbObjKey, &v1alpha1.Database{}, func(obj *client.Object) {
+				db := (*obj).(*v1alpha1.Database)
+				db.Spec.Users = append(db.Spec.Users, v1alpha1.UserSpec{
+					UserSpec:   commonv1alpha1.UserSpec{Name: "rotatedUser"},
+					Privileges: []v1alpha1.PrivilegeSpec{privileges},
+					PasswordRotation: &v1alpha1.PasswordRotationSpec{
+						Interval:  metav1.Duration{Duration: 24 * time.Hour},
+						SecretRef: v1.SecretReference{Name: "rotated-user"},
+					},
+				})
+			})
+
+			secretKey := client.ObjectKey{Namespace: DbObjKey.Namespace, Name: "rotated-user"}
+			getPassword := func() (string, error) {
+				secret := &v1.Secret{}
+				err := k8sClient.Get(ctx, secretKey, secret)
+				return string(secret.Data["password"]), err
+			}
+			Eventually(getPassword, timeout, interval).ShouldNot(BeEmpty())
+			password, err := getPassword()
+			Expect(err).ShouldNot(HaveOccurred())
+			Eventually(func() []string {
+				return fakeClientFactory.Caclient.CreateUsersRequest().GetCreateUsersCmd()
+			}, timeout, interval).Should(ContainElement(fmt.Sprintf(`create user "ROTATEDUSER" identified by "%s"`, password)))
+			var database v1alpha1.Database
+			Eventually(func() (map[string]metav1.Time, error) {
+				err := k8sClient.Get(ctx, DbObjKey, &database)
+				return database.Status.PasswordRotationTimes, err
+			}, timeout, interval).Should(HaveKey("rotatedUser"))
+
+			Expect(password).Should(MatchRegexp(`[#_]`))
+
+			By("expiring the rotation interval")
+			expireRotation(ctx, DbObjKey, secretKey)
+			Eventually(getPassword, timeout, interval).ShouldNot(Equal(password))
+			password, err = getPassword()
+			Expect(err).ShouldNot(HaveOccurred())
+			Eventually(func() []string {
+				return fakeClientFactory.Caclient.CreateUsersRequest().GetCreateUsersCmd()
+			}, timeout, interval).Should(ContainElement(fmt.Sprintf(`create user "ROTATEDUSER" identified by "%s"`, password)))
+			Eventually(func() (map[string][]byte, error) {
+				secret := &v1.Secret{}
+				err := k8sClient.Get(ctx, secretKey, secret)
+				return secret.Data, err
+			}, timeout, interval).ShouldNot(HaveKey("previous-password"))
+		})
+
+		It("Should restore the previous password if the rotated one fails to apply", func() {
+			DbObjKey := createDatabase(testhelpers.RandName("ns4"))
+			Eventually(func() (commonv1alpha1.DatabasePhase, error) {
+				return getPhase(ctx, DbObjKey)
+			}, timeout, interval).Should(Equal(commonv1alpha1.DatabaseReady))
+
+			By("adding a user with a password rotation policy")
+			testhelpers.K8sGetAndUpdateWithRetry(k8sClient, ctx, DbObjKey, &v1alpha1.Database{}, func(obj *client.Object) {
+				db := (*obj).(*v1alpha1.Database)
+				db.Spec.Users = append(db.Spec.Users, v1alpha1.UserSpec{
+					UserSpec:   commonv1alpha1.UserSpec{Name: "rotatedUser"},
+					Privileges: []v1alpha1.PrivilegeSpec{privileges},
+					PasswordRotation: &v1alpha1.PasswordRotationSpec{
+						Interval:  metav1.Duration{Duration: 24 * time.Hour},
+						SecretRef: v1.SecretReference{Name: "rotated-user"},
+					},
+				})
+			})
+			secretKey := client.ObjectKey{Namespace: DbObjKey.Namespace, Name: "rotated-user"}
+			getSecret := func() (*v1.Secret, error) {
+				secret := &v1.Secret{}
+				err := k8sClient.Get(ctx, secretKey, secret)
+				return secret, err
+			}
+			var password string
+			Eventually(func() (string, error) {
+				secret, err := getSecret()
+				password = string(secret.Data["password"])
+				return password, err
+			}, timeout, interval).ShouldNot(BeEmpty())
+			Eventually(func() []string {
+				return fakeClientFactory.Caclient.CreateUsersRequest().GetCreateUsersCmd()
+			}, timeout, interval).Should(ContainElement(fmt.Sprintf(`create user "ROTATEDUSER" identified by "%s"`, password)))
+
+			By("failing to apply the rotated password")
+			fakeClientFactory.Caclient.SetCreateUsersError(errors.New("ORA-28003: password verification for the specified password failed"))
+			expireRotation(ctx, DbObjKey, secretKey)
+			Eventually(func() []string {
+				return fakeClientFactory.Caclient.CreateUsersRequest().GetCreateUsersCmd()
+			}, timeout, interval).ShouldNot(ContainElement(fmt.Sprintf(`create user "ROTATEDUSER" identified by "%s"`, password)))
+			Eventually(func() (bool, error) {
+				secret, err := getSecret()
+				_, pending := secret.Data["previous-password"]
+				return string(secret.Data["password"]) == password && !pending, err
+			}, timeout, interval).Should(BeTrue())
+
+			By("applying the rotated password once the database accepts it")
+			fakeClientFactory.Caclient.SetCreateUsersError(nil)
+			Eventually(func() (bool, error) {
+				secret, err := getSecret()
+				_, pending := secret.Data["previous-password"]
+				return string(secret.Data["password"]) != password && !pending, err
+			}, timeout, interval).Should(BeTrue())
+		})
+	})
+
+	Context("Delete database", func() {
+		BeforeEach(func() {
+			fakeClientFactory.Reset()
+		})
+
+		It("Should drop the PDB before removing the finalizer", func() {
+			DbObjKey := createDatabase(testhelpers.RandName("ns2"))
+			instKey := client.ObjectKey{Namespace: DbObjKey.Namespace, Name: instanceName}
+
+			By("checking the finalizer and the instance database names")
+			Eventually(func() ([]string, error) {
+				var database v1alpha1.Database
+				err := k8sClient.Get(ctx, DbObjKey, &database)
+				return database.Finalizers, err
+			}, timeout, interval).Should(ContainElement(controllers.FinalizerName))
+			Eventually(func() ([]string, error) {
+				var inst v1alpha1.Instance
+				err := k8sClient.Get(ctx, instKey, &inst)
+				return inst.Status.DatabaseNames, err
+			}, timeout, interval).Should(ContainElement(DatabaseName))
+
+			By("setting the Drop deletion policy")
+			Expect(retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+				if err := k8sClient.Get(ctx, DbObjKey, createdDatabase); err != nil {
+					return err
+				}
+				createdDatabase.Spec.DeletionPolicy = v1alpha1.DatabaseDeletionPolicyDrop
+				return k8sClient.Update(ctx, createdDatabase)
+			})).Should(Succeed())
+
+			By("deleting the database")
+			Expect(k8sClient.Delete(ctx, createdDatabase)).Should(Succeed())
+			Eventually(func() bool {
+				var database v1alpha1.Database
+				return apierrors.IsNotFound(k8sClient.Get(ctx, DbObjKey, &database))
+			}, timeout, interval).Should(BeTrue())
+
+			// The deletion may be retried on a stale cache.
+			Expect(fakeClientFactory.Caclient.DeleteDatabaseCalledCnt()).Should(BeNumerically(">=", 1))
+			req := fakeClientFactory.Caclient.DeleteDatabaseRequest()
+			Expect(req.GetName()).Should(Equal(DatabaseName))
+			Expect(req.GetUnplug()).Should(BeFalse())
+
+			var inst v1alpha1.Instance
+			Expect(k8sClient.Get(ctx, instKey, &inst)).Should(Succeed())
+			Expect(inst.Status.DatabaseNames).ShouldNot(ContainElement(DatabaseName))
+		})
+
+		It("Should retain the PDB by default", func() {
+			DbObjKey := createDatabase(testhelpers.RandName("ns3"))
+			instKey := client.ObjectKey{Namespace: DbObjKey.Namespace, Name: instanceName}
+
+			By("checking the instance database names")
+			Eventually(func() ([]string, error) {
+				var inst v1alpha1.Instance
+				err := k8sClient.Get(ctx, instKey, &inst)
+				return inst.Status.DatabaseNames, err
+			}, timeout, interval).Should(ContainElement(DatabaseName))
+
+			By("deleting the database")
+			Expect(k8sClient.Delete(ctx, createdDatabase)).Should(Succeed())
+			Eventually(func() bool {
+				var database v1alpha1.Database
+				return apierrors.IsNotFound(k8sClient.Get(ctx, DbObjKey, &database))
+			}, timeout, interval).Should(BeTrue())
+
+			Expect(fakeClientFactory.Caclient.DeleteDatabaseCalledCnt()).Should(Equal(0))
+			var inst v1alpha1.Instance
+			Expect(k8sClient.Get(ctx, instKey, &inst)).Should(Succeed())
+			Expect(inst.Status.DatabaseNames).Should(ContainElement(DatabaseName))
 		})
 	})
 })
@@ -252,3 +559,19 @@ func getUserNames(ctx context.Context, objKey client.ObjectKey) ([]string, error
 	}
 	return database.Status.UserNames, nil
 }
+
+// expireRotation moves the rotation time of a password secret beyond its
+// interval and changes the Database to reconcile it.
+func expireRotation(ctx context.Context, dbKey, secretKey client.ObjectKey) {
+	Expect(retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		secret := &v1.Secret{}
+		if err := k8sClient.Get(ctx, secretKey, secret); err != nil {
+			return err
+		}
+		secret.Annotations[v1alpha1.PasswordRotatedAtAnnotation] = time.Now().Add(-25 * time.Hour).UTC().Format(time.RFC3339)
+		return k8sClient.Update(ctx, secret)
+	})).Should(Succeed())
+	testhelpers.K8sGetAndUpdateWithRetry(k8sClient, ctx, dbKey, &v1alpha1.Database{}, func(obj *client.Object) {
+		(*obj).(*v1alpha1.Database).Spec.Users[0].Privileges = append((*obj).(*v1alpha1.Database).Spec.Users[0].Privileges, "resource")
+	})
+}
@@ -18,30 +18,45 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"sort"
 	"strings"
 	"time"
 
 	"github.com/go-logr/logr"
-	"google.golang.org/grpc"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/utils/integer"
 
 	commonv1alpha1 "github.com/GoogleCloudPlatform/elcarro-oracle-operator/common/api/v1alpha1"
 	v1alpha1 "github.com/GoogleCloudPlatform/elcarro-oracle-operator/oracle/api/v1alpha1"
+	"github.com/GoogleCloudPlatform/elcarro-oracle-operator/oracle/controllers"
 	"github.com/GoogleCloudPlatform/elcarro-oracle-operator/oracle/pkg/agents/common/sql"
 	capb "github.com/GoogleCloudPlatform/elcarro-oracle-operator/oracle/pkg/agents/config_agent/protos"
 	"github.com/GoogleCloudPlatform/elcarro-oracle-operator/oracle/pkg/agents/consts"
+	"github.com/GoogleCloudPlatform/elcarro-oracle-operator/oracle/pkg/agents/security"
 	k8s "github.com/GoogleCloudPlatform/elcarro-oracle-operator/oracle/pkg/k8s"
 )
 
 const (
-	gsmResourceVersionString = "projects/%s/secrets/%s/versions/%s"
-	pdbAdminUserName         = "GPDB_ADMIN"
+	gsmResourceVersionString    = "projects/%s/secrets/%s/versions/%s"
+	secretResourceVersionString = "namespaces/%s/secrets/%s/versions/%s"
+	pdbAdminUserName            = "GPDB_ADMIN"
+	// secretPasswordKey is the key of the password in a k8s secret.
+	secretPasswordKey = "password"
+	// secretPreviousPasswordKey is the key of the previous password in the
+	// secret of a rotated password, until the new one is applied.
+	secretPreviousPasswordKey = "previous-password"
+	// minPasswordRotationInterval is the shortest rotation interval of a
+	// user password.
+	minPasswordRotationInterval = time.Hour
 )
 
 var (
-	dialTimeout = 3 * time.Minute
+	dialTimeout                  = 3 * time.Minute
+	defaultTablespaceInitialSize = resource.MustParse("100Mi")
 )
 
 // NewDatabase attempts to create a new PDB if it doesn't exist yet.
@@ -91,6 +106,17 @@ func NewDatabase(ctx context.Context, r *DatabaseReconciler, db *v1alpha1.Databa
 		}
 		req.AdminPasswordGsmSecretRef = ref
 	}
+	if db.Spec.AdminPasswordSecretRef != nil {
+		ref, err := r.secretPassword(ctx, db, db.Spec.AdminPasswordSecretRef)
+		if err != nil {
+			return false, fmt.Errorf("resource/NewDatabase: %v", err)
+		}
+		userVerStr = ref.Version
+		if lastVer, ok := db.Status.UserResourceVersions[pdbAdminUserName]; ok {
+			ref.LastVersion = lastVer
+		}
+		req.AdminPasswordSecret = ref
+	}
 	cdOut, err := caClient.CreateDatabase(ctx, req)
 	if err != nil {
 		return false, fmt.Errorf("resource/NewDatabase: failed on CreateDatabase gRPC call: %v", err)
@@ -167,10 +193,30 @@ func NewUsers(ctx context.Context, r *DatabaseReconciler, db *v1alpha1.Database,
 				}})
 			userVerMap[u.Name] = fmt.Sprintf(gsmResourceVersionString, u.GsmSecretRef.ProjectId, u.GsmSecretRef.SecretId, u.GsmSecretRef.Version)
 		}
+		if secretRef := userSecretRef(u); secretRef != nil {
+			ref, err := r.secretPassword(ctx, db, secretRef)
+			if err != nil {
+				return fmt.Errorf("resources/NewUsers: user %q: %v", u.Name, err)
+			}
+			usersCmds = append(usersCmds, sql.QueryCreateUser(u.Name, ref.Password))
+			userVerMap[u.Name] = ref.Version
+		}
 
 		for _, p := range u.Privileges {
 			grantsCmds = append(grantsCmds, sql.QueryGrantPrivileges(string(p), u.Name))
 		}
+		for _, q := range userQuotas(u) {
+			grantsCmds = append(grantsCmds, sql.QueryAlterUserQuota(u.Name, q.Tablespace, q.Unlimited, q.MaxBytes))
+		}
+		for _, p := range u.ObjectPrivileges {
+			grantsCmds = append(grantsCmds, sql.QueryGrantObjectPrivilege(strings.ToUpper(p.Privilege), p.Schema, p.Object, u.Name))
+		}
+		if u.Profile != "" {
+			grantsCmds = append(grantsCmds, sql.QueryAlterUserProfile(u.Name, u.Profile))
+		}
+		if u.AccountStatus == v1alpha1.AccountStatusLocked {
+			grantsCmds = append(grantsCmds, sql.QueryAlterUserAccount(u.Name, true))
+		}
 	}
 
 	r.Recorder.Eventf(db, corev1.EventTypeNormal, k8s.CreatingUser, "Creating new users %v", usernames)
@@ -201,6 +247,7 @@ func NewUsers(ctx context.Context, r *DatabaseReconciler, db *v1alpha1.Database,
 	cdOut, err := caClient.CreateUsers(ctx, req)
 	if err != nil {
 		log.Error(err, "resources/NewUsers: failed on CreateUsers gRPC call")
+		return err
 	}
 	log.Info("resources/NewUsers: CreateUsers succeeded with this output", "output", cdOut)
 
@@ -213,6 +260,7 @@ func NewUsers(ctx context.Context, r *DatabaseReconciler, db *v1alpha1.Database,
 
 	db.Status.Conditions = k8s.Upsert(db.Status.Conditions, k8s.UserReady, v1.ConditionTrue, k8s.CreateComplete, "")
 	db.Status.UserNames = usernames
+	db.Status.ManagedUsers = managedUsers(db, nil)
 	db.Status.UserResourceVersions = userVerMap
 	r.updateIsChangeApplied(ctx, db)
 	if err := r.Status().Update(ctx, db); err != nil {
@@ -230,7 +278,7 @@ func SyncUsers(ctx context.Context, r *DatabaseReconciler, db *v1alpha1.Database
 	ctx, cancel := context.WithTimeout(ctx, dialTimeout)
 	defer cancel()
 
-	conn, err := grpc.Dial(fmt.Sprintf("%s:%d", clusterIP, consts.DefaultConfigAgentPort), grpc.WithInsecure())
+	conn, err := controllers.DialConfigAgent(ctx, r, db.Namespace, db.Spec.Instance, fmt.Sprintf("%s:%d", clusterIP, consts.DefaultConfigAgentPort))
 	if err != nil {
 		log.Error(err, "resources/syncUsers: failed to create a conn via gRPC.Dial")
 		return err
@@ -252,8 +300,12 @@ func SyncUsers(ctx context.Context, r *DatabaseReconciler, db *v1alpha1.Database
 			privs = append(privs, string(specPriv))
 		}
 		userSpec := &capb.User{
-			Name:       user.Name,
-			Privileges: privs,
+			Name:             user.Name,
+			Privileges:       privs,
+			Quotas:           userQuotas(user),
+			ObjectPrivileges: objectPrivileges(user.ObjectPrivileges),
+			Profile:          user.Profile,
+			AccountStatus:    strings.ToUpper(string(user.AccountStatus)),
 		}
 		// database_controller.validateSpec has validated the spec earlier;
 		// So no duplicated validation here.
@@ -277,17 +329,33 @@ func SyncUsers(ctx context.Context, r *DatabaseReconciler, db *v1alpha1.Database
 			}
 			userSpec.PasswordGsmSecretRef = ref
 		}
+		if secretRef := userSecretRef(user); secretRef != nil {
+			ref, err := r.secretPassword(ctx, db, secretRef)
+			if err != nil {
+				return fmt.Errorf("resources/syncUsers: user %q: %v", user.Name, err)
+			}
+			userVerMap[user.Name] = ref.Version
+			if lastVer, ok := db.Status.UserResourceVersions[user.Name]; ok {
+				ref.LastVersion = lastVer
+			}
+			userSpec.PasswordSecret = ref
+		}
 		userSpecs = append(userSpecs, userSpec)
 	}
+	deletionPolicy := userDeletionPolicy(db.Spec.UserDeletionPolicy)
 	resp, err := caClient.UsersChanged(ctx, &capb.UsersChangedRequest{
-		PdbName:   db.Spec.Name,
-		UserSpecs: userSpecs,
+		PdbName:        db.Spec.Name,
+		UserSpecs:      userSpecs,
+		DeletionPolicy: deletionPolicy,
+		ManagedUsers:   db.Status.ManagedUsers,
 	})
 	if err != nil {
 		log.Error(err, "resources/syncUsers: failed on UsersChanged gRPC call")
 		return err
 	}
 
+	accountStatuses := resp.GetAccountStatuses()
+	var dropped []string
 	if resp.GetChanged() {
 		db.Status.Phase = commonv1alpha1.DatabaseUpdating
 		db.Status.Conditions = k8s.Upsert(db.Status.Conditions, k8s.UserReady, v1.ConditionFalse, k8s.SyncInProgress, "")
@@ -295,13 +363,24 @@ func SyncUsers(ctx context.Context, r *DatabaseReconciler, db *v1alpha1.Database
 			return err
 		}
 		log.Info("resources/syncUsers: update database users requested", "CDB", cdbName, "PDB", db.Spec.Name)
-		if _, err := caClient.UpdateUsers(ctx, &capb.UpdateUsersRequest{
-			PdbName:   db.Spec.Name,
-			UserSpecs: userSpecs,
-		}); err != nil {
+		updateResp, err := caClient.UpdateUsers(ctx, &capb.UpdateUsersRequest{
+			PdbName:        db.Spec.Name,
+			UserSpecs:      userSpecs,
+			DeletionPolicy: deletionPolicy,
+			ManagedUsers:   db.Status.ManagedUsers,
+		})
+		if err != nil {
 			log.Error(err, "resources/syncUsers: failed on UpdateUser gRPC call")
 			return err
 		}
+		accountStatuses = updateResp.GetAccountStatuses()
+		dropped = updateResp.GetDroppedUsers()
+		if len(dropped) != 0 {
+			r.Recorder.Eventf(db, corev1.EventTypeNormal, k8s.DroppedUser, "Dropped users %v not defined in database spec", dropped)
+		}
+		if locked := updateResp.GetLockedUsers(); len(locked) != 0 {
+			r.Recorder.Eventf(db, corev1.EventTypeNormal, k8s.LockedUser, "Locked users %v not defined in database spec", locked)
+		}
 		log.Info("resources/syncUsers: update database users done", "CDB", cdbName, "PDB", db.Spec.Name)
 	}
 	log.Info("resources/syncUsers: sync database users done", "CDB", cdbName, "PDB", db.Spec.Name)
@@ -337,6 +416,8 @@ func SyncUsers(ctx context.Context, r *DatabaseReconciler, db *v1alpha1.Database
 
 	db.Status.Conditions = k8s.Upsert(db.Status.Conditions, userReady.Type, userReady.Status, userReady.Reason, userReady.Message)
 	db.Status.UserResourceVersions = userVerMap
+	db.Status.UserAccountStatuses = accountStatuses
+	db.Status.ManagedUsers = managedUsers(db, dropped)
 	db.Status.UserNames = usernames[0:integer.IntMin(3, len(usernames))]
 	if len(usernames) > 3 {
 		db.Status.UserNames = append(db.Status.UserNames, "...")
@@ -347,3 +428,375 @@ func SyncUsers(ctx context.Context, r *DatabaseReconciler, db *v1alpha1.Database
 	}
 	return nil
 }
+
+// managedUsers returns the users managed for a Database once the users of
+// its spec exist: the users managed before and the users of the spec, except
+// the dropped ones. The names are the upper case names of the Oracle users.
+func managedUsers(db *v1alpha1.Database, dropped []string) []string {
+	names := make(map[string]bool)
+	for _, name := range db.Status.ManagedUsers {
+		names[strings.ToUpper(name)] = true
+	}
+	for _, u := range db.Spec.Users {
+		names[strings.ToUpper(u.Name)] = true
+	}
+	for _, name := range dropped {
+		delete(names, strings.ToUpper(name))
+	}
+	var managed []string
+	for name := range names {
+		managed = append(managed, name)
+	}
+	sort.Strings(managed)
+	return managed
+}
+
+// SyncTablespaces creates and alters the tablespaces of the PDB as specified
+// and updates their usage in the status. The caller will update the status
+// by r.Status().Update.
+func SyncTablespaces(ctx context.Context, r *DatabaseReconciler, db *v1alpha1.Database, log logr.Logger) error {
+	if len(db.Spec.Tablespaces) == 0 {
+		db.Status.Tablespaces = nil
+		return nil
+	}
+	log.Info("resources/SyncTablespaces: sync tablespaces requested", "dbName", db.Spec.Name, "tablespaces", db.Spec.Tablespaces)
+
+	var specs []*capb.Tablespace
+	for _, t := range db.Spec.Tablespaces {
+		initialSize := defaultTablespaceInitialSize
+		if t.InitialSize != nil {
+			initialSize = *t.InitialSize
+		}
+		spec := &capb.Tablespace{
+			Name:             t.Name,
+			InitialSizeBytes: initialSize.Value(),
+			AutoExtend:       t.AutoExtend,
+			Bigfile:          t.Bigfile,
+		}
+		if t.MaxSize != nil {
+			spec.MaxSizeBytes = t.MaxSize.Value()
+		}
+		specs = append(specs, spec)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, dialTimeout)
+	defer cancel()
+
+	caClient, closeConn, err := r.ClientFactory.New(ctx, r, db.Namespace, db.Spec.Instance)
+	if err != nil {
+		log.Error(err, "resources/SyncTablespaces: failed to create config agent client")
+		return err
+	}
+	defer closeConn()
+
+	resp, err := caClient.SyncTablespaces(ctx, &capb.SyncTablespacesRequest{
+		PdbName:     db.Spec.Name,
+		Tablespaces: specs,
+	})
+	if err != nil {
+		r.Recorder.Eventf(db, corev1.EventTypeWarning, k8s.FailedToSyncTablespaces, "Failed to sync tablespaces for database %q: %v", db.Spec.Name, err)
+		return fmt.Errorf("resources/SyncTablespaces: failed on SyncTablespaces gRPC call: %v", err)
+	}
+
+	var statuses []v1alpha1.TablespaceStatus
+	for _, u := range resp.GetUsages() {
+		statuses = append(statuses, v1alpha1.TablespaceStatus{
+			Name:    u.GetName(),
+			Size:    *resource.NewQuantity(u.GetSizeBytes(), resource.BinarySI),
+			Used:    *resource.NewQuantity(u.GetUsedBytes(), resource.BinarySI),
+			MaxSize: *resource.NewQuantity(u.GetMaxSizeBytes(), resource.BinarySI),
+		})
+	}
+	db.Status.Tablespaces = statuses
+	log.Info("resources/SyncTablespaces: sync tablespaces done", "dbName", db.Spec.Name, "usages", resp.GetUsages())
+	return nil
+}
+
+// SyncRoles creates the custom roles of the PDB as specified and grants and
+// revokes their privileges. Roles removed from the spec are left as is.
+func SyncRoles(ctx context.Context, r *DatabaseReconciler, db *v1alpha1.Database, log logr.Logger) error {
+	if len(db.Spec.Roles) == 0 {
+		return nil
+	}
+	log.Info("resources/SyncRoles: sync roles requested", "dbName", db.Spec.Name, "roles", db.Spec.Roles)
+
+	var specs []*capb.Role
+	for _, role := range db.Spec.Roles {
+		spec := &capb.Role{
+			Name:             role.Name,
+			ObjectPrivileges: objectPrivileges(role.ObjectPrivileges),
+		}
+		for _, p := range role.Privileges {
+			spec.Privileges = append(spec.Privileges, string(p))
+		}
+		specs = append(specs, spec)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, dialTimeout)
+	defer cancel()
+
+	caClient, closeConn, err := r.ClientFactory.New(ctx, r, db.Namespace, db.Spec.Instance)
+	if err != nil {
+		log.Error(err, "resources/SyncRoles: failed to create config agent client")
+		return err
+	}
+	defer closeConn()
+
+	if _, err := caClient.SyncRoles(ctx, &capb.SyncRolesRequest{
+		PdbName: db.Spec.Name,
+		Roles:   specs,
+	}); err != nil {
+		r.Recorder.Eventf(db, corev1.EventTypeWarning, k8s.FailedToSyncRoles, "Failed to sync roles for database %q: %v", db.Spec.Name, err)
+		return fmt.Errorf("resources/SyncRoles: failed on SyncRoles gRPC call: %v", err)
+	}
+	log.Info("resources/SyncRoles: sync roles done", "dbName", db.Spec.Name)
+	return nil
+}
+
+// userDeletionPolicy returns the config agent deletion policy for the users
+// not in the spec.
+func userDeletionPolicy(policy v1alpha1.UserDeletionPolicy) capb.UsersChangedRequest_DeletionPolicy {
+	switch policy {
+	case v1alpha1.UserDeletionPolicyLock:
+		return capb.UsersChangedRequest_LOCK
+	case v1alpha1.UserDeletionPolicyDrop:
+		return capb.UsersChangedRequest_DROP
+	case v1alpha1.UserDeletionPolicyDropCascade:
+		return capb.UsersChangedRequest_DROP_CASCADE
+	}
+	return capb.UsersChangedRequest_RETAIN
+}
+
+// SyncProfiles creates the profiles of the PDB as specified and alters their
+// limits. Profiles removed from the spec and limits which are not specified
+// are left as is.
+func SyncProfiles(ctx context.Context, r *DatabaseReconciler, db *v1alpha1.Database, log logr.Logger) error {
+	if len(db.Spec.Profiles) == 0 {
+		return nil
+	}
+	log.Info("resources/SyncProfiles: sync profiles requested", "dbName", db.Spec.Name, "profiles", db.Spec.Profiles)
+
+	var specs []*capb.Profile
+	for _, p := range db.Spec.Profiles {
+		specs = append(specs, &capb.Profile{
+			Name:   p.Name,
+			Limits: profileLimits(p),
+		})
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, dialTimeout)
+	defer cancel()
+
+	caClient, closeConn, err := r.ClientFactory.New(ctx, r, db.Namespace, db.Spec.Instance)
+	if err != nil {
+		log.Error(err, "resources/SyncProfiles: failed to create config agent client")
+		return err
+	}
+	defer closeConn()
+
+	if _, err := caClient.SyncProfiles(ctx, &capb.SyncProfilesRequest{
+		PdbName:  db.Spec.Name,
+		Profiles: specs,
+	}); err != nil {
+		r.Recorder.Eventf(db, corev1.EventTypeWarning, k8s.FailedToSyncProfiles, "Failed to sync profiles for database %q: %v", db.Spec.Name, err)
+		return fmt.Errorf("resources/SyncProfiles: failed on SyncProfiles gRPC call: %v", err)
+	}
+	log.Info("resources/SyncProfiles: sync profiles done", "dbName", db.Spec.Name)
+	return nil
+}
+
+// profileLimits returns the specified limits of a profile by resource name.
+func profileLimits(p v1alpha1.ProfileSpec) map[string]string {
+	limits := make(map[string]string)
+	for resource, limit := range map[string]v1alpha1.ProfileLimit{
+		"FAILED_LOGIN_ATTEMPTS": p.FailedLoginAttempts,
+		"PASSWORD_LIFE_TIME":    p.PasswordLifeTime,
+		"PASSWORD_GRACE_TIME":   p.PasswordGraceTime,
+		"PASSWORD_LOCK_TIME":    p.PasswordLockTime,
+		"PASSWORD_REUSE_TIME":   p.PasswordReuseTime,
+		"PASSWORD_REUSE_MAX":    p.PasswordReuseMax,
+		"INACTIVE_ACCOUNT_TIME": p.InactiveAccountTime,
+	} {
+		if limit != "" {
+			limits[resource] = string(limit)
+		}
+	}
+	if p.PasswordVerifyFunction != "" {
+		limits["PASSWORD_VERIFY_FUNCTION"] = p.PasswordVerifyFunction
+	}
+	return limits
+}
+
+// secretPassword reads the password of a credential from a k8s secret in
+// the namespace of the Database. The version of the returned password is the
+// resource version of the secret, so that a change of the secret changes the
+// password. The password must not be logged.
+func (r *DatabaseReconciler) secretPassword(ctx context.Context, db *v1alpha1.Database, ref *corev1.SecretReference) (*capb.SecretPassword, error) {
+	secret := &corev1.Secret{}
+	if err := r.Get(ctx, types.NamespacedName{Namespace: db.Namespace, Name: ref.Name}, secret); err != nil {
+		return nil, fmt.Errorf("failed to get password secret %s/%s: %w", db.Namespace, ref.Name, err)
+	}
+	pwd, ok := secret.Data[secretPasswordKey]
+	if !ok || len(pwd) == 0 {
+		return nil, fmt.Errorf("password secret %s/%s has no %q key", db.Namespace, ref.Name, secretPasswordKey)
+	}
+	if _, err := sql.Identifier(string(pwd)); err != nil {
+		return nil, fmt.Errorf("password secret %s/%s contains an invalid password: %w", db.Namespace, ref.Name, err)
+	}
+	return &capb.SecretPassword{
+		Password: string(pwd),
+		Version:  fmt.Sprintf(secretResourceVersionString, db.Namespace, ref.Name, secret.ResourceVersion),
+	}, nil
+}
+
+// objectPrivileges converts the object privileges of a user or role spec.
+func objectPrivileges(privs []v1alpha1.ObjectPrivilegeSpec) []*capb.ObjectPrivilege {
+	var res []*capb.ObjectPrivilege
+	for _, p := range privs {
+		res = append(res, &capb.ObjectPrivilege{Privilege: p.Privilege, Schema: p.Schema, Object: p.Object})
+	}
+	return res
+}
+
+// userSecretRef returns the k8s secret with the password of a user, if any.
+func userSecretRef(u v1alpha1.UserSpec) *corev1.SecretReference {
+	if u.PasswordRotation != nil {
+		return &u.PasswordRotation.SecretRef
+	}
+	return u.SecretRef
+}
+
+// RotatePasswords generates a new password for the users with a rotation
+// policy whose interval has elapsed or whose secret doesn't exist, and writes
+// it to the secret of the user along with the previous password and the time
+// of the rotation. The time is kept in the secret, so that a failed update of
+// the status doesn't rotate the password again. The users are updated from
+// their secrets afterwards, see FinishPasswordRotations. It returns the time
+// until the next rotation is due, 0 if no user has a rotation policy. The
+// caller will update the status by r.Status().Update.
+func RotatePasswords(ctx context.Context, r *DatabaseReconciler, db *v1alpha1.Database, log logr.Logger) (time.Duration, error) {
+	now := time.Now()
+	var next time.Duration
+	rotationTimes := make(map[string]v1.Time)
+	for _, u := range db.Spec.Users {
+		policy := u.PasswordRotation
+		if policy == nil {
+			continue
+		}
+		secret := &corev1.Secret{}
+		err := r.Get(ctx, types.NamespacedName{Namespace: db.Namespace, Name: policy.SecretRef.Name}, secret)
+		if err != nil && !apierrors.IsNotFound(err) {
+			return 0, fmt.Errorf("resources/RotatePasswords: failed to get password secret %s/%s of user %q: %w", db.Namespace, policy.SecretRef.Name, u.Name, err)
+		}
+		exists := err == nil
+		last, rotated := passwordRotationTime(secret)
+		if due := last.Add(policy.Interval.Duration).Sub(now); rotated && exists && due > 0 {
+			rotationTimes[u.Name] = v1.NewTime(last)
+			if next == 0 || due < next {
+				next = due
+			}
+			continue
+		}
+
+		pwd, err := security.RandStrongOraclePassword()
+		if err != nil {
+			return 0, fmt.Errorf("resources/RotatePasswords: failed to generate a password for user %q: %w", u.Name, err)
+		}
+		if secret.Data == nil {
+			secret.Data = make(map[string][]byte)
+		}
+		// The password applied to the user is kept until the new one is.
+		if prev, ok := secret.Data[secretPasswordKey]; ok && exists {
+			if _, pending := secret.Data[secretPreviousPasswordKey]; !pending {
+				secret.Data[secretPreviousPasswordKey] = prev
+			}
+		}
+		secret.Data[secretPasswordKey] = []byte(pwd)
+		if !exists {
+			secret.ObjectMeta = v1.ObjectMeta{Namespace: db.Namespace, Name: policy.SecretRef.Name}
+		}
+		if secret.Annotations == nil {
+			secret.Annotations = make(map[string]string)
+		}
+		secret.Annotations[v1alpha1.PasswordRotatedAtAnnotation] = now.UTC().Format(time.RFC3339)
+		// The update is rejected if the secret has changed since it was
+		// read, so that a concurrent change is not overwritten.
+		if exists {
+			err = r.Update(ctx, secret)
+		} else {
+			err = r.Create(ctx, secret)
+		}
+		if err != nil {
+			return 0, fmt.Errorf("resources/RotatePasswords: failed to write password secret %s/%s of user %q: %w", db.Namespace, policy.SecretRef.Name, u.Name, err)
+		}
+		log.Info("resources/RotatePasswords: rotated password", "user", u.Name, "secret", policy.SecretRef.Name)
+		r.Recorder.Eventf(db, corev1.EventTypeNormal, k8s.RotatedPassword, "Rotated the password of user %q", u.Name)
+		rotationTimes[u.Name] = v1.NewTime(now)
+		if next == 0 || policy.Interval.Duration < next {
+			next = policy.Interval.Duration
+		}
+	}
+	db.Status.PasswordRotationTimes = nil
+	if len(rotationTimes) != 0 {
+		db.Status.PasswordRotationTimes = rotationTimes
+	}
+	return next, nil
+}
+
+// passwordRotationTime returns the time the password in the secret of a user
+// was last rotated, false if it wasn't rotated yet.
+func passwordRotationTime(secret *corev1.Secret) (time.Time, bool) {
+	t, err := time.Parse(time.RFC3339, secret.Annotations[v1alpha1.PasswordRotatedAtAnnotation])
+	return t, err == nil
+}
+
+// FinishPasswordRotations completes the rotations of RotatePasswords once the
+// users were synced from their secrets: the previous passwords are dropped
+// from the secrets. If the users failed to sync, the previous passwords,
+// which are still those of the users, are restored instead and the passwords
+// are rotated again by the next reconciliation.
+func FinishPasswordRotations(ctx context.Context, r *DatabaseReconciler, db *v1alpha1.Database, syncErr error, log logr.Logger) error {
+	for _, u := range db.Spec.Users {
+		policy := u.PasswordRotation
+		if policy == nil {
+			continue
+		}
+		secret := &corev1.Secret{}
+		if err := r.Get(ctx, types.NamespacedName{Namespace: db.Namespace, Name: policy.SecretRef.Name}, secret); err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return fmt.Errorf("resources/FinishPasswordRotations: failed to get password secret %s/%s of user %q: %w", db.Namespace, policy.SecretRef.Name, u.Name, err)
+		}
+		prev, ok := secret.Data[secretPreviousPasswordKey]
+		if !ok {
+			continue
+		}
+		delete(secret.Data, secretPreviousPasswordKey)
+		if syncErr != nil {
+			secret.Data[secretPasswordKey] = prev
+			delete(secret.Annotations, v1alpha1.PasswordRotatedAtAnnotation)
+		}
+		if err := r.Update(ctx, secret); err != nil {
+			return fmt.Errorf("resources/FinishPasswordRotations: failed to write password secret %s/%s of user %q: %w", db.Namespace, policy.SecretRef.Name, u.Name, err)
+		}
+		if syncErr != nil {
+			log.Info("resources/FinishPasswordRotations: restored the previous password", "user", u.Name, "secret", policy.SecretRef.Name)
+			r.Recorder.Eventf(db, corev1.EventTypeWarning, k8s.FailedToSyncUser, "Failed to apply the rotated password of user %q, restored the previous one: %v", u.Name, syncErr)
+		}
+	}
+	return nil
+}
+
+// userQuotas returns the tablespace quotas of a user spec.
+func userQuotas(u v1alpha1.UserSpec) []*capb.TablespaceQuota {
+	var quotas []*capb.TablespaceQuota
+	for _, q := range u.Quotas {
+		quota := &capb.TablespaceQuota{Tablespace: q.Tablespace, Unlimited: q.Size == nil}
+		if q.Size != nil {
+			quota.MaxBytes = q.Size.Value()
+		}
+		quotas = append(quotas, quota)
+	}
+	return quotas
+}
@@ -28,6 +28,7 @@ import (
 	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 
 	v1alpha1 "github.com/GoogleCloudPlatform/elcarro-oracle-operator/oracle/api/v1alpha1"
 	"github.com/GoogleCloudPlatform/elcarro-oracle-operator/oracle/controllers"
@@ -93,6 +94,7 @@ var (
 // +kubebuilder:rbac:groups=oracle.db.anthosapis.com,resources=databases,verbs=get;list;watch
 // +kubebuilder:rbac:groups=oracle.db.anthosapis.com,resources=databases/status,verbs=get
 // +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
+// +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch
 
 // Reconcile is a generic reconcile function for Export resources.
 func (r *ExportReconciler) Reconcile(_ context.Context, req ctrl.Request) (result ctrl.Result, recErr error) {
@@ -106,6 +108,18 @@ func (r *ExportReconciler) Reconcile(_ context.Context, req ctrl.Request) (resul
 		return ctrl.Result{}, client.IgnoreNotFound(err)
 	}
 
+	if !exp.DeletionTimestamp.IsZero() {
+		return r.reconcileDelete(ctx, log, exp)
+	}
+
+	// The finalizer is added to a new Export and kept until the export
+	// finishes, so that an export in progress is cancelled when the Export
+	// is deleted.
+	if k8s.FindCondition(exp.Status.Conditions, k8s.Ready) == nil && !controllerutil.ContainsFinalizer(exp, controllers.FinalizerName) {
+		controllerutil.AddFinalizer(exp, controllers.FinalizerName)
+		return ctrl.Result{}, r.Update(ctx, exp)
+	}
+
 	expStatusWrapper := &readyConditionWrapper{exp: exp, defaultState: k8s.ExportPending}
 	defer func() {
 		if !expStatusWrapper.changed {
@@ -125,6 +139,12 @@ func (r *ExportReconciler) Reconcile(_ context.Context, req ctrl.Request) (resul
 	case k8s.ExportInProgress:
 		return r.handleRunningExport(ctx, log, expStatusWrapper, req)
 	default:
+		// The finalizer of a finished export is removed once its final
+		// status is stored.
+		if controllerutil.ContainsFinalizer(exp, controllers.FinalizerName) {
+			controllerutil.RemoveFinalizer(exp, controllers.FinalizerName)
+			return ctrl.Result{}, r.Update(ctx, exp)
+		}
 		log.Info(fmt.Sprintf("export is in the state %q, no action needed", expStatusWrapper.getState()))
 		return ctrl.Result{}, nil
 	}
@@ -170,6 +190,12 @@ func (r *ExportReconciler) handleNotStartedExport(ctx context.Context, log logr.
 		k8s.FindCondition(db.Status.Conditions, k8s.Ready),
 		metav1.ConditionTrue)
 
+	if exp.Spec.Cancel {
+		r.Recorder.Event(exp, corev1.EventTypeNormal, k8s.ExportCancelled, "Export was cancelled before it started")
+		expWrapper.setState(k8s.ExportCancelled, "Export was cancelled before it started")
+		return ctrl.Result{}, nil
+	}
+
 	// if can start, begin export
 	if dbReady {
 		caClient, closeConn, err := r.ClientFactory.New(ctx, r, req.Namespace, exp.Spec.Instance)
@@ -179,15 +205,22 @@ func (r *ExportReconciler) handleNotStartedExport(ctx context.Context, log logr.
 		}
 		defer closeConn()
 
+		creds, err := controllers.GetStorageCredentials(ctx, r, req.Namespace, exp.Spec.StorageCredentialsSecretRef)
+		if err != nil {
+			expWrapper.setState(k8s.ExportPending, fmt.Sprintf("failed to start export: %v", err))
+			return ctrl.Result{}, err
+		}
+
 		resp, err := caClient.DataPumpExport(ctx, &capb.DataPumpExportRequest{
-			PdbName:       db.Spec.Name,
-			DbDomain:      inst.Spec.DBDomain,
-			ObjectType:    exp.Spec.ExportObjectType,
-			Objects:       strings.Join(exp.Spec.ExportObjects, ","),
-			GcsPath:       exp.Spec.GcsPath,
-			GcsLogPath:    exp.Spec.GcsLogPath,
-			LroInput:      &capb.LROInput{OperationId: lroOperationID(exp)},
-			FlashbackTime: getFlashbackTime(exp.Spec.FlashbackTime),
+			PdbName:            db.Spec.Name,
+			DbDomain:           inst.Spec.DBDomain,
+			ObjectType:         exp.Spec.ExportObjectType,
+			Objects:            strings.Join(exp.Spec.ExportObjects, ","),
+			GcsPath:            exp.Spec.GcsPath,
+			GcsLogPath:         exp.Spec.GcsLogPath,
+			LroInput:           &capb.LROInput{OperationId: lroOperationID(exp)},
+			FlashbackTime:      getFlashbackTime(exp.Spec.FlashbackTime),
+			StorageCredentials: creds,
 		})
 
 		if err != nil {
@@ -223,7 +256,17 @@ func (r *ExportReconciler) handleRunningExport(ctx context.Context, log logr.Log
 	}
 	log.Info("GetLROOperation", "response", operation)
 
+	if !operation.Done && exp.Spec.Cancel {
+		log.Info("cancelling the LRO", "operationID", operationID)
+		if err := controllers.CancelLROOperation(r.ClientFactory, ctx, r, req.Namespace, operationID, exp.Spec.Instance); err != nil {
+			return ctrl.Result{}, err
+		}
+		return ctrl.Result{RequeueAfter: 10 * time.Second}, nil
+	}
 	if !operation.Done {
+		if progress := controllers.LROProgress(operation); progress != "" {
+			expWrapper.setState(k8s.ExportInProgress, progress)
+		}
 		return requeueLater, nil
 	}
 
@@ -233,6 +276,12 @@ func (r *ExportReconciler) handleRunningExport(ctx context.Context, log logr.Log
 		_ = controllers.DeleteLROOperation(r.ClientFactory, ctx, r, req.Namespace, operationID, exp.Spec.Instance)
 	}()
 
+	if controllers.IsLROCancelled(operation) {
+		expWrapper.setState(k8s.ExportCancelled, fmt.Sprintf("Export was cancelled on %s", time.Now().Format(time.RFC3339)))
+		r.Recorder.Event(exp, corev1.EventTypeNormal, k8s.ExportCancelled, "Export was cancelled")
+		return ctrl.Result{}, nil
+	}
+
 	if operation.GetError() != nil {
 		expWrapper.setState(
 			k8s.ExportFailed,
@@ -257,6 +306,41 @@ func (r *ExportReconciler) handleRunningExport(ctx context.Context, log logr.Log
 	return ctrl.Result{}, nil
 }
 
+// reconcileDelete cancels the export in progress of a deleted Export and
+// removes the finalizer once the operation is stopped.
+func (r *ExportReconciler) reconcileDelete(ctx context.Context, log logr.Logger, exp *v1alpha1.Export) (ctrl.Result, error) {
+	if !controllerutil.ContainsFinalizer(exp, controllers.FinalizerName) {
+		return ctrl.Result{}, nil
+	}
+
+	inst := &v1alpha1.Instance{}
+	err := r.Get(ctx, types.NamespacedName{Namespace: exp.Namespace, Name: exp.Spec.Instance}, inst)
+	if client.IgnoreNotFound(err) != nil {
+		return ctrl.Result{}, err
+	}
+	// The operation stopped with the instance otherwise, or it never
+	// started.
+	readyCond := k8s.FindCondition(exp.Status.Conditions, k8s.Ready)
+	if err == nil && inst.DeletionTimestamp.IsZero() && readyCond != nil && readyCond.Reason == k8s.ExportInProgress {
+		operationID := lroOperationID(exp)
+		operation, err := controllers.GetLROOperation(r.ClientFactory, ctx, r, exp.Namespace, operationID, exp.Spec.Instance)
+		if err != nil && !controllers.IsNotFoundError(err) {
+			return ctrl.Result{}, err
+		}
+		if err == nil && !operation.Done {
+			log.Info("cancelling the LRO of the deleted export", "operationID", operationID)
+			if err := controllers.CancelLROOperation(r.ClientFactory, ctx, r, exp.Namespace, operationID, exp.Spec.Instance); err != nil {
+				return ctrl.Result{}, err
+			}
+			return ctrl.Result{RequeueAfter: 10 * time.Second}, nil
+		}
+		_ = controllers.DeleteLROOperation(r.ClientFactory, ctx, r, exp.Namespace, operationID, exp.Spec.Instance)
+	}
+
+	controllerutil.RemoveFinalizer(exp, controllers.FinalizerName)
+	return ctrl.Result{}, r.Update(ctx, exp)
+}
+
 // SetupWithManager configures the reconciler.
 func (r *ExportReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
@@ -22,7 +22,9 @@ import (
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -73,6 +75,7 @@ var _ = Describe("Export controller", func() {
 		dbObjKey              client.ObjectKey
 		objKey                client.ObjectKey
 		fakeConfigAgentClient *testhelpers.FakeConfigAgentClient
+		storageCredsRef       *corev1.LocalObjectReference
 	)
 	ctx := context.Background()
 
@@ -120,12 +123,13 @@ var _ = Describe("Export controller", func() {
 
 		fakeClientFactory.Reset()
 		fakeConfigAgentClient = fakeClientFactory.Caclient
+		storageCredsRef = nil
 	})
 
 	AfterEach(func() {
 		Expect(k8sClient.Delete(ctx, instance)).Should(Succeed())
 		Expect(k8sClient.Delete(ctx, database)).Should(Succeed())
-		Expect(k8sClient.Delete(ctx, export)).Should(Succeed())
+		Expect(client.IgnoreNotFound(k8sClient.Delete(ctx, export))).Should(Succeed())
 	})
 
 	CreateExport := func() {
@@ -142,6 +146,9 @@ var _ = Describe("Export controller", func() {
 				ExportObjectType: "Schemas",
 				ExportObjects:    []string{"scott"},
 				FlashbackTime:    &metav1.Time{Time: time.Now()},
+				GcsPath:          "s3://bucket/export.dmp",
+
+				StorageCredentialsSecretRef: storageCredsRef,
 			},
 		}
 
@@ -209,8 +216,111 @@ var _ = Describe("Export controller", func() {
 			Expect(fakeConfigAgentClient.DeleteOperationCalledCnt()).Should(Equal(1))
 		})
 	})
-})
 
+	Context("export cancellation", func() {
+		It("should cancel a running export when cancel is set", func() {
+			SetDatabaseReadyStatus(metav1.ConditionTrue)
+
+			By("setting LRO status to Running")
+			fakeConfigAgentClient.SetNextGetOperationStatus(testhelpers.StatusRunning)
+
+			CreateExport()
+			Eventually(func() (string, error) {
+				return getConditionReason(ctx, objKey, k8s.Ready)
+			}, timeout, interval).Should(Equal(k8s.ExportInProgress))
+
+			By("cancelling the export")
+			Expect(retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+				if err := k8sClient.Get(ctx, objKey, export); err != nil {
+					return err
+				}
+				export.Spec.Cancel = true
+				return k8sClient.Update(ctx, export)
+			})).Should(Succeed())
+
+			By("checking export was cancelled")
+			Eventually(func() (string, error) {
+				return getConditionReason(ctx, objKey, k8s.Ready)
+			}, timeout, interval).Should(Equal(k8s.ExportCancelled))
+
+			By("verifying post-conditions")
+			Expect(fakeConfigAgentClient.CancelOperationCalledCnt()).Should(Equal(1))
+			Expect(fakeConfigAgentClient.DeleteOperationCalledCnt()).Should(Equal(1))
+		})
+
+		It("should not start an export when cancel is set", func() {
+			SetDatabaseReadyStatus(metav1.ConditionTrue)
+
+			By("creating a cancelled export")
+			export = &v1alpha1.Export{
+				ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: exportName},
+				Spec: v1alpha1.ExportSpec{
+					Instance:      instance.Name,
+					DatabaseName:  databaseName,
+					ExportObjects: []string{"scott"},
+					GcsPath:       "s3://bucket/export.dmp",
+					Cancel:        true,
+				},
+			}
+			objKey = client.ObjectKey{Namespace: namespace, Name: exportName}
+			Expect(k8sClient.Create(ctx, export)).Should(Succeed())
+
+			Eventually(func() (string, error) {
+				return getConditionReason(ctx, objKey, k8s.Ready)
+			}, timeout, interval).Should(Equal(k8s.ExportCancelled))
+			Expect(fakeConfigAgentClient.DataPumpExportCalledCnt()).Should(Equal(0))
+		})
+	})
+
+	Context("export with storage credentials", func() {
+		var secret *corev1.Secret
+		creds := map[string]string{
+			"accessKeyId":     "id",
+			"secretAccessKey": "key",
+		}
+
+		BeforeEach(func() {
+			secret = &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      testhelpers.RandName("s3-creds"),
+					Namespace: namespace,
+				},
+				StringData: creds,
+			}
+			storageCredsRef = &corev1.LocalObjectReference{Name: secret.Name}
+		})
+
+		It("should pass the secret data to the config agent", func() {
+			Expect(k8sClient.Create(ctx, secret)).Should(Succeed())
+			defer func() { Expect(k8sClient.Delete(ctx, secret)).Should(Succeed()) }()
+			SetDatabaseReadyStatus(metav1.ConditionTrue)
+			fakeConfigAgentClient.SetNextGetOperationStatus(testhelpers.StatusDone)
+
+			CreateExport()
+
+			By("checking export condition")
+			Eventually(func() (string, error) {
+				return getConditionReason(ctx, objKey, k8s.Ready)
+			}, timeout, interval).Should(Equal(k8s.ExportComplete))
+
+			By("verifying post-conditions")
+			Expect(fakeConfigAgentClient.DataPumpExportCalledCnt()).Should(Equal(1))
+			Expect(fakeConfigAgentClient.DataPumpExportStorageCredentials()).Should(Equal(creds))
+		})
+
+		It("should keep export pending if the secret does not exist", func() {
+			SetDatabaseReadyStatus(metav1.ConditionTrue)
+
+			CreateExport()
+
+			By("verifying export is pending")
+			Eventually(func() (string, error) {
+				return getConditionReason(ctx, objKey, k8s.Ready)
+			}, timeout, interval).Should(Equal(k8s.ExportPending))
+			Consistently(fakeConfigAgentClient.DataPumpExportCalledCnt, time.Second, interval).Should(Equal(0))
+		})
+	})
+})
 func getConditionReason(ctx context.Context, objKey client.ObjectKey, condType string) (string, error) {
 	var export v1alpha1.Export
 
@@ -27,6 +27,7 @@ import (
 	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 
 	v1alpha1 "github.com/GoogleCloudPlatform/elcarro-oracle-operator/oracle/api/v1alpha1"
 	"github.com/GoogleCloudPlatform/elcarro-oracle-operator/oracle/controllers"
@@ -92,6 +93,7 @@ var (
 // +kubebuilder:rbac:groups=oracle.db.anthosapis.com,resources=databases,verbs=get;list;watch
 // +kubebuilder:rbac:groups=oracle.db.anthosapis.com,resources=databases/status,verbs=get
 // +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
+// +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch
 
 // Reconcile is a generic reconcile function for Import resources.
 func (r *ImportReconciler) Reconcile(_ context.Context, req ctrl.Request) (result ctrl.Result, recErr error) {
@@ -105,6 +107,18 @@ func (r *ImportReconciler) Reconcile(_ context.Context, req ctrl.Request) (resul
 		return ctrl.Result{}, client.IgnoreNotFound(err)
 	}
 
+	if !imp.DeletionTimestamp.IsZero() {
+		return r.reconcileDelete(ctx, log, imp)
+	}
+
+	// The finalizer is added to a new Import and kept until the import
+	// finishes, so that an import in progress is cancelled when the Import
+	// is deleted.
+	if k8s.FindCondition(imp.Status.Conditions, k8s.Ready) == nil && !controllerutil.ContainsFinalizer(imp, controllers.FinalizerName) {
+		controllerutil.AddFinalizer(imp, controllers.FinalizerName)
+		return ctrl.Result{}, r.Update(ctx, imp)
+	}
+
 	impStatusWrapper := &readyConditionWrapper{imp: imp, defaultState: k8s.ImportPending}
 	defer func() {
 		if !impStatusWrapper.changed {
@@ -124,6 +138,12 @@ func (r *ImportReconciler) Reconcile(_ context.Context, req ctrl.Request) (resul
 	case k8s.ImportInProgress:
 		return r.handleRunningImport(ctx, log, impStatusWrapper, req)
 	default:
+		// The finalizer of a finished import is removed once its final
+		// status is stored.
+		if controllerutil.ContainsFinalizer(imp, controllers.FinalizerName) {
+			controllerutil.RemoveFinalizer(imp, controllers.FinalizerName)
+			return ctrl.Result{}, r.Update(ctx, imp)
+		}
 		log.Info(fmt.Sprintf("import is in the state %q, no action needed", impStatusWrapper.getState()))
 		return ctrl.Result{}, nil
 	}
@@ -166,6 +186,12 @@ func (r *ImportReconciler) handleNotStartedImport(ctx context.Context, log logr.
 		k8s.FindCondition(db.Status.Conditions, k8s.Ready),
 		metav1.ConditionTrue)
 
+	if imp.Spec.Cancel {
+		r.Recorder.Event(imp, corev1.EventTypeNormal, k8s.ImportCancelled, "Import was cancelled before it started")
+		impWrapper.setState(k8s.ImportCancelled, "Import was cancelled before it started")
+		return ctrl.Result{}, nil
+	}
+
 	// if can start, begin import
 	if dbReady {
 		caClient, closeConn, err := r.ClientFactory.New(ctx, r, req.Namespace, imp.Spec.Instance)
@@ -175,12 +201,19 @@ func (r *ImportReconciler) handleNotStartedImport(ctx context.Context, log logr.
 		}
 		defer closeConn()
 
+		creds, err := controllers.GetStorageCredentials(ctx, r, req.Namespace, imp.Spec.StorageCredentialsSecretRef)
+		if err != nil {
+			impWrapper.setState(k8s.ImportPending, fmt.Sprintf("failed to start import: %v", err))
+			return ctrl.Result{}, err
+		}
+
 		resp, err := caClient.DataPumpImport(ctx, &capb.DataPumpImportRequest{
-			PdbName:    db.Spec.Name,
-			DbDomain:   inst.Spec.DBDomain,
-			GcsPath:    imp.Spec.GcsPath,
-			GcsLogPath: imp.Spec.GcsLogPath,
-			LroInput:   &capb.LROInput{OperationId: lroOperationID(imp)},
+			PdbName:            db.Spec.Name,
+			DbDomain:           inst.Spec.DBDomain,
+			GcsPath:            imp.Spec.GcsPath,
+			GcsLogPath:         imp.Spec.GcsLogPath,
+			LroInput:           &capb.LROInput{OperationId: lroOperationID(imp)},
+			StorageCredentials: creds,
 		})
 		if err != nil {
 			if !controllers.IsAlreadyExistsError(err) {
@@ -216,7 +249,17 @@ func (r *ImportReconciler) handleRunningImport(ctx context.Context, log logr.Log
 	}
 	log.Info("GetLROOperation", "response", operation)
 
+	if !operation.Done && imp.Spec.Cancel {
+		log.Info("cancelling the LRO", "operationID", operationID)
+		if err := controllers.CancelLROOperation(r.ClientFactory, ctx, r, req.Namespace, operationID, imp.Spec.Instance); err != nil {
+			return ctrl.Result{}, err
+		}
+		return ctrl.Result{RequeueAfter: 10 * time.Second}, nil
+	}
 	if !operation.Done {
+		if progress := controllers.LROProgress(operation); progress != "" {
+			impWrapper.setState(k8s.ImportInProgress, progress)
+		}
 		return requeueLater, nil
 	}
 
@@ -226,6 +269,12 @@ func (r *ImportReconciler) handleRunningImport(ctx context.Context, log logr.Log
 		_ = controllers.DeleteLROOperation(r.ClientFactory, ctx, r, req.Namespace, operationID, imp.Spec.Instance)
 	}()
 
+	if controllers.IsLROCancelled(operation) {
+		impWrapper.setState(k8s.ImportCancelled, fmt.Sprintf("Import was cancelled on %s", time.Now().Format(time.RFC3339)))
+		r.Recorder.Event(imp, corev1.EventTypeNormal, k8s.ImportCancelled, "Import was cancelled")
+		return ctrl.Result{}, nil
+	}
+
 	if operation.GetError() != nil {
 		impWrapper.setState(
 			k8s.ImportFailed,
@@ -250,6 +299,41 @@ func (r *ImportReconciler) handleRunningImport(ctx context.Context, log logr.Log
 	return ctrl.Result{}, nil
 }
 
+// reconcileDelete cancels the import in progress of a deleted Import and
+// removes the finalizer once the operation is stopped.
+func (r *ImportReconciler) reconcileDelete(ctx context.Context, log logr.Logger, imp *v1alpha1.Import) (ctrl.Result, error) {
+	if !controllerutil.ContainsFinalizer(imp, controllers.FinalizerName) {
+		return ctrl.Result{}, nil
+	}
+
+	inst := &v1alpha1.Instance{}
+	err := r.Get(ctx, types.NamespacedName{Namespace: imp.Namespace, Name: imp.Spec.Instance}, inst)
+	if client.IgnoreNotFound(err) != nil {
+		return ctrl.Result{}, err
+	}
+	// The operation stopped with the instance otherwise, or it never
+	// started.
+	readyCond := k8s.FindCondition(imp.Status.Conditions, k8s.Ready)
+	if err == nil && inst.DeletionTimestamp.IsZero() && readyCond != nil && readyCond.Reason == k8s.ImportInProgress {
+		operationID := lroOperationID(imp)
+		operation, err := controllers.GetLROOperation(r.ClientFactory, ctx, r, imp.Namespace, operationID, imp.Spec.Instance)
+		if err != nil && !controllers.IsNotFoundError(err) {
+			return ctrl.Result{}, err
+		}
+		if err == nil && !operation.Done {
+			log.Info("cancelling the LRO of the deleted import", "operationID", operationID)
+			if err := controllers.CancelLROOperation(r.ClientFactory, ctx, r, imp.Namespace, operationID, imp.Spec.Instance); err != nil {
+				return ctrl.Result{}, err
+			}
+			return ctrl.Result{RequeueAfter: 10 * time.Second}, nil
+		}
+		_ = controllers.DeleteLROOperation(r.ClientFactory, ctx, r, imp.Namespace, operationID, imp.Spec.Instance)
+	}
+
+	controllerutil.RemoveFinalizer(imp, controllers.FinalizerName)
+	return ctrl.Result{}, r.Update(ctx, imp)
+}
+
 // SetupWithManager configures the reconciler.
 func (r *ImportReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
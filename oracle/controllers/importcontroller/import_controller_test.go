@@ -21,13 +21,16 @@ import (
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	commonv1alpha1 "github.com/GoogleCloudPlatform/elcarro-oracle-operator/common/api/v1alpha1"
 	v1alpha1 "github.com/GoogleCloudPlatform/elcarro-oracle-operator/oracle/api/v1alpha1"
 	"github.com/GoogleCloudPlatform/elcarro-oracle-operator/oracle/controllers/testhelpers"
+	dbdpb "github.com/GoogleCloudPlatform/elcarro-oracle-operator/oracle/pkg/agents/oracle"
 	"github.com/GoogleCloudPlatform/elcarro-oracle-operator/oracle/pkg/k8s"
 )
 
@@ -131,7 +134,7 @@ var _ = Describe("Import controller", func() {
 	AfterEach(func() {
 		Expect(k8sClient.Delete(ctx, database)).Should(Succeed())
 		Expect(k8sClient.Delete(ctx, instance)).Should(Succeed())
-		Expect(k8sClient.Delete(ctx, imp)).Should(Succeed())
+		Expect(client.IgnoreNotFound(k8sClient.Delete(ctx, imp))).Should(Succeed())
 	})
 
 	Context("Database is ready", func() {
@@ -170,6 +173,75 @@ var _ = Describe("Import controller", func() {
 			Expect(readyCond.Reason).Should(Equal(k8s.ImportComplete))
 		})
 
+		It("Should report the progress of a running LRO", func() {
+			By("simulating a running DataPumpImport LRO")
+			fakeConfigAgentClient.SetNextGetOperationStatus(testhelpers.StatusRunning)
+			fakeConfigAgentClient.SetOperationProgress(&dbdpb.OperationProgress{PercentComplete: 42})
+
+			By("creating a new import")
+			Expect(k8sClient.Create(ctx, imp)).Should(Succeed())
+
+			By("verifying the progress in the Ready condition")
+			Eventually(func() (string, error) {
+				readyCond, err := getCondition(ctx, importObjectKey, k8s.Ready)
+				if err != nil || readyCond == nil {
+					return "", err
+				}
+				return readyCond.Reason + ": " + readyCond.Message, nil
+			}, timeout, interval).Should(Equal(k8s.ImportInProgress + ": 42% complete"))
+		})
+
+		It("Should cancel a running LRO when cancel is set", func() {
+			By("simulating a running DataPumpImport LRO")
+			fakeConfigAgentClient.SetNextGetOperationStatus(testhelpers.StatusRunning)
+
+			By("creating a new import")
+			Expect(k8sClient.Create(ctx, imp)).Should(Succeed())
+			Eventually(func() (string, error) {
+				return getConditionReason(ctx, importObjectKey, k8s.Ready)
+			}, timeout, interval).Should(Equal(k8s.ImportInProgress))
+
+			By("cancelling the import")
+			Expect(retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+				if err := k8sClient.Get(ctx, importObjectKey, imp); err != nil {
+					return err
+				}
+				imp.Spec.Cancel = true
+				return k8sClient.Update(ctx, imp)
+			})).Should(Succeed())
+
+			By("verifying post-conditions")
+			Eventually(func() (string, error) {
+				return getConditionReason(ctx, importObjectKey, k8s.Ready)
+			}, timeout, interval).Should(Equal(k8s.ImportCancelled))
+			Expect(fakeConfigAgentClient.CancelOperationCalledCnt()).Should(Equal(1))
+			// The finalizer is removed by the next reconcile.
+			Eventually(func() ([]string, error) {
+				err := k8sClient.Get(ctx, importObjectKey, imp)
+				return imp.Finalizers, err
+			}, timeout, interval).Should(BeEmpty())
+		})
+
+		It("Should cancel a running LRO when the import is deleted", func() {
+			By("simulating a running DataPumpImport LRO")
+			fakeConfigAgentClient.SetNextGetOperationStatus(testhelpers.StatusRunning)
+
+			By("creating a new import")
+			Expect(k8sClient.Create(ctx, imp)).Should(Succeed())
+			Eventually(func() (string, error) {
+				return getConditionReason(ctx, importObjectKey, k8s.Ready)
+			}, timeout, interval).Should(Equal(k8s.ImportInProgress))
+
+			By("deleting the import")
+			Expect(k8sClient.Delete(ctx, imp)).Should(Succeed())
+
+			By("verifying post-conditions")
+			Eventually(func() bool {
+				return k8serrors.IsNotFound(k8sClient.Get(ctx, importObjectKey, &v1alpha1.Import{}))
+			}, timeout, interval).Should(BeTrue())
+			Expect(fakeConfigAgentClient.CancelOperationCalledCnt()).Should(Equal(1))
+		})
+
 		It("Should handle LRO failure", func() {
 			By("simulating failed DataPumpImport LRO completion")
 			fakeConfigAgentClient.SetNextGetOperationStatus(testhelpers.StatusDoneWithError)
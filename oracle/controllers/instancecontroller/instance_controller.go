@@ -22,15 +22,16 @@ import (
 	"time"
 
 	"github.com/go-logr/logr"
-	"google.golang.org/grpc"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/source"
 
@@ -65,20 +66,27 @@ type InstanceReconciler struct {
 // +kubebuilder:rbac:groups=apps,resources=statefulsets/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups="",resources=persistentvolumeclaims,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups="",resources=persistentvolumes,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=storage.k8s.io,resources=storageclasses,verbs=get;list;watch
 // +kubebuilder:rbac:groups=core,resources=services,verbs=list;watch;get;patch;create
 // +kubebuilder:rbac:groups="",resources=configmaps,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch;create;update
 // +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
 // +kubebuilder:rbac:groups=coordination.k8s.io,resources=leases,verbs=get;list;create;update
 
 // +kubebuilder:rbac:groups=oracle.db.anthosapis.com,resources=databases,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=oracle.db.anthosapis.com,resources=databases/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=oracle.db.anthosapis.com,resources=configs,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=oracle.db.anthosapis.com,resources=backups,verbs=get;list;watch;create
 
 const (
 	physicalRestore               = "PhysicalRestore"
+	changeDatabaseIdentity        = "ChangeDatabaseIdentity"
 	instanceProvisionTimeout      = 30 * time.Minute
 	createDatabaseInstanceTimeout = 30 * time.Minute // 30 minutes because it can take 20+ minutes for unseeded CDB creations
 	dateFormat                    = "20060102"
+	// cdbProgressInterval is how often the progress of a CDB creation is
+	// reported.
+	cdbProgressInterval = 30 * time.Second
 )
 
 var defaultDisks = []commonv1alpha1.DiskSpec{
@@ -197,7 +205,7 @@ func (r *InstanceReconciler) updateProgressCondition(ctx context.Context, inst v
 	return true
 }
 
-// validateSpec sanity checks a DB Domain input for conflicts.
+// validateSpec sanity checks a DB Domain and a replication input for conflicts.
 func validateSpec(inst *v1alpha1.Instance) error {
 	// Does DBUniqueName contain DB Domain as a suffix?
 	if strings.Contains(inst.Spec.DBUniqueName, ".") {
@@ -214,6 +222,24 @@ func validateSpec(inst *v1alpha1.Instance) error {
 		}
 	}
 
+	if rep := inst.Spec.Replication; rep != nil {
+		if rep.PrimaryInstance == inst.Name {
+			return fmt.Errorf("validateSpec: replication.primaryInstance %q refers to the instance itself", rep.PrimaryInstance)
+		}
+		if inst.Spec.DBUniqueName == "" {
+			return fmt.Errorf("validateSpec: dbUniqueName is required for a standby instance")
+		}
+		if inst.Spec.Mode == commonv1alpha1.ManuallySetUpStandby {
+			return fmt.Errorf("validateSpec: replication cannot be combined with mode %v", inst.Spec.Mode)
+		}
+	}
+
+	for _, fv := range inst.Spec.FileVolumes {
+		if (fv.PersistentVolumeClaim == nil) == (fv.NFS == nil) {
+			return fmt.Errorf("validateSpec: file volume %q must have exactly one of persistentVolumeClaim and nfs", fv.Name)
+		}
+	}
+
 	return nil
 }
 
@@ -246,6 +272,16 @@ func (r *InstanceReconciler) Reconcile(_ context.Context, req ctrl.Request) (_ c
 		return ctrl.Result{}, client.IgnoreNotFound(err)
 	}
 
+	if !inst.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, r.reconcileDelete(ctx, &inst, log)
+	}
+	if !controllerutil.ContainsFinalizer(&inst, controllers.FinalizerName) {
+		controllerutil.AddFinalizer(&inst, controllers.FinalizerName)
+		if err := r.Update(ctx, &inst); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
 	if err := validateSpec(&inst); err != nil {
 		log.Error(err, "instance spec validation failed")
 		// TODO better error handling, no need retry
@@ -262,6 +298,15 @@ func (r *InstanceReconciler) Reconcile(_ context.Context, req ctrl.Request) (_ c
 		}
 	}()
 
+	// Issue or renew the certificates for the mutual TLS with the agents
+	// before the agents are (re)started and dialed.
+	if !controllers.AgentTLSDisabled {
+		if err := controllers.ReconcileAgentTLS(ctx, r.Client, r.Scheme, &inst); err != nil {
+			log.Error(err, "failed to reconcile the agent certificates")
+			return ctrl.Result{}, err
+		}
+	}
+
 	diskSpace, err := commonutils.DiskSpaceTotal(&inst)
 	if err != nil {
 		log.Error(err, "failed to calculate the total disk space")
@@ -270,6 +315,19 @@ func (r *InstanceReconciler) Reconcile(_ context.Context, req ctrl.Request) (_ c
 
 	instanceReadyCond := k8s.FindCondition(inst.Status.Conditions, k8s.Ready)
 	dbInstanceCond := k8s.FindCondition(inst.Status.Conditions, k8s.DatabaseInstanceReady)
+	applyOpts := []client.PatchOption{client.ForceOwnership, client.FieldOwner("instance-controller")}
+
+	// The database of a primary whose standby has taken over with a failover
+	// is kept shut down, nothing else is reconciled.
+	if k8s.ConditionStatusEquals(dbInstanceCond, v1.ConditionTrue) {
+		standby, err := r.failedOverStandby(ctx, &inst)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		if standby != nil {
+			return r.fenceFormerPrimary(ctx, &inst, standby, applyOpts, log)
+		}
+	}
 
 	var enabledServices []commonv1alpha1.Service
 	for service, enabled := range inst.Spec.Services {
@@ -279,8 +337,9 @@ func (r *InstanceReconciler) Reconcile(_ context.Context, req ctrl.Request) (_ c
 	}
 
 	// If the instance and database is ready, we can set the instance parameters
+	// unless the instance is being patched.
 	if k8s.ConditionStatusEquals(instanceReadyCond, v1.ConditionTrue) &&
-		k8s.ConditionStatusEquals(dbInstanceCond, v1.ConditionTrue) && inst.Spec.Parameters != nil {
+		k8s.ConditionStatusEquals(dbInstanceCond, v1.ConditionTrue) && inst.Spec.Parameters != nil && inst.Status.Patching == nil {
 		log.Info("instance and db is ready, setting instance parameters")
 
 		if result, err := r.setInstanceParameterStateMachine(ctx, req, inst, log); err != nil {
@@ -307,9 +366,24 @@ func (r *InstanceReconciler) Reconcile(_ context.Context, req ctrl.Request) (_ c
 		return result, err
 	}
 
-	services := []string{"lb", "node"}
+	// Instances created before the service image was recorded in their
+	// status take it from their StatefulSet.
+	if inst.Status.CurrentServiceImage == "" {
+		image, err := r.statefulSetServiceImage(ctx, &inst)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		inst.Status.CurrentServiceImage = image
+	}
 
-	applyOpts := []client.PatchOption{client.ForceOwnership, client.FieldOwner("instance-controller")}
+	// Once the database is created, its service image is only replaced by
+	// the patching state machine.
+	serviceImage := images["service"]
+	if inst.Status.CurrentServiceImage != "" {
+		images["service"] = inst.Status.CurrentServiceImage
+	}
+
+	services := []string{"lb", "node"}
 
 	cm, err := controllers.NewConfigMap(&inst, r.Scheme, fmt.Sprintf(controllers.CmName, inst.Name))
 	if err != nil {
@@ -337,9 +411,26 @@ func (r *InstanceReconciler) Reconcile(_ context.Context, req ctrl.Request) (_ c
 		Services:       enabledServices,
 	}
 
+	agentParam := controllers.AgentDeploymentParams{
+		Inst:           &inst,
+		Config:         config,
+		Scheme:         r.Scheme,
+		Name:           fmt.Sprintf(controllers.AgentDeploymentName, inst.Name),
+		Images:         images,
+		PrivEscalation: false,
+		Log:            log,
+		Args:           controllers.GetLogLevelArgs(config),
+		Services:       enabledServices,
+	}
+
 	// If there is a Restore section in the spec the reconciliation will be handled
 	// by restore state machine until the Spec.Restore section is removed again.
 	if inst.Spec.Restore != nil {
+		// The PVCs of a clone from a Snapshot backup are created from the snapshots.
+		if err := r.prepareSnapshotClone(ctx, &inst, &sp); err != nil {
+			log.Error(err, "failed to prepare a clone from a Snapshot backup")
+			return ctrl.Result{}, err
+		}
 		// Ask the restore state machine to reconcile
 		result, err := r.restoreStateMachine(req, instanceReadyCond, dbInstanceCond, &inst, ctx, sp)
 		if err != nil {
@@ -352,9 +443,65 @@ func (r *InstanceReconciler) Reconcile(_ context.Context, req ctrl.Request) (_ c
 		// No error and no result - state machine is done, proceed with main reconciler
 	}
 
+	// Disks cannot be shrunk, a spec that shrinks a disk is rejected.
+	valid, err := r.validateDiskSizes(ctx, &inst, sp)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if !valid {
+		log.Info("instance spec validation failed, disks cannot be shrunk")
+		return ctrl.Result{}, nil
+	}
+
+	// The patching state machine rolls out a new service image to a ready
+	// instance, it handles the reconciliation until the patching is done.
+	if isPatchingEnabled(&inst) || inst.Status.Patching != nil {
+		result, err := r.patchingStateMachine(ctx, &inst, sp, serviceImage, log)
+		if err != nil {
+			log.Error(err, "patchingStateMachine failed")
+			return result, err
+		}
+		if !result.IsZero() {
+			return result, err
+		}
+	}
+
+	// The resources state machine rolls out changes of the database
+	// container resources, which restarts the database.
+	resourcesResult, err := r.resourcesStateMachine(ctx, &inst, sp, log)
+	if err != nil {
+		log.Error(err, "resourcesStateMachine failed")
+		return resourcesResult, err
+	}
+	if !resourcesResult.IsZero() {
+		return resourcesResult, nil
+	}
+
 	if k8s.ConditionStatusEquals(instanceReadyCond, v1.ConditionTrue) && k8s.ConditionStatusEquals(dbInstanceCond, v1.ConditionTrue) {
 		log.Info("instance has already been provisioned and ready")
-		return ctrl.Result{}, nil
+		if err := r.rollOutAgentTLS(ctx, &inst, sp, agentParam, applyOpts, log); err != nil {
+			return ctrl.Result{}, err
+		}
+		if err := r.reconcileLBService(ctx, &inst, applyOpts); err != nil {
+			return ctrl.Result{}, err
+		}
+		resizeResult, err := r.reconcileDiskResize(ctx, &inst, sp, log)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		resizeResult = mergeResults(resizeResult, r.anchorAuditLog(ctx, &inst, log))
+		if inst.Spec.Replication != nil {
+			// the database is replaced by a standby of the primary instance, the deferred function updates the status.
+			suspendArchiveLogShipping(&inst)
+			result, err := r.reconcileReplication(ctx, &inst, log)
+			return mergeResults(resizeResult, result), err
+		}
+		if isArchiveLogShippingEnabled(&inst) {
+			result, err := r.shipArchivedLogs(ctx, &inst, log)
+			return mergeResults(resizeResult, result), err
+		}
+		removeArchiveLogShippingCondition(&inst)
+		return resizeResult, nil
 	}
 
 	newPVCs, err := controllers.NewPVCs(sp)
@@ -368,6 +515,9 @@ func (r *InstanceReconciler) Reconcile(_ context.Context, req ctrl.Request) (_ c
 		log.Error(err, "failed to create a StatefulSet", "sts", sts)
 		return ctrl.Result{}, err
 	}
+	if err := r.keepVolumeClaimSizes(ctx, sts); err != nil {
+		return ctrl.Result{}, err
+	}
 	log.Info("StatefulSet constructed", "sts", sts, "sts.Status", sts.Status, "inst.Status", inst.Status)
 
 	if err := r.Patch(ctx, sts, client.Apply, applyOpts...); err != nil {
@@ -375,17 +525,6 @@ func (r *InstanceReconciler) Reconcile(_ context.Context, req ctrl.Request) (_ c
 		return ctrl.Result{}, err
 	}
 
-	agentParam := controllers.AgentDeploymentParams{
-		Inst:           &inst,
-		Config:         config,
-		Scheme:         r.Scheme,
-		Name:           fmt.Sprintf(controllers.AgentDeploymentName, inst.Name),
-		Images:         images,
-		PrivEscalation: false,
-		Log:            log,
-		Args:           controllers.GetLogLevelArgs(config),
-		Services:       enabledServices,
-	}
 	agentDeployment, err := controllers.NewAgentDeployment(agentParam)
 	if err != nil {
 		log.Error(err, "failed to create a Deployment", "agent deployment", agentDeployment)
@@ -404,6 +543,11 @@ func (r *InstanceReconciler) Reconcile(_ context.Context, req ctrl.Request) (_ c
 			return ctrl.Result{}, err
 		}
 
+		if s == "lb" {
+			// The load balancer follows the primary database of a Data Guard configuration.
+			svc.Spec.Selector = map[string]string{"instance": r.lbServiceInstance(ctx, &inst)}
+		}
+
 		if err := r.Patch(ctx, svc, client.Apply, applyOpts...); err != nil {
 			return ctrl.Result{}, err
 		}
@@ -484,7 +628,7 @@ func (r *InstanceReconciler) Reconcile(_ context.Context, req ctrl.Request) (_ c
 	}
 
 	if k8s.ConditionStatusEquals(k8s.FindCondition(inst.Status.Conditions, k8s.StandbyReady), v1.ConditionTrue) {
-		conn, err := grpc.Dial(fmt.Sprintf("%s:%d", svc.Spec.ClusterIP, consts.DefaultConfigAgentPort), grpc.WithInsecure())
+		conn, err := controllers.DialConfigAgent(ctx, r, inst.Namespace, inst.Name, fmt.Sprintf("%s:%d", svc.Spec.ClusterIP, consts.DefaultConfigAgentPort))
 		if err != nil {
 			log.Error(err, "failed to create a conn via gRPC.Dial")
 			return ctrl.Result{}, err
@@ -528,13 +672,13 @@ func (r *InstanceReconciler) Reconcile(_ context.Context, req ctrl.Request) (_ c
 
 	log.Info("reconciling instance: DONE")
 
-	istatus, err := controllers.CheckStatusInstanceFunc(ctx, inst.Name, inst.Spec.CDBName, svc.Spec.ClusterIP, controllers.GetDBDomain(&inst), log)
+	istatus, err := controllers.CheckStatusInstanceFunc(ctx, r, inst.Namespace, inst.Name, inst.Spec.CDBName, svc.Spec.ClusterIP, controllers.GetDBDomain(&inst), log)
 	if err != nil {
 		log.Error(err, "failed to check the database instance status")
 		return ctrl.Result{}, err
 	}
 
-	isImageSeeded, err := isImageSeeded(ctx, svc.Spec.ClusterIP, log)
+	isImageSeeded, err := isImageSeeded(ctx, r, &inst, svc.Spec.ClusterIP, log)
 	if err != nil {
 		log.Error(err, "unable to determine image type")
 		return ctrl.Result{}, err
@@ -546,7 +690,7 @@ func (r *InstanceReconciler) Reconcile(_ context.Context, req ctrl.Request) (_ c
 			log.Error(err, "failed to update the instance status")
 		}
 
-		if err = r.bootstrapCDB(ctx, inst, svc.Spec.ClusterIP, log); err != nil {
+		if err = r.bootstrapCDB(ctx, &inst, svc.Spec.ClusterIP, log); err != nil {
 			k8s.InstanceUpsertCondition(&inst.Status, k8s.DatabaseInstanceReady, v1.ConditionFalse, k8s.CreateFailed, fmt.Sprintf("Error creating CDB: %v", err))
 			log.Error(err, "Error while creating CDB database")
 			r.Recorder.Eventf(&inst, corev1.EventTypeWarning, "DatabaseInstanceCreateFailed", fmt.Sprintf("Error creating CDB: %v", err))
@@ -585,7 +729,7 @@ func (r *InstanceReconciler) Reconcile(_ context.Context, req ctrl.Request) (_ c
 }
 
 // isImageSeeded determines from the service image metadata file if the image is seeded or unseeded.
-func isImageSeeded(ctx context.Context, clusterIP string, log logr.Logger) (bool, error) {
+func isImageSeeded(ctx context.Context, r client.Reader, inst *v1alpha1.Instance, clusterIP string, log logr.Logger) (bool, error) {
 
 	log.Info("isImageSeeded: new database requested clusterIP", clusterIP)
 
@@ -594,7 +738,7 @@ func isImageSeeded(ctx context.Context, clusterIP string, log logr.Logger) (bool
 	ctx, cancel := context.WithTimeout(ctx, dialTimeout)
 	defer cancel()
 
-	conn, err := grpc.Dial(fmt.Sprintf("%s:%d", clusterIP, consts.DefaultConfigAgentPort), grpc.WithInsecure())
+	conn, err := controllers.DialConfigAgent(ctx, r, inst.Namespace, inst.Name, fmt.Sprintf("%s:%d", clusterIP, consts.DefaultConfigAgentPort))
 	if err != nil {
 		log.Error(err, "isImageSeeded: failed to create a conn via gRPC.Dial")
 		return false, err
@@ -683,7 +827,9 @@ func diskSpecs(inst *v1alpha1.Instance, config *v1alpha1.Config) []commonv1alpha
 // a) Create a CDB for an unseeded image.
 // b) Invoke a provisioning workflow for unseeded images.
 // c) Creates the database listener.
-func (r *InstanceReconciler) bootstrapCDB(ctx context.Context, inst v1alpha1.Instance, clusterIP string, log logr.Logger) error {
+// The Instance is re-read once the CDB is created, since its progress was
+// written to the status meanwhile.
+func (r *InstanceReconciler) bootstrapCDB(ctx context.Context, inst *v1alpha1.Instance, clusterIP string, log logr.Logger) error {
 	// TODO: add better error handling.
 	if inst.Spec.CDBName == "" || inst.Spec.DBUniqueName == "" {
 		return fmt.Errorf("bootstrapCDB: at least one of the following required arguments is not defined: CDBName, DBUniqueName")
@@ -697,36 +843,54 @@ func (r *InstanceReconciler) bootstrapCDB(ctx context.Context, inst v1alpha1.Ins
 	ctx, cancel := context.WithTimeout(ctx, dialTimeout)
 	defer cancel()
 
-	conn, err := grpc.Dial(fmt.Sprintf("%s:%d", clusterIP, consts.DefaultConfigAgentPort), grpc.WithInsecure())
+	conn, err := controllers.DialConfigAgent(ctx, r, inst.Namespace, inst.Name, fmt.Sprintf("%s:%d", clusterIP, consts.DefaultConfigAgentPort))
 	if err != nil {
 		log.Error(err, "bootstrapCDB: failed to create a conn via gRPC.Dial")
 		return err
 	}
 	defer conn.Close()
 
+	// CreateCDB returns once the CDB is created, its progress is reported
+	// meanwhile from the LRO operation which runs DBCA.
+	id := lroCreateCDBOperationID(inst)
+	progressCtx, stopProgress := context.WithCancel(ctx)
+	defer stopProgress()
+	progressDone := make(chan struct{})
+	go func() {
+		defer close(progressDone)
+		r.reportCDBProgress(progressCtx, client.ObjectKeyFromObject(inst), id, log)
+	}()
+
 	caClient := capb.NewConfigAgentClient(conn)
 	_, err = caClient.CreateCDB(ctx, &capb.CreateCDBRequest{
 		Sid:           inst.Spec.CDBName,
 		DbUniqueName:  inst.Spec.DBUniqueName,
-		DbDomain:      controllers.GetDBDomain(&inst),
+		DbDomain:      controllers.GetDBDomain(inst),
 		CharacterSet:  inst.Spec.CharacterSet,
 		MemoryPercent: int32(inst.Spec.MemoryPercent),
 		//DBCA expects the parameters in the following string array format
 		// ["key1=val1", "key2=val2","key3=val3"]
 		AdditionalParams: mapsToStringArray(inst.Spec.Parameters),
+		LroInput:         &capb.LROInput{OperationId: id},
 	})
+	stopProgress()
+	<-progressDone
+	if getErr := r.Get(ctx, client.ObjectKeyFromObject(inst), inst); getErr != nil {
+		log.Error(getErr, "failed to get the instance after the CDB creation")
+		return getErr
+	}
 	if err != nil {
 		return fmt.Errorf("bootstrapCDB: failed on CreateDatabase gRPC call: %v", err)
 	}
 
 	inst.Status.CurrentParameters = inst.Spec.Parameters
-	if err := r.Status().Update(ctx, &inst); err != nil {
+	if err := r.Status().Update(ctx, inst); err != nil {
 		log.Error(err, "failed to update an Instance status returning error")
 		return err
 	}
 
 	caClient = capb.NewConfigAgentClient(conn)
-	dbDomain := controllers.GetDBDomain(&inst)
+	dbDomain := controllers.GetDBDomain(inst)
 	_, err = caClient.BootstrapDatabase(ctx, &capb.BootstrapDatabaseRequest{
 		CdbName:      inst.Spec.CDBName,
 		DbUniqueName: inst.Spec.DBUniqueName,
@@ -750,6 +914,127 @@ func (r *InstanceReconciler) bootstrapCDB(ctx context.Context, inst v1alpha1.Ins
 	return nil
 }
 
+// reportCDBProgress reports the progress of the CDB creation in the
+// DatabaseInstanceReady condition until ctx is done. bootstrapCDB blocks the
+// reconciliation meanwhile, the progress is merged into the latest Instance
+// without bumping into the updates of the reconciliation.
+func (r *InstanceReconciler) reportCDBProgress(ctx context.Context, key client.ObjectKey, id string, log logr.Logger) {
+	tick := time.NewTicker(cdbProgressInterval)
+	defer tick.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-tick.C:
+		}
+		operation, err := controllers.GetLROOperation(r.ClientFactory, ctx, r, key.Namespace, id, key.Name)
+		if err != nil {
+			log.V(1).Info("reportCDBProgress: failed to get the CDB creation operation", "id", id, "err", err)
+			continue
+		}
+		progress := controllers.LROProgress(operation)
+		if progress == "" {
+			continue
+		}
+		var inst v1alpha1.Instance
+		if err := r.Get(ctx, key, &inst); err != nil {
+			log.V(1).Info("reportCDBProgress: failed to get the instance", "err", err)
+			continue
+		}
+		patch := client.MergeFrom(inst.DeepCopy())
+		k8s.InstanceUpsertCondition(&inst.Status, k8s.DatabaseInstanceReady, v1.ConditionFalse, k8s.CreateInProgress, "Bootstrapping CDB: "+progress)
+		if err := r.Status().Patch(ctx, &inst, patch); err != nil {
+			log.V(1).Info("reportCDBProgress: failed to update the instance status", "err", err)
+		}
+	}
+}
+
+// rollOutAgentTLS mounts the agent certificates into the StatefulSet and the
+// Config Agent Deployment of a ready instance created before the mutual TLS
+// was introduced, or before the Config Agent got its own certificate. The
+// Config Agent is dialed without TLS until the rollout of
+// its Deployment finishes, see controllers.DialConfigAgent.
+func (r *InstanceReconciler) rollOutAgentTLS(ctx context.Context, inst *v1alpha1.Instance, sp controllers.StsParams, agentParam controllers.AgentDeploymentParams, applyOpts []client.PatchOption, log logr.Logger) error {
+	if controllers.AgentTLSDisabled {
+		return nil
+	}
+
+	sts := &appsv1.StatefulSet{}
+	if err := r.Get(ctx, types.NamespacedName{Namespace: inst.Namespace, Name: fmt.Sprintf(controllers.StsName, inst.Name)}, sts); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+	if !controllers.HasAgentTLS(sts.Spec.Template.Spec) {
+		log.Info("rolling out the agent certificates to the StatefulSet")
+		if err := r.applyStatefulSet(ctx, *inst, sp); err != nil {
+			return err
+		}
+	}
+
+	deployment := &appsv1.Deployment{}
+	if err := r.Get(ctx, types.NamespacedName{Namespace: inst.Namespace, Name: agentParam.Name}, deployment); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+	// The Config Agent of an Instance created before the agents got their own
+	// certificates mounts the certificate of the database Pod.
+	if controllers.AgentTLSSecret(deployment.Spec.Template.Spec) == fmt.Sprintf(controllers.ConfigAgentTLSSecretName, inst.Name) {
+		return nil
+	}
+	log.Info("rolling out the agent certificates to the Config Agent Deployment")
+	agentDeployment, err := controllers.NewAgentDeployment(agentParam)
+	if err != nil {
+		return err
+	}
+	return r.Patch(ctx, agentDeployment, client.Apply, applyOpts...)
+}
+
+// lroCreateCDBOperationID returns the ID of the CDB creation operation of an
+// instance.
+func lroCreateCDBOperationID(inst *v1alpha1.Instance) string {
+	return fmt.Sprintf("CreateCDB_%s", inst.GetUID())
+}
+
+// reconcileDelete removes the finalizer of a deleted Instance once the
+// long running operations tracked by its config agent are cleaned up.
+// The cleanup is best effort: the agent may already be gone and should not
+// block the deletion of the Instance.
+func (r *InstanceReconciler) reconcileDelete(ctx context.Context, inst *v1alpha1.Instance, log logr.Logger) error {
+	if !controllerutil.ContainsFinalizer(inst, controllers.FinalizerName) {
+		return nil
+	}
+
+	if inst.Status.Phase != commonv1alpha1.InstanceDeleting {
+		inst.Status.Phase = commonv1alpha1.InstanceDeleting
+		inst.Status.Conditions = k8s.Upsert(inst.Status.Conditions, k8s.Ready, v1.ConditionFalse, k8s.DeleteInProgress, "Instance is being deleted")
+		if err := r.Status().Update(ctx, inst); err != nil {
+			return err
+		}
+	}
+
+	if err := controllers.DeleteAllLROOperations(r.ClientFactory, ctx, r, inst.Namespace, inst.Name); err != nil {
+		log.Error(err, "failed to delete long running operations of the instance")
+		r.Recorder.Eventf(inst, corev1.EventTypeWarning, k8s.DeleteFailed, "Failed to delete long running operations: %v", err)
+	}
+
+	if err := r.deleteClonedSnapshotContents(ctx, inst); err != nil {
+		log.Error(err, "failed to delete the volume snapshot contents of the clone")
+		return err
+	}
+
+	controllerutil.RemoveFinalizer(inst, controllers.FinalizerName)
+	return r.Update(ctx, inst)
+}
+
+// mergeResults returns a result which requeues as soon as any of a and b
+// does.
+func mergeResults(a, b ctrl.Result) ctrl.Result {
+	merged := ctrl.Result{Requeue: a.Requeue || b.Requeue, RequeueAfter: a.RequeueAfter}
+	if b.RequeueAfter > 0 && (merged.RequeueAfter == 0 || b.RequeueAfter < merged.RequeueAfter) {
+		merged.RequeueAfter = b.RequeueAfter
+	}
+	return merged
+}
+
 func (r *InstanceReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	r.Log.V(1).Info("SetupWithManager", "images", r.Images)
 
@@ -762,5 +1047,8 @@ func (r *InstanceReconciler) SetupWithManager(mgr ctrl.Manager) error {
 		Watches(
 			&source.Kind{Type: &v1alpha1.Database{}},
 			&handler.EnqueueRequestForObject{}).
+		Watches(
+			&source.Kind{Type: &v1alpha1.Instance{}},
+			handler.EnqueueRequestsFromMapFunc(primaryInstanceRequests)).
 		Complete(r)
 }
@@ -0,0 +1,227 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package instancecontroller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	lropb "google.golang.org/genproto/googleapis/longrunning"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	commonv1alpha1 "github.com/GoogleCloudPlatform/elcarro-oracle-operator/common/api/v1alpha1"
+	v1alpha1 "github.com/GoogleCloudPlatform/elcarro-oracle-operator/oracle/api/v1alpha1"
+	"github.com/GoogleCloudPlatform/elcarro-oracle-operator/oracle/controllers"
+	capb "github.com/GoogleCloudPlatform/elcarro-oracle-operator/oracle/pkg/agents/config_agent/protos"
+	dbdpb "github.com/GoogleCloudPlatform/elcarro-oracle-operator/oracle/pkg/agents/oracle"
+	"github.com/GoogleCloudPlatform/elcarro-oracle-operator/oracle/pkg/k8s"
+)
+
+const (
+	defaultArchiveLogShippingInterval = 5 * time.Minute
+	archiveLogShippingPollInterval    = time.Minute
+)
+
+// isArchiveLogShippingEnabled returns true if the ArchiveLogShipping service
+// is enabled for the instance.
+func isArchiveLogShippingEnabled(inst *v1alpha1.Instance) bool {
+	return inst.Spec.Services[commonv1alpha1.ArchiveLogShipping]
+}
+
+// archiveLogShippingInterval returns how often archived redo logs are shipped.
+func archiveLogShippingInterval(inst *v1alpha1.Instance) time.Duration {
+	if inst.Spec.ArchiveLogShipping != nil && inst.Spec.ArchiveLogShipping.IntervalMinutes > 0 {
+		return time.Duration(inst.Spec.ArchiveLogShipping.IntervalMinutes) * time.Minute
+	}
+	return defaultArchiveLogShippingInterval
+}
+
+// shipArchivedLogs starts an LRO uploading the archived redo logs generated
+// since the last shipped sequence to the requested storage location, and
+// records the progress in the instance status once it's done. It is invoked
+// for a ready instance, a new shipping round starts a shipping interval after
+// the last one completed.
+// The instance status is expected to be persisted by the caller.
+func (r *InstanceReconciler) shipArchivedLogs(ctx context.Context, inst *v1alpha1.Instance, log logr.Logger) (ctrl.Result, error) {
+	interval := archiveLogShippingInterval(inst)
+	if inst.Spec.ArchiveLogShipping == nil || inst.Spec.ArchiveLogShipping.GcsPath == "" {
+		log.Info("ArchiveLogShipping service is enabled, but spec.archiveLogShipping.gcsPath is not set, skipping")
+		k8s.InstanceUpsertCondition(&inst.Status, k8s.ArchiveLogShippingReady, metav1.ConditionFalse, k8s.ArchiveLogShippingDisabled,
+			"The ArchiveLogShipping service is enabled, but spec.archiveLogShipping.gcsPath is not set")
+		return ctrl.Result{}, nil
+	}
+
+	st := inst.Status.ArchiveLogShipping
+	if st != nil && st.InProgress {
+		return r.checkArchivedLogsShipped(ctx, inst, log)
+	}
+	if st != nil && st.LastRunTime != nil {
+		if wait := interval - time.Since(st.LastRunTime.Time); wait > 0 {
+			return ctrl.Result{RequeueAfter: wait}, nil
+		}
+	}
+
+	creds, err := controllers.GetStorageCredentials(ctx, r, inst.Namespace, inst.Spec.ArchiveLogShipping.StorageCredentialsSecretRef)
+	if err != nil {
+		log.Error(err, "failed to get the storage credentials")
+		r.archiveLogShippingFailed(inst, fmt.Sprintf("Failed to ship archived logs: %v", err))
+		return ctrl.Result{RequeueAfter: interval}, nil
+	}
+
+	caClient, closeConn, err := r.ClientFactory.New(ctx, r, inst.Namespace, inst.Name)
+	if err != nil {
+		log.Error(err, "failed to create config agent client")
+		return ctrl.Result{RequeueAfter: interval}, nil
+	}
+	defer closeConn()
+
+	req := &capb.ShipArchivedLogsRequest{
+		GcsPath:            inst.Spec.ArchiveLogShipping.GcsPath,
+		LroInput:           &capb.LROInput{OperationId: lroShipArchivedLogsOperationID(inst)},
+		StorageCredentials: creds,
+	}
+	if st != nil {
+		req.Incarnation = st.Incarnation
+		req.AfterSequence = st.LastShippedSequence
+	}
+	operation, err := caClient.ShipArchivedLogs(ctx, req)
+	if err != nil {
+		log.Error(err, "failed to ship archived logs")
+		r.archiveLogShippingFailed(inst, fmt.Sprintf("Failed to ship archived logs: %v", err))
+		return ctrl.Result{RequeueAfter: interval}, nil
+	}
+	if operation.GetDone() {
+		return r.archivedLogsShipped(ctx, inst, operation, log)
+	}
+
+	if inst.Status.ArchiveLogShipping == nil {
+		inst.Status.ArchiveLogShipping = &v1alpha1.ArchiveLogShippingStatus{}
+	}
+	inst.Status.ArchiveLogShipping.InProgress = true
+	return ctrl.Result{RequeueAfter: archiveLogShippingPollInterval}, nil
+}
+
+// checkArchivedLogsShipped checks the LRO started by shipArchivedLogs.
+func (r *InstanceReconciler) checkArchivedLogsShipped(ctx context.Context, inst *v1alpha1.Instance, log logr.Logger) (ctrl.Result, error) {
+	id := lroShipArchivedLogsOperationID(inst)
+	operation, err := controllers.GetLROOperation(r.ClientFactory, ctx, r, inst.Namespace, id, inst.Name)
+	if err != nil {
+		log.Error(err, "GetLROOperation returned an error", "id", id)
+		if status.Code(err) == codes.NotFound {
+			// The operation is gone, e.g. the database container was
+			// replaced, the logs are shipped by the next round.
+			inst.Status.ArchiveLogShipping.InProgress = false
+		}
+		return ctrl.Result{RequeueAfter: archiveLogShippingPollInterval}, nil
+	}
+	if !operation.GetDone() {
+		return ctrl.Result{RequeueAfter: archiveLogShippingPollInterval}, nil
+	}
+	return r.archivedLogsShipped(ctx, inst, operation, log)
+}
+
+// archivedLogsShipped records the outcome of a done shipping LRO.
+func (r *InstanceReconciler) archivedLogsShipped(ctx context.Context, inst *v1alpha1.Instance, operation *lropb.Operation, log logr.Logger) (ctrl.Result, error) {
+	interval := archiveLogShippingInterval(inst)
+	id := lroShipArchivedLogsOperationID(inst)
+	if err := controllers.DeleteLROOperation(r.ClientFactory, ctx, r, inst.Namespace, id, inst.Name); err != nil {
+		log.Error(err, "failed to delete the LRO", "id", id)
+	}
+
+	st := inst.Status.ArchiveLogShipping
+	if st == nil {
+		st = &v1alpha1.ArchiveLogShippingStatus{}
+		inst.Status.ArchiveLogShipping = st
+	}
+	st.InProgress = false
+	now := metav1.Now()
+	st.LastRunTime = &now
+
+	if operation.GetError() != nil {
+		log.Info("failed to ship archived logs", "error", operation.GetError().GetMessage())
+		r.archiveLogShippingFailed(inst, fmt.Sprintf("Failed to ship archived logs: %s", operation.GetError().GetMessage()))
+		return ctrl.Result{RequeueAfter: interval}, nil
+	}
+	resp := &dbdpb.ShipArchivedLogsResponse{}
+	if err := operation.GetResponse().UnmarshalTo(resp); err != nil {
+		log.Error(err, "failed to read the archived log shipping response")
+		r.archiveLogShippingFailed(inst, fmt.Sprintf("Failed to read the archived log shipping response: %v", err))
+		return ctrl.Result{RequeueAfter: interval}, nil
+	}
+
+	if st.Incarnation != resp.GetIncarnation() {
+		*st = v1alpha1.ArchiveLogShippingStatus{Incarnation: resp.GetIncarnation(), LastRunTime: st.LastRunTime}
+	}
+	if resp.GetShippedCount() > 0 {
+		st.LastShippedSequence = resp.GetLastSequence()
+		st.LastShippedSCN = resp.GetLastScn()
+		if resp.GetLastTime() != nil {
+			lastTime := metav1.NewTime(resp.GetLastTime().AsTime())
+			st.LastShippedTime = &lastTime
+		}
+	}
+	log.Info("archived logs shipped", "count", resp.GetShippedCount(), "lastSequence", st.LastShippedSequence, "lastSCN", st.LastShippedSCN)
+
+	// The logs shipped before a failed upload are recorded above, so that the
+	// next round continues after them.
+	if resp.GetErrorMessage() != "" {
+		r.archiveLogShippingFailed(inst, fmt.Sprintf("Shipped %d archived logs up to sequence %d, then failed: %s", resp.GetShippedCount(), st.LastShippedSequence, resp.GetErrorMessage()))
+		return ctrl.Result{RequeueAfter: interval}, nil
+	}
+	k8s.InstanceUpsertCondition(&inst.Status, k8s.ArchiveLogShippingReady, metav1.ConditionTrue, k8s.ArchiveLogShippingComplete,
+		fmt.Sprintf("Shipped archived logs up to sequence %d", st.LastShippedSequence))
+	return ctrl.Result{RequeueAfter: interval}, nil
+}
+
+// archiveLogShippingFailed reports a failed shipping round.
+func (r *InstanceReconciler) archiveLogShippingFailed(inst *v1alpha1.Instance, msg string) {
+	r.Recorder.Event(inst, corev1.EventTypeWarning, k8s.ArchiveLogShippingFailed, msg)
+	k8s.InstanceUpsertCondition(&inst.Status, k8s.ArchiveLogShippingReady, metav1.ConditionFalse, k8s.ArchiveLogShippingFailed, msg)
+}
+
+// suspendArchiveLogShipping reports that the archived logs of a standby
+// instance are not shipped. The standby receives the redo of the primary
+// instance, whose own archived logs are the ones to ship.
+func suspendArchiveLogShipping(inst *v1alpha1.Instance) {
+	if !isArchiveLogShippingEnabled(inst) {
+		return
+	}
+	k8s.InstanceUpsertCondition(&inst.Status, k8s.ArchiveLogShippingReady, metav1.ConditionFalse, k8s.ArchiveLogShippingDisabled,
+		"Archived redo logs are not shipped while spec.replication is set, enable the ArchiveLogShipping service of the primary instance instead")
+}
+
+// removeArchiveLogShippingCondition removes the ArchiveLogShippingReady
+// condition of an instance whose ArchiveLogShipping service is disabled.
+func removeArchiveLogShippingCondition(inst *v1alpha1.Instance) {
+	var conditions []metav1.Condition
+	for _, c := range inst.Status.Conditions {
+		if c.Type != k8s.ArchiveLogShippingReady {
+			conditions = append(conditions, c)
+		}
+	}
+	inst.Status.Conditions = conditions
+}
+
+// lroShipArchivedLogsOperationID returns the ID of the archived redo log
+// shipping LRO of an instance.
+func lroShipArchivedLogsOperationID(inst *v1alpha1.Instance) string {
+	return fmt.Sprintf("ShipArchivedLogs_%s", inst.GetUID())
+}
@@ -0,0 +1,66 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package instancecontroller
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	v1alpha1 "github.com/GoogleCloudPlatform/elcarro-oracle-operator/oracle/api/v1alpha1"
+	capb "github.com/GoogleCloudPlatform/elcarro-oracle-operator/oracle/pkg/agents/config_agent/protos"
+)
+
+const auditLogAnchorInterval = 10 * time.Minute
+
+// anchorAuditLog records the head of the audit log of the Database Daemon in
+// the instance status, so that a rewritten or truncated audit log is
+// detected, see audit.Verify. It is invoked for a ready instance, the head
+// is recorded once per anchor interval. A head behind the recorded one means
+// that the audit log was replaced, e.g. with the data disk, which is reported
+// with the previous anchor before the new head is recorded.
+// The instance status is expected to be persisted by the caller.
+func (r *InstanceReconciler) anchorAuditLog(ctx context.Context, inst *v1alpha1.Instance, log logr.Logger) ctrl.Result {
+	anchor := inst.Status.AuditLogAnchor
+	if anchor != nil && anchor.Time != nil {
+		if wait := auditLogAnchorInterval - time.Since(anchor.Time.Time); wait > 0 {
+			return ctrl.Result{RequeueAfter: wait}
+		}
+	}
+
+	caClient, closeConn, err := r.ClientFactory.New(ctx, r, inst.Namespace, inst.Name)
+	if err != nil {
+		log.Error(err, "failed to create config agent client")
+		return ctrl.Result{RequeueAfter: auditLogAnchorInterval}
+	}
+	defer closeConn()
+
+	head, err := caClient.GetAuditLogHead(ctx, &capb.GetAuditLogHeadRequest{})
+	if err != nil {
+		log.Error(err, "failed to get the head of the audit log")
+		return ctrl.Result{RequeueAfter: auditLogAnchorInterval}
+	}
+	if anchor != nil && (head.GetSeq() < anchor.Seq || head.GetSeq() == anchor.Seq && head.GetHash() != anchor.Hash) {
+		r.Recorder.Eventf(inst, corev1.EventTypeWarning, "AuditLogRewritten",
+			"The audit log ends at seq %d, which doesn't contain the anchored entry seq %d with hash %s", head.GetSeq(), anchor.Seq, anchor.Hash)
+	}
+	now := metav1.Now()
+	inst.Status.AuditLogAnchor = &v1alpha1.AuditLogAnchor{Seq: head.GetSeq(), Hash: head.GetHash(), Time: &now}
+	return ctrl.Result{RequeueAfter: auditLogAnchorInterval}
+}
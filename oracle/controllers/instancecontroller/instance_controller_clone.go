@@ -0,0 +1,302 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package instancecontroller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	snapv1 "github.com/kubernetes-csi/external-snapshotter/v2/pkg/apis/volumesnapshot/v1beta1"
+	lropb "google.golang.org/genproto/googleapis/longrunning"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/GoogleCloudPlatform/elcarro-oracle-operator/oracle/api/v1alpha1"
+	"github.com/GoogleCloudPlatform/elcarro-oracle-operator/oracle/controllers"
+	capb "github.com/GoogleCloudPlatform/elcarro-oracle-operator/oracle/pkg/agents/config_agent/protos"
+	dbdpb "github.com/GoogleCloudPlatform/elcarro-oracle-operator/oracle/pkg/agents/oracle"
+	"github.com/GoogleCloudPlatform/elcarro-oracle-operator/oracle/pkg/k8s"
+)
+
+// +kubebuilder:rbac:groups="snapshot.storage.k8s.io",resources=volumesnapshots,verbs=get;list;watch;create;delete
+// +kubebuilder:rbac:groups="snapshot.storage.k8s.io",resources=volumesnapshotcontents,verbs=get;list;watch;create;delete
+
+const (
+	// Labels of the VolumeSnapshotContents copied for a clone, which are
+	// cluster scoped and can't be owned by the Instance.
+	cloneInstanceLabel  = "clone-instance"
+	cloneNamespaceLabel = "clone-namespace"
+)
+
+// isClone returns true if the backup belongs to another Instance.
+func isClone(inst *v1alpha1.Instance, backup *v1alpha1.Backup) bool {
+	return backup.Spec.Instance != inst.Name || backup.Namespace != inst.Namespace
+}
+
+// isNewClone returns true if the backup belongs to another Instance and
+// the Instance has neither been restored before, other than by the current
+// restore request, nor has any databases, i.e. there is nothing a restore
+// could overwrite.
+func isNewClone(inst *v1alpha1.Instance, backup *v1alpha1.Backup) bool {
+	restored := inst.Status.LastRestoreTime != nil && !inst.Status.LastRestoreTime.Equal(&inst.Spec.Restore.RequestTime)
+	return isClone(inst, backup) && !restored && len(inst.Status.DatabaseNames) == 0
+}
+
+// authorizeClone checks that a backup in another namespace allows a clone
+// into the namespace of the Instance with the clone-namespaces annotation.
+func authorizeClone(inst *v1alpha1.Instance, backup *v1alpha1.Backup) error {
+	if backup.Namespace == inst.Namespace {
+		return nil
+	}
+	for _, ns := range strings.Split(backup.Annotations[v1alpha1.CloneNamespacesAnnotation], ",") {
+		if ns = strings.TrimSpace(ns); ns == "*" || ns == inst.Namespace {
+			return nil
+		}
+	}
+	return fmt.Errorf("backup %s/%s doesn't allow a clone into namespace %q, add the namespace to its %s annotation",
+		backup.Namespace, backup.Name, inst.Namespace, v1alpha1.CloneNamespacesAnnotation)
+}
+
+// sourceCDBName returns the CDB name of the Instance a backup belongs to.
+func (r *InstanceReconciler) sourceCDBName(ctx context.Context, backup *v1alpha1.Backup) (string, error) {
+	var src v1alpha1.Instance
+	if err := r.Get(ctx, types.NamespacedName{Namespace: backup.Namespace, Name: backup.Spec.Instance}, &src); err != nil {
+		return "", fmt.Errorf("preflight check: failed to get the Instance %s/%s of the backup: %v", backup.Namespace, backup.Spec.Instance, err)
+	}
+	return src.Spec.CDBName, nil
+}
+
+// prepareSnapshotClone sets the PVCs of a clone from a Snapshot backup to be
+// created from the volume snapshots of the backup, until the restore is
+// complete. VolumeSnapshots can't be used across namespaces, so the ones of
+// a backup in another namespace are copied into the namespace of the
+// Instance first.
+func (r *InstanceReconciler) prepareSnapshotClone(ctx context.Context, inst *v1alpha1.Instance, sp *controllers.StsParams) error {
+	if inst.Spec.Restore.BackupType != "Snapshot" {
+		return nil
+	}
+	backup, err := r.findBackupForRestore(ctx, *inst, restoreBackupNamespace(inst))
+	if err != nil || !isClone(inst, backup) {
+		// A missing backup is reported by the restore state machine.
+		return nil
+	}
+	if err := authorizeClone(inst, backup); err != nil {
+		r.Recorder.Eventf(inst, corev1.EventTypeWarning, "RestoreFailed", err.Error())
+		return err
+	}
+	// The disks of the backup hold the configuration of the source CDB.
+	sourceCDBName, err := r.sourceCDBName(ctx, backup)
+	if err != nil {
+		return err
+	}
+	if !strings.EqualFold(sourceCDBName, inst.Spec.CDBName) {
+		r.Recorder.Eventf(inst, corev1.EventTypeWarning, "RestoreFailed", "A clone from a Snapshot backup requires the CDB name %q of the source Instance", sourceCDBName)
+		return fmt.Errorf("a clone from a Snapshot backup requires the CDB name %q of Instance %s/%s, got: %q",
+			sourceCDBName, backup.Namespace, backup.Spec.Instance, inst.Spec.CDBName)
+	}
+	if backup.Namespace != inst.Namespace {
+		for _, d := range sp.Disks {
+			_, mount := controllers.GetPVCNameAndMount(inst.Name, d.Name)
+			if err := r.copyVolumeSnapshot(ctx, inst, backup, fmt.Sprintf("%s-%s", backup.Status.BackupID, mount)); err != nil {
+				return err
+			}
+		}
+	}
+	sp.Restore = inst.Spec.Restore
+	return nil
+}
+
+// copyVolumeSnapshot creates a VolumeSnapshot in the namespace of the
+// Instance, which is bound to a pre-provisioned VolumeSnapshotContent of the
+// same storage snapshot as the VolumeSnapshot of the backup in the source
+// namespace. Only a VolumeSnapshot controlled by the backup is copied. The
+// copy retains the storage snapshot, which still belongs to the backup.
+func (r *InstanceReconciler) copyVolumeSnapshot(ctx context.Context, inst *v1alpha1.Instance, backup *v1alpha1.Backup, name string) error {
+	if err := r.Get(ctx, types.NamespacedName{Namespace: inst.Namespace, Name: name}, &snapv1.VolumeSnapshot{}); err == nil || !apierrors.IsNotFound(err) {
+		return err
+	}
+
+	namespace := backup.Namespace
+	var src snapv1.VolumeSnapshot
+	if err := r.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, &src); err != nil {
+		return fmt.Errorf("failed to get VolumeSnapshot %s/%s of the backup: %v", namespace, name, err)
+	}
+	if !metav1.IsControlledBy(&src, backup) {
+		return fmt.Errorf("VolumeSnapshot %s/%s doesn't belong to backup %s", namespace, name, backup.Name)
+	}
+	if src.Status == nil || src.Status.ReadyToUse == nil || !*src.Status.ReadyToUse || src.Status.BoundVolumeSnapshotContentName == nil {
+		return fmt.Errorf("VolumeSnapshot %s/%s of the backup is not ready to use", namespace, name)
+	}
+	var srcContent snapv1.VolumeSnapshotContent
+	if err := r.Get(ctx, types.NamespacedName{Name: *src.Status.BoundVolumeSnapshotContentName}, &srcContent); err != nil {
+		return fmt.Errorf("failed to get VolumeSnapshotContent %s of the backup: %v", *src.Status.BoundVolumeSnapshotContentName, err)
+	}
+	if srcContent.Status == nil || srcContent.Status.SnapshotHandle == nil {
+		return fmt.Errorf("VolumeSnapshotContent %s of the backup has no snapshot handle", srcContent.Name)
+	}
+
+	content := &snapv1.VolumeSnapshotContent{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   fmt.Sprintf("%s-%s", inst.Namespace, name),
+			Labels: map[string]string{cloneInstanceLabel: inst.Name, cloneNamespaceLabel: inst.Namespace},
+		},
+		Spec: snapv1.VolumeSnapshotContentSpec{
+			VolumeSnapshotRef:       corev1.ObjectReference{Kind: "VolumeSnapshot", Namespace: inst.Namespace, Name: name},
+			DeletionPolicy:          snapv1.VolumeSnapshotContentRetain,
+			Driver:                  srcContent.Spec.Driver,
+			VolumeSnapshotClassName: srcContent.Spec.VolumeSnapshotClassName,
+			Source:                  snapv1.VolumeSnapshotContentSource{SnapshotHandle: srcContent.Status.SnapshotHandle},
+		},
+	}
+	if err := r.Create(ctx, content); err != nil && !apierrors.IsAlreadyExists(err) {
+		return fmt.Errorf("failed to create VolumeSnapshotContent %s: %v", content.Name, err)
+	}
+
+	snap := &snapv1.VolumeSnapshot{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: inst.Namespace, Labels: map[string]string{"snap": name}},
+		Spec: snapv1.VolumeSnapshotSpec{
+			Source:                  snapv1.VolumeSnapshotSource{VolumeSnapshotContentName: &content.Name},
+			VolumeSnapshotClassName: srcContent.Spec.VolumeSnapshotClassName,
+		},
+	}
+	if err := ctrl.SetControllerReference(inst, snap, r.Scheme); err != nil {
+		return err
+	}
+	if err := r.Create(ctx, snap); err != nil {
+		return fmt.Errorf("failed to create VolumeSnapshot %s/%s: %v", snap.Namespace, snap.Name, err)
+	}
+	r.Log.Info("copied a VolumeSnapshot of the backup", "from", namespace, "snapshot", name, "content", content.Name)
+	return nil
+}
+
+// deleteClonedSnapshotContents deletes the VolumeSnapshotContents copied for
+// a clone of the Instance. The storage snapshots are retained.
+func (r *InstanceReconciler) deleteClonedSnapshotContents(ctx context.Context, inst *v1alpha1.Instance) error {
+	var contents snapv1.VolumeSnapshotContentList
+	if err := r.List(ctx, &contents, client.MatchingLabels{cloneInstanceLabel: inst.Name, cloneNamespaceLabel: inst.Namespace}); err != nil {
+		// Nothing to delete in a cluster without volume snapshots.
+		if meta.IsNoMatchError(err) {
+			return nil
+		}
+		return err
+	}
+	for i := range contents.Items {
+		if err := r.Delete(ctx, &contents.Items[i]); client.IgnoreNotFound(err) != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// isProvisionedFromSnapshots returns true if all the PVCs of the Instance
+// were created from the volume snapshots of the backup to restore from.
+func (r *InstanceReconciler) isProvisionedFromSnapshots(ctx context.Context, inst v1alpha1.Instance, sp controllers.StsParams) (bool, error) {
+	sp.Restore = inst.Spec.Restore
+	newPVCs, err := controllers.NewPVCs(sp)
+	if err != nil {
+		return false, err
+	}
+	for _, pvc := range newPVCs {
+		var existing corev1.PersistentVolumeClaim
+		key := types.NamespacedName{Namespace: pvc.Namespace, Name: fmt.Sprintf("%s-%s-0", pvc.Name, sp.StsName)}
+		if err := r.Get(ctx, key, &existing); err != nil {
+			return false, client.IgnoreNotFound(err)
+		}
+		if existing.Spec.DataSource == nil || existing.Spec.DataSource.Name != pvc.Spec.DataSource.Name {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// changeDatabaseIdentity starts an LRO giving the database of a clone a new
+// DBID and renaming it to the CDB name of the Instance.
+func (r *InstanceReconciler) changeDatabaseIdentity(ctx context.Context, inst *v1alpha1.Instance) (ctrl.Result, error) {
+	// Update the status first, so that a restore isn't reported as complete
+	// if the LRO is started but the status update fails.
+	k8s.InstanceUpsertCondition(&inst.Status, k8s.Ready, metav1.ConditionFalse, k8s.RestoreNewIdentityInProgress, "")
+	if err := r.Status().Update(ctx, inst); err != nil {
+		return ctrl.Result{}, err
+	}
+	r.Log.Info("restoreStateMachine: RestoreInProgress->RestoreNewIdentityInProgress")
+
+	caClient, closeConn, err := r.ClientFactory.New(ctx, r, inst.Namespace, inst.Name)
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to create config agent client: %v", err)
+	}
+	defer closeConn()
+	operation, err := caClient.ChangeDatabaseIdentity(ctx, &capb.ChangeDatabaseIdentityRequest{
+		CdbName:  inst.Spec.CDBName,
+		LroInput: &capb.LROInput{OperationId: lroRestoreOperationID(changeDatabaseIdentity, *inst)},
+	})
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed on ChangeDatabaseIdentity gRPC call: %v", err)
+	}
+	if operation.GetDone() {
+		return r.databaseIdentityChanged(ctx, inst, operation)
+	}
+	return ctrl.Result{Requeue: true}, nil
+}
+
+// checkDatabaseIdentityChanged polls the LRO started by
+// changeDatabaseIdentity.
+func (r *InstanceReconciler) checkDatabaseIdentityChanged(ctx context.Context, inst *v1alpha1.Instance) (ctrl.Result, error) {
+	id := lroRestoreOperationID(changeDatabaseIdentity, *inst)
+	operation, err := controllers.GetLROOperation(r.ClientFactory, ctx, r, inst.Namespace, id, inst.Name)
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			// NID may have been interrupted, the state of the database is unknown.
+			r.setRestoreFailed(ctx, inst, fmt.Sprintf("the LRO %s giving the clone a new DBID was lost, restore the clone again", id))
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, err
+	}
+	if !operation.GetDone() {
+		r.Log.Info("database identity change still in progress, waiting")
+		return ctrl.Result{RequeueAfter: 10 * time.Second}, nil
+	}
+	return r.databaseIdentityChanged(ctx, inst, operation)
+}
+
+// databaseIdentityChanged completes the restore of a clone once the LRO
+// started by changeDatabaseIdentity is done.
+func (r *InstanceReconciler) databaseIdentityChanged(ctx context.Context, inst *v1alpha1.Instance, operation *lropb.Operation) (ctrl.Result, error) {
+	id := lroRestoreOperationID(changeDatabaseIdentity, *inst)
+	if err := controllers.DeleteLROOperation(r.ClientFactory, ctx, r, inst.Namespace, id, inst.Name); err != nil {
+		r.Log.Error(err, "failed to delete the LRO", "id", id)
+	}
+	if operation.GetError() != nil {
+		r.setRestoreFailed(ctx, inst, fmt.Sprintf("failed to give the clone a new DBID: %s", operation.GetError().GetMessage()))
+		return ctrl.Result{}, nil
+	}
+	resp := &dbdpb.ChangeDatabaseIdentityResponse{}
+	if err := operation.GetResponse().UnmarshalTo(resp); err != nil {
+		r.Log.Error(err, "failed to read the ChangeDatabaseIdentity response")
+	} else {
+		r.Recorder.Eventf(inst, corev1.EventTypeNormal, "DatabaseIdentityChanged", "Database %s of the clone has a new DBID %d", inst.Spec.CDBName, resp.GetDbid())
+	}
+	r.Log.Info("restoreStateMachine: RestoreNewIdentityInProgress->RestoreComplete")
+	r.setRestoreSucceeded(ctx, inst, restoreDescription(inst))
+	return ctrl.Result{}, nil
+}
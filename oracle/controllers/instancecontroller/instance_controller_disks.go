@@ -0,0 +1,219 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package instancecontroller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1alpha1 "github.com/GoogleCloudPlatform/elcarro-oracle-operator/oracle/api/v1alpha1"
+	"github.com/GoogleCloudPlatform/elcarro-oracle-operator/oracle/controllers"
+	"github.com/GoogleCloudPlatform/elcarro-oracle-operator/oracle/pkg/k8s"
+)
+
+// diskResizeRequeueInterval is the interval at which the progress of a
+// resize is checked.
+var diskResizeRequeueInterval = 30 * time.Second
+
+// fileSystemResizeTimeout is how long the file system resize of an expanded
+// volume may be pending before a restart of the pod is reported as needed.
+// Drivers that support online expansion resize the file system of a mounted
+// volume, the others only when the volume is mounted again.
+var fileSystemResizeTimeout = 5 * time.Minute
+
+// validateDiskSizes rejects a spec that shrinks a disk below the size of its
+// PVC, volumes cannot be shrunk. The rejection is reported by the DisksReady
+// condition and the spec is not reconciled any further until it is fixed.
+func (r *InstanceReconciler) validateDiskSizes(ctx context.Context, inst *v1alpha1.Instance, sp controllers.StsParams) (bool, error) {
+	newPVCs, err := controllers.NewPVCs(sp)
+	if err != nil {
+		return false, err
+	}
+
+	var shrunk []string
+	for _, newPVC := range newPVCs {
+		pvc, err := r.databasePVC(ctx, inst, sp, newPVC.Name)
+		if err != nil {
+			return false, err
+		}
+		if pvc == nil {
+			continue
+		}
+		size := newPVC.Spec.Resources.Requests[corev1.ResourceStorage]
+		requested := pvc.Spec.Resources.Requests[corev1.ResourceStorage]
+		if size.Cmp(requested) < 0 {
+			shrunk = append(shrunk, fmt.Sprintf("%s cannot be shrunk from %s to %s", pvc.Name, requested.String(), size.String()))
+		}
+	}
+	if len(shrunk) == 0 {
+		return true, nil
+	}
+
+	msg := strings.Join(shrunk, "; ")
+	if cond := k8s.FindCondition(inst.Status.Conditions, k8s.DisksReady); !k8s.ConditionReasonEquals(cond, k8s.DiskResizeFailed) || cond.Message != msg {
+		r.Recorder.Event(inst, corev1.EventTypeWarning, k8s.DiskResizeFailed, msg)
+	}
+	k8s.InstanceUpsertCondition(&inst.Status, k8s.DisksReady, v1.ConditionFalse, k8s.DiskResizeFailed, msg)
+	return false, nil
+}
+
+// databasePVC returns the PVC of the database StatefulSet created from the
+// volumeClaimTemplate name, or nil if it doesn't exist yet.
+func (r *InstanceReconciler) databasePVC(ctx context.Context, inst *v1alpha1.Instance, sp controllers.StsParams, name string) (*corev1.PersistentVolumeClaim, error) {
+	pvc := &corev1.PersistentVolumeClaim{}
+	key := client.ObjectKey{Namespace: inst.Namespace, Name: fmt.Sprintf("%s-%s-0", name, sp.StsName)}
+	if err := r.Get(ctx, key, pvc); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return pvc, nil
+}
+
+// reconcileDiskResize expands the PVCs of the database StatefulSet to the
+// disk sizes in the spec. The volumeClaimTemplates of a StatefulSet are
+// immutable, so the PVCs are patched directly, which requires a StorageClass
+// that allows volume expansion. Shrinking is rejected by validateDiskSizes.
+// The progress is reported by the DisksReady condition, which is added on the
+// first resize. A file system resize that waits for the volume to be mounted
+// again is reported as requiring a restart of the pod.
+func (r *InstanceReconciler) reconcileDiskResize(ctx context.Context, inst *v1alpha1.Instance, sp controllers.StsParams, log logr.Logger) (ctrl.Result, error) {
+	newPVCs, err := controllers.NewPVCs(sp)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	var resizing, restart, failed []string
+	for _, newPVC := range newPVCs {
+		pvc, err := r.databasePVC(ctx, inst, sp, newPVC.Name)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		if pvc == nil {
+			continue
+		}
+
+		size := newPVC.Spec.Resources.Requests[corev1.ResourceStorage]
+		requested := pvc.Spec.Resources.Requests[corev1.ResourceStorage]
+		if size.Cmp(requested) > 0 {
+			expandable, err := r.allowsVolumeExpansion(ctx, pvc.Spec.StorageClassName)
+			if err != nil {
+				return ctrl.Result{}, err
+			}
+			if !expandable {
+				failed = append(failed, fmt.Sprintf("%s cannot be resized, its StorageClass doesn't allow volume expansion", pvc.Name))
+				continue
+			}
+			patch := client.MergeFrom(pvc.DeepCopy())
+			pvc.Spec.Resources.Requests[corev1.ResourceStorage] = size
+			if err := r.Patch(ctx, pvc, patch); err != nil {
+				log.Error(err, "failed to resize the PVC", "pvc", pvc.Name)
+				return ctrl.Result{}, err
+			}
+			log.Info("PVC resize requested", "pvc", pvc.Name, "from", requested.String(), "to", size.String())
+			r.Recorder.Eventf(inst, corev1.EventTypeNormal, k8s.DiskResizeInProgress, "Resizing %s from %s to %s", pvc.Name, requested.String(), size.String())
+		}
+
+		if capacity := pvc.Status.Capacity[corev1.ResourceStorage]; pvc.Status.Phase == corev1.ClaimBound && capacity.Cmp(size) < 0 {
+			if isFileSystemResizeStuck(pvc) {
+				restart = append(restart, pvc.Name)
+			} else {
+				resizing = append(resizing, pvc.Name)
+			}
+		}
+	}
+
+	var result ctrl.Result
+	if len(resizing) > 0 || len(restart) > 0 {
+		result = ctrl.Result{RequeueAfter: diskResizeRequeueInterval}
+	}
+	cond := k8s.FindCondition(inst.Status.Conditions, k8s.DisksReady)
+	switch {
+	case len(failed) > 0:
+		msg := strings.Join(failed, "; ")
+		if !k8s.ConditionReasonEquals(cond, k8s.DiskResizeFailed) || cond.Message != msg {
+			r.Recorder.Event(inst, corev1.EventTypeWarning, k8s.DiskResizeFailed, msg)
+		}
+		k8s.InstanceUpsertCondition(&inst.Status, k8s.DisksReady, v1.ConditionFalse, k8s.DiskResizeFailed, msg)
+	case len(restart) > 0:
+		msg := fmt.Sprintf("The file systems of %s are resized once the volumes are mounted again, restart the pod %s-0 to complete the resize", strings.Join(restart, ", "), sp.StsName)
+		if !k8s.ConditionReasonEquals(cond, k8s.DiskResizeRestartRequired) || cond.Message != msg {
+			r.Recorder.Event(inst, corev1.EventTypeWarning, k8s.DiskResizeRestartRequired, msg)
+		}
+		k8s.InstanceUpsertCondition(&inst.Status, k8s.DisksReady, v1.ConditionFalse, k8s.DiskResizeRestartRequired, msg)
+	case len(resizing) > 0:
+		k8s.InstanceUpsertCondition(&inst.Status, k8s.DisksReady, v1.ConditionFalse, k8s.DiskResizeInProgress, fmt.Sprintf("Resizing %s", strings.Join(resizing, ", ")))
+	case cond != nil:
+		k8s.InstanceUpsertCondition(&inst.Status, k8s.DisksReady, v1.ConditionTrue, k8s.DiskResizeComplete, "")
+	}
+	return result, nil
+}
+
+// isFileSystemResizeStuck returns true if the volume of pvc is expanded and
+// its file system resize has been pending for longer than
+// fileSystemResizeTimeout, which is the case for drivers that only support
+// offline expansion.
+func isFileSystemResizeStuck(pvc *corev1.PersistentVolumeClaim) bool {
+	for _, c := range pvc.Status.Conditions {
+		if c.Type == corev1.PersistentVolumeClaimFileSystemResizePending && c.Status == corev1.ConditionTrue {
+			return time.Since(c.LastTransitionTime.Time) > fileSystemResizeTimeout
+		}
+	}
+	return false
+}
+
+func (r *InstanceReconciler) allowsVolumeExpansion(ctx context.Context, storageClassName *string) (bool, error) {
+	if storageClassName == nil || *storageClassName == "" {
+		return false, nil
+	}
+	sc := &storagev1.StorageClass{}
+	if err := r.Get(ctx, client.ObjectKey{Name: *storageClassName}, sc); err != nil {
+		if apierrors.IsNotFound(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	return sc.AllowVolumeExpansion != nil && *sc.AllowVolumeExpansion, nil
+}
+
+// keepVolumeClaimSizes sets the storage requests of the volumeClaimTemplates of
+// sts to the ones of the existing StatefulSet. The volumeClaimTemplates are
+// immutable, resized disks are handled by reconcileDiskResize instead.
+func (r *InstanceReconciler) keepVolumeClaimSizes(ctx context.Context, sts *appsv1.StatefulSet) error {
+	existing := &appsv1.StatefulSet{}
+	if err := r.Get(ctx, client.ObjectKey{Namespace: sts.Namespace, Name: sts.Name}, existing); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+	for i := range sts.Spec.VolumeClaimTemplates {
+		for _, t := range existing.Spec.VolumeClaimTemplates {
+			if t.Name == sts.Spec.VolumeClaimTemplates[i].Name {
+				sts.Spec.VolumeClaimTemplates[i].Spec.Resources = t.Spec.Resources
+			}
+		}
+	}
+	return nil
+}
@@ -117,7 +117,7 @@ func fetchCurrentParameterState(ctx context.Context, caClient capb.ConfigAgentCl
 	}
 
 	// If restart is required, check if the restartTimeRange is specified in the config.
-	if restartRequired && !maintenance.HasValidTimeRanges(spec.MaintenanceWindow) {
+	if restartRequired && !maintenance.HasValidWindows(spec.MaintenanceWindow) {
 		return nil, nil, errors.New("maintenanceWindow for db downtime not specified for static parameter update")
 	}
 
@@ -234,7 +234,7 @@ func (r *InstanceReconciler) getConfigAgentClient(ctx context.Context, req ctrl.
 		return nil, nil, err
 	}
 
-	conn, err := grpc.Dial(fmt.Sprintf("%s:%d", agentSvc.Spec.ClusterIP, consts.DefaultConfigAgentPort), grpc.WithInsecure())
+	conn, err := controllers.DialConfigAgent(ctx, r, inst.Namespace, inst.Name, fmt.Sprintf("%s:%d", agentSvc.Spec.ClusterIP, consts.DefaultConfigAgentPort))
 	if err != nil {
 		// We'll retry the reconcile if its due to transient connection errors
 		log.Error(err, "setInstanceParameterStateMachine: failed to create a conn via gRPC.Dial")
@@ -282,7 +282,7 @@ func (r *InstanceReconciler) initiateRecovery(ctx context.Context, inst v1alpha1
 }
 
 func (r *InstanceReconciler) sanityCheckTimeRange(inst v1alpha1.Instance, log logr.Logger) (ctrl.Result, error) {
-	if !maintenance.HasValidTimeRanges(inst.Spec.MaintenanceWindow) {
+	if !maintenance.HasValidWindows(inst.Spec.MaintenanceWindow) {
 		return ctrl.Result{}, fmt.Errorf("MaintenanceWindow specification is not valid: %+v", inst.Spec.MaintenanceWindow)
 	}
 
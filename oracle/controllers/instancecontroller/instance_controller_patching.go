@@ -0,0 +1,365 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package instancecontroller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	commonv1alpha1 "github.com/GoogleCloudPlatform/elcarro-oracle-operator/common/api/v1alpha1"
+	v1alpha1 "github.com/GoogleCloudPlatform/elcarro-oracle-operator/oracle/api/v1alpha1"
+	"github.com/GoogleCloudPlatform/elcarro-oracle-operator/oracle/controllers"
+	"github.com/GoogleCloudPlatform/elcarro-oracle-operator/oracle/controllers/databasecontroller"
+	capb "github.com/GoogleCloudPlatform/elcarro-oracle-operator/oracle/pkg/agents/config_agent/protos"
+	"github.com/GoogleCloudPlatform/elcarro-oracle-operator/oracle/pkg/k8s"
+)
+
+// patchingTimeout limits how long the patched StatefulSet may take to come
+// up before the patching is rolled back.
+const patchingTimeout = 30 * time.Minute
+
+// isPatchingEnabled returns true if the Patching service is enabled for the
+// instance.
+func isPatchingEnabled(inst *v1alpha1.Instance) bool {
+	return inst.Spec.Services[commonv1alpha1.Patching]
+}
+
+// patchedServiceImage returns the service image requested for the instance,
+// spec.patching.patchedServiceImage takes precedence over the service image
+// of spec.images and the Config.
+func patchedServiceImage(inst *v1alpha1.Instance, serviceImage string) string {
+	if inst.Spec.Patching != nil && inst.Spec.Patching.PatchedServiceImage != "" {
+		return inst.Spec.Patching.PatchedServiceImage
+	}
+	return serviceImage
+}
+
+// patchingStateMachine rolls out a new service image to a ready instance.
+// State transition:
+// CreateComplete -> PatchingBackupInProgress -> StatefulSetPatchingInProgress ->
+// -> DatabasePatchingInProgress -> CreateComplete
+// or ... -> PatchingRollbackInProgress -> PatchingRollbackRestoreInProgress -> CreateComplete
+// A failed rollback ends in PatchingRollbackFailed, which needs manual action.
+// The Ready condition stays true until the pre-patch Snapshot backup is taken,
+// the backup controller only backs up a ready instance.
+// Returns
+// * non-empty result if patching state machine needs another reconcile
+// * non-empty error if any error occurred
+// * empty result and error to continue with main reconciliation loop
+func (r *InstanceReconciler) patchingStateMachine(ctx context.Context, inst *v1alpha1.Instance, sp controllers.StsParams, serviceImage string, log logr.Logger) (ctrl.Result, error) {
+	instanceReadyCond := k8s.FindCondition(inst.Status.Conditions, k8s.Ready)
+	dbInstanceCond := k8s.FindCondition(inst.Status.Conditions, k8s.DatabaseInstanceReady)
+	if instanceReadyCond == nil || !k8s.ConditionStatusEquals(dbInstanceCond, v1.ConditionTrue) {
+		return ctrl.Result{}, nil
+	}
+
+	switch instanceReadyCond.Reason {
+	case k8s.PatchingBackupInProgress:
+		return r.patchingBackupInProgress(ctx, inst, sp, log)
+	case k8s.StatefulSetPatchingInProgress:
+		return r.statefulSetPatchingInProgress(ctx, inst, log)
+	case k8s.DatabasePatchingInProgress:
+		return r.databasePatchingInProgress(ctx, inst, log)
+	case k8s.PatchingRollbackInProgress:
+		return r.patchingRollbackInProgress(ctx, inst, sp, log)
+	case k8s.PatchingRollbackRestoreInProgress:
+		return r.patchingRollbackRestoreInProgress(ctx, inst, log)
+	case k8s.PatchingRollbackFailed:
+		log.Info("patchingStateMachine: rollback failed, the instance needs to be recovered manually")
+		return ctrl.Result{}, nil
+	}
+
+	// Entry point for the patching process.
+	image := patchedServiceImage(inst, serviceImage)
+	if !isPatchingEnabled(inst) || !k8s.ConditionStatusEquals(instanceReadyCond, v1.ConditionTrue) || inst.Spec.Restore != nil ||
+		inst.Status.CurrentServiceImage == "" || inst.Status.CurrentServiceImage == image || inst.Status.LastFailedServiceImage == image {
+		return ctrl.Result{}, nil
+	}
+	if result, err := r.sanityCheckTimeRange(*inst, log); err != nil {
+		log.Info("patchingStateMachine: waiting for a maintenance window", "serviceImage", image, "reason", err.Error())
+		return result, nil
+	}
+
+	inst.Status.Patching = &v1alpha1.PatchingStatus{
+		ServiceImage:         image,
+		PreviousServiceImage: inst.Status.CurrentServiceImage,
+		BackupName:           fmt.Sprintf("%s-prepatch-%s", inst.Name, time.Now().Format("20060102150405")),
+	}
+	msg := fmt.Sprintf("Patching to service image %s: taking a Snapshot backup %s", image, inst.Status.Patching.BackupName)
+	r.recordEventAndUpdateStatus(ctx, inst, v1.ConditionTrue, k8s.PatchingBackupInProgress, msg, log)
+	log.Info("patchingStateMachine: CreateComplete -> PatchingBackupInProgress")
+	return ctrl.Result{Requeue: true}, nil
+}
+
+// patchingBackupInProgress takes the pre-patch Snapshot backup and rolls out
+// the patched service image once the backup is ready.
+func (r *InstanceReconciler) patchingBackupInProgress(ctx context.Context, inst *v1alpha1.Instance, sp controllers.StsParams, log logr.Logger) (ctrl.Result, error) {
+	st := inst.Status.Patching
+	backup := &v1alpha1.Backup{}
+	err := r.Get(ctx, types.NamespacedName{Namespace: inst.Namespace, Name: st.BackupName}, backup)
+	if errors.IsNotFound(err) {
+		backup = &v1alpha1.Backup{
+			ObjectMeta: v1.ObjectMeta{Namespace: inst.Namespace, Name: st.BackupName},
+			Spec: v1alpha1.BackupSpec{
+				BackupSpec: commonv1alpha1.BackupSpec{
+					Instance: inst.Name,
+					Type:     commonv1alpha1.BackupTypeSnapshot,
+				},
+				Subtype: "Instance",
+			},
+		}
+		if err := r.Create(ctx, backup); err != nil {
+			return ctrl.Result{}, err
+		}
+		log.Info("patchingStateMachine: pre-patch backup created", "backup", st.BackupName)
+		return ctrl.Result{RequeueAfter: 10 * time.Second}, nil
+	}
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	backupReadyCond := k8s.FindCondition(backup.Status.Conditions, k8s.Ready)
+	if k8s.ConditionReasonEquals(backupReadyCond, k8s.BackupFailed) || k8s.ConditionReasonEquals(backupReadyCond, k8s.BackupCancelled) {
+		r.setPatchingFailed(ctx, inst, fmt.Sprintf("Pre-patch backup %s failed: %s", st.BackupName, backupReadyCond.Message), log)
+		return ctrl.Result{Requeue: true}, nil
+	}
+	if !k8s.ConditionStatusEquals(backupReadyCond, v1.ConditionTrue) {
+		log.Info("patchingStateMachine: pre-patch backup in progress, waiting", "backup", st.BackupName)
+		return ctrl.Result{RequeueAfter: 10 * time.Second}, nil
+	}
+
+	// The backup may take a while, check again that the database can be
+	// restarted now.
+	if result, err := r.sanityCheckTimeRange(*inst, log); err != nil {
+		log.Info("patchingStateMachine: waiting for a maintenance window", "reason", err.Error())
+		return result, nil
+	}
+
+	// Update status and commit it to k8s before the StatefulSet is patched.
+	msg := fmt.Sprintf("Patching to service image %s: rolling out the StatefulSet", st.ServiceImage)
+	r.recordEventAndUpdateStatus(ctx, inst, v1.ConditionFalse, k8s.StatefulSetPatchingInProgress, msg, log)
+	if err := r.applyStatefulSet(ctx, *inst, withServiceImage(sp, st.ServiceImage)); err != nil {
+		return ctrl.Result{}, err
+	}
+	log.Info("patchingStateMachine: PatchingBackupInProgress -> StatefulSetPatchingInProgress")
+	return ctrl.Result{RequeueAfter: 10 * time.Second}, nil
+}
+
+// statefulSetPatchingInProgress waits for the database to come up with the
+// patched service image.
+func (r *InstanceReconciler) statefulSetPatchingInProgress(ctx context.Context, inst *v1alpha1.Instance, log logr.Logger) (ctrl.Result, error) {
+	st := inst.Status.Patching
+	done, err := isStatefulSetPatched(ctx, r, inst, st.ServiceImage)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if !done {
+		elapsed := k8s.ElapsedTimeFromLastTransitionTime(k8s.FindCondition(inst.Status.Conditions, k8s.Ready), time.Second)
+		if elapsed < patchingTimeout {
+			log.Info("patchingStateMachine: StatefulSet rollout in progress, waiting", "elapsed", elapsed)
+			return ctrl.Result{RequeueAfter: 10 * time.Second}, nil
+		}
+		r.startPatchingRollback(ctx, inst, fmt.Sprintf("the database did not come up with service image %s within %v", st.ServiceImage, patchingTimeout), log)
+		return ctrl.Result{Requeue: true}, nil
+	}
+
+	inst.Status.CurrentServiceImage = st.ServiceImage
+	msg := fmt.Sprintf("Patching to service image %s: running datapatch", st.ServiceImage)
+	r.recordEventAndUpdateStatus(ctx, inst, v1.ConditionFalse, k8s.DatabasePatchingInProgress, msg, log)
+	log.Info("patchingStateMachine: StatefulSetPatchingInProgress -> DatabasePatchingInProgress")
+	return ctrl.Result{Requeue: true}, nil
+}
+
+// databasePatchingInProgress applies the SQL changes of the patches to the
+// database.
+func (r *InstanceReconciler) databasePatchingInProgress(ctx context.Context, inst *v1alpha1.Instance, log logr.Logger) (ctrl.Result, error) {
+	st := inst.Status.Patching
+	caClient, closeConn, err := r.ClientFactory.New(ctx, r, inst.Namespace, inst.Name)
+	if err != nil {
+		log.Error(err, "failed to create config agent client")
+		return ctrl.Result{}, err
+	}
+	defer closeConn()
+
+	resp, err := caClient.ApplyDatapatch(ctx, &capb.ApplyDatapatchRequest{})
+	if err != nil {
+		r.startPatchingRollback(ctx, inst, fmt.Sprintf("datapatch failed: %v", err), log)
+		return ctrl.Result{Requeue: true}, nil
+	}
+	var patches []string
+	for _, p := range resp.GetPatches() {
+		patches = append(patches, fmt.Sprintf("%d", p.GetPatchId()))
+	}
+
+	inst.Status.Patching = nil
+	msg := fmt.Sprintf("Patched to service image %s, SQL patches applied: [%s]", st.ServiceImage, strings.Join(patches, ", "))
+	r.recordEventAndUpdateStatus(ctx, inst, v1.ConditionTrue, k8s.CreateComplete, msg, log)
+	log.Info("patchingStateMachine: DatabasePatchingInProgress -> CreateComplete")
+	return ctrl.Result{Requeue: true}, nil
+}
+
+// patchingRollbackInProgress replaces the StatefulSet and its PVCs with the
+// ones of the previous service image, restored from the pre-patch backup.
+func (r *InstanceReconciler) patchingRollbackInProgress(ctx context.Context, inst *v1alpha1.Instance, sp controllers.StsParams, log logr.Logger) (ctrl.Result, error) {
+	st := inst.Status.Patching
+	backup := &v1alpha1.Backup{}
+	if err := r.Get(ctx, types.NamespacedName{Namespace: inst.Namespace, Name: st.BackupName}, backup); err != nil || backup.Status.BackupID == "" {
+		msg := fmt.Sprintf("Rollback of the patching to service image %s failed, pre-patch backup %s not found: %v", st.ServiceImage, st.BackupName, err)
+		r.recordEventAndUpdateStatus(ctx, inst, v1.ConditionFalse, k8s.PatchingRollbackFailed, msg, log)
+		return ctrl.Result{}, nil
+	}
+
+	sp = withServiceImage(sp, st.PreviousServiceImage)
+	done, err := r.cleanupSTSandPVCs(ctx, *inst, sp)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if !done {
+		log.Info("patchingStateMachine: STS/PVC removal in progress, waiting")
+		return ctrl.Result{RequeueAfter: 5 * time.Second}, nil
+	}
+
+	// Update status and commit it to k8s before the StatefulSet is restored.
+	inst.Status.CurrentServiceImage = st.PreviousServiceImage
+	k8s.InstanceUpsertCondition(&inst.Status, k8s.Ready, v1.ConditionFalse, k8s.PatchingRollbackRestoreInProgress, "")
+	if err := r.Status().Update(ctx, inst); err != nil {
+		return ctrl.Result{}, err
+	}
+	sp.Restore = &v1alpha1.RestoreSpec{BackupType: commonv1alpha1.BackupTypeSnapshot, BackupID: backup.Status.BackupID}
+	if err := r.applyStatefulSet(ctx, *inst, sp); err != nil {
+		return ctrl.Result{}, err
+	}
+	log.Info("patchingStateMachine: PatchingRollbackInProgress -> PatchingRollbackRestoreInProgress")
+	return ctrl.Result{RequeueAfter: 10 * time.Second}, nil
+}
+
+// patchingRollbackRestoreInProgress waits for the database to come up with
+// the previous service image.
+func (r *InstanceReconciler) patchingRollbackRestoreInProgress(ctx context.Context, inst *v1alpha1.Instance, log logr.Logger) (ctrl.Result, error) {
+	st := inst.Status.Patching
+	done, err := isStatefulSetPatched(ctx, r, inst, st.PreviousServiceImage)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if !done {
+		log.Info("patchingStateMachine: restore from the pre-patch backup in progress, waiting")
+		return ctrl.Result{RequeueAfter: 10 * time.Second}, nil
+	}
+
+	inst.Status.LastFailedServiceImage = st.ServiceImage
+	inst.Status.Patching = nil
+	msg := fmt.Sprintf("Patching to service image %s rolled back to service image %s", st.ServiceImage, st.PreviousServiceImage)
+	r.recordEventAndUpdateStatus(ctx, inst, v1.ConditionTrue, k8s.CreateComplete, msg, log)
+	log.Info("patchingStateMachine: PatchingRollbackRestoreInProgress -> CreateComplete")
+	return ctrl.Result{Requeue: true}, nil
+}
+
+// startPatchingRollback records the patching failure and starts the rollback.
+func (r *InstanceReconciler) startPatchingRollback(ctx context.Context, inst *v1alpha1.Instance, reason string, log logr.Logger) {
+	msg := fmt.Sprintf("Patching to service image %s failed, rolling back: %s", inst.Status.Patching.ServiceImage, reason)
+	r.recordEventAndUpdateStatus(ctx, inst, v1.ConditionFalse, k8s.PatchingRollbackInProgress, msg, log)
+	log.Info("patchingStateMachine: -> PatchingRollbackInProgress", "reason", reason)
+}
+
+// setPatchingFailed records a patching failure, which left the instance
+// untouched.
+func (r *InstanceReconciler) setPatchingFailed(ctx context.Context, inst *v1alpha1.Instance, reason string, log logr.Logger) {
+	inst.Status.LastFailedServiceImage = inst.Status.Patching.ServiceImage
+	msg := fmt.Sprintf("Patching to service image %s failed: %s", inst.Status.Patching.ServiceImage, reason)
+	inst.Status.Patching = nil
+	r.recordEventAndUpdateStatus(ctx, inst, v1.ConditionTrue, k8s.CreateComplete, msg, log)
+	log.Info("patchingStateMachine: -> CreateComplete", "reason", reason)
+}
+
+// applyStatefulSet applies the StatefulSet built from the sts params.
+func (r *InstanceReconciler) applyStatefulSet(ctx context.Context, inst v1alpha1.Instance, sp controllers.StsParams) error {
+	err, sts, _ := r.constructSTSandPVCs(ctx, inst, sp)
+	if err != nil {
+		return err
+	}
+	applyOpts := []client.PatchOption{client.ForceOwnership, client.FieldOwner("instance-controller")}
+	if err := r.Patch(ctx, sts, client.Apply, applyOpts...); err != nil {
+		r.Log.Error(err, "failed to patch the StatefulSet")
+		return err
+	}
+	return nil
+}
+
+// withServiceImage returns a copy of the sts params with the service image
+// replaced.
+func withServiceImage(sp controllers.StsParams, image string) controllers.StsParams {
+	images := make(map[string]string, len(sp.Images))
+	for k, v := range sp.Images {
+		images[k] = v
+	}
+	images["service"] = image
+	sp.Images = images
+	return sp
+}
+
+// isStatefulSetPatched returns true once the StatefulSet is rolled out with
+// the service image and its database container is ready.
+// extracted for testing.
+var isStatefulSetPatched = func(ctx context.Context, r *InstanceReconciler, inst *v1alpha1.Instance, image string) (bool, error) {
+	sts, done, err := statefulSetRolledOut(ctx, r, inst)
+	if err != nil || !done {
+		return false, err
+	}
+	for _, c := range sts.Spec.Template.Spec.Containers {
+		if c.Image == image {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// statefulSetServiceImage returns the image of the database container of
+// the StatefulSet of the instance, empty if the StatefulSet doesn't exist yet.
+func (r *InstanceReconciler) statefulSetServiceImage(ctx context.Context, inst *v1alpha1.Instance) (string, error) {
+	sts := &appsv1.StatefulSet{}
+	if err := r.Get(ctx, types.NamespacedName{Namespace: inst.Namespace, Name: fmt.Sprintf(controllers.StsName, inst.Name)}, sts); err != nil {
+		return "", client.IgnoreNotFound(err)
+	}
+	for _, c := range sts.Spec.Template.Spec.Containers {
+		if c.Name == databasecontroller.DatabaseContainerName {
+			return c.Image, nil
+		}
+	}
+	return "", nil
+}
+
+// statefulSetRolledOut returns the StatefulSet of the instance and true once
+// its latest revision is rolled out and its database container is ready.
+func statefulSetRolledOut(ctx context.Context, r *InstanceReconciler, inst *v1alpha1.Instance) (*appsv1.StatefulSet, bool, error) {
+	sts := &appsv1.StatefulSet{}
+	if err := r.Get(ctx, types.NamespacedName{Namespace: inst.Namespace, Name: fmt.Sprintf(controllers.StsName, inst.Name)}, sts); err != nil {
+		return nil, false, client.IgnoreNotFound(err)
+	}
+	if sts.Status.ObservedGeneration < sts.Generation || sts.Status.UpdateRevision != sts.Status.CurrentRevision {
+		return sts, false, nil
+	}
+	_, err := r.statusProgress(ctx, inst.Namespace, sts.Name)
+	return sts, err == nil, nil
+}
@@ -0,0 +1,460 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package instancecontroller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	v1alpha1 "github.com/GoogleCloudPlatform/elcarro-oracle-operator/oracle/api/v1alpha1"
+	"github.com/GoogleCloudPlatform/elcarro-oracle-operator/oracle/controllers"
+	capb "github.com/GoogleCloudPlatform/elcarro-oracle-operator/oracle/pkg/agents/config_agent/protos"
+	"github.com/GoogleCloudPlatform/elcarro-oracle-operator/oracle/pkg/agents/consts"
+	"github.com/GoogleCloudPlatform/elcarro-oracle-operator/oracle/pkg/k8s"
+)
+
+const (
+	replicationStatusInterval = time.Minute
+	createStandbyPollInterval = 30 * time.Second
+
+	// Database roles as reported by v$database.
+	primaryDatabaseRole         = "PRIMARY"
+	physicalStandbyDatabaseRole = "PHYSICAL STANDBY"
+)
+
+// reconcileReplication builds a Data Guard physical standby of the primary
+// Instance, performs the requested role transitions and tracks the role and
+// the lag of the standby. It is invoked for a provisioned Instance, whose
+// database is replaced by the duplicate of the primary database.
+// The instance status is expected to be persisted by the caller.
+func (r *InstanceReconciler) reconcileReplication(ctx context.Context, inst *v1alpha1.Instance, log logr.Logger) (ctrl.Result, error) {
+	// After a failover the Instance is the primary database, the former
+	// primary has to be rebuilt and no further role transitions are performed.
+	if st := inst.Status.Replication; st != nil && st.ReinstateRequired {
+		return r.updateReplicationStatus(ctx, inst, log)
+	}
+
+	var primary v1alpha1.Instance
+	if err := r.Get(ctx, types.NamespacedName{Namespace: inst.Namespace, Name: inst.Spec.Replication.PrimaryInstance}, &primary); err != nil {
+		k8s.InstanceUpsertCondition(&inst.Status, k8s.ReplicationReady, v1.ConditionFalse, k8s.CreatePending, fmt.Sprintf("Failed to find the primary instance %q: %v", inst.Spec.Replication.PrimaryInstance, err))
+		return ctrl.Result{RequeueAfter: replicationStatusInterval}, nil
+	}
+
+	cond := k8s.FindCondition(inst.Status.Conditions, k8s.ReplicationReady)
+	switch {
+	case cond == nil || cond.Reason == k8s.CreatePending:
+		return r.createStandby(ctx, inst, &primary, log)
+	case cond.Reason == k8s.CreateInProgress:
+		return r.checkStandbyCreated(ctx, inst, &primary, log)
+	case cond.Reason == k8s.CreateFailed:
+		// Rebuilding a standby overwrites its database, it is not retried.
+		return ctrl.Result{}, nil
+	}
+
+	if isRoleTransitionRequested(inst) {
+		r.transitionRole(ctx, inst, &primary, log)
+	}
+	return r.updateReplicationStatus(ctx, inst, log)
+}
+
+// createStandby starts the RMAN duplication of the primary database once the
+// primary is ready.
+func (r *InstanceReconciler) createStandby(ctx context.Context, inst, primary *v1alpha1.Instance, log logr.Logger) (ctrl.Result, error) {
+	if primary.Spec.CDBName != inst.Spec.CDBName {
+		msg := fmt.Sprintf("CDB name %q of the standby does not match CDB name %q of the primary instance %q", inst.Spec.CDBName, primary.Spec.CDBName, primary.Name)
+		k8s.InstanceUpsertCondition(&inst.Status, k8s.ReplicationReady, v1.ConditionFalse, k8s.CreateFailed, msg)
+		r.Recorder.Event(inst, corev1.EventTypeWarning, k8s.CreateFailed, msg)
+		return ctrl.Result{}, nil
+	}
+	if !k8s.ConditionStatusEquals(k8s.FindCondition(primary.Status.Conditions, k8s.DatabaseInstanceReady), v1.ConditionTrue) {
+		k8s.InstanceUpsertCondition(&inst.Status, k8s.ReplicationReady, v1.ConditionFalse, k8s.CreatePending, fmt.Sprintf("Waiting for the database of the primary instance %q", primary.Name))
+		return ctrl.Result{RequeueAfter: replicationStatusInterval}, nil
+	}
+
+	password, err := r.sysPassword(ctx, inst)
+	if err != nil {
+		k8s.InstanceUpsertCondition(&inst.Status, k8s.ReplicationReady, v1.ConditionFalse, k8s.CreatePending, err.Error())
+		return ctrl.Result{RequeueAfter: replicationStatusInterval}, nil
+	}
+
+	caClient, closeConn, err := r.ClientFactory.New(ctx, r, inst.Namespace, inst.Name)
+	if err != nil {
+		log.Error(err, "failed to create config agent client")
+		return ctrl.Result{}, err
+	}
+	defer closeConn()
+
+	log.Info("creating a standby database", "primary", primary.Name)
+	resp, err := caClient.CreateStandby(ctx, &capb.CreateStandbyRequest{
+		CdbName:              inst.Spec.CDBName,
+		DbUniqueName:         dbUniqueName(inst),
+		PrimaryConnectString: dataGuardConnectString(primary),
+		StandbyConnectString: dataGuardConnectString(inst),
+		SysPassword:          password,
+		LroInput:             &capb.LROInput{OperationId: lroCreateStandbyOperationID(inst)},
+	})
+	if err != nil {
+		msg := fmt.Sprintf("Failed to create a standby database: %v", err)
+		k8s.InstanceUpsertCondition(&inst.Status, k8s.ReplicationReady, v1.ConditionFalse, k8s.CreateFailed, msg)
+		r.Recorder.Event(inst, corev1.EventTypeWarning, k8s.CreateFailed, msg)
+		return ctrl.Result{}, nil
+	}
+	r.Recorder.Eventf(inst, corev1.EventTypeNormal, k8s.CreateInProgress, "Creating a standby database of the primary instance %q", primary.Name)
+	if resp.GetDone() {
+		return r.standbyCreated(ctx, inst, primary, log)
+	}
+	k8s.InstanceUpsertCondition(&inst.Status, k8s.ReplicationReady, v1.ConditionFalse, k8s.CreateInProgress, fmt.Sprintf("Duplicating the primary instance %q", primary.Name))
+	return ctrl.Result{RequeueAfter: createStandbyPollInterval}, nil
+}
+
+// checkStandbyCreated checks the RMAN duplication started by createStandby.
+func (r *InstanceReconciler) checkStandbyCreated(ctx context.Context, inst, primary *v1alpha1.Instance, log logr.Logger) (ctrl.Result, error) {
+	id := lroCreateStandbyOperationID(inst)
+	operation, err := controllers.GetLROOperation(r.ClientFactory, ctx, r, inst.Namespace, id, inst.Name)
+	if err != nil {
+		log.Error(err, "GetLROOperation returned an error", "id", id)
+		return ctrl.Result{RequeueAfter: createStandbyPollInterval}, nil
+	}
+	if !operation.GetDone() {
+		return ctrl.Result{RequeueAfter: createStandbyPollInterval}, nil
+	}
+	if err := controllers.DeleteLROOperation(r.ClientFactory, ctx, r, inst.Namespace, id, inst.Name); err != nil {
+		log.Error(err, "failed to delete the LRO", "id", id)
+	}
+
+	if operation.GetError() != nil {
+		msg := fmt.Sprintf("Failed to create a standby database: %s", operation.GetError().GetMessage())
+		k8s.InstanceUpsertCondition(&inst.Status, k8s.ReplicationReady, v1.ConditionFalse, k8s.CreateFailed, msg)
+		r.Recorder.Event(inst, corev1.EventTypeWarning, k8s.CreateFailed, msg)
+		return ctrl.Result{}, nil
+	}
+	return r.standbyCreated(ctx, inst, primary, log)
+}
+
+// standbyCreated configures the redo transport and apply of a new standby.
+func (r *InstanceReconciler) standbyCreated(ctx context.Context, inst, primary *v1alpha1.Instance, log logr.Logger) (ctrl.Result, error) {
+	if inst.Status.Replication == nil {
+		inst.Status.Replication = &v1alpha1.ReplicationStatus{}
+	}
+	inst.Status.Replication.Role = v1alpha1.PhysicalStandbyRole
+	if err := r.setUpDataGuard(ctx, inst, primary); err != nil {
+		// The standby exists, so the Data Guard set up is retried.
+		log.Error(err, "failed to set up Data Guard")
+		return ctrl.Result{}, err
+	}
+	k8s.InstanceUpsertCondition(&inst.Status, k8s.ReplicationReady, v1.ConditionTrue, k8s.CreateComplete, fmt.Sprintf("Standby of the primary instance %q", primary.Name))
+	r.Recorder.Eventf(inst, corev1.EventTypeNormal, k8s.CreateComplete, "Created a standby database of the primary instance %q", primary.Name)
+	return ctrl.Result{RequeueAfter: replicationStatusInterval}, nil
+}
+
+// setUpDataGuard configures both databases of the Data Guard configuration
+// to ship redo to each other, so that the roles can be switched.
+func (r *InstanceReconciler) setUpDataGuard(ctx context.Context, inst, primary *v1alpha1.Instance) error {
+	primaryClient, closePrimary, err := r.ClientFactory.New(ctx, r, primary.Namespace, primary.Name)
+	if err != nil {
+		return err
+	}
+	defer closePrimary()
+	standbyClient, closeStandby, err := r.ClientFactory.New(ctx, r, inst.Namespace, inst.Name)
+	if err != nil {
+		return err
+	}
+	defer closeStandby()
+
+	st := inst.Status.Replication
+	st.DBUniqueName = dbUniqueName(inst)
+	if st.PrimaryDBUniqueName == "" {
+		resp, err := primaryClient.DataGuardStatus(ctx, &capb.DataGuardStatusRequest{})
+		if err != nil {
+			return fmt.Errorf("failed to get the unique name of the primary database: %v", err)
+		}
+		st.PrimaryDBUniqueName = resp.GetDbUniqueName()
+	}
+
+	sync := inst.Spec.Replication.TransportMode == v1alpha1.ReplicationTransportSync
+	if _, err := primaryClient.SetUpDataGuard(ctx, &capb.SetUpDataGuardRequest{
+		PeerDbUniqueName:  st.DBUniqueName,
+		PeerConnectString: dataGuardConnectString(inst),
+		Sync:              sync,
+	}); err != nil {
+		return fmt.Errorf("failed to set up Data Guard on instance %q: %v", primary.Name, err)
+	}
+	if _, err := standbyClient.SetUpDataGuard(ctx, &capb.SetUpDataGuardRequest{
+		PeerDbUniqueName:  st.PrimaryDBUniqueName,
+		PeerConnectString: dataGuardConnectString(primary),
+		Sync:              sync,
+	}); err != nil {
+		return fmt.Errorf("failed to set up Data Guard on instance %q: %v", inst.Name, err)
+	}
+	return nil
+}
+
+// isRoleTransitionRequested returns true if a role transition is requested
+// later than the last handled one.
+func isRoleTransitionRequested(inst *v1alpha1.Instance) bool {
+	rt := inst.Spec.Replication.RoleTransition
+	if rt == nil {
+		return false
+	}
+	st := inst.Status.Replication
+	return st == nil || st.LastRoleTransitionTime == nil || rt.RequestTime.After(st.LastRoleTransitionTime.Time)
+}
+
+// transitionRole performs the requested switchover or failover. A failed
+// role transition is not retried, it has to be requested again with a later
+// request time.
+func (r *InstanceReconciler) transitionRole(ctx context.Context, inst, primary *v1alpha1.Instance, log logr.Logger) {
+	rt := inst.Spec.Replication.RoleTransition
+	st := inst.Status.Replication
+	st.LastRoleTransitionType = rt.Type
+	requestTime := rt.RequestTime
+	st.LastRoleTransitionTime = &requestTime
+
+	log.Info("performing a role transition", "type", rt.Type, "role", st.Role)
+	if err := r.doTransitionRole(ctx, inst, primary, rt.Type); err != nil {
+		msg := fmt.Sprintf("%s failed: %v", rt.Type, err)
+		k8s.InstanceUpsertCondition(&inst.Status, k8s.ReplicationReady, v1.ConditionFalse, k8s.RoleTransitionFailed, msg)
+		r.Recorder.Event(inst, corev1.EventTypeWarning, k8s.RoleTransitionFailed, msg)
+		return
+	}
+	msg := fmt.Sprintf("%s complete, the database role is %s", rt.Type, st.Role)
+	k8s.InstanceUpsertCondition(&inst.Status, k8s.ReplicationReady, v1.ConditionTrue, k8s.RoleTransitionComplete, msg)
+	r.Recorder.Event(inst, corev1.EventTypeNormal, k8s.RoleTransitionComplete, msg)
+}
+
+func (r *InstanceReconciler) doTransitionRole(ctx context.Context, inst, primary *v1alpha1.Instance, t v1alpha1.RoleTransitionType) error {
+	st := inst.Status.Replication
+	switch t {
+	case v1alpha1.Switchover:
+		// A switchover is initiated on the current primary, which is the
+		// primary Instance unless the roles have already been switched.
+		source, target, newRole := primary, st.DBUniqueName, v1alpha1.PrimaryRole
+		if st.Role == v1alpha1.PrimaryRole {
+			source, target, newRole = inst, st.PrimaryDBUniqueName, v1alpha1.PhysicalStandbyRole
+		}
+		caClient, closeConn, err := r.ClientFactory.New(ctx, r, source.Namespace, source.Name)
+		if err != nil {
+			return err
+		}
+		defer closeConn()
+		if _, err := caClient.Switchover(ctx, &capb.SwitchoverRequest{TargetDbUniqueName: target, CdbName: source.Spec.CDBName}); err != nil {
+			return err
+		}
+		st.Role = newRole
+		// Open the new primary and start the redo apply of the new standby.
+		return r.setUpDataGuard(ctx, inst, primary)
+
+	case v1alpha1.Failover:
+		if st.Role == v1alpha1.PrimaryRole {
+			return fmt.Errorf("instance %q is already the primary", inst.Name)
+		}
+		// The primary is shut down first so that the two databases don't
+		// accept writes at the same time. A lost primary can't be reached,
+		// it is shut down once it's back, see fenceFormerPrimary.
+		if err := r.fenceDatabase(ctx, primary); err != nil {
+			r.Recorder.Eventf(inst, corev1.EventTypeWarning, k8s.ReinstateRequired, "Failed to shut down the database of the primary instance %q, it is shut down once it can be reached: %v", primary.Name, err)
+		}
+		caClient, closeConn, err := r.ClientFactory.New(ctx, r, inst.Namespace, inst.Name)
+		if err != nil {
+			return err
+		}
+		defer closeConn()
+		if _, err := caClient.Failover(ctx, &capb.FailoverRequest{TargetDbUniqueName: st.DBUniqueName}); err != nil {
+			return err
+		}
+		st.Role = v1alpha1.PrimaryRole
+		st.ReinstateRequired = true
+		return nil
+
+	default:
+		return fmt.Errorf("unsupported role transition %q", t)
+	}
+}
+
+// failedOverStandby returns the standby Instance that has taken over the
+// primary role of inst with a failover, or nil.
+func (r *InstanceReconciler) failedOverStandby(ctx context.Context, inst *v1alpha1.Instance) (*v1alpha1.Instance, error) {
+	if inst.Spec.Replication != nil {
+		return nil, nil
+	}
+	var insts v1alpha1.InstanceList
+	if err := r.List(ctx, &insts, client.InNamespace(inst.Namespace)); err != nil {
+		return nil, fmt.Errorf("failed to list instances: %v", err)
+	}
+	for i := range insts.Items {
+		standby := &insts.Items[i]
+		rep, st := standby.Spec.Replication, standby.Status.Replication
+		if rep != nil && rep.PrimaryInstance == inst.Name && st != nil && st.ReinstateRequired {
+			return standby, nil
+		}
+	}
+	return nil, nil
+}
+
+// fenceFormerPrimary keeps the database of a primary Instance shut down once
+// its standby has taken over the primary role with a failover. A database
+// started again with a restarted pod is shut down on the next reconciliation.
+// Only the load balancer Service, which selects the new primary, is
+// reconciled until the Instance is deleted and rebuilt as a standby.
+func (r *InstanceReconciler) fenceFormerPrimary(ctx context.Context, inst, standby *v1alpha1.Instance, applyOpts []client.PatchOption, log logr.Logger) (ctrl.Result, error) {
+	if err := r.reconcileLBService(ctx, inst, applyOpts); err != nil {
+		return ctrl.Result{}, err
+	}
+	if err := r.fenceDatabase(ctx, inst); err != nil {
+		log.Error(err, "failed to shut down the database of a former primary")
+		return ctrl.Result{}, err
+	}
+	if cond := k8s.FindCondition(inst.Status.Conditions, k8s.ReplicationReady); cond == nil || cond.Reason != k8s.ReinstateRequired {
+		msg := fmt.Sprintf("The standby instance %q has taken over the primary role with a failover, the database is shut down until the instance is rebuilt as a standby", standby.Name)
+		k8s.InstanceUpsertCondition(&inst.Status, k8s.ReplicationReady, v1.ConditionFalse, k8s.ReinstateRequired, msg)
+		r.Recorder.Event(inst, corev1.EventTypeWarning, k8s.ReinstateRequired, msg)
+	}
+	return ctrl.Result{RequeueAfter: replicationStatusInterval}, nil
+}
+
+// fenceDatabase shuts down the database of inst if it is a primary.
+func (r *InstanceReconciler) fenceDatabase(ctx context.Context, inst *v1alpha1.Instance) error {
+	caClient, closeConn, err := r.ClientFactory.New(ctx, r, inst.Namespace, inst.Name)
+	if err != nil {
+		return err
+	}
+	defer closeConn()
+	_, err = caClient.FenceDatabase(ctx, &capb.FenceDatabaseRequest{CdbName: inst.Spec.CDBName})
+	return err
+}
+
+// updateReplicationStatus records the role and the lag of the database,
+// which is refreshed periodically.
+func (r *InstanceReconciler) updateReplicationStatus(ctx context.Context, inst *v1alpha1.Instance, log logr.Logger) (ctrl.Result, error) {
+	caClient, closeConn, err := r.ClientFactory.New(ctx, r, inst.Namespace, inst.Name)
+	if err != nil {
+		log.Error(err, "failed to create config agent client")
+		return ctrl.Result{RequeueAfter: replicationStatusInterval}, nil
+	}
+	defer closeConn()
+
+	resp, err := caClient.DataGuardStatus(ctx, &capb.DataGuardStatusRequest{})
+	if err != nil {
+		log.Error(err, "failed to get the Data Guard status")
+		return ctrl.Result{RequeueAfter: replicationStatusInterval}, nil
+	}
+
+	st := inst.Status.Replication
+	switch resp.GetDatabaseRole() {
+	case primaryDatabaseRole:
+		st.Role = v1alpha1.PrimaryRole
+	case physicalStandbyDatabaseRole:
+		st.Role = v1alpha1.PhysicalStandbyRole
+	}
+	st.ApplyLagSeconds = resp.GetApplyLagSeconds()
+	st.TransportLagSeconds = resp.GetTransportLagSeconds()
+	st.ApplyRunning = resp.GetApplyRunning()
+	return ctrl.Result{RequeueAfter: replicationStatusInterval}, nil
+}
+
+// sysPassword returns the SYS password of the primary database.
+func (r *InstanceReconciler) sysPassword(ctx context.Context, inst *v1alpha1.Instance) (string, error) {
+	ref := inst.Spec.Replication.PasswordSecretRef
+	secret := &corev1.Secret{}
+	if err := r.Get(ctx, types.NamespacedName{Namespace: inst.Namespace, Name: ref.Name}, secret); err != nil {
+		return "", fmt.Errorf("failed to get the SYS password secret %q: %v", ref.Name, err)
+	}
+	password, ok := secret.Data[ref.Key]
+	if !ok || len(password) == 0 {
+		return "", fmt.Errorf("the SYS password secret %q has no %q key", ref.Name, ref.Key)
+	}
+	return string(password), nil
+}
+
+// reconcileLBService points the load balancer Service of inst at the pod of
+// the Instance returned by lbServiceInstance.
+func (r *InstanceReconciler) reconcileLBService(ctx context.Context, inst *v1alpha1.Instance, applyOpts []client.PatchOption) error {
+	svc, err := controllers.NewSvc(inst, r.Scheme, "lb")
+	if err != nil {
+		return err
+	}
+	svc.Spec.Selector = map[string]string{"instance": r.lbServiceInstance(ctx, inst)}
+	return r.Patch(ctx, svc, client.Apply, applyOpts...)
+}
+
+// lbServiceInstance returns the name of the Instance whose pod the load
+// balancer Service of inst selects. The Services follow the database roles:
+// the Service of a primary Instance reaches its standby once the standby has
+// taken over the primary role, and the Service of a standby reaches the
+// former primary after a switchover.
+func (r *InstanceReconciler) lbServiceInstance(ctx context.Context, inst *v1alpha1.Instance) string {
+	if rep := inst.Spec.Replication; rep != nil {
+		st := inst.Status.Replication
+		if st != nil && st.Role == v1alpha1.PrimaryRole && st.LastRoleTransitionType == v1alpha1.Switchover {
+			return rep.PrimaryInstance
+		}
+		return inst.Name
+	}
+
+	var insts v1alpha1.InstanceList
+	if err := r.List(ctx, &insts, client.InNamespace(inst.Namespace)); err != nil {
+		r.Log.Error(err, "failed to list instances")
+		return inst.Name
+	}
+	for _, standby := range insts.Items {
+		rep, st := standby.Spec.Replication, standby.Status.Replication
+		if rep != nil && rep.PrimaryInstance == inst.Name && st != nil && st.Role == v1alpha1.PrimaryRole {
+			return standby.Name
+		}
+	}
+	return inst.Name
+}
+
+// primaryInstanceRequests maps a standby Instance to its primary Instance,
+// whose load balancer Service follows the role of the standby.
+func primaryInstanceRequests(obj client.Object) []reconcile.Request {
+	inst, ok := obj.(*v1alpha1.Instance)
+	if !ok || inst.Spec.Replication == nil {
+		return nil
+	}
+	return []reconcile.Request{{NamespacedName: types.NamespacedName{Namespace: inst.Namespace, Name: inst.Spec.Replication.PrimaryInstance}}}
+}
+
+// dataGuardConnectString returns an easy connect string of the database of
+// inst. It uses the NodePort Service, which always selects the pod of inst,
+// and the static listener registration, which is also available while the
+// database is not mounted.
+func dataGuardConnectString(inst *v1alpha1.Instance) string {
+	host := fmt.Sprintf(controllers.SvcEndpoint, fmt.Sprintf(controllers.SvcName, inst.Name)+"-node", inst.Namespace)
+	service := inst.Spec.CDBName
+	if domain := controllers.GetDBDomain(inst); domain != "" {
+		service += "." + domain
+	}
+	return fmt.Sprintf("//%s:%d/%s", host, consts.SecureListenerPort, service)
+}
+
+// dbUniqueName returns the db_unique_name of a standby without a domain.
+func dbUniqueName(inst *v1alpha1.Instance) string {
+	return strings.SplitN(inst.Spec.DBUniqueName, ".", 2)[0]
+}
+
+func lroCreateStandbyOperationID(inst *v1alpha1.Instance) string {
+	return fmt.Sprintf("CreateStandby_%s", inst.GetUID())
+}
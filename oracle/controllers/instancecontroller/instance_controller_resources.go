@@ -0,0 +1,277 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package instancecontroller
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/resource"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	v1alpha1 "github.com/GoogleCloudPlatform/elcarro-oracle-operator/oracle/api/v1alpha1"
+	"github.com/GoogleCloudPlatform/elcarro-oracle-operator/oracle/controllers"
+	capb "github.com/GoogleCloudPlatform/elcarro-oracle-operator/oracle/pkg/agents/config_agent/protos"
+	"github.com/GoogleCloudPlatform/elcarro-oracle-operator/oracle/pkg/agents/consts"
+	"github.com/GoogleCloudPlatform/elcarro-oracle-operator/oracle/pkg/k8s"
+)
+
+const (
+	// defaultMemoryPercent is the percentage of the memory of the database
+	// container allocated for the SGA and the PGA if spec.memoryPercent is
+	// not set.
+	defaultMemoryPercent = 25
+	sgaTargetParameter   = "sga_target"
+	pgaTargetParameter   = "pga_aggregate_target"
+)
+
+// isStatefulSetRolledOut returns true once the latest revision of the
+// StatefulSet is rolled out and its database container is ready.
+// extracted for testing.
+var isStatefulSetRolledOut = func(ctx context.Context, r *InstanceReconciler, inst *v1alpha1.Instance) (bool, error) {
+	_, done, err := statefulSetRolledOut(ctx, r, inst)
+	return done, err
+}
+
+// resourcesStateMachine rolls out changes of spec.databaseResources,
+// spec.memoryPercent and spec.fileVolumes to a ready instance. The
+// StatefulSet is updated, which restarts the database, and the SGA and PGA
+// sizes are recomputed from the memory of the database container. The sizes never exceed the container
+// they run in: smaller sizes are written to the spfile before the rollout and
+// take effect with its restart, larger ones once the larger container is
+// rolled out, with another restart of the database. A database which fails
+// to restart with them gets its last known working spfile back, like a failed
+// parameter update. The rollout waits for the maintenance window if the
+// instance has one, and starts right away otherwise.
+// State transition:
+// CreateComplete -> ResourcesUpdateInProgress -> CreateComplete
+func (r *InstanceReconciler) resourcesStateMachine(ctx context.Context, inst *v1alpha1.Instance, sp controllers.StsParams, log logr.Logger) (ctrl.Result, error) {
+	readyCond := k8s.FindCondition(inst.Status.Conditions, k8s.Ready)
+	dbInstanceCond := k8s.FindCondition(inst.Status.Conditions, k8s.DatabaseInstanceReady)
+	if readyCond == nil || !k8s.ConditionStatusEquals(dbInstanceCond, v1.ConditionTrue) {
+		return ctrl.Result{}, nil
+	}
+
+	if readyCond.Reason == k8s.ResourcesUpdateInProgress {
+		return r.resourcesUpdateInProgress(ctx, inst, sp, log)
+	}
+	if readyCond.Status != v1.ConditionTrue || inst.Spec.Restore != nil || inst.Status.Patching != nil {
+		return ctrl.Result{}, nil
+	}
+
+	// The StatefulSet of a new instance is created with the resources in the
+	// spec, there is nothing to roll out.
+	if inst.Status.DatabaseResources == nil {
+		inst.Status.DatabaseResources = &v1alpha1.DatabaseResourcesStatus{
+			Resources:     *inst.Spec.DatabaseResources.DeepCopy(),
+			MemoryPercent: inst.Spec.MemoryPercent,
+			FileVolumes:   fileVolumes(inst),
+		}
+		r.updateMemoryTargetsStatus(ctx, inst, log)
+		return ctrl.Result{}, nil
+	}
+	if !resourcesChanged(inst) {
+		return ctrl.Result{}, nil
+	}
+
+	if inst.Spec.MaintenanceWindow != nil {
+		if result, err := r.sanityCheckTimeRange(*inst, log); err != nil {
+			log.Info("resourcesStateMachine: waiting for a maintenance window", "reason", err.Error())
+			return result, nil
+		}
+	}
+	if err := r.rollOutResources(ctx, inst, sp, log); err != nil {
+		return ctrl.Result{}, err
+	}
+	msg := "Rolling out the database resources, the database is restarted"
+	r.recordEventAndUpdateStatus(ctx, inst, v1.ConditionFalse, k8s.ResourcesUpdateInProgress, msg, log)
+	log.Info("resourcesStateMachine: CreateComplete -> ResourcesUpdateInProgress")
+	return ctrl.Result{RequeueAfter: 10 * time.Second}, nil
+}
+
+// resourcesUpdateInProgress waits for the StatefulSet with the new resources
+// to come up and reports the effective SGA and PGA sizes.
+func (r *InstanceReconciler) resourcesUpdateInProgress(ctx context.Context, inst *v1alpha1.Instance, sp controllers.StsParams, log logr.Logger) (ctrl.Result, error) {
+	// The spec changed again while the previous change was rolled out.
+	if inst.Status.DatabaseResources == nil || resourcesChanged(inst) {
+		if err := r.rollOutResources(ctx, inst, sp, log); err != nil {
+			return ctrl.Result{}, err
+		}
+		return ctrl.Result{RequeueAfter: 10 * time.Second}, nil
+	}
+
+	done, err := isStatefulSetRolledOut(ctx, r, inst)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if !done {
+		log.Info("resourcesStateMachine: StatefulSet rollout in progress, waiting")
+		return ctrl.Result{RequeueAfter: 10 * time.Second}, nil
+	}
+
+	// The effective sizes are read first, so that larger ones which were
+	// already set by a previous attempt aren't set again.
+	r.updateMemoryTargetsStatus(ctx, inst, log)
+	msg := "Database resources rolled out"
+	if sgaMB, pgaMB, ok := memoryTargetsMB(inst); ok && memoryTargetsGrow(inst, sgaMB) {
+		if err := r.setMemoryTargets(ctx, inst, sgaMB, pgaMB, true, log); err != nil {
+			log.Error(err, "resourcesStateMachine: failed to set the memory targets")
+			msg = fmt.Sprintf("Database resources rolled out, the memory targets were not changed: %v", err)
+		}
+		r.updateMemoryTargetsStatus(ctx, inst, log)
+	}
+	r.recordEventAndUpdateStatus(ctx, inst, v1.ConditionTrue, k8s.CreateComplete, msg, log)
+	log.Info("resourcesStateMachine: ResourcesUpdateInProgress -> CreateComplete")
+	return ctrl.Result{Requeue: true}, nil
+}
+
+// rollOutResources applies the StatefulSet with the resources in the spec.
+// Smaller SGA and PGA sizes are written to the spfile first.
+func (r *InstanceReconciler) rollOutResources(ctx context.Context, inst *v1alpha1.Instance, sp controllers.StsParams, log logr.Logger) error {
+	if sgaMB, pgaMB, ok := memoryTargetsMB(inst); ok && !memoryTargetsGrow(inst, sgaMB) {
+		if err := r.setMemoryTargets(ctx, inst, sgaMB, pgaMB, false, log); err != nil {
+			return err
+		}
+	}
+
+	if err := r.applyStatefulSet(ctx, *inst, sp); err != nil {
+		return err
+	}
+	if inst.Status.DatabaseResources == nil {
+		inst.Status.DatabaseResources = &v1alpha1.DatabaseResourcesStatus{}
+	}
+	inst.Status.DatabaseResources.Resources = *inst.Spec.DatabaseResources.DeepCopy()
+	inst.Status.DatabaseResources.MemoryPercent = inst.Spec.MemoryPercent
+	inst.Status.DatabaseResources.FileVolumes = fileVolumes(inst)
+	return nil
+}
+
+// fileVolumes returns a copy of the file volumes in the spec.
+func fileVolumes(inst *v1alpha1.Instance) []v1alpha1.FileVolume {
+	var volumes []v1alpha1.FileVolume
+	for _, fv := range inst.Spec.FileVolumes {
+		volumes = append(volumes, *fv.DeepCopy())
+	}
+	return volumes
+}
+
+// setMemoryTargets writes the SGA and PGA sizes to the spfile, and restarts
+// the database for them to take effect if requested. If the database fails to
+// restart, its last known working spfile is recovered.
+func (r *InstanceReconciler) setMemoryTargets(ctx context.Context, inst *v1alpha1.Instance, sgaMB, pgaMB int64, restart bool, log logr.Logger) error {
+	caClient, closeConn, err := r.ClientFactory.New(ctx, r, inst.Namespace, inst.Name)
+	if err != nil {
+		log.Error(err, "failed to create config agent client")
+		return err
+	}
+	defer closeConn()
+	if _, err := caClient.SetMemoryTargets(ctx, &capb.SetMemoryTargetsRequest{
+		SgaTargetMb:          sgaMB,
+		PgaAggregateTargetMb: pgaMB,
+	}); err != nil {
+		return fmt.Errorf("failed to set the memory targets: %v", err)
+	}
+	log.Info("setMemoryTargets: memory targets set", "sgaTargetMB", sgaMB, "pgaAggregateTargetMB", pgaMB)
+	if !restart {
+		return nil
+	}
+	if _, err := caClient.BounceDatabase(ctx, &capb.BounceDatabaseRequest{Sid: inst.Spec.CDBName}); err != nil {
+		if err := r.initiateRecovery(ctx, *inst, caClient, nil, log); err != nil {
+			log.Error(err, "setMemoryTargets: recovery failed")
+		}
+		return fmt.Errorf("failed to restart the database with the memory targets: %v", err)
+	}
+	return nil
+}
+
+// memoryTargetsGrow returns true unless the SGA size is known to shrink to
+// sgaMB, in which case the larger container is only needed until the restart.
+func memoryTargetsGrow(inst *v1alpha1.Instance, sgaMB int64) bool {
+	current := inst.Status.DatabaseResources.SGATarget
+	return current == nil || sgaMB<<20 > current.Value()
+}
+
+// resourcesChanged returns true if the resources in the spec differ from the
+// rolled out ones.
+func resourcesChanged(inst *v1alpha1.Instance) bool {
+	st := inst.Status.DatabaseResources
+	if !equality.Semantic.DeepEqual(inst.Spec.DatabaseResources, st.Resources) {
+		return true
+	}
+	if !equality.Semantic.DeepEqual(inst.Spec.FileVolumes, st.FileVolumes) {
+		return true
+	}
+	_, ok := controllers.DatabaseMemory(inst.Spec.DatabaseResources)
+	return ok && inst.Spec.MemoryPercent != st.MemoryPercent
+}
+
+// memoryTargetsMB returns the SGA and PGA sizes in MB for the memory of the
+// database container. Like the memory percentage of DBCA, the percentage is
+// the memory of the SGA and the PGA combined, so that they never exceed the
+// container. The PGA gets two thirds of the SGA, as in the default init
+// parameters. Oracle 18c XE manages its memory itself.
+func memoryTargetsMB(inst *v1alpha1.Instance) (sgaMB, pgaMB int64, ok bool) {
+	mem, ok := controllers.DatabaseMemory(inst.Spec.DatabaseResources)
+	if !ok || inst.Spec.Version == consts.Oracle18c {
+		return 0, 0, false
+	}
+	percent := int64(inst.Spec.MemoryPercent)
+	if percent == 0 {
+		percent = defaultMemoryPercent
+	}
+	totalMB := mem.Value() * percent / 100 >> 20
+	sgaMB = totalMB * 3 / 5
+	return sgaMB, totalMB * 2 / 5, sgaMB > 0
+}
+
+// updateMemoryTargetsStatus reports the effective SGA and PGA sizes of the
+// database. Failures are logged only, the sizes are reported again on the
+// next rollout.
+func (r *InstanceReconciler) updateMemoryTargetsStatus(ctx context.Context, inst *v1alpha1.Instance, log logr.Logger) {
+	caClient, closeConn, err := r.ClientFactory.New(ctx, r, inst.Namespace, inst.Name)
+	if err != nil {
+		log.Error(err, "failed to create config agent client")
+		return
+	}
+	defer closeConn()
+	resp, err := caClient.GetParameterTypeValue(ctx, &capb.GetParameterTypeValueRequest{
+		Keys: []string{sgaTargetParameter, pgaTargetParameter},
+	})
+	if err != nil {
+		log.Error(err, "failed to read the memory targets")
+		return
+	}
+	values := resp.GetValues()
+	if len(values) != 2 {
+		log.Info("updateMemoryTargetsStatus: unexpected response", "values", values)
+		return
+	}
+	targets := make([]*resource.Quantity, len(values))
+	for i, v := range values {
+		bytes, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			log.Error(err, "failed to parse the memory target", "value", v)
+			return
+		}
+		targets[i] = resource.NewQuantity(bytes, resource.BinarySI)
+	}
+	inst.Status.DatabaseResources.SGATarget = targets[0]
+	inst.Status.DatabaseResources.PGAAggregateTarget = targets[1]
+}
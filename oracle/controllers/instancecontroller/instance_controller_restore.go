@@ -27,6 +27,7 @@ import (
 	capb "github.com/GoogleCloudPlatform/elcarro-oracle-operator/oracle/pkg/agents/config_agent/protos"
 	"github.com/GoogleCloudPlatform/elcarro-oracle-operator/oracle/pkg/k8s"
 	lropb "google.golang.org/genproto/googleapis/longrunning"
+	"google.golang.org/protobuf/types/known/timestamppb"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -35,12 +36,18 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
+// errRestoreCancelled is returned by isPhysicalRestoreDone for a restore
+// cancelled through Spec.Restore.Cancel.
+var errRestoreCancelled = go_errors.New("restore was cancelled")
+
 // Reconciler for restore logic.
 // Invoked when Spec.Restore is present.
 // State transition:
-// CreateComplete/RestoreFailed -> RestorePreparationInProgress -> RestorePreparationComplete ->
+// CreateComplete/RestoreFailed/RestoreCancelled -> RestorePreparationInProgress -> RestorePreparationComplete ->
 // -> RestoreInProgress -> RestoreComplete
+// or RestoreInProgress -> RestoreNewIdentityInProgress -> RestoreComplete (clone only)
 // or ... -> RestoreFailed
+// or RestoreInProgress -> RestoreCancelled (Physical only)
 // Returns
 // * non-empty result if restore state machine needs another reconcile
 // * non-empty error if any error occurred
@@ -57,13 +64,13 @@ func (r *InstanceReconciler) restoreStateMachine(req ctrl.Request,
 		r.Log.Info("restoreStateMachine: Instance not ready yet, proceed with main reconciliation")
 		return ctrl.Result{}, nil
 	}
-	// Check the Force flag
-	if !inst.Spec.Restore.Force {
+	// Find the requested backup resource
+	backup, err := r.findBackupForRestore(ctx, *inst, restoreBackupNamespace(inst))
+	// Check the Force flag, which isn't needed for a new clone.
+	if !inst.Spec.Restore.Force && (err != nil || !isNewClone(inst, backup)) {
 		r.Log.Info("instance is up and running. To replace (restore from a backup), set force=true")
 		return ctrl.Result{}, nil
 	}
-	// Find the requested backup resource
-	backup, err := r.findBackupForRestore(ctx, *inst, req.Namespace)
 	if err != nil {
 		r.setRestoreFailed(ctx, inst, fmt.Sprintf(
 			"Could not find a matching backup for BackupID: %v, BackupType: %v",
@@ -72,7 +79,7 @@ func (r *InstanceReconciler) restoreStateMachine(req ctrl.Request,
 	}
 	switch instanceReadyCond.Reason {
 	// Entry points for restore process
-	case k8s.RestoreComplete, k8s.CreateComplete, k8s.RestoreFailed:
+	case k8s.RestoreComplete, k8s.CreateComplete, k8s.RestoreFailed, k8s.RestoreCancelled:
 		if inst.Spec.Restore.BackupType != "Snapshot" && inst.Spec.Restore.BackupType != "Physical" {
 			// Not playing games here. A restore (especially the in-place restore)
 			// is destructive. It's not about being user-friendly. A user is to
@@ -80,6 +87,24 @@ func (r *InstanceReconciler) restoreStateMachine(req ctrl.Request,
 			r.Log.Error(fmt.Errorf("a BackupType is a mandatory parameter for a restore"), "stopping")
 			return ctrl.Result{}, nil
 		}
+		// Reconcile again on a validation failure, so that the status is
+		// updated before the main reconciliation runs.
+		if err := authorizeClone(inst, backup); err != nil {
+			r.setRestoreFailed(ctx, inst, err.Error())
+			return ctrl.Result{Requeue: true}, nil
+		}
+		if err := validateRecoveryTarget(inst.Spec.Restore, backup); err != nil {
+			r.setRestoreFailed(ctx, inst, err.Error())
+			return ctrl.Result{Requeue: true}, nil
+		}
+		if err := validateRestoreDatabase(inst, backup); err != nil {
+			r.setRestoreFailed(ctx, inst, err.Error())
+			return ctrl.Result{Requeue: true}, nil
+		}
+		if err := r.validateSwitchToCopy(ctx, inst, backup); err != nil {
+			r.setRestoreFailed(ctx, inst, err.Error())
+			return ctrl.Result{Requeue: true}, nil
+		}
 		// Check the request time
 		requestTime := inst.Spec.Restore.RequestTime.Rfc3339Copy()
 		if inst.Status.LastRestoreTime != nil && !requestTime.After(inst.Status.LastRestoreTime.Time) {
@@ -87,13 +112,36 @@ func (r *InstanceReconciler) restoreStateMachine(req ctrl.Request,
 				requestTime, inst.Status.LastRestoreTime.Time))
 			return ctrl.Result{}, nil
 		}
+		// An in-place restore replaces the database, it waits for a
+		// maintenance window if the instance has any.
+		if inst.Spec.MaintenanceWindow != nil && !isNewClone(inst, backup) {
+			if result, err := r.sanityCheckTimeRange(*inst, r.Log); err != nil {
+				r.Log.Info("restoreStateMachine: waiting for a maintenance window", "reason", err.Error())
+				return result, nil
+			}
+		}
+		nextReason := k8s.RestorePreparationInProgress
+		if inst.Spec.Restore.BackupType == "Snapshot" && isNewClone(inst, backup) {
+			// The PVCs of a new clone from a Snapshot backup are created
+			// from the snapshots when the Instance is provisioned.
+			done, err := r.isProvisionedFromSnapshots(ctx, *inst, stsParams)
+			if err != nil {
+				return ctrl.Result{}, err
+			}
+			if done {
+				nextReason = k8s.RestoreInProgress
+			}
+		}
 		inst.Status.LastRestoreTime = inst.Spec.Restore.RequestTime.DeepCopy()
-		inst.Status.BackupID = ""
-		k8s.InstanceUpsertCondition(&inst.Status, k8s.Ready, v1.ConditionFalse, k8s.RestorePreparationInProgress, "")
+		// The backups of the CDB are still valid after the restore of a PDB.
+		if inst.Spec.Restore.DatabaseName == "" {
+			inst.Status.BackupID = ""
+		}
+		k8s.InstanceUpsertCondition(&inst.Status, k8s.Ready, v1.ConditionFalse, nextReason, "")
 		if err := r.Status().Update(ctx, inst); err != nil {
 			return ctrl.Result{}, err
 		}
-		r.Log.Info("restoreStateMachine: CreateComplete->RestorePreparationInProgress")
+		r.Log.Info("restoreStateMachine: CreateComplete->" + nextReason)
 		// Reconcile again
 		return ctrl.Result{Requeue: true}, nil
 	case k8s.RestorePreparationInProgress:
@@ -161,7 +209,7 @@ func (r *InstanceReconciler) restoreStateMachine(req ctrl.Request,
 		case "Snapshot":
 			done, err = r.isSnapshotRestoreDone(ctx, req, *inst)
 		case "Physical":
-			done, err = r.isPhysicalRestoreDone(ctx, req, *inst)
+			done, err = r.isPhysicalRestoreDone(ctx, req, inst)
 			// Clean up LRO after we are done.
 			// The job will remain available for `ttlAfterDelete`.
 			if done {
@@ -172,6 +220,11 @@ func (r *InstanceReconciler) restoreStateMachine(req ctrl.Request,
 			r.setRestoreFailed(ctx, inst, "Unknown restore type")
 			return ctrl.Result{}, nil
 		}
+		if go_errors.Is(err, errRestoreCancelled) {
+			r.setRestoreCancelled(ctx, inst)
+			// Reconcile again once the cancelled state is stored.
+			return ctrl.Result{Requeue: true}, nil
+		}
 		if err != nil {
 			r.setRestoreFailed(ctx, inst, err.Error())
 			return ctrl.Result{}, err
@@ -180,21 +233,29 @@ func (r *InstanceReconciler) restoreStateMachine(req ctrl.Request,
 			r.Log.Info("restore still in progress, waiting")
 			return ctrl.Result{RequeueAfter: 10 * time.Second}, nil
 		}
+		if isClone(inst, backup) {
+			return r.changeDatabaseIdentity(ctx, inst)
+		}
 		r.Log.Info("restoreStateMachine: RestoreInProgress->RestoreComplete")
-		description := fmt.Sprintf("Restored on %s-%d from backup %s (type %s)", time.Now().Format(dateFormat),
-			time.Now().Nanosecond(), inst.Spec.Restore.BackupID, inst.Spec.Restore.BackupType)
-		r.setRestoreSucceeded(ctx, inst, description)
+		r.setRestoreSucceeded(ctx, inst, restoreDescription(inst))
+	case k8s.RestoreNewIdentityInProgress:
+		return r.checkDatabaseIdentityChanged(ctx, inst)
 	default:
 		r.Log.Info("restoreStateMachine: no action needed, proceed with main reconciliation")
 	}
 	return ctrl.Result{}, nil
 }
 
+// restoreDescription describes a completed restore.
+func restoreDescription(inst *v1alpha1.Instance) string {
+	return fmt.Sprintf("Restored on %s-%d from backup %s (type %s)", time.Now().Format(dateFormat),
+		time.Now().Nanosecond(), inst.Spec.Restore.BackupID, inst.Spec.Restore.BackupType)
+}
+
 // Update spec and status of the instance to reflect restore success.
 func (r *InstanceReconciler) setRestoreSucceeded(ctx context.Context, inst *v1alpha1.Instance, message string) {
 	r.Log.Info("Restore succeeded")
-	description := fmt.Sprintf("Restored on %s-%d from backup %s (type %s)", time.Now().Format(dateFormat),
-		time.Now().Nanosecond(), inst.Spec.Restore.BackupID, inst.Spec.Restore.BackupType)
+	description := restoreDescription(inst)
 	// Create event.
 	r.Recorder.Eventf(inst, corev1.EventTypeWarning, "RestoreComplete", message)
 	// Remove restore spec. Update the inst object in place.
@@ -221,6 +282,21 @@ func (r *InstanceReconciler) setRestoreFailed(ctx context.Context, inst *v1alpha
 	k8s.InstanceUpsertCondition(&inst.Status, k8s.Ready, v1.ConditionFalse, k8s.RestoreFailed, reason)
 }
 
+// Update spec and status of the instance to reflect a cancelled restore.
+func (r *InstanceReconciler) setRestoreCancelled(ctx context.Context, inst *v1alpha1.Instance) {
+	r.Log.Info("Restore cancelled")
+	message := fmt.Sprintf("Restore from backup %s was cancelled, the database must be restored again", inst.Spec.Restore.BackupID)
+	// Create event.
+	r.Recorder.Eventf(inst, corev1.EventTypeWarning, k8s.RestoreCancelled, message)
+	// Remove restore spec. Update the inst object in place.
+	inst.Spec.Restore = nil
+	if err := r.Update(ctx, inst); err != nil {
+		r.Log.Error(err, "failed to update instance spec")
+	}
+	// Update status.
+	k8s.InstanceUpsertCondition(&inst.Status, k8s.Ready, v1.ConditionFalse, k8s.RestoreCancelled, message)
+}
+
 // Check for Snapshot restore status
 // Return (true, nil) if job is done
 // Return (false, nil) if job still in progress
@@ -234,6 +310,95 @@ func (r *InstanceReconciler) isSnapshotRestoreDone(ctx context.Context, req ctrl
 	return err == nil, nil
 }
 
+// validateRecoveryTarget sanity checks the point-in-time recovery target
+// of a restore request. The range of the archived redo logs is only known
+// once the backup control file is restored, so it is validated later by
+// the database daemon.
+func validateRecoveryTarget(restore *v1alpha1.RestoreSpec, backup *v1alpha1.Backup) error {
+	targets := 0
+	if restore.UntilTime != nil {
+		targets++
+	}
+	if restore.UntilSCN != 0 {
+		targets++
+	}
+	if restore.UntilRestorePoint != "" {
+		targets++
+	}
+	if targets == 0 {
+		return nil
+	}
+	if targets > 1 {
+		return fmt.Errorf("at most one of untilTime, untilSCN and untilRestorePoint can be set")
+	}
+	if restore.BackupType != "Physical" {
+		return fmt.Errorf("a point-in-time recovery is only supported from a Physical backup, got: %q", restore.BackupType)
+	}
+	if restore.UntilTime != nil {
+		if restore.UntilTime.After(time.Now()) {
+			return fmt.Errorf("untilTime %v is in the future", restore.UntilTime.Time)
+		}
+		if !backup.CreationTimestamp.IsZero() && restore.UntilTime.Before(&backup.CreationTimestamp) {
+			return fmt.Errorf("untilTime %v is earlier than the creation of backup %q at %v", restore.UntilTime.Time, restore.BackupID, backup.CreationTimestamp.Time)
+		}
+	}
+	return nil
+}
+
+// validateRestoreDatabase checks that the Database (PDB) to restore alone,
+// if requested, belongs to the Instance and is covered by the backup.
+func validateRestoreDatabase(inst *v1alpha1.Instance, backup *v1alpha1.Backup) error {
+	name := inst.Spec.Restore.DatabaseName
+	if name == "" {
+		return nil
+	}
+	if inst.Spec.Restore.BackupType != "Physical" {
+		return fmt.Errorf("a restore of database %q is only supported from a Physical backup, got: %q", name, inst.Spec.Restore.BackupType)
+	}
+	if isClone(inst, backup) {
+		return fmt.Errorf("a restore of database %q is only supported from a backup of the same Instance", name)
+	}
+	if !controllers.Contains(inst.Status.DatabaseNames, name) {
+		return fmt.Errorf("database %q not found in the Instance, known Databases: %v", name, inst.Status.DatabaseNames)
+	}
+	if backup.Spec.Subtype == "Database" && !controllers.Contains(backup.Spec.BackupItems, name) {
+		return fmt.Errorf("database %q is not included in backup %q of Databases %v", name, inst.Spec.Restore.BackupID, backup.Spec.BackupItems)
+	}
+	return nil
+}
+
+// validateSwitchToCopy checks that a restore switching the database to its
+// image copies, if requested, is from the latest incrementally updated backup
+// of the Instance. All of them share a single set of image copies, which
+// holds the state of the latest one.
+func (r *InstanceReconciler) validateSwitchToCopy(ctx context.Context, inst *v1alpha1.Instance, backup *v1alpha1.Backup) error {
+	if !inst.Spec.Restore.SwitchToCopy {
+		return nil
+	}
+	if inst.Spec.Restore.BackupType != "Physical" {
+		return fmt.Errorf("a switch to copy is only supported from a Physical backup, got: %q", inst.Spec.Restore.BackupType)
+	}
+	if !backup.Spec.IncrementallyUpdated {
+		return fmt.Errorf("a switch to copy is only supported from an incrementally updated backup, backup %q is not", inst.Spec.Restore.BackupID)
+	}
+	if isClone(inst, backup) {
+		return fmt.Errorf("a switch to copy is only supported from a backup of the same Instance")
+	}
+	if inst.Spec.Restore.DatabaseName != "" {
+		return fmt.Errorf("a switch to copy restores the whole Instance, it can't be combined with the restore of database %q", inst.Spec.Restore.DatabaseName)
+	}
+	var backups v1alpha1.BackupList
+	if err := r.List(ctx, &backups, client.InNamespace(inst.Namespace)); err != nil {
+		return fmt.Errorf("failed to list the backups of the Instance: %v", err)
+	}
+	for _, b := range backups.Items {
+		if b.Spec.Instance == inst.Name && b.Spec.IncrementallyUpdated && b.CreationTimestamp.After(backup.CreationTimestamp.Time) {
+			return fmt.Errorf("a switch to copy is only supported from the latest incrementally updated backup of the Instance, whose image copies it uses: backup %q is newer than %q", b.Status.BackupID, inst.Spec.Restore.BackupID)
+		}
+	}
+	return nil
+}
+
 func restoreDOP(r, b int32) int32 {
 	// Determine the restore DOP. The order of preference is:
 	// - If DOP is explicitly requested in the restore section, take it.
@@ -250,6 +415,14 @@ func restoreDOP(r, b int32) int32 {
 	return 1
 }
 
+// restoreBackupNamespace returns the namespace of the backup to restore from.
+func restoreBackupNamespace(inst *v1alpha1.Instance) string {
+	if inst.Spec.Restore.BackupNamespace != "" {
+		return inst.Spec.Restore.BackupNamespace
+	}
+	return inst.Namespace
+}
+
 // findBackupForRestore fetches the backup with the backup_id specified in the spec for initiating the instance restore.
 func (r *InstanceReconciler) findBackupForRestore(ctx context.Context, inst v1alpha1.Instance, namespace string) (*v1alpha1.Backup, error) {
 	var backups v1alpha1.BackupList
@@ -276,22 +449,35 @@ func (r *InstanceReconciler) findBackupForRestore(ctx context.Context, inst v1al
 // it makes a gRPC call to a PhysicalRestore.
 func (r *InstanceReconciler) restorePhysical(ctx context.Context, inst v1alpha1.Instance, backup *v1alpha1.Backup, req ctrl.Request) (*lropb.Operation, error) {
 	// Confirm that an external LB is ready.
-	if err := restorePhysicalPreflightCheck(ctx, r, req.Namespace, inst.Name); err != nil {
+	err := restorePhysicalPreflightCheck(ctx, r, req.Namespace, inst.Name)
+	if err != nil {
 		return nil, err
 	}
-	if !*backup.Spec.Backupset {
-		return nil, fmt.Errorf("preflight check: located a physical backup, but in this release the auto-restore is only supported from a Backupset backup: %v", backup.Spec.Backupset)
+	// The image copies of an incrementally updated backup are switched to.
+	if !*backup.Spec.Backupset && !inst.Spec.Restore.SwitchToCopy {
+		return nil, fmt.Errorf("preflight check: located a physical backup, but in this release the auto-restore is only supported from a Backupset backup or with switchToCopy: %v", backup.Spec.Backupset)
 	}
-	if backup.Spec.Subtype != "Instance" {
+	// A Database can also be restored from a backup of that Database.
+	if backup.Spec.Subtype != "Instance" && (inst.Spec.Restore.DatabaseName == "" || backup.Spec.Subtype != "Database") {
 		return nil, fmt.Errorf("preflight check: located a physical backup, but in this release the auto-restore is only supported from a Backupset taken at the Instance level: %q", backup.Spec.Subtype)
 	}
 	backupReadyCond := k8s.FindCondition(backup.Status.Conditions, k8s.Ready)
 	if !k8s.ConditionStatusEquals(backupReadyCond, v1.ConditionTrue) {
-		return nil, fmt.Errorf("preflight check: located a physical backup, but it's not in the ready state: %q", backup.Status)
+		return nil, fmt.Errorf("preflight check: located a physical backup, but it's not in the ready state: %v", backup.Status)
+	}
+	var sourceCDBName string
+	if isClone(&inst, backup) {
+		// The backup pieces on the disks of another Instance are out of reach.
+		if backup.Spec.GcsPath == "" {
+			return nil, fmt.Errorf("preflight check: a clone is only supported from a physical backup in a storage location, set gcsPath when taking the backup")
+		}
+		if sourceCDBName, err = r.sourceCDBName(ctx, backup); err != nil {
+			return nil, err
+		}
 	}
 	r.Log.Info("preflight check for a restore from a physical backup - all DONE", "backup", backup)
 	dop := restoreDOP(inst.Spec.Restore.Dop, backup.Spec.Dop)
-	caClient, closeConn, err := r.ClientFactory.New(ctx, r, req.Namespace, backup.Spec.Instance)
+	caClient, closeConn, err := r.ClientFactory.New(ctx, r, req.Namespace, inst.Name)
 	if err != nil {
 		r.Log.Error(err, "failed to create config agent client")
 		return nil, err
@@ -301,16 +487,35 @@ func (r *InstanceReconciler) restorePhysical(ctx context.Context, inst v1alpha1.
 	if inst.Spec.Restore.TimeLimitMinutes != 0 {
 		timeLimitMinutes = time.Duration(inst.Spec.Restore.TimeLimitMinutes) * time.Minute
 	}
+	// The Secrets of the namespace of a backup to clone are never read.
+	secretRef := backup.Spec.StorageCredentialsSecretRef
+	if backup.Namespace != inst.Namespace {
+		secretRef = inst.Spec.Restore.StorageCredentialsSecretRef
+	}
+	creds, err := controllers.GetStorageCredentials(ctx, r, inst.Namespace, secretRef)
+	if err != nil {
+		return nil, err
+	}
 	ctxRestore, cancel := context.WithTimeout(context.Background(), timeLimitMinutes)
 	defer cancel()
-	resp, err := caClient.PhysicalRestore(ctxRestore, &capb.PhysicalRestoreRequest{
-		InstanceName: inst.Name,
-		CdbName:      inst.Spec.CDBName,
-		Dop:          dop,
-		LocalPath:    backup.Spec.LocalPath,
-		GcsPath:      backup.Spec.GcsPath,
-		LroInput:     &capb.LROInput{OperationId: lroRestoreOperationID(physicalRestore, inst)},
-	})
+	restoreReq := &capb.PhysicalRestoreRequest{
+		InstanceName:       inst.Name,
+		CdbName:            inst.Spec.CDBName,
+		Dop:                dop,
+		LocalPath:          backup.Spec.LocalPath,
+		GcsPath:            backup.Spec.GcsPath,
+		LroInput:           &capb.LROInput{OperationId: lroRestoreOperationID(physicalRestore, inst)},
+		UntilScn:           inst.Spec.Restore.UntilSCN,
+		UntilRestorePoint:  inst.Spec.Restore.UntilRestorePoint,
+		StorageCredentials: creds,
+		SourceCdbName:      sourceCDBName,
+		PdbName:            inst.Spec.Restore.DatabaseName,
+		SwitchToCopy:       inst.Spec.Restore.SwitchToCopy,
+	}
+	if inst.Spec.Restore.UntilTime != nil {
+		restoreReq.UntilTime = timestamppb.New(inst.Spec.Restore.UntilTime.Time)
+	}
+	resp, err := caClient.PhysicalRestore(ctxRestore, restoreReq)
 	if err != nil {
 		return nil, fmt.Errorf("failed on PhysicalRestore gRPC call: %v", err)
 	}
@@ -357,11 +562,13 @@ var restorePhysicalPreflightCheck = func(ctx context.Context, r *InstanceReconci
 // Check for Physical restore LRO job status
 // Return (true, nil) if LRO is done without errors.
 // Return (true, err) if LRO is done with an error.
-// Return (false, nil) if LRO still in progress.
+// Return (true, errRestoreCancelled) if LRO was cancelled.
+// Return (false, nil) if LRO still in progress, its progress is reported in
+// the Ready condition. The LRO is cancelled if Spec.Restore.Cancel is set.
 // Return (false, err) if other error occurred.
 func (r *InstanceReconciler) isPhysicalRestoreDone(ctx context.Context, req ctrl.Request,
-	inst v1alpha1.Instance) (bool, error) {
-	id := lroRestoreOperationID(physicalRestore, inst)
+	inst *v1alpha1.Instance) (bool, error) {
+	id := lroRestoreOperationID(physicalRestore, *inst)
 	operation, err := controllers.GetLROOperation(r.ClientFactory, ctx, r, req.Namespace, id, inst.Name)
 	if err != nil {
 		r.Log.Error(err, "GetLROOperation returned an error")
@@ -369,9 +576,24 @@ func (r *InstanceReconciler) isPhysicalRestoreDone(ctx context.Context, req ctrl
 	}
 	r.Log.Info("GetLROOperation", "response", operation)
 	if !operation.Done {
+		if inst.Spec.Restore.Cancel {
+			r.Log.Info("cancelling the LRO", "id", id)
+			// The cancellation is retried on the next reconcile, the
+			// restore isn't failed while it runs.
+			if err := controllers.CancelLROOperation(r.ClientFactory, ctx, r, req.Namespace, id, inst.Name); err != nil {
+				r.Log.Error(err, "CancelLROOperation returned an error")
+			}
+			return false, nil
+		}
+		if progress := controllers.LROProgress(operation); progress != "" {
+			k8s.InstanceUpsertCondition(&inst.Status, k8s.Ready, v1.ConditionFalse, k8s.RestoreInProgress, progress)
+		}
 		return false, nil
 	}
 	r.Log.Info("LRO is DONE, ", "id", id)
+	if controllers.IsLROCancelled(operation) {
+		return true, errRestoreCancelled
+	}
 	// handle case when remote LRO completed unsuccessfully
 	if operation.GetError() != nil {
 		backupID := inst.Spec.Restore.BackupID
@@ -408,6 +630,9 @@ func (r *InstanceReconciler) constructSTSandPVCs(ctx context.Context,
 		r.Log.Error(err, "failed to create a StatefulSet", "sts", sts)
 		return err, nil, nil
 	}
+	if err := r.keepVolumeClaimSizes(ctx, sts); err != nil {
+		return err, nil, nil
+	}
 	r.Log.Info("StatefulSet constructed", "sts", sts, "sts.Status", sts.Status, "inst.Status", inst.Status)
 	return nil, sts, newPVCs
 }
@@ -25,9 +25,12 @@ import (
 	"github.com/go-logr/logr"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	lropb "google.golang.org/genproto/googleapis/longrunning"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/util/retry"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -35,7 +38,12 @@ import (
 
 	commonv1alpha1 "github.com/GoogleCloudPlatform/elcarro-oracle-operator/common/api/v1alpha1"
 	"github.com/GoogleCloudPlatform/elcarro-oracle-operator/oracle/api/v1alpha1"
+	"github.com/GoogleCloudPlatform/elcarro-oracle-operator/oracle/controllers"
 	"github.com/GoogleCloudPlatform/elcarro-oracle-operator/oracle/controllers/testhelpers"
+	"github.com/GoogleCloudPlatform/elcarro-oracle-operator/oracle/pkg/agents/common/mtls"
+	capb "github.com/GoogleCloudPlatform/elcarro-oracle-operator/oracle/pkg/agents/config_agent/protos"
+	"github.com/GoogleCloudPlatform/elcarro-oracle-operator/oracle/pkg/agents/consts"
+	dbdpb "github.com/GoogleCloudPlatform/elcarro-oracle-operator/oracle/pkg/agents/oracle"
 	"github.com/GoogleCloudPlatform/elcarro-oracle-operator/oracle/pkg/k8s"
 )
 
@@ -55,7 +63,7 @@ var (
 func TestInstanceController(t *testing.T) {
 
 	// Mock functions
-	CheckStatusInstanceFunc = func(ctx context.Context, instName, cdbName, clusterIP, DBDomain string, log logr.Logger) (string, error) {
+	CheckStatusInstanceFunc = func(ctx context.Context, r client.Reader, namespace, instName, cdbName, clusterIP, DBDomain string, log logr.Logger) (string, error) {
 		return "Ready", nil
 	}
 
@@ -218,13 +226,92 @@ var _ = Describe("Instance controller", func() {
 		})
 	})
 
+	Context("Archived redo log shipping", func() {
+		It("should ship archived logs for a ready instance", func() {
+			fakeClientFactory.Reset()
+			ctx := context.Background()
+			objKey := client.ObjectKey{Namespace: Namespace, Name: "archivelog-test-inst"}
+			instance := createSimpleInstance(ctx, objKey.Name, objKey.Namespace, timeout, interval)
+
+			By("enabling the ArchiveLogShipping service")
+			Expect(retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+				if err := k8sClient.Get(ctx, objKey, instance); err != nil {
+					return err
+				}
+				instance.Spec.Services = map[commonv1alpha1.Service]bool{commonv1alpha1.ArchiveLogShipping: true}
+				instance.Spec.ArchiveLogShipping = &v1alpha1.ArchiveLogShippingSpec{GcsPath: "gs://bucket/archivelogs"}
+				return k8sClient.Update(ctx, instance)
+			})).Should(Succeed())
+
+			By("checking that archived logs are shipped")
+			Eventually(func() int {
+				return fakeClientFactory.Caclient.ShipArchivedLogsCalledCnt()
+			}, timeout, interval).Should(BeNumerically(">=", 1))
+			Eventually(func() bool {
+				if err := k8sClient.Get(ctx, objKey, instance); err != nil {
+					return false
+				}
+				return instance.Status.ArchiveLogShipping != nil && instance.Status.ArchiveLogShipping.LastRunTime != nil
+			}, timeout, interval).Should(Equal(true))
+			Expect(instance.Status.ArchiveLogShipping.InProgress).Should(BeFalse())
+			Expect(k8s.ConditionReasonEquals(k8s.FindCondition(instance.Status.Conditions, k8s.ArchiveLogShippingReady), k8s.ArchiveLogShippingComplete)).Should(BeTrue())
+
+			By("checking that the next round waits for the shipping interval")
+			shipped := fakeClientFactory.Caclient.ShipArchivedLogsCalledCnt()
+			Consistently(func() int {
+				return fakeClientFactory.Caclient.ShipArchivedLogsCalledCnt()
+			}, 5*time.Second, interval).Should(Equal(shipped))
+
+			Expect(k8sClient.Delete(ctx, instance)).Should(Succeed())
+		})
+
+		It("should record the logs shipped before a failed upload", func() {
+			fakeClientFactory.Reset()
+			fakeClientFactory.Caclient.SetShipArchivedLogsResponse(&dbdpb.ShipArchivedLogsResponse{
+				Incarnation:  2,
+				LastSequence: 12,
+				LastScn:      3456,
+				ShippedCount: 2,
+				ErrorMessage: "failed to upload thread_1_seq_13.arc",
+			})
+			ctx := context.Background()
+			objKey := client.ObjectKey{Namespace: Namespace, Name: "archivelog-failed-inst"}
+			instance := createSimpleInstance(ctx, objKey.Name, objKey.Namespace, timeout, interval)
+
+			Expect(retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+				if err := k8sClient.Get(ctx, objKey, instance); err != nil {
+					return err
+				}
+				instance.Spec.Services = map[commonv1alpha1.Service]bool{commonv1alpha1.ArchiveLogShipping: true}
+				instance.Spec.ArchiveLogShipping = &v1alpha1.ArchiveLogShippingSpec{GcsPath: "gs://bucket/archivelogs"}
+				return k8sClient.Update(ctx, instance)
+			})).Should(Succeed())
+
+			By("checking that the shipped logs and the failure are recorded")
+			Eventually(func() int64 {
+				if err := k8sClient.Get(ctx, objKey, instance); err != nil || instance.Status.ArchiveLogShipping == nil {
+					return 0
+				}
+				return instance.Status.ArchiveLogShipping.LastShippedSequence
+			}, timeout, interval).Should(Equal(int64(12)))
+			Expect(instance.Status.ArchiveLogShipping.LastShippedSCN).Should(Equal(int64(3456)))
+			cond := k8s.FindCondition(instance.Status.Conditions, k8s.ArchiveLogShippingReady)
+			Expect(k8s.ConditionReasonEquals(cond, k8s.ArchiveLogShippingFailed)).Should(BeTrue())
+			Expect(cond.Message).Should(ContainSubstring("thread_1_seq_13.arc"))
+
+			Expect(k8sClient.Delete(ctx, instance)).Should(Succeed())
+		})
+	})
+
 	Context("Existing instance restore from RMAN backup", func() {
 		var fakeConfigAgentClient *testhelpers.FakeConfigAgentClient
+		var maintenanceWindow *commonv1alpha1.MaintenanceWindowSpec
 		oldPreflightFunc := restorePhysicalPreflightCheck
 
 		BeforeEach(func() {
 			fakeClientFactory.Reset()
 			fakeConfigAgentClient = fakeClientFactory.Caclient
+			maintenanceWindow = nil
 
 			fakeConfigAgentClient.SetAsyncPhysicalRestore(true)
 			restorePhysicalPreflightCheck = func(ctx context.Context, r *InstanceReconciler, namespace, instName string) error {
@@ -232,14 +319,19 @@ var _ = Describe("Instance controller", func() {
 			}
 		})
 
-		AfterEach(func() {
-			restorePhysicalPreflightCheck = oldPreflightFunc
-		})
-
 		backupName := "test-backup"
 		backupID := "test-backup-id"
 		objKey := client.ObjectKey{Namespace: Namespace, Name: InstanceName}
 		ctx := context.Background()
+
+		AfterEach(func() {
+			restorePhysicalPreflightCheck = oldPreflightFunc
+
+			By("waiting for the Instance to be deleted")
+			Eventually(func() bool {
+				return k8serrors.IsNotFound(k8sClient.Get(ctx, objKey, &v1alpha1.Instance{}))
+			}, timeout, interval).Should(Equal(true))
+		})
 		restoreRequestTime := metav1.Now()
 
 		createInstanceAndStartRestore := func(mode testhelpers.FakeOperationStatus) (*v1alpha1.Instance, *v1alpha1.Backup) {
@@ -347,6 +439,7 @@ var _ = Describe("Instance controller", func() {
 				if err := k8sClient.Get(ctx, objKey, instance); err != nil {
 					return err
 				}
+				instance.Spec.MaintenanceWindow = maintenanceWindow
 				instance.Spec.Restore = &v1alpha1.RestoreSpec{
 					BackupID:    backupID,
 					BackupType:  "Physical",
@@ -414,6 +507,38 @@ var _ = Describe("Instance controller", func() {
 			Expect(k8sClient.Delete(ctx, backup)).Should(Succeed())
 		})
 
+		It("it should cancel a restore in progress", func() {
+			instance, backup := createInstanceAndStartRestore(testhelpers.StatusRunning)
+
+			By("verifying restore LRO was started")
+			Eventually(func() (string, error) {
+				return getConditionReason(ctx, objKey, k8s.Ready)
+			}, timeout, interval).Should(Equal(k8s.RestoreInProgress))
+
+			By("cancelling the restore")
+			Expect(retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+				if err := k8sClient.Get(ctx, objKey, instance); err != nil {
+					return err
+				}
+				instance.Spec.Restore.Cancel = true
+				return k8sClient.Update(ctx, instance)
+			})).Should(Succeed())
+
+			Eventually(func() (string, error) {
+				return getConditionReason(ctx, objKey, k8s.Ready)
+			}, timeout, interval).Should(Equal(k8s.RestoreCancelled))
+			Expect(fakeConfigAgentClient.CancelOperationCalledCnt()).Should(BeNumerically(">=", 1))
+
+			By("checking that instance Restore section is deleted")
+			Eventually(func() (*v1alpha1.RestoreSpec, error) {
+				err := k8sClient.Get(ctx, objKey, instance)
+				return instance.Spec.Restore, err
+			}, timeout, interval).Should(BeNil())
+
+			Expect(k8sClient.Delete(ctx, instance)).Should(Succeed())
+			Expect(k8sClient.Delete(ctx, backup)).Should(Succeed())
+		})
+
 		It("it should NOT attempt to restore with the same RequestTime", func() {
 			instance, backup := testCaseHappyPathLRORestore()
 
@@ -493,6 +618,46 @@ var _ = Describe("Instance controller", func() {
 			Expect(k8sClient.Delete(ctx, backup)).Should(Succeed())
 		})
 
+		It("it should wait for a maintenance window to restore", func() {
+			oneHour := metav1.Duration{Duration: time.Hour}
+			maintenanceWindow = &commonv1alpha1.MaintenanceWindowSpec{
+				RecurringWindows: []commonv1alpha1.RecurringWindow{{
+					Schedule: fmt.Sprintf("0 %d * * *", time.Now().UTC().Add(2*time.Hour).Hour()),
+					Duration: &oneHour,
+				}},
+			}
+			instance, backup := createInstanceAndStartRestore(testhelpers.StatusRunning)
+
+			By("verifying restore was not started outside of the maintenance window")
+			Consistently(func() (string, error) {
+				return getConditionReason(ctx, objKey, k8s.Ready)
+			}, 2*time.Second, interval).Should(Equal(k8s.CreateComplete))
+			Expect(fakeConfigAgentClient.PhysicalRestoreCalledCnt()).Should(Equal(0))
+
+			By("opening a maintenance window")
+			Expect(retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+				if err := k8sClient.Get(ctx, objKey, instance); err != nil {
+					return err
+				}
+				instance.Spec.MaintenanceWindow.RecurringWindows[0].Schedule = "* * * * *"
+				return k8sClient.Update(ctx, instance)
+			})).Should(Succeed())
+
+			By("verifying restore was started")
+			Eventually(func() (string, error) {
+				return getConditionReason(ctx, objKey, k8s.Ready)
+			}, timeout, interval).Should(Equal(k8s.RestoreInProgress))
+			Eventually(fakeConfigAgentClient.PhysicalRestoreCalledCnt, timeout, interval).Should(Equal(1))
+
+			fakeConfigAgentClient.SetNextGetOperationStatus(testhelpers.StatusDone)
+			Eventually(func() (metav1.ConditionStatus, error) {
+				return getConditionStatus(ctx, objKey, k8s.Ready)
+			}, timeout, interval).Should(Equal(metav1.ConditionTrue))
+
+			Expect(k8sClient.Delete(ctx, instance)).Should(Succeed())
+			Expect(k8sClient.Delete(ctx, backup)).Should(Succeed())
+		})
+
 		It("it should handle failure in LRO operation", func() {
 
 			instance, backup := createInstanceAndStartRestore(testhelpers.StatusDoneWithError)
@@ -650,43 +815,1151 @@ var _ = Describe("Instance controller", func() {
 			Expect(k8sClient.Delete(ctx, instance)).Should(Succeed())
 			Expect(k8sClient.Delete(ctx, backup)).Should(Succeed())
 		})
+
+		startDatabaseRestore := func(instance *v1alpha1.Instance, databaseName string) {
+			By("setting the Databases of the Instance")
+			testhelpers.K8sGetAndUpdateStatusWithRetry(k8sClient, ctx, objKey, &v1alpha1.Instance{}, func(obj *client.Object) {
+				(*obj).(*v1alpha1.Instance).Status.DatabaseNames = []string{"pdb1", "pdb2"}
+				(*obj).(*v1alpha1.Instance).Status.BackupID = backupID
+			})
+
+			By("invoking RMAN restore for a Database")
+			Expect(retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+				if err := k8sClient.Get(ctx, objKey, instance); err != nil {
+					return err
+				}
+				instance.Spec.Restore = &v1alpha1.RestoreSpec{
+					BackupID:     backupID,
+					BackupType:   "Physical",
+					DatabaseName: databaseName,
+					Force:        true,
+					RequestTime:  restoreRequestTime,
+				}
+				return k8sClient.Update(ctx, instance)
+			})).Should(Succeed())
+		}
+
+		It("it should restore a single Database", func() {
+			fakeConfigAgentClient.SetAsyncPhysicalRestore(false)
+			fakeConfigAgentClient.SetNextGetOperationStatus(testhelpers.StatusNotFound)
+
+			instance := createSimpleInstance(ctx, InstanceName, Namespace, timeout, interval)
+			backup := createSimpleRMANBackup(ctx, InstanceName, backupName, backupID, Namespace)
+			startDatabaseRestore(instance, "pdb1")
+
+			By("checking that instance status is Ready")
+			Eventually(func() (string, error) {
+				return getConditionReason(ctx, objKey, k8s.Ready)
+			}, timeout, interval).Should(Equal(k8s.RestoreComplete))
+			Expect(fakeConfigAgentClient.PhysicalRestoreCalledCnt()).Should(Equal(1))
+			Expect(fakeConfigAgentClient.PhysicalRestoreRequest().GetPdbName()).Should(Equal("pdb1"))
+
+			By("checking that the backups of the Instance are still valid")
+			Expect(k8sClient.Get(ctx, objKey, instance)).Should(Succeed())
+			Expect(instance.Status.BackupID).Should(Equal(backupID))
+
+			Expect(k8sClient.Delete(ctx, instance)).Should(Succeed())
+			Expect(k8sClient.Delete(ctx, backup)).Should(Succeed())
+		})
+
+		It("it should fail to restore an unknown Database", func() {
+			instance := createSimpleInstance(ctx, InstanceName, Namespace, timeout, interval)
+			backup := createSimpleRMANBackup(ctx, InstanceName, backupName, backupID, Namespace)
+			startDatabaseRestore(instance, "pdb3")
+
+			By("checking that instance has RestoreFailed status")
+			Eventually(func() (string, error) {
+				return getConditionReason(ctx, objKey, k8s.Ready)
+			}, timeout, interval).Should(Equal(k8s.RestoreFailed))
+			Expect(fakeConfigAgentClient.PhysicalRestoreCalledCnt()).Should(Equal(0))
+
+			Expect(k8sClient.Delete(ctx, instance)).Should(Succeed())
+			Expect(k8sClient.Delete(ctx, backup)).Should(Succeed())
+		})
+
+		startSwitchToCopy := func(instance *v1alpha1.Instance) {
+			By("invoking a switch to copy restore for an Instance")
+			Expect(retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+				if err := k8sClient.Get(ctx, objKey, instance); err != nil {
+					return err
+				}
+				instance.Spec.Restore = &v1alpha1.RestoreSpec{
+					BackupID:     backupID,
+					BackupType:   "Physical",
+					SwitchToCopy: true,
+					Force:        true,
+					RequestTime:  restoreRequestTime,
+				}
+				return k8sClient.Update(ctx, instance)
+			})).Should(Succeed())
+		}
+
+		makeIncrementallyUpdated := func(backup *v1alpha1.Backup) {
+			By("turning the backup into an incrementally updated backup of image copies")
+			Expect(retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+				if err := k8sClient.Get(ctx, client.ObjectKeyFromObject(backup), backup); err != nil {
+					return err
+				}
+				falseVar := false
+				backup.Spec.Backupset = &falseVar
+				backup.Spec.IncrementallyUpdated = true
+				return k8sClient.Update(ctx, backup)
+			})).Should(Succeed())
+		}
+
+		It("it should switch to the image copies of an incrementally updated backup", func() {
+			fakeConfigAgentClient.SetAsyncPhysicalRestore(false)
+			fakeConfigAgentClient.SetNextGetOperationStatus(testhelpers.StatusNotFound)
+
+			instance := createSimpleInstance(ctx, InstanceName, Namespace, timeout, interval)
+			backup := createSimpleRMANBackup(ctx, InstanceName, backupName, backupID, Namespace)
+			makeIncrementallyUpdated(backup)
+			startSwitchToCopy(instance)
+
+			By("checking that instance status is Ready")
+			Eventually(func() (string, error) {
+				return getConditionReason(ctx, objKey, k8s.Ready)
+			}, timeout, interval).Should(Equal(k8s.RestoreComplete))
+			Expect(fakeConfigAgentClient.PhysicalRestoreCalledCnt()).Should(Equal(1))
+			Expect(fakeConfigAgentClient.PhysicalRestoreRequest().GetSwitchToCopy()).Should(BeTrue())
+
+			Expect(k8sClient.Delete(ctx, instance)).Should(Succeed())
+			Expect(k8sClient.Delete(ctx, backup)).Should(Succeed())
+		})
+
+		It("it should fail to switch to the copies of an older incrementally updated backup", func() {
+			instance := createSimpleInstance(ctx, InstanceName, Namespace, timeout, interval)
+			backup := createSimpleRMANBackup(ctx, InstanceName, backupName, backupID, Namespace)
+			makeIncrementallyUpdated(backup)
+			// Creation timestamps have a resolution of a second.
+			time.Sleep(time.Second)
+			newerBackup := createSimpleRMANBackup(ctx, InstanceName, backupName+"-newer", backupID+"-newer", Namespace)
+			makeIncrementallyUpdated(newerBackup)
+			startSwitchToCopy(instance)
+
+			By("checking that instance has RestoreFailed status")
+			Eventually(func() (string, error) {
+				return getConditionReason(ctx, objKey, k8s.Ready)
+			}, timeout, interval).Should(Equal(k8s.RestoreFailed))
+			Expect(fakeConfigAgentClient.PhysicalRestoreCalledCnt()).Should(Equal(0))
+
+			Expect(k8sClient.Delete(ctx, instance)).Should(Succeed())
+			Expect(k8sClient.Delete(ctx, backup)).Should(Succeed())
+			Expect(k8sClient.Delete(ctx, newerBackup)).Should(Succeed())
+		})
+
+		It("it should fail to switch to the copies of a regular backup", func() {
+			instance := createSimpleInstance(ctx, InstanceName, Namespace, timeout, interval)
+			backup := createSimpleRMANBackup(ctx, InstanceName, backupName, backupID, Namespace)
+			startSwitchToCopy(instance)
+
+			By("checking that instance has RestoreFailed status")
+			Eventually(func() (string, error) {
+				return getConditionReason(ctx, objKey, k8s.Ready)
+			}, timeout, interval).Should(Equal(k8s.RestoreFailed))
+			Expect(fakeConfigAgentClient.PhysicalRestoreCalledCnt()).Should(Equal(0))
+
+			Expect(k8sClient.Delete(ctx, instance)).Should(Succeed())
+			Expect(k8sClient.Delete(ctx, backup)).Should(Succeed())
+		})
 	})
-})
 
-func TestSanityCheckForReservedParameters(t *testing.T) {
-	twoHourBefore := metav1.NewTime(time.Now().Add(-2 * time.Hour))
-	oneHourBefore := metav1.NewTime(time.Now().Add(-time.Hour))
-	oneHourAfter := metav1.NewTime(time.Now().Add(time.Hour))
-	oneHour := metav1.Duration{Duration: time.Hour}
-	twoHours := metav1.Duration{Duration: 2 * time.Hour}
+	Context("Clone from a backup of another Instance", func() {
+		oldPreflightFunc := restorePhysicalPreflightCheck
 
-	tests := []struct {
-		name              string
-		parameterKey      string
-		parameterVal      string
-		expectedError     bool
-		maintenanceWindow commonv1alpha1.MaintenanceWindowSpec
-	}{
-		{
-			name:              "should return error for reserved parameters",
-			parameterKey:      "audit_trail",
-			parameterVal:      "/some/directory",
-			expectedError:     true,
-			maintenanceWindow: commonv1alpha1.MaintenanceWindowSpec{TimeRanges: []commonv1alpha1.TimeRange{{Start: &oneHourBefore, Duration: &twoHours}}},
-		},
-		{
-			name:              "should not return error for valid parameters",
-			parameterKey:      "parallel_servers_target",
-			parameterVal:      "15",
-			expectedError:     false,
-			maintenanceWindow: commonv1alpha1.MaintenanceWindowSpec{TimeRanges: []commonv1alpha1.TimeRange{{Start: &oneHourBefore, Duration: &twoHours}}},
-		},
-		{
-			name:              "should return error for elapsed past time range",
-			parameterKey:      "parallel_servers_target",
-			parameterVal:      "15",
-			expectedError:     true,
-			maintenanceWindow: commonv1alpha1.MaintenanceWindowSpec{TimeRanges: []commonv1alpha1.TimeRange{{Start: &twoHourBefore, Duration: &oneHour}}},
+		BeforeEach(func() {
+			fakeClientFactory.Reset()
+			fakeClientFactory.Caclient.SetAsyncPhysicalRestore(true)
+			restorePhysicalPreflightCheck = func(ctx context.Context, r *InstanceReconciler, namespace, instName string) error {
+				return nil
+			}
+		})
+
+		AfterEach(func() {
+			restorePhysicalPreflightCheck = oldPreflightFunc
+		})
+
+		It("should restore a physical backup of another namespace into a new Instance", func() {
+			ctx := context.Background()
+			fakeConfigAgentClient := fakeClientFactory.Caclient
+			const (
+				sourceNamespace = "clone-source"
+				backupID        = "source-backup-id"
+			)
+			objKey := client.ObjectKey{Namespace: Namespace, Name: "clone-inst"}
+
+			By("creating the source Instance and its backup in another namespace")
+			Expect(k8sClient.Create(ctx, &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: sourceNamespace}})).Should(Succeed())
+			source := &v1alpha1.Instance{
+				ObjectMeta: metav1.ObjectMeta{Name: "source-inst", Namespace: sourceNamespace},
+				Spec: v1alpha1.InstanceSpec{
+					CDBName:      "PROD",
+					InstanceSpec: commonv1alpha1.InstanceSpec{Images: images},
+				},
+			}
+			Expect(k8sClient.Create(ctx, source)).Should(Succeed())
+			trueVar := true
+			backup := &v1alpha1.Backup{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:   sourceNamespace,
+					Name:        "source-backup",
+					Annotations: map[string]string{v1alpha1.CloneNamespacesAnnotation: "other, " + Namespace},
+				},
+				Spec: v1alpha1.BackupSpec{
+					BackupSpec: commonv1alpha1.BackupSpec{
+						Instance: source.Name,
+						Type:     commonv1alpha1.BackupTypePhysical,
+					},
+					Subtype:                     "Instance",
+					Backupset:                   &trueVar,
+					GcsPath:                     "gs://bucket/source-backup",
+					StorageCredentialsSecretRef: &corev1.LocalObjectReference{Name: "source-creds"},
+				},
+			}
+			Expect(k8sClient.Create(ctx, backup)).Should(Succeed())
+			backup.Status = v1alpha1.BackupStatus{
+				BackupStatus: commonv1alpha1.BackupStatus{
+					Conditions: []metav1.Condition{{
+						Type:               k8s.Ready,
+						Status:             metav1.ConditionTrue,
+						Reason:             k8s.BackupReady,
+						LastTransitionTime: metav1.Now().Rfc3339Copy(),
+					}},
+					Phase: commonv1alpha1.BackupSucceeded,
+				},
+				BackupID: backupID,
+			}
+			Expect(k8sClient.Status().Update(ctx, backup)).Should(Succeed())
+
+			By("creating a new Instance from the backup")
+			creds := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "clone-creds", Namespace: Namespace},
+				Data:       map[string][]byte{"access_key_id": []byte("clone-key")},
+			}
+			Expect(k8sClient.Create(ctx, creds)).Should(Succeed())
+			fakeConfigAgentClient.SetNextGetOperationStatus(testhelpers.StatusRunning)
+			instance := &v1alpha1.Instance{
+				ObjectMeta: metav1.ObjectMeta{Name: objKey.Name, Namespace: objKey.Namespace},
+				Spec: v1alpha1.InstanceSpec{
+					CDBName:      "QA",
+					InstanceSpec: commonv1alpha1.InstanceSpec{Images: images},
+					Restore: &v1alpha1.RestoreSpec{
+						BackupID:                    backupID,
+						BackupType:                  "Physical",
+						BackupNamespace:             sourceNamespace,
+						StorageCredentialsSecretRef: &corev1.LocalObjectReference{Name: creds.Name},
+						RequestTime:                 metav1.Now(),
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, instance)).Should(Succeed())
+			Eventually(func() (string, error) {
+				return getConditionReason(ctx, objKey, k8s.Ready)
+			}, timeout, interval).Should(Equal(k8s.CreateInProgress))
+
+			By("setting the new Instance as Ready")
+			testhelpers.K8sGetAndUpdateStatusWithRetry(k8sClient, ctx, objKey, &v1alpha1.Instance{}, func(obj *client.Object) {
+				(*obj).(*v1alpha1.Instance).Status = v1alpha1.InstanceStatus{
+					InstanceStatus: commonv1alpha1.InstanceStatus{
+						Conditions: []metav1.Condition{
+							{
+								Type:               k8s.Ready,
+								Status:             metav1.ConditionTrue,
+								Reason:             k8s.CreateComplete,
+								LastTransitionTime: metav1.Now().Rfc3339Copy(),
+							},
+							{
+								Type:               k8s.DatabaseInstanceReady,
+								Status:             metav1.ConditionTrue,
+								Reason:             k8s.CreateComplete,
+								LastTransitionTime: metav1.Now().Rfc3339Copy(),
+							},
+						},
+					},
+				}
+			})
+
+			By("checking that the backup is restored without the force flag")
+			Eventually(func() (string, error) {
+				return getConditionReason(ctx, objKey, k8s.Ready)
+			}, timeout, interval).Should(Equal(k8s.RestoreInProgress))
+			Eventually(fakeConfigAgentClient.PhysicalRestoreCalledCnt, timeout, interval).Should(Equal(1))
+			restoreReq := fakeConfigAgentClient.PhysicalRestoreRequest()
+			Expect(restoreReq.GetCdbName()).Should(Equal("QA"))
+			Expect(restoreReq.GetSourceCdbName()).Should(Equal("PROD"))
+			Expect(restoreReq.GetGcsPath()).Should(Equal(backup.Spec.GcsPath))
+			Expect(restoreReq.GetStorageCredentials()).Should(Equal(map[string]string{"access_key_id": "clone-key"}))
+
+			By("checking that the database is renamed once the restore is done")
+			fakeConfigAgentClient.SetNextGetOperationStatus(testhelpers.StatusDone)
+			Eventually(func() (metav1.ConditionStatus, error) {
+				return getConditionStatus(ctx, objKey, k8s.Ready)
+			}, timeout, interval).Should(Equal(metav1.ConditionTrue))
+			Expect(fakeConfigAgentClient.ChangeDatabaseIdentityCalledCnt()).Should(Equal(1))
+			Expect(fakeConfigAgentClient.ChangeDatabaseIdentityRequest().GetCdbName()).Should(Equal("QA"))
+			Expect(k8sClient.Get(ctx, objKey, instance)).Should(Succeed())
+			Expect(instance.Spec.Restore).Should(BeNil())
+
+			By("checking that a backup which doesn't allow the clone isn't restored")
+			testhelpers.K8sGetAndUpdateWithRetry(k8sClient, ctx, client.ObjectKeyFromObject(backup), backup, func(obj *client.Object) {
+				(*obj).(*v1alpha1.Backup).Annotations = nil
+			})
+			testhelpers.K8sGetAndUpdateWithRetry(k8sClient, ctx, objKey, instance, func(obj *client.Object) {
+				(*obj).(*v1alpha1.Instance).Spec.Restore = &v1alpha1.RestoreSpec{
+					BackupID:        backupID,
+					BackupType:      "Physical",
+					BackupNamespace: sourceNamespace,
+					Force:           true,
+					RequestTime:     metav1.Now(),
+				}
+			})
+			Eventually(func() (string, error) {
+				return getConditionReason(ctx, objKey, k8s.Ready)
+			}, timeout, interval).Should(Equal(k8s.RestoreFailed))
+			Expect(fakeConfigAgentClient.PhysicalRestoreCalledCnt()).Should(Equal(1))
+
+			Expect(k8sClient.Delete(ctx, instance)).Should(Succeed())
+			Expect(k8sClient.Delete(ctx, backup)).Should(Succeed())
+			Expect(k8sClient.Delete(ctx, source)).Should(Succeed())
+			Expect(k8sClient.Delete(ctx, creds)).Should(Succeed())
+			Eventually(func() bool {
+				return k8serrors.IsNotFound(k8sClient.Get(ctx, objKey, &v1alpha1.Instance{}))
+			}, timeout, interval).Should(Equal(true))
+		})
+	})
+
+	Context("Agent mutual TLS", func() {
+		It("should issue the agent certificates and mount them into the pods", func() {
+			fakeClientFactory.Reset()
+			ctx := context.Background()
+			objKey := client.ObjectKey{Namespace: Namespace, Name: "agent-tls-test-inst"}
+			instance := createSimpleInstance(ctx, objKey.Name, objKey.Namespace, timeout, interval)
+
+			By("checking that the agent certificate is issued by the agent CA")
+			var caSecret, tlsSecret corev1.Secret
+			Expect(k8sClient.Get(ctx, client.ObjectKey{Namespace: Namespace, Name: fmt.Sprintf(controllers.AgentCASecretName, objKey.Name)}, &caSecret)).Should(Succeed())
+			Expect(k8sClient.Get(ctx, client.ObjectKey{Namespace: Namespace, Name: fmt.Sprintf(controllers.AgentTLSSecretName, objKey.Name)}, &tlsSecret)).Should(Succeed())
+			Expect(tlsSecret.Type).Should(Equal(corev1.SecretTypeTLS))
+			Expect(tlsSecret.Data[mtls.CAFile]).Should(Equal(caSecret.Data[mtls.CAFile]))
+			Expect(mtls.ExpiresBefore(tlsSecret.Data[mtls.CertFile], time.Now().Add(24*time.Hour))).Should(BeFalse())
+			Expect(mtls.Role(tlsSecret.Data[mtls.CertFile])).Should(Equal(mtls.RoleDatabase))
+
+			By("checking that the Config Agent and the operator have their own certificates")
+			var agentSecret, operatorSecret corev1.Secret
+			Expect(k8sClient.Get(ctx, client.ObjectKey{Namespace: Namespace, Name: fmt.Sprintf(controllers.ConfigAgentTLSSecretName, objKey.Name)}, &agentSecret)).Should(Succeed())
+			Expect(mtls.Role(agentSecret.Data[mtls.CertFile])).Should(Equal(mtls.RoleConfigAgent))
+			Expect(k8sClient.Get(ctx, client.ObjectKey{Namespace: Namespace, Name: fmt.Sprintf(controllers.OperatorTLSSecretName, objKey.Name)}, &operatorSecret)).Should(Succeed())
+			Expect(mtls.Role(operatorSecret.Data[mtls.CertFile])).Should(Equal(mtls.RoleOperator))
+
+			By("checking that the agents use the certificate")
+			var sts appsv1.StatefulSet
+			Expect(k8sClient.Get(ctx, client.ObjectKey{Namespace: Namespace, Name: fmt.Sprintf(controllers.StsName, objKey.Name)}, &sts)).Should(Succeed())
+			Expect(sts.Spec.Template.Spec.Volumes).Should(ContainElement(WithTransform(func(v corev1.Volume) string {
+				if v.Secret == nil {
+					return ""
+				}
+				return v.Secret.SecretName
+			}, Equal(tlsSecret.Name))))
+			for _, c := range sts.Spec.Template.Spec.Containers {
+				if c.Name == "dbdaemon" {
+					Expect(c.Args).Should(ContainElement("--tls_cert_dir=" + consts.AgentTLSDir))
+				}
+			}
+			var deployment appsv1.Deployment
+			Expect(k8sClient.Get(ctx, client.ObjectKey{Namespace: Namespace, Name: fmt.Sprintf(controllers.AgentDeploymentName, objKey.Name)}, &deployment)).Should(Succeed())
+			Expect(deployment.Spec.Template.Spec.Containers[0].Args).Should(ContainElement("--tls_cert_dir=" + consts.AgentTLSDir))
+			Expect(controllers.AgentTLSSecret(deployment.Spec.Template.Spec)).Should(Equal(agentSecret.Name))
+
+			Expect(k8sClient.Delete(ctx, instance)).Should(Succeed())
+		})
+
+		It("should roll out the agent certificates to a ready instance", func() {
+			fakeClientFactory.Reset()
+			ctx := context.Background()
+			objKey := client.ObjectKey{Namespace: Namespace, Name: "agent-tls-rollout-inst"}
+			instance := createSimpleInstance(ctx, objKey.Name, objKey.Namespace, timeout, interval)
+
+			By("removing the agent certificates from the workloads as before the mutual TLS")
+			withoutAgentTLS := func(spec *corev1.PodSpec) {
+				spec.Volumes = nil
+				for i := range spec.Containers {
+					spec.Containers[i].VolumeMounts = nil
+					spec.Containers[i].Args = nil
+				}
+			}
+			deploymentKey := client.ObjectKey{Namespace: Namespace, Name: fmt.Sprintf(controllers.AgentDeploymentName, objKey.Name)}
+			var deployment appsv1.Deployment
+			Expect(retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+				if err := k8sClient.Get(ctx, deploymentKey, &deployment); err != nil {
+					return err
+				}
+				withoutAgentTLS(&deployment.Spec.Template.Spec)
+				return k8sClient.Update(ctx, &deployment)
+			})).Should(Succeed())
+			stsKey := client.ObjectKey{Namespace: Namespace, Name: fmt.Sprintf(controllers.StsName, objKey.Name)}
+			var sts appsv1.StatefulSet
+			Expect(retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+				if err := k8sClient.Get(ctx, stsKey, &sts); err != nil {
+					return err
+				}
+				withoutAgentTLS(&sts.Spec.Template.Spec)
+				return k8sClient.Update(ctx, &sts)
+			})).Should(Succeed())
+
+			By("checking that the agent certificates are mounted again")
+			Eventually(func() (bool, error) {
+				if err := k8sClient.Get(ctx, stsKey, &sts); err != nil {
+					return false, err
+				}
+				if err := k8sClient.Get(ctx, deploymentKey, &deployment); err != nil {
+					return false, err
+				}
+				return controllers.HasAgentTLS(sts.Spec.Template.Spec) && controllers.HasAgentTLS(deployment.Spec.Template.Spec), nil
+			}, timeout, interval).Should(BeTrue())
+			Expect(deployment.Spec.Template.Spec.Containers[0].Args).Should(ContainElement("--tls_cert_dir=" + consts.AgentTLSDir))
+
+			Expect(k8sClient.Delete(ctx, instance)).Should(Succeed())
+		})
+	})
+
+	Context("Audit log", func() {
+		It("should anchor the head of the audit log", func() {
+			fakeClientFactory.Reset()
+			fakeClientFactory.Caclient.SetAuditLogHead(&capb.GetAuditLogHeadResponse{Seq: 7, Hash: "abc"})
+			ctx := context.Background()
+			objKey := client.ObjectKey{Namespace: Namespace, Name: "audit-anchor-inst"}
+			instance := createSimpleInstance(ctx, objKey.Name, objKey.Namespace, timeout, interval)
+
+			var anchor *v1alpha1.AuditLogAnchor
+			Eventually(func() (int64, error) {
+				inst := &v1alpha1.Instance{}
+				if err := k8sClient.Get(ctx, objKey, inst); err != nil {
+					return 0, err
+				}
+				anchor = inst.Status.AuditLogAnchor
+				if anchor == nil {
+					return 0, nil
+				}
+				return anchor.Seq, nil
+			}, timeout, interval).Should(Equal(int64(7)))
+			Expect(anchor.Hash).Should(Equal("abc"))
+			Expect(anchor.Time).ShouldNot(BeNil())
+
+			Expect(k8sClient.Delete(ctx, instance)).Should(Succeed())
+		})
+	})
+
+	Context("Data Guard standby", func() {
+		It("should create a standby and switch over to it", func() {
+			fakeClientFactory.Reset()
+			fakeClientFactory.Caclient.SetDataGuardStatus(&capb.DataGuardStatusResponse{DbUniqueName: "GCLOUD", DatabaseRole: "PHYSICAL STANDBY", ApplyLagSeconds: 5})
+			ctx := context.Background()
+			primaryKey := client.ObjectKey{Namespace: Namespace, Name: "replication-primary-inst"}
+			standbyKey := client.ObjectKey{Namespace: Namespace, Name: "replication-standby-inst"}
+			primary := createSimpleInstance(ctx, primaryKey.Name, primaryKey.Namespace, timeout, interval)
+			standby := createSimpleInstance(ctx, standbyKey.Name, standbyKey.Namespace, timeout, interval)
+			secret := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "replication-sys-pw", Namespace: Namespace},
+				Data:       map[string][]byte{"password": []byte("pw")},
+			}
+			Expect(k8sClient.Create(ctx, secret)).Should(Succeed())
+
+			By("making the instance a standby of the primary")
+			Expect(retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+				if err := k8sClient.Get(ctx, standbyKey, standby); err != nil {
+					return err
+				}
+				standby.Spec.DBUniqueName = "GCLOUD_B"
+				standby.Spec.Services = map[commonv1alpha1.Service]bool{commonv1alpha1.ArchiveLogShipping: true}
+				standby.Spec.ArchiveLogShipping = &v1alpha1.ArchiveLogShippingSpec{GcsPath: "gs://bucket/archivelogs"}
+				standby.Spec.Replication = &v1alpha1.ReplicationSpec{
+					PrimaryInstance: primaryKey.Name,
+					PasswordSecretRef: corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: secret.Name},
+						Key:                  "password",
+					},
+				}
+				return k8sClient.Update(ctx, standby)
+			})).Should(Succeed())
+
+			By("checking that the standby is created")
+			Eventually(func() (string, error) {
+				return getConditionReason(ctx, standbyKey, k8s.ReplicationReady)
+			}, timeout, interval).Should(Equal(k8s.CreateComplete))
+			Expect(fakeClientFactory.Caclient.CreateStandbyCalledCnt()).Should(BeNumerically(">=", 1))
+			Expect(fakeClientFactory.Caclient.CreateStandbyRequest().GetDbUniqueName()).Should(Equal("GCLOUD_B"))
+			Expect(fakeClientFactory.Caclient.SetUpDataGuardCalledCnt()).Should(BeNumerically(">=", 2))
+
+			By("checking that the archived logs of the standby are not shipped")
+			Eventually(func() (string, error) {
+				return getConditionReason(ctx, standbyKey, k8s.ArchiveLogShippingReady)
+			}, timeout, interval).Should(Equal(k8s.ArchiveLogShippingDisabled))
+			Expect(fakeClientFactory.Caclient.ShipArchivedLogsCalledCnt()).Should(Equal(0))
+			Eventually(func() (*v1alpha1.ReplicationStatus, error) {
+				err := k8sClient.Get(ctx, standbyKey, standby)
+				return standby.Status.Replication, err
+			}, timeout, interval).Should(And(
+				Not(BeNil()),
+				WithTransform(func(st *v1alpha1.ReplicationStatus) v1alpha1.DatabaseRole { return st.Role }, Equal(v1alpha1.PhysicalStandbyRole)),
+				WithTransform(func(st *v1alpha1.ReplicationStatus) int64 { return st.ApplyLagSeconds }, Equal(int64(5))),
+			))
+
+			By("requesting a switchover")
+			Expect(retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+				if err := k8sClient.Get(ctx, standbyKey, standby); err != nil {
+					return err
+				}
+				standby.Spec.Replication.RoleTransition = &v1alpha1.RoleTransitionSpec{
+					Type:        v1alpha1.Switchover,
+					RequestTime: metav1.Now(),
+				}
+				return k8sClient.Update(ctx, standby)
+			})).Should(Succeed())
+
+			Eventually(func() (string, error) {
+				return getConditionReason(ctx, standbyKey, k8s.ReplicationReady)
+			}, timeout, interval).Should(Equal(k8s.RoleTransitionComplete))
+			Expect(fakeClientFactory.Caclient.SwitchoverCalledCnt()).Should(Equal(1))
+			Expect(fakeClientFactory.Caclient.SwitchoverRequest().GetTargetDbUniqueName()).Should(Equal("GCLOUD_B"))
+			Expect(k8sClient.Get(ctx, standbyKey, standby)).Should(Succeed())
+			Expect(standby.Status.Replication.Role).Should(Equal(v1alpha1.PrimaryRole))
+
+			By("checking that the load balancers follow the roles")
+			Eventually(func() (map[string]string, error) {
+				var svc corev1.Service
+				err := k8sClient.Get(ctx, client.ObjectKey{Namespace: Namespace, Name: fmt.Sprintf(controllers.SvcName, primaryKey.Name)}, &svc)
+				return svc.Spec.Selector, err
+			}, timeout, interval).Should(Equal(map[string]string{"instance": standbyKey.Name}))
+			Eventually(func() (map[string]string, error) {
+				var svc corev1.Service
+				err := k8sClient.Get(ctx, client.ObjectKey{Namespace: Namespace, Name: fmt.Sprintf(controllers.SvcName, standbyKey.Name)}, &svc)
+				return svc.Spec.Selector, err
+			}, timeout, interval).Should(Equal(map[string]string{"instance": primaryKey.Name}))
+
+			Expect(k8sClient.Delete(ctx, standby)).Should(Succeed())
+			Expect(k8sClient.Delete(ctx, primary)).Should(Succeed())
+			Expect(k8sClient.Delete(ctx, secret)).Should(Succeed())
+		})
+
+		It("should fence the primary on a failover", func() {
+			fakeClientFactory.Reset()
+			fakeClientFactory.Caclient.SetDataGuardStatus(&capb.DataGuardStatusResponse{DbUniqueName: "GCLOUD", DatabaseRole: "PHYSICAL STANDBY"})
+			ctx := context.Background()
+			primaryKey := client.ObjectKey{Namespace: Namespace, Name: "failover-primary-inst"}
+			standbyKey := client.ObjectKey{Namespace: Namespace, Name: "failover-standby-inst"}
+			primary := createSimpleInstance(ctx, primaryKey.Name, primaryKey.Namespace, timeout, interval)
+			standby := createSimpleInstance(ctx, standbyKey.Name, standbyKey.Namespace, timeout, interval)
+			secret := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "failover-sys-pw", Namespace: Namespace},
+				Data:       map[string][]byte{"password": []byte("pw")},
+			}
+			Expect(k8sClient.Create(ctx, secret)).Should(Succeed())
+
+			By("making the instance a standby of the primary")
+			Expect(retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+				if err := k8sClient.Get(ctx, standbyKey, standby); err != nil {
+					return err
+				}
+				standby.Spec.DBUniqueName = "GCLOUD_B"
+				standby.Spec.Replication = &v1alpha1.ReplicationSpec{
+					PrimaryInstance: primaryKey.Name,
+					PasswordSecretRef: corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: secret.Name},
+						Key:                  "password",
+					},
+				}
+				return k8sClient.Update(ctx, standby)
+			})).Should(Succeed())
+			Eventually(func() (string, error) {
+				return getConditionReason(ctx, standbyKey, k8s.ReplicationReady)
+			}, timeout, interval).Should(Equal(k8s.CreateComplete))
+			Expect(fakeClientFactory.Caclient.FenceDatabaseCalledCnt()).Should(Equal(0))
+
+			By("requesting a failover")
+			Expect(retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+				if err := k8sClient.Get(ctx, standbyKey, standby); err != nil {
+					return err
+				}
+				standby.Spec.Replication.RoleTransition = &v1alpha1.RoleTransitionSpec{
+					Type:        v1alpha1.Failover,
+					RequestTime: metav1.Now(),
+				}
+				return k8sClient.Update(ctx, standby)
+			})).Should(Succeed())
+
+			Eventually(func() (string, error) {
+				return getConditionReason(ctx, standbyKey, k8s.ReplicationReady)
+			}, timeout, interval).Should(Equal(k8s.RoleTransitionComplete))
+			Expect(fakeClientFactory.Caclient.FailoverCalledCnt()).Should(Equal(1))
+			Expect(fakeClientFactory.Caclient.FenceDatabaseCalledCnt()).Should(BeNumerically(">=", 1))
+			Expect(fakeClientFactory.Caclient.FenceDatabaseRequest().GetCdbName()).Should(Equal(primary.Spec.CDBName))
+			Expect(k8sClient.Get(ctx, standbyKey, standby)).Should(Succeed())
+			Expect(standby.Status.Replication.Role).Should(Equal(v1alpha1.PrimaryRole))
+			Expect(standby.Status.Replication.ReinstateRequired).Should(BeTrue())
+
+			By("checking that the former primary is kept shut down")
+			Eventually(func() (string, error) {
+				return getConditionReason(ctx, primaryKey, k8s.ReplicationReady)
+			}, timeout, interval).Should(Equal(k8s.ReinstateRequired))
+			Eventually(func() (map[string]string, error) {
+				var svc corev1.Service
+				err := k8sClient.Get(ctx, client.ObjectKey{Namespace: Namespace, Name: fmt.Sprintf(controllers.SvcName, primaryKey.Name)}, &svc)
+				return svc.Spec.Selector, err
+			}, timeout, interval).Should(Equal(map[string]string{"instance": standbyKey.Name}))
+
+			Expect(k8sClient.Delete(ctx, standby)).Should(Succeed())
+			Expect(k8sClient.Delete(ctx, primary)).Should(Succeed())
+			Expect(k8sClient.Delete(ctx, secret)).Should(Succeed())
+		})
+	})
+
+	Context("Patching", func() {
+		oldIsStatefulSetPatched := isStatefulSetPatched
+
+		BeforeEach(func() {
+			fakeClientFactory.Reset()
+			// The StatefulSet is rolled out as soon as it's patched.
+			isStatefulSetPatched = func(ctx context.Context, r *InstanceReconciler, inst *v1alpha1.Instance, image string) (bool, error) {
+				sts := &appsv1.StatefulSet{}
+				if err := r.Get(ctx, client.ObjectKey{Namespace: inst.Namespace, Name: fmt.Sprintf(controllers.StsName, inst.Name)}, sts); err != nil {
+					return false, client.IgnoreNotFound(err)
+				}
+				for _, c := range sts.Spec.Template.Spec.Containers {
+					if c.Image == image {
+						return true, nil
+					}
+				}
+				return false, nil
+			}
+		})
+
+		AfterEach(func() {
+			isStatefulSetPatched = oldIsStatefulSetPatched
+		})
+
+		ctx := context.Background()
+
+		// startPatching enables the Patching service of a ready Instance and
+		// returns the pre-patch backup once it's marked as ready.
+		startPatching := func(objKey client.ObjectKey) (*v1alpha1.Instance, *v1alpha1.Backup) {
+			instance := createSimpleInstance(ctx, objKey.Name, objKey.Namespace, timeout, interval)
+			testhelpers.K8sGetAndUpdateStatusWithRetry(k8sClient, ctx, objKey, &v1alpha1.Instance{}, func(obj *client.Object) {
+				(*obj).(*v1alpha1.Instance).Status.CurrentServiceImage = images["service"]
+			})
+
+			By("requesting a patched service image")
+			start := metav1.NewTime(time.Now().Add(-time.Hour))
+			testhelpers.K8sGetAndUpdateWithRetry(k8sClient, ctx, objKey, &v1alpha1.Instance{}, func(obj *client.Object) {
+				inst := (*obj).(*v1alpha1.Instance)
+				inst.Spec.Services = map[commonv1alpha1.Service]bool{commonv1alpha1.Patching: true}
+				inst.Spec.Patching = &commonv1alpha1.PatchingSpec{PatchVersion: "19.10", PatchedServiceImage: "patchedServiceImage"}
+				inst.Spec.MaintenanceWindow = &commonv1alpha1.MaintenanceWindowSpec{
+					TimeRanges: []commonv1alpha1.TimeRange{{Start: &start, Duration: &metav1.Duration{Duration: 2 * time.Hour}}},
+				}
+			})
+
+			By("checking that a pre-patch Snapshot backup is requested")
+			backup := &v1alpha1.Backup{}
+			Eventually(func() error {
+				if err := k8sClient.Get(ctx, objKey, instance); err != nil {
+					return err
+				}
+				if instance.Status.Patching == nil {
+					return errors.New("patching has not started yet")
+				}
+				return k8sClient.Get(ctx, client.ObjectKey{Namespace: objKey.Namespace, Name: instance.Status.Patching.BackupName}, backup)
+			}, timeout, interval).Should(Succeed())
+			Expect(backup.Spec.Type).Should(Equal(commonv1alpha1.BackupTypeSnapshot))
+			Expect(backup.Spec.Instance).Should(Equal(objKey.Name))
+
+			By("marking the backup as ready")
+			backupKey := client.ObjectKey{Namespace: backup.Namespace, Name: backup.Name}
+			testhelpers.K8sGetAndUpdateStatusWithRetry(k8sClient, ctx, backupKey, &v1alpha1.Backup{}, func(obj *client.Object) {
+				(*obj).(*v1alpha1.Backup).Status = v1alpha1.BackupStatus{
+					BackupStatus: commonv1alpha1.BackupStatus{
+						Conditions: []metav1.Condition{{
+							Type:               k8s.Ready,
+							Status:             metav1.ConditionTrue,
+							Reason:             k8s.BackupReady,
+							LastTransitionTime: metav1.Now().Rfc3339Copy(),
+						}},
+						Phase: commonv1alpha1.BackupSucceeded,
+					},
+					BackupID: "prepatch-backup-id",
+				}
+			})
+			return instance, backup
+		}
+
+		// statefulSetImages returns the images of the StatefulSet containers.
+		statefulSetImages := func(objKey client.ObjectKey) []string {
+			sts := &appsv1.StatefulSet{}
+			Expect(k8sClient.Get(ctx, client.ObjectKey{Namespace: objKey.Namespace, Name: fmt.Sprintf(controllers.StsName, objKey.Name)}, sts)).Should(Succeed())
+			var images []string
+			for _, c := range sts.Spec.Template.Spec.Containers {
+				images = append(images, c.Image)
+			}
+			return images
+		}
+
+		It("should record the service image of an existing Instance", func() {
+			objKey := client.ObjectKey{Namespace: Namespace, Name: "patching-backfill-test-inst"}
+			// The status of the Instance is reset, as for an Instance created
+			// before the service image was recorded.
+			instance := createSimpleInstance(ctx, objKey.Name, objKey.Namespace, timeout, interval)
+
+			Eventually(func() (string, error) {
+				err := k8sClient.Get(ctx, objKey, instance)
+				return instance.Status.CurrentServiceImage, err
+			}, timeout, interval).Should(Equal(images["service"]))
+
+			Expect(k8sClient.Delete(ctx, instance)).Should(Succeed())
+		})
+
+		It("should patch the service image and run datapatch", func() {
+			objKey := client.ObjectKey{Namespace: Namespace, Name: "patching-test-inst"}
+			instance, backup := startPatching(objKey)
+
+			By("checking that the patching completes")
+			Eventually(func() (string, error) {
+				err := k8sClient.Get(ctx, objKey, instance)
+				return instance.Status.CurrentServiceImage, err
+			}, timeout, interval).Should(Equal("patchedServiceImage"))
+			Eventually(func() (*v1alpha1.PatchingStatus, error) {
+				err := k8sClient.Get(ctx, objKey, instance)
+				return instance.Status.Patching, err
+			}, timeout, interval).Should(BeNil())
+			Expect(k8s.FindCondition(instance.Status.Conditions, k8s.Ready).Status).Should(Equal(metav1.ConditionTrue))
+			Expect(fakeClientFactory.Caclient.ApplyDatapatchCalledCnt()).Should(Equal(1))
+			Expect(statefulSetImages(objKey)).Should(ContainElement("patchedServiceImage"))
+
+			Expect(k8sClient.Delete(ctx, backup)).Should(Succeed())
+			Expect(k8sClient.Delete(ctx, instance)).Should(Succeed())
+		})
+
+		It("should roll back a failed datapatch", func() {
+			fakeClientFactory.Caclient.SetApplyDatapatchError(errors.New("datapatch failed"))
+			objKey := client.ObjectKey{Namespace: Namespace, Name: "patching-rollback-test-inst"}
+			instance, backup := startPatching(objKey)
+
+			By("checking that the patching is rolled back")
+			Eventually(func() (string, error) {
+				err := k8sClient.Get(ctx, objKey, instance)
+				return instance.Status.LastFailedServiceImage, err
+			}, timeout, interval).Should(Equal("patchedServiceImage"))
+			Expect(instance.Status.CurrentServiceImage).Should(Equal(images["service"]))
+			Expect(instance.Status.Patching).Should(BeNil())
+			Expect(k8s.FindCondition(instance.Status.Conditions, k8s.Ready).Status).Should(Equal(metav1.ConditionTrue))
+			Expect(statefulSetImages(objKey)).ShouldNot(ContainElement("patchedServiceImage"))
+
+			By("checking that the StatefulSet is restored from the pre-patch backup")
+			sts := &appsv1.StatefulSet{}
+			Expect(k8sClient.Get(ctx, client.ObjectKey{Namespace: objKey.Namespace, Name: fmt.Sprintf(controllers.StsName, objKey.Name)}, sts)).Should(Succeed())
+			for _, pvc := range sts.Spec.VolumeClaimTemplates {
+				Expect(pvc.Spec.DataSource).ShouldNot(BeNil())
+				Expect(pvc.Spec.DataSource.Name).Should(HavePrefix("prepatch-backup-id-"))
+			}
+
+			Expect(k8sClient.Delete(ctx, backup)).Should(Succeed())
+			Expect(k8sClient.Delete(ctx, instance)).Should(Succeed())
+		})
+	})
+
+	Context("Disk resize", func() {
+		ctx := context.Background()
+		objKey := client.ObjectKey{Namespace: Namespace, Name: "disk-resize-test-inst"}
+		oldRequeueInterval := diskResizeRequeueInterval
+
+		BeforeEach(func() {
+			diskResizeRequeueInterval = time.Second
+		})
+
+		AfterEach(func() {
+			diskResizeRequeueInterval = oldRequeueInterval
+		})
+
+		pvcKey := func(mount string) client.ObjectKey {
+			name := fmt.Sprintf("%s-%s-0", fmt.Sprintf(controllers.PvcMountName, objKey.Name, mount), fmt.Sprintf(controllers.StsName, objKey.Name))
+			return client.ObjectKey{Namespace: objKey.Namespace, Name: name}
+		}
+
+		createBoundPVC := func(mount, storageClass string, size resource.Quantity) {
+			pvc := &corev1.PersistentVolumeClaim{
+				ObjectMeta: metav1.ObjectMeta{Namespace: objKey.Namespace, Name: pvcKey(mount).Name},
+				Spec: corev1.PersistentVolumeClaimSpec{
+					AccessModes:      []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce},
+					Resources:        corev1.ResourceRequirements{Requests: corev1.ResourceList{corev1.ResourceStorage: size}},
+					StorageClassName: &storageClass,
+				},
+			}
+			Expect(k8sClient.Create(ctx, pvc)).Should(Succeed())
+			pvc.Status = corev1.PersistentVolumeClaimStatus{
+				Phase:    corev1.ClaimBound,
+				Capacity: corev1.ResourceList{corev1.ResourceStorage: size},
+			}
+			Expect(k8sClient.Status().Update(ctx, pvc)).Should(Succeed())
+		}
+
+		setDiskSize := func(name string, size resource.Quantity) {
+			instance := &v1alpha1.Instance{}
+			Expect(retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+				if err := k8sClient.Get(ctx, objKey, instance); err != nil {
+					return err
+				}
+				for i := range instance.Spec.Disks {
+					if instance.Spec.Disks[i].Name == name {
+						instance.Spec.Disks[i].Size = size
+					}
+				}
+				return k8sClient.Update(ctx, instance)
+			})).Should(Succeed())
+		}
+
+		disksReadyReason := func() (string, error) {
+			return getConditionReason(ctx, objKey, k8s.DisksReady)
+		}
+
+		It("should expand the PVCs and reject shrinking", func() {
+			allowExpansion := true
+			storageClass := &storagev1.StorageClass{
+				ObjectMeta:           metav1.ObjectMeta{Name: "expandable"},
+				Provisioner:          "kubernetes.io/no-provisioner",
+				AllowVolumeExpansion: &allowExpansion,
+			}
+			Expect(k8sClient.Create(ctx, storageClass)).Should(Succeed())
+
+			By("creating a ready Instance")
+			instance := &v1alpha1.Instance{
+				ObjectMeta: metav1.ObjectMeta{Name: objKey.Name, Namespace: objKey.Namespace},
+				Spec: v1alpha1.InstanceSpec{
+					CDBName: "GCLOUD",
+					InstanceSpec: commonv1alpha1.InstanceSpec{
+						Images: images,
+						Disks: []commonv1alpha1.DiskSpec{
+							{Name: "DataDisk", Size: resource.MustParse("100Gi"), StorageClass: "expandable"},
+							{Name: "LogDisk", Size: resource.MustParse("150Gi"), StorageClass: "expandable"},
+						},
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, instance)).Should(Succeed())
+			Eventually(func() (string, error) {
+				return getConditionReason(ctx, objKey, k8s.Ready)
+			}, timeout, interval).Should(Equal(k8s.CreateInProgress))
+			createBoundPVC("u02", "expandable", resource.MustParse("100Gi"))
+			createBoundPVC("u03", "expandable", resource.MustParse("150Gi"))
+			Expect(retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+				if err := k8sClient.Get(ctx, objKey, instance); err != nil {
+					return err
+				}
+				k8s.InstanceUpsertCondition(&instance.Status, k8s.Ready, metav1.ConditionTrue, k8s.CreateComplete, "")
+				k8s.InstanceUpsertCondition(&instance.Status, k8s.DatabaseInstanceReady, metav1.ConditionTrue, k8s.CreateComplete, "")
+				return k8sClient.Status().Update(ctx, instance)
+			})).Should(Succeed())
+
+			By("increasing the size of the data disk")
+			setDiskSize("DataDisk", resource.MustParse("200Gi"))
+			Eventually(func() (string, error) {
+				pvc := &corev1.PersistentVolumeClaim{}
+				err := k8sClient.Get(ctx, pvcKey("u02"), pvc)
+				size := pvc.Spec.Resources.Requests[corev1.ResourceStorage]
+				return size.String(), err
+			}, timeout, interval).Should(Equal("200Gi"))
+			Eventually(disksReadyReason, timeout, interval).Should(Equal(k8s.DiskResizeInProgress))
+
+			By("reporting a file system resize that waits for a restart")
+			pvc := &corev1.PersistentVolumeClaim{}
+			Expect(k8sClient.Get(ctx, pvcKey("u02"), pvc)).Should(Succeed())
+			pvc.Status.Conditions = []corev1.PersistentVolumeClaimCondition{{
+				Type:               corev1.PersistentVolumeClaimFileSystemResizePending,
+				Status:             corev1.ConditionTrue,
+				LastTransitionTime: metav1.NewTime(time.Now().Add(-time.Hour)),
+			}}
+			Expect(k8sClient.Status().Update(ctx, pvc)).Should(Succeed())
+			Eventually(disksReadyReason, timeout, interval).Should(Equal(k8s.DiskResizeRestartRequired))
+
+			By("completing the resize of the volume")
+			Expect(k8sClient.Get(ctx, pvcKey("u02"), pvc)).Should(Succeed())
+			pvc.Status.Conditions = nil
+			pvc.Status.Capacity = corev1.ResourceList{corev1.ResourceStorage: resource.MustParse("200Gi")}
+			Expect(k8sClient.Status().Update(ctx, pvc)).Should(Succeed())
+			Eventually(disksReadyReason, timeout, interval).Should(Equal(k8s.DiskResizeComplete))
+
+			By("rejecting a smaller size of the log disk")
+			setDiskSize("LogDisk", resource.MustParse("100Gi"))
+			Eventually(disksReadyReason, timeout, interval).Should(Equal(k8s.DiskResizeFailed))
+			Expect(k8sClient.Get(ctx, pvcKey("u03"), pvc)).Should(Succeed())
+			size := pvc.Spec.Resources.Requests[corev1.ResourceStorage]
+			Expect(size.String()).Should(Equal("150Gi"))
+
+			By("accepting the spec again once the size is restored")
+			setDiskSize("LogDisk", resource.MustParse("150Gi"))
+			Eventually(disksReadyReason, timeout, interval).Should(Equal(k8s.DiskResizeComplete))
+
+			Expect(k8sClient.Delete(ctx, instance)).Should(Succeed())
+			Expect(k8sClient.Delete(ctx, storageClass)).Should(Succeed())
+		})
+	})
+
+	Context("Database resources", func() {
+		ctx := context.Background()
+		objKey := client.ObjectKey{Namespace: Namespace, Name: "resources-test-inst"}
+		oldIsStatefulSetRolledOut := isStatefulSetRolledOut
+
+		BeforeEach(func() {
+			fakeClientFactory.Reset()
+			// The StatefulSet is rolled out as soon as it's updated.
+			isStatefulSetRolledOut = func(ctx context.Context, r *InstanceReconciler, inst *v1alpha1.Instance) (bool, error) {
+				return true, nil
+			}
+		})
+
+		AfterEach(func() {
+			isStatefulSetRolledOut = oldIsStatefulSetRolledOut
+		})
+
+		It("should roll out new resources and memory targets in a maintenance window", func() {
+			instance := createSimpleInstance(ctx, objKey.Name, objKey.Namespace, timeout, interval)
+			Eventually(func() (*v1alpha1.DatabaseResourcesStatus, error) {
+				err := k8sClient.Get(ctx, objKey, instance)
+				return instance.Status.DatabaseResources, err
+			}, timeout, interval).ShouldNot(BeNil())
+
+			By("requesting more memory within a maintenance window")
+			fakeClientFactory.Caclient.SetParameterValues(map[string]string{
+				"sga_target":           "1073741824",
+				"pga_aggregate_target": "715653120",
+			})
+			testhelpers.K8sGetAndUpdateWithRetry(k8sClient, ctx, objKey, &v1alpha1.Instance{}, func(obj *client.Object) {
+				inst := (*obj).(*v1alpha1.Instance)
+				inst.Spec.DatabaseResources = corev1.ResourceRequirements{
+					Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("2"), corev1.ResourceMemory: resource.MustParse("8Gi")},
+					Limits:   corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("8Gi")},
+				}
+				inst.Spec.MemoryPercent = 25
+				inst.Spec.MaintenanceWindow = &commonv1alpha1.MaintenanceWindowSpec{
+					RecurringWindows: []commonv1alpha1.RecurringWindow{{Schedule: "* * * * *", Duration: &metav1.Duration{Duration: time.Hour}}},
+				}
+			})
+
+			By("checking that the memory targets and the StatefulSet are updated")
+			Eventually(func() (*v1alpha1.DatabaseResourcesStatus, error) {
+				err := k8sClient.Get(ctx, objKey, instance)
+				return instance.Status.DatabaseResources, err
+			}, timeout, interval).Should(WithTransform(func(st *v1alpha1.DatabaseResourcesStatus) *resource.Quantity {
+				return st.SGATarget
+			}, Not(BeNil())))
+			Expect(fakeClientFactory.Caclient.SetMemoryTargetsCalledCnt()).Should(Equal(1))
+			req := fakeClientFactory.Caclient.SetMemoryTargetsRequest()
+			// 25% of 8Gi for the SGA and the PGA together.
+			Expect(req.GetSgaTargetMb()).Should(Equal(int64(1228)))
+			Expect(req.GetPgaAggregateTargetMb()).Should(Equal(int64(819)))
+			Expect(instance.Status.DatabaseResources.SGATarget.String()).Should(Equal("1228Mi"))
+			Expect(instance.Status.DatabaseResources.MemoryPercent).Should(Equal(25))
+			Expect(k8s.FindCondition(instance.Status.Conditions, k8s.Ready).Reason).Should(Equal(k8s.CreateComplete))
+
+			sts := &appsv1.StatefulSet{}
+			Expect(k8sClient.Get(ctx, client.ObjectKey{Namespace: objKey.Namespace, Name: fmt.Sprintf(controllers.StsName, objKey.Name)}, sts)).Should(Succeed())
+			var memoryLimit string
+			for _, c := range sts.Spec.Template.Spec.Containers {
+				if c.Name == "oracledb" {
+					memoryLimit = c.Resources.Limits.Memory().String()
+				}
+			}
+			Expect(memoryLimit).Should(Equal("8Gi"))
+			// The larger SGA is set once the larger container is up.
+			Expect(fakeClientFactory.Caclient.BounceDatabaseCalledCnt()).Should(Equal(1))
+
+			Expect(k8sClient.Delete(ctx, instance)).Should(Succeed())
+		})
+
+		It("should roll out new resources right away without a maintenance window", func() {
+			objKey := client.ObjectKey{Namespace: Namespace, Name: "resources-no-window-test-inst"}
+			instance := createSimpleInstance(ctx, objKey.Name, objKey.Namespace, timeout, interval)
+			Eventually(func() (*v1alpha1.DatabaseResourcesStatus, error) {
+				err := k8sClient.Get(ctx, objKey, instance)
+				return instance.Status.DatabaseResources, err
+			}, timeout, interval).ShouldNot(BeNil())
+
+			testhelpers.K8sGetAndUpdateWithRetry(k8sClient, ctx, objKey, &v1alpha1.Instance{}, func(obj *client.Object) {
+				inst := (*obj).(*v1alpha1.Instance)
+				inst.Spec.DatabaseResources = corev1.ResourceRequirements{
+					Requests: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("8Gi")},
+				}
+			})
+
+			Eventually(func() (corev1.ResourceList, error) {
+				err := k8sClient.Get(ctx, objKey, instance)
+				return instance.Status.DatabaseResources.Resources.Requests, err
+			}, timeout, interval).Should(HaveKey(corev1.ResourceMemory))
+			Eventually(fakeClientFactory.Caclient.SetMemoryTargetsCalledCnt, timeout, interval).Should(Equal(1))
+			Eventually(func() (string, error) {
+				err := k8sClient.Get(ctx, objKey, instance)
+				return k8s.FindCondition(instance.Status.Conditions, k8s.Ready).Reason, err
+			}, timeout, interval).Should(Equal(k8s.CreateComplete))
+
+			Expect(k8sClient.Delete(ctx, instance)).Should(Succeed())
+		})
+
+		It("should mount new file volumes into the database containers", func() {
+			objKey := client.ObjectKey{Namespace: Namespace, Name: "file-volumes-test-inst"}
+			instance := createSimpleInstance(ctx, objKey.Name, objKey.Namespace, timeout, interval)
+			Eventually(func() (*v1alpha1.DatabaseResourcesStatus, error) {
+				err := k8sClient.Get(ctx, objKey, instance)
+				return instance.Status.DatabaseResources, err
+			}, timeout, interval).ShouldNot(BeNil())
+
+			testhelpers.K8sGetAndUpdateWithRetry(k8sClient, ctx, objKey, &v1alpha1.Instance{}, func(obj *client.Object) {
+				inst := (*obj).(*v1alpha1.Instance)
+				inst.Spec.FileVolumes = []v1alpha1.FileVolume{
+					{Name: "backups", PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: "backup-pvc"}},
+				}
+			})
+
+			stsKey := client.ObjectKey{Namespace: objKey.Namespace, Name: fmt.Sprintf(controllers.StsName, objKey.Name)}
+			Eventually(func() (map[string]string, error) {
+				sts := &appsv1.StatefulSet{}
+				mounts := make(map[string]string)
+				if err := k8sClient.Get(ctx, stsKey, sts); err != nil {
+					return nil, err
+				}
+				for _, c := range sts.Spec.Template.Spec.Containers {
+					for _, m := range c.VolumeMounts {
+						if m.Name == "file-backups" {
+							mounts[c.Name] = m.MountPath
+						}
+					}
+				}
+				return mounts, nil
+			}, timeout, interval).Should(Equal(map[string]string{"oracledb": "/mnt/backups", "dbdaemon": "/mnt/backups"}))
+			Eventually(func() ([]v1alpha1.FileVolume, error) {
+				err := k8sClient.Get(ctx, objKey, instance)
+				return instance.Status.DatabaseResources.FileVolumes, err
+			}, timeout, interval).Should(HaveLen(1))
+
+			Expect(k8sClient.Delete(ctx, instance)).Should(Succeed())
+		})
+
+		It("should not roll out new resources outside of the maintenance window", func() {
+			objKey := client.ObjectKey{Namespace: Namespace, Name: "resources-closed-window-test-inst"}
+			instance := createSimpleInstance(ctx, objKey.Name, objKey.Namespace, timeout, interval)
+			Eventually(func() (*v1alpha1.DatabaseResourcesStatus, error) {
+				err := k8sClient.Get(ctx, objKey, instance)
+				return instance.Status.DatabaseResources, err
+			}, timeout, interval).ShouldNot(BeNil())
+
+			start := metav1.NewTime(time.Now().Add(24 * time.Hour))
+			testhelpers.K8sGetAndUpdateWithRetry(k8sClient, ctx, objKey, &v1alpha1.Instance{}, func(obj *client.Object) {
+				inst := (*obj).(*v1alpha1.Instance)
+				inst.Spec.DatabaseResources = corev1.ResourceRequirements{
+					Requests: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("8Gi")},
+				}
+				inst.Spec.MaintenanceWindow = &commonv1alpha1.MaintenanceWindowSpec{
+					TimeRanges: []commonv1alpha1.TimeRange{{Start: &start, Duration: &metav1.Duration{Duration: time.Hour}}},
+				}
+			})
+
+			Consistently(func() (corev1.ResourceList, error) {
+				err := k8sClient.Get(ctx, objKey, instance)
+				return instance.Status.DatabaseResources.Resources.Requests, err
+			}, 2*time.Second, interval).ShouldNot(HaveKey(corev1.ResourceMemory))
+			Expect(fakeClientFactory.Caclient.SetMemoryTargetsCalledCnt()).Should(Equal(0))
+
+			Expect(k8sClient.Delete(ctx, instance)).Should(Succeed())
+		})
+	})
+
+	Context("Instance deletion", func() {
+		It("should delete long running operations before removing the finalizer", func() {
+			fakeClientFactory.Reset()
+			fakeClientFactory.Caclient.SetOperations([]*lropb.Operation{{Name: "op1"}, {Name: "op2"}})
+			ctx := context.Background()
+			objKey := client.ObjectKey{Namespace: Namespace, Name: "deletion-test-inst"}
+			instance := createSimpleInstance(ctx, objKey.Name, objKey.Namespace, timeout, interval)
+
+			By("checking that the finalizer is added")
+			Eventually(func() ([]string, error) {
+				err := k8sClient.Get(ctx, objKey, instance)
+				return instance.Finalizers, err
+			}, timeout, interval).Should(ContainElement(controllers.FinalizerName))
+
+			By("deleting the Instance")
+			Expect(k8sClient.Delete(ctx, instance)).Should(Succeed())
+			Eventually(func() bool {
+				return k8serrors.IsNotFound(k8sClient.Get(ctx, objKey, &v1alpha1.Instance{}))
+			}, timeout, interval).Should(Equal(true))
+			Expect(fakeClientFactory.Caclient.DeleteOperationCalledCnt()).Should(BeNumerically(">=", 2))
+		})
+	})
+})
+
+func TestMergeResults(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b ctrl.Result
+		want ctrl.Result
+	}{
+		{name: "none", want: ctrl.Result{}},
+		{name: "first only", a: ctrl.Result{RequeueAfter: time.Minute}, want: ctrl.Result{RequeueAfter: time.Minute}},
+		{name: "second only", b: ctrl.Result{RequeueAfter: time.Minute}, want: ctrl.Result{RequeueAfter: time.Minute}},
+		{name: "shorter wins", a: ctrl.Result{RequeueAfter: time.Minute}, b: ctrl.Result{RequeueAfter: time.Second}, want: ctrl.Result{RequeueAfter: time.Second}},
+		{name: "requeue", a: ctrl.Result{Requeue: true}, b: ctrl.Result{RequeueAfter: time.Minute}, want: ctrl.Result{Requeue: true, RequeueAfter: time.Minute}},
+	}
+	for _, tc := range tests {
+		if got := mergeResults(tc.a, tc.b); got != tc.want {
+			t.Errorf("%s: mergeResults(%v, %v) = %v, want %v", tc.name, tc.a, tc.b, got, tc.want)
+		}
+	}
+}
+
+func TestSanityCheckForReservedParameters(t *testing.T) {
+	twoHourBefore := metav1.NewTime(time.Now().Add(-2 * time.Hour))
+	oneHourBefore := metav1.NewTime(time.Now().Add(-time.Hour))
+	oneHourAfter := metav1.NewTime(time.Now().Add(time.Hour))
+	oneHour := metav1.Duration{Duration: time.Hour}
+	twoHours := metav1.Duration{Duration: 2 * time.Hour}
+
+	tests := []struct {
+		name              string
+		parameterKey      string
+		parameterVal      string
+		expectedError     bool
+		maintenanceWindow commonv1alpha1.MaintenanceWindowSpec
+	}{
+		{
+			name:              "should return error for reserved parameters",
+			parameterKey:      "audit_trail",
+			parameterVal:      "/some/directory",
+			expectedError:     true,
+			maintenanceWindow: commonv1alpha1.MaintenanceWindowSpec{TimeRanges: []commonv1alpha1.TimeRange{{Start: &oneHourBefore, Duration: &twoHours}}},
+		},
+		{
+			name:              "should not return error for valid parameters",
+			parameterKey:      "parallel_servers_target",
+			parameterVal:      "15",
+			expectedError:     false,
+			maintenanceWindow: commonv1alpha1.MaintenanceWindowSpec{TimeRanges: []commonv1alpha1.TimeRange{{Start: &oneHourBefore, Duration: &twoHours}}},
+		},
+		{
+			name:              "should return error for elapsed past time range",
+			parameterKey:      "parallel_servers_target",
+			parameterVal:      "15",
+			expectedError:     true,
+			maintenanceWindow: commonv1alpha1.MaintenanceWindowSpec{TimeRanges: []commonv1alpha1.TimeRange{{Start: &twoHourBefore, Duration: &oneHour}}},
+		},
+		{
+			name:              "should not return error for current time within a recurring window",
+			parameterKey:      "parallel_servers_target",
+			parameterVal:      "15",
+			expectedError:     false,
+			maintenanceWindow: commonv1alpha1.MaintenanceWindowSpec{RecurringWindows: []commonv1alpha1.RecurringWindow{{Schedule: "* * * * *", Duration: &oneHour}}},
+		},
+		{
+			name:              "should return error for current time not within a recurring window",
+			parameterKey:      "parallel_servers_target",
+			parameterVal:      "15",
+			expectedError:     true,
+			maintenanceWindow: commonv1alpha1.MaintenanceWindowSpec{RecurringWindows: []commonv1alpha1.RecurringWindow{{Schedule: fmt.Sprintf("0 %d * * *", time.Now().UTC().Add(2*time.Hour).Hour()), Duration: &oneHour}}},
 		},
 		{
 			name:              "should return error for current time not within time range",
@@ -18,12 +18,12 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"path"
 	"strings"
 	"time"
 
 	"github.com/go-logr/logr"
 	snapv1 "github.com/kubernetes-csi/external-snapshotter/v2/pkg/apis/volumesnapshot/v1beta1"
-	"google.golang.org/grpc"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
@@ -31,6 +31,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	commonv1alpha1 "github.com/GoogleCloudPlatform/elcarro-oracle-operator/common/api/v1alpha1"
 	"github.com/GoogleCloudPlatform/elcarro-oracle-operator/oracle/api/v1alpha1"
@@ -58,6 +59,8 @@ const (
 	defaultUID                  = int64(54321)
 	defaultGID                  = int64(54322)
 	safeMinMemoryForDBContainer = "4.0Gi"
+	// fileVolumeName is the pod volume name of a file volume of an instance.
+	fileVolumeName = "file-%s"
 )
 
 var (
@@ -343,6 +346,7 @@ func NewAgentDeployment(agentDeployment AgentDeploymentParams) (*appsv1.Deployme
 		fmt.Sprintf("--dbservice=%s", fmt.Sprintf(DbdaemonSvcName, agentDeployment.Inst.Name)),
 		fmt.Sprintf("--dbport=%d", consts.DefaultDBDaemonPort),
 	}
+	configAgentArgs = append(configAgentArgs, agentTLSArgs()...)
 
 	monitoringAgentArgs := []string{
 		fmt.Sprintf("--dbservice=%s", fmt.Sprintf(DbdaemonSvcName, agentDeployment.Inst.Name)),
@@ -402,9 +406,15 @@ func NewAgentDeployment(agentDeployment AgentDeploymentParams) (*appsv1.Deployme
 		}
 	}
 
+	var volumes []corev1.Volume
+	if v := addAgentTLS(fmt.Sprintf(ConfigAgentTLSSecretName, agentDeployment.Inst.Name), containers); v != nil {
+		volumes = append(volumes, *v)
+	}
+
 	podSpec := corev1.PodSpec{
 		SecurityContext: &corev1.PodSecurityContext{},
 		Containers:      containers,
+		Volumes:         volumes,
 		// Add pod affinity for agent pod, so that k8s will try to schedule the agent pod
 		// to the same node where the paired DB pod is located. In this way, we can avoid
 		// unnecessary cross node communication.
@@ -536,6 +546,39 @@ func buildPVCMounts(sp StsParams) []corev1.VolumeMount {
 	return diskMounts
 }
 
+// addFileVolumes mounts the file volumes of the instance as
+// consts.FileVolumesDir/<name> into the containers and returns the volumes.
+func addFileVolumes(inst *v1alpha1.Instance, containers []corev1.Container) []corev1.Volume {
+	var volumes []corev1.Volume
+	for _, fv := range inst.Spec.FileVolumes {
+		name := fmt.Sprintf(fileVolumeName, fv.Name)
+		volumes = append(volumes, corev1.Volume{
+			Name: name,
+			VolumeSource: corev1.VolumeSource{
+				PersistentVolumeClaim: fv.PersistentVolumeClaim,
+				NFS:                   fv.NFS,
+			},
+		})
+		for i := range containers {
+			containers[i].VolumeMounts = append(containers[i].VolumeMounts, corev1.VolumeMount{
+				Name:      name,
+				MountPath: path.Join(consts.FileVolumesDir, fv.Name),
+			})
+		}
+	}
+	return volumes
+}
+
+// DatabaseMemory returns the memory of the database container, which is its
+// memory limit or, without a limit, its memory request.
+func DatabaseMemory(resources corev1.ResourceRequirements) (resource.Quantity, bool) {
+	if m, ok := resources.Limits[corev1.ResourceMemory]; ok {
+		return m, true
+	}
+	m, ok := resources.Requests[corev1.ResourceMemory]
+	return m, ok
+}
+
 // NewPodTemplate returns the pod template for the database statefulset.
 func NewPodTemplate(sp StsParams, cdbName, DBDomain string) corev1.PodTemplateSpec {
 	labels := map[string]string{
@@ -549,6 +592,13 @@ func NewPodTemplate(sp StsParams, cdbName, DBDomain string) corev1.PodTemplateSp
 		minMemoryForDBContainer = sp.Inst.Spec.MinMemoryForDBContainer
 		sp.Log.Info("NewPodTemplate: replacing", "SafeMinMemoryForDBContainer", safeMinMemoryForDBContainer, "sp.Inst.Spec.MinMemoryForDBContainer", sp.Inst.Spec.MinMemoryForDBContainer)
 	}
+	dbResources := *sp.Inst.Spec.DatabaseResources.DeepCopy()
+	if _, ok := DatabaseMemory(dbResources); !ok {
+		if dbResources.Requests == nil {
+			dbResources.Requests = corev1.ResourceList{}
+		}
+		dbResources.Requests[corev1.ResourceMemory] = resource.MustParse(minMemoryForDBContainer)
+	}
 
 	// Kind cluster can only use local images
 	imagePullPolicy := corev1.PullAlways
@@ -560,15 +610,11 @@ func NewPodTemplate(sp StsParams, cdbName, DBDomain string) corev1.PodTemplateSp
 	dataDiskPVC, dataDiskMountName := GetPVCNameAndMount(sp.Inst.Name, "DataDisk")
 	containers := []corev1.Container{
 		{
-			Name: "oracledb",
-			Resources: corev1.ResourceRequirements{
-				Requests: corev1.ResourceList{
-					corev1.ResourceMemory: resource.MustParse(minMemoryForDBContainer),
-				},
-			},
-			Image:   sp.Images["service"],
-			Command: []string{fmt.Sprintf("%s/init_oracle.sh", scriptDir)},
-			Args:    []string{cdbName, DBDomain},
+			Name:      "oracledb",
+			Resources: dbResources,
+			Image:     sp.Images["service"],
+			Command:   []string{fmt.Sprintf("%s/init_oracle.sh", scriptDir)},
+			Args:      []string{cdbName, DBDomain},
 			Ports: []corev1.ContainerPort{
 				{Name: "secure-listener", Protocol: "TCP", ContainerPort: consts.SecureListenerPort},
 				{Name: "ssl-listener", Protocol: "TCP", ContainerPort: consts.SSLListenerPort},
@@ -592,7 +638,7 @@ func NewPodTemplate(sp StsParams, cdbName, DBDomain string) corev1.PodTemplateSp
 			Name:    "dbdaemon",
 			Image:   sp.Images["service"],
 			Command: []string{"/agents/dbdaemon"},
-			Args:    []string{fmt.Sprintf("--cdb_name=%s", cdbName)},
+			Args:    append([]string{fmt.Sprintf("--cdb_name=%s", cdbName)}, agentTLSArgs()...),
 			Ports: []corev1.ContainerPort{
 				{Name: "dbdaemon", Protocol: "TCP", ContainerPort: consts.DefaultDBDaemonPort},
 			},
@@ -632,6 +678,19 @@ func NewPodTemplate(sp StsParams, cdbName, DBDomain string) corev1.PodTemplateSp
 			},
 			ImagePullPolicy: imagePullPolicy,
 		},
+		{
+			Name:    "audit-log-sidecar",
+			Image:   sp.Images["logging_sidecar"],
+			Command: []string{"/logging_main"},
+			Args:    []string{"--logType=AUDIT"},
+			SecurityContext: &corev1.SecurityContext{
+				AllowPrivilegeEscalation: &sp.PrivEscalation,
+			},
+			VolumeMounts: []corev1.VolumeMount{
+				{Name: dataDiskPVC, MountPath: fmt.Sprintf("/%s", dataDiskMountName)},
+			},
+			ImagePullPolicy: imagePullPolicy,
+		},
 	}
 	initContainers := []corev1.Container{
 		{
@@ -658,6 +717,13 @@ func NewPodTemplate(sp StsParams, cdbName, DBDomain string) corev1.PodTemplateSp
 			VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}},
 		},
 	}
+	// The database container and the sidecars reach the Database Daemon
+	// through localhost.
+	if v := addAgentTLS(fmt.Sprintf(AgentTLSSecretName, sp.Inst.Name), containers); v != nil {
+		volumes = append(volumes, *v)
+	}
+	// The oracledb and dbdaemon containers access file:// locations.
+	volumes = append(volumes, addFileVolumes(sp.Inst, containers[:2])...)
 
 	var antiAffinityNamespaces []string
 	if sp.Config != nil && len(sp.Config.Spec.HostAntiAffinityNamespaces) != 0 {
@@ -769,14 +835,14 @@ func NewSnapshotInst(inst *v1alpha1.Instance, scheme *runtime.Scheme, pvcName, s
 // In particular:
 //   - has provisioning finished?
 //   - is Instance up and accepting connection requests?
-var CheckStatusInstanceFunc = func(ctx context.Context, instName, cdbName, clusterIP, DBDomain string, log logr.Logger) (string, error) {
+var CheckStatusInstanceFunc = func(ctx context.Context, r client.Reader, namespace, instName, cdbName, clusterIP, DBDomain string, log logr.Logger) (string, error) {
 	log.Info("resources/checkStatusInstance", "inst name", instName, "clusterIP", clusterIP)
 
 	// Establish a connection to a Config Agent.
 	ctx, cancel := context.WithTimeout(ctx, dialTimeout)
 	defer cancel()
 
-	conn, err := grpc.Dial(fmt.Sprintf("%s:%d", clusterIP, consts.DefaultConfigAgentPort), grpc.WithInsecure())
+	conn, err := DialConfigAgent(ctx, r, namespace, instName, fmt.Sprintf("%s:%d", clusterIP, consts.DefaultConfigAgentPort))
 	if err != nil {
 		log.Error(err, "resources/checkStatusInstance: failed to create a conn via gRPC.Dial")
 		return "", err
@@ -18,6 +18,7 @@ import (
 	"testing"
 
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	commonv1alpha1 "github.com/GoogleCloudPlatform/elcarro-oracle-operator/common/api/v1alpha1"
@@ -106,3 +107,85 @@ func TestBuildPVCMounts(t *testing.T) {
 		})
 	}
 }
+
+func TestDatabaseMemory(t *testing.T) {
+	testCases := []struct {
+		name      string
+		resources corev1.ResourceRequirements
+		want      string
+		wantOK    bool
+	}{
+		{
+			name: "no memory",
+			resources: corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("2")},
+			},
+		},
+		{
+			name: "memory request",
+			resources: corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("8Gi")},
+			},
+			want:   "8Gi",
+			wantOK: true,
+		},
+		{
+			name: "memory limit takes precedence",
+			resources: corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("8Gi")},
+				Limits:   corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("12Gi")},
+			},
+			want:   "12Gi",
+			wantOK: true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, ok := DatabaseMemory(tc.resources)
+			if ok != tc.wantOK {
+				t.Fatalf("DatabaseMemory got ok=%v, want %v", ok, tc.wantOK)
+			}
+			if ok && got.String() != tc.want {
+				t.Errorf("DatabaseMemory got %s, want %s", got.String(), tc.want)
+			}
+		})
+	}
+}
+
+func TestAddFileVolumes(t *testing.T) {
+	inst := &v1alpha1.Instance{
+		Spec: v1alpha1.InstanceSpec{
+			FileVolumes: []v1alpha1.FileVolume{
+				{Name: "backups", PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: "backup-pvc"}},
+				{Name: "exports", NFS: &corev1.NFSVolumeSource{Server: "nfs.example.com", Path: "/exports"}},
+			},
+		},
+	}
+	containers := []corev1.Container{{Name: "oracledb"}, {Name: "dbdaemon"}}
+
+	volumes := addFileVolumes(inst, containers)
+
+	if len(volumes) != 2 {
+		t.Fatalf("addFileVolumes got %d volumes, want 2", len(volumes))
+	}
+	if volumes[0].Name != "file-backups" || volumes[0].PersistentVolumeClaim.ClaimName != "backup-pvc" {
+		t.Errorf("addFileVolumes got volume %+v, want file-backups of claim backup-pvc", volumes[0])
+	}
+	if volumes[1].Name != "file-exports" || volumes[1].NFS.Server != "nfs.example.com" {
+		t.Errorf("addFileVolumes got volume %+v, want file-exports of server nfs.example.com", volumes[1])
+	}
+	for _, c := range containers {
+		want := []corev1.VolumeMount{
+			{Name: "file-backups", MountPath: "/mnt/backups"},
+			{Name: "file-exports", MountPath: "/mnt/exports"},
+		}
+		if len(c.VolumeMounts) != len(want) {
+			t.Fatalf("addFileVolumes got mounts %+v in container %s, want %+v", c.VolumeMounts, c.Name, want)
+		}
+		for i := range want {
+			if c.VolumeMounts[i] != want[i] {
+				t.Errorf("addFileVolumes got mount %+v in container %s, want %+v", c.VolumeMounts[i], c.Name, want[i])
+			}
+		}
+	}
+}
@@ -546,6 +546,7 @@ func getAgentLogs(ctx context.Context, config *rest.Config, namespace, instance,
 		"dbdaemon":             "instance=" + instance,
 		"alert-log-sidecar":    "instance=" + instance,
 		"listener-log-sidecar": "instance=" + instance,
+		"audit-log-sidecar":    "instance=" + instance,
 	}
 
 	clientSet, err := kubernetes.NewForConfig(config)
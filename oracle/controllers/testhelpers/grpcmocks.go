@@ -17,6 +17,8 @@ package testhelpers
 import (
 	"context"
 	"fmt"
+	"strconv"
+	"strings"
 	"sync"
 	"sync/atomic"
 
@@ -26,10 +28,12 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	grpcstatus "google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/anypb"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/GoogleCloudPlatform/elcarro-oracle-operator/oracle/controllers"
 	capb "github.com/GoogleCloudPlatform/elcarro-oracle-operator/oracle/pkg/agents/config_agent/protos"
+	dbdpb "github.com/GoogleCloudPlatform/elcarro-oracle-operator/oracle/pkg/agents/oracle"
 )
 
 // FakeOperationStatus is an enum type for LRO statuses managed by FakeConfigAgentClient.
@@ -46,6 +50,8 @@ const (
 	StatusDoneWithError
 	//StatusNotFound not found.
 	StatusNotFound
+	//StatusDoneCancelled done after a cancellation.
+	StatusDoneCancelled
 )
 
 // FakeConfigAgentClient a client for capturing calls the various ConfigAgent api.
@@ -66,17 +72,61 @@ type FakeConfigAgentClient struct {
 	listOperationsCalledCnt        int32
 	getOperationCalledCnt          int32
 	deleteOperationCalledCnt       int32
+	cancelOperationCalledCnt       int32
 	dataPumpImportCalledCnt        int32
 	dataPumpExportCalledCnt        int32
 	setParameterCalledCnt          int32
 	getParameterTypeValueCalledCnt int32
 	recoverConfigFileCalledCnt     int32
+	shipArchivedLogsCalledCnt      int32
+	deleteDatabaseCalledCnt        int32
+	deleteBackupCalledCnt          int32
+	createStandbyCalledCnt         int32
+	setUpDataGuardCalledCnt        int32
+	dataGuardStatusCalledCnt       int32
+	switchoverCalledCnt            int32
+	failoverCalledCnt              int32
+	fenceDatabaseCalledCnt         int32
+	verifyBackupCalledCnt          int32
+	describeBackupCalledCnt        int32
+	changeDatabaseIdentityCnt      int32
+	syncTablespacesCalledCnt       int32
+	syncRolesCalledCnt             int32
+	syncProfilesCalledCnt          int32
+	applyDatapatchCalledCnt        int32
+	setMemoryTargetsCalledCnt      int32
 
 	lock                         sync.Mutex
 	fetchServiceImageMetaDataCnt int32
 	asyncPhysicalBackup          bool
 	asyncPhysicalRestore         bool
 	nextGetOperationStatus       FakeOperationStatus
+	dataPumpExportStorageCreds   map[string]string
+	deleteDatabaseReq            *capb.DeleteDatabaseRequest
+	deleteBackupReq              *capb.DeleteBackupRequest
+	operations                   []*longrunning.Operation
+	asyncCreateStandby           bool
+	createStandbyReq             *capb.CreateStandbyRequest
+	setUpDataGuardReqs           []*capb.SetUpDataGuardRequest
+	dataGuardStatus              *capb.DataGuardStatusResponse
+	switchoverReq                *capb.SwitchoverRequest
+	failoverReq                  *capb.FailoverRequest
+	fenceDatabaseReq             *capb.FenceDatabaseRequest
+	verifyBackupReq              *capb.VerifyBackupRequest
+	describeBackupResp           *capb.DescribeBackupResponse
+	physicalRestoreReq           *capb.PhysicalRestoreRequest
+	changeDatabaseIdentityReq    *capb.ChangeDatabaseIdentityRequest
+	syncTablespacesReq           *capb.SyncTablespacesRequest
+	syncRolesReq                 *capb.SyncRolesRequest
+	syncProfilesReq              *capb.SyncProfilesRequest
+	createUsersReq               *capb.CreateUsersRequest
+	createUsersErr               error
+	applyDatapatchErr            error
+	setMemoryTargetsReq          *capb.SetMemoryTargetsRequest
+	parameterValues              map[string]string
+	operationProgress            *dbdpb.OperationProgress
+	auditLogHead                 *capb.GetAuditLogHeadResponse
+	shipArchivedLogsResp         *dbdpb.ShipArchivedLogsResponse
 }
 
 var (
@@ -113,11 +163,31 @@ func (cli *FakeConfigAgentClient) CreateDatabase(context.Context, *capb.CreateDa
 }
 
 // CreateUsers wrapper.
-func (cli *FakeConfigAgentClient) CreateUsers(context.Context, *capb.CreateUsersRequest, ...grpc.CallOption) (*capb.CreateUsersResponse, error) {
+func (cli *FakeConfigAgentClient) CreateUsers(ctx context.Context, in *capb.CreateUsersRequest, opts ...grpc.CallOption) (*capb.CreateUsersResponse, error) {
 	atomic.AddInt32(&cli.createUsersCalledCnt, 1)
+	cli.lock.Lock()
+	defer cli.lock.Unlock()
+	cli.createUsersReq = in
+	if cli.createUsersErr != nil {
+		return nil, cli.createUsersErr
+	}
 	return nil, nil
 }
 
+// SetCreateUsersError sets the error returned by CreateUsers.
+func (cli *FakeConfigAgentClient) SetCreateUsersError(err error) {
+	cli.lock.Lock()
+	defer cli.lock.Unlock()
+	cli.createUsersErr = err
+}
+
+// CreateUsersRequest returns the last CreateUsers request.
+func (cli *FakeConfigAgentClient) CreateUsersRequest() *capb.CreateUsersRequest {
+	cli.lock.Lock()
+	defer cli.lock.Unlock()
+	return cli.createUsersReq
+}
+
 // UsersChanged wrapper.
 func (cli *FakeConfigAgentClient) UsersChanged(context.Context, *capb.UsersChangedRequest, ...grpc.CallOption) (*capb.UsersChangedResponse, error) {
 	atomic.AddInt32(&cli.usersChangedCalledCnt, 1)
@@ -137,8 +207,11 @@ func (cli *FakeConfigAgentClient) PhysicalBackup(context.Context, *capb.Physical
 }
 
 // PhysicalRestore wrapper.
-func (cli *FakeConfigAgentClient) PhysicalRestore(context.Context, *capb.PhysicalRestoreRequest, ...grpc.CallOption) (*longrunning.Operation, error) {
+func (cli *FakeConfigAgentClient) PhysicalRestore(ctx context.Context, in *capb.PhysicalRestoreRequest, opts ...grpc.CallOption) (*longrunning.Operation, error) {
 	atomic.AddInt32(&cli.physicalRestoreCalledCnt, 1)
+	cli.lock.Lock()
+	defer cli.lock.Unlock()
+	cli.physicalRestoreReq = in
 	return &longrunning.Operation{Done: !cli.asyncPhysicalRestore}, nil
 }
 
@@ -163,7 +236,9 @@ func (cli *FakeConfigAgentClient) CreateListener(context.Context, *capb.CreateLi
 // ListOperations wrapper.
 func (cli *FakeConfigAgentClient) ListOperations(context.Context, *longrunning.ListOperationsRequest, ...grpc.CallOption) (*longrunning.ListOperationsResponse, error) {
 	atomic.AddInt32(&cli.listOperationsCalledCnt, 1)
-	return nil, nil
+	cli.lock.Lock()
+	defer cli.lock.Unlock()
+	return &longrunning.ListOperationsResponse{Operations: cli.operations}, nil
 }
 
 // GetOperation wrapper.
@@ -183,7 +258,23 @@ func (cli *FakeConfigAgentClient) GetOperation(context.Context, *longrunning.Get
 		}, nil
 
 	case StatusRunning:
-		return &longrunning.Operation{}, nil
+		op := &longrunning.Operation{}
+		if progress := cli.OperationProgress(); progress != nil {
+			md, err := anypb.New(progress)
+			if err != nil {
+				return nil, err
+			}
+			op.Metadata = md
+		}
+		return op, nil
+
+	case StatusDoneCancelled:
+		return &longrunning.Operation{
+			Done: true,
+			Result: &longrunning.Operation_Error{
+				Error: &status.Status{Code: int32(codes.Canceled), Message: "Test Cancelled"},
+			},
+		}, nil
 
 	case StatusNotFound:
 		return nil, grpcstatus.Errorf(codes.NotFound, "")
@@ -202,6 +293,17 @@ func (cli *FakeConfigAgentClient) DeleteOperation(context.Context, *longrunning.
 	return nil, nil
 }
 
+// CancelOperation wrapper, a running operation is done once cancelled.
+func (cli *FakeConfigAgentClient) CancelOperation(context.Context, *longrunning.CancelOperationRequest, ...grpc.CallOption) (*empty.Empty, error) {
+	atomic.AddInt32(&cli.cancelOperationCalledCnt, 1)
+	cli.lock.Lock()
+	defer cli.lock.Unlock()
+	if cli.nextGetOperationStatus == StatusRunning {
+		cli.nextGetOperationStatus = StatusDoneCancelled
+	}
+	return &empty.Empty{}, nil
+}
+
 // CreateCDBUser wrapper.
 func (cli *FakeConfigAgentClient) CreateCDBUser(context.Context, *capb.CreateCDBUserRequest, ...grpc.CallOption) (*capb.CreateCDBUserResponse, error) {
 	atomic.AddInt32(&cli.createListenerCalledCnt, 1)
@@ -227,8 +329,11 @@ func (cli *FakeConfigAgentClient) DataPumpImport(context.Context, *capb.DataPump
 }
 
 // DataPumpExport wrapper.
-func (cli *FakeConfigAgentClient) DataPumpExport(context.Context, *capb.DataPumpExportRequest, ...grpc.CallOption) (*longrunning.Operation, error) {
+func (cli *FakeConfigAgentClient) DataPumpExport(ctx context.Context, req *capb.DataPumpExportRequest, opts ...grpc.CallOption) (*longrunning.Operation, error) {
 	atomic.AddInt32(&cli.dataPumpExportCalledCnt, 1)
+	cli.lock.Lock()
+	defer cli.lock.Unlock()
+	cli.dataPumpExportStorageCreds = req.GetStorageCredentials()
 	return nil, nil
 }
 
@@ -238,6 +343,11 @@ func (cli *FakeConfigAgentClient) BounceDatabase(context.Context, *capb.BounceDa
 	return nil, nil
 }
 
+// BounceDatabaseCalledCnt returns call count.
+func (cli *FakeConfigAgentClient) BounceDatabaseCalledCnt() int {
+	return int(atomic.LoadInt32(&cli.bounceDatabaseCalledCnt))
+}
+
 // DataPumpImportCalledCnt returns call count.
 func (cli *FakeConfigAgentClient) DataPumpImportCalledCnt() int {
 	return int(atomic.LoadInt32(&cli.dataPumpImportCalledCnt))
@@ -248,11 +358,24 @@ func (cli *FakeConfigAgentClient) DataPumpExportCalledCnt() int {
 	return int(atomic.LoadInt32(&cli.dataPumpExportCalledCnt))
 }
 
+// DataPumpExportStorageCredentials returns the storage credentials of the
+// last DataPumpExport call.
+func (cli *FakeConfigAgentClient) DataPumpExportStorageCredentials() map[string]string {
+	cli.lock.Lock()
+	defer cli.lock.Unlock()
+	return cli.dataPumpExportStorageCreds
+}
+
 // DeleteOperationCalledCnt returns call count.
 func (cli *FakeConfigAgentClient) DeleteOperationCalledCnt() int {
 	return int(atomic.LoadInt32(&cli.deleteOperationCalledCnt))
 }
 
+// CancelOperationCalledCnt returns call count.
+func (cli *FakeConfigAgentClient) CancelOperationCalledCnt() int {
+	return int(atomic.LoadInt32(&cli.cancelOperationCalledCnt))
+}
+
 func (cli *FakeConfigAgentClient) PhysicalBackupCalledCnt() int {
 	return int(atomic.LoadInt32(&cli.physicalBackupCalledCnt))
 }
@@ -261,6 +384,13 @@ func (cli *FakeConfigAgentClient) PhysicalRestoreCalledCnt() int {
 	return int(atomic.LoadInt32(&cli.physicalRestoreCalledCnt))
 }
 
+// PhysicalRestoreRequest returns the last PhysicalRestore request.
+func (cli *FakeConfigAgentClient) PhysicalRestoreRequest() *capb.PhysicalRestoreRequest {
+	cli.lock.Lock()
+	defer cli.lock.Unlock()
+	return cli.physicalRestoreReq
+}
+
 func (cli *FakeConfigAgentClient) GetOperationCalledCnt() int {
 	return int(atomic.LoadInt32(&cli.getOperationCalledCnt))
 }
@@ -272,9 +402,25 @@ func (cli *FakeConfigAgentClient) SetParameter(context.Context, *capb.SetParamet
 }
 
 // GetParameterTypeValue wrapper.
-func (cli *FakeConfigAgentClient) GetParameterTypeValue(context.Context, *capb.GetParameterTypeValueRequest, ...grpc.CallOption) (*capb.GetParameterTypeValueResponse, error) {
+func (cli *FakeConfigAgentClient) GetParameterTypeValue(ctx context.Context, in *capb.GetParameterTypeValueRequest, opts ...grpc.CallOption) (*capb.GetParameterTypeValueResponse, error) {
 	atomic.AddInt32(&cli.getParameterTypeValueCalledCnt, 1)
-	return nil, nil
+	cli.lock.Lock()
+	defer cli.lock.Unlock()
+	if cli.parameterValues == nil {
+		return nil, nil
+	}
+	resp := &capb.GetParameterTypeValueResponse{}
+	for _, k := range in.GetKeys() {
+		resp.Values = append(resp.Values, cli.parameterValues[k])
+	}
+	return resp, nil
+}
+
+// SetParameterValues sets the parameter values returned by GetParameterTypeValue.
+func (cli *FakeConfigAgentClient) SetParameterValues(values map[string]string) {
+	cli.lock.Lock()
+	defer cli.lock.Unlock()
+	cli.parameterValues = values
 }
 
 // RecoverConfigFile wrapper.
@@ -288,6 +434,89 @@ func (cli *FakeConfigAgentClient) FetchServiceImageMetaData(ctx context.Context,
 	return nil, nil
 }
 
+// ShipArchivedLogs wrapper, returns a done operation.
+func (cli *FakeConfigAgentClient) ShipArchivedLogs(ctx context.Context, in *capb.ShipArchivedLogsRequest, opts ...grpc.CallOption) (*longrunning.Operation, error) {
+	atomic.AddInt32(&cli.shipArchivedLogsCalledCnt, 1)
+	cli.lock.Lock()
+	shipped := cli.shipArchivedLogsResp
+	cli.lock.Unlock()
+	if shipped == nil {
+		shipped = &dbdpb.ShipArchivedLogsResponse{Incarnation: in.GetIncarnation(), LastSequence: in.GetAfterSequence()}
+	}
+	resp, err := anypb.New(shipped)
+	if err != nil {
+		return nil, err
+	}
+	return &longrunning.Operation{Done: true, Result: &longrunning.Operation_Response{Response: resp}}, nil
+}
+
+// SetShipArchivedLogsResponse sets the response of the ShipArchivedLogs
+// operations.
+func (cli *FakeConfigAgentClient) SetShipArchivedLogsResponse(resp *dbdpb.ShipArchivedLogsResponse) {
+	cli.lock.Lock()
+	defer cli.lock.Unlock()
+	cli.shipArchivedLogsResp = resp
+}
+
+// ShipArchivedLogsCalledCnt returns call count.
+func (cli *FakeConfigAgentClient) ShipArchivedLogsCalledCnt() int {
+	return int(atomic.LoadInt32(&cli.shipArchivedLogsCalledCnt))
+}
+
+// DeleteDatabase wrapper.
+func (cli *FakeConfigAgentClient) DeleteDatabase(ctx context.Context, in *capb.DeleteDatabaseRequest, opts ...grpc.CallOption) (*capb.DeleteDatabaseResponse, error) {
+	atomic.AddInt32(&cli.deleteDatabaseCalledCnt, 1)
+	cli.lock.Lock()
+	defer cli.lock.Unlock()
+	cli.deleteDatabaseReq = in
+	return &capb.DeleteDatabaseResponse{}, nil
+}
+
+// DeleteDatabaseCalledCnt returns call count.
+func (cli *FakeConfigAgentClient) DeleteDatabaseCalledCnt() int {
+	return int(atomic.LoadInt32(&cli.deleteDatabaseCalledCnt))
+}
+
+// DeleteDatabaseRequest returns the last DeleteDatabase request.
+func (cli *FakeConfigAgentClient) DeleteDatabaseRequest() *capb.DeleteDatabaseRequest {
+	cli.lock.Lock()
+	defer cli.lock.Unlock()
+	return cli.deleteDatabaseReq
+}
+
+// DeleteBackup wrapper.
+func (cli *FakeConfigAgentClient) DeleteBackup(ctx context.Context, in *capb.DeleteBackupRequest, opts ...grpc.CallOption) (*capb.DeleteBackupResponse, error) {
+	atomic.AddInt32(&cli.deleteBackupCalledCnt, 1)
+	cli.lock.Lock()
+	defer cli.lock.Unlock()
+	cli.deleteBackupReq = in
+	return &capb.DeleteBackupResponse{}, nil
+}
+
+// DeleteBackupCalledCnt returns call count.
+func (cli *FakeConfigAgentClient) DeleteBackupCalledCnt() int {
+	return int(atomic.LoadInt32(&cli.deleteBackupCalledCnt))
+}
+
+// DeleteBackupRequest returns the last DeleteBackup request.
+func (cli *FakeConfigAgentClient) DeleteBackupRequest() *capb.DeleteBackupRequest {
+	cli.lock.Lock()
+	defer cli.lock.Unlock()
+	return cli.deleteBackupReq
+}
+
+// ListOperationsCalledCnt returns call count.
+func (cli *FakeConfigAgentClient) ListOperationsCalledCnt() int {
+	return int(atomic.LoadInt32(&cli.listOperationsCalledCnt))
+}
+
+// SetOperations sets the operations returned by ListOperations.
+func (cli *FakeConfigAgentClient) SetOperations(operations []*longrunning.Operation) {
+	cli.lock.Lock()
+	defer cli.lock.Unlock()
+	cli.operations = operations
+}
+
 func (cli *FakeConfigAgentClient) SetNextGetOperationStatus(status FakeOperationStatus) {
 	cli.lock.Lock()
 	defer cli.lock.Unlock()
@@ -300,6 +529,21 @@ func (cli *FakeConfigAgentClient) NextGetOperationStatus() FakeOperationStatus {
 	return cli.nextGetOperationStatus
 }
 
+// SetOperationProgress sets the progress reported by GetOperation for a
+// running operation.
+func (cli *FakeConfigAgentClient) SetOperationProgress(progress *dbdpb.OperationProgress) {
+	cli.lock.Lock()
+	defer cli.lock.Unlock()
+	cli.operationProgress = progress
+}
+
+// OperationProgress returns the progress reported for a running operation.
+func (cli *FakeConfigAgentClient) OperationProgress() *dbdpb.OperationProgress {
+	cli.lock.Lock()
+	defer cli.lock.Unlock()
+	return cli.operationProgress
+}
+
 func (cli *FakeConfigAgentClient) SetAsyncPhysicalBackup(async bool) {
 	cli.lock.Lock()
 	defer cli.lock.Unlock()
@@ -311,3 +555,352 @@ func (cli *FakeConfigAgentClient) SetAsyncPhysicalRestore(async bool) {
 	defer cli.lock.Unlock()
 	cli.asyncPhysicalRestore = async
 }
+
+// CreateStandby wrapper.
+func (cli *FakeConfigAgentClient) CreateStandby(ctx context.Context, in *capb.CreateStandbyRequest, opts ...grpc.CallOption) (*longrunning.Operation, error) {
+	atomic.AddInt32(&cli.createStandbyCalledCnt, 1)
+	cli.lock.Lock()
+	defer cli.lock.Unlock()
+	cli.createStandbyReq = in
+	return &longrunning.Operation{Done: !cli.asyncCreateStandby}, nil
+}
+
+// CreateStandbyCalledCnt returns call count.
+func (cli *FakeConfigAgentClient) CreateStandbyCalledCnt() int {
+	return int(atomic.LoadInt32(&cli.createStandbyCalledCnt))
+}
+
+// CreateStandbyRequest returns the last CreateStandby request.
+func (cli *FakeConfigAgentClient) CreateStandbyRequest() *capb.CreateStandbyRequest {
+	cli.lock.Lock()
+	defer cli.lock.Unlock()
+	return cli.createStandbyReq
+}
+
+// SetAsyncCreateStandby makes CreateStandby return a running operation.
+func (cli *FakeConfigAgentClient) SetAsyncCreateStandby(flag bool) {
+	cli.lock.Lock()
+	defer cli.lock.Unlock()
+	cli.asyncCreateStandby = flag
+}
+
+// SetUpDataGuard wrapper.
+func (cli *FakeConfigAgentClient) SetUpDataGuard(ctx context.Context, in *capb.SetUpDataGuardRequest, opts ...grpc.CallOption) (*capb.SetUpDataGuardResponse, error) {
+	atomic.AddInt32(&cli.setUpDataGuardCalledCnt, 1)
+	cli.lock.Lock()
+	defer cli.lock.Unlock()
+	cli.setUpDataGuardReqs = append(cli.setUpDataGuardReqs, in)
+	return &capb.SetUpDataGuardResponse{}, nil
+}
+
+// SetUpDataGuardCalledCnt returns call count.
+func (cli *FakeConfigAgentClient) SetUpDataGuardCalledCnt() int {
+	return int(atomic.LoadInt32(&cli.setUpDataGuardCalledCnt))
+}
+
+// SetUpDataGuardRequests returns all SetUpDataGuard requests.
+func (cli *FakeConfigAgentClient) SetUpDataGuardRequests() []*capb.SetUpDataGuardRequest {
+	cli.lock.Lock()
+	defer cli.lock.Unlock()
+	return append([]*capb.SetUpDataGuardRequest(nil), cli.setUpDataGuardReqs...)
+}
+
+// DataGuardStatus wrapper.
+func (cli *FakeConfigAgentClient) DataGuardStatus(ctx context.Context, in *capb.DataGuardStatusRequest, opts ...grpc.CallOption) (*capb.DataGuardStatusResponse, error) {
+	atomic.AddInt32(&cli.dataGuardStatusCalledCnt, 1)
+	cli.lock.Lock()
+	defer cli.lock.Unlock()
+	if cli.dataGuardStatus == nil {
+		return &capb.DataGuardStatusResponse{}, nil
+	}
+	return cli.dataGuardStatus, nil
+}
+
+// DataGuardStatusCalledCnt returns call count.
+func (cli *FakeConfigAgentClient) DataGuardStatusCalledCnt() int {
+	return int(atomic.LoadInt32(&cli.dataGuardStatusCalledCnt))
+}
+
+// SetDataGuardStatus sets the response of DataGuardStatus.
+func (cli *FakeConfigAgentClient) SetDataGuardStatus(resp *capb.DataGuardStatusResponse) {
+	cli.lock.Lock()
+	defer cli.lock.Unlock()
+	cli.dataGuardStatus = resp
+}
+
+// Switchover wrapper, the target becomes the primary.
+func (cli *FakeConfigAgentClient) Switchover(ctx context.Context, in *capb.SwitchoverRequest, opts ...grpc.CallOption) (*capb.SwitchoverResponse, error) {
+	atomic.AddInt32(&cli.switchoverCalledCnt, 1)
+	cli.lock.Lock()
+	defer cli.lock.Unlock()
+	cli.switchoverReq = in
+	// The target database reports the primary role once switched over.
+	cli.dataGuardStatus = &capb.DataGuardStatusResponse{DbUniqueName: in.GetTargetDbUniqueName(), DatabaseRole: "PRIMARY"}
+	return &capb.SwitchoverResponse{}, nil
+}
+
+// SwitchoverCalledCnt returns call count.
+func (cli *FakeConfigAgentClient) SwitchoverCalledCnt() int {
+	return int(atomic.LoadInt32(&cli.switchoverCalledCnt))
+}
+
+// SwitchoverRequest returns the last Switchover request.
+func (cli *FakeConfigAgentClient) SwitchoverRequest() *capb.SwitchoverRequest {
+	cli.lock.Lock()
+	defer cli.lock.Unlock()
+	return cli.switchoverReq
+}
+
+// Failover wrapper.
+func (cli *FakeConfigAgentClient) Failover(ctx context.Context, in *capb.FailoverRequest, opts ...grpc.CallOption) (*capb.FailoverResponse, error) {
+	atomic.AddInt32(&cli.failoverCalledCnt, 1)
+	cli.lock.Lock()
+	defer cli.lock.Unlock()
+	cli.failoverReq = in
+	// The target database reports the primary role once failed over.
+	cli.dataGuardStatus = &capb.DataGuardStatusResponse{DbUniqueName: in.GetTargetDbUniqueName(), DatabaseRole: "PRIMARY"}
+	return &capb.FailoverResponse{}, nil
+}
+
+// FailoverCalledCnt returns call count.
+func (cli *FakeConfigAgentClient) FailoverCalledCnt() int {
+	return int(atomic.LoadInt32(&cli.failoverCalledCnt))
+}
+
+// FailoverRequest returns the last Failover request.
+func (cli *FakeConfigAgentClient) FailoverRequest() *capb.FailoverRequest {
+	cli.lock.Lock()
+	defer cli.lock.Unlock()
+	return cli.failoverReq
+}
+
+// FenceDatabase wrapper.
+func (cli *FakeConfigAgentClient) FenceDatabase(ctx context.Context, in *capb.FenceDatabaseRequest, opts ...grpc.CallOption) (*capb.FenceDatabaseResponse, error) {
+	atomic.AddInt32(&cli.fenceDatabaseCalledCnt, 1)
+	cli.lock.Lock()
+	defer cli.lock.Unlock()
+	cli.fenceDatabaseReq = in
+	return &capb.FenceDatabaseResponse{}, nil
+}
+
+// FenceDatabaseCalledCnt returns call count.
+func (cli *FakeConfigAgentClient) FenceDatabaseCalledCnt() int {
+	return int(atomic.LoadInt32(&cli.fenceDatabaseCalledCnt))
+}
+
+// FenceDatabaseRequest returns the last FenceDatabase request.
+func (cli *FakeConfigAgentClient) FenceDatabaseRequest() *capb.FenceDatabaseRequest {
+	cli.lock.Lock()
+	defer cli.lock.Unlock()
+	return cli.fenceDatabaseReq
+}
+
+// VerifyBackup wrapper.
+func (cli *FakeConfigAgentClient) VerifyBackup(ctx context.Context, in *capb.VerifyBackupRequest, opts ...grpc.CallOption) (*longrunning.Operation, error) {
+	atomic.AddInt32(&cli.verifyBackupCalledCnt, 1)
+	cli.lock.Lock()
+	defer cli.lock.Unlock()
+	cli.verifyBackupReq = in
+	return &longrunning.Operation{}, nil
+}
+
+// VerifyBackupCalledCnt returns call count.
+func (cli *FakeConfigAgentClient) VerifyBackupCalledCnt() int {
+	return int(atomic.LoadInt32(&cli.verifyBackupCalledCnt))
+}
+
+// VerifyBackupRequest returns the last VerifyBackup request.
+func (cli *FakeConfigAgentClient) VerifyBackupRequest() *capb.VerifyBackupRequest {
+	cli.lock.Lock()
+	defer cli.lock.Unlock()
+	return cli.verifyBackupReq
+}
+
+// DescribeBackup wrapper.
+func (cli *FakeConfigAgentClient) DescribeBackup(ctx context.Context, in *capb.DescribeBackupRequest, opts ...grpc.CallOption) (*capb.DescribeBackupResponse, error) {
+	atomic.AddInt32(&cli.describeBackupCalledCnt, 1)
+	cli.lock.Lock()
+	defer cli.lock.Unlock()
+	if cli.describeBackupResp == nil {
+		return &capb.DescribeBackupResponse{}, nil
+	}
+	return cli.describeBackupResp, nil
+}
+
+// DescribeBackupCalledCnt returns call count.
+func (cli *FakeConfigAgentClient) DescribeBackupCalledCnt() int {
+	return int(atomic.LoadInt32(&cli.describeBackupCalledCnt))
+}
+
+// SetDescribeBackup sets the response of DescribeBackup.
+func (cli *FakeConfigAgentClient) SetDescribeBackup(resp *capb.DescribeBackupResponse) {
+	cli.lock.Lock()
+	defer cli.lock.Unlock()
+	cli.describeBackupResp = resp
+}
+
+// ChangeDatabaseIdentity wrapper.
+func (cli *FakeConfigAgentClient) ChangeDatabaseIdentity(ctx context.Context, in *capb.ChangeDatabaseIdentityRequest, opts ...grpc.CallOption) (*longrunning.Operation, error) {
+	atomic.AddInt32(&cli.changeDatabaseIdentityCnt, 1)
+	cli.lock.Lock()
+	defer cli.lock.Unlock()
+	cli.changeDatabaseIdentityReq = in
+	resp, err := anypb.New(&dbdpb.ChangeDatabaseIdentityResponse{Dbid: 12345})
+	if err != nil {
+		return nil, err
+	}
+	return &longrunning.Operation{Done: true, Result: &longrunning.Operation_Response{Response: resp}}, nil
+}
+
+// ChangeDatabaseIdentityCalledCnt returns call count.
+func (cli *FakeConfigAgentClient) ChangeDatabaseIdentityCalledCnt() int {
+	return int(atomic.LoadInt32(&cli.changeDatabaseIdentityCnt))
+}
+
+// ChangeDatabaseIdentityRequest returns the last ChangeDatabaseIdentity request.
+func (cli *FakeConfigAgentClient) ChangeDatabaseIdentityRequest() *capb.ChangeDatabaseIdentityRequest {
+	cli.lock.Lock()
+	defer cli.lock.Unlock()
+	return cli.changeDatabaseIdentityReq
+}
+
+// SyncTablespaces wrapper. It reports the tablespaces of the request as
+// created with their initial size.
+func (cli *FakeConfigAgentClient) SyncTablespaces(ctx context.Context, in *capb.SyncTablespacesRequest, opts ...grpc.CallOption) (*capb.SyncTablespacesResponse, error) {
+	atomic.AddInt32(&cli.syncTablespacesCalledCnt, 1)
+	cli.lock.Lock()
+	defer cli.lock.Unlock()
+	cli.syncTablespacesReq = in
+	resp := &capb.SyncTablespacesResponse{}
+	for _, t := range in.GetTablespaces() {
+		maxSize := t.GetInitialSizeBytes()
+		if t.GetAutoExtend() && t.GetMaxSizeBytes() != 0 {
+			maxSize = t.GetMaxSizeBytes()
+		}
+		resp.Usages = append(resp.Usages, &capb.SyncTablespacesResponse_Usage{
+			Name:         strings.ToUpper(t.GetName()),
+			SizeBytes:    t.GetInitialSizeBytes(),
+			MaxSizeBytes: maxSize,
+		})
+	}
+	return resp, nil
+}
+
+// SyncTablespacesCalledCnt returns call count.
+func (cli *FakeConfigAgentClient) SyncTablespacesCalledCnt() int {
+	return int(atomic.LoadInt32(&cli.syncTablespacesCalledCnt))
+}
+
+// SyncTablespacesRequest returns the last SyncTablespaces request.
+func (cli *FakeConfigAgentClient) SyncTablespacesRequest() *capb.SyncTablespacesRequest {
+	cli.lock.Lock()
+	defer cli.lock.Unlock()
+	return cli.syncTablespacesReq
+}
+
+// SyncRoles wrapper.
+func (cli *FakeConfigAgentClient) SyncRoles(ctx context.Context, in *capb.SyncRolesRequest, opts ...grpc.CallOption) (*capb.SyncRolesResponse, error) {
+	atomic.AddInt32(&cli.syncRolesCalledCnt, 1)
+	cli.lock.Lock()
+	defer cli.lock.Unlock()
+	cli.syncRolesReq = in
+	return &capb.SyncRolesResponse{}, nil
+}
+
+// SyncRolesCalledCnt returns call count.
+func (cli *FakeConfigAgentClient) SyncRolesCalledCnt() int {
+	return int(atomic.LoadInt32(&cli.syncRolesCalledCnt))
+}
+
+// SyncRolesRequest returns the last SyncRoles request.
+func (cli *FakeConfigAgentClient) SyncRolesRequest() *capb.SyncRolesRequest {
+	cli.lock.Lock()
+	defer cli.lock.Unlock()
+	return cli.syncRolesReq
+}
+
+// SyncProfiles wrapper.
+func (cli *FakeConfigAgentClient) SyncProfiles(ctx context.Context, in *capb.SyncProfilesRequest, opts ...grpc.CallOption) (*capb.SyncProfilesResponse, error) {
+	atomic.AddInt32(&cli.syncProfilesCalledCnt, 1)
+	cli.lock.Lock()
+	defer cli.lock.Unlock()
+	cli.syncProfilesReq = in
+	return &capb.SyncProfilesResponse{}, nil
+}
+
+// SyncProfilesCalledCnt returns call count.
+func (cli *FakeConfigAgentClient) SyncProfilesCalledCnt() int {
+	return int(atomic.LoadInt32(&cli.syncProfilesCalledCnt))
+}
+
+// SyncProfilesRequest returns the last SyncProfiles request.
+func (cli *FakeConfigAgentClient) SyncProfilesRequest() *capb.SyncProfilesRequest {
+	cli.lock.Lock()
+	defer cli.lock.Unlock()
+	return cli.syncProfilesReq
+}
+
+// GetAuditLogHead wrapper.
+func (cli *FakeConfigAgentClient) GetAuditLogHead(ctx context.Context, in *capb.GetAuditLogHeadRequest, opts ...grpc.CallOption) (*capb.GetAuditLogHeadResponse, error) {
+	cli.lock.Lock()
+	defer cli.lock.Unlock()
+	if cli.auditLogHead == nil {
+		return &capb.GetAuditLogHeadResponse{}, nil
+	}
+	return cli.auditLogHead, nil
+}
+
+// SetAuditLogHead sets the response of GetAuditLogHead.
+func (cli *FakeConfigAgentClient) SetAuditLogHead(head *capb.GetAuditLogHeadResponse) {
+	cli.lock.Lock()
+	defer cli.lock.Unlock()
+	cli.auditLogHead = head
+}
+
+// ApplyDatapatch wrapper.
+func (cli *FakeConfigAgentClient) ApplyDatapatch(ctx context.Context, in *capb.ApplyDatapatchRequest, opts ...grpc.CallOption) (*capb.ApplyDatapatchResponse, error) {
+	atomic.AddInt32(&cli.applyDatapatchCalledCnt, 1)
+	cli.lock.Lock()
+	defer cli.lock.Unlock()
+	if cli.applyDatapatchErr != nil {
+		return nil, cli.applyDatapatchErr
+	}
+	return &capb.ApplyDatapatchResponse{}, nil
+}
+
+// ApplyDatapatchCalledCnt returns call count.
+func (cli *FakeConfigAgentClient) ApplyDatapatchCalledCnt() int {
+	return int(atomic.LoadInt32(&cli.applyDatapatchCalledCnt))
+}
+
+// SetApplyDatapatchError sets the error returned by ApplyDatapatch.
+func (cli *FakeConfigAgentClient) SetApplyDatapatchError(err error) {
+	cli.lock.Lock()
+	defer cli.lock.Unlock()
+	cli.applyDatapatchErr = err
+}
+
+// SetMemoryTargets wrapper.
+func (cli *FakeConfigAgentClient) SetMemoryTargets(ctx context.Context, in *capb.SetMemoryTargetsRequest, opts ...grpc.CallOption) (*capb.SetMemoryTargetsResponse, error) {
+	atomic.AddInt32(&cli.setMemoryTargetsCalledCnt, 1)
+	cli.lock.Lock()
+	defer cli.lock.Unlock()
+	cli.setMemoryTargetsReq = in
+	if cli.parameterValues != nil {
+		cli.parameterValues["sga_target"] = strconv.FormatInt(in.GetSgaTargetMb()<<20, 10)
+		cli.parameterValues["pga_aggregate_target"] = strconv.FormatInt(in.GetPgaAggregateTargetMb()<<20, 10)
+	}
+	return &capb.SetMemoryTargetsResponse{}, nil
+}
+
+// SetMemoryTargetsCalledCnt returns call count.
+func (cli *FakeConfigAgentClient) SetMemoryTargetsCalledCnt() int {
+	return int(atomic.LoadInt32(&cli.setMemoryTargetsCalledCnt))
+}
+
+// SetMemoryTargetsRequest returns the last SetMemoryTargets request.
+func (cli *FakeConfigAgentClient) SetMemoryTargetsRequest() *capb.SetMemoryTargetsRequest {
+	cli.lock.Lock()
+	defer cli.lock.Unlock()
+	return cli.setMemoryTargetsReq
+}
@@ -76,6 +76,8 @@ func main() {
 	flag.StringVar(&metricsAddr, "metrics-addr", ":8080", "The address the metric endpoint binds to.")
 	flag.BoolVar(&enableLeaderElection, "enable-leader-election", false,
 		"Enable leader election for controller manager. Enabling this will ensure there is only one active controller manager.")
+	flag.BoolVar(&controllers.AgentTLSDisabled, "disable-agent-tls", false,
+		"DEV CLUSTERS ONLY: Disable the mutual TLS between the operator, the Config Agent and the Database Daemon.")
 	flag.Parse()
 
 	ctrl.SetLogger(klogr.New())
@@ -19,12 +19,16 @@ package backup
 import (
 	"context"
 	"fmt"
+	"path/filepath"
+	"time"
 
 	lropb "google.golang.org/genproto/googleapis/longrunning"
 	"k8s.io/klog/v2"
 
+	"github.com/GoogleCloudPlatform/elcarro-oracle-operator/oracle/pkg/agents/common"
 	"github.com/GoogleCloudPlatform/elcarro-oracle-operator/oracle/pkg/agents/consts"
 	dbdpb "github.com/GoogleCloudPlatform/elcarro-oracle-operator/oracle/pkg/agents/oracle"
+	"github.com/GoogleCloudPlatform/elcarro-oracle-operator/oracle/pkg/database/lib/storage"
 )
 
 const (
@@ -38,8 +42,9 @@ const (
 	//			<check logical>
 	//			<filesperset X>
 	//			<section size Y>
-	//			incremental level <Z>
+	//			incremental level <Z> <cumulative>
 	//			to destination '<W>'
+	//			<tag 'T'>
 	// 			<granularity: (database|pluggable database pdb1,pdb2)>
 	//		backup...
 	//	}
@@ -50,15 +55,68 @@ const (
 				%s
 				%s
 				%s
-				incremental level %d
+				incremental level %d %s
 				to destination '%s'
+				%s
 				(%s);
 			backup
 				to destination '%s'
+				%s
 				(spfile) (current controlfile)
 				plus archivelog;
 		}
 	`
+
+	// incrementallyUpdatedBackupStmtTemplate rolls the image copies of the
+	// database forward: a level 1 backup of the changes since the copies
+	// tagged '<C>' is taken and applied to them. On the first run there are
+	// no copies yet, RMAN creates them instead. The copies keep tag '<C>',
+	// the incremental backup pieces are tagged 'T' like any other backup.
+	// 	run {
+	//		channels
+	//		backup
+	//			<check logical>
+	//			incremental level 1 for recover of copy with tag '<C>'
+	//			to destination '<W>'
+	//			<tag 'T'>
+	//			(database);
+	//		recover copy of database with tag '<C>';
+	//		backup...
+	//	}
+	incrementallyUpdatedBackupStmtTemplate = `run {
+			%[1]s
+			backup
+				%[2]s
+				incremental level 1 for recover of copy with tag '%[3]s'
+				to destination '%[4]s'
+				%[5]s
+				(database);
+			recover copy of database with tag '%[3]s';
+			backup
+				to destination '%[4]s'
+				%[5]s
+				(spfile) (current controlfile)
+				plus archivelog;
+		}
+	`
+
+	// CopyTag is the RMAN tag of the image copies kept up to date by the
+	// incrementally updated backups of a database.
+	CopyTag = "EL_COPY"
+
+	// enableBlockChangeTrackingSQL turns on block change tracking, so that
+	// RMAN reads only the changed blocks for an incremental backup. The
+	// change tracking file is created next to the control files.
+	enableBlockChangeTrackingSQL = `declare
+  s varchar2(10);
+  f varchar2(513);
+begin
+  select status into s from v$block_change_tracking;
+  if s = 'DISABLED' then
+    select substr(name, 1, instr(name, '/', -1)) || 'bct.f' into f from v$controlfile where rownum = 1;
+    execute immediate 'alter database enable block change tracking using file ''' || f || ''' reuse';
+  end if;
+end;`
 )
 
 // Params that can be passed to PhysicalBackup.
@@ -72,11 +130,39 @@ type Params struct {
 	CheckLogical bool
 	Compressed   bool
 	Level        int32
+	Cumulative   bool
 	Filesperset  int32
 	SectionSize  int32
 	LocalPath    string
 	GCSPath      string
 	OperationID  string
+
+	// IncrementallyUpdated rolls the image copies tagged CopyTag forward
+	// instead of taking a new backup of the database.
+	IncrementallyUpdated bool
+
+	// Tag is an optional RMAN tag of the backup pieces.
+	Tag string
+
+	// StorageCredentials authenticate access to GCSPath.
+	StorageCredentials storage.Credentials
+
+	// Point-in-time recovery targets, only used by PhysicalRestore.
+	UntilTime         time.Time
+	UntilSCN          int64
+	UntilRestorePoint string
+
+	// SourceCDBName is the name of the CDB a backup was taken from, only
+	// used by PhysicalRestore to restore a backup of another CDB.
+	SourceCDBName string
+
+	// PDBName is the name of a PDB, only used by PhysicalRestore to restore
+	// that PDB alone.
+	PDBName string
+
+	// SwitchToCopy is only used by PhysicalRestore to switch the database to
+	// the image copies tagged CopyTag instead of restoring the datafiles.
+	SwitchToCopy bool
 }
 
 // PhysicalBackup takes a physical backup of the oracle database.
@@ -99,8 +185,10 @@ func PhysicalBackup(ctx context.Context, params *Params) (*lropb.Operation, erro
 		backupDir = params.LocalPath
 	}
 	// for RMAN backup to GCS bucket, first backup to a staging location. Remove staging dir when upload finishes.
+	// The pieces of a tagged backup are staged in a dir of their own, which
+	// is removed alone if the backup is cancelled.
 	if params.GCSPath != "" {
-		backupDir = consts.RMANStagingDir
+		backupDir = filepath.Join(consts.RMANStagingDir, params.Tag)
 	}
 	klog.InfoS("oracle/PhysicalBackup", "backupDir", backupDir)
 
@@ -116,6 +204,18 @@ func PhysicalBackup(ctx context.Context, params *Params) (*lropb.Operation, erro
 		return nil, fmt.Errorf("oracle/PhysicalBackup: failed a pre-flight check: Image Copy type of backup is not compatible with a Compress setting")
 	}
 
+	if params.IncrementallyUpdated {
+		if params.Backupset {
+			return nil, fmt.Errorf("oracle/PhysicalBackup: failed a pre-flight check: an incrementally updated backup is only supported for Image Copy type of backup")
+		}
+		if granularity != "database" {
+			return nil, fmt.Errorf("oracle/PhysicalBackup: failed a pre-flight check: an incrementally updated backup is only supported for the whole database, got: %q", granularity)
+		}
+		if params.GCSPath != "" {
+			return nil, fmt.Errorf("oracle/PhysicalBackup: failed a pre-flight check: the image copies of an incrementally updated backup can't be transferred to %q", params.GCSPath)
+		}
+	}
+
 	var compressed string
 	if params.Compressed {
 		compressed = "compressed"
@@ -147,14 +247,41 @@ func PhysicalBackup(ctx context.Context, params *Params) (*lropb.Operation, erro
 	}
 	klog.InfoS("oracle/PhysicalBackup", "sectionSize", sectionSize)
 
-	backupStmt := fmt.Sprintf(backupStmtTemplate, channels, compressed, backupset, checklogical, filesperset, sectionSize, params.Level, backupDir, granularity, backupDir)
+	cumulative := ""
+	if params.Cumulative && params.Level > 0 {
+		cumulative = "cumulative"
+	}
+	klog.InfoS("oracle/PhysicalBackup", "cumulative", cumulative)
+
+	tag := ""
+	if params.Tag != "" {
+		tag = fmt.Sprintf("tag '%s'", params.Tag)
+	}
+	klog.InfoS("oracle/PhysicalBackup", "tag", tag)
+
+	backupStmt := fmt.Sprintf(backupStmtTemplate, channels, compressed, backupset, checklogical, filesperset, sectionSize, params.Level, cumulative, backupDir, tag, granularity, backupDir, tag)
+	if params.IncrementallyUpdated {
+		backupStmt = fmt.Sprintf(incrementallyUpdatedBackupStmtTemplate, channels, checklogical, CopyTag, backupDir, tag)
+	}
 	klog.InfoS("oracle/PhysicalBackup", "finalBackupRequest", backupStmt)
 
+	// Block change tracking only speeds up incremental backups, the backup
+	// is still taken if it can't be turned on.
+	if _, err := params.Client.RunSQLPlus(ctx, &dbdpb.RunSQLPlusCMDRequest{Commands: []string{enableBlockChangeTrackingSQL}}); err != nil {
+		klog.ErrorS(err, "oracle/PhysicalBackup: failed to enable block change tracking")
+	}
+
 	backupReq := &dbdpb.RunRMANAsyncRequest{
-		SyncRequest: &dbdpb.RunRMANRequest{Scripts: []string{backupStmt}, GcsPath: params.GCSPath, LocalPath: params.LocalPath, Cmd: consts.RMANBackup},
-		LroInput:    &dbdpb.LROInput{OperationId: params.OperationID},
+		SyncRequest: &dbdpb.RunRMANRequest{
+			Scripts:            []string{backupStmt},
+			GcsPath:            params.GCSPath,
+			LocalPath:          backupDir,
+			Cmd:                consts.RMANBackup,
+			StorageCredentials: params.StorageCredentials,
+		},
+		LroInput: &dbdpb.LROInput{OperationId: params.OperationID},
 	}
-	klog.InfoS("oracle/PhysicalBackup", "backupReq", backupReq)
+	klog.InfoS("oracle/PhysicalBackup", "backupReq", common.RedactStorageCredentials(backupReq))
 
 	operation, err := params.Client.RunRMANAsync(ctx, backupReq)
 	if err != nil {
@@ -19,15 +19,19 @@ package backup
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"path/filepath"
+	"regexp"
 	"sort"
 	"strings"
 	"time"
 
 	lropb "google.golang.org/genproto/googleapis/longrunning"
+	"google.golang.org/protobuf/types/known/timestamppb"
 	"k8s.io/klog/v2"
 
+	"github.com/GoogleCloudPlatform/elcarro-oracle-operator/oracle/pkg/agents/common"
 	"github.com/GoogleCloudPlatform/elcarro-oracle-operator/oracle/pkg/agents/consts"
 	dbdpb "github.com/GoogleCloudPlatform/elcarro-oracle-operator/oracle/pkg/agents/oracle"
 )
@@ -43,13 +47,32 @@ const (
 				)
 	`
 
-	restoreStmtTemplate = `run {
+	// mountStmtTemplate restores the spfile and the control file of a
+	// backup and mounts it, the datafiles are restored by restoreStmtTemplate
+	// once the recovery target was validated against the control file.
+	mountStmtTemplate = `run {
 				startup force nomount;
 				restore spfile to '%s' from '%s';
 				shutdown immediate;
 				startup nomount;
 				restore controlfile from '%s';
 				startup mount;
+		}
+	`
+
+	// cloneMountStmtTemplate mounts the control file of a backup of another
+	// CDB. The local spfile is kept, but db_name is set to the name of the
+	// source CDB, as it has to match the restored controlfile.
+	cloneMountStmtTemplate = `run {
+				startup force nomount;
+				sql "alter system set db_name=''%s'' scope=spfile";
+				startup force nomount;
+				restore controlfile from '%s';
+				startup mount;
+		}
+	`
+
+	restoreStmtTemplate = `run {
 				%s
 				restore database;
 				delete foreign archivelog all;
@@ -62,43 +85,229 @@ const (
 				alter pluggable database all open;
 		}
 	`
+
+	restorePointSCNColumn = `,
+					(select to_char(scn) from v$restore_point where name='%s') as target_scn`
+
+	pitrRecoverStmtTemplate = `run {
+				recover database until %s;
+				alter database open resetlogs;
+				alter pluggable database all open;
+		}
+	`
+
+	// switchToCopyStmt makes the image copies of the database tagged
+	// CopyTag its datafiles, the current control file is kept.
+	switchToCopyStmt = `run {
+				startup force mount;
+				switch database to copy;
+		}
+	`
+
+	// completeRecoverStmt recovers a database with its current control
+	// file up to the last change in the online redo logs.
+	completeRecoverStmt = `run {
+				recover database;
+				alter database open;
+				alter pluggable database all open;
+		}
+	`
+
+	pdbOpenModeQuery = `select open_mode from v$pdbs where name='%s'`
+
+	// pdbRestoreStmtTemplate restores the datafiles of a PDB while the CDB
+	// and the other PDBs stay open.
+	pdbRestoreStmtTemplate = `run {
+				%[1]s
+				%[2]s
+				restore pluggable database %[3]s;
+		}
+	`
+
+	// pdbCatalogStmtTemplate catalogs the backup pieces of a PDB before the
+	// restore, as the pieces downloaded from a storage location are not
+	// recorded in the control file of the running CDB. The recovery target is
+	// validated against them before any datafile is restored.
+	pdbCatalogStmtTemplate = "catalog start with '%s/' noprompt;"
+
+	pdbCloseStmtTemplate = "alter pluggable database %s close immediate;"
+
+	pdbRecoverStmtTemplate = `run {
+				recover pluggable database %[1]s;
+				alter pluggable database %[1]s open;
+		}
+	`
+
+	pdbPITRRecoverStmtTemplate = `run {
+				recover pluggable database %[1]s until %[2]s;
+				alter pluggable database %[1]s open resetlogs;
+		}
+	`
 )
 
+var (
+	// recoverableRangeQuery returns the range a backup can be recovered to,
+	// as recorded in its mounted control file before any datafile is
+	// restored: from the latest checkpoint of the level 0 backups, which
+	// restore database picks, up to the last change in the last archived redo
+	// log. Times are returned in UTC, see utcTime.
+	recoverableRangeQuery = `
+				select
+					(select to_char(max(checkpoint_change#)) from v$backup_datafile
+						where file# > 0 and nvl(incremental_level, 0) = 0) as min_scn,
+					to_char(max(next_change#)) as max_scn,
+					(select ` + utcTime("max(checkpoint_time)") + ` from v$backup_datafile
+						where file# > 0 and nvl(incremental_level, 0) = 0) as min_time,
+					` + utcTime("max(next_time)") + ` as max_time%s
+				from v$archived_log
+				where resetlogs_id=(
+					select resetlogs_id
+					from v$database_incarnation
+					where status='CURRENT'
+				)
+	`
+
+	// copyRecoverableRangeQuery returns the range the image copies tagged
+	// CopyTag can be recovered to, before the database is switched to them:
+	// from their latest checkpoint up to the last change in the last
+	// archived redo log.
+	copyRecoverableRangeQuery = `
+				select
+					(select to_char(max(checkpoint_change#)) from v$datafile_copy
+						where tag='` + CopyTag + `' and status='A') as min_scn,
+					to_char(max(next_change#)) as max_scn,
+					(select ` + utcTime("max(checkpoint_time)") + ` from v$datafile_copy
+						where tag='` + CopyTag + `' and status='A') as min_time,
+					` + utcTime("max(next_time)") + ` as max_time%s
+				from v$archived_log
+				where resetlogs_id=(
+					select resetlogs_id
+					from v$database_incarnation
+					where status='CURRENT'
+				)
+	`
+
+	// pdbRecoverableRangeQuery returns the range a PDB can be recovered to,
+	// before its datafiles are restored: from the latest checkpoint of its
+	// level 0 backups up to the current SCN of the CDB.
+	pdbRecoverableRangeQuery = `
+				select
+					(select to_char(max(checkpoint_change#)) from v$backup_datafile
+						where file# > 0 and nvl(incremental_level, 0) = 0
+						and con_id=(select con_id from v$pdbs where name='%[1]s')) as min_scn,
+					to_char(current_scn) as max_scn,
+					(select ` + utcTime("max(checkpoint_time)") + ` from v$backup_datafile
+						where file# > 0 and nvl(incremental_level, 0) = 0
+						and con_id=(select con_id from v$pdbs where name='%[1]s')) as min_time,
+					` + utcTime("sysdate") + ` as max_time%[2]s
+				from v$database
+	`
+)
+
+// recoveryTimeLayout matches the 'YYYY-MM-DD HH24:MI:SS' Oracle date format.
+const recoveryTimeLayout = "2006-01-02 15:04:05"
+
+// dbTimeZone is the offset of the time zone of the database host, which
+// Oracle DATE values such as checkpoint_time and sysdate are in.
+const dbTimeZone = "to_char(systimestamp, 'TZH:TZM')"
+
+// utcTime returns the SQL expression of an Oracle DATE converted from the
+// time zone of the database host to UTC, in the recoveryTimeLayout format.
+func utcTime(date string) string {
+	return fmt.Sprintf("to_char(sys_extract_utc(from_tz(cast(%s as timestamp), %s)), 'YYYY-MM-DD HH24:MI:SS')", date, dbTimeZone)
+}
+
+var restorePointMatcher = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_$#]*$`).MatchString
+
+var cdbNameMatcher = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_$#]{0,7}$`).MatchString
+
+var pdbNameMatcher = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_$#]{0,29}$`).MatchString
+
 type fileTime struct {
 	name    string
 	modTime time.Time
 }
 
+// recoveryTarget returns the RMAN until clause of a point-in-time recovery
+// and the extra columns of the query of the range it needs to be validated
+// against. Both are empty if no recovery target is requested.
+func recoveryTarget(params *Params) (string, string, error) {
+	targets := 0
+	var until, extraColumns string
+	if !params.UntilTime.IsZero() {
+		targets++
+		// The target is converted from UTC to the time zone of the
+		// database host, which RMAN compares it with.
+		until = fmt.Sprintf(`time "cast(from_tz(to_timestamp('%s', 'YYYY-MM-DD HH24:MI:SS'), '+00:00') at time zone %s as date)"`,
+			params.UntilTime.UTC().Format(recoveryTimeLayout), dbTimeZone)
+	}
+	if params.UntilSCN != 0 {
+		targets++
+		if params.UntilSCN < 0 {
+			return "", "", fmt.Errorf("until SCN must be positive, got %d", params.UntilSCN)
+		}
+		until = fmt.Sprintf("scn %d", params.UntilSCN)
+	}
+	if params.UntilRestorePoint != "" {
+		targets++
+		if !restorePointMatcher(params.UntilRestorePoint) {
+			return "", "", fmt.Errorf("not a valid restore point name: %q", params.UntilRestorePoint)
+		}
+		until = fmt.Sprintf("restore point %s", params.UntilRestorePoint)
+		extraColumns = fmt.Sprintf(restorePointSCNColumn, strings.ToUpper(params.UntilRestorePoint))
+	}
+	if targets > 1 {
+		return "", "", fmt.Errorf("at most one of until time, until SCN and until restore point can be set")
+	}
+	return until, extraColumns, nil
+}
+
 // PhysicalRestore runs an RMAN restore and recovery.
-// Presently the recovery process goes up to the last SCN in the last
-// archived redo log.
+// By default the recovery process goes up to the last SCN in the last
+// archived redo log. If a point-in-time recovery target is set in params,
+// the recovery stops at that target instead, provided that it falls within
+// the range covered by the backup and the archived redo logs recorded in its
+// control file. The target is validated once that control file is mounted,
+// before the datafiles of the database are deleted and restored.
+// A backup of another CDB, as set by params.SourceCDBName, is restored under
+// the name of that CDB. If params.PDBName is set, only that PDB is restored
+// and recovered, see restorePDB. If params.SwitchToCopy is set, the
+// database is switched to its image copies instead, see switchToCopy.
 func PhysicalRestore(ctx context.Context, params *Params) (*lropb.Operation, error) {
 	klog.InfoS("oracle/PhysicalRestore", "params", params)
 
-	var channels string
-	for i := 1; i <= int(params.DOP); i++ {
-		channels += fmt.Sprintf(allocateChannel, i)
+	if params.PDBName != "" {
+		return restorePDB(ctx, params)
+	}
+	if params.SwitchToCopy {
+		return switchToCopy(ctx, params)
 	}
-	klog.InfoS("oracle/PhysicalRestore", "channels", channels)
 
-	backupDir := consts.DefaultRMANDir
-	if params.LocalPath != "" {
-		backupDir = params.LocalPath
+	clone := params.SourceCDBName != "" && !strings.EqualFold(params.SourceCDBName, params.CDBName)
+	if clone && !cdbNameMatcher(params.SourceCDBName) {
+		return nil, fmt.Errorf("oracle/PhysicalRestore: not a valid source CDB name: %q", params.SourceCDBName)
 	}
-	klog.InfoS("oracle/PhysicalRestore", "backupDir", backupDir)
 
-	if params.GCSPath != "" {
-		backupDir = consts.RMANStagingDir
-		downloadReq := &dbdpb.DownloadDirectoryFromGCSRequest{
-			GcsPath:   params.GCSPath,
-			LocalPath: backupDir,
-		}
-		klog.InfoS("oracle/PhysicalRestore", "restore from gcs, downloadReq", downloadReq)
+	until, extraColumns, err := recoveryTarget(params)
+	if err != nil {
+		return nil, fmt.Errorf("oracle/PhysicalRestore: invalid recovery target: %v", err)
+	}
+	recoverStmt := recoverStmtTemplate
+	var rangeQuery string
+	if until != "" {
+		recoverStmt = fmt.Sprintf(pitrRecoverStmtTemplate, until)
+		rangeQuery = fmt.Sprintf(recoverableRangeQuery, extraColumns)
+	}
+	klog.InfoS("oracle/PhysicalRestore", "recoverStmt", recoverStmt)
 
-		if _, err := params.Client.DownloadDirectoryFromGCS(ctx, downloadReq); err != nil {
-			return nil, fmt.Errorf("failed to download rman backup from GCS bucket %s", err)
-		}
+	channels := restoreChannels(params.DOP)
+	klog.InfoS("oracle/PhysicalRestore", "channels", channels)
+
+	backupDir, err := downloadBackup(ctx, params)
+	if err != nil {
+		return nil, err
 	}
+	klog.InfoS("oracle/PhysicalRestore", "backupDir", backupDir)
 
 	// Files stored in default format
 	// /u03/app/oracle/rman/id/DB_UNIQUE_NAME/backupset/2020_03_13/o1_mf_nnsnf_TAG20200313T214926_h6qzz6g6_.bkp
@@ -152,31 +361,43 @@ func PhysicalRestore(ctx context.Context, params *Params) (*lropb.Operation, err
 	})
 	klog.InfoS("oracle/PhysicalRestore sorted control files", "ctlFilesTime", ctlfilesTime)
 
-	// Clear spfile and datafile dir.
+	// Clear the spfile, the datafiles are deleted by the restore once the
+	// recovery target is validated.
 
 	spfileLoc := filepath.Join(
 		fmt.Sprintf(consts.ConfigDir, consts.DataMount, params.CDBName),
 		fmt.Sprintf("spfile%s.ora", params.CDBName),
 	)
 
-	if _, err := params.Client.DeleteDir(ctx, &dbdpb.DeleteDirRequest{Path: spfileLoc}); err != nil {
-		klog.ErrorS(err, "failed to delete the spfile before restore")
+	// The spfile of the local CDB is kept for a restore of another CDB.
+	if !clone {
+		if _, err := params.Client.DeleteDir(ctx, &dbdpb.DeleteDirRequest{Path: spfileLoc}); err != nil {
+			klog.ErrorS(err, "failed to delete the spfile before restore")
+		}
 	}
 
-	dataDir := filepath.Join(consts.OracleBase, "oradata", "*")
-	if _, err := params.Client.DeleteDir(ctx, &dbdpb.DeleteDirRequest{Path: dataDir}); err != nil {
-		klog.ErrorS(err, "failed to delete the data files before restore", "dataDir", dataDir)
+	mountStmt := fmt.Sprintf(mountStmtTemplate, spfileLoc, spfilesTime[0].name, ctlfilesTime[0].name)
+	if clone {
+		mountStmt = fmt.Sprintf(cloneMountStmtTemplate, strings.ToUpper(params.SourceCDBName), ctlfilesTime[0].name)
 	}
+	restoreStmt := fmt.Sprintf(restoreStmtTemplate, channels)
 
-	restoreStmt := fmt.Sprintf(restoreStmtTemplate, spfileLoc, spfilesTime[0].name, ctlfilesTime[0].name, channels)
-
+	syncReq := &dbdpb.PhysicalRestoreRequest{
+		MountStatement:            mountStmt,
+		RestoreStatement:          restoreStmt,
+		LatestRecoverableScnQuery: maxSCNquery,
+		RecoverStatementTemplate:  recoverStmt,
+		RecoverableRangeQuery:     rangeQuery,
+		UntilScn:                  params.UntilSCN,
+		UntilRestorePoint:         params.UntilRestorePoint,
+		DatafilePatterns:          datafilePatterns(params.CDBName),
+	}
+	if !params.UntilTime.IsZero() {
+		syncReq.UntilTime = timestamppb.New(params.UntilTime)
+	}
 	operation, err := params.Client.PhysicalRestoreAsync(ctx, &dbdpb.PhysicalRestoreAsyncRequest{
-		SyncRequest: &dbdpb.PhysicalRestoreRequest{
-			RestoreStatement:          restoreStmt,
-			LatestRecoverableScnQuery: maxSCNquery,
-			RecoverStatementTemplate:  recoverStmtTemplate,
-		},
-		LroInput: &dbdpb.LROInput{OperationId: params.OperationID},
+		SyncRequest: syncReq,
+		LroInput:    &dbdpb.LROInput{OperationId: params.OperationID},
 	})
 
 	if err != nil {
@@ -184,3 +405,152 @@ func PhysicalRestore(ctx context.Context, params *Params) (*lropb.Operation, err
 	}
 	return operation, nil
 }
+
+// restorePDB restores and recovers a single PDB from a physical backup of the
+// CDB or of the PDB, while the CDB and the other PDBs stay open. The PDB is
+// closed for the restore. By default the PDB is recovered completely, up to
+// the current SCN of the CDB. A point-in-time recovery opens the PDB with
+// resetlogs.
+func restorePDB(ctx context.Context, params *Params) (*lropb.Operation, error) {
+	if !pdbNameMatcher(params.PDBName) {
+		return nil, fmt.Errorf("oracle/PhysicalRestore: not a valid PDB name: %q", params.PDBName)
+	}
+	pdbName := strings.ToUpper(params.PDBName)
+
+	until, extraColumns, err := recoveryTarget(params)
+	if err != nil {
+		return nil, fmt.Errorf("oracle/PhysicalRestore: invalid recovery target: %v", err)
+	}
+	recoverStmt := fmt.Sprintf(pdbRecoverStmtTemplate, pdbName)
+	var rangeQuery string
+	if until != "" {
+		recoverStmt = fmt.Sprintf(pdbPITRRecoverStmtTemplate, pdbName, until)
+		rangeQuery = fmt.Sprintf(pdbRecoverableRangeQuery, pdbName, extraColumns)
+	}
+	klog.InfoS("oracle/PhysicalRestore", "pdbName", pdbName, "recoverStmt", recoverStmt)
+
+	resp, err := params.Client.RunSQLPlusFormatted(ctx, &dbdpb.RunSQLPlusCMDRequest{Commands: []string{fmt.Sprintf(pdbOpenModeQuery, pdbName)}})
+	if err != nil {
+		return nil, fmt.Errorf("oracle/PhysicalRestore: failed to query the open mode of PDB %s: %v", pdbName, err)
+	}
+	if len(resp.GetMsg()) != 1 {
+		return nil, fmt.Errorf("oracle/PhysicalRestore: PDB %s not found", pdbName)
+	}
+	row := make(map[string]string)
+	if err := json.Unmarshal([]byte(resp.GetMsg()[0]), &row); err != nil {
+		return nil, fmt.Errorf("oracle/PhysicalRestore: failed to parse %q: %v", resp.GetMsg()[0], err)
+	}
+	var closeStmt string
+	if row["OPEN_MODE"] != "MOUNTED" {
+		closeStmt = fmt.Sprintf(pdbCloseStmtTemplate, pdbName)
+	}
+
+	backupDir, err := downloadBackup(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	restoreStmt := fmt.Sprintf(pdbRestoreStmtTemplate, closeStmt, restoreChannels(params.DOP), pdbName)
+
+	syncReq := &dbdpb.PhysicalRestoreRequest{
+		MountStatement:           fmt.Sprintf(pdbCatalogStmtTemplate, backupDir),
+		RestoreStatement:         restoreStmt,
+		RecoverStatementTemplate: recoverStmt,
+		RecoverableRangeQuery:    rangeQuery,
+		UntilScn:                 params.UntilSCN,
+		UntilRestorePoint:        params.UntilRestorePoint,
+	}
+	if !params.UntilTime.IsZero() {
+		syncReq.UntilTime = timestamppb.New(params.UntilTime)
+	}
+	operation, err := params.Client.PhysicalRestoreAsync(ctx, &dbdpb.PhysicalRestoreAsyncRequest{
+		SyncRequest: syncReq,
+		LroInput:    &dbdpb.LROInput{OperationId: params.OperationID},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("oracle/PhysicalRestore: failed to create PDB restore request: %v", err)
+	}
+	return operation, nil
+}
+
+// switchToCopy switches the database to the image copies kept up to date by
+// its incrementally updated backups and recovers it. The copies become the
+// datafiles of the database where they are, nothing is restored. As the
+// current control file is kept, the database is recovered completely by
+// default, including the changes in the online redo logs, and opened without
+// resetlogs. A point-in-time recovery opens it with resetlogs.
+func switchToCopy(ctx context.Context, params *Params) (*lropb.Operation, error) {
+	until, extraColumns, err := recoveryTarget(params)
+	if err != nil {
+		return nil, fmt.Errorf("oracle/PhysicalRestore: invalid recovery target: %v", err)
+	}
+	recoverStmt := completeRecoverStmt
+	var rangeQuery string
+	if until != "" {
+		recoverStmt = fmt.Sprintf(pitrRecoverStmtTemplate, until)
+		rangeQuery = fmt.Sprintf(copyRecoverableRangeQuery, extraColumns)
+	}
+	klog.InfoS("oracle/PhysicalRestore", "switchToCopy", true, "recoverStmt", recoverStmt)
+
+	syncReq := &dbdpb.PhysicalRestoreRequest{
+		RestoreStatement:         switchToCopyStmt,
+		RecoverStatementTemplate: recoverStmt,
+		RecoverableRangeQuery:    rangeQuery,
+		UntilScn:                 params.UntilSCN,
+		UntilRestorePoint:        params.UntilRestorePoint,
+	}
+	if !params.UntilTime.IsZero() {
+		syncReq.UntilTime = timestamppb.New(params.UntilTime)
+	}
+	operation, err := params.Client.PhysicalRestoreAsync(ctx, &dbdpb.PhysicalRestoreAsyncRequest{
+		SyncRequest: syncReq,
+		LroInput:    &dbdpb.LROInput{OperationId: params.OperationID},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("oracle/PhysicalRestore: failed to create switch to copy request: %v", err)
+	}
+	return operation, nil
+}
+
+// datafilePatterns returns the glob patterns of the datafiles of a CDB: those
+// of the CDB, of the seed and of the PDBs.
+func datafilePatterns(cdbName string) []string {
+	dataDir := fmt.Sprintf(consts.DataDir, consts.DataMount, cdbName)
+	return []string{
+		filepath.Join(dataDir, "*.dbf"),
+		filepath.Join(dataDir, "*", "*.dbf"),
+		filepath.Join(dataDir, "*", "data", "*.dbf"),
+	}
+}
+
+func restoreChannels(dop int32) string {
+	var channels string
+	for i := 1; i <= int(dop); i++ {
+		channels += fmt.Sprintf(allocateChannel, i)
+	}
+	return channels
+}
+
+// downloadBackup downloads the backup pieces from a storage location, if
+// params.GCSPath is set, and returns the directory of the backup pieces.
+func downloadBackup(ctx context.Context, params *Params) (string, error) {
+	backupDir := consts.DefaultRMANDir
+	if params.LocalPath != "" {
+		backupDir = params.LocalPath
+	}
+	if params.GCSPath == "" {
+		return backupDir, nil
+	}
+
+	backupDir = consts.RMANStagingDir
+	downloadReq := &dbdpb.DownloadDirectoryFromGCSRequest{
+		GcsPath:            params.GCSPath,
+		LocalPath:          backupDir,
+		StorageCredentials: params.StorageCredentials,
+	}
+	klog.InfoS("oracle/PhysicalRestore", "restore from gcs, downloadReq", common.RedactStorageCredentials(downloadReq))
+
+	if _, err := params.Client.DownloadDirectoryFromGCS(ctx, downloadReq); err != nil {
+		return "", fmt.Errorf("failed to download rman backup from GCS bucket %s", err)
+	}
+	return backupDir, nil
+}
@@ -0,0 +1,118 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backup
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	lropb "google.golang.org/genproto/googleapis/longrunning"
+	"k8s.io/klog/v2"
+
+	"github.com/GoogleCloudPlatform/elcarro-oracle-operator/oracle/pkg/agents/common"
+	"github.com/GoogleCloudPlatform/elcarro-oracle-operator/oracle/pkg/agents/consts"
+	dbdpb "github.com/GoogleCloudPlatform/elcarro-oracle-operator/oracle/pkg/agents/oracle"
+)
+
+// The format of the verify statement template is:
+//
+//	run {
+//		channels
+//		validate backupset <keys>;
+//		restore database preview summary;
+//	}
+//
+// Unlike RESTORE ... VALIDATE, which validates the backups RMAN would choose
+// to restore the database from, VALIDATE BACKUPSET reads exactly the backup
+// sets with the tag, so it works for level 1 backups and the incremental
+// backups of an incrementally updated backup as well. RESTORE ... PREVIEW
+// then checks that the backups and archived redo logs recorded in the
+// control file are enough to restore the database.
+const verifyStmtTemplate = `run {
+			%s
+			validate backupset %s;
+			restore database preview summary;
+		}
+	`
+
+// backupSetsQuery lists the keys of the available backup sets with a tag.
+const backupSetsQuery = `select distinct s.recid as bs_key
+from v$backup_set s, v$backup_piece p
+where p.set_stamp = s.set_stamp and p.set_count = s.set_count
+and p.tag = '%s' and p.status = 'A'
+order by 1`
+
+// VerifyBackup checks that the backup pieces of a physical backup with the
+// given tag can be restored, without restoring them, by reading and
+// validating its backup sets with RMAN. Backup pieces uploaded to a storage
+// location are downloaded back to their staging dir by the operation first.
+func VerifyBackup(ctx context.Context, params *Params) (*lropb.Operation, error) {
+	klog.InfoS("oracle/VerifyBackup", "params", params)
+
+	if !common.RMANTagRegexp.MatchString(params.Tag) {
+		return nil, fmt.Errorf("oracle/VerifyBackup: invalid RMAN tag %q", params.Tag)
+	}
+	tag := strings.ToUpper(params.Tag)
+
+	resp, err := params.Client.RunSQLPlusFormatted(ctx, &dbdpb.RunSQLPlusCMDRequest{Commands: []string{fmt.Sprintf(backupSetsQuery, tag)}})
+	if err != nil {
+		return nil, fmt.Errorf("oracle/VerifyBackup: failed to query the backup sets: %v", err)
+	}
+	var keys []string
+	for _, msg := range resp.GetMsg() {
+		row := make(map[string]string)
+		if err := json.Unmarshal([]byte(msg), &row); err != nil {
+			return nil, fmt.Errorf("oracle/VerifyBackup: failed to parse %q: %v", msg, err)
+		}
+		keys = append(keys, row["BS_KEY"])
+	}
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("oracle/VerifyBackup: no backup sets found with tag %q", tag)
+	}
+
+	var channels string
+	for i := 1; i <= int(params.DOP); i++ {
+		channels += fmt.Sprintf(allocateChannel, i)
+	}
+
+	// The pieces were uploaded from the staging dir of the backup, which
+	// they're downloaded back to.
+	var stagingDir string
+	if params.GCSPath != "" {
+		stagingDir = filepath.Join(consts.RMANStagingDir, params.Tag)
+	}
+
+	verifyStmt := fmt.Sprintf(verifyStmtTemplate, channels, strings.Join(keys, ","))
+	klog.InfoS("oracle/VerifyBackup", "verifyStmt", verifyStmt)
+
+	verifyReq := &dbdpb.RunRMANAsyncRequest{
+		SyncRequest: &dbdpb.RunRMANRequest{
+			Scripts:            []string{verifyStmt},
+			GcsPath:            params.GCSPath,
+			LocalPath:          stagingDir,
+			Cmd:                consts.RMANVerify,
+			StorageCredentials: params.StorageCredentials,
+		},
+		LroInput: &dbdpb.LROInput{OperationId: params.OperationID},
+	}
+	operation, err := params.Client.RunRMANAsync(ctx, verifyReq)
+	if err != nil {
+		return nil, fmt.Errorf("oracle/VerifyBackup: failed to create database backup verification request: %v", err)
+	}
+	return operation, nil
+}
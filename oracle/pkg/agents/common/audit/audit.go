@@ -0,0 +1,422 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package audit provides the audit trail of the SQL and RMAN statements run
+// by the Database Daemon. Every statement is recorded, redacted, in an
+// append-only file of JSON lines. Each entry holds the hash of the previous
+// one, so that Verify detects entries which were lost, reordered or
+// corrupted. The head of the chain, see Logger.Head, is anchored outside of
+// the database Pod by the operator, which records it in the Instance
+// status. Rewriting or truncating the entries up to an anchor breaks the
+// chain at the anchor, which Verify detects; only the entries after the last
+// anchor can be rewritten by someone who can write the file.
+//
+// The callers are identified by their client certificates, see Caller.
+package audit
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"k8s.io/klog/v2"
+
+	"github.com/GoogleCloudPlatform/elcarro-oracle-operator/oracle/pkg/agents/common/mtls"
+)
+
+const (
+	// CallerMetadataKey is the gRPC metadata key of the callers on whose
+	// behalf the Config Agent calls the Database Daemon, see
+	// UnaryServerInterceptor. It's ignored in the calls of other peers.
+	CallerMetadataKey = "x-elcarro-caller"
+
+	// OutcomeSuccess and OutcomeFailure are the outcomes of an audited call.
+	OutcomeSuccess = "SUCCESS"
+	OutcomeFailure = "FAILURE"
+	// OutcomeGap is the outcome of the entry recorded in place of a partially
+	// written entry, e.g. after a crash or a full disk.
+	OutcomeGap = "GAP"
+
+	redacted = "<REDACTED>"
+	// internalCaller is the caller of calls that didn't come through gRPC.
+	internalCaller  = "internal"
+	callerSeparator = " > "
+)
+
+// Entry is a record of the audit trail.
+type Entry struct {
+	// Seq is the position of the entry in the audit trail, starting at 1.
+	Seq  int64     `json:"seq"`
+	Time time.Time `json:"time"`
+	// Caller is the chain of callers, the original caller first, see Caller.
+	Caller string `json:"caller"`
+	// RPC is the Database Daemon method, e.g. RunSQLPlus.
+	RPC string `json:"rpc"`
+	// Statements are the redacted SQL statements or RMAN scripts.
+	Statements []string `json:"statements"`
+	DurationMs int64    `json:"durationMs"`
+	Outcome    string   `json:"outcome"`
+	Error      string   `json:"error,omitempty"`
+	// PrevHash is the hash of the previous entry, empty for the first one.
+	PrevHash string `json:"prevHash"`
+	// Hash is the SHA-256 of the entry with an empty Hash.
+	Hash string `json:"hash"`
+}
+
+// Head identifies the last entry of an audit log. An anchored head is
+// checked by Verify.
+type Head struct {
+	Seq  int64
+	Hash string
+}
+
+func (e Entry) computeHash() (string, error) {
+	e.Hash = ""
+	b, err := e.marshal()
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// marshal returns the JSON line of the entry, statements are not HTML
+// escaped to keep them readable.
+func (e Entry) marshal() ([]byte, error) {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(e); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// Logger appends entries to an audit log file. A nil Logger discards
+// entries.
+type Logger struct {
+	mu       sync.Mutex
+	f        *os.File
+	seq      int64
+	prevHash string
+	now      func() time.Time
+}
+
+// NewLogger opens the audit log file at path, creating it and its directory
+// if needed. New entries continue the hash chain of the existing ones.
+// A partially written last entry is truncated and replaced with a gap entry,
+// so that the Database Daemon still starts after a crash.
+func NewLogger(path string) (*Logger, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0750); err != nil {
+		return nil, fmt.Errorf("audit: failed to create the directory of %q: %v", path, err)
+	}
+	f, err := os.OpenFile(path, os.O_RDWR|os.O_APPEND|os.O_CREATE, 0640)
+	if err != nil {
+		return nil, fmt.Errorf("audit: failed to open %q: %v", path, err)
+	}
+	last, end, size, err := lastEntry(f)
+	if err != nil {
+		f.Close()
+		return nil, fmt.Errorf("audit: failed to read the last entry of %q: %v", path, err)
+	}
+	l := &Logger{f: f, now: time.Now}
+	if last != nil {
+		l.seq = last.Seq
+		l.prevHash = last.Hash
+	}
+	if end < size {
+		klog.Warningf("audit: discarding %d bytes of a partially written entry after seq %d of %q", size-end, l.seq, path)
+		if err := f.Truncate(end); err != nil {
+			f.Close()
+			return nil, fmt.Errorf("audit: failed to truncate the partially written entry of %q: %v", path, err)
+		}
+		now := l.now()
+		gap := Entry{
+			Seq:      l.seq + 1,
+			Time:     now.UTC(),
+			Caller:   internalCaller,
+			Outcome:  OutcomeGap,
+			Error:    fmt.Sprintf("discarded %d bytes of a partially written entry", size-end),
+			PrevHash: l.prevHash,
+		}
+		if err := l.append(gap); err != nil {
+			f.Close()
+			return nil, fmt.Errorf("audit: failed to record the gap entry of %q: %v", path, err)
+		}
+	}
+	return l, nil
+}
+
+// lastEntry returns the last entry of the audit log, nil if it's empty, the
+// offset of the end of that entry and the size of the log. A last line which
+// isn't a valid entry was partially written, its entry is the one before.
+func lastEntry(r io.Reader) (*Entry, int64, int64, error) {
+	type line struct {
+		text []byte
+		end  int64
+	}
+	var prev, last line
+	var size int64
+	br := bufio.NewReader(r)
+	for {
+		b, err := br.ReadBytes('\n')
+		size += int64(len(b))
+		if text := bytes.TrimSpace(b); len(text) > 0 {
+			prev, last = last, line{text: text, end: size}
+		}
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, 0, 0, err
+		}
+	}
+	if last.text == nil {
+		return nil, size, size, nil
+	}
+	e := &Entry{}
+	if err := json.Unmarshal(last.text, e); err == nil {
+		return e, size, size, nil
+	}
+	if prev.text == nil {
+		return nil, 0, size, nil
+	}
+	e = &Entry{}
+	if err := json.Unmarshal(prev.text, e); err != nil {
+		return nil, 0, 0, err
+	}
+	return e, prev.end, size, nil
+}
+
+// Head returns the head of the audit log, the zero Head if it's empty.
+func (l *Logger) Head() Head {
+	if l == nil {
+		return Head{}
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return Head{Seq: l.seq, Hash: l.prevHash}
+}
+
+// Close closes the audit log file.
+func (l *Logger) Close() error {
+	if l == nil {
+		return nil
+	}
+	return l.f.Close()
+}
+
+// Log records a call of rpc which ran the statements from start on. The
+// statements and the error are redacted.
+func (l *Logger) Log(ctx context.Context, rpc string, statements []string, start time.Time, callErr error) {
+	if l == nil {
+		return
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	e := Entry{
+		Seq:        l.seq + 1,
+		Time:       now.UTC(),
+		Caller:     Caller(ctx),
+		RPC:        rpc,
+		Statements: make([]string, len(statements)),
+		DurationMs: now.Sub(start).Milliseconds(),
+		Outcome:    OutcomeSuccess,
+		PrevHash:   l.prevHash,
+	}
+	for i, s := range statements {
+		e.Statements[i] = Redact(s)
+	}
+	if callErr != nil {
+		e.Outcome = OutcomeFailure
+		e.Error = Redact(callErr.Error())
+	}
+	if err := l.append(e); err != nil {
+		klog.ErrorS(err, "audit: failed to record an entry", "rpc", rpc, "caller", e.Caller)
+	}
+}
+
+func (l *Logger) append(e Entry) error {
+	hash, err := e.computeHash()
+	if err != nil {
+		return err
+	}
+	e.Hash = hash
+	b, err := e.marshal()
+	if err != nil {
+		return err
+	}
+	if _, err := l.f.Write(b); err != nil {
+		return err
+	}
+	if err := l.f.Sync(); err != nil {
+		return err
+	}
+	l.seq = e.Seq
+	l.prevHash = e.Hash
+	return nil
+}
+
+// Verify checks the hash chain of an audit log and returns the number of
+// entries. It fails on the first entry which was modified, or which doesn't
+// follow the previous one. If anchor isn't the zero Head, it also fails if
+// the entry at the anchor doesn't have the anchored hash, or if the log ends
+// before it, i.e. if the chain was rewritten or truncated up to the anchor.
+func Verify(r io.Reader, anchor Head) (int64, error) {
+	var prev Entry
+	var n int64
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 16*1024*1024)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		var e Entry
+		if err := json.Unmarshal([]byte(line), &e); err != nil {
+			return n, fmt.Errorf("audit: entry after seq %d is not valid: %v", prev.Seq, err)
+		}
+		hash, err := e.computeHash()
+		if err != nil {
+			return n, err
+		}
+		if hash != e.Hash {
+			return n, fmt.Errorf("audit: entry seq %d was modified", e.Seq)
+		}
+		if e.Seq != prev.Seq+1 || e.PrevHash != prev.Hash {
+			return n, fmt.Errorf("audit: entry seq %d doesn't follow entry seq %d", e.Seq, prev.Seq)
+		}
+		if e.Seq == anchor.Seq && e.Hash != anchor.Hash {
+			return n, fmt.Errorf("audit: entry seq %d doesn't match the anchor, the log was rewritten", e.Seq)
+		}
+		prev = e
+		n++
+	}
+	if err := scanner.Err(); err != nil {
+		return n, err
+	}
+	if prev.Seq < anchor.Seq {
+		return n, fmt.Errorf("audit: the log ends at seq %d before the anchor at seq %d, it was truncated", prev.Seq, anchor.Seq)
+	}
+	return n, nil
+}
+
+type callerKey struct{}
+
+// WithCaller returns a copy of ctx with the caller, for calls which run
+// outside of the gRPC call, e.g. in a long running operation.
+func WithCaller(ctx context.Context, caller string) context.Context {
+	return context.WithValue(ctx, callerKey{}, caller)
+}
+
+// Caller returns the identity of the caller of a gRPC call. A peer which
+// presents a verified client certificate is identified by its common name,
+// any other peer by its address. The callers in the gRPC metadata precede
+// the peer only if the peer presents the certificate of the Config Agent,
+// the metadata of other peers isn't trusted and is ignored.
+func Caller(ctx context.Context) string {
+	if caller, ok := ctx.Value(callerKey{}).(string); ok {
+		return caller
+	}
+	p, forwards := peerName(ctx)
+	var callers []string
+	if md, ok := metadata.FromIncomingContext(ctx); ok && forwards {
+		callers = append(callers, md.Get(CallerMetadataKey)...)
+	}
+	if p != "" {
+		callers = append(callers, p)
+	}
+	if len(callers) == 0 {
+		return internalCaller
+	}
+	return strings.Join(callers, callerSeparator)
+}
+
+// peerName returns the name of the peer of a gRPC call, and true if the
+// peer forwards the callers on whose behalf it calls.
+func peerName(ctx context.Context) (string, bool) {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return "", false
+	}
+	if tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo); ok {
+		if chains := tlsInfo.State.VerifiedChains; len(chains) > 0 && len(chains[0]) > 0 {
+			cert := chains[0][0]
+			return commonName(cert), mtls.CertificateRole(cert) == mtls.RoleConfigAgent
+		}
+	}
+	if p.Addr != nil {
+		return p.Addr.String(), false
+	}
+	return "", false
+}
+
+func commonName(cert *x509.Certificate) string {
+	if cert.Subject.CommonName != "" {
+		return "cn=" + cert.Subject.CommonName
+	}
+	return cert.Subject.String()
+}
+
+// UnaryServerInterceptor forwards the caller of a gRPC call to the outgoing
+// calls of its handler. The Database Daemon trusts the forwarded caller only
+// if the call comes with the certificate of the Config Agent.
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if caller := Caller(ctx); caller != internalCaller {
+			ctx = metadata.AppendToOutgoingContext(ctx, CallerMetadataKey, caller)
+		}
+		return handler(ctx, req)
+	}
+}
+
+var (
+	// secretPatterns match the secrets in SQL statements and RMAN scripts,
+	// the first group is kept.
+	secretPatterns = []*regexp.Regexp{
+		// create/alter user ... identified by <password>, administer key
+		// management ... identified by <password>
+		regexp.MustCompile(`(?i)(\bidentified\s+by\s+)("[^"]*"|'[^']*'|[^\s;]+)`),
+		// connect target <user>/<password>@<service>
+		regexp.MustCompile(`(?i)(\bconnect\s+(?:target\s+|auxiliary\s+|catalog\s+)?[^\s/]+/)("[^"]*"|[^\s@;]+)`),
+		// password=<password>, secret=<password>
+		regexp.MustCompile(`(?i)(\b(?:password|secret)\s*=\s*)("[^"]*"|'[^']*'|[^\s,;)]+)`),
+	}
+)
+
+// Redact returns the statement with the passwords and secrets replaced.
+func Redact(statement string) string {
+	for _, re := range secretPatterns {
+		statement = re.ReplaceAllString(statement, "${1}"+redacted)
+	}
+	return statement
+}
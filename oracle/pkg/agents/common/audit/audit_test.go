@@ -0,0 +1,319 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package audit
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+
+	"github.com/GoogleCloudPlatform/elcarro-oracle-operator/oracle/pkg/agents/common/mtls"
+)
+
+// tlsPeer returns a peer which presents a certificate of role, verified if
+// verified is true.
+func tlsPeer(commonName, role string, verified bool) *peer.Peer {
+	cert := &x509.Certificate{Subject: pkix.Name{CommonName: commonName, OrganizationalUnit: []string{role}}}
+	state := tls.ConnectionState{PeerCertificates: []*x509.Certificate{cert}}
+	if verified {
+		state.VerifiedChains = [][]*x509.Certificate{{cert}}
+	}
+	return &peer.Peer{
+		Addr:     &net.TCPAddr{IP: net.ParseIP("10.0.0.7"), Port: 4242},
+		AuthInfo: credentials.TLSInfo{State: state},
+	}
+}
+
+func TestLogger(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit", "audit.log")
+	ctx := peer.NewContext(
+		metadata.NewIncomingContext(context.Background(), metadata.Pairs(CallerMetadataKey, "cn=inst.ns operator")),
+		tlsPeer("inst.ns config-agent", mtls.RoleConfigAgent, true))
+
+	l, err := NewLogger(path)
+	if err != nil {
+		t.Fatalf("NewLogger(%q) failed: %v", path, err)
+	}
+	l.Log(ctx, "RunSQLPlus", []string{`alter user scott identified by "tiger"`}, time.Now(), nil)
+	l.Log(ctx, "RunRMAN", []string{"backup database;"}, time.Now(), errors.New("RMAN-03009"))
+	if err := l.Close(); err != nil {
+		t.Fatalf("Close() failed: %v", err)
+	}
+
+	// A reopened log continues the hash chain.
+	l, err = NewLogger(path)
+	if err != nil {
+		t.Fatalf("NewLogger(%q) failed: %v", path, err)
+	}
+	l.Log(context.Background(), "RunSQLPlusFormatted", []string{"select 1 from dual"}, time.Now(), nil)
+	anchor := l.Head()
+	l.Close()
+	if anchor.Seq != 3 || anchor.Hash == "" {
+		t.Fatalf("Head() = %+v, want seq 3", anchor)
+	}
+
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatalf("ReadFile(%q) failed: %v", path, err)
+	}
+	n, err := Verify(bytes.NewReader(b), anchor)
+	if err != nil || n != 3 {
+		t.Fatalf("Verify() = %d, %v, want 3, nil", n, err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(string(b)), "\n")
+	var entries []Entry
+	for _, line := range lines {
+		var e Entry
+		if err := json.Unmarshal([]byte(line), &e); err != nil {
+			t.Fatalf("failed to parse entry %q: %v", line, err)
+		}
+		entries = append(entries, e)
+	}
+	if got, want := entries[0].Statements[0], `alter user scott identified by <REDACTED>`; got != want {
+		t.Errorf("entries[0].Statements[0] = %q, want %q", got, want)
+	}
+	if got, want := entries[0].Caller, "cn=inst.ns operator > cn=inst.ns config-agent"; got != want {
+		t.Errorf("entries[0].Caller = %q, want %q", got, want)
+	}
+	if entries[0].Outcome != OutcomeSuccess || entries[1].Outcome != OutcomeFailure || entries[1].Error != "RMAN-03009" {
+		t.Errorf("unexpected outcomes: %+v, %+v", entries[0], entries[1])
+	}
+	if got, want := entries[2].Caller, internalCaller; got != want {
+		t.Errorf("entries[2].Caller = %q, want %q", got, want)
+	}
+
+	// A modified entry is detected.
+	tampered := strings.Replace(string(b), "backup database;", "delete noprompt backup;", 1)
+	if _, err := Verify(strings.NewReader(tampered), Head{}); err == nil {
+		t.Error("Verify() of a modified log succeeded, want an error")
+	}
+	// A removed entry is detected.
+	removed := lines[0] + "\n" + lines[2] + "\n"
+	if _, err := Verify(strings.NewReader(removed), Head{}); err == nil {
+		t.Error("Verify() of a log with a removed entry succeeded, want an error")
+	}
+	// A truncated log is detected by the anchor.
+	truncated := lines[0] + "\n" + lines[1] + "\n"
+	if _, err := Verify(strings.NewReader(truncated), Head{}); err != nil {
+		t.Errorf("Verify() of a truncated log without an anchor = %v, want nil", err)
+	}
+	if _, err := Verify(strings.NewReader(truncated), anchor); err == nil {
+		t.Error("Verify() of a truncated log succeeded, want an error")
+	}
+
+	// A rewritten log with a valid chain is detected by the anchor.
+	rewrittenPath := filepath.Join(t.TempDir(), "audit.log")
+	rewritten, err := NewLogger(rewrittenPath)
+	if err != nil {
+		t.Fatalf("NewLogger(%q) failed: %v", rewrittenPath, err)
+	}
+	for i := 0; i < 3; i++ {
+		rewritten.Log(context.Background(), "RunSQLPlus", []string{"select 1 from dual"}, time.Now(), nil)
+	}
+	rewritten.Close()
+	b, err = ioutil.ReadFile(rewrittenPath)
+	if err != nil {
+		t.Fatalf("ReadFile(%q) failed: %v", rewrittenPath, err)
+	}
+	if _, err := Verify(bytes.NewReader(b), Head{}); err != nil {
+		t.Errorf("Verify() of a rewritten log without an anchor = %v, want nil", err)
+	}
+	if _, err := Verify(bytes.NewReader(b), anchor); err == nil {
+		t.Error("Verify() of a rewritten log succeeded, want an error")
+	}
+}
+
+func TestLoggerPartialEntry(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.log")
+	l, err := NewLogger(path)
+	if err != nil {
+		t.Fatalf("NewLogger(%q) failed: %v", path, err)
+	}
+	l.Log(context.Background(), "RunRMAN", []string{"backup database;"}, time.Now(), nil)
+	l.Close()
+
+	// The daemon crashed while it was writing an entry.
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND, 0)
+	if err != nil {
+		t.Fatalf("OpenFile(%q) failed: %v", path, err)
+	}
+	if _, err := f.WriteString(`{"seq":2,"time":"2021-`); err != nil {
+		t.Fatalf("WriteString failed: %v", err)
+	}
+	f.Close()
+
+	l, err = NewLogger(path)
+	if err != nil {
+		t.Fatalf("NewLogger(%q) of a log with a partial entry failed: %v", path, err)
+	}
+	l.Log(context.Background(), "RunSQLPlus", []string{"select 1 from dual"}, time.Now(), nil)
+	l.Close()
+
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatalf("ReadFile(%q) failed: %v", path, err)
+	}
+	n, err := Verify(bytes.NewReader(b), Head{})
+	if err != nil || n != 3 {
+		t.Fatalf("Verify() = %d, %v, want 3, nil", n, err)
+	}
+	lines := strings.Split(strings.TrimSpace(string(b)), "\n")
+	var gap Entry
+	if err := json.Unmarshal([]byte(lines[1]), &gap); err != nil {
+		t.Fatalf("failed to parse entry %q: %v", lines[1], err)
+	}
+	if gap.Seq != 2 || gap.Outcome != OutcomeGap {
+		t.Errorf("entry 2 = %+v, want a gap entry", gap)
+	}
+}
+
+func TestNilLogger(t *testing.T) {
+	var l *Logger
+	l.Log(context.Background(), "RunSQLPlus", []string{"select 1 from dual"}, time.Now(), nil)
+	if got := l.Head(); got != (Head{}) {
+		t.Errorf("Head() = %+v, want the zero Head", got)
+	}
+	if err := l.Close(); err != nil {
+		t.Errorf("Close() = %v, want nil", err)
+	}
+}
+
+func TestRedact(t *testing.T) {
+	tests := []struct {
+		statement string
+		want      string
+	}{
+		{
+			statement: "select name from v$database",
+			want:      "select name from v$database",
+		},
+		{
+			statement: `create user "APP" identified by "Pa$$word"`,
+			want:      `create user "APP" identified by <REDACTED>`,
+		},
+		{
+			statement: "alter user scott IDENTIFIED BY tiger account unlock",
+			want:      "alter user scott IDENTIFIED BY <REDACTED> account unlock",
+		},
+		{
+			statement: "connect target sys/secret@GCLOUD\nbackup database;",
+			want:      "connect target sys/<REDACTED>@GCLOUD\nbackup database;",
+		},
+		{
+			statement: "administer key management set keystore open identified by 'wallet_pw';",
+			want:      "administer key management set keystore open identified by <REDACTED>;",
+		},
+		{
+			statement: "orapwd file=/u02/orapw password=secret entries=10",
+			want:      "orapwd file=/u02/orapw password=<REDACTED> entries=10",
+		},
+	}
+	for _, tc := range tests {
+		if got := Redact(tc.statement); got != tc.want {
+			t.Errorf("Redact(%q) = %q, want %q", tc.statement, got, tc.want)
+		}
+	}
+}
+
+func TestCaller(t *testing.T) {
+	addr := &net.TCPAddr{IP: net.ParseIP("10.0.0.7"), Port: 4242}
+	forwarded := metadata.Pairs(CallerMetadataKey, "cn=inst.ns operator")
+	tests := []struct {
+		name string
+		ctx  context.Context
+		want string
+	}{
+		{
+			name: "internal",
+			ctx:  context.Background(),
+			want: internalCaller,
+		},
+		{
+			name: "peer",
+			ctx:  peer.NewContext(context.Background(), &peer.Peer{Addr: addr}),
+			want: "10.0.0.7:4242",
+		},
+		{
+			name: "certificate",
+			ctx:  peer.NewContext(context.Background(), tlsPeer("inst.ns database", mtls.RoleDatabase, true)),
+			want: "cn=inst.ns database",
+		},
+		{
+			name: "forwarded by the Config Agent",
+			ctx:  peer.NewContext(metadata.NewIncomingContext(context.Background(), forwarded), tlsPeer("inst.ns config-agent", mtls.RoleConfigAgent, true)),
+			want: "cn=inst.ns operator > cn=inst.ns config-agent",
+		},
+		{
+			name: "forwarded by another certificate",
+			ctx:  peer.NewContext(metadata.NewIncomingContext(context.Background(), forwarded), tlsPeer("inst.ns database", mtls.RoleDatabase, true)),
+			want: "cn=inst.ns database",
+		},
+		{
+			name: "forwarded without a certificate",
+			ctx:  peer.NewContext(metadata.NewIncomingContext(context.Background(), forwarded), &peer.Peer{Addr: addr}),
+			want: "10.0.0.7:4242",
+		},
+		{
+			name: "unverified certificate",
+			ctx:  peer.NewContext(metadata.NewIncomingContext(context.Background(), forwarded), tlsPeer("inst.ns config-agent", mtls.RoleConfigAgent, false)),
+			want: "10.0.0.7:4242",
+		},
+		{
+			name: "explicit caller",
+			ctx:  WithCaller(peer.NewContext(context.Background(), &peer.Peer{Addr: addr}), "lro"),
+			want: "lro",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := Caller(tc.ctx); got != tc.want {
+				t.Errorf("Caller() = %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	ctx := peer.NewContext(context.Background(), tlsPeer("inst.ns operator", mtls.RoleOperator, true))
+	var got []string
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		md, _ := metadata.FromOutgoingContext(ctx)
+		got = md.Get(CallerMetadataKey)
+		return nil, nil
+	}
+	if _, err := UnaryServerInterceptor()(ctx, nil, &grpc.UnaryServerInfo{}, handler); err != nil {
+		t.Fatalf("interceptor failed: %v", err)
+	}
+	if want := "cn=inst.ns operator"; len(got) != 1 || got[0] != want {
+		t.Errorf("outgoing %s = %q, want [%q]", CallerMetadataKey, got, want)
+	}
+}
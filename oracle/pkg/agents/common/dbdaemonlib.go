@@ -20,7 +20,11 @@ import (
 	"time"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/local"
+
+	"github.com/GoogleCloudPlatform/elcarro-oracle-operator/oracle/pkg/agents/common/mtls"
+	"github.com/GoogleCloudPlatform/elcarro-oracle-operator/oracle/pkg/agents/consts"
 )
 
 var (
@@ -37,10 +41,18 @@ func withTimeout(ctx context.Context, timeOut time.Duration) (context.Context, c
 }
 
 // DatabaseDaemonDialLocalhost connects to a local Database Daemon via gRPC.
+// Mutual TLS is used if the agent certificates are mounted into the container.
 func DatabaseDaemonDialLocalhost(ctx context.Context, port int, opts ...grpc.DialOption) (*grpc.ClientConn, error) {
 	ctxDial, cancel := withTimeout(ctx, CallTimeout)
 	defer cancel()
-	finalOpts := append([]grpc.DialOption{grpc.WithTransportCredentials(local.NewCredentials())}, opts...)
+	creds := local.NewCredentials()
+	if mtls.Available(consts.AgentTLSDir) {
+		var err error
+		if creds, err = mtls.NewClientCredentials(consts.AgentTLSDir, consts.Localhost); err != nil {
+			return nil, err
+		}
+	}
+	finalOpts := append([]grpc.DialOption{grpc.WithTransportCredentials(creds)}, opts...)
 	return grpc.DialContext(ctxDial, fmt.Sprintf("localhost:%d", port), finalOpts...)
 }
 
@@ -54,9 +66,14 @@ func DatabaseDaemonDialSocket(ctx context.Context, socket string, opts ...grpc.D
 }
 
 // DatabaseDaemonDialService connects to Database Service via gRPC.
-func DatabaseDaemonDialService(ctx context.Context, serviceAndPort string, opts ...grpc.DialOption) (*grpc.ClientConn, error) {
+// The connection is not encrypted if creds is nil.
+func DatabaseDaemonDialService(ctx context.Context, serviceAndPort string, creds credentials.TransportCredentials, opts ...grpc.DialOption) (*grpc.ClientConn, error) {
 	ctxDial, cancel := withTimeout(ctx, CallTimeout)
 	defer cancel()
-	finalOpts := append([]grpc.DialOption{grpc.WithInsecure()}, opts...)
+	credsOpt := grpc.WithInsecure()
+	if creds != nil {
+		credsOpt = grpc.WithTransportCredentials(creds)
+	}
+	finalOpts := append([]grpc.DialOption{credsOpt}, opts...)
 	return grpc.DialContext(ctxDial, serviceAndPort, finalOpts...)
 }
@@ -0,0 +1,291 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package mtls provides the certificates and the gRPC transport credentials
+// for the mutual TLS between the operator, the Config Agent and the Database
+// Daemon. Every Instance has its own CA, which signs a single certificate
+// that is used by both the servers and the clients of the Instance.
+package mtls
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"time"
+
+	"google.golang.org/grpc/credentials"
+)
+
+// Names of the files in a certificate directory, which match the keys of a
+// Kubernetes TLS Secret.
+const (
+	// CAFile holds the PEM encoded CA certificates that are trusted.
+	CAFile = "ca.crt"
+	// CertFile holds the PEM encoded certificate.
+	CertFile = "tls.crt"
+	// KeyFile holds the PEM encoded private key of the certificate.
+	KeyFile = "tls.key"
+)
+
+// Roles of the certificates of an Instance. The role is the organizational
+// unit of a certificate, which identifies the component presenting it.
+const (
+	// RoleOperator is the role of the certificate of the operator, which is
+	// only used as a client.
+	RoleOperator = "operator"
+	// RoleConfigAgent is the role of the certificate of the Config Agent.
+	RoleConfigAgent = "config-agent"
+	// RoleDatabase is the role of the certificate of the database Pod, which
+	// is used by the Database Daemon and the clients in the Pod.
+	RoleDatabase = "database"
+)
+
+// NewCA returns a new self-signed CA certificate and its private key, PEM
+// encoded.
+func NewCA(commonName string, notAfter time.Time) (certPEM, keyPEM []byte, err error) {
+	tmpl := &x509.Certificate{
+		Subject:               pkix.Name{CommonName: commonName},
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign | x509.KeyUsageDigitalSignature,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	return newCertificate(tmpl, nil, nil, notAfter)
+}
+
+// IssueCertificate returns a new certificate of role for hosts signed by the
+// CA, and its private key, PEM encoded. The certificate can be used by both
+// servers and clients. Hosts may be DNS names or IP addresses.
+func IssueCertificate(caCertPEM, caKeyPEM []byte, commonName, role string, hosts []string, notAfter time.Time) (certPEM, keyPEM []byte, err error) {
+	ca, err := tls.X509KeyPair(caCertPEM, caKeyPEM)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to load the CA: %v", err)
+	}
+	caCert, err := x509.ParseCertificate(ca.Certificate[0])
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to parse the CA certificate: %v", err)
+	}
+
+	tmpl := &x509.Certificate{
+		Subject:     pkix.Name{CommonName: commonName, OrganizationalUnit: []string{role}},
+		KeyUsage:    x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+	for _, h := range hosts {
+		if ip := net.ParseIP(h); ip != nil {
+			tmpl.IPAddresses = append(tmpl.IPAddresses, ip)
+		} else {
+			tmpl.DNSNames = append(tmpl.DNSNames, h)
+		}
+	}
+	if caCert.NotAfter.Before(notAfter) {
+		notAfter = caCert.NotAfter
+	}
+	return newCertificate(tmpl, caCert, ca.PrivateKey, notAfter)
+}
+
+func newCertificate(tmpl, parent *x509.Certificate, parentKey interface{}, notAfter time.Time) (certPEM, keyPEM []byte, err error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to generate a private key: %v", err)
+	}
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to generate a serial number: %v", err)
+	}
+	tmpl.SerialNumber = serial
+	// Tolerate clock skew between the operator and the agents.
+	tmpl.NotBefore = time.Now().Add(-time.Hour)
+	tmpl.NotAfter = notAfter
+	if parent == nil {
+		parent, parentKey = tmpl, key
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, parent, &key.PublicKey, parentKey)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create a certificate: %v", err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to marshal the private key: %v", err)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), nil
+}
+
+// ExpiresBefore returns true if the first certificate in certPEM, which is
+// the certificate of a key pair or the current CA of a bundle, expires
+// before t. It also returns true if certPEM cannot be parsed.
+func ExpiresBefore(certPEM []byte, t time.Time) bool {
+	certs, err := parseCertificates(certPEM)
+	if err != nil || len(certs) == 0 {
+		return true
+	}
+	return certs[0].NotAfter.Before(t)
+}
+
+// Role returns the role of the first certificate in certPEM, empty if it
+// has none or if certPEM cannot be parsed.
+func Role(certPEM []byte) string {
+	certs, err := parseCertificates(certPEM)
+	if err != nil || len(certs) == 0 {
+		return ""
+	}
+	return CertificateRole(certs[0])
+}
+
+// CertificateRole returns the role of a certificate issued by
+// IssueCertificate, empty if it has none.
+func CertificateRole(cert *x509.Certificate) string {
+	if len(cert.Subject.OrganizationalUnit) != 1 {
+		return ""
+	}
+	return cert.Subject.OrganizationalUnit[0]
+}
+
+// UnexpiredCertificates returns the certificates in certPEM which are still
+// valid at t, PEM encoded.
+func UnexpiredCertificates(certPEM []byte, t time.Time) []byte {
+	certs, _ := parseCertificates(certPEM)
+	var out []byte
+	for _, c := range certs {
+		if c.NotAfter.After(t) {
+			out = append(out, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: c.Raw})...)
+		}
+	}
+	return out
+}
+
+func parseCertificates(certPEM []byte) ([]*x509.Certificate, error) {
+	var certs []*x509.Certificate
+	for block, rest := pem.Decode(certPEM); block != nil; block, rest = pem.Decode(rest) {
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		c, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		certs = append(certs, c)
+	}
+	return certs, nil
+}
+
+// Available returns true if dir contains a certificate, its private key and
+// the trusted CA certificates.
+func Available(dir string) bool {
+	for _, f := range []string{CAFile, CertFile, KeyFile} {
+		if _, err := os.Stat(filepath.Join(dir, f)); err != nil {
+			return false
+		}
+	}
+	return true
+}
+
+// NewServerCredentials returns the credentials of a server which requires
+// clients to present a certificate signed by a CA in dir. The files in dir
+// are read on every handshake, so that rotated certificates are picked up
+// without a restart.
+func NewServerCredentials(dir string) (credentials.TransportCredentials, error) {
+	if _, err := loadServerConfig(dir); err != nil {
+		return nil, err
+	}
+	return credentials.NewTLS(&tls.Config{
+		MinVersion: tls.VersionTLS12,
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			return loadServerConfig(dir)
+		},
+	}), nil
+}
+
+func loadServerConfig(dir string) (*tls.Config, error) {
+	caPEM, cert, err := loadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	pool, err := certPool(caPEM)
+	if err != nil {
+		return nil, err
+	}
+	return &tls.Config{
+		MinVersion:   tls.VersionTLS12,
+		Certificates: []tls.Certificate{cert},
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		ClientCAs:    pool,
+	}, nil
+}
+
+// NewClientCredentials returns the credentials of a client which presents
+// the certificate in dir and verifies that the server certificate is valid
+// for serverName and signed by a CA in dir.
+func NewClientCredentials(dir, serverName string) (credentials.TransportCredentials, error) {
+	caPEM, cert, err := loadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	return newClientCredentials(caPEM, cert, serverName)
+}
+
+// NewClientCredentialsFromPEM is like NewClientCredentials, but it takes the
+// PEM encoded CA certificates, certificate and private key.
+func NewClientCredentialsFromPEM(caPEM, certPEM, keyPEM []byte, serverName string) (credentials.TransportCredentials, error) {
+	cert, err := tls.X509KeyPair(certPEM, keyPEM)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load the certificate: %v", err)
+	}
+	return newClientCredentials(caPEM, cert, serverName)
+}
+
+func newClientCredentials(caPEM []byte, cert tls.Certificate, serverName string) (credentials.TransportCredentials, error) {
+	pool, err := certPool(caPEM)
+	if err != nil {
+		return nil, err
+	}
+	return credentials.NewTLS(&tls.Config{
+		MinVersion:   tls.VersionTLS12,
+		Certificates: []tls.Certificate{cert},
+		RootCAs:      pool,
+		ServerName:   serverName,
+	}), nil
+}
+
+func loadDir(dir string) ([]byte, tls.Certificate, error) {
+	caPEM, err := ioutil.ReadFile(filepath.Join(dir, CAFile))
+	if err != nil {
+		return nil, tls.Certificate{}, fmt.Errorf("failed to read the CA certificates: %v", err)
+	}
+	cert, err := tls.LoadX509KeyPair(filepath.Join(dir, CertFile), filepath.Join(dir, KeyFile))
+	if err != nil {
+		return nil, tls.Certificate{}, fmt.Errorf("failed to load the certificate: %v", err)
+	}
+	return caPEM, cert, nil
+}
+
+func certPool(caPEM []byte) (*x509.CertPool, error) {
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(caPEM) {
+		return nil, errors.New("failed to parse the CA certificates")
+	}
+	return pool, nil
+}
@@ -0,0 +1,156 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mtls
+
+import (
+	"context"
+	"crypto/tls"
+	"io/ioutil"
+	"net"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+func newTestDir(t *testing.T, caCertPEM, caKeyPEM []byte, hosts []string) string {
+	t.Helper()
+	certPEM, keyPEM, err := IssueCertificate(caCertPEM, caKeyPEM, "test", RoleDatabase, hosts, time.Now().Add(time.Hour))
+	if err != nil {
+		t.Fatalf("IssueCertificate failed: %v", err)
+	}
+	dir := t.TempDir()
+	for f, data := range map[string][]byte{CAFile: caCertPEM, CertFile: certPEM, KeyFile: keyPEM} {
+		if err := ioutil.WriteFile(filepath.Join(dir, f), data, 0600); err != nil {
+			t.Fatalf("failed to write %s: %v", f, err)
+		}
+	}
+	return dir
+}
+
+func TestMutualTLS(t *testing.T) {
+	caCertPEM, caKeyPEM, err := NewCA("test-ca", time.Now().Add(24*time.Hour))
+	if err != nil {
+		t.Fatalf("NewCA failed: %v", err)
+	}
+	otherCACertPEM, otherCAKeyPEM, err := NewCA("other-ca", time.Now().Add(24*time.Hour))
+	if err != nil {
+		t.Fatalf("NewCA failed: %v", err)
+	}
+	serverDir := newTestDir(t, caCertPEM, caKeyPEM, []string{"localhost", "127.0.0.1"})
+	clientDir := newTestDir(t, caCertPEM, caKeyPEM, []string{"localhost"})
+	otherDir := newTestDir(t, otherCACertPEM, otherCAKeyPEM, []string{"localhost"})
+
+	serverCreds, err := NewServerCredentials(serverDir)
+	if err != nil {
+		t.Fatalf("NewServerCredentials failed: %v", err)
+	}
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to listen: %v", err)
+	}
+	grpcSvr := grpc.NewServer(grpc.Creds(serverCreds))
+	healthpb.RegisterHealthServer(grpcSvr, health.NewServer())
+	go grpcSvr.Serve(lis)
+	defer grpcSvr.Stop()
+
+	mustClientCreds := func(dir, serverName string) credentials.TransportCredentials {
+		creds, err := NewClientCredentials(dir, serverName)
+		if err != nil {
+			t.Fatalf("NewClientCredentials(%q) failed: %v", dir, err)
+		}
+		return creds
+	}
+	tests := []struct {
+		name    string
+		creds   credentials.TransportCredentials
+		wantErr bool
+	}{
+		{name: "trusted client", creds: mustClientCreds(clientDir, "localhost")},
+		{name: "client of another CA", creds: mustClientCreds(otherDir, "localhost"), wantErr: true},
+		{name: "wrong server name", creds: mustClientCreds(clientDir, "other-host"), wantErr: true},
+		{name: "client without a certificate", creds: credentials.NewTLS(&tls.Config{InsecureSkipVerify: true}), wantErr: true},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+			conn, err := grpc.DialContext(ctx, lis.Addr().String(), grpc.WithTransportCredentials(tc.creds))
+			if err != nil {
+				t.Fatalf("DialContext failed: %v", err)
+			}
+			defer conn.Close()
+			_, err = healthpb.NewHealthClient(conn).Check(ctx, &healthpb.HealthCheckRequest{})
+			if gotErr := err != nil; gotErr != tc.wantErr {
+				t.Errorf("Check got error %v, wanted an error: %v", err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestExpiresBefore(t *testing.T) {
+	now := time.Now()
+	caCertPEM, caKeyPEM, err := NewCA("test-ca", now.Add(48*time.Hour))
+	if err != nil {
+		t.Fatalf("NewCA failed: %v", err)
+	}
+	certPEM, _, err := IssueCertificate(caCertPEM, caKeyPEM, "test", RoleDatabase, []string{"localhost"}, now.Add(72*time.Hour))
+	if err != nil {
+		t.Fatalf("IssueCertificate failed: %v", err)
+	}
+
+	tests := []struct {
+		name string
+		pem  []byte
+		t    time.Time
+		want bool
+	}{
+		{name: "valid", pem: certPEM, t: now.Add(24 * time.Hour), want: false},
+		{name: "limited by the CA", pem: certPEM, t: now.Add(60 * time.Hour), want: true},
+		{name: "bundle", pem: append(append([]byte{}, caCertPEM...), certPEM...), t: now.Add(24 * time.Hour), want: false},
+		{name: "expired CA in a bundle", pem: append(append([]byte{}, certPEM...), caCertPEM...), t: now.Add(60 * time.Hour), want: true},
+		{name: "invalid", pem: []byte("invalid"), t: now, want: true},
+	}
+	for _, tc := range tests {
+		if got := ExpiresBefore(tc.pem, tc.t); got != tc.want {
+			t.Errorf("%s: ExpiresBefore got %v, want %v", tc.name, got, tc.want)
+		}
+	}
+
+	if got := UnexpiredCertificates(append(append([]byte{}, caCertPEM...), certPEM...), now.Add(60*time.Hour)); len(got) != 0 {
+		t.Errorf("UnexpiredCertificates got %q, want no certificates", got)
+	}
+}
+
+func TestRole(t *testing.T) {
+	caCertPEM, caKeyPEM, err := NewCA("test-ca", time.Now().Add(24*time.Hour))
+	if err != nil {
+		t.Fatalf("NewCA failed: %v", err)
+	}
+	certPEM, _, err := IssueCertificate(caCertPEM, caKeyPEM, "test", RoleConfigAgent, nil, time.Now().Add(time.Hour))
+	if err != nil {
+		t.Fatalf("IssueCertificate failed: %v", err)
+	}
+	if got := Role(certPEM); got != RoleConfigAgent {
+		t.Errorf("Role got %q, want %q", got, RoleConfigAgent)
+	}
+	if got := Role(caCertPEM); got != "" {
+		t.Errorf("Role of the CA got %q, want none", got)
+	}
+}
@@ -0,0 +1,65 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const storageCredentialsField = "storage_credentials"
+
+// passwordFields are the fields of the requests that hold passwords, alone
+// or in SQL statements.
+var passwordFields = []protoreflect.Name{"password", "last_password", "create_users_cmd"}
+
+// RedactStorageCredentials returns a copy of a request with the storage
+// credentials removed, including those of nested messages (e.g. the
+// sync_request of an async request), so that the request can be logged.
+func RedactStorageCredentials(m proto.Message) proto.Message {
+	m = proto.Clone(m)
+	redact(m.ProtoReflect(), storageCredentialsField)
+	return m
+}
+
+// RedactPasswords returns a copy of a request with the passwords removed,
+// including those of nested messages (e.g. the users of a request), so that
+// the request can be logged.
+func RedactPasswords(m proto.Message) proto.Message {
+	m = proto.Clone(m)
+	redact(m.ProtoReflect(), passwordFields...)
+	return m
+}
+
+func redact(m protoreflect.Message, names ...protoreflect.Name) {
+	for _, name := range names {
+		if fd := m.Descriptor().Fields().ByName(name); fd != nil {
+			m.Clear(fd)
+		}
+	}
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		if fd.Kind() != protoreflect.MessageKind || fd.IsMap() {
+			return true
+		}
+		if fd.IsList() {
+			for i := 0; i < v.List().Len(); i++ {
+				redact(v.List().Get(i).Message(), names...)
+			}
+			return true
+		}
+		redact(v.Message(), names...)
+		return true
+	})
+}
@@ -0,0 +1,77 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	capb "github.com/GoogleCloudPlatform/elcarro-oracle-operator/oracle/pkg/agents/config_agent/protos"
+	dbdpb "github.com/GoogleCloudPlatform/elcarro-oracle-operator/oracle/pkg/agents/oracle"
+)
+
+func TestRedactStorageCredentials(t *testing.T) {
+	creds := map[string]string{"secretAccessKey": "secret"}
+	req := &dbdpb.DataPumpExportAsyncRequest{
+		SyncRequest: &dbdpb.DataPumpExportRequest{
+			PdbName:            "pdb1",
+			GcsPath:            "s3://bucket/export.dmp",
+			StorageCredentials: creds,
+		},
+		LroInput: &dbdpb.LROInput{OperationId: "op"},
+	}
+	want := &dbdpb.DataPumpExportAsyncRequest{
+		SyncRequest: &dbdpb.DataPumpExportRequest{
+			PdbName: "pdb1",
+			GcsPath: "s3://bucket/export.dmp",
+		},
+		LroInput: &dbdpb.LROInput{OperationId: "op"},
+	}
+
+	got := RedactStorageCredentials(req)
+	if diff := cmp.Diff(want, got, protocmp.Transform()); diff != "" {
+		t.Errorf("RedactStorageCredentials got unexpected request: -want +got %v", diff)
+	}
+	if diff := cmp.Diff(creds, req.GetSyncRequest().GetStorageCredentials()); diff != "" {
+		t.Errorf("RedactStorageCredentials modified the original request: -want +got %v", diff)
+	}
+}
+
+func TestRedactPasswords(t *testing.T) {
+	req := &capb.UpdateUsersRequest{
+		PdbName: "pdb1",
+		UserSpecs: []*capb.User{
+			{Name: "scott", Password: "tiger", LastPassword: "lion", Privileges: []string{"connect"}},
+			{Name: "joice", PasswordSecret: &capb.SecretPassword{Password: "guess", Version: "2", LastVersion: "1"}},
+		},
+	}
+	want := &capb.UpdateUsersRequest{
+		PdbName: "pdb1",
+		UserSpecs: []*capb.User{
+			{Name: "scott", Privileges: []string{"connect"}},
+			{Name: "joice", PasswordSecret: &capb.SecretPassword{Version: "2", LastVersion: "1"}},
+		},
+	}
+
+	got := RedactPasswords(req)
+	if diff := cmp.Diff(want, got, protocmp.Transform()); diff != "" {
+		t.Errorf("RedactPasswords got unexpected request: -want +got %v", diff)
+	}
+	if req.GetUserSpecs()[0].GetPassword() != "tiger" || req.GetUserSpecs()[1].GetPasswordSecret().GetPassword() != "guess" {
+		t.Errorf("RedactPasswords modified the original request: %v", req)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"regexp"
+	"sort"
 	"strings"
 )
 
@@ -15,7 +16,18 @@ const (
 	alterUserCmd      = "alter user %s identified by %s"
 	grantPrivCmd      = "grant %s to %s"
 	revokePrivCmd     = "revoke %s from %s"
+	grantObjPrivCmd   = "grant %s on %s.%s to %s"
+	revokeObjPrivCmd  = "revoke %s on %s.%s from %s"
+	createRoleCmd     = "create role %s"
 	alterSystemSetCmd = "alter system set %s=%s"
+	createTSCmd       = "create %stablespace %s datafile size %d %s"
+	alterDatafileCmd  = "alter database datafile %d %s"
+	alterUserQuotaCmd = "alter user %s quota %s on %s"
+	createProfileCmd  = "create profile %s limit %s"
+	alterProfileCmd   = "alter profile %s limit %s"
+	alterUserProfCmd  = "alter user %s profile %s"
+	alterUserAcctCmd  = "alter user %s account %s"
+	dropUserCmd       = "drop user %s"
 )
 
 var (
@@ -24,6 +36,23 @@ var (
 	ErrQuoteInIdentifier      = errors.New("identifier contains double quotes")
 	privilegeMatcher          = regexp.MustCompile(`^[A-Za-z ,_]+$`).MatchString
 	parameterNonStringMatcher = regexp.MustCompile(`^[A-Za-z0-9-]+$`).MatchString
+	profileLimitMatcher       = regexp.MustCompile(`^(?i)([0-9]+(\.[0-9]+)?|unlimited|default)$`).MatchString
+	verifyFunctionMatcher     = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_$#]*$`).MatchString
+	// profileResources are the password and login resources which can be
+	// limited by a profile.
+	profileResources = map[string]bool{
+		"FAILED_LOGIN_ATTEMPTS":    true,
+		"PASSWORD_LIFE_TIME":       true,
+		"PASSWORD_GRACE_TIME":      true,
+		"PASSWORD_LOCK_TIME":       true,
+		"PASSWORD_REUSE_TIME":      true,
+		"PASSWORD_REUSE_MAX":       true,
+		"PASSWORD_VERIFY_FUNCTION": true,
+		"INACTIVE_ACCOUNT_TIME":    true,
+		"SESSIONS_PER_USER":        true,
+		"IDLE_TIME":                true,
+		"CONNECT_TIME":             true,
+	}
 )
 
 // QueryCreatePDB constructs a sql statement for creating a new pluggable database.
@@ -153,6 +182,38 @@ func QueryRevokePrivileges(privileges, grantee string) string {
 	)
 }
 
+// QueryGrantObjectPrivilege constructs a sql statement for granting a
+// privilege on the object of a schema.
+// It panics if privilege is not a valid privilege (syntactically) or schema,
+// object or grantee is not a valid identifier.
+func QueryGrantObjectPrivilege(privilege, schema, object, grantee string) string {
+	return fmt.Sprintf(grantObjPrivCmd,
+		mustBePrivilege(privilege),
+		MustBeObjectName(schema),
+		MustBeObjectName(object),
+		MustBeObjectName(grantee),
+	)
+}
+
+// QueryRevokeObjectPrivilege constructs a sql statement for revoking a
+// privilege on the object of a schema.
+// It panics if privilege is not a valid privilege (syntactically) or schema,
+// object or grantee is not a valid identifier.
+func QueryRevokeObjectPrivilege(privilege, schema, object, grantee string) string {
+	return fmt.Sprintf(revokeObjPrivCmd,
+		mustBePrivilege(privilege),
+		MustBeObjectName(schema),
+		MustBeObjectName(object),
+		MustBeObjectName(grantee),
+	)
+}
+
+// QueryCreateRole constructs a sql statement for creating a role.
+// It panics if name is not a valid identifier.
+func QueryCreateRole(name string) string {
+	return fmt.Sprintf(createRoleCmd, MustBeObjectName(name))
+}
+
 // IsValidParameterValue returns false if parameter value is not a valid one
 // based on the parameter type.
 // It still can return true in cases when parameter value won't be accepted by the database
@@ -178,3 +239,124 @@ func QuerySetSystemParameterNoPanic(name, value string, isTypeString bool) (stri
 
 	return fmt.Sprintf(alterSystemSetCmd, name, value), nil
 }
+
+// QueryCreateTablespace constructs a sql statement for creating a tablespace
+// with a single datafile managed by Oracle.
+// It panics if name is not a valid identifier.
+func QueryCreateTablespace(name string, bigfile bool, sizeBytes int64, autoExtend bool, maxSizeBytes int64) string {
+	kind := ""
+	if bigfile {
+		kind = "bigfile "
+	}
+	return fmt.Sprintf(createTSCmd,
+		kind,
+		MustBeObjectName(name),
+		sizeBytes,
+		autoExtendClause(autoExtend, maxSizeBytes),
+	)
+}
+
+// QueryAlterDatafileAutoExtend constructs a sql statement for changing the
+// autoextend settings of a datafile. A maxSizeBytes of 0 allows the datafile
+// to grow unlimited.
+func QueryAlterDatafileAutoExtend(fileID int64, autoExtend bool, maxSizeBytes int64) string {
+	return fmt.Sprintf(alterDatafileCmd, fileID, autoExtendClause(autoExtend, maxSizeBytes))
+}
+
+func autoExtendClause(autoExtend bool, maxSizeBytes int64) string {
+	switch {
+	case !autoExtend:
+		return "autoextend off"
+	case maxSizeBytes == 0:
+		return "autoextend on maxsize unlimited"
+	default:
+		return fmt.Sprintf("autoextend on maxsize %d", maxSizeBytes)
+	}
+}
+
+// QueryAlterUserQuota constructs a sql statement for setting the quota of a
+// user on a tablespace.
+// It panics if user or tablespace is not a valid identifier.
+func QueryAlterUserQuota(user, tablespace string, unlimited bool, maxBytes int64) string {
+	quota := "unlimited"
+	if !unlimited {
+		quota = fmt.Sprintf("%d", maxBytes)
+	}
+	return fmt.Sprintf(alterUserQuotaCmd,
+		MustBeObjectName(user),
+		quota,
+		MustBeObjectName(tablespace),
+	)
+}
+
+// IsProfileLimit returns true if value is a valid limit of the profile
+// resource: a number, UNLIMITED or DEFAULT, or the name of a function (or
+// NULL) for PASSWORD_VERIFY_FUNCTION.
+func IsProfileLimit(resource, value string) bool {
+	resource = strings.ToUpper(resource)
+	if !profileResources[resource] {
+		return false
+	}
+	if resource == "PASSWORD_VERIFY_FUNCTION" {
+		return verifyFunctionMatcher(value)
+	}
+	return profileLimitMatcher(value)
+}
+
+// profileLimits returns the limit clause of a profile statement, sorted by
+// resource.
+// It panics if a limit is not valid.
+func profileLimits(limits map[string]string) string {
+	var clauses []string
+	for r, v := range limits {
+		if !IsProfileLimit(r, v) {
+			panic(fmt.Errorf("not a limit of %s: %s", r, v))
+		}
+		clauses = append(clauses, fmt.Sprintf("%s %s", strings.ToUpper(r), strings.ToUpper(v)))
+	}
+	sort.Strings(clauses)
+	return strings.Join(clauses, " ")
+}
+
+// QueryCreateProfile constructs a sql statement for creating a profile with
+// limits by resource name.
+// It panics if name is not a valid identifier or a limit is not valid.
+func QueryCreateProfile(name string, limits map[string]string) string {
+	return fmt.Sprintf(createProfileCmd, MustBeObjectName(name), profileLimits(limits))
+}
+
+// QueryAlterProfile constructs a sql statement for altering the limits of a
+// profile by resource name.
+// It panics if name is not a valid identifier or a limit is not valid.
+func QueryAlterProfile(name string, limits map[string]string) string {
+	return fmt.Sprintf(alterProfileCmd, MustBeObjectName(name), profileLimits(limits))
+}
+
+// QueryAlterUserProfile constructs a sql statement for assigning a profile to
+// a user.
+// It panics if user or profile is not a valid identifier.
+func QueryAlterUserProfile(user, profile string) string {
+	return fmt.Sprintf(alterUserProfCmd, MustBeObjectName(user), MustBeObjectName(profile))
+}
+
+// QueryDropUser constructs a sql statement for dropping a user, including the
+// objects of its schema if cascade is set.
+// It panics if user is not a valid identifier.
+func QueryDropUser(user string, cascade bool) string {
+	cmd := fmt.Sprintf(dropUserCmd, MustBeObjectName(user))
+	if cascade {
+		cmd += " cascade"
+	}
+	return cmd
+}
+
+// QueryAlterUserAccount constructs a sql statement for locking or unlocking
+// the account of a user.
+// It panics if user is not a valid identifier.
+func QueryAlterUserAccount(user string, lock bool) string {
+	status := "unlock"
+	if lock {
+		status = "lock"
+	}
+	return fmt.Sprintf(alterUserAcctCmd, MustBeObjectName(user), status)
+}
@@ -375,3 +375,133 @@ func TestQuerySetSystemParameterNoPanic(t *testing.T) {
 		})
 	}
 }
+
+func TestQueryCreateTablespace(t *testing.T) {
+	tests := []struct {
+		name         string
+		bigfile      bool
+		autoExtend   bool
+		maxSizeBytes int64
+		want         string
+	}{
+		{
+			name: "fixed size",
+			want: `create tablespace "TS1" datafile size 1048576 autoextend off`,
+		},
+		{
+			name:       "unlimited",
+			autoExtend: true,
+			want:       `create tablespace "TS1" datafile size 1048576 autoextend on maxsize unlimited`,
+		},
+		{
+			name:         "bigfile with max size",
+			bigfile:      true,
+			autoExtend:   true,
+			maxSizeBytes: 2097152,
+			want:         `create bigfile tablespace "TS1" datafile size 1048576 autoextend on maxsize 2097152`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := QueryCreateTablespace("ts1", tt.bigfile, 1048576, tt.autoExtend, tt.maxSizeBytes); got != tt.want {
+				t.Errorf("QueryCreateTablespace() got = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestQueryAlterUserQuota(t *testing.T) {
+	if got, want := QueryAlterUserQuota("scott", "users", true, 0), `alter user "SCOTT" quota unlimited on "USERS"`; got != want {
+		t.Errorf("QueryAlterUserQuota() got = %q, want %q", got, want)
+	}
+	if got, want := QueryAlterUserQuota("scott", "users", false, 1024), `alter user "SCOTT" quota 1024 on "USERS"`; got != want {
+		t.Errorf("QueryAlterUserQuota() got = %q, want %q", got, want)
+	}
+}
+
+func TestQueryObjectPrivileges(t *testing.T) {
+	if got, want := QueryGrantObjectPrivilege("SELECT", "scott", "emp", "app"), `grant SELECT on "SCOTT"."EMP" to "APP"`; got != want {
+		t.Errorf("QueryGrantObjectPrivilege() got = %q, want %q", got, want)
+	}
+	if got, want := QueryRevokeObjectPrivilege("SELECT", "scott", "emp", "app"), `revoke SELECT on "SCOTT"."EMP" from "APP"`; got != want {
+		t.Errorf("QueryRevokeObjectPrivilege() got = %q, want %q", got, want)
+	}
+	if got, want := QueryCreateRole("readers"), `create role "READERS"`; got != want {
+		t.Errorf("QueryCreateRole() got = %q, want %q", got, want)
+	}
+}
+
+func TestQueryGrantObjectPrivilegePanics(t *testing.T) {
+	tests := []struct {
+		name      string
+		privilege string
+		schema    string
+		object    string
+	}{
+		{name: "invalid privilege", privilege: "SELECT; drop user scott", schema: "scott", object: "emp"},
+		{name: "invalid schema", privilege: "SELECT", schema: `scott"."x`, object: "emp"},
+		{name: "invalid object", privilege: "SELECT", schema: "scott", object: `emp" to public --`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Errorf("QueryGrantObjectPrivilege(%q, %q, %q) did not panic", tt.privilege, tt.schema, tt.object)
+				}
+			}()
+			QueryGrantObjectPrivilege(tt.privilege, tt.schema, tt.object, "app")
+		})
+	}
+}
+
+func TestQueryProfiles(t *testing.T) {
+	limits := map[string]string{"password_life_time": "90", "FAILED_LOGIN_ATTEMPTS": "5", "PASSWORD_VERIFY_FUNCTION": "ora12c_verify_function"}
+	if got, want := QueryCreateProfile("app", limits), `create profile "APP" limit FAILED_LOGIN_ATTEMPTS 5 PASSWORD_LIFE_TIME 90 PASSWORD_VERIFY_FUNCTION ORA12C_VERIFY_FUNCTION`; got != want {
+		t.Errorf("QueryCreateProfile() got = %q, want %q", got, want)
+	}
+	if got, want := QueryAlterProfile("app", map[string]string{"PASSWORD_LOCK_TIME": "unlimited"}), `alter profile "APP" limit PASSWORD_LOCK_TIME UNLIMITED`; got != want {
+		t.Errorf("QueryAlterProfile() got = %q, want %q", got, want)
+	}
+	if got, want := QueryAlterUserProfile("scott", "app"), `alter user "SCOTT" profile "APP"`; got != want {
+		t.Errorf("QueryAlterUserProfile() got = %q, want %q", got, want)
+	}
+	if got, want := QueryAlterUserAccount("scott", true), `alter user "SCOTT" account lock`; got != want {
+		t.Errorf("QueryAlterUserAccount() got = %q, want %q", got, want)
+	}
+	if got, want := QueryAlterUserAccount("scott", false), `alter user "SCOTT" account unlock`; got != want {
+		t.Errorf("QueryAlterUserAccount() got = %q, want %q", got, want)
+	}
+}
+
+func TestQueryDropUser(t *testing.T) {
+	if got, want := QueryDropUser("scott", false), `drop user "SCOTT"`; got != want {
+		t.Errorf("QueryDropUser() got = %q, want %q", got, want)
+	}
+	if got, want := QueryDropUser("scott", true), `drop user "SCOTT" cascade`; got != want {
+		t.Errorf("QueryDropUser() got = %q, want %q", got, want)
+	}
+}
+
+func TestIsProfileLimit(t *testing.T) {
+	tests := []struct {
+		resource string
+		value    string
+		want     bool
+	}{
+		{resource: "FAILED_LOGIN_ATTEMPTS", value: "10", want: true},
+		{resource: "PASSWORD_LOCK_TIME", value: "0.5", want: true},
+		{resource: "PASSWORD_LIFE_TIME", value: "UNLIMITED", want: true},
+		{resource: "password_reuse_max", value: "default", want: true},
+		{resource: "PASSWORD_VERIFY_FUNCTION", value: "NULL", want: true},
+		{resource: "PASSWORD_VERIFY_FUNCTION", value: "ora12c_verify_function", want: true},
+		{resource: "PASSWORD_VERIFY_FUNCTION", value: "f; drop user scott", want: false},
+		{resource: "FAILED_LOGIN_ATTEMPTS", value: "10 password_life_time 1", want: false},
+		{resource: "FAILED_LOGIN_ATTEMPTS", value: "-1", want: false},
+		{resource: "CPU_PER_CALL", value: "10", want: false},
+	}
+	for _, tt := range tests {
+		if got := IsProfileLimit(tt.resource, tt.value); got != tt.want {
+			t.Errorf("IsProfileLimit(%q, %q) got = %v, want %v", tt.resource, tt.value, got, tt.want)
+		}
+	}
+}
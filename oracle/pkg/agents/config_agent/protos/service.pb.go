@@ -27,6 +27,7 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -38,6 +39,64 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// DeletionPolicy defines what happens to the users of the PDB which are not
+// in user_specs.
+type UsersChangedRequest_DeletionPolicy int32
+
+const (
+	// RETAIN leaves the users as is and reports them as suppressed.
+	UsersChangedRequest_RETAIN UsersChangedRequest_DeletionPolicy = 0
+	// LOCK locks the accounts of the users.
+	UsersChangedRequest_LOCK UsersChangedRequest_DeletionPolicy = 1
+	// DROP drops the users, which fails for users owning objects.
+	UsersChangedRequest_DROP UsersChangedRequest_DeletionPolicy = 2
+	// DROP_CASCADE drops the users including the objects they own.
+	UsersChangedRequest_DROP_CASCADE UsersChangedRequest_DeletionPolicy = 3
+)
+
+// Enum value maps for UsersChangedRequest_DeletionPolicy.
+var (
+	UsersChangedRequest_DeletionPolicy_name = map[int32]string{
+		0: "RETAIN",
+		1: "LOCK",
+		2: "DROP",
+		3: "DROP_CASCADE",
+	}
+	UsersChangedRequest_DeletionPolicy_value = map[string]int32{
+		"RETAIN":       0,
+		"LOCK":         1,
+		"DROP":         2,
+		"DROP_CASCADE": 3,
+	}
+)
+
+func (x UsersChangedRequest_DeletionPolicy) Enum() *UsersChangedRequest_DeletionPolicy {
+	p := new(UsersChangedRequest_DeletionPolicy)
+	*p = x
+	return p
+}
+
+func (x UsersChangedRequest_DeletionPolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (UsersChangedRequest_DeletionPolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_oracle_pkg_agents_config_agent_protos_service_proto_enumTypes[0].Descriptor()
+}
+
+func (UsersChangedRequest_DeletionPolicy) Type() protoreflect.EnumType {
+	return &file_oracle_pkg_agents_config_agent_protos_service_proto_enumTypes[0]
+}
+
+func (x UsersChangedRequest_DeletionPolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use UsersChangedRequest_DeletionPolicy.Descriptor instead.
+func (UsersChangedRequest_DeletionPolicy) EnumDescriptor() ([]byte, []int) {
+	return file_oracle_pkg_agents_config_agent_protos_service_proto_rawDescGZIP(), []int{14, 0}
+}
+
 type UsersChangedResponse_Type int32
 
 const (
@@ -71,11 +130,11 @@ func (x UsersChangedResponse_Type) String() string {
 }
 
 func (UsersChangedResponse_Type) Descriptor() protoreflect.EnumDescriptor {
-	return file_oracle_pkg_agents_config_agent_protos_service_proto_enumTypes[0].Descriptor()
+	return file_oracle_pkg_agents_config_agent_protos_service_proto_enumTypes[1].Descriptor()
 }
 
 func (UsersChangedResponse_Type) Type() protoreflect.EnumType {
-	return &file_oracle_pkg_agents_config_agent_protos_service_proto_enumTypes[0]
+	return &file_oracle_pkg_agents_config_agent_protos_service_proto_enumTypes[1]
 }
 
 func (x UsersChangedResponse_Type) Number() protoreflect.EnumNumber {
@@ -84,7 +143,7 @@ func (x UsersChangedResponse_Type) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use UsersChangedResponse_Type.Descriptor instead.
 func (UsersChangedResponse_Type) EnumDescriptor() ([]byte, []int) {
-	return file_oracle_pkg_agents_config_agent_protos_service_proto_rawDescGZIP(), []int{12, 0}
+	return file_oracle_pkg_agents_config_agent_protos_service_proto_rawDescGZIP(), []int{15, 0}
 }
 
 type PhysicalBackupRequest_Type int32
@@ -126,11 +185,11 @@ func (x PhysicalBackupRequest_Type) String() string {
 }
 
 func (PhysicalBackupRequest_Type) Descriptor() protoreflect.EnumDescriptor {
-	return file_oracle_pkg_agents_config_agent_protos_service_proto_enumTypes[1].Descriptor()
+	return file_oracle_pkg_agents_config_agent_protos_service_proto_enumTypes[2].Descriptor()
 }
 
 func (PhysicalBackupRequest_Type) Type() protoreflect.EnumType {
-	return &file_oracle_pkg_agents_config_agent_protos_service_proto_enumTypes[1]
+	return &file_oracle_pkg_agents_config_agent_protos_service_proto_enumTypes[2]
 }
 
 func (x PhysicalBackupRequest_Type) Number() protoreflect.EnumNumber {
@@ -139,7 +198,7 @@ func (x PhysicalBackupRequest_Type) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use PhysicalBackupRequest_Type.Descriptor instead.
 func (PhysicalBackupRequest_Type) EnumDescriptor() ([]byte, []int) {
-	return file_oracle_pkg_agents_config_agent_protos_service_proto_rawDescGZIP(), []int{15, 0}
+	return file_oracle_pkg_agents_config_agent_protos_service_proto_rawDescGZIP(), []int{18, 0}
 }
 
 type CheckStatusRequest_Type int32
@@ -172,11 +231,11 @@ func (x CheckStatusRequest_Type) String() string {
 }
 
 func (CheckStatusRequest_Type) Descriptor() protoreflect.EnumDescriptor {
-	return file_oracle_pkg_agents_config_agent_protos_service_proto_enumTypes[2].Descriptor()
+	return file_oracle_pkg_agents_config_agent_protos_service_proto_enumTypes[3].Descriptor()
 }
 
 func (CheckStatusRequest_Type) Type() protoreflect.EnumType {
-	return &file_oracle_pkg_agents_config_agent_protos_service_proto_enumTypes[2]
+	return &file_oracle_pkg_agents_config_agent_protos_service_proto_enumTypes[3]
 }
 
 func (x CheckStatusRequest_Type) Number() protoreflect.EnumNumber {
@@ -185,7 +244,7 @@ func (x CheckStatusRequest_Type) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use CheckStatusRequest_Type.Descriptor instead.
 func (CheckStatusRequest_Type) EnumDescriptor() ([]byte, []int) {
-	return file_oracle_pkg_agents_config_agent_protos_service_proto_rawDescGZIP(), []int{17, 0}
+	return file_oracle_pkg_agents_config_agent_protos_service_proto_rawDescGZIP(), []int{20, 0}
 }
 
 type SetParameterRequest_Type int32
@@ -221,11 +280,11 @@ func (x SetParameterRequest_Type) String() string {
 }
 
 func (SetParameterRequest_Type) Descriptor() protoreflect.EnumDescriptor {
-	return file_oracle_pkg_agents_config_agent_protos_service_proto_enumTypes[3].Descriptor()
+	return file_oracle_pkg_agents_config_agent_protos_service_proto_enumTypes[4].Descriptor()
 }
 
 func (SetParameterRequest_Type) Type() protoreflect.EnumType {
-	return &file_oracle_pkg_agents_config_agent_protos_service_proto_enumTypes[3]
+	return &file_oracle_pkg_agents_config_agent_protos_service_proto_enumTypes[4]
 }
 
 func (x SetParameterRequest_Type) Number() protoreflect.EnumNumber {
@@ -234,7 +293,7 @@ func (x SetParameterRequest_Type) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use SetParameterRequest_Type.Descriptor instead.
 func (SetParameterRequest_Type) EnumDescriptor() ([]byte, []int) {
-	return file_oracle_pkg_agents_config_agent_protos_service_proto_rawDescGZIP(), []int{26, 0}
+	return file_oracle_pkg_agents_config_agent_protos_service_proto_rawDescGZIP(), []int{29, 0}
 }
 
 type CreateCDBRequest struct {
@@ -250,6 +309,9 @@ type CreateCDBRequest struct {
 	AdditionalParams []string `protobuf:"bytes,6,rep,name=additional_params,json=additionalParams,proto3" json:"additional_params,omitempty"`
 	Version          string   `protobuf:"bytes,7,opt,name=version,proto3" json:"version,omitempty"`
 	DbDomain         string   `protobuf:"bytes,8,opt,name=db_domain,json=dbDomain,proto3" json:"db_domain,omitempty"`
+	// lro_input identifies the CDB creation operation of the Database Daemon,
+	// its progress is reported by GetOperation.
+	LroInput *LROInput `protobuf:"bytes,9,opt,name=lro_input,json=lroInput,proto3" json:"lro_input,omitempty"`
 }
 
 func (x *CreateCDBRequest) Reset() {
@@ -340,6 +402,13 @@ func (x *CreateCDBRequest) GetDbDomain() string {
 	return ""
 }
 
+func (x *CreateCDBRequest) GetLroInput() *LROInput {
+	if x != nil {
+		return x.LroInput
+	}
+	return nil
+}
+
 type CreateListenerRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -528,6 +597,72 @@ func (x *GsmSecretReference) GetLastVersion() string {
 	return ""
 }
 
+// A password resolved from a k8s secret by the operator. The password is
+// changed if version, the resource version of the secret, differs from the
+// last_version applied.
+type SecretPassword struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Password    string `protobuf:"bytes,1,opt,name=password,proto3" json:"password,omitempty"`
+	Version     string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	LastVersion string `protobuf:"bytes,3,opt,name=last_version,json=lastVersion,proto3" json:"last_version,omitempty"`
+}
+
+func (x *SecretPassword) Reset() {
+	*x = SecretPassword{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SecretPassword) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SecretPassword) ProtoMessage() {}
+
+func (x *SecretPassword) ProtoReflect() protoreflect.Message {
+	mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SecretPassword.ProtoReflect.Descriptor instead.
+func (*SecretPassword) Descriptor() ([]byte, []int) {
+	return file_oracle_pkg_agents_config_agent_protos_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SecretPassword) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *SecretPassword) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *SecretPassword) GetLastVersion() string {
+	if x != nil {
+		return x.LastVersion
+	}
+	return ""
+}
+
 type CreateDatabaseRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -542,13 +677,14 @@ type CreateDatabaseRequest struct {
 	AdminPasswordGsmSecretRef *GsmSecretReference `protobuf:"bytes,5,opt,name=admin_password_gsm_secret_ref,json=adminPasswordGsmSecretRef,proto3" json:"admin_password_gsm_secret_ref,omitempty"`
 	// only being used for plaintext password scenario.
 	// GSM doesn't use this field.
-	LastPassword string `protobuf:"bytes,6,opt,name=last_password,json=lastPassword,proto3" json:"last_password,omitempty"`
+	LastPassword        string          `protobuf:"bytes,6,opt,name=last_password,json=lastPassword,proto3" json:"last_password,omitempty"`
+	AdminPasswordSecret *SecretPassword `protobuf:"bytes,7,opt,name=admin_password_secret,json=adminPasswordSecret,proto3" json:"admin_password_secret,omitempty"`
 }
 
 func (x *CreateDatabaseRequest) Reset() {
 	*x = CreateDatabaseRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[4]
+		mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -561,7 +697,7 @@ func (x *CreateDatabaseRequest) String() string {
 func (*CreateDatabaseRequest) ProtoMessage() {}
 
 func (x *CreateDatabaseRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[4]
+	mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -574,7 +710,7 @@ func (x *CreateDatabaseRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateDatabaseRequest.ProtoReflect.Descriptor instead.
 func (*CreateDatabaseRequest) Descriptor() ([]byte, []int) {
-	return file_oracle_pkg_agents_config_agent_protos_service_proto_rawDescGZIP(), []int{4}
+	return file_oracle_pkg_agents_config_agent_protos_service_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *CreateDatabaseRequest) GetCdbName() string {
@@ -619,6 +755,13 @@ func (x *CreateDatabaseRequest) GetLastPassword() string {
 	return ""
 }
 
+func (x *CreateDatabaseRequest) GetAdminPasswordSecret() *SecretPassword {
+	if x != nil {
+		return x.AdminPasswordSecret
+	}
+	return nil
+}
+
 type CreateDatabaseResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -631,7 +774,7 @@ type CreateDatabaseResponse struct {
 func (x *CreateDatabaseResponse) Reset() {
 	*x = CreateDatabaseResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[5]
+		mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -644,7 +787,7 @@ func (x *CreateDatabaseResponse) String() string {
 func (*CreateDatabaseResponse) ProtoMessage() {}
 
 func (x *CreateDatabaseResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[5]
+	mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -657,7 +800,7 @@ func (x *CreateDatabaseResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateDatabaseResponse.ProtoReflect.Descriptor instead.
 func (*CreateDatabaseResponse) Descriptor() ([]byte, []int) {
-	return file_oracle_pkg_agents_config_agent_protos_service_proto_rawDescGZIP(), []int{5}
+	return file_oracle_pkg_agents_config_agent_protos_service_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *CreateDatabaseResponse) GetStatus() string {
@@ -690,7 +833,7 @@ type CreateUsersRequest struct {
 func (x *CreateUsersRequest) Reset() {
 	*x = CreateUsersRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[6]
+		mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -703,7 +846,7 @@ func (x *CreateUsersRequest) String() string {
 func (*CreateUsersRequest) ProtoMessage() {}
 
 func (x *CreateUsersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[6]
+	mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -716,7 +859,7 @@ func (x *CreateUsersRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateUsersRequest.ProtoReflect.Descriptor instead.
 func (*CreateUsersRequest) Descriptor() ([]byte, []int) {
-	return file_oracle_pkg_agents_config_agent_protos_service_proto_rawDescGZIP(), []int{6}
+	return file_oracle_pkg_agents_config_agent_protos_service_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *CreateUsersRequest) GetCdbName() string {
@@ -772,7 +915,7 @@ type CreateUsersResponse struct {
 func (x *CreateUsersResponse) Reset() {
 	*x = CreateUsersResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[7]
+		mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -785,7 +928,7 @@ func (x *CreateUsersResponse) String() string {
 func (*CreateUsersResponse) ProtoMessage() {}
 
 func (x *CreateUsersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[7]
+	mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -798,7 +941,7 @@ func (x *CreateUsersResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateUsersResponse.ProtoReflect.Descriptor instead.
 func (*CreateUsersResponse) Descriptor() ([]byte, []int) {
-	return file_oracle_pkg_agents_config_agent_protos_service_proto_rawDescGZIP(), []int{7}
+	return file_oracle_pkg_agents_config_agent_protos_service_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *CreateUsersResponse) GetStatus() string {
@@ -821,7 +964,7 @@ type CreateCDBUserRequest struct {
 func (x *CreateCDBUserRequest) Reset() {
 	*x = CreateCDBUserRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[8]
+		mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -834,7 +977,7 @@ func (x *CreateCDBUserRequest) String() string {
 func (*CreateCDBUserRequest) ProtoMessage() {}
 
 func (x *CreateCDBUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[8]
+	mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -847,7 +990,7 @@ func (x *CreateCDBUserRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateCDBUserRequest.ProtoReflect.Descriptor instead.
 func (*CreateCDBUserRequest) Descriptor() ([]byte, []int) {
-	return file_oracle_pkg_agents_config_agent_protos_service_proto_rawDescGZIP(), []int{8}
+	return file_oracle_pkg_agents_config_agent_protos_service_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *CreateCDBUserRequest) GetCdbName() string {
@@ -883,7 +1026,7 @@ type CreateCDBUserResponse struct {
 func (x *CreateCDBUserResponse) Reset() {
 	*x = CreateCDBUserResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[9]
+		mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -896,7 +1039,7 @@ func (x *CreateCDBUserResponse) String() string {
 func (*CreateCDBUserResponse) ProtoMessage() {}
 
 func (x *CreateCDBUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[9]
+	mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -909,7 +1052,7 @@ func (x *CreateCDBUserResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateCDBUserResponse.ProtoReflect.Descriptor instead.
 func (*CreateCDBUserResponse) Descriptor() ([]byte, []int) {
-	return file_oracle_pkg_agents_config_agent_protos_service_proto_rawDescGZIP(), []int{9}
+	return file_oracle_pkg_agents_config_agent_protos_service_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *CreateCDBUserResponse) GetStatus() string {
@@ -940,12 +1083,23 @@ type User struct {
 	// only being used for plaintext password scenario.
 	// GSM doesn't use this field.
 	LastPassword string `protobuf:"bytes,5,opt,name=last_password,json=lastPassword,proto3" json:"last_password,omitempty"`
+	// Quotas of the user on tablespaces. Quotas on other tablespaces are
+	// revoked if any quota is specified.
+	Quotas         []*TablespaceQuota `protobuf:"bytes,6,rep,name=quotas,proto3" json:"quotas,omitempty"`
+	PasswordSecret *SecretPassword    `protobuf:"bytes,7,opt,name=password_secret,json=passwordSecret,proto3" json:"password_secret,omitempty"`
+	// Privileges on objects of other schemas. The other object privileges of
+	// the user are revoked if any object privilege is specified.
+	ObjectPrivileges []*ObjectPrivilege `protobuf:"bytes,8,rep,name=object_privileges,json=objectPrivileges,proto3" json:"object_privileges,omitempty"`
+	// Profile assigned to the user, the profile is left as is if empty.
+	Profile string `protobuf:"bytes,9,opt,name=profile,proto3" json:"profile,omitempty"`
+	// LOCKED or UNLOCKED, the account is left as is if empty.
+	AccountStatus string `protobuf:"bytes,10,opt,name=account_status,json=accountStatus,proto3" json:"account_status,omitempty"`
 }
 
 func (x *User) Reset() {
 	*x = User{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[10]
+		mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -958,7 +1112,7 @@ func (x *User) String() string {
 func (*User) ProtoMessage() {}
 
 func (x *User) ProtoReflect() protoreflect.Message {
-	mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[10]
+	mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -971,7 +1125,7 @@ func (x *User) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use User.ProtoReflect.Descriptor instead.
 func (*User) Descriptor() ([]byte, []int) {
-	return file_oracle_pkg_agents_config_agent_protos_service_proto_rawDescGZIP(), []int{10}
+	return file_oracle_pkg_agents_config_agent_protos_service_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *User) GetName() string {
@@ -1009,72 +1163,55 @@ func (x *User) GetLastPassword() string {
 	return ""
 }
 
-type UsersChangedRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	PdbName   string  `protobuf:"bytes,1,opt,name=pdb_name,json=pdbName,proto3" json:"pdb_name,omitempty"`
-	UserSpecs []*User `protobuf:"bytes,2,rep,name=user_specs,json=userSpecs,proto3" json:"user_specs,omitempty"`
-}
-
-func (x *UsersChangedRequest) Reset() {
-	*x = UsersChangedRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[11]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *User) GetQuotas() []*TablespaceQuota {
+	if x != nil {
+		return x.Quotas
 	}
+	return nil
 }
 
-func (x *UsersChangedRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*UsersChangedRequest) ProtoMessage() {}
-
-func (x *UsersChangedRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[11]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *User) GetPasswordSecret() *SecretPassword {
+	if x != nil {
+		return x.PasswordSecret
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use UsersChangedRequest.ProtoReflect.Descriptor instead.
-func (*UsersChangedRequest) Descriptor() ([]byte, []int) {
-	return file_oracle_pkg_agents_config_agent_protos_service_proto_rawDescGZIP(), []int{11}
+func (x *User) GetObjectPrivileges() []*ObjectPrivilege {
+	if x != nil {
+		return x.ObjectPrivileges
+	}
+	return nil
 }
 
-func (x *UsersChangedRequest) GetPdbName() string {
+func (x *User) GetProfile() string {
 	if x != nil {
-		return x.PdbName
+		return x.Profile
 	}
 	return ""
 }
 
-func (x *UsersChangedRequest) GetUserSpecs() []*User {
+func (x *User) GetAccountStatus() string {
 	if x != nil {
-		return x.UserSpecs
+		return x.AccountStatus
 	}
-	return nil
+	return ""
 }
 
-type UsersChangedResponse struct {
+// ObjectPrivilege is a privilege on a schema object, for example SELECT on
+// SCOTT.EMP.
+type ObjectPrivilege struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Changed    bool                               `protobuf:"varint,1,opt,name=changed,proto3" json:"changed,omitempty"`
-	Suppressed []*UsersChangedResponse_Suppressed `protobuf:"bytes,2,rep,name=suppressed,proto3" json:"suppressed,omitempty"`
+	Privilege string `protobuf:"bytes,1,opt,name=privilege,proto3" json:"privilege,omitempty"`
+	Schema    string `protobuf:"bytes,2,opt,name=schema,proto3" json:"schema,omitempty"`
+	Object    string `protobuf:"bytes,3,opt,name=object,proto3" json:"object,omitempty"`
 }
 
-func (x *UsersChangedResponse) Reset() {
-	*x = UsersChangedResponse{}
+func (x *ObjectPrivilege) Reset() {
+	*x = ObjectPrivilege{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1082,13 +1219,13 @@ func (x *UsersChangedResponse) Reset() {
 	}
 }
 
-func (x *UsersChangedResponse) String() string {
+func (x *ObjectPrivilege) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UsersChangedResponse) ProtoMessage() {}
+func (*ObjectPrivilege) ProtoMessage() {}
 
-func (x *UsersChangedResponse) ProtoReflect() protoreflect.Message {
+func (x *ObjectPrivilege) ProtoReflect() protoreflect.Message {
 	mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1100,36 +1237,45 @@ func (x *UsersChangedResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UsersChangedResponse.ProtoReflect.Descriptor instead.
-func (*UsersChangedResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ObjectPrivilege.ProtoReflect.Descriptor instead.
+func (*ObjectPrivilege) Descriptor() ([]byte, []int) {
 	return file_oracle_pkg_agents_config_agent_protos_service_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *UsersChangedResponse) GetChanged() bool {
+func (x *ObjectPrivilege) GetPrivilege() string {
 	if x != nil {
-		return x.Changed
+		return x.Privilege
 	}
-	return false
+	return ""
 }
 
-func (x *UsersChangedResponse) GetSuppressed() []*UsersChangedResponse_Suppressed {
+func (x *ObjectPrivilege) GetSchema() string {
 	if x != nil {
-		return x.Suppressed
+		return x.Schema
 	}
-	return nil
+	return ""
 }
 
-type UpdateUsersRequest struct {
+func (x *ObjectPrivilege) GetObject() string {
+	if x != nil {
+		return x.Object
+	}
+	return ""
+}
+
+type TablespaceQuota struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PdbName   string  `protobuf:"bytes,1,opt,name=pdb_name,json=pdbName,proto3" json:"pdb_name,omitempty"`
-	UserSpecs []*User `protobuf:"bytes,2,rep,name=user_specs,json=userSpecs,proto3" json:"user_specs,omitempty"`
+	Tablespace string `protobuf:"bytes,1,opt,name=tablespace,proto3" json:"tablespace,omitempty"`
+	// Ignored if unlimited is set.
+	MaxBytes  int64 `protobuf:"varint,2,opt,name=max_bytes,json=maxBytes,proto3" json:"max_bytes,omitempty"`
+	Unlimited bool  `protobuf:"varint,3,opt,name=unlimited,proto3" json:"unlimited,omitempty"`
 }
 
-func (x *UpdateUsersRequest) Reset() {
-	*x = UpdateUsersRequest{}
+func (x *TablespaceQuota) Reset() {
+	*x = TablespaceQuota{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1137,13 +1283,13 @@ func (x *UpdateUsersRequest) Reset() {
 	}
 }
 
-func (x *UpdateUsersRequest) String() string {
+func (x *TablespaceQuota) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateUsersRequest) ProtoMessage() {}
+func (*TablespaceQuota) ProtoMessage() {}
 
-func (x *UpdateUsersRequest) ProtoReflect() protoreflect.Message {
+func (x *TablespaceQuota) ProtoReflect() protoreflect.Message {
 	mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1155,33 +1301,48 @@ func (x *UpdateUsersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateUsersRequest.ProtoReflect.Descriptor instead.
-func (*UpdateUsersRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use TablespaceQuota.ProtoReflect.Descriptor instead.
+func (*TablespaceQuota) Descriptor() ([]byte, []int) {
 	return file_oracle_pkg_agents_config_agent_protos_service_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *UpdateUsersRequest) GetPdbName() string {
+func (x *TablespaceQuota) GetTablespace() string {
 	if x != nil {
-		return x.PdbName
+		return x.Tablespace
 	}
 	return ""
 }
 
-func (x *UpdateUsersRequest) GetUserSpecs() []*User {
+func (x *TablespaceQuota) GetMaxBytes() int64 {
 	if x != nil {
-		return x.UserSpecs
+		return x.MaxBytes
 	}
-	return nil
+	return 0
 }
 
-type UpdateUsersResponse struct {
+func (x *TablespaceQuota) GetUnlimited() bool {
+	if x != nil {
+		return x.Unlimited
+	}
+	return false
+}
+
+type UsersChangedRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	PdbName        string                             `protobuf:"bytes,1,opt,name=pdb_name,json=pdbName,proto3" json:"pdb_name,omitempty"`
+	UserSpecs      []*User                            `protobuf:"bytes,2,rep,name=user_specs,json=userSpecs,proto3" json:"user_specs,omitempty"`
+	DeletionPolicy UsersChangedRequest_DeletionPolicy `protobuf:"varint,3,opt,name=deletion_policy,json=deletionPolicy,proto3,enum=protos.UsersChangedRequest_DeletionPolicy" json:"deletion_policy,omitempty"`
+	// managed_users lists the users created for the database before. Only they
+	// are subject to deletion_policy once they are not in user_specs, other
+	// users are retained.
+	ManagedUsers []string `protobuf:"bytes,4,rep,name=managed_users,json=managedUsers,proto3" json:"managed_users,omitempty"`
 }
 
-func (x *UpdateUsersResponse) Reset() {
-	*x = UpdateUsersResponse{}
+func (x *UsersChangedRequest) Reset() {
+	*x = UsersChangedRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1189,13 +1350,13 @@ func (x *UpdateUsersResponse) Reset() {
 	}
 }
 
-func (x *UpdateUsersResponse) String() string {
+func (x *UsersChangedRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateUsersResponse) ProtoMessage() {}
+func (*UsersChangedRequest) ProtoMessage() {}
 
-func (x *UpdateUsersResponse) ProtoReflect() protoreflect.Message {
+func (x *UsersChangedRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1207,20 +1368,252 @@ func (x *UpdateUsersResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateUsersResponse.ProtoReflect.Descriptor instead.
-func (*UpdateUsersResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use UsersChangedRequest.ProtoReflect.Descriptor instead.
+func (*UsersChangedRequest) Descriptor() ([]byte, []int) {
 	return file_oracle_pkg_agents_config_agent_protos_service_proto_rawDescGZIP(), []int{14}
 }
 
-type PhysicalBackupRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	BackupSubType PhysicalBackupRequest_Type `protobuf:"varint,1,opt,name=backup_sub_type,json=backupSubType,proto3,enum=protos.PhysicalBackupRequest_Type" json:"backup_sub_type,omitempty"`
-	BackupItems   []string                   `protobuf:"bytes,2,rep,name=backup_items,json=backupItems,proto3" json:"backup_items,omitempty"`
-	Backupset     bool                       `protobuf:"varint,3,opt,name=backupset,proto3" json:"backupset,omitempty"`
-	Compressed    bool                       `protobuf:"varint,4,opt,name=compressed,proto3" json:"compressed,omitempty"`
+func (x *UsersChangedRequest) GetPdbName() string {
+	if x != nil {
+		return x.PdbName
+	}
+	return ""
+}
+
+func (x *UsersChangedRequest) GetUserSpecs() []*User {
+	if x != nil {
+		return x.UserSpecs
+	}
+	return nil
+}
+
+func (x *UsersChangedRequest) GetDeletionPolicy() UsersChangedRequest_DeletionPolicy {
+	if x != nil {
+		return x.DeletionPolicy
+	}
+	return UsersChangedRequest_RETAIN
+}
+
+func (x *UsersChangedRequest) GetManagedUsers() []string {
+	if x != nil {
+		return x.ManagedUsers
+	}
+	return nil
+}
+
+type UsersChangedResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Changed    bool                               `protobuf:"varint,1,opt,name=changed,proto3" json:"changed,omitempty"`
+	Suppressed []*UsersChangedResponse_Suppressed `protobuf:"bytes,2,rep,name=suppressed,proto3" json:"suppressed,omitempty"`
+	// account_statuses maps the users to their dba_users.account_status.
+	AccountStatuses map[string]string `protobuf:"bytes,3,rep,name=account_statuses,json=accountStatuses,proto3" json:"account_statuses,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *UsersChangedResponse) Reset() {
+	*x = UsersChangedResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UsersChangedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UsersChangedResponse) ProtoMessage() {}
+
+func (x *UsersChangedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UsersChangedResponse.ProtoReflect.Descriptor instead.
+func (*UsersChangedResponse) Descriptor() ([]byte, []int) {
+	return file_oracle_pkg_agents_config_agent_protos_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *UsersChangedResponse) GetChanged() bool {
+	if x != nil {
+		return x.Changed
+	}
+	return false
+}
+
+func (x *UsersChangedResponse) GetSuppressed() []*UsersChangedResponse_Suppressed {
+	if x != nil {
+		return x.Suppressed
+	}
+	return nil
+}
+
+func (x *UsersChangedResponse) GetAccountStatuses() map[string]string {
+	if x != nil {
+		return x.AccountStatuses
+	}
+	return nil
+}
+
+type UpdateUsersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PdbName        string                             `protobuf:"bytes,1,opt,name=pdb_name,json=pdbName,proto3" json:"pdb_name,omitempty"`
+	UserSpecs      []*User                            `protobuf:"bytes,2,rep,name=user_specs,json=userSpecs,proto3" json:"user_specs,omitempty"`
+	DeletionPolicy UsersChangedRequest_DeletionPolicy `protobuf:"varint,3,opt,name=deletion_policy,json=deletionPolicy,proto3,enum=protos.UsersChangedRequest_DeletionPolicy" json:"deletion_policy,omitempty"`
+	// managed_users lists the users created for the database before, see
+	// UsersChangedRequest.managed_users.
+	ManagedUsers []string `protobuf:"bytes,4,rep,name=managed_users,json=managedUsers,proto3" json:"managed_users,omitempty"`
+}
+
+func (x *UpdateUsersRequest) Reset() {
+	*x = UpdateUsersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateUsersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateUsersRequest) ProtoMessage() {}
+
+func (x *UpdateUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateUsersRequest.ProtoReflect.Descriptor instead.
+func (*UpdateUsersRequest) Descriptor() ([]byte, []int) {
+	return file_oracle_pkg_agents_config_agent_protos_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *UpdateUsersRequest) GetPdbName() string {
+	if x != nil {
+		return x.PdbName
+	}
+	return ""
+}
+
+func (x *UpdateUsersRequest) GetUserSpecs() []*User {
+	if x != nil {
+		return x.UserSpecs
+	}
+	return nil
+}
+
+func (x *UpdateUsersRequest) GetDeletionPolicy() UsersChangedRequest_DeletionPolicy {
+	if x != nil {
+		return x.DeletionPolicy
+	}
+	return UsersChangedRequest_RETAIN
+}
+
+func (x *UpdateUsersRequest) GetManagedUsers() []string {
+	if x != nil {
+		return x.ManagedUsers
+	}
+	return nil
+}
+
+type UpdateUsersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// account_statuses maps the users to their dba_users.account_status after
+	// the update.
+	AccountStatuses map[string]string `protobuf:"bytes,1,rep,name=account_statuses,json=accountStatuses,proto3" json:"account_statuses,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// dropped_users lists the users not in user_specs which were dropped.
+	DroppedUsers []string `protobuf:"bytes,2,rep,name=dropped_users,json=droppedUsers,proto3" json:"dropped_users,omitempty"`
+	// locked_users lists the users not in user_specs which were locked.
+	LockedUsers []string `protobuf:"bytes,3,rep,name=locked_users,json=lockedUsers,proto3" json:"locked_users,omitempty"`
+}
+
+func (x *UpdateUsersResponse) Reset() {
+	*x = UpdateUsersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateUsersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateUsersResponse) ProtoMessage() {}
+
+func (x *UpdateUsersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateUsersResponse.ProtoReflect.Descriptor instead.
+func (*UpdateUsersResponse) Descriptor() ([]byte, []int) {
+	return file_oracle_pkg_agents_config_agent_protos_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *UpdateUsersResponse) GetAccountStatuses() map[string]string {
+	if x != nil {
+		return x.AccountStatuses
+	}
+	return nil
+}
+
+func (x *UpdateUsersResponse) GetDroppedUsers() []string {
+	if x != nil {
+		return x.DroppedUsers
+	}
+	return nil
+}
+
+func (x *UpdateUsersResponse) GetLockedUsers() []string {
+	if x != nil {
+		return x.LockedUsers
+	}
+	return nil
+}
+
+type PhysicalBackupRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BackupSubType PhysicalBackupRequest_Type `protobuf:"varint,1,opt,name=backup_sub_type,json=backupSubType,proto3,enum=protos.PhysicalBackupRequest_Type" json:"backup_sub_type,omitempty"`
+	BackupItems   []string                   `protobuf:"bytes,2,rep,name=backup_items,json=backupItems,proto3" json:"backup_items,omitempty"`
+	Backupset     bool                       `protobuf:"varint,3,opt,name=backupset,proto3" json:"backupset,omitempty"`
+	Compressed    bool                       `protobuf:"varint,4,opt,name=compressed,proto3" json:"compressed,omitempty"`
 	CheckLogical  bool                       `protobuf:"varint,5,opt,name=check_logical,json=checkLogical,proto3" json:"check_logical,omitempty"`
 	// DOP = degree of parallelism for physical backup.
 	Dop         int32     `protobuf:"varint,6,opt,name=dop,proto3" json:"dop,omitempty"`
@@ -1230,12 +1623,22 @@ type PhysicalBackupRequest struct {
 	LocalPath   string    `protobuf:"bytes,10,opt,name=local_path,json=localPath,proto3" json:"local_path,omitempty"`
 	GcsPath     string    `protobuf:"bytes,11,opt,name=gcs_path,json=gcsPath,proto3" json:"gcs_path,omitempty"`
 	LroInput    *LROInput `protobuf:"bytes,12,opt,name=lro_input,json=lroInput,proto3" json:"lro_input,omitempty"`
+	// Credentials to access the storage locations of the request.
+	StorageCredentials map[string]string `protobuf:"bytes,13,rep,name=storage_credentials,json=storageCredentials,proto3" json:"storage_credentials,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// RMAN tag of the backup, used to find its backup pieces on deletion.
+	BackupTag string `protobuf:"bytes,14,opt,name=backup_tag,json=backupTag,proto3" json:"backup_tag,omitempty"`
+	// A level 1 backup contains the changes since the last level 0 backup
+	// instead of the last level 0 or 1 backup.
+	Cumulative bool `protobuf:"varint,15,opt,name=cumulative,proto3" json:"cumulative,omitempty"`
+	// Keep a single set of image copies of the database up to date with a
+	// level 1 backup instead of taking new image copies.
+	IncrementallyUpdated bool `protobuf:"varint,16,opt,name=incrementally_updated,json=incrementallyUpdated,proto3" json:"incrementally_updated,omitempty"`
 }
 
 func (x *PhysicalBackupRequest) Reset() {
 	*x = PhysicalBackupRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[15]
+		mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1248,7 +1651,7 @@ func (x *PhysicalBackupRequest) String() string {
 func (*PhysicalBackupRequest) ProtoMessage() {}
 
 func (x *PhysicalBackupRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[15]
+	mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1261,7 +1664,7 @@ func (x *PhysicalBackupRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PhysicalBackupRequest.ProtoReflect.Descriptor instead.
 func (*PhysicalBackupRequest) Descriptor() ([]byte, []int) {
-	return file_oracle_pkg_agents_config_agent_protos_service_proto_rawDescGZIP(), []int{15}
+	return file_oracle_pkg_agents_config_agent_protos_service_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *PhysicalBackupRequest) GetBackupSubType() PhysicalBackupRequest_Type {
@@ -1348,6 +1751,34 @@ func (x *PhysicalBackupRequest) GetLroInput() *LROInput {
 	return nil
 }
 
+func (x *PhysicalBackupRequest) GetStorageCredentials() map[string]string {
+	if x != nil {
+		return x.StorageCredentials
+	}
+	return nil
+}
+
+func (x *PhysicalBackupRequest) GetBackupTag() string {
+	if x != nil {
+		return x.BackupTag
+	}
+	return ""
+}
+
+func (x *PhysicalBackupRequest) GetCumulative() bool {
+	if x != nil {
+		return x.Cumulative
+	}
+	return false
+}
+
+func (x *PhysicalBackupRequest) GetIncrementallyUpdated() bool {
+	if x != nil {
+		return x.IncrementallyUpdated
+	}
+	return false
+}
+
 type PhysicalRestoreRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1360,12 +1791,28 @@ type PhysicalRestoreRequest struct {
 	LocalPath string    `protobuf:"bytes,4,opt,name=local_path,json=localPath,proto3" json:"local_path,omitempty"`
 	GcsPath   string    `protobuf:"bytes,5,opt,name=gcs_path,json=gcsPath,proto3" json:"gcs_path,omitempty"`
 	LroInput  *LROInput `protobuf:"bytes,6,opt,name=lro_input,json=lroInput,proto3" json:"lro_input,omitempty"`
+	// Optional point-in-time recovery target, at most one can be set.
+	UntilTime         *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=until_time,json=untilTime,proto3" json:"until_time,omitempty"`
+	UntilScn          int64                  `protobuf:"varint,8,opt,name=until_scn,json=untilScn,proto3" json:"until_scn,omitempty"`
+	UntilRestorePoint string                 `protobuf:"bytes,9,opt,name=until_restore_point,json=untilRestorePoint,proto3" json:"until_restore_point,omitempty"`
+	// Credentials to access the storage locations of the request.
+	StorageCredentials map[string]string `protobuf:"bytes,10,rep,name=storage_credentials,json=storageCredentials,proto3" json:"storage_credentials,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Optional name of the CDB the backup was taken from, if it differs from
+	// cdb_name. The database keeps that name after the restore and is renamed
+	// to cdb_name by ChangeDatabaseIdentity.
+	SourceCdbName string `protobuf:"bytes,11,opt,name=source_cdb_name,json=sourceCdbName,proto3" json:"source_cdb_name,omitempty"`
+	// Optional name of a PDB to restore and recover alone, while the CDB and
+	// the other PDBs stay open.
+	PdbName string `protobuf:"bytes,12,opt,name=pdb_name,json=pdbName,proto3" json:"pdb_name,omitempty"`
+	// Switch the database to the image copies of an incrementally updated
+	// backup instead of restoring the datafiles.
+	SwitchToCopy bool `protobuf:"varint,13,opt,name=switch_to_copy,json=switchToCopy,proto3" json:"switch_to_copy,omitempty"`
 }
 
 func (x *PhysicalRestoreRequest) Reset() {
 	*x = PhysicalRestoreRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[16]
+		mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1378,7 +1825,7 @@ func (x *PhysicalRestoreRequest) String() string {
 func (*PhysicalRestoreRequest) ProtoMessage() {}
 
 func (x *PhysicalRestoreRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[16]
+	mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1391,7 +1838,7 @@ func (x *PhysicalRestoreRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PhysicalRestoreRequest.ProtoReflect.Descriptor instead.
 func (*PhysicalRestoreRequest) Descriptor() ([]byte, []int) {
-	return file_oracle_pkg_agents_config_agent_protos_service_proto_rawDescGZIP(), []int{16}
+	return file_oracle_pkg_agents_config_agent_protos_service_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *PhysicalRestoreRequest) GetInstanceName() string {
@@ -1436,21 +1883,70 @@ func (x *PhysicalRestoreRequest) GetLroInput() *LROInput {
 	return nil
 }
 
-type CheckStatusRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *PhysicalRestoreRequest) GetUntilTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UntilTime
+	}
+	return nil
+}
 
-	Name            string                  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	CdbName         string                  `protobuf:"bytes,2,opt,name=cdb_name,json=cdbName,proto3" json:"cdb_name,omitempty"`
-	CheckStatusType CheckStatusRequest_Type `protobuf:"varint,3,opt,name=check_status_type,json=checkStatusType,proto3,enum=protos.CheckStatusRequest_Type" json:"check_status_type,omitempty"`
-	DbDomain        string                  `protobuf:"bytes,4,opt,name=db_domain,json=dbDomain,proto3" json:"db_domain,omitempty"`
+func (x *PhysicalRestoreRequest) GetUntilScn() int64 {
+	if x != nil {
+		return x.UntilScn
+	}
+	return 0
 }
 
-func (x *CheckStatusRequest) Reset() {
+func (x *PhysicalRestoreRequest) GetUntilRestorePoint() string {
+	if x != nil {
+		return x.UntilRestorePoint
+	}
+	return ""
+}
+
+func (x *PhysicalRestoreRequest) GetStorageCredentials() map[string]string {
+	if x != nil {
+		return x.StorageCredentials
+	}
+	return nil
+}
+
+func (x *PhysicalRestoreRequest) GetSourceCdbName() string {
+	if x != nil {
+		return x.SourceCdbName
+	}
+	return ""
+}
+
+func (x *PhysicalRestoreRequest) GetPdbName() string {
+	if x != nil {
+		return x.PdbName
+	}
+	return ""
+}
+
+func (x *PhysicalRestoreRequest) GetSwitchToCopy() bool {
+	if x != nil {
+		return x.SwitchToCopy
+	}
+	return false
+}
+
+type CheckStatusRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name            string                  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	CdbName         string                  `protobuf:"bytes,2,opt,name=cdb_name,json=cdbName,proto3" json:"cdb_name,omitempty"`
+	CheckStatusType CheckStatusRequest_Type `protobuf:"varint,3,opt,name=check_status_type,json=checkStatusType,proto3,enum=protos.CheckStatusRequest_Type" json:"check_status_type,omitempty"`
+	DbDomain        string                  `protobuf:"bytes,4,opt,name=db_domain,json=dbDomain,proto3" json:"db_domain,omitempty"`
+}
+
+func (x *CheckStatusRequest) Reset() {
 	*x = CheckStatusRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[17]
+		mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1463,7 +1959,7 @@ func (x *CheckStatusRequest) String() string {
 func (*CheckStatusRequest) ProtoMessage() {}
 
 func (x *CheckStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[17]
+	mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1476,7 +1972,7 @@ func (x *CheckStatusRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CheckStatusRequest.ProtoReflect.Descriptor instead.
 func (*CheckStatusRequest) Descriptor() ([]byte, []int) {
-	return file_oracle_pkg_agents_config_agent_protos_service_proto_rawDescGZIP(), []int{17}
+	return file_oracle_pkg_agents_config_agent_protos_service_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *CheckStatusRequest) GetName() string {
@@ -1519,7 +2015,7 @@ type CheckStatusResponse struct {
 func (x *CheckStatusResponse) Reset() {
 	*x = CheckStatusResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[18]
+		mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1532,7 +2028,7 @@ func (x *CheckStatusResponse) String() string {
 func (*CheckStatusResponse) ProtoMessage() {}
 
 func (x *CheckStatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[18]
+	mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1545,7 +2041,7 @@ func (x *CheckStatusResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CheckStatusResponse.ProtoReflect.Descriptor instead.
 func (*CheckStatusResponse) Descriptor() ([]byte, []int) {
-	return file_oracle_pkg_agents_config_agent_protos_service_proto_rawDescGZIP(), []int{18}
+	return file_oracle_pkg_agents_config_agent_protos_service_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *CheckStatusResponse) GetStatus() string {
@@ -1574,12 +2070,14 @@ type DataPumpImportRequest struct {
 	// GCS path to output log file
 	GcsLogPath string    `protobuf:"bytes,4,opt,name=gcs_log_path,json=gcsLogPath,proto3" json:"gcs_log_path,omitempty"`
 	LroInput   *LROInput `protobuf:"bytes,5,opt,name=lro_input,json=lroInput,proto3" json:"lro_input,omitempty"`
+	// Credentials to access the storage locations of the request.
+	StorageCredentials map[string]string `protobuf:"bytes,6,rep,name=storage_credentials,json=storageCredentials,proto3" json:"storage_credentials,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
 func (x *DataPumpImportRequest) Reset() {
 	*x = DataPumpImportRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[19]
+		mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1592,7 +2090,7 @@ func (x *DataPumpImportRequest) String() string {
 func (*DataPumpImportRequest) ProtoMessage() {}
 
 func (x *DataPumpImportRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[19]
+	mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1605,7 +2103,7 @@ func (x *DataPumpImportRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DataPumpImportRequest.ProtoReflect.Descriptor instead.
 func (*DataPumpImportRequest) Descriptor() ([]byte, []int) {
-	return file_oracle_pkg_agents_config_agent_protos_service_proto_rawDescGZIP(), []int{19}
+	return file_oracle_pkg_agents_config_agent_protos_service_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *DataPumpImportRequest) GetPdbName() string {
@@ -1643,6 +2141,13 @@ func (x *DataPumpImportRequest) GetLroInput() *LROInput {
 	return nil
 }
 
+func (x *DataPumpImportRequest) GetStorageCredentials() map[string]string {
+	if x != nil {
+		return x.StorageCredentials
+	}
+	return nil
+}
+
 type DataPumpExportRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1656,12 +2161,14 @@ type DataPumpExportRequest struct {
 	GcsLogPath    string    `protobuf:"bytes,6,opt,name=gcs_log_path,json=gcsLogPath,proto3" json:"gcs_log_path,omitempty"`
 	LroInput      *LROInput `protobuf:"bytes,7,opt,name=lro_input,json=lroInput,proto3" json:"lro_input,omitempty"`
 	FlashbackTime string    `protobuf:"bytes,8,opt,name=flashback_time,json=flashbackTime,proto3" json:"flashback_time,omitempty"`
+	// Credentials to access the storage locations of the request.
+	StorageCredentials map[string]string `protobuf:"bytes,9,rep,name=storage_credentials,json=storageCredentials,proto3" json:"storage_credentials,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
 func (x *DataPumpExportRequest) Reset() {
 	*x = DataPumpExportRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[20]
+		mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1674,7 +2181,7 @@ func (x *DataPumpExportRequest) String() string {
 func (*DataPumpExportRequest) ProtoMessage() {}
 
 func (x *DataPumpExportRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[20]
+	mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1687,7 +2194,7 @@ func (x *DataPumpExportRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DataPumpExportRequest.ProtoReflect.Descriptor instead.
 func (*DataPumpExportRequest) Descriptor() ([]byte, []int) {
-	return file_oracle_pkg_agents_config_agent_protos_service_proto_rawDescGZIP(), []int{20}
+	return file_oracle_pkg_agents_config_agent_protos_service_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *DataPumpExportRequest) GetPdbName() string {
@@ -1746,6 +2253,13 @@ func (x *DataPumpExportRequest) GetFlashbackTime() string {
 	return ""
 }
 
+func (x *DataPumpExportRequest) GetStorageCredentials() map[string]string {
+	if x != nil {
+		return x.StorageCredentials
+	}
+	return nil
+}
+
 // LROInput is a common part of input requests for all Async operations.
 type LROInput struct {
 	state         protoimpl.MessageState
@@ -1758,7 +2272,7 @@ type LROInput struct {
 func (x *LROInput) Reset() {
 	*x = LROInput{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[21]
+		mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1771,7 +2285,7 @@ func (x *LROInput) String() string {
 func (*LROInput) ProtoMessage() {}
 
 func (x *LROInput) ProtoReflect() protoreflect.Message {
-	mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[21]
+	mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1784,7 +2298,7 @@ func (x *LROInput) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LROInput.ProtoReflect.Descriptor instead.
 func (*LROInput) Descriptor() ([]byte, []int) {
-	return file_oracle_pkg_agents_config_agent_protos_service_proto_rawDescGZIP(), []int{21}
+	return file_oracle_pkg_agents_config_agent_protos_service_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *LROInput) GetOperationId() string {
@@ -1809,7 +2323,7 @@ type BootstrapDatabaseRequest struct {
 func (x *BootstrapDatabaseRequest) Reset() {
 	*x = BootstrapDatabaseRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[22]
+		mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1822,7 +2336,7 @@ func (x *BootstrapDatabaseRequest) String() string {
 func (*BootstrapDatabaseRequest) ProtoMessage() {}
 
 func (x *BootstrapDatabaseRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[22]
+	mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1835,7 +2349,7 @@ func (x *BootstrapDatabaseRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BootstrapDatabaseRequest.ProtoReflect.Descriptor instead.
 func (*BootstrapDatabaseRequest) Descriptor() ([]byte, []int) {
-	return file_oracle_pkg_agents_config_agent_protos_service_proto_rawDescGZIP(), []int{22}
+	return file_oracle_pkg_agents_config_agent_protos_service_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *BootstrapDatabaseRequest) GetCdbName() string {
@@ -1882,7 +2396,7 @@ type BootstrapDatabaseResponse struct {
 func (x *BootstrapDatabaseResponse) Reset() {
 	*x = BootstrapDatabaseResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[23]
+		mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1895,7 +2409,7 @@ func (x *BootstrapDatabaseResponse) String() string {
 func (*BootstrapDatabaseResponse) ProtoMessage() {}
 
 func (x *BootstrapDatabaseResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[23]
+	mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1908,7 +2422,7 @@ func (x *BootstrapDatabaseResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BootstrapDatabaseResponse.ProtoReflect.Descriptor instead.
 func (*BootstrapDatabaseResponse) Descriptor() ([]byte, []int) {
-	return file_oracle_pkg_agents_config_agent_protos_service_proto_rawDescGZIP(), []int{23}
+	return file_oracle_pkg_agents_config_agent_protos_service_proto_rawDescGZIP(), []int{26}
 }
 
 type BootstrapStandbyRequest struct {
@@ -1924,7 +2438,7 @@ type BootstrapStandbyRequest struct {
 func (x *BootstrapStandbyRequest) Reset() {
 	*x = BootstrapStandbyRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[24]
+		mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1937,7 +2451,7 @@ func (x *BootstrapStandbyRequest) String() string {
 func (*BootstrapStandbyRequest) ProtoMessage() {}
 
 func (x *BootstrapStandbyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[24]
+	mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1950,7 +2464,7 @@ func (x *BootstrapStandbyRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BootstrapStandbyRequest.ProtoReflect.Descriptor instead.
 func (*BootstrapStandbyRequest) Descriptor() ([]byte, []int) {
-	return file_oracle_pkg_agents_config_agent_protos_service_proto_rawDescGZIP(), []int{24}
+	return file_oracle_pkg_agents_config_agent_protos_service_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *BootstrapStandbyRequest) GetCdbName() string {
@@ -1985,7 +2499,7 @@ type BootstrapStandbyResponse struct {
 func (x *BootstrapStandbyResponse) Reset() {
 	*x = BootstrapStandbyResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[25]
+		mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1998,7 +2512,7 @@ func (x *BootstrapStandbyResponse) String() string {
 func (*BootstrapStandbyResponse) ProtoMessage() {}
 
 func (x *BootstrapStandbyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[25]
+	mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2011,7 +2525,7 @@ func (x *BootstrapStandbyResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BootstrapStandbyResponse.ProtoReflect.Descriptor instead.
 func (*BootstrapStandbyResponse) Descriptor() ([]byte, []int) {
-	return file_oracle_pkg_agents_config_agent_protos_service_proto_rawDescGZIP(), []int{25}
+	return file_oracle_pkg_agents_config_agent_protos_service_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *BootstrapStandbyResponse) GetPdbs() []*BootstrapStandbyResponse_PDB {
@@ -2034,7 +2548,7 @@ type SetParameterRequest struct {
 func (x *SetParameterRequest) Reset() {
 	*x = SetParameterRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[26]
+		mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2047,7 +2561,7 @@ func (x *SetParameterRequest) String() string {
 func (*SetParameterRequest) ProtoMessage() {}
 
 func (x *SetParameterRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[26]
+	mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2060,7 +2574,7 @@ func (x *SetParameterRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetParameterRequest.ProtoReflect.Descriptor instead.
 func (*SetParameterRequest) Descriptor() ([]byte, []int) {
-	return file_oracle_pkg_agents_config_agent_protos_service_proto_rawDescGZIP(), []int{26}
+	return file_oracle_pkg_agents_config_agent_protos_service_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *SetParameterRequest) GetKey() string {
@@ -2095,7 +2609,7 @@ type SetParameterResponse struct {
 func (x *SetParameterResponse) Reset() {
 	*x = SetParameterResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[27]
+		mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2108,7 +2622,7 @@ func (x *SetParameterResponse) String() string {
 func (*SetParameterResponse) ProtoMessage() {}
 
 func (x *SetParameterResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[27]
+	mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2121,7 +2635,7 @@ func (x *SetParameterResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetParameterResponse.ProtoReflect.Descriptor instead.
 func (*SetParameterResponse) Descriptor() ([]byte, []int) {
-	return file_oracle_pkg_agents_config_agent_protos_service_proto_rawDescGZIP(), []int{27}
+	return file_oracle_pkg_agents_config_agent_protos_service_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *SetParameterResponse) GetStatic() bool {
@@ -2142,7 +2656,7 @@ type GetParameterTypeValueRequest struct {
 func (x *GetParameterTypeValueRequest) Reset() {
 	*x = GetParameterTypeValueRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[28]
+		mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2155,7 +2669,7 @@ func (x *GetParameterTypeValueRequest) String() string {
 func (*GetParameterTypeValueRequest) ProtoMessage() {}
 
 func (x *GetParameterTypeValueRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[28]
+	mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2168,7 +2682,7 @@ func (x *GetParameterTypeValueRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetParameterTypeValueRequest.ProtoReflect.Descriptor instead.
 func (*GetParameterTypeValueRequest) Descriptor() ([]byte, []int) {
-	return file_oracle_pkg_agents_config_agent_protos_service_proto_rawDescGZIP(), []int{28}
+	return file_oracle_pkg_agents_config_agent_protos_service_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *GetParameterTypeValueRequest) GetKeys() []string {
@@ -2190,7 +2704,7 @@ type GetParameterTypeValueResponse struct {
 func (x *GetParameterTypeValueResponse) Reset() {
 	*x = GetParameterTypeValueResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[29]
+		mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2203,7 +2717,7 @@ func (x *GetParameterTypeValueResponse) String() string {
 func (*GetParameterTypeValueResponse) ProtoMessage() {}
 
 func (x *GetParameterTypeValueResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[29]
+	mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2216,7 +2730,7 @@ func (x *GetParameterTypeValueResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetParameterTypeValueResponse.ProtoReflect.Descriptor instead.
 func (*GetParameterTypeValueResponse) Descriptor() ([]byte, []int) {
-	return file_oracle_pkg_agents_config_agent_protos_service_proto_rawDescGZIP(), []int{29}
+	return file_oracle_pkg_agents_config_agent_protos_service_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *GetParameterTypeValueResponse) GetTypes() []string {
@@ -2247,7 +2761,7 @@ type BounceDatabaseRequest struct {
 func (x *BounceDatabaseRequest) Reset() {
 	*x = BounceDatabaseRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[30]
+		mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2260,7 +2774,7 @@ func (x *BounceDatabaseRequest) String() string {
 func (*BounceDatabaseRequest) ProtoMessage() {}
 
 func (x *BounceDatabaseRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[30]
+	mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2273,7 +2787,7 @@ func (x *BounceDatabaseRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BounceDatabaseRequest.ProtoReflect.Descriptor instead.
 func (*BounceDatabaseRequest) Descriptor() ([]byte, []int) {
-	return file_oracle_pkg_agents_config_agent_protos_service_proto_rawDescGZIP(), []int{30}
+	return file_oracle_pkg_agents_config_agent_protos_service_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *BounceDatabaseRequest) GetSid() string {
@@ -2299,7 +2813,7 @@ type BounceDatabaseResponse struct {
 func (x *BounceDatabaseResponse) Reset() {
 	*x = BounceDatabaseResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[31]
+		mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2312,7 +2826,7 @@ func (x *BounceDatabaseResponse) String() string {
 func (*BounceDatabaseResponse) ProtoMessage() {}
 
 func (x *BounceDatabaseResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[31]
+	mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2325,7 +2839,7 @@ func (x *BounceDatabaseResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BounceDatabaseResponse.ProtoReflect.Descriptor instead.
 func (*BounceDatabaseResponse) Descriptor() ([]byte, []int) {
-	return file_oracle_pkg_agents_config_agent_protos_service_proto_rawDescGZIP(), []int{31}
+	return file_oracle_pkg_agents_config_agent_protos_service_proto_rawDescGZIP(), []int{34}
 }
 
 type RecoverConfigFileRequest struct {
@@ -2339,7 +2853,7 @@ type RecoverConfigFileRequest struct {
 func (x *RecoverConfigFileRequest) Reset() {
 	*x = RecoverConfigFileRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[32]
+		mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2352,7 +2866,7 @@ func (x *RecoverConfigFileRequest) String() string {
 func (*RecoverConfigFileRequest) ProtoMessage() {}
 
 func (x *RecoverConfigFileRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[32]
+	mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2365,7 +2879,7 @@ func (x *RecoverConfigFileRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RecoverConfigFileRequest.ProtoReflect.Descriptor instead.
 func (*RecoverConfigFileRequest) Descriptor() ([]byte, []int) {
-	return file_oracle_pkg_agents_config_agent_protos_service_proto_rawDescGZIP(), []int{32}
+	return file_oracle_pkg_agents_config_agent_protos_service_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *RecoverConfigFileRequest) GetCdbName() string {
@@ -2384,7 +2898,7 @@ type RecoverConfigFileResponse struct {
 func (x *RecoverConfigFileResponse) Reset() {
 	*x = RecoverConfigFileResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[33]
+		mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2397,7 +2911,7 @@ func (x *RecoverConfigFileResponse) String() string {
 func (*RecoverConfigFileResponse) ProtoMessage() {}
 
 func (x *RecoverConfigFileResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[33]
+	mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2410,7 +2924,7 @@ func (x *RecoverConfigFileResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RecoverConfigFileResponse.ProtoReflect.Descriptor instead.
 func (*RecoverConfigFileResponse) Descriptor() ([]byte, []int) {
-	return file_oracle_pkg_agents_config_agent_protos_service_proto_rawDescGZIP(), []int{33}
+	return file_oracle_pkg_agents_config_agent_protos_service_proto_rawDescGZIP(), []int{36}
 }
 
 type FetchServiceImageMetaDataRequest struct {
@@ -2422,7 +2936,7 @@ type FetchServiceImageMetaDataRequest struct {
 func (x *FetchServiceImageMetaDataRequest) Reset() {
 	*x = FetchServiceImageMetaDataRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[34]
+		mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2435,7 +2949,7 @@ func (x *FetchServiceImageMetaDataRequest) String() string {
 func (*FetchServiceImageMetaDataRequest) ProtoMessage() {}
 
 func (x *FetchServiceImageMetaDataRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[34]
+	mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2448,7 +2962,7 @@ func (x *FetchServiceImageMetaDataRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FetchServiceImageMetaDataRequest.ProtoReflect.Descriptor instead.
 func (*FetchServiceImageMetaDataRequest) Descriptor() ([]byte, []int) {
-	return file_oracle_pkg_agents_config_agent_protos_service_proto_rawDescGZIP(), []int{34}
+	return file_oracle_pkg_agents_config_agent_protos_service_proto_rawDescGZIP(), []int{37}
 }
 
 type FetchServiceImageMetaDataResponse struct {
@@ -2464,7 +2978,7 @@ type FetchServiceImageMetaDataResponse struct {
 func (x *FetchServiceImageMetaDataResponse) Reset() {
 	*x = FetchServiceImageMetaDataResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[35]
+		mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2477,7 +2991,7 @@ func (x *FetchServiceImageMetaDataResponse) String() string {
 func (*FetchServiceImageMetaDataResponse) ProtoMessage() {}
 
 func (x *FetchServiceImageMetaDataResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[35]
+	mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2490,7 +3004,7 @@ func (x *FetchServiceImageMetaDataResponse) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use FetchServiceImageMetaDataResponse.ProtoReflect.Descriptor instead.
 func (*FetchServiceImageMetaDataResponse) Descriptor() ([]byte, []int) {
-	return file_oracle_pkg_agents_config_agent_protos_service_proto_rawDescGZIP(), []int{35}
+	return file_oracle_pkg_agents_config_agent_protos_service_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *FetchServiceImageMetaDataResponse) GetVersion() string {
@@ -2514,37 +3028,36 @@ func (x *FetchServiceImageMetaDataResponse) GetOracleHome() string {
 	return ""
 }
 
-// Suppressed describes user creates/updates which will be suppressed in the
-// current release.
-type UsersChangedResponse_Suppressed struct {
+type ShipArchivedLogsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SuppressType UsersChangedResponse_Type `protobuf:"varint,1,opt,name=suppress_type,json=suppressType,proto3,enum=protos.UsersChangedResponse_Type" json:"suppress_type,omitempty"`
-	UserName     string                    `protobuf:"bytes,2,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
-	// sql is the suppressed cmd which can update the user to the spec defined
-	// state
-	Sql string `protobuf:"bytes,3,opt,name=sql,proto3" json:"sql,omitempty"`
+	GcsPath       string    `protobuf:"bytes,1,opt,name=gcs_path,json=gcsPath,proto3" json:"gcs_path,omitempty"`
+	Incarnation   int64     `protobuf:"varint,2,opt,name=incarnation,proto3" json:"incarnation,omitempty"`
+	AfterSequence int64     `protobuf:"varint,3,opt,name=after_sequence,json=afterSequence,proto3" json:"after_sequence,omitempty"`
+	LroInput      *LROInput `protobuf:"bytes,4,opt,name=lro_input,json=lroInput,proto3" json:"lro_input,omitempty"`
+	// Credentials to access the storage location of the request.
+	StorageCredentials map[string]string `protobuf:"bytes,5,rep,name=storage_credentials,json=storageCredentials,proto3" json:"storage_credentials,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
-func (x *UsersChangedResponse_Suppressed) Reset() {
-	*x = UsersChangedResponse_Suppressed{}
+func (x *ShipArchivedLogsRequest) Reset() {
+	*x = ShipArchivedLogsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[36]
+		mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UsersChangedResponse_Suppressed) String() string {
+func (x *ShipArchivedLogsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UsersChangedResponse_Suppressed) ProtoMessage() {}
+func (*ShipArchivedLogsRequest) ProtoMessage() {}
 
-func (x *UsersChangedResponse_Suppressed) ProtoReflect() protoreflect.Message {
-	mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[36]
+func (x *ShipArchivedLogsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2555,58 +3068,75 @@ func (x *UsersChangedResponse_Suppressed) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UsersChangedResponse_Suppressed.ProtoReflect.Descriptor instead.
-func (*UsersChangedResponse_Suppressed) Descriptor() ([]byte, []int) {
-	return file_oracle_pkg_agents_config_agent_protos_service_proto_rawDescGZIP(), []int{12, 0}
+// Deprecated: Use ShipArchivedLogsRequest.ProtoReflect.Descriptor instead.
+func (*ShipArchivedLogsRequest) Descriptor() ([]byte, []int) {
+	return file_oracle_pkg_agents_config_agent_protos_service_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *UsersChangedResponse_Suppressed) GetSuppressType() UsersChangedResponse_Type {
+func (x *ShipArchivedLogsRequest) GetGcsPath() string {
 	if x != nil {
-		return x.SuppressType
+		return x.GcsPath
 	}
-	return UsersChangedResponse_UNKNOWN_TYPE
+	return ""
 }
 
-func (x *UsersChangedResponse_Suppressed) GetUserName() string {
+func (x *ShipArchivedLogsRequest) GetIncarnation() int64 {
 	if x != nil {
-		return x.UserName
+		return x.Incarnation
 	}
-	return ""
+	return 0
 }
 
-func (x *UsersChangedResponse_Suppressed) GetSql() string {
+func (x *ShipArchivedLogsRequest) GetAfterSequence() int64 {
 	if x != nil {
-		return x.Sql
+		return x.AfterSequence
 	}
-	return ""
+	return 0
 }
 
-type BootstrapStandbyResponse_User struct {
+func (x *ShipArchivedLogsRequest) GetLroInput() *LROInput {
+	if x != nil {
+		return x.LroInput
+	}
+	return nil
+}
+
+func (x *ShipArchivedLogsRequest) GetStorageCredentials() map[string]string {
+	if x != nil {
+		return x.StorageCredentials
+	}
+	return nil
+}
+
+type DeleteDatabaseRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserName string   `protobuf:"bytes,1,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
-	Privs    []string `protobuf:"bytes,2,rep,name=privs,proto3" json:"privs,omitempty"`
+	Name    string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	CdbName string `protobuf:"bytes,2,opt,name=cdb_name,json=cdbName,proto3" json:"cdb_name,omitempty"`
+	// Unplug the PDB into an XML manifest and keep its datafiles instead of
+	// dropping them, so that it can be plugged in again.
+	Unplug bool `protobuf:"varint,3,opt,name=unplug,proto3" json:"unplug,omitempty"`
 }
 
-func (x *BootstrapStandbyResponse_User) Reset() {
-	*x = BootstrapStandbyResponse_User{}
+func (x *DeleteDatabaseRequest) Reset() {
+	*x = DeleteDatabaseRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[37]
+		mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *BootstrapStandbyResponse_User) String() string {
+func (x *DeleteDatabaseRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BootstrapStandbyResponse_User) ProtoMessage() {}
+func (*DeleteDatabaseRequest) ProtoMessage() {}
 
-func (x *BootstrapStandbyResponse_User) ProtoReflect() protoreflect.Message {
-	mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[37]
+func (x *DeleteDatabaseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2617,672 +3147,3732 @@ func (x *BootstrapStandbyResponse_User) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BootstrapStandbyResponse_User.ProtoReflect.Descriptor instead.
-func (*BootstrapStandbyResponse_User) Descriptor() ([]byte, []int) {
-	return file_oracle_pkg_agents_config_agent_protos_service_proto_rawDescGZIP(), []int{25, 0}
+// Deprecated: Use DeleteDatabaseRequest.ProtoReflect.Descriptor instead.
+func (*DeleteDatabaseRequest) Descriptor() ([]byte, []int) {
+	return file_oracle_pkg_agents_config_agent_protos_service_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *BootstrapStandbyResponse_User) GetUserName() string {
+func (x *DeleteDatabaseRequest) GetName() string {
 	if x != nil {
-		return x.UserName
+		return x.Name
 	}
 	return ""
 }
 
-func (x *BootstrapStandbyResponse_User) GetPrivs() []string {
+func (x *DeleteDatabaseRequest) GetCdbName() string {
 	if x != nil {
-		return x.Privs
+		return x.CdbName
 	}
-	return nil
+	return ""
 }
 
-type BootstrapStandbyResponse_PDB struct {
+func (x *DeleteDatabaseRequest) GetUnplug() bool {
+	if x != nil {
+		return x.Unplug
+	}
+	return false
+}
+
+type DeleteDatabaseResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PdbName string                           `protobuf:"bytes,1,opt,name=pdb_name,json=pdbName,proto3" json:"pdb_name,omitempty"`
-	Users   []*BootstrapStandbyResponse_User `protobuf:"bytes,2,rep,name=users,proto3" json:"users,omitempty"`
+	// Location of the XML manifest of an unplugged PDB.
+	ManifestPath string `protobuf:"bytes,1,opt,name=manifest_path,json=manifestPath,proto3" json:"manifest_path,omitempty"`
 }
 
-func (x *BootstrapStandbyResponse_PDB) Reset() {
-	*x = BootstrapStandbyResponse_PDB{}
+func (x *DeleteDatabaseResponse) Reset() {
+	*x = DeleteDatabaseResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[38]
+		mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *BootstrapStandbyResponse_PDB) String() string {
+func (x *DeleteDatabaseResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BootstrapStandbyResponse_PDB) ProtoMessage() {}
+func (*DeleteDatabaseResponse) ProtoMessage() {}
 
-func (x *BootstrapStandbyResponse_PDB) ProtoReflect() protoreflect.Message {
-	mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[38]
+func (x *DeleteDatabaseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use BootstrapStandbyResponse_PDB.ProtoReflect.Descriptor instead.
-func (*BootstrapStandbyResponse_PDB) Descriptor() ([]byte, []int) {
-	return file_oracle_pkg_agents_config_agent_protos_service_proto_rawDescGZIP(), []int{25, 1}
-}
-
-func (x *BootstrapStandbyResponse_PDB) GetPdbName() string {
-	if x != nil {
-		return x.PdbName
-	}
-	return ""
-}
-
-func (x *BootstrapStandbyResponse_PDB) GetUsers() []*BootstrapStandbyResponse_User {
-	if x != nil {
-		return x.Users
-	}
-	return nil
-}
-
-var File_oracle_pkg_agents_config_agent_protos_service_proto protoreflect.FileDescriptor
-
-var file_oracle_pkg_agents_config_agent_protos_service_proto_rawDesc = []byte{
-	0x0a, 0x33, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x67, 0x65,
-	0x6e, 0x74, 0x73, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x61, 0x67, 0x65, 0x6e, 0x74,
-	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x1a, 0x23, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x6c, 0x6f, 0x6e, 0x67, 0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e,
-	0x67, 0x2f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
-	0x9b, 0x02, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x44, 0x42, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5f, 0x68,
-	0x6f, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6f, 0x72, 0x61, 0x63, 0x6c,
-	0x65, 0x48, 0x6f, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x03, 0x73, 0x69, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x64, 0x62, 0x5f, 0x75, 0x6e,
-	0x69, 0x71, 0x75, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0c, 0x64, 0x62, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a,
-	0x0d, 0x63, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x74, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x53,
-	0x65, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x5f, 0x70, 0x65, 0x72,
-	0x63, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x6d, 0x65, 0x6d, 0x6f,
-	0x72, 0x79, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x2b, 0x0a, 0x11, 0x61, 0x64, 0x64,
-	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x06,
-	0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c,
-	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
-	0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
-	0x12, 0x1b, 0x0a, 0x09, 0x64, 0x62, 0x5f, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x08, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x62, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x22, 0x99, 0x01,
-	0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70,
-	0x6f, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12,
-	0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x1f, 0x0a, 0x0b, 0x6f,
-	0x72, 0x61, 0x63, 0x6c, 0x65, 0x5f, 0x68, 0x6f, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0a, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x48, 0x6f, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09,
-	0x64, 0x62, 0x5f, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x64, 0x62, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x22, 0x18, 0x0a, 0x16, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x8d, 0x01, 0x0a, 0x12, 0x47, 0x73, 0x6d, 0x53, 0x65, 0x63, 0x72, 0x65,
-	0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72,
-	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x65, 0x63,
-	0x72, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65,
-	0x63, 0x72, 0x65, 0x74, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
-	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
-	0x12, 0x21, 0x0a, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x56, 0x65, 0x72, 0x73,
-	0x69, 0x6f, 0x6e, 0x22, 0x82, 0x02, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x61,
-	0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a,
-	0x08, 0x63, 0x64, 0x62, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x63, 0x64, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08,
-	0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x62, 0x5f, 0x64,
-	0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x62, 0x44,
-	0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x12, 0x5c, 0x0a, 0x1d, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x70,
-	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x5f, 0x67, 0x73, 0x6d, 0x5f, 0x73, 0x65, 0x63, 0x72,
-	0x65, 0x74, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x47, 0x73, 0x6d, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52,
-	0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x19, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x50,
-	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x47, 0x73, 0x6d, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74,
-	0x52, 0x65, 0x66, 0x12, 0x23, 0x0a, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x70, 0x61, 0x73, 0x73,
-	0x77, 0x6f, 0x72, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6c, 0x61, 0x73, 0x74,
-	0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x55, 0x0a, 0x16, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x72,
-	0x72, 0x6f, 0x72, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22,
-	0xdb, 0x01, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x64, 0x62, 0x5f, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x64, 0x62, 0x4e, 0x61, 0x6d,
-	0x65, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x64, 0x62, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x64, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x28, 0x0a, 0x10,
-	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x5f, 0x63, 0x6d, 0x64,
-	0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73,
-	0x65, 0x72, 0x73, 0x43, 0x6d, 0x64, 0x12, 0x26, 0x0a, 0x0f, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x5f,
-	0x70, 0x72, 0x69, 0x76, 0x73, 0x5f, 0x63, 0x6d, 0x64, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52,
-	0x0d, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x50, 0x72, 0x69, 0x76, 0x73, 0x43, 0x6d, 0x64, 0x12, 0x1b,
-	0x0a, 0x09, 0x64, 0x62, 0x5f, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x64, 0x62, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x12, 0x20, 0x0a, 0x04, 0x75,
-	0x73, 0x65, 0x72, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x73, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x22, 0x2d, 0x0a,
-	0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x83, 0x01, 0x0a,
-	0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x44, 0x42, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x64, 0x62, 0x5f, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x64, 0x62, 0x4e, 0x61, 0x6d, 0x65,
-	0x12, 0x28, 0x0a, 0x10, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x73,
-	0x5f, 0x63, 0x6d, 0x64, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x73, 0x43, 0x6d, 0x64, 0x12, 0x26, 0x0a, 0x0f, 0x67, 0x72,
-	0x61, 0x6e, 0x74, 0x5f, 0x70, 0x72, 0x69, 0x76, 0x73, 0x5f, 0x63, 0x6d, 0x64, 0x18, 0x04, 0x20,
-	0x03, 0x28, 0x09, 0x52, 0x0d, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x50, 0x72, 0x69, 0x76, 0x73, 0x43,
-	0x6d, 0x64, 0x22, 0x54, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x44, 0x42, 0x55,
-	0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x72, 0x72, 0x6f,
-	0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xce, 0x01, 0x0a, 0x04, 0x55, 0x73, 0x65,
-	0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
-	0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x72, 0x69, 0x76, 0x69, 0x6c, 0x65, 0x67, 0x65, 0x73, 0x18,
-	0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x72, 0x69, 0x76, 0x69, 0x6c, 0x65, 0x67, 0x65,
-	0x73, 0x12, 0x51, 0x0a, 0x17, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x5f, 0x67, 0x73,
-	0x6d, 0x5f, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x47, 0x73, 0x6d, 0x53,
-	0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x14,
-	0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x47, 0x73, 0x6d, 0x53, 0x65, 0x63, 0x72, 0x65,
-	0x74, 0x52, 0x65, 0x66, 0x12, 0x23, 0x0a, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x70, 0x61, 0x73,
-	0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6c, 0x61, 0x73,
-	0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x5d, 0x0a, 0x13, 0x55, 0x73, 0x65,
-	0x72, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x19, 0x0a, 0x08, 0x70, 0x64, 0x62, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x70, 0x64, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2b, 0x0a, 0x0a, 0x75,
-	0x73, 0x65, 0x72, 0x5f, 0x73, 0x70, 0x65, 0x63, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x0c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x09, 0x75,
-	0x73, 0x65, 0x72, 0x53, 0x70, 0x65, 0x63, 0x73, 0x22, 0xb1, 0x02, 0x0a, 0x14, 0x55, 0x73, 0x65,
-	0x72, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x12, 0x47, 0x0a, 0x0a, 0x73,
-	0x75, 0x70, 0x70, 0x72, 0x65, 0x73, 0x73, 0x65, 0x64, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x27, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x73, 0x43, 0x68,
-	0x61, 0x6e, 0x67, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x75,
-	0x70, 0x70, 0x72, 0x65, 0x73, 0x73, 0x65, 0x64, 0x52, 0x0a, 0x73, 0x75, 0x70, 0x70, 0x72, 0x65,
-	0x73, 0x73, 0x65, 0x64, 0x1a, 0x83, 0x01, 0x0a, 0x0a, 0x53, 0x75, 0x70, 0x70, 0x72, 0x65, 0x73,
-	0x73, 0x65, 0x64, 0x12, 0x46, 0x0a, 0x0d, 0x73, 0x75, 0x70, 0x70, 0x72, 0x65, 0x73, 0x73, 0x5f,
-	0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x73, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0c, 0x73,
-	0x75, 0x70, 0x70, 0x72, 0x65, 0x73, 0x73, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x75,
-	0x73, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x75, 0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x71, 0x6c, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x71, 0x6c, 0x22, 0x30, 0x0a, 0x04, 0x54, 0x79,
-	0x70, 0x65, 0x12, 0x10, 0x0a, 0x0c, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x54, 0x59,
-	0x50, 0x45, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x10, 0x01,
-	0x12, 0x0a, 0x0a, 0x06, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x10, 0x02, 0x22, 0x5c, 0x0a, 0x12,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x64, 0x62, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x64, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2b, 0x0a,
-	0x0a, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x73, 0x70, 0x65, 0x63, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52,
-	0x09, 0x75, 0x73, 0x65, 0x72, 0x53, 0x70, 0x65, 0x63, 0x73, 0x22, 0x15, 0x0a, 0x13, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x93, 0x04, 0x0a, 0x15, 0x50, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x42, 0x61,
-	0x63, 0x6b, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4a, 0x0a, 0x0f, 0x62,
-	0x61, 0x63, 0x6b, 0x75, 0x70, 0x5f, 0x73, 0x75, 0x62, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x50, 0x68,
-	0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0d, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70,
-	0x53, 0x75, 0x62, 0x54, 0x79, 0x70, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x61, 0x63, 0x6b, 0x75,
-	0x70, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x62,
-	0x61, 0x63, 0x6b, 0x75, 0x70, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x62, 0x61,
-	0x63, 0x6b, 0x75, 0x70, 0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x62,
-	0x61, 0x63, 0x6b, 0x75, 0x70, 0x73, 0x65, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x70,
-	0x72, 0x65, 0x73, 0x73, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x63, 0x6f,
-	0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x65, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x68, 0x65, 0x63,
-	0x6b, 0x5f, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x61, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x0c, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x4c, 0x6f, 0x67, 0x69, 0x63, 0x61, 0x6c, 0x12, 0x10, 0x0a,
-	0x03, 0x64, 0x6f, 0x70, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x64, 0x6f, 0x70, 0x12,
-	0x14, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05,
-	0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x20, 0x0a, 0x0b, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x65,
-	0x72, 0x73, 0x65, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x66, 0x69, 0x6c, 0x65,
-	0x73, 0x70, 0x65, 0x72, 0x73, 0x65, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x65, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x73,
-	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x6f,
-	0x63, 0x61, 0x6c, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x50, 0x61, 0x74, 0x68, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x63, 0x73,
-	0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x63, 0x73,
-	0x50, 0x61, 0x74, 0x68, 0x12, 0x2d, 0x0a, 0x09, 0x6c, 0x72, 0x6f, 0x5f, 0x69, 0x6e, 0x70, 0x75,
-	0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73,
-	0x2e, 0x4c, 0x52, 0x4f, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x52, 0x08, 0x6c, 0x72, 0x6f, 0x49, 0x6e,
-	0x70, 0x75, 0x74, 0x22, 0x52, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x10, 0x0a, 0x0c, 0x55,
-	0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x10, 0x00, 0x12, 0x0c, 0x0a,
-	0x08, 0x49, 0x4e, 0x53, 0x54, 0x41, 0x4e, 0x43, 0x45, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x44,
-	0x41, 0x54, 0x41, 0x42, 0x41, 0x53, 0x45, 0x10, 0x02, 0x12, 0x0e, 0x0a, 0x0a, 0x54, 0x41, 0x42,
-	0x4c, 0x45, 0x53, 0x50, 0x41, 0x43, 0x45, 0x10, 0x03, 0x12, 0x0c, 0x0a, 0x08, 0x44, 0x41, 0x54,
-	0x41, 0x46, 0x49, 0x4c, 0x45, 0x10, 0x04, 0x22, 0xd3, 0x01, 0x0a, 0x16, 0x50, 0x68, 0x79, 0x73,
-	0x69, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x69, 0x6e, 0x73, 0x74, 0x61,
-	0x6e, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x64, 0x62, 0x5f, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x64, 0x62, 0x4e, 0x61,
-	0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x6f, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x03, 0x64, 0x6f, 0x70, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x70, 0x61,
-	0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x50,
-	0x61, 0x74, 0x68, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x63, 0x73, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x63, 0x73, 0x50, 0x61, 0x74, 0x68, 0x12, 0x2d,
-	0x0a, 0x09, 0x6c, 0x72, 0x6f, 0x5f, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x4c, 0x52, 0x4f, 0x49, 0x6e,
-	0x70, 0x75, 0x74, 0x52, 0x08, 0x6c, 0x72, 0x6f, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x22, 0xd5, 0x01,
-	0x0a, 0x12, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x64, 0x62, 0x5f,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x64, 0x62, 0x4e,
-	0x61, 0x6d, 0x65, 0x12, 0x4b, 0x0a, 0x11, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x5f, 0x73, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52,
-	0x0f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x54, 0x79, 0x70, 0x65,
-	0x12, 0x1b, 0x0a, 0x09, 0x64, 0x62, 0x5f, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x62, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x22, 0x26, 0x0a,
-	0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x10, 0x0a, 0x0c, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e,
-	0x5f, 0x54, 0x59, 0x50, 0x45, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x49, 0x4e, 0x53, 0x54, 0x41,
-	0x4e, 0x43, 0x45, 0x10, 0x01, 0x22, 0x52, 0x0a, 0x13, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06,
-	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x6d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x72, 0x72,
-	0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xbb, 0x01, 0x0a, 0x15, 0x44, 0x61,
-	0x74, 0x61, 0x50, 0x75, 0x6d, 0x70, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x64, 0x62, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x64, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b,
-	0x0a, 0x09, 0x64, 0x62, 0x5f, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x64, 0x62, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x67,
-	0x63, 0x73, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67,
-	0x63, 0x73, 0x50, 0x61, 0x74, 0x68, 0x12, 0x20, 0x0a, 0x0c, 0x67, 0x63, 0x73, 0x5f, 0x6c, 0x6f,
-	0x67, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x67, 0x63,
-	0x73, 0x4c, 0x6f, 0x67, 0x50, 0x61, 0x74, 0x68, 0x12, 0x2d, 0x0a, 0x09, 0x6c, 0x72, 0x6f, 0x5f,
-	0x69, 0x6e, 0x70, 0x75, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x4c, 0x52, 0x4f, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x52, 0x08, 0x6c,
-	0x72, 0x6f, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x22, 0x9d, 0x02, 0x0a, 0x15, 0x44, 0x61, 0x74, 0x61,
-	0x50, 0x75, 0x6d, 0x70, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x64, 0x62, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x64, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09,
-	0x64, 0x62, 0x5f, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x64, 0x62, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x6f, 0x62, 0x6a,
-	0x65, 0x63, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
-	0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x62,
-	0x6a, 0x65, 0x63, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x62, 0x6a,
-	0x65, 0x63, 0x74, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x63, 0x73, 0x5f, 0x70, 0x61, 0x74, 0x68,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x63, 0x73, 0x50, 0x61, 0x74, 0x68, 0x12,
-	0x20, 0x0a, 0x0c, 0x67, 0x63, 0x73, 0x5f, 0x6c, 0x6f, 0x67, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x67, 0x63, 0x73, 0x4c, 0x6f, 0x67, 0x50, 0x61, 0x74,
-	0x68, 0x12, 0x2d, 0x0a, 0x09, 0x6c, 0x72, 0x6f, 0x5f, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x18, 0x07,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x4c, 0x52,
-	0x4f, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x52, 0x08, 0x6c, 0x72, 0x6f, 0x49, 0x6e, 0x70, 0x75, 0x74,
-	0x12, 0x25, 0x0a, 0x0e, 0x66, 0x6c, 0x61, 0x73, 0x68, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x74, 0x69,
-	0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x66, 0x6c, 0x61, 0x73, 0x68, 0x62,
-	0x61, 0x63, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x2d, 0x0a, 0x08, 0x4c, 0x52, 0x4f, 0x49, 0x6e,
-	0x70, 0x75, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x70, 0x65, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0xa5, 0x01, 0x0a, 0x18, 0x42, 0x6f, 0x6f, 0x74, 0x73,
-	0x74, 0x72, 0x61, 0x70, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x64, 0x62, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x64, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18,
-	0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x6f, 0x73, 0x74,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0e,
-	0x64, 0x62, 0x5f, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x64, 0x62, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x4e, 0x61,
-	0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x62, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x62, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x22, 0x1b,
-	0x0a, 0x19, 0x42, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x44, 0x61, 0x74, 0x61, 0x62,
-	0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x6a, 0x0a, 0x17, 0x42,
-	0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x62, 0x79, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x64, 0x62, 0x5f, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x64, 0x62, 0x4e, 0x61, 0x6d,
-	0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x64,
-	0x62, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64,
-	0x62, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x22, 0xee, 0x01, 0x0a, 0x18, 0x42, 0x6f, 0x6f, 0x74,
-	0x73, 0x74, 0x72, 0x61, 0x70, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x62, 0x79, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x04, 0x70, 0x64, 0x62, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x24, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x42, 0x6f, 0x6f, 0x74,
-	0x73, 0x74, 0x72, 0x61, 0x70, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x62, 0x79, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x50, 0x44, 0x42, 0x52, 0x04, 0x70, 0x64, 0x62, 0x73, 0x1a, 0x39,
-	0x0a, 0x04, 0x55, 0x73, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x4e,
-	0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x76, 0x73, 0x18, 0x02, 0x20, 0x03,
-	0x28, 0x09, 0x52, 0x05, 0x70, 0x72, 0x69, 0x76, 0x73, 0x1a, 0x5d, 0x0a, 0x03, 0x50, 0x44, 0x42,
-	0x12, 0x19, 0x0a, 0x08, 0x70, 0x64, 0x62, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x70, 0x64, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x3b, 0x0a, 0x05, 0x75,
-	0x73, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x73, 0x2e, 0x42, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x53, 0x74, 0x61,
-	0x6e, 0x64, 0x62, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x55, 0x73, 0x65,
-	0x72, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x22, 0xa2, 0x01, 0x0a, 0x13, 0x53, 0x65, 0x74,
-	0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
-	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x34, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e,
-	0x53, 0x65, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x2d,
-	0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x44, 0x59, 0x4e, 0x41, 0x4d, 0x49,
-	0x43, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x54, 0x41, 0x54, 0x49, 0x43, 0x10, 0x01, 0x12,
-	0x0c, 0x0a, 0x08, 0x44, 0x45, 0x46, 0x45, 0x52, 0x52, 0x45, 0x44, 0x10, 0x02, 0x22, 0x2e, 0x0a,
-	0x14, 0x53, 0x65, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x69, 0x63, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x69, 0x63, 0x22, 0x32, 0x0a,
-	0x1c, 0x47, 0x65, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x54, 0x79, 0x70,
-	0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
-	0x04, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x65, 0x79,
-	0x73, 0x22, 0x4d, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65,
-	0x72, 0x54, 0x79, 0x70, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x09, 0x52, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73,
-	0x22, 0x59, 0x0a, 0x15, 0x42, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61,
-	0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x69, 0x64, 0x12, 0x2e, 0x0a, 0x13, 0x61,
-	0x76, 0x6f, 0x69, 0x64, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x62, 0x61, 0x63, 0x6b,
-	0x75, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x61, 0x76, 0x6f, 0x69, 0x64, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x22, 0x18, 0x0a, 0x16, 0x42,
-	0x6f, 0x75, 0x6e, 0x63, 0x65, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x35, 0x0a, 0x18, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x64, 0x62, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x64, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x1b, 0x0a, 0x19,
-	0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x46, 0x69, 0x6c,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x22, 0x0a, 0x20, 0x46, 0x65, 0x74,
-	0x63, 0x68, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x4d, 0x65,
-	0x74, 0x61, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x79, 0x0a,
-	0x21, 0x46, 0x65, 0x74, 0x63, 0x68, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x6d, 0x61,
-	0x67, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x0a, 0x08,
-	0x63, 0x64, 0x62, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x63, 0x64, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6f, 0x72, 0x61, 0x63, 0x6c,
-	0x65, 0x5f, 0x68, 0x6f, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6f, 0x72,
-	0x61, 0x63, 0x6c, 0x65, 0x48, 0x6f, 0x6d, 0x65, 0x32, 0xdd, 0x0e, 0x0a, 0x0b, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x12, 0x51, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x12, 0x1d, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x73, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61,
-	0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x73, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x0b, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x1a, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x73, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4e, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43,
-	0x44, 0x42, 0x55, 0x73, 0x65, 0x72, 0x12, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x44, 0x42, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x43, 0x44, 0x42, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x0c, 0x55, 0x73, 0x65, 0x72, 0x73, 0x43, 0x68,
-	0x61, 0x6e, 0x67, 0x65, 0x64, 0x12, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x55,
-	0x73, 0x65, 0x72, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x55, 0x73, 0x65, 0x72,
-	0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x12, 0x48, 0x0a, 0x0b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72,
-	0x73, 0x12, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65,
-	0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x50, 0x0a, 0x0e,
-	0x50, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x12, 0x1d,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x50, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c,
-	0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x6c, 0x6f, 0x6e, 0x67, 0x72, 0x75, 0x6e, 0x6e, 0x69,
-	0x6e, 0x67, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x00, 0x12, 0x52,
-	0x0a, 0x0f, 0x50, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72,
-	0x65, 0x12, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x50, 0x68, 0x79, 0x73, 0x69,
-	0x63, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x1d, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x6c, 0x6f, 0x6e, 0x67, 0x72,
-	0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x22, 0x00, 0x12, 0x48, 0x0a, 0x0b, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x12, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x53, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x09,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x44, 0x42, 0x12, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x73, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x44, 0x42, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x6c, 0x6f, 0x6e,
-	0x67, 0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x22, 0x00, 0x12, 0x51, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4c, 0x69,
-	0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x12, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4e, 0x0a, 0x0e, 0x44, 0x61, 0x74, 0x61, 0x50,
-	0x75, 0x6d, 0x70, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x73, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x50, 0x75, 0x6d, 0x70, 0x49, 0x6d, 0x70, 0x6f, 0x72,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x6c, 0x6f, 0x6e, 0x67, 0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x4f, 0x70,
-	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x67, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x4f,
-	0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x29, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x6c, 0x6f, 0x6e, 0x67, 0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x4c,
-	0x69, 0x73, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x6c, 0x6f,
-	0x6e, 0x67, 0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x70,
-	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x56, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x27, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x6c, 0x6f, 0x6e, 0x67, 0x72, 0x75,
-	0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x6c, 0x6f, 0x6e, 0x67, 0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x4f,
-	0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x55, 0x0a, 0x0f, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2a, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x6c, 0x6f, 0x6e, 0x67, 0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67,
-	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12,
-	0x56, 0x0a, 0x11, 0x42, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x44, 0x61, 0x74, 0x61,
-	0x62, 0x61, 0x73, 0x65, 0x12, 0x20, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x42, 0x6f,
-	0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x6c, 0x6f, 0x6e, 0x67, 0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x4f, 0x70, 0x65, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x00, 0x12, 0x57, 0x0a, 0x10, 0x42, 0x6f, 0x6f, 0x74, 0x73,
-	0x74, 0x72, 0x61, 0x70, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x62, 0x79, 0x12, 0x1f, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x42, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x53, 0x74,
-	0x61, 0x6e, 0x64, 0x62, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x42, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x53,
-	0x74, 0x61, 0x6e, 0x64, 0x62, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
-	0x12, 0x4e, 0x0a, 0x0e, 0x44, 0x61, 0x74, 0x61, 0x50, 0x75, 0x6d, 0x70, 0x45, 0x78, 0x70, 0x6f,
-	0x72, 0x74, 0x12, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x44, 0x61, 0x74, 0x61,
-	0x50, 0x75, 0x6d, 0x70, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x1d, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x6c, 0x6f, 0x6e, 0x67, 0x72,
-	0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x4b, 0x0a, 0x0c, 0x53, 0x65, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72,
-	0x12, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x53, 0x65, 0x74, 0x50, 0x61, 0x72,
-	0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x53, 0x65, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65,
-	0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x66, 0x0a,
-	0x15, 0x47, 0x65, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x54, 0x79, 0x70,
-	0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x24, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e,
-	0x47, 0x65, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65,
-	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74,
-	0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x51, 0x0a, 0x0e, 0x42, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x44,
-	0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x12, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73,
-	0x2e, 0x42, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e,
-	0x42, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5a, 0x0a, 0x11, 0x52, 0x65, 0x63, 0x6f,
-	0x76, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x20, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x21, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x12, 0x72, 0x0a, 0x19, 0x46, 0x65, 0x74, 0x63, 0x68, 0x53, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x44, 0x61, 0x74,
-	0x61, 0x12, 0x28, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x46, 0x65, 0x74, 0x63, 0x68,
-	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x4d, 0x65, 0x74, 0x61,
-	0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x46, 0x65, 0x74, 0x63, 0x68, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x65, 0x5a, 0x63, 0x67, 0x69, 0x74, 0x68,
-	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x47, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x43, 0x6c, 0x6f,
-	0x75, 0x64, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x2f, 0x65, 0x6c, 0x63, 0x61, 0x72,
-	0x72, 0x6f, 0x2d, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2d, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74,
-	0x6f, 0x72, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x67,
-	0x65, 0x6e, 0x74, 0x73, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x61, 0x67, 0x65, 0x6e,
-	0x74, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x3b, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
-}
-
-var (
-	file_oracle_pkg_agents_config_agent_protos_service_proto_rawDescOnce sync.Once
-	file_oracle_pkg_agents_config_agent_protos_service_proto_rawDescData = file_oracle_pkg_agents_config_agent_protos_service_proto_rawDesc
-)
-
-func file_oracle_pkg_agents_config_agent_protos_service_proto_rawDescGZIP() []byte {
-	file_oracle_pkg_agents_config_agent_protos_service_proto_rawDescOnce.Do(func() {
-		file_oracle_pkg_agents_config_agent_protos_service_proto_rawDescData = protoimpl.X.CompressGZIP(file_oracle_pkg_agents_config_agent_protos_service_proto_rawDescData)
-	})
-	return file_oracle_pkg_agents_config_agent_protos_service_proto_rawDescData
-}
-
-var file_oracle_pkg_agents_config_agent_protos_service_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
-var file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes = make([]protoimpl.MessageInfo, 39)
-var file_oracle_pkg_agents_config_agent_protos_service_proto_goTypes = []interface{}{
-	(UsersChangedResponse_Type)(0),             // 0: protos.UsersChangedResponse.Type
-	(PhysicalBackupRequest_Type)(0),            // 1: protos.PhysicalBackupRequest.Type
-	(CheckStatusRequest_Type)(0),               // 2: protos.CheckStatusRequest.Type
-	(SetParameterRequest_Type)(0),              // 3: protos.SetParameterRequest.Type
-	(*CreateCDBRequest)(nil),                   // 4: protos.CreateCDBRequest
-	(*CreateListenerRequest)(nil),              // 5: protos.CreateListenerRequest
-	(*CreateListenerResponse)(nil),             // 6: protos.CreateListenerResponse
-	(*GsmSecretReference)(nil),                 // 7: protos.GsmSecretReference
-	(*CreateDatabaseRequest)(nil),              // 8: protos.CreateDatabaseRequest
-	(*CreateDatabaseResponse)(nil),             // 9: protos.CreateDatabaseResponse
-	(*CreateUsersRequest)(nil),                 // 10: protos.CreateUsersRequest
-	(*CreateUsersResponse)(nil),                // 11: protos.CreateUsersResponse
-	(*CreateCDBUserRequest)(nil),               // 12: protos.CreateCDBUserRequest
-	(*CreateCDBUserResponse)(nil),              // 13: protos.CreateCDBUserResponse
-	(*User)(nil),                               // 14: protos.User
-	(*UsersChangedRequest)(nil),                // 15: protos.UsersChangedRequest
-	(*UsersChangedResponse)(nil),               // 16: protos.UsersChangedResponse
-	(*UpdateUsersRequest)(nil),                 // 17: protos.UpdateUsersRequest
-	(*UpdateUsersResponse)(nil),                // 18: protos.UpdateUsersResponse
-	(*PhysicalBackupRequest)(nil),              // 19: protos.PhysicalBackupRequest
-	(*PhysicalRestoreRequest)(nil),             // 20: protos.PhysicalRestoreRequest
-	(*CheckStatusRequest)(nil),                 // 21: protos.CheckStatusRequest
-	(*CheckStatusResponse)(nil),                // 22: protos.CheckStatusResponse
-	(*DataPumpImportRequest)(nil),              // 23: protos.DataPumpImportRequest
-	(*DataPumpExportRequest)(nil),              // 24: protos.DataPumpExportRequest
-	(*LROInput)(nil),                           // 25: protos.LROInput
-	(*BootstrapDatabaseRequest)(nil),           // 26: protos.BootstrapDatabaseRequest
-	(*BootstrapDatabaseResponse)(nil),          // 27: protos.BootstrapDatabaseResponse
-	(*BootstrapStandbyRequest)(nil),            // 28: protos.BootstrapStandbyRequest
-	(*BootstrapStandbyResponse)(nil),           // 29: protos.BootstrapStandbyResponse
-	(*SetParameterRequest)(nil),                // 30: protos.SetParameterRequest
-	(*SetParameterResponse)(nil),               // 31: protos.SetParameterResponse
-	(*GetParameterTypeValueRequest)(nil),       // 32: protos.GetParameterTypeValueRequest
-	(*GetParameterTypeValueResponse)(nil),      // 33: protos.GetParameterTypeValueResponse
-	(*BounceDatabaseRequest)(nil),              // 34: protos.BounceDatabaseRequest
-	(*BounceDatabaseResponse)(nil),             // 35: protos.BounceDatabaseResponse
-	(*RecoverConfigFileRequest)(nil),           // 36: protos.RecoverConfigFileRequest
-	(*RecoverConfigFileResponse)(nil),          // 37: protos.RecoverConfigFileResponse
-	(*FetchServiceImageMetaDataRequest)(nil),   // 38: protos.FetchServiceImageMetaDataRequest
-	(*FetchServiceImageMetaDataResponse)(nil),  // 39: protos.FetchServiceImageMetaDataResponse
-	(*UsersChangedResponse_Suppressed)(nil),    // 40: protos.UsersChangedResponse.Suppressed
-	(*BootstrapStandbyResponse_User)(nil),      // 41: protos.BootstrapStandbyResponse.User
-	(*BootstrapStandbyResponse_PDB)(nil),       // 42: protos.BootstrapStandbyResponse.PDB
-	(*longrunning.ListOperationsRequest)(nil),  // 43: google.longrunning.ListOperationsRequest
-	(*longrunning.GetOperationRequest)(nil),    // 44: google.longrunning.GetOperationRequest
-	(*longrunning.DeleteOperationRequest)(nil), // 45: google.longrunning.DeleteOperationRequest
-	(*longrunning.Operation)(nil),              // 46: google.longrunning.Operation
-	(*longrunning.ListOperationsResponse)(nil), // 47: google.longrunning.ListOperationsResponse
-	(*emptypb.Empty)(nil),                      // 48: google.protobuf.Empty
-}
-var file_oracle_pkg_agents_config_agent_protos_service_proto_depIdxs = []int32{
-	7,  // 0: protos.CreateDatabaseRequest.admin_password_gsm_secret_ref:type_name -> protos.GsmSecretReference
-	14, // 1: protos.CreateUsersRequest.user:type_name -> protos.User
-	7,  // 2: protos.User.password_gsm_secret_ref:type_name -> protos.GsmSecretReference
-	14, // 3: protos.UsersChangedRequest.user_specs:type_name -> protos.User
-	40, // 4: protos.UsersChangedResponse.suppressed:type_name -> protos.UsersChangedResponse.Suppressed
-	14, // 5: protos.UpdateUsersRequest.user_specs:type_name -> protos.User
-	1,  // 6: protos.PhysicalBackupRequest.backup_sub_type:type_name -> protos.PhysicalBackupRequest.Type
-	25, // 7: protos.PhysicalBackupRequest.lro_input:type_name -> protos.LROInput
-	25, // 8: protos.PhysicalRestoreRequest.lro_input:type_name -> protos.LROInput
-	2,  // 9: protos.CheckStatusRequest.check_status_type:type_name -> protos.CheckStatusRequest.Type
-	25, // 10: protos.DataPumpImportRequest.lro_input:type_name -> protos.LROInput
-	25, // 11: protos.DataPumpExportRequest.lro_input:type_name -> protos.LROInput
-	42, // 12: protos.BootstrapStandbyResponse.pdbs:type_name -> protos.BootstrapStandbyResponse.PDB
-	3,  // 13: protos.SetParameterRequest.type:type_name -> protos.SetParameterRequest.Type
-	0,  // 14: protos.UsersChangedResponse.Suppressed.suppress_type:type_name -> protos.UsersChangedResponse.Type
-	41, // 15: protos.BootstrapStandbyResponse.PDB.users:type_name -> protos.BootstrapStandbyResponse.User
-	8,  // 16: protos.ConfigAgent.CreateDatabase:input_type -> protos.CreateDatabaseRequest
-	10, // 17: protos.ConfigAgent.CreateUsers:input_type -> protos.CreateUsersRequest
-	12, // 18: protos.ConfigAgent.CreateCDBUser:input_type -> protos.CreateCDBUserRequest
-	15, // 19: protos.ConfigAgent.UsersChanged:input_type -> protos.UsersChangedRequest
-	17, // 20: protos.ConfigAgent.UpdateUsers:input_type -> protos.UpdateUsersRequest
-	19, // 21: protos.ConfigAgent.PhysicalBackup:input_type -> protos.PhysicalBackupRequest
-	20, // 22: protos.ConfigAgent.PhysicalRestore:input_type -> protos.PhysicalRestoreRequest
-	21, // 23: protos.ConfigAgent.CheckStatus:input_type -> protos.CheckStatusRequest
-	4,  // 24: protos.ConfigAgent.CreateCDB:input_type -> protos.CreateCDBRequest
-	5,  // 25: protos.ConfigAgent.CreateListener:input_type -> protos.CreateListenerRequest
-	23, // 26: protos.ConfigAgent.DataPumpImport:input_type -> protos.DataPumpImportRequest
-	43, // 27: protos.ConfigAgent.ListOperations:input_type -> google.longrunning.ListOperationsRequest
-	44, // 28: protos.ConfigAgent.GetOperation:input_type -> google.longrunning.GetOperationRequest
-	45, // 29: protos.ConfigAgent.DeleteOperation:input_type -> google.longrunning.DeleteOperationRequest
-	26, // 30: protos.ConfigAgent.BootstrapDatabase:input_type -> protos.BootstrapDatabaseRequest
-	28, // 31: protos.ConfigAgent.BootstrapStandby:input_type -> protos.BootstrapStandbyRequest
-	24, // 32: protos.ConfigAgent.DataPumpExport:input_type -> protos.DataPumpExportRequest
-	30, // 33: protos.ConfigAgent.SetParameter:input_type -> protos.SetParameterRequest
-	32, // 34: protos.ConfigAgent.GetParameterTypeValue:input_type -> protos.GetParameterTypeValueRequest
-	34, // 35: protos.ConfigAgent.BounceDatabase:input_type -> protos.BounceDatabaseRequest
-	36, // 36: protos.ConfigAgent.RecoverConfigFile:input_type -> protos.RecoverConfigFileRequest
-	38, // 37: protos.ConfigAgent.FetchServiceImageMetaData:input_type -> protos.FetchServiceImageMetaDataRequest
-	9,  // 38: protos.ConfigAgent.CreateDatabase:output_type -> protos.CreateDatabaseResponse
-	11, // 39: protos.ConfigAgent.CreateUsers:output_type -> protos.CreateUsersResponse
-	13, // 40: protos.ConfigAgent.CreateCDBUser:output_type -> protos.CreateCDBUserResponse
-	16, // 41: protos.ConfigAgent.UsersChanged:output_type -> protos.UsersChangedResponse
-	18, // 42: protos.ConfigAgent.UpdateUsers:output_type -> protos.UpdateUsersResponse
-	46, // 43: protos.ConfigAgent.PhysicalBackup:output_type -> google.longrunning.Operation
-	46, // 44: protos.ConfigAgent.PhysicalRestore:output_type -> google.longrunning.Operation
-	22, // 45: protos.ConfigAgent.CheckStatus:output_type -> protos.CheckStatusResponse
-	46, // 46: protos.ConfigAgent.CreateCDB:output_type -> google.longrunning.Operation
-	6,  // 47: protos.ConfigAgent.CreateListener:output_type -> protos.CreateListenerResponse
-	46, // 48: protos.ConfigAgent.DataPumpImport:output_type -> google.longrunning.Operation
-	47, // 49: protos.ConfigAgent.ListOperations:output_type -> google.longrunning.ListOperationsResponse
-	46, // 50: protos.ConfigAgent.GetOperation:output_type -> google.longrunning.Operation
-	48, // 51: protos.ConfigAgent.DeleteOperation:output_type -> google.protobuf.Empty
-	46, // 52: protos.ConfigAgent.BootstrapDatabase:output_type -> google.longrunning.Operation
-	29, // 53: protos.ConfigAgent.BootstrapStandby:output_type -> protos.BootstrapStandbyResponse
-	46, // 54: protos.ConfigAgent.DataPumpExport:output_type -> google.longrunning.Operation
-	31, // 55: protos.ConfigAgent.SetParameter:output_type -> protos.SetParameterResponse
-	33, // 56: protos.ConfigAgent.GetParameterTypeValue:output_type -> protos.GetParameterTypeValueResponse
-	35, // 57: protos.ConfigAgent.BounceDatabase:output_type -> protos.BounceDatabaseResponse
-	37, // 58: protos.ConfigAgent.RecoverConfigFile:output_type -> protos.RecoverConfigFileResponse
-	39, // 59: protos.ConfigAgent.FetchServiceImageMetaData:output_type -> protos.FetchServiceImageMetaDataResponse
-	38, // [38:60] is the sub-list for method output_type
-	16, // [16:38] is the sub-list for method input_type
-	16, // [16:16] is the sub-list for extension type_name
-	16, // [16:16] is the sub-list for extension extendee
-	0,  // [0:16] is the sub-list for field type_name
-}
-
-func init() { file_oracle_pkg_agents_config_agent_protos_service_proto_init() }
-func file_oracle_pkg_agents_config_agent_protos_service_proto_init() {
-	if File_oracle_pkg_agents_config_agent_protos_service_proto != nil {
-		return
-	}
-	if !protoimpl.UnsafeEnabled {
-		file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateCDBRequest); i {
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteDatabaseResponse.ProtoReflect.Descriptor instead.
+func (*DeleteDatabaseResponse) Descriptor() ([]byte, []int) {
+	return file_oracle_pkg_agents_config_agent_protos_service_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *DeleteDatabaseResponse) GetManifestPath() string {
+	if x != nil {
+		return x.ManifestPath
+	}
+	return ""
+}
+
+type DeleteBackupRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BackupTag string `protobuf:"bytes,1,opt,name=backup_tag,json=backupTag,proto3" json:"backup_tag,omitempty"`
+	GcsPath   string `protobuf:"bytes,2,opt,name=gcs_path,json=gcsPath,proto3" json:"gcs_path,omitempty"`
+	// Credentials to access the storage locations of the request.
+	StorageCredentials map[string]string `protobuf:"bytes,3,rep,name=storage_credentials,json=storageCredentials,proto3" json:"storage_credentials,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *DeleteBackupRequest) Reset() {
+	*x = DeleteBackupRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteBackupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteBackupRequest) ProtoMessage() {}
+
+func (x *DeleteBackupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteBackupRequest.ProtoReflect.Descriptor instead.
+func (*DeleteBackupRequest) Descriptor() ([]byte, []int) {
+	return file_oracle_pkg_agents_config_agent_protos_service_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *DeleteBackupRequest) GetBackupTag() string {
+	if x != nil {
+		return x.BackupTag
+	}
+	return ""
+}
+
+func (x *DeleteBackupRequest) GetGcsPath() string {
+	if x != nil {
+		return x.GcsPath
+	}
+	return ""
+}
+
+func (x *DeleteBackupRequest) GetStorageCredentials() map[string]string {
+	if x != nil {
+		return x.StorageCredentials
+	}
+	return nil
+}
+
+type DeleteBackupResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DeletedCount int32 `protobuf:"varint,1,opt,name=deleted_count,json=deletedCount,proto3" json:"deleted_count,omitempty"`
+}
+
+func (x *DeleteBackupResponse) Reset() {
+	*x = DeleteBackupResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteBackupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteBackupResponse) ProtoMessage() {}
+
+func (x *DeleteBackupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteBackupResponse.ProtoReflect.Descriptor instead.
+func (*DeleteBackupResponse) Descriptor() ([]byte, []int) {
+	return file_oracle_pkg_agents_config_agent_protos_service_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *DeleteBackupResponse) GetDeletedCount() int32 {
+	if x != nil {
+		return x.DeletedCount
+	}
+	return 0
+}
+
+type CreateStandbyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name of the CDB, which is shared by the primary and the standby.
+	CdbName string `protobuf:"bytes,1,opt,name=cdb_name,json=cdbName,proto3" json:"cdb_name,omitempty"`
+	// Unique name of the standby database.
+	DbUniqueName string `protobuf:"bytes,2,opt,name=db_unique_name,json=dbUniqueName,proto3" json:"db_unique_name,omitempty"`
+	// Easy connect strings (//host:port/service) of the primary database and
+	// of the standby instance, which must be reachable while in nomount.
+	PrimaryConnectString string `protobuf:"bytes,3,opt,name=primary_connect_string,json=primaryConnectString,proto3" json:"primary_connect_string,omitempty"`
+	StandbyConnectString string `protobuf:"bytes,4,opt,name=standby_connect_string,json=standbyConnectString,proto3" json:"standby_connect_string,omitempty"`
+	// SYS password of the primary database, the standby password file is
+	// created with it.
+	SysPassword string    `protobuf:"bytes,5,opt,name=sys_password,json=sysPassword,proto3" json:"sys_password,omitempty"`
+	LroInput    *LROInput `protobuf:"bytes,6,opt,name=lro_input,json=lroInput,proto3" json:"lro_input,omitempty"`
+}
+
+func (x *CreateStandbyRequest) Reset() {
+	*x = CreateStandbyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateStandbyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateStandbyRequest) ProtoMessage() {}
+
+func (x *CreateStandbyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateStandbyRequest.ProtoReflect.Descriptor instead.
+func (*CreateStandbyRequest) Descriptor() ([]byte, []int) {
+	return file_oracle_pkg_agents_config_agent_protos_service_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *CreateStandbyRequest) GetCdbName() string {
+	if x != nil {
+		return x.CdbName
+	}
+	return ""
+}
+
+func (x *CreateStandbyRequest) GetDbUniqueName() string {
+	if x != nil {
+		return x.DbUniqueName
+	}
+	return ""
+}
+
+func (x *CreateStandbyRequest) GetPrimaryConnectString() string {
+	if x != nil {
+		return x.PrimaryConnectString
+	}
+	return ""
+}
+
+func (x *CreateStandbyRequest) GetStandbyConnectString() string {
+	if x != nil {
+		return x.StandbyConnectString
+	}
+	return ""
+}
+
+func (x *CreateStandbyRequest) GetSysPassword() string {
+	if x != nil {
+		return x.SysPassword
+	}
+	return ""
+}
+
+func (x *CreateStandbyRequest) GetLroInput() *LROInput {
+	if x != nil {
+		return x.LroInput
+	}
+	return nil
+}
+
+type SetUpDataGuardRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Unique name and easy connect string of the other database of the
+	// Data Guard configuration.
+	PeerDbUniqueName  string `protobuf:"bytes,1,opt,name=peer_db_unique_name,json=peerDbUniqueName,proto3" json:"peer_db_unique_name,omitempty"`
+	PeerConnectString string `protobuf:"bytes,2,opt,name=peer_connect_string,json=peerConnectString,proto3" json:"peer_connect_string,omitempty"`
+	// Ship redo synchronously (SYNC AFFIRM) instead of asynchronously.
+	Sync bool `protobuf:"varint,3,opt,name=sync,proto3" json:"sync,omitempty"`
+}
+
+func (x *SetUpDataGuardRequest) Reset() {
+	*x = SetUpDataGuardRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetUpDataGuardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetUpDataGuardRequest) ProtoMessage() {}
+
+func (x *SetUpDataGuardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetUpDataGuardRequest.ProtoReflect.Descriptor instead.
+func (*SetUpDataGuardRequest) Descriptor() ([]byte, []int) {
+	return file_oracle_pkg_agents_config_agent_protos_service_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *SetUpDataGuardRequest) GetPeerDbUniqueName() string {
+	if x != nil {
+		return x.PeerDbUniqueName
+	}
+	return ""
+}
+
+func (x *SetUpDataGuardRequest) GetPeerConnectString() string {
+	if x != nil {
+		return x.PeerConnectString
+	}
+	return ""
+}
+
+func (x *SetUpDataGuardRequest) GetSync() bool {
+	if x != nil {
+		return x.Sync
+	}
+	return false
+}
+
+type SetUpDataGuardResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SetUpDataGuardResponse) Reset() {
+	*x = SetUpDataGuardResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetUpDataGuardResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetUpDataGuardResponse) ProtoMessage() {}
+
+func (x *SetUpDataGuardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetUpDataGuardResponse.ProtoReflect.Descriptor instead.
+func (*SetUpDataGuardResponse) Descriptor() ([]byte, []int) {
+	return file_oracle_pkg_agents_config_agent_protos_service_proto_rawDescGZIP(), []int{46}
+}
+
+type DataGuardStatusRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DataGuardStatusRequest) Reset() {
+	*x = DataGuardStatusRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DataGuardStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DataGuardStatusRequest) ProtoMessage() {}
+
+func (x *DataGuardStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DataGuardStatusRequest.ProtoReflect.Descriptor instead.
+func (*DataGuardStatusRequest) Descriptor() ([]byte, []int) {
+	return file_oracle_pkg_agents_config_agent_protos_service_proto_rawDescGZIP(), []int{47}
+}
+
+type DataGuardStatusResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DbUniqueName string `protobuf:"bytes,1,opt,name=db_unique_name,json=dbUniqueName,proto3" json:"db_unique_name,omitempty"`
+	// Role of the database as reported by v$database, e.g. PRIMARY or
+	// PHYSICAL STANDBY.
+	DatabaseRole string `protobuf:"bytes,2,opt,name=database_role,json=databaseRole,proto3" json:"database_role,omitempty"`
+	OpenMode     string `protobuf:"bytes,3,opt,name=open_mode,json=openMode,proto3" json:"open_mode,omitempty"`
+	// Lags in seconds, only reported for a standby database.
+	ApplyLagSeconds     int64 `protobuf:"varint,4,opt,name=apply_lag_seconds,json=applyLagSeconds,proto3" json:"apply_lag_seconds,omitempty"`
+	TransportLagSeconds int64 `protobuf:"varint,5,opt,name=transport_lag_seconds,json=transportLagSeconds,proto3" json:"transport_lag_seconds,omitempty"`
+	// Whether the managed recovery process is running.
+	ApplyRunning bool `protobuf:"varint,6,opt,name=apply_running,json=applyRunning,proto3" json:"apply_running,omitempty"`
+}
+
+func (x *DataGuardStatusResponse) Reset() {
+	*x = DataGuardStatusResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DataGuardStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DataGuardStatusResponse) ProtoMessage() {}
+
+func (x *DataGuardStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DataGuardStatusResponse.ProtoReflect.Descriptor instead.
+func (*DataGuardStatusResponse) Descriptor() ([]byte, []int) {
+	return file_oracle_pkg_agents_config_agent_protos_service_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *DataGuardStatusResponse) GetDbUniqueName() string {
+	if x != nil {
+		return x.DbUniqueName
+	}
+	return ""
+}
+
+func (x *DataGuardStatusResponse) GetDatabaseRole() string {
+	if x != nil {
+		return x.DatabaseRole
+	}
+	return ""
+}
+
+func (x *DataGuardStatusResponse) GetOpenMode() string {
+	if x != nil {
+		return x.OpenMode
+	}
+	return ""
+}
+
+func (x *DataGuardStatusResponse) GetApplyLagSeconds() int64 {
+	if x != nil {
+		return x.ApplyLagSeconds
+	}
+	return 0
+}
+
+func (x *DataGuardStatusResponse) GetTransportLagSeconds() int64 {
+	if x != nil {
+		return x.TransportLagSeconds
+	}
+	return 0
+}
+
+func (x *DataGuardStatusResponse) GetApplyRunning() bool {
+	if x != nil {
+		return x.ApplyRunning
+	}
+	return false
+}
+
+type SwitchoverRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Unique name of the standby database that becomes the primary.
+	TargetDbUniqueName string `protobuf:"bytes,1,opt,name=target_db_unique_name,json=targetDbUniqueName,proto3" json:"target_db_unique_name,omitempty"`
+	// Name of the local CDB, which is mounted as a standby afterwards.
+	CdbName string `protobuf:"bytes,2,opt,name=cdb_name,json=cdbName,proto3" json:"cdb_name,omitempty"`
+}
+
+func (x *SwitchoverRequest) Reset() {
+	*x = SwitchoverRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SwitchoverRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SwitchoverRequest) ProtoMessage() {}
+
+func (x *SwitchoverRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SwitchoverRequest.ProtoReflect.Descriptor instead.
+func (*SwitchoverRequest) Descriptor() ([]byte, []int) {
+	return file_oracle_pkg_agents_config_agent_protos_service_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *SwitchoverRequest) GetTargetDbUniqueName() string {
+	if x != nil {
+		return x.TargetDbUniqueName
+	}
+	return ""
+}
+
+func (x *SwitchoverRequest) GetCdbName() string {
+	if x != nil {
+		return x.CdbName
+	}
+	return ""
+}
+
+type SwitchoverResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SwitchoverResponse) Reset() {
+	*x = SwitchoverResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SwitchoverResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SwitchoverResponse) ProtoMessage() {}
+
+func (x *SwitchoverResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SwitchoverResponse.ProtoReflect.Descriptor instead.
+func (*SwitchoverResponse) Descriptor() ([]byte, []int) {
+	return file_oracle_pkg_agents_config_agent_protos_service_proto_rawDescGZIP(), []int{50}
+}
+
+type FailoverRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Unique name of the local standby database that becomes the primary.
+	TargetDbUniqueName string `protobuf:"bytes,1,opt,name=target_db_unique_name,json=targetDbUniqueName,proto3" json:"target_db_unique_name,omitempty"`
+}
+
+func (x *FailoverRequest) Reset() {
+	*x = FailoverRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FailoverRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FailoverRequest) ProtoMessage() {}
+
+func (x *FailoverRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FailoverRequest.ProtoReflect.Descriptor instead.
+func (*FailoverRequest) Descriptor() ([]byte, []int) {
+	return file_oracle_pkg_agents_config_agent_protos_service_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *FailoverRequest) GetTargetDbUniqueName() string {
+	if x != nil {
+		return x.TargetDbUniqueName
+	}
+	return ""
+}
+
+type FailoverResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *FailoverResponse) Reset() {
+	*x = FailoverResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FailoverResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FailoverResponse) ProtoMessage() {}
+
+func (x *FailoverResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FailoverResponse.ProtoReflect.Descriptor instead.
+func (*FailoverResponse) Descriptor() ([]byte, []int) {
+	return file_oracle_pkg_agents_config_agent_protos_service_proto_rawDescGZIP(), []int{52}
+}
+
+type FenceDatabaseRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name of the local CDB, which is shut down if it is a primary.
+	CdbName string `protobuf:"bytes,1,opt,name=cdb_name,json=cdbName,proto3" json:"cdb_name,omitempty"`
+}
+
+func (x *FenceDatabaseRequest) Reset() {
+	*x = FenceDatabaseRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FenceDatabaseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FenceDatabaseRequest) ProtoMessage() {}
+
+func (x *FenceDatabaseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FenceDatabaseRequest.ProtoReflect.Descriptor instead.
+func (*FenceDatabaseRequest) Descriptor() ([]byte, []int) {
+	return file_oracle_pkg_agents_config_agent_protos_service_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *FenceDatabaseRequest) GetCdbName() string {
+	if x != nil {
+		return x.CdbName
+	}
+	return ""
+}
+
+type FenceDatabaseResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *FenceDatabaseResponse) Reset() {
+	*x = FenceDatabaseResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FenceDatabaseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FenceDatabaseResponse) ProtoMessage() {}
+
+func (x *FenceDatabaseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FenceDatabaseResponse.ProtoReflect.Descriptor instead.
+func (*FenceDatabaseResponse) Descriptor() ([]byte, []int) {
+	return file_oracle_pkg_agents_config_agent_protos_service_proto_rawDescGZIP(), []int{54}
+}
+
+type VerifyBackupRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// RMAN tag of the backup pieces to verify, all the backup sets with the
+	// tag are validated.
+	BackupTag string `protobuf:"bytes,3,opt,name=backup_tag,json=backupTag,proto3" json:"backup_tag,omitempty"`
+	// DOP = degree of parallelism for the verification.
+	Dop int32 `protobuf:"varint,4,opt,name=dop,proto3" json:"dop,omitempty"`
+	// Storage location the backup pieces were uploaded to, if any.
+	GcsPath  string    `protobuf:"bytes,5,opt,name=gcs_path,json=gcsPath,proto3" json:"gcs_path,omitempty"`
+	LroInput *LROInput `protobuf:"bytes,6,opt,name=lro_input,json=lroInput,proto3" json:"lro_input,omitempty"`
+	// Credentials to access the storage locations of the request.
+	StorageCredentials map[string]string `protobuf:"bytes,7,rep,name=storage_credentials,json=storageCredentials,proto3" json:"storage_credentials,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *VerifyBackupRequest) Reset() {
+	*x = VerifyBackupRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VerifyBackupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyBackupRequest) ProtoMessage() {}
+
+func (x *VerifyBackupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyBackupRequest.ProtoReflect.Descriptor instead.
+func (*VerifyBackupRequest) Descriptor() ([]byte, []int) {
+	return file_oracle_pkg_agents_config_agent_protos_service_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *VerifyBackupRequest) GetBackupTag() string {
+	if x != nil {
+		return x.BackupTag
+	}
+	return ""
+}
+
+func (x *VerifyBackupRequest) GetDop() int32 {
+	if x != nil {
+		return x.Dop
+	}
+	return 0
+}
+
+func (x *VerifyBackupRequest) GetGcsPath() string {
+	if x != nil {
+		return x.GcsPath
+	}
+	return ""
+}
+
+func (x *VerifyBackupRequest) GetLroInput() *LROInput {
+	if x != nil {
+		return x.LroInput
+	}
+	return nil
+}
+
+func (x *VerifyBackupRequest) GetStorageCredentials() map[string]string {
+	if x != nil {
+		return x.StorageCredentials
+	}
+	return nil
+}
+
+type DescribeBackupRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BackupTag string `protobuf:"bytes,1,opt,name=backup_tag,json=backupTag,proto3" json:"backup_tag,omitempty"`
+}
+
+func (x *DescribeBackupRequest) Reset() {
+	*x = DescribeBackupRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DescribeBackupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DescribeBackupRequest) ProtoMessage() {}
+
+func (x *DescribeBackupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DescribeBackupRequest.ProtoReflect.Descriptor instead.
+func (*DescribeBackupRequest) Descriptor() ([]byte, []int) {
+	return file_oracle_pkg_agents_config_agent_protos_service_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *DescribeBackupRequest) GetBackupTag() string {
+	if x != nil {
+		return x.BackupTag
+	}
+	return ""
+}
+
+type DescribeBackupResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Range of SCNs the backup can be recovered to: from the highest
+	// checkpoint SCN of its datafiles up to the next SCN of the last archived
+	// redo log backed up with it.
+	MinScn int64 `protobuf:"varint,1,opt,name=min_scn,json=minScn,proto3" json:"min_scn,omitempty"`
+	MaxScn int64 `protobuf:"varint,2,opt,name=max_scn,json=maxScn,proto3" json:"max_scn,omitempty"`
+}
+
+func (x *DescribeBackupResponse) Reset() {
+	*x = DescribeBackupResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DescribeBackupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DescribeBackupResponse) ProtoMessage() {}
+
+func (x *DescribeBackupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DescribeBackupResponse.ProtoReflect.Descriptor instead.
+func (*DescribeBackupResponse) Descriptor() ([]byte, []int) {
+	return file_oracle_pkg_agents_config_agent_protos_service_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *DescribeBackupResponse) GetMinScn() int64 {
+	if x != nil {
+		return x.MinScn
+	}
+	return 0
+}
+
+func (x *DescribeBackupResponse) GetMaxScn() int64 {
+	if x != nil {
+		return x.MaxScn
+	}
+	return 0
+}
+
+type ChangeDatabaseIdentityRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name of the local CDB. The database is renamed to it if it has a
+	// different name, e.g. after it is restored from a backup of another CDB.
+	CdbName  string    `protobuf:"bytes,1,opt,name=cdb_name,json=cdbName,proto3" json:"cdb_name,omitempty"`
+	LroInput *LROInput `protobuf:"bytes,2,opt,name=lro_input,json=lroInput,proto3" json:"lro_input,omitempty"`
+}
+
+func (x *ChangeDatabaseIdentityRequest) Reset() {
+	*x = ChangeDatabaseIdentityRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChangeDatabaseIdentityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeDatabaseIdentityRequest) ProtoMessage() {}
+
+func (x *ChangeDatabaseIdentityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeDatabaseIdentityRequest.ProtoReflect.Descriptor instead.
+func (*ChangeDatabaseIdentityRequest) Descriptor() ([]byte, []int) {
+	return file_oracle_pkg_agents_config_agent_protos_service_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *ChangeDatabaseIdentityRequest) GetCdbName() string {
+	if x != nil {
+		return x.CdbName
+	}
+	return ""
+}
+
+func (x *ChangeDatabaseIdentityRequest) GetLroInput() *LROInput {
+	if x != nil {
+		return x.LroInput
+	}
+	return nil
+}
+
+type Tablespace struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Size of the datafile the tablespace is created with.
+	InitialSizeBytes int64 `protobuf:"varint,2,opt,name=initial_size_bytes,json=initialSizeBytes,proto3" json:"initial_size_bytes,omitempty"`
+	AutoExtend       bool  `protobuf:"varint,3,opt,name=auto_extend,json=autoExtend,proto3" json:"auto_extend,omitempty"`
+	// Size the datafiles may grow to, unlimited if 0. Ignored unless
+	// auto_extend is set.
+	MaxSizeBytes int64 `protobuf:"varint,4,opt,name=max_size_bytes,json=maxSizeBytes,proto3" json:"max_size_bytes,omitempty"`
+	Bigfile      bool  `protobuf:"varint,5,opt,name=bigfile,proto3" json:"bigfile,omitempty"`
+}
+
+func (x *Tablespace) Reset() {
+	*x = Tablespace{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Tablespace) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Tablespace) ProtoMessage() {}
+
+func (x *Tablespace) ProtoReflect() protoreflect.Message {
+	mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Tablespace.ProtoReflect.Descriptor instead.
+func (*Tablespace) Descriptor() ([]byte, []int) {
+	return file_oracle_pkg_agents_config_agent_protos_service_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *Tablespace) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Tablespace) GetInitialSizeBytes() int64 {
+	if x != nil {
+		return x.InitialSizeBytes
+	}
+	return 0
+}
+
+func (x *Tablespace) GetAutoExtend() bool {
+	if x != nil {
+		return x.AutoExtend
+	}
+	return false
+}
+
+func (x *Tablespace) GetMaxSizeBytes() int64 {
+	if x != nil {
+		return x.MaxSizeBytes
+	}
+	return 0
+}
+
+func (x *Tablespace) GetBigfile() bool {
+	if x != nil {
+		return x.Bigfile
+	}
+	return false
+}
+
+type SyncTablespacesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PdbName     string        `protobuf:"bytes,1,opt,name=pdb_name,json=pdbName,proto3" json:"pdb_name,omitempty"`
+	Tablespaces []*Tablespace `protobuf:"bytes,2,rep,name=tablespaces,proto3" json:"tablespaces,omitempty"`
+}
+
+func (x *SyncTablespacesRequest) Reset() {
+	*x = SyncTablespacesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SyncTablespacesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncTablespacesRequest) ProtoMessage() {}
+
+func (x *SyncTablespacesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncTablespacesRequest.ProtoReflect.Descriptor instead.
+func (*SyncTablespacesRequest) Descriptor() ([]byte, []int) {
+	return file_oracle_pkg_agents_config_agent_protos_service_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *SyncTablespacesRequest) GetPdbName() string {
+	if x != nil {
+		return x.PdbName
+	}
+	return ""
+}
+
+func (x *SyncTablespacesRequest) GetTablespaces() []*Tablespace {
+	if x != nil {
+		return x.Tablespaces
+	}
+	return nil
+}
+
+type SyncTablespacesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Usages []*SyncTablespacesResponse_Usage `protobuf:"bytes,1,rep,name=usages,proto3" json:"usages,omitempty"`
+}
+
+func (x *SyncTablespacesResponse) Reset() {
+	*x = SyncTablespacesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SyncTablespacesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncTablespacesResponse) ProtoMessage() {}
+
+func (x *SyncTablespacesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncTablespacesResponse.ProtoReflect.Descriptor instead.
+func (*SyncTablespacesResponse) Descriptor() ([]byte, []int) {
+	return file_oracle_pkg_agents_config_agent_protos_service_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *SyncTablespacesResponse) GetUsages() []*SyncTablespacesResponse_Usage {
+	if x != nil {
+		return x.Usages
+	}
+	return nil
+}
+
+type ApplyDatapatchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ApplyDatapatchRequest) Reset() {
+	*x = ApplyDatapatchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApplyDatapatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyDatapatchRequest) ProtoMessage() {}
+
+func (x *ApplyDatapatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyDatapatchRequest.ProtoReflect.Descriptor instead.
+func (*ApplyDatapatchRequest) Descriptor() ([]byte, []int) {
+	return file_oracle_pkg_agents_config_agent_protos_service_proto_rawDescGZIP(), []int{62}
+}
+
+type ApplyDatapatchResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Patches []*ApplyDatapatchResponse_SqlPatch `protobuf:"bytes,1,rep,name=patches,proto3" json:"patches,omitempty"`
+}
+
+func (x *ApplyDatapatchResponse) Reset() {
+	*x = ApplyDatapatchResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApplyDatapatchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyDatapatchResponse) ProtoMessage() {}
+
+func (x *ApplyDatapatchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyDatapatchResponse.ProtoReflect.Descriptor instead.
+func (*ApplyDatapatchResponse) Descriptor() ([]byte, []int) {
+	return file_oracle_pkg_agents_config_agent_protos_service_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *ApplyDatapatchResponse) GetPatches() []*ApplyDatapatchResponse_SqlPatch {
+	if x != nil {
+		return x.Patches
+	}
+	return nil
+}
+
+// SetMemoryTargetsRequest sets the SGA and PGA sizes of the database, which
+// take effect on the next restart.
+type SetMemoryTargetsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SgaTargetMb          int64 `protobuf:"varint,1,opt,name=sga_target_mb,json=sgaTargetMb,proto3" json:"sga_target_mb,omitempty"`
+	PgaAggregateTargetMb int64 `protobuf:"varint,2,opt,name=pga_aggregate_target_mb,json=pgaAggregateTargetMb,proto3" json:"pga_aggregate_target_mb,omitempty"`
+}
+
+func (x *SetMemoryTargetsRequest) Reset() {
+	*x = SetMemoryTargetsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetMemoryTargetsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetMemoryTargetsRequest) ProtoMessage() {}
+
+func (x *SetMemoryTargetsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetMemoryTargetsRequest.ProtoReflect.Descriptor instead.
+func (*SetMemoryTargetsRequest) Descriptor() ([]byte, []int) {
+	return file_oracle_pkg_agents_config_agent_protos_service_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *SetMemoryTargetsRequest) GetSgaTargetMb() int64 {
+	if x != nil {
+		return x.SgaTargetMb
+	}
+	return 0
+}
+
+func (x *SetMemoryTargetsRequest) GetPgaAggregateTargetMb() int64 {
+	if x != nil {
+		return x.PgaAggregateTargetMb
+	}
+	return 0
+}
+
+type SetMemoryTargetsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SetMemoryTargetsResponse) Reset() {
+	*x = SetMemoryTargetsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetMemoryTargetsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetMemoryTargetsResponse) ProtoMessage() {}
+
+func (x *SetMemoryTargetsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetMemoryTargetsResponse.ProtoReflect.Descriptor instead.
+func (*SetMemoryTargetsResponse) Descriptor() ([]byte, []int) {
+	return file_oracle_pkg_agents_config_agent_protos_service_proto_rawDescGZIP(), []int{65}
+}
+
+type Role struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// System privileges and roles granted to the role.
+	Privileges []string `protobuf:"bytes,2,rep,name=privileges,proto3" json:"privileges,omitempty"`
+	// The other object privileges of the role are revoked if any object
+	// privilege is specified.
+	ObjectPrivileges []*ObjectPrivilege `protobuf:"bytes,3,rep,name=object_privileges,json=objectPrivileges,proto3" json:"object_privileges,omitempty"`
+}
+
+func (x *Role) Reset() {
+	*x = Role{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Role) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Role) ProtoMessage() {}
+
+func (x *Role) ProtoReflect() protoreflect.Message {
+	mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Role.ProtoReflect.Descriptor instead.
+func (*Role) Descriptor() ([]byte, []int) {
+	return file_oracle_pkg_agents_config_agent_protos_service_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *Role) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Role) GetPrivileges() []string {
+	if x != nil {
+		return x.Privileges
+	}
+	return nil
+}
+
+func (x *Role) GetObjectPrivileges() []*ObjectPrivilege {
+	if x != nil {
+		return x.ObjectPrivileges
+	}
+	return nil
+}
+
+type SyncRolesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PdbName string  `protobuf:"bytes,1,opt,name=pdb_name,json=pdbName,proto3" json:"pdb_name,omitempty"`
+	Roles   []*Role `protobuf:"bytes,2,rep,name=roles,proto3" json:"roles,omitempty"`
+}
+
+func (x *SyncRolesRequest) Reset() {
+	*x = SyncRolesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SyncRolesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncRolesRequest) ProtoMessage() {}
+
+func (x *SyncRolesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncRolesRequest.ProtoReflect.Descriptor instead.
+func (*SyncRolesRequest) Descriptor() ([]byte, []int) {
+	return file_oracle_pkg_agents_config_agent_protos_service_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *SyncRolesRequest) GetPdbName() string {
+	if x != nil {
+		return x.PdbName
+	}
+	return ""
+}
+
+func (x *SyncRolesRequest) GetRoles() []*Role {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+type SyncRolesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SyncRolesResponse) Reset() {
+	*x = SyncRolesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SyncRolesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncRolesResponse) ProtoMessage() {}
+
+func (x *SyncRolesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncRolesResponse.ProtoReflect.Descriptor instead.
+func (*SyncRolesResponse) Descriptor() ([]byte, []int) {
+	return file_oracle_pkg_agents_config_agent_protos_service_proto_rawDescGZIP(), []int{68}
+}
+
+type Profile struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Limits by resource name, for example FAILED_LOGIN_ATTEMPTS. Resources
+	// which aren't listed are left as is.
+	Limits map[string]string `protobuf:"bytes,2,rep,name=limits,proto3" json:"limits,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *Profile) Reset() {
+	*x = Profile{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Profile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Profile) ProtoMessage() {}
+
+func (x *Profile) ProtoReflect() protoreflect.Message {
+	mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Profile.ProtoReflect.Descriptor instead.
+func (*Profile) Descriptor() ([]byte, []int) {
+	return file_oracle_pkg_agents_config_agent_protos_service_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *Profile) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Profile) GetLimits() map[string]string {
+	if x != nil {
+		return x.Limits
+	}
+	return nil
+}
+
+type SyncProfilesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PdbName  string     `protobuf:"bytes,1,opt,name=pdb_name,json=pdbName,proto3" json:"pdb_name,omitempty"`
+	Profiles []*Profile `protobuf:"bytes,2,rep,name=profiles,proto3" json:"profiles,omitempty"`
+}
+
+func (x *SyncProfilesRequest) Reset() {
+	*x = SyncProfilesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SyncProfilesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncProfilesRequest) ProtoMessage() {}
+
+func (x *SyncProfilesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncProfilesRequest.ProtoReflect.Descriptor instead.
+func (*SyncProfilesRequest) Descriptor() ([]byte, []int) {
+	return file_oracle_pkg_agents_config_agent_protos_service_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *SyncProfilesRequest) GetPdbName() string {
+	if x != nil {
+		return x.PdbName
+	}
+	return ""
+}
+
+func (x *SyncProfilesRequest) GetProfiles() []*Profile {
+	if x != nil {
+		return x.Profiles
+	}
+	return nil
+}
+
+type SyncProfilesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SyncProfilesResponse) Reset() {
+	*x = SyncProfilesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SyncProfilesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncProfilesResponse) ProtoMessage() {}
+
+func (x *SyncProfilesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncProfilesResponse.ProtoReflect.Descriptor instead.
+func (*SyncProfilesResponse) Descriptor() ([]byte, []int) {
+	return file_oracle_pkg_agents_config_agent_protos_service_proto_rawDescGZIP(), []int{71}
+}
+
+type GetAuditLogHeadRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetAuditLogHeadRequest) Reset() {
+	*x = GetAuditLogHeadRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAuditLogHeadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAuditLogHeadRequest) ProtoMessage() {}
+
+func (x *GetAuditLogHeadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAuditLogHeadRequest.ProtoReflect.Descriptor instead.
+func (*GetAuditLogHeadRequest) Descriptor() ([]byte, []int) {
+	return file_oracle_pkg_agents_config_agent_protos_service_proto_rawDescGZIP(), []int{72}
+}
+
+type GetAuditLogHeadResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Seq  int64  `protobuf:"varint,1,opt,name=seq,proto3" json:"seq,omitempty"`
+	Hash string `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+}
+
+func (x *GetAuditLogHeadResponse) Reset() {
+	*x = GetAuditLogHeadResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAuditLogHeadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAuditLogHeadResponse) ProtoMessage() {}
+
+func (x *GetAuditLogHeadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAuditLogHeadResponse.ProtoReflect.Descriptor instead.
+func (*GetAuditLogHeadResponse) Descriptor() ([]byte, []int) {
+	return file_oracle_pkg_agents_config_agent_protos_service_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *GetAuditLogHeadResponse) GetSeq() int64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *GetAuditLogHeadResponse) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+// Suppressed describes user creates/updates which will be suppressed in the
+// current release.
+type UsersChangedResponse_Suppressed struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SuppressType UsersChangedResponse_Type `protobuf:"varint,1,opt,name=suppress_type,json=suppressType,proto3,enum=protos.UsersChangedResponse_Type" json:"suppress_type,omitempty"`
+	UserName     string                    `protobuf:"bytes,2,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
+	// sql is the suppressed cmd which can update the user to the spec defined
+	// state
+	Sql string `protobuf:"bytes,3,opt,name=sql,proto3" json:"sql,omitempty"`
+}
+
+func (x *UsersChangedResponse_Suppressed) Reset() {
+	*x = UsersChangedResponse_Suppressed{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UsersChangedResponse_Suppressed) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UsersChangedResponse_Suppressed) ProtoMessage() {}
+
+func (x *UsersChangedResponse_Suppressed) ProtoReflect() protoreflect.Message {
+	mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UsersChangedResponse_Suppressed.ProtoReflect.Descriptor instead.
+func (*UsersChangedResponse_Suppressed) Descriptor() ([]byte, []int) {
+	return file_oracle_pkg_agents_config_agent_protos_service_proto_rawDescGZIP(), []int{15, 0}
+}
+
+func (x *UsersChangedResponse_Suppressed) GetSuppressType() UsersChangedResponse_Type {
+	if x != nil {
+		return x.SuppressType
+	}
+	return UsersChangedResponse_UNKNOWN_TYPE
+}
+
+func (x *UsersChangedResponse_Suppressed) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *UsersChangedResponse_Suppressed) GetSql() string {
+	if x != nil {
+		return x.Sql
+	}
+	return ""
+}
+
+type BootstrapStandbyResponse_User struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserName string   `protobuf:"bytes,1,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
+	Privs    []string `protobuf:"bytes,2,rep,name=privs,proto3" json:"privs,omitempty"`
+}
+
+func (x *BootstrapStandbyResponse_User) Reset() {
+	*x = BootstrapStandbyResponse_User{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BootstrapStandbyResponse_User) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BootstrapStandbyResponse_User) ProtoMessage() {}
+
+func (x *BootstrapStandbyResponse_User) ProtoReflect() protoreflect.Message {
+	mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BootstrapStandbyResponse_User.ProtoReflect.Descriptor instead.
+func (*BootstrapStandbyResponse_User) Descriptor() ([]byte, []int) {
+	return file_oracle_pkg_agents_config_agent_protos_service_proto_rawDescGZIP(), []int{28, 0}
+}
+
+func (x *BootstrapStandbyResponse_User) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *BootstrapStandbyResponse_User) GetPrivs() []string {
+	if x != nil {
+		return x.Privs
+	}
+	return nil
+}
+
+type BootstrapStandbyResponse_PDB struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PdbName string                           `protobuf:"bytes,1,opt,name=pdb_name,json=pdbName,proto3" json:"pdb_name,omitempty"`
+	Users   []*BootstrapStandbyResponse_User `protobuf:"bytes,2,rep,name=users,proto3" json:"users,omitempty"`
+}
+
+func (x *BootstrapStandbyResponse_PDB) Reset() {
+	*x = BootstrapStandbyResponse_PDB{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BootstrapStandbyResponse_PDB) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BootstrapStandbyResponse_PDB) ProtoMessage() {}
+
+func (x *BootstrapStandbyResponse_PDB) ProtoReflect() protoreflect.Message {
+	mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BootstrapStandbyResponse_PDB.ProtoReflect.Descriptor instead.
+func (*BootstrapStandbyResponse_PDB) Descriptor() ([]byte, []int) {
+	return file_oracle_pkg_agents_config_agent_protos_service_proto_rawDescGZIP(), []int{28, 1}
+}
+
+func (x *BootstrapStandbyResponse_PDB) GetPdbName() string {
+	if x != nil {
+		return x.PdbName
+	}
+	return ""
+}
+
+func (x *BootstrapStandbyResponse_PDB) GetUsers() []*BootstrapStandbyResponse_User {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+type SyncTablespacesResponse_Usage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Allocated size of the datafiles of the tablespace.
+	SizeBytes int64 `protobuf:"varint,2,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	UsedBytes int64 `protobuf:"varint,3,opt,name=used_bytes,json=usedBytes,proto3" json:"used_bytes,omitempty"`
+	// Size the datafiles may grow to.
+	MaxSizeBytes int64 `protobuf:"varint,4,opt,name=max_size_bytes,json=maxSizeBytes,proto3" json:"max_size_bytes,omitempty"`
+}
+
+func (x *SyncTablespacesResponse_Usage) Reset() {
+	*x = SyncTablespacesResponse_Usage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[86]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SyncTablespacesResponse_Usage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncTablespacesResponse_Usage) ProtoMessage() {}
+
+func (x *SyncTablespacesResponse_Usage) ProtoReflect() protoreflect.Message {
+	mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[86]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncTablespacesResponse_Usage.ProtoReflect.Descriptor instead.
+func (*SyncTablespacesResponse_Usage) Descriptor() ([]byte, []int) {
+	return file_oracle_pkg_agents_config_agent_protos_service_proto_rawDescGZIP(), []int{61, 0}
+}
+
+func (x *SyncTablespacesResponse_Usage) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SyncTablespacesResponse_Usage) GetSizeBytes() int64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+func (x *SyncTablespacesResponse_Usage) GetUsedBytes() int64 {
+	if x != nil {
+		return x.UsedBytes
+	}
+	return 0
+}
+
+func (x *SyncTablespacesResponse_Usage) GetMaxSizeBytes() int64 {
+	if x != nil {
+		return x.MaxSizeBytes
+	}
+	return 0
+}
+
+// SqlPatch describes the last action on a patch in dba_registry_sqlpatch.
+type ApplyDatapatchResponse_SqlPatch struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PatchId     int64  `protobuf:"varint,1,opt,name=patch_id,json=patchId,proto3" json:"patch_id,omitempty"`
+	Action      string `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"`
+	Status      string `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	Description string `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+}
+
+func (x *ApplyDatapatchResponse_SqlPatch) Reset() {
+	*x = ApplyDatapatchResponse_SqlPatch{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[87]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApplyDatapatchResponse_SqlPatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyDatapatchResponse_SqlPatch) ProtoMessage() {}
+
+func (x *ApplyDatapatchResponse_SqlPatch) ProtoReflect() protoreflect.Message {
+	mi := &file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[87]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyDatapatchResponse_SqlPatch.ProtoReflect.Descriptor instead.
+func (*ApplyDatapatchResponse_SqlPatch) Descriptor() ([]byte, []int) {
+	return file_oracle_pkg_agents_config_agent_protos_service_proto_rawDescGZIP(), []int{63, 0}
+}
+
+func (x *ApplyDatapatchResponse_SqlPatch) GetPatchId() int64 {
+	if x != nil {
+		return x.PatchId
+	}
+	return 0
+}
+
+func (x *ApplyDatapatchResponse_SqlPatch) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *ApplyDatapatchResponse_SqlPatch) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ApplyDatapatchResponse_SqlPatch) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+var File_oracle_pkg_agents_config_agent_protos_service_proto protoreflect.FileDescriptor
+
+var file_oracle_pkg_agents_config_agent_protos_service_proto_rawDesc = []byte{
+	0x0a, 0x33, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x67, 0x65,
+	0x6e, 0x74, 0x73, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x61, 0x67, 0x65, 0x6e, 0x74,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x1a, 0x23, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x6c, 0x6f, 0x6e, 0x67, 0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e,
+	0x67, 0x2f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x22, 0xca, 0x02, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x44, 0x42, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5f,
+	0x68, 0x6f, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6f, 0x72, 0x61, 0x63,
+	0x6c, 0x65, 0x48, 0x6f, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x69, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x64, 0x62, 0x5f, 0x75,
+	0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x64, 0x62, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x23,
+	0x0a, 0x0d, 0x63, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72,
+	0x53, 0x65, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x5f, 0x70, 0x65,
+	0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x6d, 0x65, 0x6d,
+	0x6f, 0x72, 0x79, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x2b, 0x0a, 0x11, 0x61, 0x64,
+	0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18,
+	0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61,
+	0x6c, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x62, 0x5f, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x62, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x12, 0x2d,
+	0x0a, 0x09, 0x6c, 0x72, 0x6f, 0x5f, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x4c, 0x52, 0x4f, 0x49, 0x6e,
+	0x70, 0x75, 0x74, 0x52, 0x08, 0x6c, 0x72, 0x6f, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x22, 0x99, 0x01,
+	0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70,
+	0x6f, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12,
+	0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x1f, 0x0a, 0x0b, 0x6f,
+	0x72, 0x61, 0x63, 0x6c, 0x65, 0x5f, 0x68, 0x6f, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x48, 0x6f, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09,
+	0x64, 0x62, 0x5f, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x64, 0x62, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x22, 0x18, 0x0a, 0x16, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x8d, 0x01, 0x0a, 0x12, 0x47, 0x73, 0x6d, 0x53, 0x65, 0x63, 0x72, 0x65,
+	0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72,
+	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x65, 0x63,
+	0x72, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65,
+	0x63, 0x72, 0x65, 0x74, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x21, 0x0a, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x56, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x22, 0x69, 0x0a, 0x0e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x50, 0x61, 0x73,
+	0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
+	0x64, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x6c,
+	0x61, 0x73, 0x74, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xce,
+	0x02, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x64, 0x62, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x64, 0x62, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77,
+	0x6f, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77,
+	0x6f, 0x72, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x62, 0x5f, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x62, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e,
+	0x12, 0x5c, 0x0a, 0x1d, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f,
+	0x72, 0x64, 0x5f, 0x67, 0x73, 0x6d, 0x5f, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x5f, 0x72, 0x65,
+	0x66, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73,
+	0x2e, 0x47, 0x73, 0x6d, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x52, 0x19, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f,
+	0x72, 0x64, 0x47, 0x73, 0x6d, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x66, 0x12, 0x23,
+	0x0a, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77,
+	0x6f, 0x72, 0x64, 0x12, 0x4a, 0x0a, 0x15, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x70, 0x61, 0x73,
+	0x73, 0x77, 0x6f, 0x72, 0x64, 0x5f, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x53, 0x65, 0x63, 0x72,
+	0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x13, 0x61, 0x64, 0x6d, 0x69,
+	0x6e, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x22,
+	0x55, 0x0a, 0x16, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xdb, 0x01, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a,
+	0x08, 0x63, 0x64, 0x62, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x63, 0x64, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x64, 0x62, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x64, 0x62, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x75, 0x73,
+	0x65, 0x72, 0x73, 0x5f, 0x63, 0x6d, 0x64, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x73, 0x43, 0x6d, 0x64, 0x12, 0x26, 0x0a,
+	0x0f, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x5f, 0x70, 0x72, 0x69, 0x76, 0x73, 0x5f, 0x63, 0x6d, 0x64,
+	0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x50, 0x72, 0x69,
+	0x76, 0x73, 0x43, 0x6d, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x62, 0x5f, 0x64, 0x6f, 0x6d, 0x61,
+	0x69, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x62, 0x44, 0x6f, 0x6d, 0x61,
+	0x69, 0x6e, 0x12, 0x20, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x0c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04,
+	0x75, 0x73, 0x65, 0x72, 0x22, 0x2d, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73,
+	0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x22, 0x83, 0x01, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x44,
+	0x42, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08,
+	0x63, 0x64, 0x62, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x63, 0x64, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x5f, 0x63, 0x6d, 0x64, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x0e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x73, 0x43, 0x6d,
+	0x64, 0x12, 0x26, 0x0a, 0x0f, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x5f, 0x70, 0x72, 0x69, 0x76, 0x73,
+	0x5f, 0x63, 0x6d, 0x64, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x67, 0x72, 0x61, 0x6e,
+	0x74, 0x50, 0x72, 0x69, 0x76, 0x73, 0x43, 0x6d, 0x64, 0x22, 0x54, 0x0a, 0x15, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x43, 0x44, 0x42, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22,
+	0xc7, 0x03, 0x0a, 0x04, 0x55, 0x73, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08,
+	0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x72, 0x69, 0x76,
+	0x69, 0x6c, 0x65, 0x67, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x72,
+	0x69, 0x76, 0x69, 0x6c, 0x65, 0x67, 0x65, 0x73, 0x12, 0x51, 0x0a, 0x17, 0x70, 0x61, 0x73, 0x73,
+	0x77, 0x6f, 0x72, 0x64, 0x5f, 0x67, 0x73, 0x6d, 0x5f, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x5f,
+	0x72, 0x65, 0x66, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x73, 0x2e, 0x47, 0x73, 0x6d, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x14, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x47,
+	0x73, 0x6d, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x66, 0x12, 0x23, 0x0a, 0x0d, 0x6c,
+	0x61, 0x73, 0x74, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
+	0x12, 0x2f, 0x0a, 0x06, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x52, 0x06, 0x71, 0x75, 0x6f, 0x74, 0x61,
+	0x73, 0x12, 0x3f, 0x0a, 0x0f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x5f, 0x73, 0x65,
+	0x63, 0x72, 0x65, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x73, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f,
+	0x72, 0x64, 0x52, 0x0e, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x53, 0x65, 0x63, 0x72,
+	0x65, 0x74, 0x12, 0x44, 0x0a, 0x11, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x70, 0x72, 0x69,
+	0x76, 0x69, 0x6c, 0x65, 0x67, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x50, 0x72, 0x69,
+	0x76, 0x69, 0x6c, 0x65, 0x67, 0x65, 0x52, 0x10, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x50, 0x72,
+	0x69, 0x76, 0x69, 0x6c, 0x65, 0x67, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x66,
+	0x69, 0x6c, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69,
+	0x6c, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x5f, 0x0a, 0x0f, 0x4f, 0x62, 0x6a,
+	0x65, 0x63, 0x74, 0x50, 0x72, 0x69, 0x76, 0x69, 0x6c, 0x65, 0x67, 0x65, 0x12, 0x1c, 0x0a, 0x09,
+	0x70, 0x72, 0x69, 0x76, 0x69, 0x6c, 0x65, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x70, 0x72, 0x69, 0x76, 0x69, 0x6c, 0x65, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x63,
+	0x68, 0x65, 0x6d, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x63, 0x68, 0x65,
+	0x6d, 0x61, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x6c, 0x0a, 0x0f, 0x54, 0x61,
+	0x62, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x12, 0x1e, 0x0a,
+	0x0a, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x1b, 0x0a,
+	0x09, 0x6d, 0x61, 0x78, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x08, 0x6d, 0x61, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x6e,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x75,
+	0x6e, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x64, 0x22, 0x9b, 0x02, 0x0a, 0x13, 0x55, 0x73, 0x65,
+	0x72, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x19, 0x0a, 0x08, 0x70, 0x64, 0x62, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x70, 0x64, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2b, 0x0a, 0x0a, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x73, 0x70, 0x65, 0x63, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x0c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x09, 0x75,
+	0x73, 0x65, 0x72, 0x53, 0x70, 0x65, 0x63, 0x73, 0x12, 0x53, 0x0a, 0x0f, 0x64, 0x65, 0x6c, 0x65,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x2a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x73,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x0e, 0x64,
+	0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x23, 0x0a,
+	0x0d, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x64, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x64, 0x55, 0x73, 0x65,
+	0x72, 0x73, 0x22, 0x42, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x12, 0x0a, 0x0a, 0x06, 0x52, 0x45, 0x54, 0x41, 0x49, 0x4e, 0x10, 0x00,
+	0x12, 0x08, 0x0a, 0x04, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x44, 0x52,
+	0x4f, 0x50, 0x10, 0x02, 0x12, 0x10, 0x0a, 0x0c, 0x44, 0x52, 0x4f, 0x50, 0x5f, 0x43, 0x41, 0x53,
+	0x43, 0x41, 0x44, 0x45, 0x10, 0x03, 0x22, 0xd3, 0x03, 0x0a, 0x14, 0x55, 0x73, 0x65, 0x72, 0x73,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x12, 0x47, 0x0a, 0x0a, 0x73, 0x75, 0x70,
+	0x70, 0x72, 0x65, 0x73, 0x73, 0x65, 0x64, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x73, 0x43, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x75, 0x70, 0x70,
+	0x72, 0x65, 0x73, 0x73, 0x65, 0x64, 0x52, 0x0a, 0x73, 0x75, 0x70, 0x70, 0x72, 0x65, 0x73, 0x73,
+	0x65, 0x64, 0x12, 0x5c, 0x0a, 0x10, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x0f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73,
+	0x1a, 0x83, 0x01, 0x0a, 0x0a, 0x53, 0x75, 0x70, 0x70, 0x72, 0x65, 0x73, 0x73, 0x65, 0x64, 0x12,
+	0x46, 0x0a, 0x0d, 0x73, 0x75, 0x70, 0x70, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e,
+	0x55, 0x73, 0x65, 0x72, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0c, 0x73, 0x75, 0x70, 0x70, 0x72,
+	0x65, 0x73, 0x73, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x71, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x73, 0x71, 0x6c, 0x1a, 0x42, 0x0a, 0x14, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x30, 0x0a, 0x04, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x10, 0x0a, 0x0c, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x54, 0x59,
+	0x50, 0x45, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x10, 0x01,
+	0x12, 0x0a, 0x0a, 0x06, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x10, 0x02, 0x22, 0xd6, 0x01, 0x0a,
+	0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x64, 0x62, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x64, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2b,
+	0x0a, 0x0a, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x73, 0x70, 0x65, 0x63, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x55, 0x73, 0x65, 0x72,
+	0x52, 0x09, 0x75, 0x73, 0x65, 0x72, 0x53, 0x70, 0x65, 0x63, 0x73, 0x12, 0x53, 0x0a, 0x0f, 0x64,
+	0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x2a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x55, 0x73,
+	0x65, 0x72, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x52, 0x0e, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x64, 0x5f, 0x75, 0x73, 0x65, 0x72,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x64,
+	0x55, 0x73, 0x65, 0x72, 0x73, 0x22, 0xfe, 0x01, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5b, 0x0a,
+	0x10, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73,
+	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0f, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x72,
+	0x6f, 0x70, 0x70, 0x65, 0x64, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x0c, 0x64, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12,
+	0x21, 0x0a, 0x0c, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x55, 0x73, 0x65,
+	0x72, 0x73, 0x1a, 0x42, 0x0a, 0x14, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xb6, 0x06, 0x0a, 0x15, 0x50, 0x68, 0x79, 0x73, 0x69,
+	0x63, 0x61, 0x6c, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x4a, 0x0a, 0x0f, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x5f, 0x73, 0x75, 0x62, 0x5f, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x73, 0x2e, 0x50, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x42, 0x61, 0x63, 0x6b, 0x75,
+	0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0d, 0x62,
+	0x61, 0x63, 0x6b, 0x75, 0x70, 0x53, 0x75, 0x62, 0x54, 0x79, 0x70, 0x65, 0x12, 0x21, 0x0a, 0x0c,
+	0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0b, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x12,
+	0x1c, 0x0a, 0x09, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x09, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x73, 0x65, 0x74, 0x12, 0x1e, 0x0a,
+	0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x65, 0x64, 0x12, 0x23, 0x0a,
+	0x0d, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x5f, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x61, 0x6c, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x4c, 0x6f, 0x67, 0x69, 0x63,
+	0x61, 0x6c, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x6f, 0x70, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x03, 0x64, 0x6f, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x20, 0x0a, 0x0b, 0x66, 0x69,
+	0x6c, 0x65, 0x73, 0x70, 0x65, 0x72, 0x73, 0x65, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0b, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x70, 0x65, 0x72, 0x73, 0x65, 0x74, 0x12, 0x21, 0x0a, 0x0c,
+	0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0b, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x69, 0x7a, 0x65, 0x12,
+	0x1d, 0x0a, 0x0a, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x50, 0x61, 0x74, 0x68, 0x12, 0x19,
+	0x0a, 0x08, 0x67, 0x63, 0x73, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x67, 0x63, 0x73, 0x50, 0x61, 0x74, 0x68, 0x12, 0x2d, 0x0a, 0x09, 0x6c, 0x72, 0x6f,
+	0x5f, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x4c, 0x52, 0x4f, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x52, 0x08,
+	0x6c, 0x72, 0x6f, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x66, 0x0a, 0x13, 0x73, 0x74, 0x6f, 0x72,
+	0x61, 0x67, 0x65, 0x5f, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x18,
+	0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x50,
+	0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x43, 0x72, 0x65, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x12, 0x73, 0x74,
+	0x6f, 0x72, 0x61, 0x67, 0x65, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73,
+	0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x5f, 0x74, 0x61, 0x67, 0x18, 0x0e,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x54, 0x61, 0x67, 0x12,
+	0x1e, 0x0a, 0x0a, 0x63, 0x75, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x18, 0x0f, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0a, 0x63, 0x75, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x12,
+	0x33, 0x0a, 0x15, 0x69, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x6c, 0x79,
+	0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x10, 0x20, 0x01, 0x28, 0x08, 0x52, 0x14,
+	0x69, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x64, 0x1a, 0x45, 0x0a, 0x17, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x43,
+	0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x52, 0x0a, 0x04, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x10, 0x0a, 0x0c, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x54,
+	0x59, 0x50, 0x45, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x49, 0x4e, 0x53, 0x54, 0x41, 0x4e, 0x43,
+	0x45, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x44, 0x41, 0x54, 0x41, 0x42, 0x41, 0x53, 0x45, 0x10,
+	0x02, 0x12, 0x0e, 0x0a, 0x0a, 0x54, 0x41, 0x42, 0x4c, 0x45, 0x53, 0x50, 0x41, 0x43, 0x45, 0x10,
+	0x03, 0x12, 0x0c, 0x0a, 0x08, 0x44, 0x41, 0x54, 0x41, 0x46, 0x49, 0x4c, 0x45, 0x10, 0x04, 0x22,
+	0xf4, 0x04, 0x0a, 0x16, 0x50, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x74,
+	0x6f, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x6e,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x19, 0x0a, 0x08, 0x63, 0x64, 0x62, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x63, 0x64, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x6f,
+	0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x64, 0x6f, 0x70, 0x12, 0x1d, 0x0a, 0x0a,
+	0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x50, 0x61, 0x74, 0x68, 0x12, 0x19, 0x0a, 0x08, 0x67,
+	0x63, 0x73, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67,
+	0x63, 0x73, 0x50, 0x61, 0x74, 0x68, 0x12, 0x2d, 0x0a, 0x09, 0x6c, 0x72, 0x6f, 0x5f, 0x69, 0x6e,
+	0x70, 0x75, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x73, 0x2e, 0x4c, 0x52, 0x4f, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x52, 0x08, 0x6c, 0x72, 0x6f,
+	0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x54, 0x69, 0x6d, 0x65,
+	0x12, 0x1b, 0x0a, 0x09, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x5f, 0x73, 0x63, 0x6e, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x08, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x53, 0x63, 0x6e, 0x12, 0x2e, 0x0a,
+	0x13, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x5f, 0x72, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x5f, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x75, 0x6e, 0x74, 0x69,
+	0x6c, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x67, 0x0a,
+	0x13, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x61, 0x6c, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x73, 0x2e, 0x50, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x74,
+	0x6f, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61,
+	0x67, 0x65, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x12, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x43, 0x72, 0x65, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x5f, 0x63, 0x64, 0x62, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x64, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x19,
+	0x0a, 0x08, 0x70, 0x64, 0x62, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x70, 0x64, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x24, 0x0a, 0x0e, 0x73, 0x77, 0x69,
+	0x74, 0x63, 0x68, 0x5f, 0x74, 0x6f, 0x5f, 0x63, 0x6f, 0x70, 0x79, 0x18, 0x0d, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0c, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x54, 0x6f, 0x43, 0x6f, 0x70, 0x79, 0x1a,
+	0x45, 0x0a, 0x17, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x61, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xd5, 0x01, 0x0a, 0x12, 0x43, 0x68, 0x65, 0x63, 0x6b,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x64, 0x62, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x64, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x4b, 0x0a, 0x11,
+	0x63, 0x68, 0x65, 0x63, 0x6b, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73,
+	0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x62, 0x5f,
+	0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x62,
+	0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x22, 0x26, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x10,
+	0x0a, 0x0c, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x10, 0x00,
+	0x12, 0x0c, 0x0a, 0x08, 0x49, 0x4e, 0x53, 0x54, 0x41, 0x4e, 0x43, 0x45, 0x10, 0x01, 0x22, 0x52,
+	0x0a, 0x13, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x23, 0x0a,
+	0x0d, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x22, 0xea, 0x02, 0x0a, 0x15, 0x44, 0x61, 0x74, 0x61, 0x50, 0x75, 0x6d, 0x70, 0x49,
+	0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08,
+	0x70, 0x64, 0x62, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x70, 0x64, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x62, 0x5f, 0x64, 0x6f,
+	0x6d, 0x61, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x62, 0x44, 0x6f,
+	0x6d, 0x61, 0x69, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x63, 0x73, 0x5f, 0x70, 0x61, 0x74, 0x68,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x63, 0x73, 0x50, 0x61, 0x74, 0x68, 0x12,
+	0x20, 0x0a, 0x0c, 0x67, 0x63, 0x73, 0x5f, 0x6c, 0x6f, 0x67, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x67, 0x63, 0x73, 0x4c, 0x6f, 0x67, 0x50, 0x61, 0x74,
+	0x68, 0x12, 0x2d, 0x0a, 0x09, 0x6c, 0x72, 0x6f, 0x5f, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x4c, 0x52,
+	0x4f, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x52, 0x08, 0x6c, 0x72, 0x6f, 0x49, 0x6e, 0x70, 0x75, 0x74,
+	0x12, 0x66, 0x0a, 0x13, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x63, 0x72, 0x65, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x35, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x50, 0x75, 0x6d, 0x70, 0x49,
+	0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x74, 0x6f,
+	0x72, 0x61, 0x67, 0x65, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x12, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x43, 0x72, 0x65,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x1a, 0x45, 0x0a, 0x17, 0x53, 0x74, 0x6f, 0x72,
+	0x61, 0x67, 0x65, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22,
+	0xcc, 0x03, 0x0a, 0x15, 0x44, 0x61, 0x74, 0x61, 0x50, 0x75, 0x6d, 0x70, 0x45, 0x78, 0x70, 0x6f,
+	0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x64, 0x62,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x64, 0x62,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x62, 0x5f, 0x64, 0x6f, 0x6d, 0x61, 0x69,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x62, 0x44, 0x6f, 0x6d, 0x61, 0x69,
+	0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x12, 0x19, 0x0a, 0x08,
+	0x67, 0x63, 0x73, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x67, 0x63, 0x73, 0x50, 0x61, 0x74, 0x68, 0x12, 0x20, 0x0a, 0x0c, 0x67, 0x63, 0x73, 0x5f, 0x6c,
+	0x6f, 0x67, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x67,
+	0x63, 0x73, 0x4c, 0x6f, 0x67, 0x50, 0x61, 0x74, 0x68, 0x12, 0x2d, 0x0a, 0x09, 0x6c, 0x72, 0x6f,
+	0x5f, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x4c, 0x52, 0x4f, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x52, 0x08,
+	0x6c, 0x72, 0x6f, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x66, 0x6c, 0x61, 0x73,
+	0x68, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x66, 0x6c, 0x61, 0x73, 0x68, 0x62, 0x61, 0x63, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x12,
+	0x66, 0x0a, 0x13, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x63, 0x72, 0x65, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x50, 0x75, 0x6d, 0x70, 0x45, 0x78,
+	0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x74, 0x6f, 0x72,
+	0x61, 0x67, 0x65, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x12, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x43, 0x72, 0x65, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x1a, 0x45, 0x0a, 0x17, 0x53, 0x74, 0x6f, 0x72, 0x61,
+	0x67, 0x65, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x2d,
+	0x0a, 0x08, 0x4c, 0x52, 0x4f, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x6f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0xa5, 0x01,
+	0x0a, 0x18, 0x42, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x44, 0x61, 0x74, 0x61, 0x62,
+	0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x64,
+	0x62, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x64,
+	0x62, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x12, 0x0a, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68,
+	0x6f, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0e, 0x64, 0x62, 0x5f, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x64, 0x62, 0x55,
+	0x6e, 0x69, 0x71, 0x75, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x62, 0x64,
+	0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x62, 0x64,
+	0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x22, 0x1b, 0x0a, 0x19, 0x42, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72,
+	0x61, 0x70, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x6a, 0x0a, 0x17, 0x42, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x53,
+	0x74, 0x61, 0x6e, 0x64, 0x62, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a,
+	0x08, 0x63, 0x64, 0x62, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x63, 0x64, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x62, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x62, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x22, 0xee,
+	0x01, 0x0a, 0x18, 0x42, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x53, 0x74, 0x61, 0x6e,
+	0x64, 0x62, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x04, 0x70,
+	0x64, 0x62, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x73, 0x2e, 0x42, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x53, 0x74, 0x61, 0x6e,
+	0x64, 0x62, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x50, 0x44, 0x42, 0x52,
+	0x04, 0x70, 0x64, 0x62, 0x73, 0x1a, 0x39, 0x0a, 0x04, 0x55, 0x73, 0x65, 0x72, 0x12, 0x1b, 0x0a,
+	0x09, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72,
+	0x69, 0x76, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x70, 0x72, 0x69, 0x76, 0x73,
+	0x1a, 0x5d, 0x0a, 0x03, 0x50, 0x44, 0x42, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x64, 0x62, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x64, 0x62, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x3b, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x25, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x42, 0x6f, 0x6f, 0x74, 0x73,
+	0x74, 0x72, 0x61, 0x70, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x62, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x22,
+	0xa2, 0x01, 0x0a, 0x13, 0x53, 0x65, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12,
+	0x34, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x53, 0x65, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65,
+	0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x2d, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a,
+	0x07, 0x44, 0x59, 0x4e, 0x41, 0x4d, 0x49, 0x43, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x54,
+	0x41, 0x54, 0x49, 0x43, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x44, 0x45, 0x46, 0x45, 0x52, 0x52,
+	0x45, 0x44, 0x10, 0x02, 0x22, 0x2e, 0x0a, 0x14, 0x53, 0x65, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d,
+	0x65, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x69, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x69, 0x63, 0x22, 0x32, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d,
+	0x65, 0x74, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x22, 0x4d, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x50,
+	0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x12,
+	0x16, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x22, 0x59, 0x0a, 0x15, 0x42, 0x6f, 0x75, 0x6e, 0x63,
+	0x65, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x10, 0x0a, 0x03, 0x73, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73,
+	0x69, 0x64, 0x12, 0x2e, 0x0a, 0x13, 0x61, 0x76, 0x6f, 0x69, 0x64, 0x5f, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x5f, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x11, 0x61, 0x76, 0x6f, 0x69, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x42, 0x61, 0x63, 0x6b,
+	0x75, 0x70, 0x22, 0x18, 0x0a, 0x16, 0x42, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x44, 0x61, 0x74, 0x61,
+	0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x35, 0x0a, 0x18,
+	0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x46, 0x69, 0x6c,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x64, 0x62, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x64, 0x62, 0x4e,
+	0x61, 0x6d, 0x65, 0x22, 0x1b, 0x0a, 0x19, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x22, 0x0a, 0x20, 0x46, 0x65, 0x74, 0x63, 0x68, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x49, 0x6d, 0x61, 0x67, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x22, 0x79, 0x0a, 0x21, 0x46, 0x65, 0x74, 0x63, 0x68, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x44, 0x61, 0x74,
+	0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x64, 0x62, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x64, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1f,
+	0x0a, 0x0b, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5f, 0x68, 0x6f, 0x6d, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x48, 0x6f, 0x6d, 0x65, 0x22,
+	0xdd, 0x02, 0x0a, 0x17, 0x53, 0x68, 0x69, 0x70, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64,
+	0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x67,
+	0x63, 0x73, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67,
+	0x63, 0x73, 0x50, 0x61, 0x74, 0x68, 0x12, 0x20, 0x0a, 0x0b, 0x69, 0x6e, 0x63, 0x61, 0x72, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x69, 0x6e, 0x63,
+	0x61, 0x72, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x61, 0x66, 0x74, 0x65,
+	0x72, 0x5f, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0d, 0x61, 0x66, 0x74, 0x65, 0x72, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12,
+	0x2d, 0x0a, 0x09, 0x6c, 0x72, 0x6f, 0x5f, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x4c, 0x52, 0x4f, 0x49,
+	0x6e, 0x70, 0x75, 0x74, 0x52, 0x08, 0x6c, 0x72, 0x6f, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x68,
+	0x0a, 0x13, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x61, 0x6c, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x53, 0x68, 0x69, 0x70, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65,
+	0x64, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x74, 0x6f,
+	0x72, 0x61, 0x67, 0x65, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x12, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x43, 0x72, 0x65,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x1a, 0x45, 0x0a, 0x17, 0x53, 0x74, 0x6f, 0x72,
+	0x61, 0x67, 0x65, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22,
+	0x5e, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08,
+	0x63, 0x64, 0x62, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x63, 0x64, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x6e, 0x70, 0x6c, 0x75,
+	0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x75, 0x6e, 0x70, 0x6c, 0x75, 0x67, 0x22,
+	0x3d, 0x0a, 0x16, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x61, 0x6e,
+	0x69, 0x66, 0x65, 0x73, 0x74, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x50, 0x61, 0x74, 0x68, 0x22, 0xfc,
+	0x01, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70,
+	0x5f, 0x74, 0x61, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x61, 0x63, 0x6b,
+	0x75, 0x70, 0x54, 0x61, 0x67, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x63, 0x73, 0x5f, 0x70, 0x61, 0x74,
+	0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x63, 0x73, 0x50, 0x61, 0x74, 0x68,
+	0x12, 0x64, 0x0a, 0x13, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x63, 0x72, 0x65, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x33, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x61, 0x63,
+	0x6b, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61,
+	0x67, 0x65, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x12, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x43, 0x72, 0x65, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x1a, 0x45, 0x0a, 0x17, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67,
+	0x65, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x3b, 0x0a,
+	0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64,
+	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x64, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x95, 0x02, 0x0a, 0x14, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x62, 0x79, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x64, 0x62, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x64, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x24,
+	0x0a, 0x0e, 0x64, 0x62, 0x5f, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x64, 0x62, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x34, 0x0a, 0x16, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x5f,
+	0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x43, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x34, 0x0a, 0x16, 0x73, 0x74,
+	0x61, 0x6e, 0x64, 0x62, 0x79, 0x5f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x5f, 0x73, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x73, 0x74, 0x61, 0x6e,
+	0x64, 0x62, 0x79, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x12, 0x21, 0x0a, 0x0c, 0x73, 0x79, 0x73, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x79, 0x73, 0x50, 0x61, 0x73, 0x73, 0x77,
+	0x6f, 0x72, 0x64, 0x12, 0x2d, 0x0a, 0x09, 0x6c, 0x72, 0x6f, 0x5f, 0x69, 0x6e, 0x70, 0x75, 0x74,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e,
+	0x4c, 0x52, 0x4f, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x52, 0x08, 0x6c, 0x72, 0x6f, 0x49, 0x6e, 0x70,
+	0x75, 0x74, 0x22, 0x8a, 0x01, 0x0a, 0x15, 0x53, 0x65, 0x74, 0x55, 0x70, 0x44, 0x61, 0x74, 0x61,
+	0x47, 0x75, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2d, 0x0a, 0x13,
+	0x70, 0x65, 0x65, 0x72, 0x5f, 0x64, 0x62, 0x5f, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x70, 0x65, 0x65, 0x72, 0x44,
+	0x62, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2e, 0x0a, 0x13, 0x70,
+	0x65, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x70, 0x65, 0x65, 0x72, 0x43, 0x6f,
+	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x73,
+	0x79, 0x6e, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x73, 0x79, 0x6e, 0x63, 0x22,
+	0x18, 0x0a, 0x16, 0x53, 0x65, 0x74, 0x55, 0x70, 0x44, 0x61, 0x74, 0x61, 0x47, 0x75, 0x61, 0x72,
+	0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x18, 0x0a, 0x16, 0x44, 0x61, 0x74,
+	0x61, 0x47, 0x75, 0x61, 0x72, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x22, 0x86, 0x02, 0x0a, 0x17, 0x44, 0x61, 0x74, 0x61, 0x47, 0x75, 0x61, 0x72,
+	0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x24, 0x0a, 0x0e, 0x64, 0x62, 0x5f, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x64, 0x62, 0x55, 0x6e, 0x69, 0x71, 0x75,
+	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73,
+	0x65, 0x5f, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x64, 0x61,
+	0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6f, 0x70,
+	0x65, 0x6e, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f,
+	0x70, 0x65, 0x6e, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x61, 0x70, 0x70, 0x6c, 0x79,
+	0x5f, 0x6c, 0x61, 0x67, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0f, 0x61, 0x70, 0x70, 0x6c, 0x79, 0x4c, 0x61, 0x67, 0x53, 0x65, 0x63, 0x6f,
+	0x6e, 0x64, 0x73, 0x12, 0x32, 0x0a, 0x15, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74,
+	0x5f, 0x6c, 0x61, 0x67, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x13, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x4c, 0x61, 0x67,
+	0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x70, 0x70, 0x6c, 0x79,
+	0x5f, 0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c,
+	0x61, 0x70, 0x70, 0x6c, 0x79, 0x52, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x22, 0x61, 0x0a, 0x11,
+	0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x6f, 0x76, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x31, 0x0a, 0x15, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x64, 0x62, 0x5f, 0x75,
+	0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x12, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x44, 0x62, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x64, 0x62, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x64, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x22,
+	0x14, 0x0a, 0x12, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x6f, 0x76, 0x65, 0x72, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x44, 0x0a, 0x0f, 0x46, 0x61, 0x69, 0x6c, 0x6f, 0x76, 0x65,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x31, 0x0a, 0x15, 0x74, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x5f, 0x64, 0x62, 0x5f, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x44,
+	0x62, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x12, 0x0a, 0x10, 0x46,
+	0x61, 0x69, 0x6c, 0x6f, 0x76, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x31, 0x0a, 0x14, 0x46, 0x65, 0x6e, 0x63, 0x65, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x64, 0x62, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x64, 0x62, 0x4e, 0x61,
+	0x6d, 0x65, 0x22, 0x17, 0x0a, 0x15, 0x46, 0x65, 0x6e, 0x63, 0x65, 0x44, 0x61, 0x74, 0x61, 0x62,
+	0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xbd, 0x02, 0x0a, 0x13,
+	0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x5f, 0x74, 0x61,
+	0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x54,
+	0x61, 0x67, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x6f, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x03, 0x64, 0x6f, 0x70, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x63, 0x73, 0x5f, 0x70, 0x61, 0x74, 0x68,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x63, 0x73, 0x50, 0x61, 0x74, 0x68, 0x12,
+	0x2d, 0x0a, 0x09, 0x6c, 0x72, 0x6f, 0x5f, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x4c, 0x52, 0x4f, 0x49,
+	0x6e, 0x70, 0x75, 0x74, 0x52, 0x08, 0x6c, 0x72, 0x6f, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x64,
+	0x0a, 0x13, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x61, 0x6c, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x42, 0x61, 0x63, 0x6b, 0x75,
+	0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
+	0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x12, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x61, 0x6c, 0x73, 0x1a, 0x45, 0x0a, 0x17, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x43,
+	0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x36, 0x0a, 0x15, 0x44,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x5f, 0x74,
+	0x61, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70,
+	0x54, 0x61, 0x67, 0x22, 0x4a, 0x0a, 0x16, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x42,
+	0x61, 0x63, 0x6b, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x17, 0x0a,
+	0x07, 0x6d, 0x69, 0x6e, 0x5f, 0x73, 0x63, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x6d, 0x69, 0x6e, 0x53, 0x63, 0x6e, 0x12, 0x17, 0x0a, 0x07, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x63,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6d, 0x61, 0x78, 0x53, 0x63, 0x6e, 0x22,
+	0x69, 0x0a, 0x1d, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73,
+	0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x19, 0x0a, 0x08, 0x63, 0x64, 0x62, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x63, 0x64, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2d, 0x0a, 0x09, 0x6c,
+	0x72, 0x6f, 0x5f, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x4c, 0x52, 0x4f, 0x49, 0x6e, 0x70, 0x75, 0x74,
+	0x52, 0x08, 0x6c, 0x72, 0x6f, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x22, 0xaf, 0x01, 0x0a, 0x0a, 0x54,
+	0x61, 0x62, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2c, 0x0a,
+	0x12, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x62, 0x79,
+	0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x69, 0x6e, 0x69, 0x74, 0x69,
+	0x61, 0x6c, 0x53, 0x69, 0x7a, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x61,
+	0x75, 0x74, 0x6f, 0x5f, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0a, 0x61, 0x75, 0x74, 0x6f, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x12, 0x24, 0x0a, 0x0e,
+	0x6d, 0x61, 0x78, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x6d, 0x61, 0x78, 0x53, 0x69, 0x7a, 0x65, 0x42, 0x79, 0x74,
+	0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x69, 0x67, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x07, 0x62, 0x69, 0x67, 0x66, 0x69, 0x6c, 0x65, 0x22, 0x69, 0x0a, 0x16,
+	0x53, 0x79, 0x6e, 0x63, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x64, 0x62, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x64, 0x62, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x34, 0x0a, 0x0b, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e,
+	0x54, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x0b, 0x74, 0x61, 0x62, 0x6c,
+	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x22, 0xd9, 0x01, 0x0a, 0x17, 0x53, 0x79, 0x6e, 0x63,
+	0x54, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x06, 0x75, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x53, 0x79, 0x6e,
+	0x63, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x06, 0x75, 0x73, 0x61, 0x67,
+	0x65, 0x73, 0x1a, 0x7f, 0x0a, 0x05, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x1d, 0x0a, 0x0a, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x69, 0x7a, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x1d,
+	0x0a, 0x0a, 0x75, 0x73, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x09, 0x75, 0x73, 0x65, 0x64, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x24, 0x0a,
+	0x0e, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x6d, 0x61, 0x78, 0x53, 0x69, 0x7a, 0x65, 0x42, 0x79,
+	0x74, 0x65, 0x73, 0x22, 0x17, 0x0a, 0x15, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x44, 0x61, 0x74, 0x61,
+	0x70, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xd4, 0x01, 0x0a,
+	0x16, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x44, 0x61, 0x74, 0x61, 0x70, 0x61, 0x74, 0x63, 0x68, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x07, 0x70, 0x61, 0x74, 0x63, 0x68,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x73, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x44, 0x61, 0x74, 0x61, 0x70, 0x61, 0x74, 0x63, 0x68,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x71, 0x6c, 0x50, 0x61, 0x74, 0x63,
+	0x68, 0x52, 0x07, 0x70, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x1a, 0x77, 0x0a, 0x08, 0x53, 0x71,
+	0x6c, 0x50, 0x61, 0x74, 0x63, 0x68, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x61, 0x74, 0x63, 0x68, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x70, 0x61, 0x74, 0x63, 0x68, 0x49,
+	0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x22, 0x74, 0x0a, 0x17, 0x53, 0x65, 0x74, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79,
+	0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22,
+	0x0a, 0x0d, 0x73, 0x67, 0x61, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x6d, 0x62, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x73, 0x67, 0x61, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74,
+	0x4d, 0x62, 0x12, 0x35, 0x0a, 0x17, 0x70, 0x67, 0x61, 0x5f, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67,
+	0x61, 0x74, 0x65, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x6d, 0x62, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x14, 0x70, 0x67, 0x61, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74,
+	0x65, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x4d, 0x62, 0x22, 0x1a, 0x0a, 0x18, 0x53, 0x65, 0x74,
+	0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x80, 0x01, 0x0a, 0x04, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x72, 0x69, 0x76, 0x69, 0x6c, 0x65, 0x67, 0x65, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x72, 0x69, 0x76, 0x69, 0x6c, 0x65, 0x67,
+	0x65, 0x73, 0x12, 0x44, 0x0a, 0x11, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x70, 0x72, 0x69,
+	0x76, 0x69, 0x6c, 0x65, 0x67, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x50, 0x72, 0x69,
+	0x76, 0x69, 0x6c, 0x65, 0x67, 0x65, 0x52, 0x10, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x50, 0x72,
+	0x69, 0x76, 0x69, 0x6c, 0x65, 0x67, 0x65, 0x73, 0x22, 0x51, 0x0a, 0x10, 0x53, 0x79, 0x6e, 0x63,
+	0x52, 0x6f, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08,
+	0x70, 0x64, 0x62, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x70, 0x64, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e,
+	0x52, 0x6f, 0x6c, 0x65, 0x52, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x22, 0x13, 0x0a, 0x11, 0x53,
+	0x79, 0x6e, 0x63, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x8d, 0x01, 0x0a, 0x07, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x33, 0x0a, 0x06, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c,
+	0x65, 0x2e, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x73, 0x1a, 0x39, 0x0a, 0x0b, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x22, 0x5d, 0x0a, 0x13, 0x53, 0x79, 0x6e, 0x63, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x64, 0x62, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x64, 0x62, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x2b, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x50, 0x72,
+	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x22,
+	0x16, 0x0a, 0x14, 0x53, 0x79, 0x6e, 0x63, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x18, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x41, 0x75,
+	0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x48, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x22, 0x3f, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67,
+	0x48, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03,
+	0x73, 0x65, 0x71, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x73, 0x65, 0x71, 0x12, 0x12,
+	0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x61,
+	0x73, 0x68, 0x32, 0xe7, 0x1a, 0x0a, 0x0b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x41, 0x67, 0x65,
+	0x6e, 0x74, 0x12, 0x51, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61,
+	0x62, 0x61, 0x73, 0x65, 0x12, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x0b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55,
+	0x73, 0x65, 0x72, 0x73, 0x12, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x4e, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x44, 0x42, 0x55, 0x73, 0x65, 0x72,
+	0x12, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x43, 0x44, 0x42, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x44,
+	0x42, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x4b, 0x0a, 0x0c, 0x55, 0x73, 0x65, 0x72, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x12,
+	0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x73, 0x43, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x0b,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x1a, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73,
+	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x50, 0x0a, 0x0e, 0x50, 0x68, 0x79, 0x73, 0x69, 0x63,
+	0x61, 0x6c, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x12, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x73, 0x2e, 0x50, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x6c, 0x6f, 0x6e, 0x67, 0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x4f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x0f, 0x50, 0x68, 0x79, 0x73,
+	0x69, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x12, 0x1e, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x50, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x73,
+	0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x6c, 0x6f, 0x6e, 0x67, 0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67,
+	0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x0b,
+	0x43, 0x68, 0x65, 0x63, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1a, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73,
+	0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x43, 0x44, 0x42, 0x12, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x43, 0x44, 0x42, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x6c, 0x6f, 0x6e, 0x67, 0x72, 0x75, 0x6e, 0x6e, 0x69,
+	0x6e, 0x67, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x00, 0x12, 0x51,
+	0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72,
+	0x12, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4c,
+	0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x4e, 0x0a, 0x0e, 0x44, 0x61, 0x74, 0x61, 0x50, 0x75, 0x6d, 0x70, 0x49, 0x6d, 0x70,
+	0x6f, 0x72, 0x74, 0x12, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x44, 0x61, 0x74,
+	0x61, 0x50, 0x75, 0x6d, 0x70, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x6c, 0x6f, 0x6e, 0x67,
+	0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x67, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x29, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x6c, 0x6f, 0x6e,
+	0x67, 0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x6c, 0x6f, 0x6e, 0x67, 0x72, 0x75, 0x6e, 0x6e,
+	0x69, 0x6e, 0x67, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x0c, 0x47, 0x65,
+	0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x27, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x6c, 0x6f, 0x6e, 0x67, 0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e,
+	0x47, 0x65, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x6c, 0x6f, 0x6e,
+	0x67, 0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x55, 0x0a, 0x0f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x6c,
+	0x6f, 0x6e, 0x67, 0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x55, 0x0a, 0x0f, 0x43, 0x61, 0x6e,
+	0x63, 0x65, 0x6c, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x6c, 0x6f, 0x6e, 0x67, 0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e,
+	0x67, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x12, 0x56, 0x0a, 0x11, 0x42, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x44, 0x61, 0x74,
+	0x61, 0x62, 0x61, 0x73, 0x65, 0x12, 0x20, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x42,
+	0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x6c, 0x6f, 0x6e, 0x67, 0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x4f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x00, 0x12, 0x57, 0x0a, 0x10, 0x42, 0x6f, 0x6f, 0x74,
+	0x73, 0x74, 0x72, 0x61, 0x70, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x62, 0x79, 0x12, 0x1f, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x42, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x53,
+	0x74, 0x61, 0x6e, 0x64, 0x62, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x42, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70,
+	0x53, 0x74, 0x61, 0x6e, 0x64, 0x62, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x4e, 0x0a, 0x0e, 0x44, 0x61, 0x74, 0x61, 0x50, 0x75, 0x6d, 0x70, 0x45, 0x78, 0x70,
+	0x6f, 0x72, 0x74, 0x12, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x44, 0x61, 0x74,
+	0x61, 0x50, 0x75, 0x6d, 0x70, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x6c, 0x6f, 0x6e, 0x67,
+	0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x4b, 0x0a, 0x0c, 0x53, 0x65, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65,
+	0x72, 0x12, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x53, 0x65, 0x74, 0x50, 0x61,
+	0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x53, 0x65, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d,
+	0x65, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x66,
+	0x0a, 0x15, 0x47, 0x65, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x54, 0x79,
+	0x70, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x24, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73,
+	0x2e, 0x47, 0x65, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x54, 0x79, 0x70,
+	0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65,
+	0x74, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x51, 0x0a, 0x0e, 0x42, 0x6f, 0x75, 0x6e, 0x63, 0x65,
+	0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x12, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x73, 0x2e, 0x42, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73,
+	0x2e, 0x42, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5a, 0x0a, 0x11, 0x52, 0x65, 0x63,
+	0x6f, 0x76, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x20,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x21, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65,
+	0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x72, 0x0a, 0x19, 0x46, 0x65, 0x74, 0x63, 0x68, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x44, 0x61,
+	0x74, 0x61, 0x12, 0x28, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x46, 0x65, 0x74, 0x63,
+	0x68, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x4d, 0x65, 0x74,
+	0x61, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x46, 0x65, 0x74, 0x63, 0x68, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x44, 0x61, 0x74, 0x61, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x54, 0x0a, 0x10, 0x53, 0x68, 0x69,
+	0x70, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x4c, 0x6f, 0x67, 0x73, 0x12, 0x1f, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x53, 0x68, 0x69, 0x70, 0x41, 0x72, 0x63, 0x68, 0x69,
+	0x76, 0x65, 0x64, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x6c, 0x6f, 0x6e, 0x67, 0x72, 0x75, 0x6e, 0x6e,
+	0x69, 0x6e, 0x67, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x00, 0x12,
+	0x51, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73,
+	0x65, 0x12, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x4b, 0x0a, 0x0c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x61, 0x63, 0x6b,
+	0x75, 0x70, 0x12, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42,
+	0x61, 0x63, 0x6b, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x4e, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x62, 0x79,
+	0x12, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x53, 0x74, 0x61, 0x6e, 0x64, 0x62, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x6c, 0x6f, 0x6e, 0x67, 0x72, 0x75, 0x6e, 0x6e,
+	0x69, 0x6e, 0x67, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x00, 0x12,
+	0x51, 0x0a, 0x0e, 0x53, 0x65, 0x74, 0x55, 0x70, 0x44, 0x61, 0x74, 0x61, 0x47, 0x75, 0x61, 0x72,
+	0x64, 0x12, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x53, 0x65, 0x74, 0x55, 0x70,
+	0x44, 0x61, 0x74, 0x61, 0x47, 0x75, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x53, 0x65, 0x74, 0x55, 0x70, 0x44,
+	0x61, 0x74, 0x61, 0x47, 0x75, 0x61, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x54, 0x0a, 0x0f, 0x44, 0x61, 0x74, 0x61, 0x47, 0x75, 0x61, 0x72, 0x64, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x44,
+	0x61, 0x74, 0x61, 0x47, 0x75, 0x61, 0x72, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x44,
+	0x61, 0x74, 0x61, 0x47, 0x75, 0x61, 0x72, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x45, 0x0a, 0x0a, 0x53, 0x77, 0x69, 0x74,
+	0x63, 0x68, 0x6f, 0x76, 0x65, 0x72, 0x12, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e,
+	0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x6f, 0x76, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x53, 0x77, 0x69, 0x74, 0x63,
+	0x68, 0x6f, 0x76, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x3f, 0x0a, 0x08, 0x46, 0x61, 0x69, 0x6c, 0x6f, 0x76, 0x65, 0x72, 0x12, 0x17, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x46, 0x61, 0x69, 0x6c, 0x6f, 0x76, 0x65, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x46, 0x61,
+	0x69, 0x6c, 0x6f, 0x76, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x4e, 0x0a, 0x0d, 0x46, 0x65, 0x6e, 0x63, 0x65, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73,
+	0x65, 0x12, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x46, 0x65, 0x6e, 0x63, 0x65,
+	0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x46, 0x65, 0x6e, 0x63, 0x65, 0x44, 0x61,
+	0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x4c, 0x0a, 0x0c, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70,
+	0x12, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79,
+	0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x6c, 0x6f, 0x6e, 0x67, 0x72, 0x75, 0x6e, 0x6e, 0x69,
+	0x6e, 0x67, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x00, 0x12, 0x51,
+	0x0a, 0x0e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70,
+	0x12, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x62, 0x65, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62,
+	0x65, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x60, 0x0a, 0x16, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x44, 0x61, 0x74, 0x61, 0x62,
+	0x61, 0x73, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x25, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x44, 0x61, 0x74, 0x61, 0x62,
+	0x61, 0x73, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x6c, 0x6f, 0x6e, 0x67,
+	0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x22, 0x00, 0x12, 0x54, 0x0a, 0x0f, 0x53, 0x79, 0x6e, 0x63, 0x54, 0x61, 0x62, 0x6c, 0x65,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x12, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e,
+	0x53, 0x79, 0x6e, 0x63, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e,
+	0x53, 0x79, 0x6e, 0x63, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x51, 0x0a, 0x0e, 0x41, 0x70, 0x70,
+	0x6c, 0x79, 0x44, 0x61, 0x74, 0x61, 0x70, 0x61, 0x74, 0x63, 0x68, 0x12, 0x1d, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x44, 0x61, 0x74, 0x61, 0x70, 0x61,
+	0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x73, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x44, 0x61, 0x74, 0x61, 0x70, 0x61, 0x74,
+	0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x57, 0x0a, 0x10,
+	0x53, 0x65, 0x74, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73,
+	0x12, 0x1f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x53, 0x65, 0x74, 0x4d, 0x65, 0x6d,
+	0x6f, 0x72, 0x79, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x20, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x53, 0x65, 0x74, 0x4d, 0x65,
+	0x6d, 0x6f, 0x72, 0x79, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x09, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x6f, 0x6c,
+	0x65, 0x73, 0x12, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x53, 0x79, 0x6e, 0x63,
+	0x52, 0x6f, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x0c, 0x53, 0x79, 0x6e,
+	0x63, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x73, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e,
+	0x53, 0x79, 0x6e, 0x63, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x54, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x41, 0x75, 0x64,
+	0x69, 0x74, 0x4c, 0x6f, 0x67, 0x48, 0x65, 0x61, 0x64, 0x12, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x48, 0x65,
+	0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x48, 0x65,
+	0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x65, 0x5a, 0x63,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x47, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x43, 0x6c, 0x6f, 0x75, 0x64, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x2f, 0x65,
+	0x6c, 0x63, 0x61, 0x72, 0x72, 0x6f, 0x2d, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2d, 0x6f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x70, 0x6b,
+	0x67, 0x2f, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f,
+	0x61, 0x67, 0x65, 0x6e, 0x74, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x3b, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_oracle_pkg_agents_config_agent_protos_service_proto_rawDescOnce sync.Once
+	file_oracle_pkg_agents_config_agent_protos_service_proto_rawDescData = file_oracle_pkg_agents_config_agent_protos_service_proto_rawDesc
+)
+
+func file_oracle_pkg_agents_config_agent_protos_service_proto_rawDescGZIP() []byte {
+	file_oracle_pkg_agents_config_agent_protos_service_proto_rawDescOnce.Do(func() {
+		file_oracle_pkg_agents_config_agent_protos_service_proto_rawDescData = protoimpl.X.CompressGZIP(file_oracle_pkg_agents_config_agent_protos_service_proto_rawDescData)
+	})
+	return file_oracle_pkg_agents_config_agent_protos_service_proto_rawDescData
+}
+
+var file_oracle_pkg_agents_config_agent_protos_service_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
+var file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes = make([]protoimpl.MessageInfo, 89)
+var file_oracle_pkg_agents_config_agent_protos_service_proto_goTypes = []interface{}{
+	(UsersChangedRequest_DeletionPolicy)(0),    // 0: protos.UsersChangedRequest.DeletionPolicy
+	(UsersChangedResponse_Type)(0),             // 1: protos.UsersChangedResponse.Type
+	(PhysicalBackupRequest_Type)(0),            // 2: protos.PhysicalBackupRequest.Type
+	(CheckStatusRequest_Type)(0),               // 3: protos.CheckStatusRequest.Type
+	(SetParameterRequest_Type)(0),              // 4: protos.SetParameterRequest.Type
+	(*CreateCDBRequest)(nil),                   // 5: protos.CreateCDBRequest
+	(*CreateListenerRequest)(nil),              // 6: protos.CreateListenerRequest
+	(*CreateListenerResponse)(nil),             // 7: protos.CreateListenerResponse
+	(*GsmSecretReference)(nil),                 // 8: protos.GsmSecretReference
+	(*SecretPassword)(nil),                     // 9: protos.SecretPassword
+	(*CreateDatabaseRequest)(nil),              // 10: protos.CreateDatabaseRequest
+	(*CreateDatabaseResponse)(nil),             // 11: protos.CreateDatabaseResponse
+	(*CreateUsersRequest)(nil),                 // 12: protos.CreateUsersRequest
+	(*CreateUsersResponse)(nil),                // 13: protos.CreateUsersResponse
+	(*CreateCDBUserRequest)(nil),               // 14: protos.CreateCDBUserRequest
+	(*CreateCDBUserResponse)(nil),              // 15: protos.CreateCDBUserResponse
+	(*User)(nil),                               // 16: protos.User
+	(*ObjectPrivilege)(nil),                    // 17: protos.ObjectPrivilege
+	(*TablespaceQuota)(nil),                    // 18: protos.TablespaceQuota
+	(*UsersChangedRequest)(nil),                // 19: protos.UsersChangedRequest
+	(*UsersChangedResponse)(nil),               // 20: protos.UsersChangedResponse
+	(*UpdateUsersRequest)(nil),                 // 21: protos.UpdateUsersRequest
+	(*UpdateUsersResponse)(nil),                // 22: protos.UpdateUsersResponse
+	(*PhysicalBackupRequest)(nil),              // 23: protos.PhysicalBackupRequest
+	(*PhysicalRestoreRequest)(nil),             // 24: protos.PhysicalRestoreRequest
+	(*CheckStatusRequest)(nil),                 // 25: protos.CheckStatusRequest
+	(*CheckStatusResponse)(nil),                // 26: protos.CheckStatusResponse
+	(*DataPumpImportRequest)(nil),              // 27: protos.DataPumpImportRequest
+	(*DataPumpExportRequest)(nil),              // 28: protos.DataPumpExportRequest
+	(*LROInput)(nil),                           // 29: protos.LROInput
+	(*BootstrapDatabaseRequest)(nil),           // 30: protos.BootstrapDatabaseRequest
+	(*BootstrapDatabaseResponse)(nil),          // 31: protos.BootstrapDatabaseResponse
+	(*BootstrapStandbyRequest)(nil),            // 32: protos.BootstrapStandbyRequest
+	(*BootstrapStandbyResponse)(nil),           // 33: protos.BootstrapStandbyResponse
+	(*SetParameterRequest)(nil),                // 34: protos.SetParameterRequest
+	(*SetParameterResponse)(nil),               // 35: protos.SetParameterResponse
+	(*GetParameterTypeValueRequest)(nil),       // 36: protos.GetParameterTypeValueRequest
+	(*GetParameterTypeValueResponse)(nil),      // 37: protos.GetParameterTypeValueResponse
+	(*BounceDatabaseRequest)(nil),              // 38: protos.BounceDatabaseRequest
+	(*BounceDatabaseResponse)(nil),             // 39: protos.BounceDatabaseResponse
+	(*RecoverConfigFileRequest)(nil),           // 40: protos.RecoverConfigFileRequest
+	(*RecoverConfigFileResponse)(nil),          // 41: protos.RecoverConfigFileResponse
+	(*FetchServiceImageMetaDataRequest)(nil),   // 42: protos.FetchServiceImageMetaDataRequest
+	(*FetchServiceImageMetaDataResponse)(nil),  // 43: protos.FetchServiceImageMetaDataResponse
+	(*ShipArchivedLogsRequest)(nil),            // 44: protos.ShipArchivedLogsRequest
+	(*DeleteDatabaseRequest)(nil),              // 45: protos.DeleteDatabaseRequest
+	(*DeleteDatabaseResponse)(nil),             // 46: protos.DeleteDatabaseResponse
+	(*DeleteBackupRequest)(nil),                // 47: protos.DeleteBackupRequest
+	(*DeleteBackupResponse)(nil),               // 48: protos.DeleteBackupResponse
+	(*CreateStandbyRequest)(nil),               // 49: protos.CreateStandbyRequest
+	(*SetUpDataGuardRequest)(nil),              // 50: protos.SetUpDataGuardRequest
+	(*SetUpDataGuardResponse)(nil),             // 51: protos.SetUpDataGuardResponse
+	(*DataGuardStatusRequest)(nil),             // 52: protos.DataGuardStatusRequest
+	(*DataGuardStatusResponse)(nil),            // 53: protos.DataGuardStatusResponse
+	(*SwitchoverRequest)(nil),                  // 54: protos.SwitchoverRequest
+	(*SwitchoverResponse)(nil),                 // 55: protos.SwitchoverResponse
+	(*FailoverRequest)(nil),                    // 56: protos.FailoverRequest
+	(*FailoverResponse)(nil),                   // 57: protos.FailoverResponse
+	(*FenceDatabaseRequest)(nil),               // 58: protos.FenceDatabaseRequest
+	(*FenceDatabaseResponse)(nil),              // 59: protos.FenceDatabaseResponse
+	(*VerifyBackupRequest)(nil),                // 60: protos.VerifyBackupRequest
+	(*DescribeBackupRequest)(nil),              // 61: protos.DescribeBackupRequest
+	(*DescribeBackupResponse)(nil),             // 62: protos.DescribeBackupResponse
+	(*ChangeDatabaseIdentityRequest)(nil),      // 63: protos.ChangeDatabaseIdentityRequest
+	(*Tablespace)(nil),                         // 64: protos.Tablespace
+	(*SyncTablespacesRequest)(nil),             // 65: protos.SyncTablespacesRequest
+	(*SyncTablespacesResponse)(nil),            // 66: protos.SyncTablespacesResponse
+	(*ApplyDatapatchRequest)(nil),              // 67: protos.ApplyDatapatchRequest
+	(*ApplyDatapatchResponse)(nil),             // 68: protos.ApplyDatapatchResponse
+	(*SetMemoryTargetsRequest)(nil),            // 69: protos.SetMemoryTargetsRequest
+	(*SetMemoryTargetsResponse)(nil),           // 70: protos.SetMemoryTargetsResponse
+	(*Role)(nil),                               // 71: protos.Role
+	(*SyncRolesRequest)(nil),                   // 72: protos.SyncRolesRequest
+	(*SyncRolesResponse)(nil),                  // 73: protos.SyncRolesResponse
+	(*Profile)(nil),                            // 74: protos.Profile
+	(*SyncProfilesRequest)(nil),                // 75: protos.SyncProfilesRequest
+	(*SyncProfilesResponse)(nil),               // 76: protos.SyncProfilesResponse
+	(*GetAuditLogHeadRequest)(nil),             // 77: protos.GetAuditLogHeadRequest
+	(*GetAuditLogHeadResponse)(nil),            // 78: protos.GetAuditLogHeadResponse
+	(*UsersChangedResponse_Suppressed)(nil),    // 79: protos.UsersChangedResponse.Suppressed
+	nil,                                        // 80: protos.UsersChangedResponse.AccountStatusesEntry
+	nil,                                        // 81: protos.UpdateUsersResponse.AccountStatusesEntry
+	nil,                                        // 82: protos.PhysicalBackupRequest.StorageCredentialsEntry
+	nil,                                        // 83: protos.PhysicalRestoreRequest.StorageCredentialsEntry
+	nil,                                        // 84: protos.DataPumpImportRequest.StorageCredentialsEntry
+	nil,                                        // 85: protos.DataPumpExportRequest.StorageCredentialsEntry
+	(*BootstrapStandbyResponse_User)(nil),      // 86: protos.BootstrapStandbyResponse.User
+	(*BootstrapStandbyResponse_PDB)(nil),       // 87: protos.BootstrapStandbyResponse.PDB
+	nil,                                        // 88: protos.ShipArchivedLogsRequest.StorageCredentialsEntry
+	nil,                                        // 89: protos.DeleteBackupRequest.StorageCredentialsEntry
+	nil,                                        // 90: protos.VerifyBackupRequest.StorageCredentialsEntry
+	(*SyncTablespacesResponse_Usage)(nil),      // 91: protos.SyncTablespacesResponse.Usage
+	(*ApplyDatapatchResponse_SqlPatch)(nil),    // 92: protos.ApplyDatapatchResponse.SqlPatch
+	nil,                                        // 93: protos.Profile.LimitsEntry
+	(*timestamppb.Timestamp)(nil),              // 94: google.protobuf.Timestamp
+	(*longrunning.ListOperationsRequest)(nil),  // 95: google.longrunning.ListOperationsRequest
+	(*longrunning.GetOperationRequest)(nil),    // 96: google.longrunning.GetOperationRequest
+	(*longrunning.DeleteOperationRequest)(nil), // 97: google.longrunning.DeleteOperationRequest
+	(*longrunning.CancelOperationRequest)(nil), // 98: google.longrunning.CancelOperationRequest
+	(*longrunning.Operation)(nil),              // 99: google.longrunning.Operation
+	(*longrunning.ListOperationsResponse)(nil), // 100: google.longrunning.ListOperationsResponse
+	(*emptypb.Empty)(nil),                      // 101: google.protobuf.Empty
+}
+var file_oracle_pkg_agents_config_agent_protos_service_proto_depIdxs = []int32{
+	29,  // 0: protos.CreateCDBRequest.lro_input:type_name -> protos.LROInput
+	8,   // 1: protos.CreateDatabaseRequest.admin_password_gsm_secret_ref:type_name -> protos.GsmSecretReference
+	9,   // 2: protos.CreateDatabaseRequest.admin_password_secret:type_name -> protos.SecretPassword
+	16,  // 3: protos.CreateUsersRequest.user:type_name -> protos.User
+	8,   // 4: protos.User.password_gsm_secret_ref:type_name -> protos.GsmSecretReference
+	18,  // 5: protos.User.quotas:type_name -> protos.TablespaceQuota
+	9,   // 6: protos.User.password_secret:type_name -> protos.SecretPassword
+	17,  // 7: protos.User.object_privileges:type_name -> protos.ObjectPrivilege
+	16,  // 8: protos.UsersChangedRequest.user_specs:type_name -> protos.User
+	0,   // 9: protos.UsersChangedRequest.deletion_policy:type_name -> protos.UsersChangedRequest.DeletionPolicy
+	79,  // 10: protos.UsersChangedResponse.suppressed:type_name -> protos.UsersChangedResponse.Suppressed
+	80,  // 11: protos.UsersChangedResponse.account_statuses:type_name -> protos.UsersChangedResponse.AccountStatusesEntry
+	16,  // 12: protos.UpdateUsersRequest.user_specs:type_name -> protos.User
+	0,   // 13: protos.UpdateUsersRequest.deletion_policy:type_name -> protos.UsersChangedRequest.DeletionPolicy
+	81,  // 14: protos.UpdateUsersResponse.account_statuses:type_name -> protos.UpdateUsersResponse.AccountStatusesEntry
+	2,   // 15: protos.PhysicalBackupRequest.backup_sub_type:type_name -> protos.PhysicalBackupRequest.Type
+	29,  // 16: protos.PhysicalBackupRequest.lro_input:type_name -> protos.LROInput
+	82,  // 17: protos.PhysicalBackupRequest.storage_credentials:type_name -> protos.PhysicalBackupRequest.StorageCredentialsEntry
+	29,  // 18: protos.PhysicalRestoreRequest.lro_input:type_name -> protos.LROInput
+	94,  // 19: protos.PhysicalRestoreRequest.until_time:type_name -> google.protobuf.Timestamp
+	83,  // 20: protos.PhysicalRestoreRequest.storage_credentials:type_name -> protos.PhysicalRestoreRequest.StorageCredentialsEntry
+	3,   // 21: protos.CheckStatusRequest.check_status_type:type_name -> protos.CheckStatusRequest.Type
+	29,  // 22: protos.DataPumpImportRequest.lro_input:type_name -> protos.LROInput
+	84,  // 23: protos.DataPumpImportRequest.storage_credentials:type_name -> protos.DataPumpImportRequest.StorageCredentialsEntry
+	29,  // 24: protos.DataPumpExportRequest.lro_input:type_name -> protos.LROInput
+	85,  // 25: protos.DataPumpExportRequest.storage_credentials:type_name -> protos.DataPumpExportRequest.StorageCredentialsEntry
+	87,  // 26: protos.BootstrapStandbyResponse.pdbs:type_name -> protos.BootstrapStandbyResponse.PDB
+	4,   // 27: protos.SetParameterRequest.type:type_name -> protos.SetParameterRequest.Type
+	29,  // 28: protos.ShipArchivedLogsRequest.lro_input:type_name -> protos.LROInput
+	88,  // 29: protos.ShipArchivedLogsRequest.storage_credentials:type_name -> protos.ShipArchivedLogsRequest.StorageCredentialsEntry
+	89,  // 30: protos.DeleteBackupRequest.storage_credentials:type_name -> protos.DeleteBackupRequest.StorageCredentialsEntry
+	29,  // 31: protos.CreateStandbyRequest.lro_input:type_name -> protos.LROInput
+	29,  // 32: protos.VerifyBackupRequest.lro_input:type_name -> protos.LROInput
+	90,  // 33: protos.VerifyBackupRequest.storage_credentials:type_name -> protos.VerifyBackupRequest.StorageCredentialsEntry
+	29,  // 34: protos.ChangeDatabaseIdentityRequest.lro_input:type_name -> protos.LROInput
+	64,  // 35: protos.SyncTablespacesRequest.tablespaces:type_name -> protos.Tablespace
+	91,  // 36: protos.SyncTablespacesResponse.usages:type_name -> protos.SyncTablespacesResponse.Usage
+	92,  // 37: protos.ApplyDatapatchResponse.patches:type_name -> protos.ApplyDatapatchResponse.SqlPatch
+	17,  // 38: protos.Role.object_privileges:type_name -> protos.ObjectPrivilege
+	71,  // 39: protos.SyncRolesRequest.roles:type_name -> protos.Role
+	93,  // 40: protos.Profile.limits:type_name -> protos.Profile.LimitsEntry
+	74,  // 41: protos.SyncProfilesRequest.profiles:type_name -> protos.Profile
+	1,   // 42: protos.UsersChangedResponse.Suppressed.suppress_type:type_name -> protos.UsersChangedResponse.Type
+	86,  // 43: protos.BootstrapStandbyResponse.PDB.users:type_name -> protos.BootstrapStandbyResponse.User
+	10,  // 44: protos.ConfigAgent.CreateDatabase:input_type -> protos.CreateDatabaseRequest
+	12,  // 45: protos.ConfigAgent.CreateUsers:input_type -> protos.CreateUsersRequest
+	14,  // 46: protos.ConfigAgent.CreateCDBUser:input_type -> protos.CreateCDBUserRequest
+	19,  // 47: protos.ConfigAgent.UsersChanged:input_type -> protos.UsersChangedRequest
+	21,  // 48: protos.ConfigAgent.UpdateUsers:input_type -> protos.UpdateUsersRequest
+	23,  // 49: protos.ConfigAgent.PhysicalBackup:input_type -> protos.PhysicalBackupRequest
+	24,  // 50: protos.ConfigAgent.PhysicalRestore:input_type -> protos.PhysicalRestoreRequest
+	25,  // 51: protos.ConfigAgent.CheckStatus:input_type -> protos.CheckStatusRequest
+	5,   // 52: protos.ConfigAgent.CreateCDB:input_type -> protos.CreateCDBRequest
+	6,   // 53: protos.ConfigAgent.CreateListener:input_type -> protos.CreateListenerRequest
+	27,  // 54: protos.ConfigAgent.DataPumpImport:input_type -> protos.DataPumpImportRequest
+	95,  // 55: protos.ConfigAgent.ListOperations:input_type -> google.longrunning.ListOperationsRequest
+	96,  // 56: protos.ConfigAgent.GetOperation:input_type -> google.longrunning.GetOperationRequest
+	97,  // 57: protos.ConfigAgent.DeleteOperation:input_type -> google.longrunning.DeleteOperationRequest
+	98,  // 58: protos.ConfigAgent.CancelOperation:input_type -> google.longrunning.CancelOperationRequest
+	30,  // 59: protos.ConfigAgent.BootstrapDatabase:input_type -> protos.BootstrapDatabaseRequest
+	32,  // 60: protos.ConfigAgent.BootstrapStandby:input_type -> protos.BootstrapStandbyRequest
+	28,  // 61: protos.ConfigAgent.DataPumpExport:input_type -> protos.DataPumpExportRequest
+	34,  // 62: protos.ConfigAgent.SetParameter:input_type -> protos.SetParameterRequest
+	36,  // 63: protos.ConfigAgent.GetParameterTypeValue:input_type -> protos.GetParameterTypeValueRequest
+	38,  // 64: protos.ConfigAgent.BounceDatabase:input_type -> protos.BounceDatabaseRequest
+	40,  // 65: protos.ConfigAgent.RecoverConfigFile:input_type -> protos.RecoverConfigFileRequest
+	42,  // 66: protos.ConfigAgent.FetchServiceImageMetaData:input_type -> protos.FetchServiceImageMetaDataRequest
+	44,  // 67: protos.ConfigAgent.ShipArchivedLogs:input_type -> protos.ShipArchivedLogsRequest
+	45,  // 68: protos.ConfigAgent.DeleteDatabase:input_type -> protos.DeleteDatabaseRequest
+	47,  // 69: protos.ConfigAgent.DeleteBackup:input_type -> protos.DeleteBackupRequest
+	49,  // 70: protos.ConfigAgent.CreateStandby:input_type -> protos.CreateStandbyRequest
+	50,  // 71: protos.ConfigAgent.SetUpDataGuard:input_type -> protos.SetUpDataGuardRequest
+	52,  // 72: protos.ConfigAgent.DataGuardStatus:input_type -> protos.DataGuardStatusRequest
+	54,  // 73: protos.ConfigAgent.Switchover:input_type -> protos.SwitchoverRequest
+	56,  // 74: protos.ConfigAgent.Failover:input_type -> protos.FailoverRequest
+	58,  // 75: protos.ConfigAgent.FenceDatabase:input_type -> protos.FenceDatabaseRequest
+	60,  // 76: protos.ConfigAgent.VerifyBackup:input_type -> protos.VerifyBackupRequest
+	61,  // 77: protos.ConfigAgent.DescribeBackup:input_type -> protos.DescribeBackupRequest
+	63,  // 78: protos.ConfigAgent.ChangeDatabaseIdentity:input_type -> protos.ChangeDatabaseIdentityRequest
+	65,  // 79: protos.ConfigAgent.SyncTablespaces:input_type -> protos.SyncTablespacesRequest
+	67,  // 80: protos.ConfigAgent.ApplyDatapatch:input_type -> protos.ApplyDatapatchRequest
+	69,  // 81: protos.ConfigAgent.SetMemoryTargets:input_type -> protos.SetMemoryTargetsRequest
+	72,  // 82: protos.ConfigAgent.SyncRoles:input_type -> protos.SyncRolesRequest
+	75,  // 83: protos.ConfigAgent.SyncProfiles:input_type -> protos.SyncProfilesRequest
+	77,  // 84: protos.ConfigAgent.GetAuditLogHead:input_type -> protos.GetAuditLogHeadRequest
+	11,  // 85: protos.ConfigAgent.CreateDatabase:output_type -> protos.CreateDatabaseResponse
+	13,  // 86: protos.ConfigAgent.CreateUsers:output_type -> protos.CreateUsersResponse
+	15,  // 87: protos.ConfigAgent.CreateCDBUser:output_type -> protos.CreateCDBUserResponse
+	20,  // 88: protos.ConfigAgent.UsersChanged:output_type -> protos.UsersChangedResponse
+	22,  // 89: protos.ConfigAgent.UpdateUsers:output_type -> protos.UpdateUsersResponse
+	99,  // 90: protos.ConfigAgent.PhysicalBackup:output_type -> google.longrunning.Operation
+	99,  // 91: protos.ConfigAgent.PhysicalRestore:output_type -> google.longrunning.Operation
+	26,  // 92: protos.ConfigAgent.CheckStatus:output_type -> protos.CheckStatusResponse
+	99,  // 93: protos.ConfigAgent.CreateCDB:output_type -> google.longrunning.Operation
+	7,   // 94: protos.ConfigAgent.CreateListener:output_type -> protos.CreateListenerResponse
+	99,  // 95: protos.ConfigAgent.DataPumpImport:output_type -> google.longrunning.Operation
+	100, // 96: protos.ConfigAgent.ListOperations:output_type -> google.longrunning.ListOperationsResponse
+	99,  // 97: protos.ConfigAgent.GetOperation:output_type -> google.longrunning.Operation
+	101, // 98: protos.ConfigAgent.DeleteOperation:output_type -> google.protobuf.Empty
+	101, // 99: protos.ConfigAgent.CancelOperation:output_type -> google.protobuf.Empty
+	99,  // 100: protos.ConfigAgent.BootstrapDatabase:output_type -> google.longrunning.Operation
+	33,  // 101: protos.ConfigAgent.BootstrapStandby:output_type -> protos.BootstrapStandbyResponse
+	99,  // 102: protos.ConfigAgent.DataPumpExport:output_type -> google.longrunning.Operation
+	35,  // 103: protos.ConfigAgent.SetParameter:output_type -> protos.SetParameterResponse
+	37,  // 104: protos.ConfigAgent.GetParameterTypeValue:output_type -> protos.GetParameterTypeValueResponse
+	39,  // 105: protos.ConfigAgent.BounceDatabase:output_type -> protos.BounceDatabaseResponse
+	41,  // 106: protos.ConfigAgent.RecoverConfigFile:output_type -> protos.RecoverConfigFileResponse
+	43,  // 107: protos.ConfigAgent.FetchServiceImageMetaData:output_type -> protos.FetchServiceImageMetaDataResponse
+	99,  // 108: protos.ConfigAgent.ShipArchivedLogs:output_type -> google.longrunning.Operation
+	46,  // 109: protos.ConfigAgent.DeleteDatabase:output_type -> protos.DeleteDatabaseResponse
+	48,  // 110: protos.ConfigAgent.DeleteBackup:output_type -> protos.DeleteBackupResponse
+	99,  // 111: protos.ConfigAgent.CreateStandby:output_type -> google.longrunning.Operation
+	51,  // 112: protos.ConfigAgent.SetUpDataGuard:output_type -> protos.SetUpDataGuardResponse
+	53,  // 113: protos.ConfigAgent.DataGuardStatus:output_type -> protos.DataGuardStatusResponse
+	55,  // 114: protos.ConfigAgent.Switchover:output_type -> protos.SwitchoverResponse
+	57,  // 115: protos.ConfigAgent.Failover:output_type -> protos.FailoverResponse
+	59,  // 116: protos.ConfigAgent.FenceDatabase:output_type -> protos.FenceDatabaseResponse
+	99,  // 117: protos.ConfigAgent.VerifyBackup:output_type -> google.longrunning.Operation
+	62,  // 118: protos.ConfigAgent.DescribeBackup:output_type -> protos.DescribeBackupResponse
+	99,  // 119: protos.ConfigAgent.ChangeDatabaseIdentity:output_type -> google.longrunning.Operation
+	66,  // 120: protos.ConfigAgent.SyncTablespaces:output_type -> protos.SyncTablespacesResponse
+	68,  // 121: protos.ConfigAgent.ApplyDatapatch:output_type -> protos.ApplyDatapatchResponse
+	70,  // 122: protos.ConfigAgent.SetMemoryTargets:output_type -> protos.SetMemoryTargetsResponse
+	73,  // 123: protos.ConfigAgent.SyncRoles:output_type -> protos.SyncRolesResponse
+	76,  // 124: protos.ConfigAgent.SyncProfiles:output_type -> protos.SyncProfilesResponse
+	78,  // 125: protos.ConfigAgent.GetAuditLogHead:output_type -> protos.GetAuditLogHeadResponse
+	85,  // [85:126] is the sub-list for method output_type
+	44,  // [44:85] is the sub-list for method input_type
+	44,  // [44:44] is the sub-list for extension type_name
+	44,  // [44:44] is the sub-list for extension extendee
+	0,   // [0:44] is the sub-list for field type_name
+}
+
+func init() { file_oracle_pkg_agents_config_agent_protos_service_proto_init() }
+func file_oracle_pkg_agents_config_agent_protos_service_proto_init() {
+	if File_oracle_pkg_agents_config_agent_protos_service_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateCDBRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateListenerRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateListenerResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GsmSecretReference); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SecretPassword); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateDatabaseRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateDatabaseResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateUsersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateUsersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateCDBUserRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateCDBUserResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*User); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ObjectPrivilege); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TablespaceQuota); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UsersChangedRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UsersChangedResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateUsersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateUsersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PhysicalBackupRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PhysicalRestoreRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckStatusRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckStatusResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DataPumpImportRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DataPumpExportRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LROInput); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BootstrapDatabaseRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BootstrapDatabaseResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BootstrapStandbyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BootstrapStandbyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetParameterRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetParameterResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetParameterTypeValueRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetParameterTypeValueResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BounceDatabaseRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BounceDatabaseResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3293,8 +6883,8 @@ func file_oracle_pkg_agents_config_agent_protos_service_proto_init() {
 				return nil
 			}
 		}
-		file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateListenerRequest); i {
+		file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecoverConfigFileRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3305,8 +6895,8 @@ func file_oracle_pkg_agents_config_agent_protos_service_proto_init() {
 				return nil
 			}
 		}
-		file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateListenerResponse); i {
+		file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecoverConfigFileResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3317,8 +6907,8 @@ func file_oracle_pkg_agents_config_agent_protos_service_proto_init() {
 				return nil
 			}
 		}
-		file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GsmSecretReference); i {
+		file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FetchServiceImageMetaDataRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3329,8 +6919,8 @@ func file_oracle_pkg_agents_config_agent_protos_service_proto_init() {
 				return nil
 			}
 		}
-		file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateDatabaseRequest); i {
+		file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FetchServiceImageMetaDataResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3341,8 +6931,8 @@ func file_oracle_pkg_agents_config_agent_protos_service_proto_init() {
 				return nil
 			}
 		}
-		file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateDatabaseResponse); i {
+		file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ShipArchivedLogsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3353,8 +6943,8 @@ func file_oracle_pkg_agents_config_agent_protos_service_proto_init() {
 				return nil
 			}
 		}
-		file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateUsersRequest); i {
+		file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteDatabaseRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3365,8 +6955,8 @@ func file_oracle_pkg_agents_config_agent_protos_service_proto_init() {
 				return nil
 			}
 		}
-		file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateUsersResponse); i {
+		file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteDatabaseResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3377,8 +6967,8 @@ func file_oracle_pkg_agents_config_agent_protos_service_proto_init() {
 				return nil
 			}
 		}
-		file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateCDBUserRequest); i {
+		file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteBackupRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3389,8 +6979,8 @@ func file_oracle_pkg_agents_config_agent_protos_service_proto_init() {
 				return nil
 			}
 		}
-		file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateCDBUserResponse); i {
+		file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteBackupResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3401,8 +6991,8 @@ func file_oracle_pkg_agents_config_agent_protos_service_proto_init() {
 				return nil
 			}
 		}
-		file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*User); i {
+		file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateStandbyRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3413,8 +7003,8 @@ func file_oracle_pkg_agents_config_agent_protos_service_proto_init() {
 				return nil
 			}
 		}
-		file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UsersChangedRequest); i {
+		file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetUpDataGuardRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3425,8 +7015,8 @@ func file_oracle_pkg_agents_config_agent_protos_service_proto_init() {
 				return nil
 			}
 		}
-		file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UsersChangedResponse); i {
+		file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetUpDataGuardResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3437,8 +7027,8 @@ func file_oracle_pkg_agents_config_agent_protos_service_proto_init() {
 				return nil
 			}
 		}
-		file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateUsersRequest); i {
+		file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DataGuardStatusRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3449,8 +7039,8 @@ func file_oracle_pkg_agents_config_agent_protos_service_proto_init() {
 				return nil
 			}
 		}
-		file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateUsersResponse); i {
+		file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DataGuardStatusResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3461,8 +7051,8 @@ func file_oracle_pkg_agents_config_agent_protos_service_proto_init() {
 				return nil
 			}
 		}
-		file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PhysicalBackupRequest); i {
+		file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SwitchoverRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3473,8 +7063,8 @@ func file_oracle_pkg_agents_config_agent_protos_service_proto_init() {
 				return nil
 			}
 		}
-		file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PhysicalRestoreRequest); i {
+		file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SwitchoverResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3485,8 +7075,8 @@ func file_oracle_pkg_agents_config_agent_protos_service_proto_init() {
 				return nil
 			}
 		}
-		file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CheckStatusRequest); i {
+		file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FailoverRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3497,8 +7087,8 @@ func file_oracle_pkg_agents_config_agent_protos_service_proto_init() {
 				return nil
 			}
 		}
-		file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CheckStatusResponse); i {
+		file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FailoverResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3509,8 +7099,8 @@ func file_oracle_pkg_agents_config_agent_protos_service_proto_init() {
 				return nil
 			}
 		}
-		file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DataPumpImportRequest); i {
+		file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FenceDatabaseRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3521,8 +7111,8 @@ func file_oracle_pkg_agents_config_agent_protos_service_proto_init() {
 				return nil
 			}
 		}
-		file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DataPumpExportRequest); i {
+		file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FenceDatabaseResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3533,8 +7123,8 @@ func file_oracle_pkg_agents_config_agent_protos_service_proto_init() {
 				return nil
 			}
 		}
-		file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LROInput); i {
+		file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerifyBackupRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3545,8 +7135,8 @@ func file_oracle_pkg_agents_config_agent_protos_service_proto_init() {
 				return nil
 			}
 		}
-		file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BootstrapDatabaseRequest); i {
+		file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DescribeBackupRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3557,8 +7147,8 @@ func file_oracle_pkg_agents_config_agent_protos_service_proto_init() {
 				return nil
 			}
 		}
-		file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BootstrapDatabaseResponse); i {
+		file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DescribeBackupResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3569,8 +7159,8 @@ func file_oracle_pkg_agents_config_agent_protos_service_proto_init() {
 				return nil
 			}
 		}
-		file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BootstrapStandbyRequest); i {
+		file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChangeDatabaseIdentityRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3581,8 +7171,8 @@ func file_oracle_pkg_agents_config_agent_protos_service_proto_init() {
 				return nil
 			}
 		}
-		file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BootstrapStandbyResponse); i {
+		file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Tablespace); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3593,8 +7183,8 @@ func file_oracle_pkg_agents_config_agent_protos_service_proto_init() {
 				return nil
 			}
 		}
-		file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetParameterRequest); i {
+		file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SyncTablespacesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3605,8 +7195,8 @@ func file_oracle_pkg_agents_config_agent_protos_service_proto_init() {
 				return nil
 			}
 		}
-		file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetParameterResponse); i {
+		file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SyncTablespacesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3617,8 +7207,8 @@ func file_oracle_pkg_agents_config_agent_protos_service_proto_init() {
 				return nil
 			}
 		}
-		file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetParameterTypeValueRequest); i {
+		file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApplyDatapatchRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3629,8 +7219,8 @@ func file_oracle_pkg_agents_config_agent_protos_service_proto_init() {
 				return nil
 			}
 		}
-		file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetParameterTypeValueResponse); i {
+		file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApplyDatapatchResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3641,8 +7231,8 @@ func file_oracle_pkg_agents_config_agent_protos_service_proto_init() {
 				return nil
 			}
 		}
-		file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BounceDatabaseRequest); i {
+		file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetMemoryTargetsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3653,8 +7243,8 @@ func file_oracle_pkg_agents_config_agent_protos_service_proto_init() {
 				return nil
 			}
 		}
-		file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BounceDatabaseResponse); i {
+		file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetMemoryTargetsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3665,8 +7255,8 @@ func file_oracle_pkg_agents_config_agent_protos_service_proto_init() {
 				return nil
 			}
 		}
-		file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RecoverConfigFileRequest); i {
+		file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Role); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3677,8 +7267,8 @@ func file_oracle_pkg_agents_config_agent_protos_service_proto_init() {
 				return nil
 			}
 		}
-		file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RecoverConfigFileResponse); i {
+		file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SyncRolesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3689,8 +7279,8 @@ func file_oracle_pkg_agents_config_agent_protos_service_proto_init() {
 				return nil
 			}
 		}
-		file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FetchServiceImageMetaDataRequest); i {
+		file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SyncRolesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3701,8 +7291,8 @@ func file_oracle_pkg_agents_config_agent_protos_service_proto_init() {
 				return nil
 			}
 		}
-		file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FetchServiceImageMetaDataResponse); i {
+		file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Profile); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3713,7 +7303,55 @@ func file_oracle_pkg_agents_config_agent_protos_service_proto_init() {
 				return nil
 			}
 		}
-		file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+		file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SyncProfilesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SyncProfilesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAuditLogHeadRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAuditLogHeadResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UsersChangedResponse_Suppressed); i {
 			case 0:
 				return &v.state
@@ -3725,7 +7363,7 @@ func file_oracle_pkg_agents_config_agent_protos_service_proto_init() {
 				return nil
 			}
 		}
-		file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+		file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*BootstrapStandbyResponse_User); i {
 			case 0:
 				return &v.state
@@ -3737,7 +7375,7 @@ func file_oracle_pkg_agents_config_agent_protos_service_proto_init() {
 				return nil
 			}
 		}
-		file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+		file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*BootstrapStandbyResponse_PDB); i {
 			case 0:
 				return &v.state
@@ -3749,14 +7387,38 @@ func file_oracle_pkg_agents_config_agent_protos_service_proto_init() {
 				return nil
 			}
 		}
+		file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SyncTablespacesResponse_Usage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_oracle_pkg_agents_config_agent_protos_service_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApplyDatapatchResponse_SqlPatch); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_oracle_pkg_agents_config_agent_protos_service_proto_rawDesc,
-			NumEnums:      4,
-			NumMessages:   39,
+			NumEnums:      5,
+			NumMessages:   89,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
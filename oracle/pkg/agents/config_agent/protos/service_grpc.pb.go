@@ -40,6 +40,9 @@ type ConfigAgentClient interface {
 	// no longer interested in the operation result. It does not cancel the
 	// operation.
 	DeleteOperation(ctx context.Context, in *longrunning.DeleteOperationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Starts the cancellation of a long-running operation. The operation
+	// completes with a CANCELLED error once its work is stopped.
+	CancelOperation(ctx context.Context, in *longrunning.CancelOperationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	BootstrapDatabase(ctx context.Context, in *BootstrapDatabaseRequest, opts ...grpc.CallOption) (*longrunning.Operation, error)
 	BootstrapStandby(ctx context.Context, in *BootstrapStandbyRequest, opts ...grpc.CallOption) (*BootstrapStandbyResponse, error)
 	DataPumpExport(ctx context.Context, in *DataPumpExportRequest, opts ...grpc.CallOption) (*longrunning.Operation, error)
@@ -48,6 +51,24 @@ type ConfigAgentClient interface {
 	BounceDatabase(ctx context.Context, in *BounceDatabaseRequest, opts ...grpc.CallOption) (*BounceDatabaseResponse, error)
 	RecoverConfigFile(ctx context.Context, in *RecoverConfigFileRequest, opts ...grpc.CallOption) (*RecoverConfigFileResponse, error)
 	FetchServiceImageMetaData(ctx context.Context, in *FetchServiceImageMetaDataRequest, opts ...grpc.CallOption) (*FetchServiceImageMetaDataResponse, error)
+	ShipArchivedLogs(ctx context.Context, in *ShipArchivedLogsRequest, opts ...grpc.CallOption) (*longrunning.Operation, error)
+	DeleteDatabase(ctx context.Context, in *DeleteDatabaseRequest, opts ...grpc.CallOption) (*DeleteDatabaseResponse, error)
+	DeleteBackup(ctx context.Context, in *DeleteBackupRequest, opts ...grpc.CallOption) (*DeleteBackupResponse, error)
+	CreateStandby(ctx context.Context, in *CreateStandbyRequest, opts ...grpc.CallOption) (*longrunning.Operation, error)
+	SetUpDataGuard(ctx context.Context, in *SetUpDataGuardRequest, opts ...grpc.CallOption) (*SetUpDataGuardResponse, error)
+	DataGuardStatus(ctx context.Context, in *DataGuardStatusRequest, opts ...grpc.CallOption) (*DataGuardStatusResponse, error)
+	Switchover(ctx context.Context, in *SwitchoverRequest, opts ...grpc.CallOption) (*SwitchoverResponse, error)
+	Failover(ctx context.Context, in *FailoverRequest, opts ...grpc.CallOption) (*FailoverResponse, error)
+	FenceDatabase(ctx context.Context, in *FenceDatabaseRequest, opts ...grpc.CallOption) (*FenceDatabaseResponse, error)
+	VerifyBackup(ctx context.Context, in *VerifyBackupRequest, opts ...grpc.CallOption) (*longrunning.Operation, error)
+	DescribeBackup(ctx context.Context, in *DescribeBackupRequest, opts ...grpc.CallOption) (*DescribeBackupResponse, error)
+	ChangeDatabaseIdentity(ctx context.Context, in *ChangeDatabaseIdentityRequest, opts ...grpc.CallOption) (*longrunning.Operation, error)
+	SyncTablespaces(ctx context.Context, in *SyncTablespacesRequest, opts ...grpc.CallOption) (*SyncTablespacesResponse, error)
+	ApplyDatapatch(ctx context.Context, in *ApplyDatapatchRequest, opts ...grpc.CallOption) (*ApplyDatapatchResponse, error)
+	SetMemoryTargets(ctx context.Context, in *SetMemoryTargetsRequest, opts ...grpc.CallOption) (*SetMemoryTargetsResponse, error)
+	SyncRoles(ctx context.Context, in *SyncRolesRequest, opts ...grpc.CallOption) (*SyncRolesResponse, error)
+	SyncProfiles(ctx context.Context, in *SyncProfilesRequest, opts ...grpc.CallOption) (*SyncProfilesResponse, error)
+	GetAuditLogHead(ctx context.Context, in *GetAuditLogHeadRequest, opts ...grpc.CallOption) (*GetAuditLogHeadResponse, error)
 }
 
 type configAgentClient struct {
@@ -184,6 +205,15 @@ func (c *configAgentClient) DeleteOperation(ctx context.Context, in *longrunning
 	return out, nil
 }
 
+func (c *configAgentClient) CancelOperation(ctx context.Context, in *longrunning.CancelOperationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/protos.ConfigAgent/CancelOperation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *configAgentClient) BootstrapDatabase(ctx context.Context, in *BootstrapDatabaseRequest, opts ...grpc.CallOption) (*longrunning.Operation, error) {
 	out := new(longrunning.Operation)
 	err := c.cc.Invoke(ctx, "/protos.ConfigAgent/BootstrapDatabase", in, out, opts...)
@@ -256,6 +286,168 @@ func (c *configAgentClient) FetchServiceImageMetaData(ctx context.Context, in *F
 	return out, nil
 }
 
+func (c *configAgentClient) ShipArchivedLogs(ctx context.Context, in *ShipArchivedLogsRequest, opts ...grpc.CallOption) (*longrunning.Operation, error) {
+	out := new(longrunning.Operation)
+	err := c.cc.Invoke(ctx, "/protos.ConfigAgent/ShipArchivedLogs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configAgentClient) DeleteDatabase(ctx context.Context, in *DeleteDatabaseRequest, opts ...grpc.CallOption) (*DeleteDatabaseResponse, error) {
+	out := new(DeleteDatabaseResponse)
+	err := c.cc.Invoke(ctx, "/protos.ConfigAgent/DeleteDatabase", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configAgentClient) DeleteBackup(ctx context.Context, in *DeleteBackupRequest, opts ...grpc.CallOption) (*DeleteBackupResponse, error) {
+	out := new(DeleteBackupResponse)
+	err := c.cc.Invoke(ctx, "/protos.ConfigAgent/DeleteBackup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configAgentClient) CreateStandby(ctx context.Context, in *CreateStandbyRequest, opts ...grpc.CallOption) (*longrunning.Operation, error) {
+	out := new(longrunning.Operation)
+	err := c.cc.Invoke(ctx, "/protos.ConfigAgent/CreateStandby", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configAgentClient) SetUpDataGuard(ctx context.Context, in *SetUpDataGuardRequest, opts ...grpc.CallOption) (*SetUpDataGuardResponse, error) {
+	out := new(SetUpDataGuardResponse)
+	err := c.cc.Invoke(ctx, "/protos.ConfigAgent/SetUpDataGuard", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configAgentClient) DataGuardStatus(ctx context.Context, in *DataGuardStatusRequest, opts ...grpc.CallOption) (*DataGuardStatusResponse, error) {
+	out := new(DataGuardStatusResponse)
+	err := c.cc.Invoke(ctx, "/protos.ConfigAgent/DataGuardStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configAgentClient) Switchover(ctx context.Context, in *SwitchoverRequest, opts ...grpc.CallOption) (*SwitchoverResponse, error) {
+	out := new(SwitchoverResponse)
+	err := c.cc.Invoke(ctx, "/protos.ConfigAgent/Switchover", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configAgentClient) Failover(ctx context.Context, in *FailoverRequest, opts ...grpc.CallOption) (*FailoverResponse, error) {
+	out := new(FailoverResponse)
+	err := c.cc.Invoke(ctx, "/protos.ConfigAgent/Failover", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configAgentClient) FenceDatabase(ctx context.Context, in *FenceDatabaseRequest, opts ...grpc.CallOption) (*FenceDatabaseResponse, error) {
+	out := new(FenceDatabaseResponse)
+	err := c.cc.Invoke(ctx, "/protos.ConfigAgent/FenceDatabase", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configAgentClient) VerifyBackup(ctx context.Context, in *VerifyBackupRequest, opts ...grpc.CallOption) (*longrunning.Operation, error) {
+	out := new(longrunning.Operation)
+	err := c.cc.Invoke(ctx, "/protos.ConfigAgent/VerifyBackup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configAgentClient) DescribeBackup(ctx context.Context, in *DescribeBackupRequest, opts ...grpc.CallOption) (*DescribeBackupResponse, error) {
+	out := new(DescribeBackupResponse)
+	err := c.cc.Invoke(ctx, "/protos.ConfigAgent/DescribeBackup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configAgentClient) ChangeDatabaseIdentity(ctx context.Context, in *ChangeDatabaseIdentityRequest, opts ...grpc.CallOption) (*longrunning.Operation, error) {
+	out := new(longrunning.Operation)
+	err := c.cc.Invoke(ctx, "/protos.ConfigAgent/ChangeDatabaseIdentity", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configAgentClient) SyncTablespaces(ctx context.Context, in *SyncTablespacesRequest, opts ...grpc.CallOption) (*SyncTablespacesResponse, error) {
+	out := new(SyncTablespacesResponse)
+	err := c.cc.Invoke(ctx, "/protos.ConfigAgent/SyncTablespaces", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configAgentClient) ApplyDatapatch(ctx context.Context, in *ApplyDatapatchRequest, opts ...grpc.CallOption) (*ApplyDatapatchResponse, error) {
+	out := new(ApplyDatapatchResponse)
+	err := c.cc.Invoke(ctx, "/protos.ConfigAgent/ApplyDatapatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configAgentClient) SetMemoryTargets(ctx context.Context, in *SetMemoryTargetsRequest, opts ...grpc.CallOption) (*SetMemoryTargetsResponse, error) {
+	out := new(SetMemoryTargetsResponse)
+	err := c.cc.Invoke(ctx, "/protos.ConfigAgent/SetMemoryTargets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configAgentClient) SyncRoles(ctx context.Context, in *SyncRolesRequest, opts ...grpc.CallOption) (*SyncRolesResponse, error) {
+	out := new(SyncRolesResponse)
+	err := c.cc.Invoke(ctx, "/protos.ConfigAgent/SyncRoles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configAgentClient) SyncProfiles(ctx context.Context, in *SyncProfilesRequest, opts ...grpc.CallOption) (*SyncProfilesResponse, error) {
+	out := new(SyncProfilesResponse)
+	err := c.cc.Invoke(ctx, "/protos.ConfigAgent/SyncProfiles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configAgentClient) GetAuditLogHead(ctx context.Context, in *GetAuditLogHeadRequest, opts ...grpc.CallOption) (*GetAuditLogHeadResponse, error) {
+	out := new(GetAuditLogHeadResponse)
+	err := c.cc.Invoke(ctx, "/protos.ConfigAgent/GetAuditLogHead", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ConfigAgentServer is the server API for ConfigAgent service.
 // All implementations must embed UnimplementedConfigAgentServer
 // for forward compatibility
@@ -280,6 +472,9 @@ type ConfigAgentServer interface {
 	// no longer interested in the operation result. It does not cancel the
 	// operation.
 	DeleteOperation(context.Context, *longrunning.DeleteOperationRequest) (*emptypb.Empty, error)
+	// Starts the cancellation of a long-running operation. The operation
+	// completes with a CANCELLED error once its work is stopped.
+	CancelOperation(context.Context, *longrunning.CancelOperationRequest) (*emptypb.Empty, error)
 	BootstrapDatabase(context.Context, *BootstrapDatabaseRequest) (*longrunning.Operation, error)
 	BootstrapStandby(context.Context, *BootstrapStandbyRequest) (*BootstrapStandbyResponse, error)
 	DataPumpExport(context.Context, *DataPumpExportRequest) (*longrunning.Operation, error)
@@ -288,6 +483,24 @@ type ConfigAgentServer interface {
 	BounceDatabase(context.Context, *BounceDatabaseRequest) (*BounceDatabaseResponse, error)
 	RecoverConfigFile(context.Context, *RecoverConfigFileRequest) (*RecoverConfigFileResponse, error)
 	FetchServiceImageMetaData(context.Context, *FetchServiceImageMetaDataRequest) (*FetchServiceImageMetaDataResponse, error)
+	ShipArchivedLogs(context.Context, *ShipArchivedLogsRequest) (*longrunning.Operation, error)
+	DeleteDatabase(context.Context, *DeleteDatabaseRequest) (*DeleteDatabaseResponse, error)
+	DeleteBackup(context.Context, *DeleteBackupRequest) (*DeleteBackupResponse, error)
+	CreateStandby(context.Context, *CreateStandbyRequest) (*longrunning.Operation, error)
+	SetUpDataGuard(context.Context, *SetUpDataGuardRequest) (*SetUpDataGuardResponse, error)
+	DataGuardStatus(context.Context, *DataGuardStatusRequest) (*DataGuardStatusResponse, error)
+	Switchover(context.Context, *SwitchoverRequest) (*SwitchoverResponse, error)
+	Failover(context.Context, *FailoverRequest) (*FailoverResponse, error)
+	FenceDatabase(context.Context, *FenceDatabaseRequest) (*FenceDatabaseResponse, error)
+	VerifyBackup(context.Context, *VerifyBackupRequest) (*longrunning.Operation, error)
+	DescribeBackup(context.Context, *DescribeBackupRequest) (*DescribeBackupResponse, error)
+	ChangeDatabaseIdentity(context.Context, *ChangeDatabaseIdentityRequest) (*longrunning.Operation, error)
+	SyncTablespaces(context.Context, *SyncTablespacesRequest) (*SyncTablespacesResponse, error)
+	ApplyDatapatch(context.Context, *ApplyDatapatchRequest) (*ApplyDatapatchResponse, error)
+	SetMemoryTargets(context.Context, *SetMemoryTargetsRequest) (*SetMemoryTargetsResponse, error)
+	SyncRoles(context.Context, *SyncRolesRequest) (*SyncRolesResponse, error)
+	SyncProfiles(context.Context, *SyncProfilesRequest) (*SyncProfilesResponse, error)
+	GetAuditLogHead(context.Context, *GetAuditLogHeadRequest) (*GetAuditLogHeadResponse, error)
 	mustEmbedUnimplementedConfigAgentServer()
 }
 
@@ -337,6 +550,9 @@ func (UnimplementedConfigAgentServer) GetOperation(context.Context, *longrunning
 func (UnimplementedConfigAgentServer) DeleteOperation(context.Context, *longrunning.DeleteOperationRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteOperation not implemented")
 }
+func (UnimplementedConfigAgentServer) CancelOperation(context.Context, *longrunning.CancelOperationRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelOperation not implemented")
+}
 func (UnimplementedConfigAgentServer) BootstrapDatabase(context.Context, *BootstrapDatabaseRequest) (*longrunning.Operation, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BootstrapDatabase not implemented")
 }
@@ -361,6 +577,60 @@ func (UnimplementedConfigAgentServer) RecoverConfigFile(context.Context, *Recove
 func (UnimplementedConfigAgentServer) FetchServiceImageMetaData(context.Context, *FetchServiceImageMetaDataRequest) (*FetchServiceImageMetaDataResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FetchServiceImageMetaData not implemented")
 }
+func (UnimplementedConfigAgentServer) ShipArchivedLogs(context.Context, *ShipArchivedLogsRequest) (*longrunning.Operation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ShipArchivedLogs not implemented")
+}
+func (UnimplementedConfigAgentServer) DeleteDatabase(context.Context, *DeleteDatabaseRequest) (*DeleteDatabaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteDatabase not implemented")
+}
+func (UnimplementedConfigAgentServer) DeleteBackup(context.Context, *DeleteBackupRequest) (*DeleteBackupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteBackup not implemented")
+}
+func (UnimplementedConfigAgentServer) CreateStandby(context.Context, *CreateStandbyRequest) (*longrunning.Operation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateStandby not implemented")
+}
+func (UnimplementedConfigAgentServer) SetUpDataGuard(context.Context, *SetUpDataGuardRequest) (*SetUpDataGuardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetUpDataGuard not implemented")
+}
+func (UnimplementedConfigAgentServer) DataGuardStatus(context.Context, *DataGuardStatusRequest) (*DataGuardStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DataGuardStatus not implemented")
+}
+func (UnimplementedConfigAgentServer) Switchover(context.Context, *SwitchoverRequest) (*SwitchoverResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Switchover not implemented")
+}
+func (UnimplementedConfigAgentServer) Failover(context.Context, *FailoverRequest) (*FailoverResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Failover not implemented")
+}
+func (UnimplementedConfigAgentServer) FenceDatabase(context.Context, *FenceDatabaseRequest) (*FenceDatabaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FenceDatabase not implemented")
+}
+func (UnimplementedConfigAgentServer) VerifyBackup(context.Context, *VerifyBackupRequest) (*longrunning.Operation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyBackup not implemented")
+}
+func (UnimplementedConfigAgentServer) DescribeBackup(context.Context, *DescribeBackupRequest) (*DescribeBackupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeBackup not implemented")
+}
+func (UnimplementedConfigAgentServer) ChangeDatabaseIdentity(context.Context, *ChangeDatabaseIdentityRequest) (*longrunning.Operation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangeDatabaseIdentity not implemented")
+}
+func (UnimplementedConfigAgentServer) SyncTablespaces(context.Context, *SyncTablespacesRequest) (*SyncTablespacesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SyncTablespaces not implemented")
+}
+func (UnimplementedConfigAgentServer) ApplyDatapatch(context.Context, *ApplyDatapatchRequest) (*ApplyDatapatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApplyDatapatch not implemented")
+}
+func (UnimplementedConfigAgentServer) SetMemoryTargets(context.Context, *SetMemoryTargetsRequest) (*SetMemoryTargetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMemoryTargets not implemented")
+}
+func (UnimplementedConfigAgentServer) SyncRoles(context.Context, *SyncRolesRequest) (*SyncRolesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SyncRoles not implemented")
+}
+func (UnimplementedConfigAgentServer) SyncProfiles(context.Context, *SyncProfilesRequest) (*SyncProfilesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SyncProfiles not implemented")
+}
+func (UnimplementedConfigAgentServer) GetAuditLogHead(context.Context, *GetAuditLogHeadRequest) (*GetAuditLogHeadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAuditLogHead not implemented")
+}
 func (UnimplementedConfigAgentServer) mustEmbedUnimplementedConfigAgentServer() {}
 
 // UnsafeConfigAgentServer may be embedded to opt out of forward compatibility for this service.
@@ -626,6 +896,24 @@ func _ConfigAgent_DeleteOperation_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ConfigAgent_CancelOperation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(longrunning.CancelOperationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigAgentServer).CancelOperation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protos.ConfigAgent/CancelOperation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigAgentServer).CancelOperation(ctx, req.(*longrunning.CancelOperationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ConfigAgent_BootstrapDatabase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(BootstrapDatabaseRequest)
 	if err := dec(in); err != nil {
@@ -770,6 +1058,330 @@ func _ConfigAgent_FetchServiceImageMetaData_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ConfigAgent_ShipArchivedLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShipArchivedLogsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigAgentServer).ShipArchivedLogs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protos.ConfigAgent/ShipArchivedLogs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigAgentServer).ShipArchivedLogs(ctx, req.(*ShipArchivedLogsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConfigAgent_DeleteDatabase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteDatabaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigAgentServer).DeleteDatabase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protos.ConfigAgent/DeleteDatabase",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigAgentServer).DeleteDatabase(ctx, req.(*DeleteDatabaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConfigAgent_DeleteBackup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteBackupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigAgentServer).DeleteBackup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protos.ConfigAgent/DeleteBackup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigAgentServer).DeleteBackup(ctx, req.(*DeleteBackupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConfigAgent_CreateStandby_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateStandbyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigAgentServer).CreateStandby(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protos.ConfigAgent/CreateStandby",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigAgentServer).CreateStandby(ctx, req.(*CreateStandbyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConfigAgent_SetUpDataGuard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetUpDataGuardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigAgentServer).SetUpDataGuard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protos.ConfigAgent/SetUpDataGuard",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigAgentServer).SetUpDataGuard(ctx, req.(*SetUpDataGuardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConfigAgent_DataGuardStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DataGuardStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigAgentServer).DataGuardStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protos.ConfigAgent/DataGuardStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigAgentServer).DataGuardStatus(ctx, req.(*DataGuardStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConfigAgent_Switchover_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SwitchoverRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigAgentServer).Switchover(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protos.ConfigAgent/Switchover",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigAgentServer).Switchover(ctx, req.(*SwitchoverRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConfigAgent_Failover_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FailoverRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigAgentServer).Failover(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protos.ConfigAgent/Failover",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigAgentServer).Failover(ctx, req.(*FailoverRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConfigAgent_FenceDatabase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FenceDatabaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigAgentServer).FenceDatabase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protos.ConfigAgent/FenceDatabase",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigAgentServer).FenceDatabase(ctx, req.(*FenceDatabaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConfigAgent_VerifyBackup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyBackupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigAgentServer).VerifyBackup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protos.ConfigAgent/VerifyBackup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigAgentServer).VerifyBackup(ctx, req.(*VerifyBackupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConfigAgent_DescribeBackup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeBackupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigAgentServer).DescribeBackup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protos.ConfigAgent/DescribeBackup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigAgentServer).DescribeBackup(ctx, req.(*DescribeBackupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConfigAgent_ChangeDatabaseIdentity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeDatabaseIdentityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigAgentServer).ChangeDatabaseIdentity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protos.ConfigAgent/ChangeDatabaseIdentity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigAgentServer).ChangeDatabaseIdentity(ctx, req.(*ChangeDatabaseIdentityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConfigAgent_SyncTablespaces_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SyncTablespacesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigAgentServer).SyncTablespaces(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protos.ConfigAgent/SyncTablespaces",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigAgentServer).SyncTablespaces(ctx, req.(*SyncTablespacesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConfigAgent_ApplyDatapatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplyDatapatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigAgentServer).ApplyDatapatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protos.ConfigAgent/ApplyDatapatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigAgentServer).ApplyDatapatch(ctx, req.(*ApplyDatapatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConfigAgent_SetMemoryTargets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetMemoryTargetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigAgentServer).SetMemoryTargets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protos.ConfigAgent/SetMemoryTargets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigAgentServer).SetMemoryTargets(ctx, req.(*SetMemoryTargetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConfigAgent_SyncRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SyncRolesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigAgentServer).SyncRoles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protos.ConfigAgent/SyncRoles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigAgentServer).SyncRoles(ctx, req.(*SyncRolesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConfigAgent_SyncProfiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SyncProfilesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigAgentServer).SyncProfiles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protos.ConfigAgent/SyncProfiles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigAgentServer).SyncProfiles(ctx, req.(*SyncProfilesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConfigAgent_GetAuditLogHead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAuditLogHeadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigAgentServer).GetAuditLogHead(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protos.ConfigAgent/GetAuditLogHead",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigAgentServer).GetAuditLogHead(ctx, req.(*GetAuditLogHeadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ConfigAgent_ServiceDesc is the grpc.ServiceDesc for ConfigAgent service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -833,6 +1445,10 @@ var ConfigAgent_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteOperation",
 			Handler:    _ConfigAgent_DeleteOperation_Handler,
 		},
+		{
+			MethodName: "CancelOperation",
+			Handler:    _ConfigAgent_CancelOperation_Handler,
+		},
 		{
 			MethodName: "BootstrapDatabase",
 			Handler:    _ConfigAgent_BootstrapDatabase_Handler,
@@ -865,6 +1481,78 @@ var ConfigAgent_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "FetchServiceImageMetaData",
 			Handler:    _ConfigAgent_FetchServiceImageMetaData_Handler,
 		},
+		{
+			MethodName: "ShipArchivedLogs",
+			Handler:    _ConfigAgent_ShipArchivedLogs_Handler,
+		},
+		{
+			MethodName: "DeleteDatabase",
+			Handler:    _ConfigAgent_DeleteDatabase_Handler,
+		},
+		{
+			MethodName: "DeleteBackup",
+			Handler:    _ConfigAgent_DeleteBackup_Handler,
+		},
+		{
+			MethodName: "CreateStandby",
+			Handler:    _ConfigAgent_CreateStandby_Handler,
+		},
+		{
+			MethodName: "SetUpDataGuard",
+			Handler:    _ConfigAgent_SetUpDataGuard_Handler,
+		},
+		{
+			MethodName: "DataGuardStatus",
+			Handler:    _ConfigAgent_DataGuardStatus_Handler,
+		},
+		{
+			MethodName: "Switchover",
+			Handler:    _ConfigAgent_Switchover_Handler,
+		},
+		{
+			MethodName: "Failover",
+			Handler:    _ConfigAgent_Failover_Handler,
+		},
+		{
+			MethodName: "FenceDatabase",
+			Handler:    _ConfigAgent_FenceDatabase_Handler,
+		},
+		{
+			MethodName: "VerifyBackup",
+			Handler:    _ConfigAgent_VerifyBackup_Handler,
+		},
+		{
+			MethodName: "DescribeBackup",
+			Handler:    _ConfigAgent_DescribeBackup_Handler,
+		},
+		{
+			MethodName: "ChangeDatabaseIdentity",
+			Handler:    _ConfigAgent_ChangeDatabaseIdentity_Handler,
+		},
+		{
+			MethodName: "SyncTablespaces",
+			Handler:    _ConfigAgent_SyncTablespaces_Handler,
+		},
+		{
+			MethodName: "ApplyDatapatch",
+			Handler:    _ConfigAgent_ApplyDatapatch_Handler,
+		},
+		{
+			MethodName: "SetMemoryTargets",
+			Handler:    _ConfigAgent_SetMemoryTargets_Handler,
+		},
+		{
+			MethodName: "SyncRoles",
+			Handler:    _ConfigAgent_SyncRoles_Handler,
+		},
+		{
+			MethodName: "SyncProfiles",
+			Handler:    _ConfigAgent_SyncProfiles_Handler,
+		},
+		{
+			MethodName: "GetAuditLogHead",
+			Handler:    _ConfigAgent_GetAuditLogHead_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "oracle/pkg/agents/config_agent/protos/service.proto",
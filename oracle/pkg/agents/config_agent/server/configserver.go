@@ -21,17 +21,22 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"regexp"
+	"strconv"
 	"strings"
+	"time"
 
 	secretmanager "cloud.google.com/go/secretmanager/apiv1"
 	"github.com/golang/protobuf/ptypes/empty"
 	secretmanagerpb "google.golang.org/genproto/googleapis/cloud/secretmanager/v1"
 	lropb "google.golang.org/genproto/googleapis/longrunning"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	"k8s.io/klog/v2"
 
 	"github.com/GoogleCloudPlatform/elcarro-oracle-operator/oracle/pkg/agents/backup"
 	"github.com/GoogleCloudPlatform/elcarro-oracle-operator/oracle/pkg/agents/common"
+	"github.com/GoogleCloudPlatform/elcarro-oracle-operator/oracle/pkg/agents/common/mtls"
 	"github.com/GoogleCloudPlatform/elcarro-oracle-operator/oracle/pkg/agents/common/sql"
 	pb "github.com/GoogleCloudPlatform/elcarro-oracle-operator/oracle/pkg/agents/config_agent/protos"
 	"github.com/GoogleCloudPlatform/elcarro-oracle-operator/oracle/pkg/agents/consts"
@@ -43,11 +48,44 @@ const (
 	version      = "12.2"
 	pdbAdmin     = "GPDB_ADMIN"
 	gsmSecretStr = "projects/%s/secrets/%s/versions/%s"
+
+	// operationPollInterval is how often waitForOperation polls an operation.
+	operationPollInterval = 10 * time.Second
+
+	// backupSCNRangeSQL returns the highest checkpoint SCN of the datafile
+	// backups and the highest next SCN of the archived redo log backups with
+	// a tag.
+	backupSCNRangeSQL = `select
+		(select to_char(max(d.checkpoint_change#)) from v$backup_datafile d, v$backup_piece p
+			where d.set_stamp=p.set_stamp and d.set_count=p.set_count and p.tag='%s' and p.status='A') as min_scn,
+		(select to_char(max(r.next_change#)) from v$backup_redolog r, v$backup_piece p
+			where r.set_stamp=p.set_stamp and r.set_count=p.set_count and p.tag='%s' and p.status='A') as max_scn
+		from dual`
+)
+
+const (
+	databaseNameSQL = "select name from v$database"
+
+	// sqlPatchesSQL returns the last action on every patch applied by
+	// datapatch.
+	sqlPatchesSQL = `select to_char(patch_id) as patch_id, action, status, description from dba_registry_sqlpatch r
+		where action_time = (select max(action_time) from dba_registry_sqlpatch where patch_id = r.patch_id)
+		order by patch_id`
 )
 
 var (
+	// cdbNameRegexp matches valid db_name values.
+	cdbNameRegexp = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_$#]{0,7}$`)
+
 	newDBDClient = func(ctx context.Context, server *ConfigServer) (dbdpb.DatabaseDaemonClient, func() error, error) {
-		conn, err := common.DatabaseDaemonDialService(ctx, fmt.Sprintf("%s:%d", server.DBService, server.DBPort), grpc.WithBlock())
+		var creds credentials.TransportCredentials
+		if server.TLSCertDir != "" {
+			var err error
+			if creds, err = mtls.NewClientCredentials(server.TLSCertDir, server.DBService); err != nil {
+				return nil, func() error { return nil }, err
+			}
+		}
+		conn, err := common.DatabaseDaemonDialService(ctx, fmt.Sprintf("%s:%d", server.DBService, server.DBPort), creds, grpc.WithBlock())
 		if err != nil {
 			return nil, func() error { return nil }, err
 		}
@@ -86,6 +124,9 @@ type ConfigServer struct {
 	*pb.UnimplementedConfigAgentServer
 	DBService string
 	DBPort    int
+	// TLSCertDir holds the certificates for the mutual TLS with the
+	// Database Daemon, the connection is not encrypted if it is empty.
+	TLSCertDir string
 }
 
 // CheckStatus runs a requested set of state checks.
@@ -137,7 +178,7 @@ func (s *ConfigServer) CheckStatus(ctx context.Context, req *pb.CheckStatusReque
 
 // PhysicalRestore restores an RMAN backup (downloaded from GCS).
 func (s *ConfigServer) PhysicalRestore(ctx context.Context, req *pb.PhysicalRestoreRequest) (*lropb.Operation, error) {
-	klog.InfoS("configagent/PhysicalRestore", "req", req)
+	klog.InfoS("configagent/PhysicalRestore", "req", common.RedactStorageCredentials(req))
 
 	client, closeConn, err := newDBDClient(ctx, s)
 	if err != nil {
@@ -146,41 +187,34 @@ func (s *ConfigServer) PhysicalRestore(ctx context.Context, req *pb.PhysicalRest
 	defer closeConn()
 	klog.InfoS("configagent/PhysicalRestore", "client", client)
 
-	return backup.PhysicalRestore(ctx, &backup.Params{
-		Client:       client,
-		InstanceName: req.GetInstanceName(),
-		CDBName:      req.CdbName,
-		DOP:          req.GetDop(),
-		LocalPath:    req.GetLocalPath(),
-		GCSPath:      req.GetGcsPath(),
-		OperationID:  req.GetLroInput().GetOperationId(),
-	})
+	params := &backup.Params{
+		Client:             client,
+		InstanceName:       req.GetInstanceName(),
+		CDBName:            req.CdbName,
+		DOP:                req.GetDop(),
+		LocalPath:          req.GetLocalPath(),
+		GCSPath:            req.GetGcsPath(),
+		StorageCredentials: req.GetStorageCredentials(),
+		OperationID:        req.GetLroInput().GetOperationId(),
+		UntilSCN:           req.GetUntilScn(),
+		UntilRestorePoint:  req.GetUntilRestorePoint(),
+		SourceCDBName:      req.GetSourceCdbName(),
+		PDBName:            req.GetPdbName(),
+		SwitchToCopy:       req.GetSwitchToCopy(),
+	}
+	if req.GetUntilTime() != nil {
+		params.UntilTime = req.GetUntilTime().AsTime()
+	}
+	return backup.PhysicalRestore(ctx, params)
 }
 
 // PhysicalBackup starts an RMAN backup and stores it in the GCS bucket provided.
 func (s *ConfigServer) PhysicalBackup(ctx context.Context, req *pb.PhysicalBackupRequest) (*lropb.Operation, error) {
-	klog.InfoS("configagent/PhysicalBackup", "req", req)
+	klog.InfoS("configagent/PhysicalBackup", "req", common.RedactStorageCredentials(req))
 
-	var granularity string
-	switch req.BackupSubType {
-	case pb.PhysicalBackupRequest_INSTANCE:
-		granularity = "database"
-	case pb.PhysicalBackupRequest_DATABASE:
-		if req.GetBackupItems() == nil {
-			return &lropb.Operation{}, fmt.Errorf("configagent/PhysicalBackup: failed a pre-flight check: a PDB backup is requested, but no PDB name(s) given")
-		}
-
-		granularity = "pluggable database "
-		for i, pdb := range req.GetBackupItems() {
-			if i == 0 {
-				granularity += pdb
-			} else {
-				granularity += ", "
-				granularity += pdb
-			}
-		}
-	default:
-		return &lropb.Operation{}, fmt.Errorf("configagent/PhysicalBackup: unsupported in this release sub backup type of %v", req.BackupSubType)
+	granularity, err := backupGranularity(req.GetBackupSubType(), req.GetBackupItems())
+	if err != nil {
+		return &lropb.Operation{}, fmt.Errorf("configagent/PhysicalBackup: %v", err)
 	}
 	klog.InfoS("configagent/PhysicalBackup", "granularity", granularity)
 
@@ -192,21 +226,41 @@ func (s *ConfigServer) PhysicalBackup(ctx context.Context, req *pb.PhysicalBacku
 	klog.InfoS("configagent/PhysicalBackup", "client", client)
 
 	return backup.PhysicalBackup(ctx, &backup.Params{
-		Client:       client,
-		Granularity:  granularity,
-		Backupset:    req.GetBackupset(),
-		CheckLogical: req.GetCheckLogical(),
-		Compressed:   req.GetCompressed(),
-		DOP:          req.GetDop(),
-		Level:        req.GetLevel(),
-		Filesperset:  req.GetFilesperset(),
-		SectionSize:  req.GetSectionSize(),
-		LocalPath:    req.GetLocalPath(),
-		GCSPath:      req.GetGcsPath(),
-		OperationID:  req.GetLroInput().GetOperationId(),
+		Client:               client,
+		Granularity:          granularity,
+		Backupset:            req.GetBackupset(),
+		CheckLogical:         req.GetCheckLogical(),
+		Compressed:           req.GetCompressed(),
+		DOP:                  req.GetDop(),
+		Level:                req.GetLevel(),
+		Cumulative:           req.GetCumulative(),
+		IncrementallyUpdated: req.GetIncrementallyUpdated(),
+		Filesperset:          req.GetFilesperset(),
+		SectionSize:          req.GetSectionSize(),
+		LocalPath:            req.GetLocalPath(),
+		GCSPath:              req.GetGcsPath(),
+		StorageCredentials:   req.GetStorageCredentials(),
+		OperationID:          req.GetLroInput().GetOperationId(),
+		Tag:                  req.GetBackupTag(),
 	})
 }
 
+// backupGranularity returns the RMAN granularity of a physical backup of the
+// given sub type.
+func backupGranularity(subType pb.PhysicalBackupRequest_Type, items []string) (string, error) {
+	switch subType {
+	case pb.PhysicalBackupRequest_INSTANCE:
+		return "database", nil
+	case pb.PhysicalBackupRequest_DATABASE:
+		if items == nil {
+			return "", fmt.Errorf("failed a pre-flight check: a PDB backup is requested, but no PDB name(s) given")
+		}
+		return "pluggable database " + strings.Join(items, ", "), nil
+	default:
+		return "", fmt.Errorf("unsupported in this release sub backup type of %v", subType)
+	}
+}
+
 // CreateCDB creates a CDB using dbca.
 func (s *ConfigServer) CreateCDB(ctx context.Context, req *pb.CreateCDBRequest) (*lropb.Operation, error) {
 	klog.InfoS("configagent/CreateCDB", "req", req)
@@ -216,19 +270,27 @@ func (s *ConfigServer) CreateCDB(ctx context.Context, req *pb.CreateCDBRequest)
 	}
 	defer closeConn()
 
-	_, err = dbdClient.CreateCDB(ctx, &dbdpb.CreateCDBRequest{
-		OracleHome:       req.GetOracleHome(),
-		DatabaseName:     req.GetSid(),
-		Version:          req.GetVersion(),
-		DbUniqueName:     req.GetDbUniqueName(),
-		CharacterSet:     req.GetCharacterSet(),
-		MemoryPercent:    req.GetMemoryPercent(),
-		AdditionalParams: req.GetAdditionalParams(),
-		DbDomain:         req.GetDbDomain(),
+	// The CDB is created in a long running operation of the Database Daemon
+	// which reports the DBCA progress, the call waits for it to complete.
+	op, err := dbdClient.CreateCDBAsync(ctx, &dbdpb.CreateCDBAsyncRequest{
+		SyncRequest: &dbdpb.CreateCDBRequest{
+			OracleHome:       req.GetOracleHome(),
+			DatabaseName:     req.GetSid(),
+			Version:          req.GetVersion(),
+			DbUniqueName:     req.GetDbUniqueName(),
+			CharacterSet:     req.GetCharacterSet(),
+			MemoryPercent:    req.GetMemoryPercent(),
+			AdditionalParams: req.GetAdditionalParams(),
+			DbDomain:         req.GetDbDomain(),
+		},
+		LroInput: &dbdpb.LROInput{OperationId: req.GetLroInput().GetOperationId()},
 	})
 	if err != nil {
 		return nil, fmt.Errorf("configagent/CreateCDB: failed to create CDB: %v", err)
 	}
+	if err := waitForOperation(ctx, dbdClient, op.GetName()); err != nil {
+		return nil, fmt.Errorf("configagent/CreateCDB: failed to create CDB: %v", err)
+	}
 
 	if _, err := dbdClient.BounceDatabase(ctx, &dbdpb.BounceDatabaseRequest{
 		Operation:    dbdpb.BounceDatabaseRequest_SHUTDOWN,
@@ -240,6 +302,28 @@ func (s *ConfigServer) CreateCDB(ctx context.Context, req *pb.CreateCDBRequest)
 	return &lropb.Operation{Done: true}, nil
 }
 
+// waitForOperation polls a long running operation of the Database Daemon
+// until it's done and returns its error.
+func waitForOperation(ctx context.Context, dbdClient dbdpb.DatabaseDaemonClient, name string) error {
+	for {
+		op, err := dbdClient.GetOperation(ctx, &lropb.GetOperationRequest{Name: name})
+		if err != nil {
+			return err
+		}
+		if op.GetDone() {
+			if op.GetError() != nil {
+				return errors.New(op.GetError().GetMessage())
+			}
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(operationPollInterval):
+		}
+	}
+}
+
 // CreateListener invokes dbdaemon.CreateListener.
 func (s *ConfigServer) CreateListener(ctx context.Context, req *pb.CreateListenerRequest) (*pb.CreateListenerResponse, error) {
 	klog.InfoS("configagent/CreateListener", "req", req)
@@ -265,7 +349,7 @@ func (s *ConfigServer) CreateListener(ctx context.Context, req *pb.CreateListene
 
 // CreateDatabase creates PDB as requested.
 func (s *ConfigServer) CreateDatabase(ctx context.Context, req *pb.CreateDatabaseRequest) (*pb.CreateDatabaseResponse, error) {
-	klog.InfoS("configagent/CreateDatabase", "req", req)
+	klog.InfoS("configagent/CreateDatabase", "req", common.RedactPasswords(req))
 
 	var pwd string
 	var err error
@@ -275,6 +359,11 @@ func (s *ConfigServer) CreateDatabase(ctx context.Context, req *pb.CreateDatabas
 		pwd = req.Password
 	}
 
+	toUpdateSecretAdminPwd := req.AdminPasswordSecret != nil && req.AdminPasswordSecret.Version != req.AdminPasswordSecret.LastVersion
+	if toUpdateSecretAdminPwd {
+		pwd = req.AdminPasswordSecret.Password
+	}
+
 	toUpdateGsmAdminPwd := req.AdminPasswordGsmSecretRef != nil && (req.AdminPasswordGsmSecretRef.Version != req.AdminPasswordGsmSecretRef.LastVersion || req.AdminPasswordGsmSecretRef.Version == "latest")
 	if toUpdateGsmAdminPwd {
 		pwd, err = AccessSecretVersionFunc(ctx, fmt.Sprintf(gsmSecretStr, req.AdminPasswordGsmSecretRef.ProjectId, req.AdminPasswordGsmSecretRef.SecretId, req.AdminPasswordGsmSecretRef.Version))
@@ -309,7 +398,7 @@ func (s *ConfigServer) CreateDatabase(ctx context.Context, req *pb.CreateDatabas
 	klog.InfoS("configagent/CreateDatabase pre-flight check#2", "pdb", p.pluggableDatabaseName, "resp", resp)
 
 	if resp.Msg != nil {
-		if toUpdateGsmAdminPwd || toUpdatePlaintextAdminPwd {
+		if toUpdateGsmAdminPwd || toUpdatePlaintextAdminPwd || toUpdateSecretAdminPwd {
 			sqls := append([]string{sql.QuerySetSessionContainer(p.pluggableDatabaseName)}, []string{sql.QueryAlterUser(pdbAdmin, pwd)}...)
 			if _, err := client.RunSQLPlus(ctx, &dbdpb.RunSQLPlusCMDRequest{
 				Commands: sqls,
@@ -384,7 +473,7 @@ func (s *ConfigServer) CreateUsers(ctx context.Context, req *pb.CreateUsersReque
 	// UsersChanged is called before this function by caller (db controller) to check if
 	// the users requested are already existing.
 	// Thus no duplicated list user check is performed here.
-	klog.InfoS("configagent/CreateUsers", "req", req)
+	klog.InfoS("configagent/CreateUsers", "req", common.RedactPasswords(req))
 
 	p, err := buildPDB(req.GetCdbName(), req.GetPdbName(), "", version, consts.ListenerNames, true)
 	if err != nil {
@@ -440,19 +529,35 @@ func (s *ConfigServer) CreateUsers(ctx context.Context, req *pb.CreateUsersReque
 
 // UsersChanged determines whether there is change on users (update/delete/create).
 func (s *ConfigServer) UsersChanged(ctx context.Context, req *pb.UsersChangedRequest) (*pb.UsersChangedResponse, error) {
-	klog.InfoS("configagent/UsersChanged", "req", req)
+	klog.InfoS("configagent/UsersChanged", "req", common.RedactPasswords(req))
+	if err := validateDeletionPolicy(req.GetDeletionPolicy()); err != nil {
+		return nil, fmt.Errorf("configagent/UsersChanged: %v", err)
+	}
+	for _, u := range req.GetUserSpecs() {
+		if err := validateObjectPrivileges(u.GetObjectPrivileges()); err != nil {
+			return nil, fmt.Errorf("configagent/UsersChanged: user %q: %v", u.GetName(), err)
+		}
+		if err := validateAccount(u); err != nil {
+			return nil, fmt.Errorf("configagent/UsersChanged: user %q: %v", u.GetName(), err)
+		}
+	}
 	client, closeConn, err := newDBDClient(ctx, s)
 	if err != nil {
 		return nil, fmt.Errorf("configagent/UsersChanged: failed to create database daemon client: %v", err)
 	}
 	defer closeConn()
-	us := newUsers(req.GetPdbName(), req.GetUserSpecs())
+	us := newUsers(req.GetPdbName(), req.GetUserSpecs(), req.GetManagedUsers())
 	toCreate, toUpdate, toDelete, toUpdatePwd, err := us.diff(ctx, client)
 	if err != nil {
 		return nil, fmt.Errorf("configagent/UsersChanged: failed to get difference between env and spec for users: %v", err)
 	}
 	var suppressed []*pb.UsersChangedResponse_Suppressed
+	toRemove := false
 	for _, du := range toDelete {
+		if policy := us.deletionPolicy(du, req.GetDeletionPolicy()); policy != pb.UsersChangedRequest_RETAIN {
+			toRemove = toRemove || len(du.deleteCmds(policy)) != 0
+			continue
+		}
 		suppressed = append(suppressed, &pb.UsersChangedResponse_Suppressed{
 			SuppressType: pb.UsersChangedResponse_DELETE,
 			UserName:     du.userName,
@@ -468,8 +573,9 @@ func (s *ConfigServer) UsersChanged(ctx context.Context, req *pb.UsersChangedReq
 		}
 	}
 	resp := &pb.UsersChangedResponse{
-		Changed:    len(toCreate) != 0 || len(toUpdate) != 0 || len(toUpdatePwd) != 0,
-		Suppressed: suppressed,
+		Changed:         len(toCreate) != 0 || len(toUpdate) != 0 || len(toUpdatePwd) != 0 || toRemove,
+		Suppressed:      suppressed,
+		AccountStatuses: us.accountStatuses(req.GetDeletionPolicy()),
 	}
 	klog.InfoS("configagent/UsersChanged: DONE", "resp", resp)
 	return resp, nil
@@ -477,15 +583,26 @@ func (s *ConfigServer) UsersChanged(ctx context.Context, req *pb.UsersChangedReq
 
 // UpdateUsers update/create users as requested.
 func (s *ConfigServer) UpdateUsers(ctx context.Context, req *pb.UpdateUsersRequest) (*pb.UpdateUsersResponse, error) {
-	klog.InfoS("configagent/UpdateUsers", "req", req)
+	klog.InfoS("configagent/UpdateUsers", "req", common.RedactPasswords(req))
+	if err := validateDeletionPolicy(req.GetDeletionPolicy()); err != nil {
+		return nil, fmt.Errorf("configagent/UpdateUsers: %v", err)
+	}
+	for _, u := range req.GetUserSpecs() {
+		if err := validateObjectPrivileges(u.GetObjectPrivileges()); err != nil {
+			return nil, fmt.Errorf("configagent/UpdateUsers: user %q: %v", u.GetName(), err)
+		}
+		if err := validateAccount(u); err != nil {
+			return nil, fmt.Errorf("configagent/UpdateUsers: user %q: %v", u.GetName(), err)
+		}
+	}
 
 	client, closeConn, err := newDBDClient(ctx, s)
 	if err != nil {
 		return nil, fmt.Errorf("configagent/UpdateUsers: failed to create database daemon client: %v", err)
 	}
 	defer closeConn()
-	us := newUsers(req.GetPdbName(), req.GetUserSpecs())
-	toCreate, toUpdate, _, toUpdatePwd, err := us.diff(ctx, client)
+	us := newUsers(req.GetPdbName(), req.GetUserSpecs(), req.GetManagedUsers())
+	toCreate, toUpdate, toDelete, toUpdatePwd, err := us.diff(ctx, client)
 	if err != nil {
 		return nil, fmt.Errorf("configagent/UpdateUsers: failed to get difference between env and spec for users: %v", err)
 	}
@@ -518,11 +635,172 @@ func (s *ConfigServer) UpdateUsers(ctx context.Context, req *pb.UpdateUsersReque
 		}
 	}
 
+	resp := &pb.UpdateUsersResponse{}
+	for _, u := range toDelete {
+		policy := us.deletionPolicy(u, req.GetDeletionPolicy())
+		if len(u.deleteCmds(policy)) == 0 {
+			continue
+		}
+		klog.InfoS("configagent/UpdateUsers", "removing user", u.userName, "policy", policy)
+		if err := u.remove(ctx, client, policy); err != nil {
+			klog.ErrorS(err, "failed to remove user")
+			foundErr = true
+			continue
+		}
+		if policy == pb.UsersChangedRequest_LOCK {
+			resp.LockedUsers = append(resp.LockedUsers, u.userName)
+		} else {
+			resp.DroppedUsers = append(resp.DroppedUsers, u.userName)
+		}
+	}
+
 	if foundErr {
 		return nil, errors.New("failed to update users")
 	}
-	klog.InfoS("configagent/UpdateUsers: DONE")
-	return &pb.UpdateUsersResponse{}, nil
+	if err := us.readEnv(ctx, client); err != nil {
+		return nil, fmt.Errorf("configagent/UpdateUsers: failed to read the env users: %v", err)
+	}
+	resp.AccountStatuses = us.accountStatuses(req.GetDeletionPolicy())
+	klog.InfoS("configagent/UpdateUsers: DONE", "resp", resp)
+	return resp, nil
+}
+
+// SyncTablespaces creates the requested tablespaces which don't exist yet and
+// alters the autoextend settings of the existing ones. Tablespaces are never
+// dropped. It returns the usage of the requested tablespaces.
+func (s *ConfigServer) SyncTablespaces(ctx context.Context, req *pb.SyncTablespacesRequest) (*pb.SyncTablespacesResponse, error) {
+	klog.InfoS("configagent/SyncTablespaces", "req", req)
+
+	for _, t := range req.GetTablespaces() {
+		if _, err := sql.ObjectName(t.GetName()); err != nil {
+			return nil, fmt.Errorf("configagent/SyncTablespaces: invalid tablespace name %q: %v", t.GetName(), err)
+		}
+		if t.GetInitialSizeBytes() <= 0 {
+			return nil, fmt.Errorf("configagent/SyncTablespaces: invalid initial size %d of tablespace %q", t.GetInitialSizeBytes(), t.GetName())
+		}
+	}
+
+	client, closeConn, err := newDBDClient(ctx, s)
+	if err != nil {
+		return nil, fmt.Errorf("configagent/SyncTablespaces: failed to create database daemon client: %v", err)
+	}
+	defer closeConn()
+
+	ts := newTablespaces(req.GetPdbName(), req.GetTablespaces())
+	toCreate, toAlter, err := ts.diff(ctx, client)
+	if err != nil {
+		return nil, fmt.Errorf("configagent/SyncTablespaces: failed to get difference between env and spec for tablespaces: %v", err)
+	}
+	if len(toCreate) != 0 || len(toAlter) != 0 {
+		cmds := []string{sql.QuerySetSessionContainer(ts.databaseName)}
+		for _, t := range toCreate {
+			cmds = append(cmds, sql.QueryCreateTablespace(t.GetName(), t.GetBigfile(), t.GetInitialSizeBytes(), t.GetAutoExtend(), t.GetMaxSizeBytes()))
+		}
+		cmds = append(cmds, toAlter...)
+		if _, err := client.RunSQLPlus(ctx, &dbdpb.RunSQLPlusCMDRequest{Commands: cmds}); err != nil {
+			return nil, fmt.Errorf("configagent/SyncTablespaces: failed to sync tablespaces in PDB %s: %v", req.GetPdbName(), err)
+		}
+		if err := ts.readEnv(ctx, client); err != nil {
+			return nil, fmt.Errorf("configagent/SyncTablespaces: failed to read the env tablespaces: %v", err)
+		}
+	}
+
+	resp := &pb.SyncTablespacesResponse{Usages: ts.usage()}
+	klog.InfoS("configagent/SyncTablespaces: DONE", "resp", resp)
+	return resp, nil
+}
+
+// SyncRoles creates the requested roles which don't exist yet and grants and
+// revokes the privileges of the roles. Roles are never dropped.
+func (s *ConfigServer) SyncRoles(ctx context.Context, req *pb.SyncRolesRequest) (*pb.SyncRolesResponse, error) {
+	klog.InfoS("configagent/SyncRoles", "req", req)
+
+	for _, r := range req.GetRoles() {
+		if r.GetName() == "" {
+			return nil, errors.New("configagent/SyncRoles: missing role name")
+		}
+		if _, err := sql.ObjectName(r.GetName()); err != nil {
+			return nil, fmt.Errorf("configagent/SyncRoles: invalid role name %q: %v", r.GetName(), err)
+		}
+		for _, p := range r.GetPrivileges() {
+			if !sql.IsPrivilege(p) {
+				return nil, fmt.Errorf("configagent/SyncRoles: invalid privilege %q of role %q", p, r.GetName())
+			}
+		}
+		if err := validateObjectPrivileges(r.GetObjectPrivileges()); err != nil {
+			return nil, fmt.Errorf("configagent/SyncRoles: role %q: %v", r.GetName(), err)
+		}
+	}
+
+	client, closeConn, err := newDBDClient(ctx, s)
+	if err != nil {
+		return nil, fmt.Errorf("configagent/SyncRoles: failed to create database daemon client: %v", err)
+	}
+	defer closeConn()
+
+	rs := newRoles(req.GetPdbName(), req.GetRoles())
+	cmds, err := rs.diff(ctx, client)
+	if err != nil {
+		return nil, fmt.Errorf("configagent/SyncRoles: failed to get difference between env and spec for roles: %v", err)
+	}
+	if len(cmds) != 0 {
+		sqls := append([]string{sql.QuerySetSessionContainer(rs.databaseName)}, cmds...)
+		if _, err := client.RunSQLPlus(ctx, &dbdpb.RunSQLPlusCMDRequest{Commands: sqls}); err != nil {
+			return nil, fmt.Errorf("configagent/SyncRoles: failed to sync roles in PDB %s: %v", req.GetPdbName(), err)
+		}
+	}
+
+	klog.InfoS("configagent/SyncRoles: DONE", "pdb", req.GetPdbName())
+	return &pb.SyncRolesResponse{}, nil
+}
+
+// SyncProfiles creates the requested profiles which don't exist yet and
+// alters the requested limits of the existing ones. Profiles are never
+// dropped.
+func (s *ConfigServer) SyncProfiles(ctx context.Context, req *pb.SyncProfilesRequest) (*pb.SyncProfilesResponse, error) {
+	klog.InfoS("configagent/SyncProfiles", "req", req)
+
+	for _, p := range req.GetProfiles() {
+		if err := validateProfile(p); err != nil {
+			return nil, fmt.Errorf("configagent/SyncProfiles: %v", err)
+		}
+	}
+
+	client, closeConn, err := newDBDClient(ctx, s)
+	if err != nil {
+		return nil, fmt.Errorf("configagent/SyncProfiles: failed to create database daemon client: %v", err)
+	}
+	defer closeConn()
+
+	ps := newProfiles(req.GetPdbName(), req.GetProfiles())
+	cmds, err := ps.diff(ctx, client)
+	if err != nil {
+		return nil, fmt.Errorf("configagent/SyncProfiles: failed to get difference between env and spec for profiles: %v", err)
+	}
+	if len(cmds) != 0 {
+		sqls := append([]string{sql.QuerySetSessionContainer(ps.databaseName)}, cmds...)
+		if _, err := client.RunSQLPlus(ctx, &dbdpb.RunSQLPlusCMDRequest{Commands: sqls}); err != nil {
+			return nil, fmt.Errorf("configagent/SyncProfiles: failed to sync profiles in PDB %s: %v", req.GetPdbName(), err)
+		}
+	}
+
+	klog.InfoS("configagent/SyncProfiles: DONE", "pdb", req.GetPdbName())
+	return &pb.SyncProfilesResponse{}, nil
+}
+
+// GetAuditLogHead returns the head of the audit log of the Database Daemon.
+func (s *ConfigServer) GetAuditLogHead(ctx context.Context, req *pb.GetAuditLogHeadRequest) (*pb.GetAuditLogHeadResponse, error) {
+	client, closeConn, err := newDBDClient(ctx, s)
+	if err != nil {
+		return nil, fmt.Errorf("configagent/GetAuditLogHead: failed to create database daemon client: %v", err)
+	}
+	defer closeConn()
+
+	resp, err := client.GetAuditLogHead(ctx, &dbdpb.GetAuditLogHeadRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("configagent/GetAuditLogHead: failed to get the head of the audit log: %v", err)
+	}
+	return &pb.GetAuditLogHeadResponse{Seq: resp.GetSeq(), Hash: resp.GetHash()}, nil
 }
 
 // GetOperation fetches corresponding lro given operation name.
@@ -564,6 +842,18 @@ func (s *ConfigServer) DeleteOperation(ctx context.Context, req *lropb.DeleteOpe
 	return client.DeleteOperation(ctx, req)
 }
 
+// CancelOperation cancels lro given by name.
+func (s *ConfigServer) CancelOperation(ctx context.Context, req *lropb.CancelOperationRequest) (*empty.Empty, error) {
+	klog.InfoS("configagent/CancelOperation", "req", req)
+	client, closeConn, err := newDBDClient(ctx, s)
+	if err != nil {
+		return nil, fmt.Errorf("configagent/CancelOperation: failed to create database daemon client: %v", err)
+	}
+	defer func() { _ = closeConn() }()
+
+	return client.CancelOperation(ctx, req)
+}
+
 // CreateCDBUser creates CDB user as requested.
 func (s *ConfigServer) CreateCDBUser(ctx context.Context, req *pb.CreateCDBUserRequest) (*pb.CreateCDBUserResponse, error) {
 	klog.InfoS("configagent/CreateCDBUser", "req", req)
@@ -656,7 +946,7 @@ func (s *ConfigServer) BootstrapStandby(ctx context.Context, req *pb.BootstrapSt
 
 	var migratedPDBs []*pb.BootstrapStandbyResponse_PDB
 	for _, pdb := range knownPDBsResp.GetKnownPdbs() {
-		us := newUsers(pdb, []*pb.User{})
+		us := newUsers(pdb, []*pb.User{}, nil)
 		_, _, existingUsers, _, err := us.diff(ctx, dbdClient)
 		if err != nil {
 			return nil, fmt.Errorf("configagent/BootstrapStandby: failed to get existing users for pdb %v: %v", pdb, err)
@@ -697,7 +987,7 @@ func (s *ConfigServer) BootstrapDatabase(ctx context.Context, req *pb.BootstrapD
 
 // DataPumpImport imports data dump file provided in GCS path.
 func (s *ConfigServer) DataPumpImport(ctx context.Context, req *pb.DataPumpImportRequest) (*lropb.Operation, error) {
-	klog.InfoS("configagent/DataPumpImport", "req", req)
+	klog.InfoS("configagent/DataPumpImport", "req", common.RedactStorageCredentials(req))
 
 	client, closeConn, err := newDBDClient(ctx, s)
 	if err != nil {
@@ -707,10 +997,11 @@ func (s *ConfigServer) DataPumpImport(ctx context.Context, req *pb.DataPumpImpor
 
 	return client.DataPumpImportAsync(ctx, &dbdpb.DataPumpImportAsyncRequest{
 		SyncRequest: &dbdpb.DataPumpImportRequest{
-			PdbName:    req.PdbName,
-			DbDomain:   req.DbDomain,
-			GcsPath:    req.GcsPath,
-			GcsLogPath: req.GcsLogPath,
+			PdbName:            req.PdbName,
+			DbDomain:           req.DbDomain,
+			GcsPath:            req.GcsPath,
+			GcsLogPath:         req.GcsLogPath,
+			StorageCredentials: req.GetStorageCredentials(),
 			CommandParams: []string{
 				"FULL=YES",
 				"METRICS=YES",
@@ -726,7 +1017,7 @@ func (s *ConfigServer) DataPumpImport(ctx context.Context, req *pb.DataPumpImpor
 // DataPumpExport exports data pump file to GCS path provided.
 func (s *ConfigServer) DataPumpExport(ctx context.Context, req *pb.DataPumpExportRequest) (*lropb.Operation, error) {
 
-	klog.InfoS("configagent/DataPumpExport", "req", req)
+	klog.InfoS("configagent/DataPumpExport", "req", common.RedactStorageCredentials(req))
 
 	client, closeConn, err := newDBDClient(ctx, s)
 	if err != nil {
@@ -736,13 +1027,14 @@ func (s *ConfigServer) DataPumpExport(ctx context.Context, req *pb.DataPumpExpor
 
 	return client.DataPumpExportAsync(ctx, &dbdpb.DataPumpExportAsyncRequest{
 		SyncRequest: &dbdpb.DataPumpExportRequest{
-			PdbName:       req.PdbName,
-			DbDomain:      req.DbDomain,
-			ObjectType:    req.ObjectType,
-			Objects:       req.Objects,
-			GcsPath:       req.GcsPath,
-			GcsLogPath:    req.GcsLogPath,
-			FlashbackTime: req.FlashbackTime,
+			PdbName:            req.PdbName,
+			DbDomain:           req.DbDomain,
+			ObjectType:         req.ObjectType,
+			Objects:            req.Objects,
+			GcsPath:            req.GcsPath,
+			GcsLogPath:         req.GcsLogPath,
+			FlashbackTime:      req.FlashbackTime,
+			StorageCredentials: req.GetStorageCredentials(),
 			CommandParams: []string{
 				"METRICS=YES",
 				"LOGTIME=ALL",
@@ -953,6 +1245,277 @@ func (s *ConfigServer) FetchServiceImageMetaData(ctx context.Context, req *pb.Fe
 	return &pb.FetchServiceImageMetaDataResponse{Version: metaData.Version, CdbName: metaData.CdbName, OracleHome: metaData.OracleHome}, nil
 }
 
+// ShipArchivedLogs starts an LRO uploading archived redo logs generated since
+// the last shipped sequence to a storage location. The response of the done
+// operation is a dbdaemon ShipArchivedLogsResponse.
+func (s *ConfigServer) ShipArchivedLogs(ctx context.Context, req *pb.ShipArchivedLogsRequest) (*lropb.Operation, error) {
+	klog.InfoS("configagent/ShipArchivedLogs", "req", common.RedactStorageCredentials(req))
+	client, closeConn, err := newDBDClient(ctx, s)
+	if err != nil {
+		return nil, fmt.Errorf("configagent/ShipArchivedLogs: failed to create dbdClient: %v", err)
+	}
+	defer closeConn()
+
+	return client.ShipArchivedLogsAsync(ctx, &dbdpb.ShipArchivedLogsAsyncRequest{
+		SyncRequest: &dbdpb.ShipArchivedLogsRequest{
+			GcsPath:            req.GetGcsPath(),
+			Incarnation:        req.GetIncarnation(),
+			AfterSequence:      req.GetAfterSequence(),
+			StorageCredentials: req.GetStorageCredentials(),
+		},
+		LroInput: &dbdpb.LROInput{OperationId: req.GetLroInput().GetOperationId()},
+	})
+}
+
+// ApplyDatapatch runs datapatch to apply the SQL changes of the patches
+// installed in the Oracle home and verifies in dba_registry_sqlpatch that
+// all of them were applied successfully.
+func (s *ConfigServer) ApplyDatapatch(ctx context.Context, req *pb.ApplyDatapatchRequest) (*pb.ApplyDatapatchResponse, error) {
+	klog.InfoS("configagent/ApplyDatapatch", "req", req)
+	client, closeConn, err := newDBDClient(ctx, s)
+	if err != nil {
+		return nil, fmt.Errorf("configagent/ApplyDatapatch: failed to create dbdClient: %v", err)
+	}
+	defer closeConn()
+
+	if _, err := client.RunDatapatch(ctx, &dbdpb.RunDatapatchRequest{}); err != nil {
+		return nil, fmt.Errorf("configagent/ApplyDatapatch: failed to run datapatch: %v", err)
+	}
+
+	resp, err := client.RunSQLPlusFormatted(ctx, &dbdpb.RunSQLPlusCMDRequest{Commands: []string{sqlPatchesSQL}})
+	if err != nil {
+		return nil, fmt.Errorf("configagent/ApplyDatapatch: failed to query the applied patches: %v", err)
+	}
+	rows, err := parseSQLResponse(resp)
+	if err != nil {
+		return nil, fmt.Errorf("configagent/ApplyDatapatch: %v", err)
+	}
+	var patches []*pb.ApplyDatapatchResponse_SqlPatch
+	var failed []string
+	for _, row := range rows {
+		id, err := strconv.ParseInt(row["PATCH_ID"], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("configagent/ApplyDatapatch: failed to parse the patch id of %v: %v", row, err)
+		}
+		patches = append(patches, &pb.ApplyDatapatchResponse_SqlPatch{
+			PatchId:     id,
+			Action:      row["ACTION"],
+			Status:      row["STATUS"],
+			Description: row["DESCRIPTION"],
+		})
+		if row["STATUS"] != "SUCCESS" {
+			failed = append(failed, fmt.Sprintf("%d (%s %s)", id, row["ACTION"], row["STATUS"]))
+		}
+	}
+	if len(failed) != 0 {
+		return nil, fmt.Errorf("configagent/ApplyDatapatch: patches not applied successfully: %s", strings.Join(failed, ", "))
+	}
+	klog.InfoS("configagent/ApplyDatapatch: DONE", "patches", patches)
+
+	return &pb.ApplyDatapatchResponse{Patches: patches}, nil
+}
+
+// SetMemoryTargets sets the SGA and PGA sizes of the database in the SPFILE.
+// sga_max_size is static, so the sizes take effect on the next restart of the
+// database.
+func (s *ConfigServer) SetMemoryTargets(ctx context.Context, req *pb.SetMemoryTargetsRequest) (*pb.SetMemoryTargetsResponse, error) {
+	klog.InfoS("configagent/SetMemoryTargets", "req", req)
+	if req.GetSgaTargetMb() <= 0 || req.GetPgaAggregateTargetMb() <= 0 {
+		return nil, fmt.Errorf("configagent/SetMemoryTargets: invalid SGA size %dM or PGA size %dM", req.GetSgaTargetMb(), req.GetPgaAggregateTargetMb())
+	}
+	client, closeConn, err := newDBDClient(ctx, s)
+	if err != nil {
+		return nil, fmt.Errorf("configagent/SetMemoryTargets: failed to create dbdClient: %v", err)
+	}
+	defer closeConn()
+
+	var commands []string
+	for _, p := range []struct {
+		name   string
+		sizeMB int64
+	}{
+		{name: "sga_max_size", sizeMB: req.GetSgaTargetMb()},
+		{name: "sga_target", sizeMB: req.GetSgaTargetMb()},
+		{name: "pga_aggregate_target", sizeMB: req.GetPgaAggregateTargetMb()},
+	} {
+		command, err := sql.QuerySetSystemParameterNoPanic(p.name, fmt.Sprintf("%dM", p.sizeMB), false)
+		if err != nil {
+			return nil, fmt.Errorf("configagent/SetMemoryTargets: %v", err)
+		}
+		commands = append(commands, fmt.Sprintf("%s scope=spfile", command))
+	}
+	if _, err := client.RunSQLPlus(ctx, &dbdpb.RunSQLPlusCMDRequest{Commands: commands}); err != nil {
+		return nil, fmt.Errorf("configagent/SetMemoryTargets: failed to set the memory targets: %v", err)
+	}
+	klog.InfoS("configagent/SetMemoryTargets: DONE", "commands", commands)
+
+	return &pb.SetMemoryTargetsResponse{}, nil
+}
+
+// DeleteDatabase drops a PDB, or unplugs it into an XML manifest next to its
+// datafiles if requested. It succeeds if the PDB does not exist.
+func (s *ConfigServer) DeleteDatabase(ctx context.Context, req *pb.DeleteDatabaseRequest) (*pb.DeleteDatabaseResponse, error) {
+	klog.InfoS("configagent/DeleteDatabase", "req", req)
+
+	pdbName, err := sql.ObjectName(req.GetName())
+	if err != nil {
+		return nil, fmt.Errorf("configagent/DeleteDatabase: invalid PDB name %q: %v", req.GetName(), err)
+	}
+
+	client, closeConn, err := newDBDClient(ctx, s)
+	if err != nil {
+		return nil, fmt.Errorf("configagent/DeleteDatabase: failed to create database daemon client: %v", err)
+	}
+	defer closeConn()
+
+	pdbCheckCmd := []string{fmt.Sprintf("select open_mode from v$pdbs where name = '%s'", sql.StringParam(strings.ToUpper(req.GetName())))}
+	resp, err := client.RunSQLPlusFormatted(ctx, &dbdpb.RunSQLPlusCMDRequest{Commands: pdbCheckCmd, Suppress: false})
+	if err != nil {
+		return nil, fmt.Errorf("configagent/DeleteDatabase: failed to check if a PDB called %s exists: %v", req.GetName(), err)
+	}
+	if resp.Msg == nil {
+		klog.InfoS("configagent/DeleteDatabase: PDB doesn't exist, nothing to delete", "pdb", req.GetName())
+		return &pb.DeleteDatabaseResponse{}, nil
+	}
+
+	cmds := []string{fmt.Sprintf("alter pluggable database %s close immediate", pdbName)}
+	var manifest string
+	if req.GetUnplug() {
+		cdbDir := fmt.Sprintf(consts.DataDir, consts.DataMount, req.GetCdbName())
+		manifest = filepath.Join(cdbDir, strings.ToUpper(req.GetName()), strings.ToLower(req.GetName())+".xml")
+		cmds = append(cmds,
+			fmt.Sprintf("alter pluggable database %s unplug into '%s'", pdbName, sql.StringParam(manifest)),
+			fmt.Sprintf("drop pluggable database %s keep datafiles", pdbName))
+	} else {
+		cmds = append(cmds, fmt.Sprintf("drop pluggable database %s including datafiles", pdbName))
+	}
+	// A closed PDB can't be closed again, run the commands one by one and
+	// ignore a failure to close.
+	for i, cmd := range cmds {
+		if _, err := client.RunSQLPlus(ctx, &dbdpb.RunSQLPlusCMDRequest{Commands: []string{cmd}, Suppress: false}); err != nil {
+			if i == 0 {
+				klog.InfoS("configagent/DeleteDatabase: failed to close PDB, proceeding", "pdb", req.GetName(), "err", err)
+				continue
+			}
+			return nil, fmt.Errorf("configagent/DeleteDatabase: %q failed: %v", cmd, err)
+		}
+	}
+	klog.InfoS("configagent/DeleteDatabase: DONE", "pdb", req.GetName(), "manifest", manifest)
+
+	return &pb.DeleteDatabaseResponse{ManifestPath: manifest}, nil
+}
+
+// DeleteBackup deletes the RMAN backup pieces of a physical backup.
+func (s *ConfigServer) DeleteBackup(ctx context.Context, req *pb.DeleteBackupRequest) (*pb.DeleteBackupResponse, error) {
+	klog.InfoS("configagent/DeleteBackup", "req", common.RedactStorageCredentials(req))
+	client, closeConn, err := newDBDClient(ctx, s)
+	if err != nil {
+		return nil, fmt.Errorf("configagent/DeleteBackup: failed to create dbdClient: %v", err)
+	}
+	defer closeConn()
+
+	resp, err := client.DeleteBackup(ctx, &dbdpb.DeleteBackupRequest{
+		Tag:                req.GetBackupTag(),
+		GcsPath:            req.GetGcsPath(),
+		StorageCredentials: req.GetStorageCredentials(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("configagent/DeleteBackup: failed to delete backup: %v", err)
+	}
+	klog.InfoS("configagent/DeleteBackup: done", "resp", resp)
+
+	return &pb.DeleteBackupResponse{DeletedCount: resp.GetDeletedCount()}, nil
+}
+
+// VerifyBackup starts an RMAN verification of the backup pieces of a physical
+// backup, which are downloaded from GCS if needed.
+func (s *ConfigServer) VerifyBackup(ctx context.Context, req *pb.VerifyBackupRequest) (*lropb.Operation, error) {
+	klog.InfoS("configagent/VerifyBackup", "req", common.RedactStorageCredentials(req))
+
+	client, closeConn, err := newDBDClient(ctx, s)
+	if err != nil {
+		return nil, fmt.Errorf("configagent/VerifyBackup: failed to create database daemon client: %v", err)
+	}
+	defer closeConn()
+
+	return backup.VerifyBackup(ctx, &backup.Params{
+		Client:             client,
+		DOP:                req.GetDop(),
+		GCSPath:            req.GetGcsPath(),
+		StorageCredentials: req.GetStorageCredentials(),
+		OperationID:        req.GetLroInput().GetOperationId(),
+		Tag:                req.GetBackupTag(),
+	})
+}
+
+// DescribeBackup returns the range of SCNs a physical backup can be recovered
+// to, as recorded in the control file.
+func (s *ConfigServer) DescribeBackup(ctx context.Context, req *pb.DescribeBackupRequest) (*pb.DescribeBackupResponse, error) {
+	klog.InfoS("configagent/DescribeBackup", "req", req)
+
+	if !common.RMANTagRegexp.MatchString(req.GetBackupTag()) {
+		return nil, fmt.Errorf("configagent/DescribeBackup: invalid RMAN tag %q", req.GetBackupTag())
+	}
+	tag := strings.ToUpper(req.GetBackupTag())
+
+	client, closeConn, err := newDBDClient(ctx, s)
+	if err != nil {
+		return nil, fmt.Errorf("configagent/DescribeBackup: failed to create database daemon client: %v", err)
+	}
+	defer closeConn()
+
+	row, err := queryRow(ctx, client, fmt.Sprintf(backupSCNRangeSQL, tag, tag))
+	if err != nil {
+		return nil, fmt.Errorf("configagent/DescribeBackup: failed to query the backup SCN range: %v", err)
+	}
+	if row["MIN_SCN"] == "" {
+		return nil, fmt.Errorf("configagent/DescribeBackup: no datafile backups found with tag %q", tag)
+	}
+	resp := &pb.DescribeBackupResponse{}
+	if resp.MinScn, err = strconv.ParseInt(row["MIN_SCN"], 10, 64); err != nil {
+		return nil, fmt.Errorf("configagent/DescribeBackup: failed to parse the min SCN %q: %v", row["MIN_SCN"], err)
+	}
+	resp.MaxScn = resp.MinScn
+	if row["MAX_SCN"] != "" {
+		if resp.MaxScn, err = strconv.ParseInt(row["MAX_SCN"], 10, 64); err != nil {
+			return nil, fmt.Errorf("configagent/DescribeBackup: failed to parse the max SCN %q: %v", row["MAX_SCN"], err)
+		}
+	}
+	klog.InfoS("configagent/DescribeBackup: done", "resp", resp)
+	return resp, nil
+}
+
+// ChangeDatabaseIdentity starts an LRO generating a new DBID for the
+// database with NID and renaming it to the name of the local CDB if it's
+// different, e.g. after a backup of another CDB was restored. The database is
+// restarted.
+func (s *ConfigServer) ChangeDatabaseIdentity(ctx context.Context, req *pb.ChangeDatabaseIdentityRequest) (*lropb.Operation, error) {
+	klog.InfoS("configagent/ChangeDatabaseIdentity", "req", req)
+
+	cdbName := strings.ToUpper(req.GetCdbName())
+	if !cdbNameRegexp.MatchString(cdbName) {
+		return nil, fmt.Errorf("configagent/ChangeDatabaseIdentity: invalid CDB name %q", req.GetCdbName())
+	}
+
+	client, closeConn, err := newDBDClient(ctx, s)
+	if err != nil {
+		return nil, fmt.Errorf("configagent/ChangeDatabaseIdentity: failed to create database daemon client: %v", err)
+	}
+	defer closeConn()
+
+	row, err := queryRow(ctx, client, databaseNameSQL)
+	if err != nil {
+		return nil, fmt.Errorf("configagent/ChangeDatabaseIdentity: failed to query the database name: %v", err)
+	}
+	rename := !strings.EqualFold(row["NAME"], cdbName)
+	klog.InfoS("configagent/ChangeDatabaseIdentity", "name", row["NAME"], "rename", rename)
+
+	return client.ChangeDatabaseIdentityAsync(ctx, &dbdpb.ChangeDatabaseIdentityAsyncRequest{
+		SyncRequest: &dbdpb.ChangeDatabaseIdentityRequest{CdbName: cdbName, Rename: rename},
+		LroInput:    &dbdpb.LROInput{OperationId: req.GetLroInput().GetOperationId()},
+	})
+}
+
 // AccessSecretVersionFunc accesses the payload for the given secret version if one
 // exists. The version can be a version number as a string (e.g. "5") or an
 // alias (e.g. "latest").
@@ -24,8 +24,10 @@ import (
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	lropb "google.golang.org/genproto/googleapis/longrunning"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/testing/protocmp"
 
 	"github.com/GoogleCloudPlatform/elcarro-oracle-operator/oracle/pkg/agents/common/sql"
 	pb "github.com/GoogleCloudPlatform/elcarro-oracle-operator/oracle/pkg/agents/config_agent/protos"
@@ -48,7 +50,7 @@ var (
 	}
 
 	sampleSqlToResp = map[string][]string{
-		`alter session set container="MYDB";select username from dba_users where ORACLE_MAINTAINED='N' and INHERITED='NO'`: {
+		`alter session set container="MYDB";select username, account_status, profile from dba_users where ORACLE_MAINTAINED='N' and INHERITED='NO'`: {
 			`{"USERNAME": "GPDB_ADMIN"}`,
 			`{"USERNAME": "SUPERUSER"}`,
 			`{"USERNAME": "SCOTT"}`,
@@ -69,6 +71,13 @@ var (
 			`{"GRANTED_ROLE": "CONNECT"}`,
 		},
 		`alter session set container="MYDB";select granted_role from dba_role_privs where grantee='PROBERUSER'`: {},
+		`alter session set container="MYDB";select tablespace_name, max_bytes from dba_ts_quotas where username='SCOTT'`: {
+			`{"TABLESPACE_NAME": "USERS", "MAX_BYTES": "-1"}`,
+			`{"TABLESPACE_NAME": "APP", "MAX_BYTES": "10485760"}`,
+		},
+		`alter session set container="MYDB";select owner, table_name, privilege from dba_tab_privs where grantee='SCOTT'`: {
+			`{"OWNER": "HR", "TABLE_NAME": "EMPLOYEES", "PRIVILEGE": "SELECT"}`,
+		},
 	}
 )
 
@@ -119,10 +128,73 @@ func TestConfigServerUsersChanged(t *testing.T) {
 			},
 			wantChanged: false,
 		},
+		{
+			name:      "no quota changed",
+			sqlToResp: sampleSqlToResp,
+			req: &pb.UsersChangedRequest{
+				PdbName: "MYDB",
+				UserSpecs: []*pb.User{
+					{
+						Name:         "superuser",
+						Password:     "superpassword",
+						LastPassword: "superpassword",
+						Privileges:   []string{"dba"},
+					},
+					{
+						Name:         "scott",
+						Password:     "tiger",
+						LastPassword: "tiger",
+						Privileges:   []string{"connect", "resource", "unlimited tablespace"},
+						Quotas: []*pb.TablespaceQuota{
+							{Tablespace: "users", Unlimited: true},
+							{Tablespace: "app", MaxBytes: 10 * 1024 * 1024},
+						},
+					},
+					{
+						Name:         "proberuser",
+						Password:     "proberpassword",
+						LastPassword: "proberpassword",
+						Privileges:   []string{"create session"},
+					},
+				},
+			},
+			wantChanged: false,
+		},
+		{
+			name:      "quota changed",
+			sqlToResp: sampleSqlToResp,
+			req: &pb.UsersChangedRequest{
+				PdbName: "MYDB",
+				UserSpecs: []*pb.User{
+					{
+						Name:         "superuser",
+						Password:     "superpassword",
+						LastPassword: "superpassword",
+						Privileges:   []string{"dba"},
+					},
+					{
+						Name:         "scott",
+						Password:     "tiger",
+						LastPassword: "tiger",
+						Privileges:   []string{"connect", "resource", "unlimited tablespace"},
+						Quotas: []*pb.TablespaceQuota{
+							{Tablespace: "users", Unlimited: true},
+						},
+					},
+					{
+						Name:         "proberuser",
+						Password:     "proberpassword",
+						LastPassword: "proberpassword",
+						Privileges:   []string{"create session"},
+					},
+				},
+			},
+			wantChanged: true,
+		},
 		{
 			name: "added a user in spec",
 			sqlToResp: map[string][]string{
-				`alter session set container="MYDB";select username from dba_users where ORACLE_MAINTAINED='N' and INHERITED='NO'`: {
+				`alter session set container="MYDB";select username, account_status, profile from dba_users where ORACLE_MAINTAINED='N' and INHERITED='NO'`: {
 					`{"USERNAME": "GPDB_ADMIN"}`,
 					`{"USERNAME": "SUPERUSER"}`,
 					`{"USERNAME": "PROBERUSER"}`,
@@ -161,7 +233,7 @@ func TestConfigServerUsersChanged(t *testing.T) {
 		{
 			name: "deleted users in spec",
 			sqlToResp: map[string][]string{
-				`alter session set container="MYDB";select username from dba_users where ORACLE_MAINTAINED='N' and INHERITED='NO'`: {
+				`alter session set container="MYDB";select username, account_status, profile from dba_users where ORACLE_MAINTAINED='N' and INHERITED='NO'`: {
 					`{"USERNAME": "GPDB_ADMIN"}`,
 					`{"USERNAME": "SUPERUSER"}`,
 					`{"USERNAME": "SCOTT"}`,
@@ -197,10 +269,131 @@ func TestConfigServerUsersChanged(t *testing.T) {
 			wantChanged:         false,
 			wantSuppressedUsers: []string{"PROBERUSER", "SCOTT"},
 		},
+		{
+			name: "deleted users to lock",
+			sqlToResp: map[string][]string{
+				`alter session set container="MYDB";select username, account_status, profile from dba_users where ORACLE_MAINTAINED='N' and INHERITED='NO'`: {
+					`{"USERNAME": "GPDB_ADMIN", "ACCOUNT_STATUS": "OPEN"}`,
+					`{"USERNAME": "SUPERUSER", "ACCOUNT_STATUS": "OPEN"}`,
+					`{"USERNAME": "SCOTT", "ACCOUNT_STATUS": "OPEN"}`,
+					`{"USERNAME": "PROBERUSER", "ACCOUNT_STATUS": "EXPIRED & LOCKED"}`,
+				},
+				`alter session set container="MYDB";select privilege from dba_sys_privs where grantee='SUPERUSER'`: {},
+				`alter session set container="MYDB";select privilege from dba_sys_privs where grantee='SCOTT'`: {
+					`{"PRIVILEGE": "UNLIMITED TABLESPACE"}`,
+				},
+				`alter session set container="MYDB";select privilege from dba_sys_privs where grantee='PROBERUSER'`: {
+					`{"PRIVILEGE": "CREATE SESSION"}`,
+				},
+				`alter session set container="MYDB";select granted_role from dba_role_privs where grantee='SUPERUSER'`: {
+					`{"GRANTED_ROLE": "DBA"}`,
+				},
+				`alter session set container="MYDB";select granted_role from dba_role_privs where grantee='SCOTT'`: {
+					`{"GRANTED_ROLE": "RESOURCE"}`,
+					`{"GRANTED_ROLE": "CONNECT"}`,
+				},
+				`alter session set container="MYDB";select granted_role from dba_role_privs where grantee='PROBERUSER'`: {},
+			},
+			req: &pb.UsersChangedRequest{
+				PdbName: "MYDB",
+				UserSpecs: []*pb.User{
+					{
+						Name:         "superuser",
+						Password:     "superpassword",
+						LastPassword: "superpassword",
+						Privileges:   []string{"dba"},
+					},
+				},
+				DeletionPolicy: pb.UsersChangedRequest_LOCK,
+				ManagedUsers:   []string{"SUPERUSER", "SCOTT", "PROBERUSER"},
+			},
+			wantChanged: true,
+		},
+		{
+			name: "unmanaged users retained",
+			sqlToResp: map[string][]string{
+				`alter session set container="MYDB";select username, account_status, profile from dba_users where ORACLE_MAINTAINED='N' and INHERITED='NO'`: {
+					`{"USERNAME": "GPDB_ADMIN", "ACCOUNT_STATUS": "OPEN"}`,
+					`{"USERNAME": "SUPERUSER", "ACCOUNT_STATUS": "OPEN"}`,
+					`{"USERNAME": "SCOTT", "ACCOUNT_STATUS": "OPEN"}`,
+					`{"USERNAME": "PROBERUSER", "ACCOUNT_STATUS": "EXPIRED & LOCKED"}`,
+				},
+				`alter session set container="MYDB";select privilege from dba_sys_privs where grantee='SUPERUSER'`: {},
+				`alter session set container="MYDB";select privilege from dba_sys_privs where grantee='SCOTT'`: {
+					`{"PRIVILEGE": "UNLIMITED TABLESPACE"}`,
+				},
+				`alter session set container="MYDB";select privilege from dba_sys_privs where grantee='PROBERUSER'`: {
+					`{"PRIVILEGE": "CREATE SESSION"}`,
+				},
+				`alter session set container="MYDB";select granted_role from dba_role_privs where grantee='SUPERUSER'`: {
+					`{"GRANTED_ROLE": "DBA"}`,
+				},
+				`alter session set container="MYDB";select granted_role from dba_role_privs where grantee='SCOTT'`: {
+					`{"GRANTED_ROLE": "RESOURCE"}`,
+					`{"GRANTED_ROLE": "CONNECT"}`,
+				},
+				`alter session set container="MYDB";select granted_role from dba_role_privs where grantee='PROBERUSER'`: {},
+			},
+			req: &pb.UsersChangedRequest{
+				PdbName: "MYDB",
+				UserSpecs: []*pb.User{
+					{
+						Name:         "superuser",
+						Password:     "superpassword",
+						LastPassword: "superpassword",
+						Privileges:   []string{"dba"},
+					},
+				},
+				DeletionPolicy: pb.UsersChangedRequest_LOCK,
+				ManagedUsers:   []string{"SUPERUSER"},
+			},
+			wantChanged:         false,
+			wantSuppressedUsers: []string{"PROBERUSER", "SCOTT"},
+		},
+		{
+			name: "deleted users locked",
+			sqlToResp: map[string][]string{
+				`alter session set container="MYDB";select username, account_status, profile from dba_users where ORACLE_MAINTAINED='N' and INHERITED='NO'`: {
+					`{"USERNAME": "GPDB_ADMIN", "ACCOUNT_STATUS": "OPEN"}`,
+					`{"USERNAME": "SUPERUSER", "ACCOUNT_STATUS": "OPEN"}`,
+					`{"USERNAME": "SCOTT", "ACCOUNT_STATUS": "LOCKED"}`,
+					`{"USERNAME": "PROBERUSER", "ACCOUNT_STATUS": "EXPIRED & LOCKED"}`,
+				},
+				`alter session set container="MYDB";select privilege from dba_sys_privs where grantee='SUPERUSER'`: {},
+				`alter session set container="MYDB";select privilege from dba_sys_privs where grantee='SCOTT'`: {
+					`{"PRIVILEGE": "UNLIMITED TABLESPACE"}`,
+				},
+				`alter session set container="MYDB";select privilege from dba_sys_privs where grantee='PROBERUSER'`: {
+					`{"PRIVILEGE": "CREATE SESSION"}`,
+				},
+				`alter session set container="MYDB";select granted_role from dba_role_privs where grantee='SUPERUSER'`: {
+					`{"GRANTED_ROLE": "DBA"}`,
+				},
+				`alter session set container="MYDB";select granted_role from dba_role_privs where grantee='SCOTT'`: {
+					`{"GRANTED_ROLE": "RESOURCE"}`,
+					`{"GRANTED_ROLE": "CONNECT"}`,
+				},
+				`alter session set container="MYDB";select granted_role from dba_role_privs where grantee='PROBERUSER'`: {},
+			},
+			req: &pb.UsersChangedRequest{
+				PdbName: "MYDB",
+				UserSpecs: []*pb.User{
+					{
+						Name:         "superuser",
+						Password:     "superpassword",
+						LastPassword: "superpassword",
+						Privileges:   []string{"dba"},
+					},
+				},
+				DeletionPolicy: pb.UsersChangedRequest_LOCK,
+				ManagedUsers:   []string{"SUPERUSER", "SCOTT", "PROBERUSER"},
+			},
+			wantChanged: false,
+		},
 		{
 			name: "user added privs",
 			sqlToResp: map[string][]string{
-				`alter session set container="MYDB";select username from dba_users where ORACLE_MAINTAINED='N' and INHERITED='NO'`: {
+				`alter session set container="MYDB";select username, account_status, profile from dba_users where ORACLE_MAINTAINED='N' and INHERITED='NO'`: {
 					`{"USERNAME": "GPDB_ADMIN"}`,
 					`{"USERNAME": "SUPERUSER"}`,
 					`{"USERNAME": "SCOTT"}`,
@@ -244,7 +437,7 @@ func TestConfigServerUsersChanged(t *testing.T) {
 		{
 			name: "user added and deleted privs",
 			sqlToResp: map[string][]string{
-				`alter session set container="MYDB";select username from dba_users where ORACLE_MAINTAINED='N' and INHERITED='NO'`: {
+				`alter session set container="MYDB";select username, account_status, profile from dba_users where ORACLE_MAINTAINED='N' and INHERITED='NO'`: {
 					`{"USERNAME": "GPDB_ADMIN"}`,
 					`{"USERNAME": "SUPERUSER"}`,
 					`{"USERNAME": "SCOTT"}`,
@@ -460,7 +653,7 @@ func TestConfigServerUpdateUsers(t *testing.T) {
 		{
 			name: "added a user in spec",
 			sqlToResp: map[string][]string{
-				`alter session set container="MYDB";select username from dba_users where ORACLE_MAINTAINED='N' and INHERITED='NO'`: {
+				`alter session set container="MYDB";select username, account_status, profile from dba_users where ORACLE_MAINTAINED='N' and INHERITED='NO'`: {
 					`{"USERNAME": "GPDB_ADMIN"}`,
 					`{"USERNAME": "SUPERUSER"}`,
 					`{"USERNAME": "PROBERUSER"}`,
@@ -510,7 +703,7 @@ func TestConfigServerUpdateUsers(t *testing.T) {
 		{
 			name: "deleted users in spec",
 			sqlToResp: map[string][]string{
-				`alter session set container="MYDB";select username from dba_users where ORACLE_MAINTAINED='N' and INHERITED='NO'`: {
+				`alter session set container="MYDB";select username, account_status, profile from dba_users where ORACLE_MAINTAINED='N' and INHERITED='NO'`: {
 					`{"USERNAME": "GPDB_ADMIN"}`,
 					`{"USERNAME": "SUPERUSER"}`,
 					`{"USERNAME": "SCOTT"}`,
@@ -544,10 +737,137 @@ func TestConfigServerUpdateUsers(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "deleted users dropped",
+			sqlToResp: map[string][]string{
+				`alter session set container="MYDB";select username, account_status, profile from dba_users where ORACLE_MAINTAINED='N' and INHERITED='NO'`: {
+					`{"USERNAME": "GPDB_ADMIN", "ACCOUNT_STATUS": "OPEN"}`,
+					`{"USERNAME": "SUPERUSER", "ACCOUNT_STATUS": "OPEN"}`,
+					`{"USERNAME": "SCOTT", "ACCOUNT_STATUS": "OPEN"}`,
+					`{"USERNAME": "PROBERUSER", "ACCOUNT_STATUS": "EXPIRED & LOCKED"}`,
+				},
+				`alter session set container="MYDB";select privilege from dba_sys_privs where grantee='SUPERUSER'`: {},
+				`alter session set container="MYDB";select privilege from dba_sys_privs where grantee='SCOTT'`: {
+					`{"PRIVILEGE": "UNLIMITED TABLESPACE"}`,
+				},
+				`alter session set container="MYDB";select privilege from dba_sys_privs where grantee='PROBERUSER'`: {
+					`{"PRIVILEGE": "CREATE SESSION"}`,
+				},
+				`alter session set container="MYDB";select granted_role from dba_role_privs where grantee='SUPERUSER'`: {
+					`{"GRANTED_ROLE": "DBA"}`,
+				},
+				`alter session set container="MYDB";select granted_role from dba_role_privs where grantee='SCOTT'`: {
+					`{"GRANTED_ROLE": "RESOURCE"}`,
+					`{"GRANTED_ROLE": "CONNECT"}`,
+				},
+				`alter session set container="MYDB";select granted_role from dba_role_privs where grantee='PROBERUSER'`: {},
+			},
+			req: &pb.UpdateUsersRequest{
+				PdbName: "MYDB",
+				UserSpecs: []*pb.User{
+					{
+						Name:         "superuser",
+						Password:     "superpassword",
+						LastPassword: "superpassword",
+						Privileges:   []string{"dba"},
+					},
+				},
+				DeletionPolicy: pb.UsersChangedRequest_DROP_CASCADE,
+				ManagedUsers:   []string{"SUPERUSER", "SCOTT", "PROBERUSER"},
+			},
+			wantSQLs: [][]string{
+				{`alter session set container="MYDB"`, `drop user "PROBERUSER" cascade`},
+				{`alter session set container="MYDB"`, `drop user "SCOTT" cascade`},
+			},
+		},
+		{
+			name: "unmanaged users retained",
+			sqlToResp: map[string][]string{
+				`alter session set container="MYDB";select username, account_status, profile from dba_users where ORACLE_MAINTAINED='N' and INHERITED='NO'`: {
+					`{"USERNAME": "GPDB_ADMIN", "ACCOUNT_STATUS": "OPEN"}`,
+					`{"USERNAME": "SUPERUSER", "ACCOUNT_STATUS": "OPEN"}`,
+					`{"USERNAME": "SCOTT", "ACCOUNT_STATUS": "OPEN"}`,
+					`{"USERNAME": "PROBERUSER", "ACCOUNT_STATUS": "EXPIRED & LOCKED"}`,
+				},
+				`alter session set container="MYDB";select privilege from dba_sys_privs where grantee='SUPERUSER'`: {},
+				`alter session set container="MYDB";select privilege from dba_sys_privs where grantee='SCOTT'`: {
+					`{"PRIVILEGE": "UNLIMITED TABLESPACE"}`,
+				},
+				`alter session set container="MYDB";select privilege from dba_sys_privs where grantee='PROBERUSER'`: {
+					`{"PRIVILEGE": "CREATE SESSION"}`,
+				},
+				`alter session set container="MYDB";select granted_role from dba_role_privs where grantee='SUPERUSER'`: {
+					`{"GRANTED_ROLE": "DBA"}`,
+				},
+				`alter session set container="MYDB";select granted_role from dba_role_privs where grantee='SCOTT'`: {
+					`{"GRANTED_ROLE": "RESOURCE"}`,
+					`{"GRANTED_ROLE": "CONNECT"}`,
+				},
+				`alter session set container="MYDB";select granted_role from dba_role_privs where grantee='PROBERUSER'`: {},
+			},
+			req: &pb.UpdateUsersRequest{
+				PdbName: "MYDB",
+				UserSpecs: []*pb.User{
+					{
+						Name:         "superuser",
+						Password:     "superpassword",
+						LastPassword: "superpassword",
+						Privileges:   []string{"dba"},
+					},
+				},
+				DeletionPolicy: pb.UsersChangedRequest_DROP_CASCADE,
+				ManagedUsers:   []string{"superuser", "scott"},
+			},
+			wantSQLs: [][]string{
+				{`alter session set container="MYDB"`, `drop user "SCOTT" cascade`},
+			},
+		},
+		{
+			name: "deleted users locked",
+			sqlToResp: map[string][]string{
+				`alter session set container="MYDB";select username, account_status, profile from dba_users where ORACLE_MAINTAINED='N' and INHERITED='NO'`: {
+					`{"USERNAME": "GPDB_ADMIN", "ACCOUNT_STATUS": "OPEN"}`,
+					`{"USERNAME": "SUPERUSER", "ACCOUNT_STATUS": "OPEN"}`,
+					`{"USERNAME": "SCOTT", "ACCOUNT_STATUS": "OPEN"}`,
+					`{"USERNAME": "PROBERUSER", "ACCOUNT_STATUS": "EXPIRED & LOCKED"}`,
+				},
+				`alter session set container="MYDB";select privilege from dba_sys_privs where grantee='SUPERUSER'`: {},
+				`alter session set container="MYDB";select privilege from dba_sys_privs where grantee='SCOTT'`: {
+					`{"PRIVILEGE": "UNLIMITED TABLESPACE"}`,
+				},
+				`alter session set container="MYDB";select privilege from dba_sys_privs where grantee='PROBERUSER'`: {
+					`{"PRIVILEGE": "CREATE SESSION"}`,
+				},
+				`alter session set container="MYDB";select granted_role from dba_role_privs where grantee='SUPERUSER'`: {
+					`{"GRANTED_ROLE": "DBA"}`,
+				},
+				`alter session set container="MYDB";select granted_role from dba_role_privs where grantee='SCOTT'`: {
+					`{"GRANTED_ROLE": "RESOURCE"}`,
+					`{"GRANTED_ROLE": "CONNECT"}`,
+				},
+				`alter session set container="MYDB";select granted_role from dba_role_privs where grantee='PROBERUSER'`: {},
+			},
+			req: &pb.UpdateUsersRequest{
+				PdbName: "MYDB",
+				UserSpecs: []*pb.User{
+					{
+						Name:         "superuser",
+						Password:     "superpassword",
+						LastPassword: "superpassword",
+						Privileges:   []string{"dba"},
+					},
+				},
+				DeletionPolicy: pb.UsersChangedRequest_LOCK,
+				ManagedUsers:   []string{"SUPERUSER", "SCOTT", "PROBERUSER"},
+			},
+			wantSQLs: [][]string{
+				{`alter session set container="MYDB"`, `alter user "SCOTT" account lock`},
+			},
+		},
 		{
 			name: "user added privs",
 			sqlToResp: map[string][]string{
-				`alter session set container="MYDB";select username from dba_users where ORACLE_MAINTAINED='N' and INHERITED='NO'`: {
+				`alter session set container="MYDB";select username, account_status, profile from dba_users where ORACLE_MAINTAINED='N' and INHERITED='NO'`: {
 					`{"USERNAME": "GPDB_ADMIN"}`,
 					`{"USERNAME": "SUPERUSER"}`,
 					`{"USERNAME": "SCOTT"}`,
@@ -597,7 +917,7 @@ func TestConfigServerUpdateUsers(t *testing.T) {
 		{
 			name: "user added and deleted privs",
 			sqlToResp: map[string][]string{
-				`alter session set container="MYDB";select username from dba_users where ORACLE_MAINTAINED='N' and INHERITED='NO'`: {
+				`alter session set container="MYDB";select username, account_status, profile from dba_users where ORACLE_MAINTAINED='N' and INHERITED='NO'`: {
 					`{"USERNAME": "GPDB_ADMIN"}`,
 					`{"USERNAME": "SUPERUSER"}`,
 					`{"USERNAME": "SCOTT"}`,
@@ -680,15 +1000,84 @@ func TestConfigServerUpdateUsers(t *testing.T) {
 			},
 		},
 		{
-			name:      "user updated gsm password",
+			name:      "user quotas updated",
 			sqlToResp: sampleSqlToResp,
 			req: &pb.UpdateUsersRequest{
 				PdbName: "MYDB",
 				UserSpecs: []*pb.User{
 					{
-						Name:                 "proberuser",
-						Privileges:           []string{"create session"},
-						PasswordGsmSecretRef: gsmRefWithChange,
+						Name:         "scott",
+						Password:     "tiger",
+						LastPassword: "tiger",
+						Privileges:   []string{"connect", "resource", "unlimited tablespace"},
+						Quotas: []*pb.TablespaceQuota{
+							{Tablespace: "app", MaxBytes: 20 * 1024 * 1024},
+							{Tablespace: "data", Unlimited: true},
+						},
+					},
+				},
+			},
+			wantSQLs: [][]string{
+				{`alter session set container="MYDB"`, `alter user "SCOTT" quota 20971520 on "APP"`, `alter user "SCOTT" quota unlimited on "DATA"`, `alter user "SCOTT" quota 0 on "USERS"`},
+			},
+		},
+		{
+			name:      "user object privileges updated",
+			sqlToResp: sampleSqlToResp,
+			req: &pb.UpdateUsersRequest{
+				PdbName: "MYDB",
+				UserSpecs: []*pb.User{
+					{
+						Name:             "scott",
+						Password:         "tiger",
+						LastPassword:     "tiger",
+						Privileges:       []string{"connect", "resource", "unlimited tablespace"},
+						ObjectPrivileges: []*pb.ObjectPrivilege{{Privilege: "select", Schema: "hr", Object: "departments"}},
+					},
+				},
+			},
+			wantSQLs: [][]string{
+				{`alter session set container="MYDB"`, `grant SELECT on "HR"."DEPARTMENTS" to "SCOTT"`, `revoke SELECT on "HR"."EMPLOYEES" from "SCOTT"`},
+			},
+		},
+		{
+			name:      "user updated secret password",
+			sqlToResp: sampleSqlToResp,
+			req: &pb.UpdateUsersRequest{
+				PdbName: "MYDB",
+				UserSpecs: []*pb.User{
+					{
+						Name:           "proberuser",
+						Privileges:     []string{"create session"},
+						PasswordSecret: &pb.SecretPassword{Password: "proberpassword", Version: "12", LastVersion: "12"},
+					},
+					{
+						Name:           "scott",
+						Privileges:     []string{"connect", "resource", "unlimited tablespace"},
+						PasswordSecret: &pb.SecretPassword{Password: "tiger1", Version: "13", LastVersion: "12"},
+					},
+					{
+						Name:         "superuser",
+						Password:     "superpassword",
+						LastPassword: "superpassword",
+						Privileges:   []string{"dba"},
+					},
+				},
+			},
+			wantSQLs: [][]string{
+				{`alter session set container="MYDB"`, `alter user "SCOTT" identified by "tiger1"`},
+			},
+		},
+		{
+			name:      "user updated gsm password",
+			sqlToResp: sampleSqlToResp,
+			req: &pb.UpdateUsersRequest{
+				PdbName: "MYDB",
+				UserSpecs: []*pb.User{
+					{
+						Name:                 "proberuser",
+						Privileges:           []string{"create session"},
+						PasswordGsmSecretRef: gsmRefWithChange,
 					},
 					{
 						Name:                 "scott",
@@ -708,6 +1097,64 @@ func TestConfigServerUpdateUsers(t *testing.T) {
 				{`alter session set container="MYDB"`, `alter user "SUPERUSER" identified by "topsecuredsecret"`},
 			},
 		},
+		{
+			name: "user accounts updated",
+			sqlToResp: map[string][]string{
+				`alter session set container="MYDB";select username, account_status, profile from dba_users where ORACLE_MAINTAINED='N' and INHERITED='NO'`: {
+					`{"USERNAME": "GPDB_ADMIN", "ACCOUNT_STATUS": "OPEN", "PROFILE": "DEFAULT"}`,
+					`{"USERNAME": "SUPERUSER", "ACCOUNT_STATUS": "EXPIRED & LOCKED", "PROFILE": "DEFAULT"}`,
+					`{"USERNAME": "SCOTT", "ACCOUNT_STATUS": "OPEN", "PROFILE": "DEFAULT"}`,
+					`{"USERNAME": "PROBERUSER", "ACCOUNT_STATUS": "LOCKED(TIMED)", "PROFILE": "APP_USERS"}`,
+				},
+				`alter session set container="MYDB";select privilege from dba_sys_privs where grantee='SUPERUSER'`: {},
+				`alter session set container="MYDB";select privilege from dba_sys_privs where grantee='SCOTT'`: {
+					`{"PRIVILEGE": "UNLIMITED TABLESPACE"}`,
+				},
+				`alter session set container="MYDB";select privilege from dba_sys_privs where grantee='PROBERUSER'`: {
+					`{"PRIVILEGE": "CREATE SESSION"}`,
+				},
+				`alter session set container="MYDB";select granted_role from dba_role_privs where grantee='SUPERUSER'`: {
+					`{"GRANTED_ROLE": "DBA"}`,
+				},
+				`alter session set container="MYDB";select granted_role from dba_role_privs where grantee='SCOTT'`: {
+					`{"GRANTED_ROLE": "RESOURCE"}`,
+					`{"GRANTED_ROLE": "CONNECT"}`,
+				},
+				`alter session set container="MYDB";select granted_role from dba_role_privs where grantee='PROBERUSER'`: {},
+			},
+			req: &pb.UpdateUsersRequest{
+				PdbName: "MYDB",
+				UserSpecs: []*pb.User{
+					{
+						Name:          "superuser",
+						Password:      "superpassword",
+						LastPassword:  "superpassword",
+						Privileges:    []string{"dba"},
+						AccountStatus: "UNLOCKED",
+					},
+					{
+						Name:          "scott",
+						Password:      "tiger",
+						LastPassword:  "tiger",
+						Privileges:    []string{"connect", "resource", "unlimited tablespace"},
+						Profile:       "app_users",
+						AccountStatus: "LOCKED",
+					},
+					{
+						Name:          "proberuser",
+						Password:      "proberpassword",
+						LastPassword:  "proberpassword",
+						Privileges:    []string{"create session"},
+						Profile:       "app_users",
+						AccountStatus: "UNLOCKED",
+					},
+				},
+			},
+			wantSQLs: [][]string{
+				{`alter session set container="MYDB"`, `alter user "SCOTT" profile "APP_USERS"`, `alter user "SCOTT" account lock`},
+				{`alter session set container="MYDB"`, `alter user "SUPERUSER" account unlock`},
+			},
+		},
 	}
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
@@ -894,10 +1341,811 @@ func TestConfigServerCreateUsers(t *testing.T) {
 	}
 }
 
+func TestConfigServerDataGuardStatus(t *testing.T) {
+	dbdServer := &fakeServer{}
+	client, cleanup := newFakeDatabaseDaemonClient(t, dbdServer)
+	newDBDClientBak := newDBDClient
+	newDBDClient = func(context.Context, *ConfigServer) (dbdpb.DatabaseDaemonClient, func() error, error) {
+		return client, func() error { return nil }, nil
+	}
+	defer func() {
+		newDBDClient = newDBDClientBak
+		cleanup()
+	}()
+	ctx := context.Background()
+	testCases := []struct {
+		name      string
+		sqlToResp map[string][]string
+		wantResp  *pb.DataGuardStatusResponse
+	}{
+		{
+			name: "primary",
+			sqlToResp: map[string][]string{
+				databaseRoleSQL: {`{"DB_UNIQUE_NAME":"GCLOUD","DATABASE_ROLE":"PRIMARY","OPEN_MODE":"READ WRITE"}`},
+			},
+			wantResp: &pb.DataGuardStatusResponse{
+				DbUniqueName: "GCLOUD",
+				DatabaseRole: "PRIMARY",
+				OpenMode:     "READ WRITE",
+			},
+		},
+		{
+			name: "standby",
+			sqlToResp: map[string][]string{
+				databaseRoleSQL: {`{"DB_UNIQUE_NAME":"GCLOUD_B","DATABASE_ROLE":"PHYSICAL STANDBY","OPEN_MODE":"MOUNTED"}`},
+				dataGuardStatsSQL: {
+					`{"NAME":"transport lag","VALUE":"+00 00:00:02"}`,
+					`{"NAME":"apply lag","VALUE":"+01 00:01:05"}`,
+				},
+				applyRunningSQL: {`{"CNT":"1"}`},
+			},
+			wantResp: &pb.DataGuardStatusResponse{
+				DbUniqueName:        "GCLOUD_B",
+				DatabaseRole:        "PHYSICAL STANDBY",
+				OpenMode:            "MOUNTED",
+				ApplyLagSeconds:     86465,
+				TransportLagSeconds: 2,
+				ApplyRunning:        true,
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			dbdServer.fakeRunSQLPlusFormatted = func(ctx context.Context, request *dbdpb.RunSQLPlusCMDRequest) (*dbdpb.RunCMDResponse, error) {
+				sql := strings.Join(request.GetCommands(), ";")
+				resp, ok := tc.sqlToResp[sql]
+				if !ok {
+					return nil, fmt.Errorf("failed to find mock sql resp for %q", sql)
+				}
+				return &dbdpb.RunCMDResponse{
+					Msg: resp,
+				}, nil
+			}
+			configServer := &ConfigServer{}
+			resp, err := configServer.DataGuardStatus(ctx, &pb.DataGuardStatusRequest{})
+			if err != nil {
+				t.Fatalf("DataGuardStatus(ctx) failed: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantResp, resp, protocmp.Transform()); diff != "" {
+				t.Errorf("DataGuardStatus(ctx) got unexpected response: -want +got %v", diff)
+			}
+		})
+	}
+}
+
+func TestConfigServerFenceDatabase(t *testing.T) {
+	ctx := context.Background()
+	testCases := []struct {
+		name         string
+		role         string
+		wantCmds     []string
+		wantShutdown bool
+	}{
+		{
+			name:         "primary",
+			role:         `{"DB_UNIQUE_NAME":"GCLOUD","DATABASE_ROLE":"PRIMARY","OPEN_MODE":"READ WRITE"}`,
+			wantCmds:     []string{deferTransportSQL},
+			wantShutdown: true,
+		},
+		{
+			name: "standby",
+			role: `{"DB_UNIQUE_NAME":"GCLOUD","DATABASE_ROLE":"PHYSICAL STANDBY","OPEN_MODE":"MOUNTED"}`,
+		},
+		{
+			name: "database down",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			dbdServer := &fakeServer{}
+			client, cleanup := newFakeDatabaseDaemonClient(t, dbdServer)
+			newDBDClientBak := newDBDClient
+			newDBDClient = func(context.Context, *ConfigServer) (dbdpb.DatabaseDaemonClient, func() error, error) {
+				return client, func() error { return nil }, nil
+			}
+			defer func() {
+				newDBDClient = newDBDClientBak
+				cleanup()
+			}()
+			dbdServer.fakeRunSQLPlusFormatted = func(ctx context.Context, request *dbdpb.RunSQLPlusCMDRequest) (*dbdpb.RunCMDResponse, error) {
+				if tc.role == "" {
+					return nil, errors.New("ORA-01034: ORACLE not available")
+				}
+				return &dbdpb.RunCMDResponse{Msg: []string{tc.role}}, nil
+			}
+			var gotCmds []string
+			dbdServer.fakeRunSQLPlus = func(ctx context.Context, request *dbdpb.RunSQLPlusCMDRequest) (*dbdpb.RunCMDResponse, error) {
+				gotCmds = append(gotCmds, request.GetCommands()...)
+				return &dbdpb.RunCMDResponse{}, nil
+			}
+
+			configServer := &ConfigServer{}
+			if _, err := configServer.FenceDatabase(ctx, &pb.FenceDatabaseRequest{CdbName: "GCLOUD"}); err != nil {
+				t.Fatalf("FenceDatabase(ctx) failed: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantCmds, gotCmds); diff != "" {
+				t.Errorf("FenceDatabase(ctx) got unexpected commands: -want +got %v", diff)
+			}
+			if gotShutdown := len(dbdServer.bounceDatabaseReqs) == 1; gotShutdown != tc.wantShutdown {
+				t.Fatalf("FenceDatabase(ctx) shut down the database: %v, want %v", gotShutdown, tc.wantShutdown)
+			}
+			if tc.wantShutdown {
+				req := dbdServer.bounceDatabaseReqs[0]
+				if req.GetOperation() != dbdpb.BounceDatabaseRequest_SHUTDOWN || req.GetOption() != "abort" {
+					t.Errorf("FenceDatabase(ctx) got bounce request %v, want a shutdown abort", req)
+				}
+			}
+		})
+	}
+}
+
+func TestConfigServerDescribeBackup(t *testing.T) {
+	dbdServer := &fakeServer{}
+	client, cleanup := newFakeDatabaseDaemonClient(t, dbdServer)
+	newDBDClientBak := newDBDClient
+	newDBDClient = func(context.Context, *ConfigServer) (dbdpb.DatabaseDaemonClient, func() error, error) {
+		return client, func() error { return nil }, nil
+	}
+	defer func() {
+		newDBDClient = newDBDClientBak
+		cleanup()
+	}()
+	ctx := context.Background()
+	testCases := []struct {
+		name     string
+		tag      string
+		row      string
+		wantResp *pb.DescribeBackupResponse
+		wantErr  bool
+	}{
+		{
+			name:     "datafiles and archived logs",
+			tag:      "el_123",
+			row:      `{"MIN_SCN":"1000","MAX_SCN":"1200"}`,
+			wantResp: &pb.DescribeBackupResponse{MinScn: 1000, MaxScn: 1200},
+		},
+		{
+			name:     "no archived logs",
+			tag:      "EL_123",
+			row:      `{"MIN_SCN":"1000","MAX_SCN":""}`,
+			wantResp: &pb.DescribeBackupResponse{MinScn: 1000, MaxScn: 1000},
+		},
+		{
+			name:    "no backup",
+			tag:     "EL_123",
+			row:     `{"MIN_SCN":"","MAX_SCN":""}`,
+			wantErr: true,
+		},
+		{
+			name:    "invalid tag",
+			tag:     "EL_123' or '1'='1",
+			wantErr: true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			dbdServer.fakeRunSQLPlusFormatted = func(ctx context.Context, request *dbdpb.RunSQLPlusCMDRequest) (*dbdpb.RunCMDResponse, error) {
+				if want := fmt.Sprintf(backupSCNRangeSQL, "EL_123", "EL_123"); request.GetCommands()[0] != want {
+					return nil, fmt.Errorf("got query %q, want %q", request.GetCommands()[0], want)
+				}
+				return &dbdpb.RunCMDResponse{Msg: []string{tc.row}}, nil
+			}
+			configServer := &ConfigServer{}
+			resp, err := configServer.DescribeBackup(ctx, &pb.DescribeBackupRequest{BackupTag: tc.tag})
+			if gotErr := err != nil; gotErr != tc.wantErr {
+				t.Fatalf("DescribeBackup(ctx, %q) got error %v, wanted an error: %v", tc.tag, err, tc.wantErr)
+			}
+			if diff := cmp.Diff(tc.wantResp, resp, protocmp.Transform()); diff != "" {
+				t.Errorf("DescribeBackup(ctx, %q) got unexpected response: -want +got %v", tc.tag, diff)
+			}
+		})
+	}
+}
+
+func TestParseDataGuardLag(t *testing.T) {
+	tests := []struct {
+		value string
+		want  int64
+	}{
+		{"", 0},
+		{"+00 00:00:00", 0},
+		{"+00 00:00:42", 42},
+		{"+00 01:02:03", 3723},
+		{"+02 00:00:00", 172800},
+	}
+	for _, test := range tests {
+		got, err := parseDataGuardLag(test.value)
+		if err != nil || got != test.want {
+			t.Errorf("parseDataGuardLag(%q)=%d, %v; wanted %d", test.value, got, err, test.want)
+		}
+	}
+
+	if got, err := parseDataGuardLag("unknown"); err == nil {
+		t.Errorf("parseDataGuardLag(%q)=%d, nil; wanted an error", "unknown", got)
+	}
+}
+
+func TestConfigServerChangeDatabaseIdentity(t *testing.T) {
+	ctx := context.Background()
+	testCases := []struct {
+		name       string
+		cdbName    string
+		sourceName string
+		want       *dbdpb.ChangeDatabaseIdentityAsyncRequest
+		wantErr    bool
+	}{
+		{
+			name:       "rename a clone",
+			cdbName:    "clone",
+			sourceName: "GCLOUD",
+			want: &dbdpb.ChangeDatabaseIdentityAsyncRequest{
+				SyncRequest: &dbdpb.ChangeDatabaseIdentityRequest{CdbName: "CLONE", Rename: true},
+				LroInput:    &dbdpb.LROInput{OperationId: "op"},
+			},
+		},
+		{
+			name:       "new DBID only",
+			cdbName:    "GCLOUD",
+			sourceName: "GCLOUD",
+			want: &dbdpb.ChangeDatabaseIdentityAsyncRequest{
+				SyncRequest: &dbdpb.ChangeDatabaseIdentityRequest{CdbName: "GCLOUD"},
+				LroInput:    &dbdpb.LROInput{OperationId: "op"},
+			},
+		},
+		{
+			name:    "invalid CDB name",
+			cdbName: "GCLOUD' scope=memory",
+			wantErr: true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			dbdServer := &fakeServer{}
+			client, cleanup := newFakeDatabaseDaemonClient(t, dbdServer)
+			newDBDClientBak := newDBDClient
+			newDBDClient = func(context.Context, *ConfigServer) (dbdpb.DatabaseDaemonClient, func() error, error) {
+				return client, func() error { return nil }, nil
+			}
+			defer func() {
+				newDBDClient = newDBDClientBak
+				cleanup()
+			}()
+			dbdServer.fakeRunSQLPlusFormatted = func(ctx context.Context, request *dbdpb.RunSQLPlusCMDRequest) (*dbdpb.RunCMDResponse, error) {
+				return &dbdpb.RunCMDResponse{Msg: []string{fmt.Sprintf(`{"NAME":"%s"}`, tc.sourceName)}}, nil
+			}
+
+			configServer := &ConfigServer{}
+			_, err := configServer.ChangeDatabaseIdentity(ctx, &pb.ChangeDatabaseIdentityRequest{CdbName: tc.cdbName, LroInput: &pb.LROInput{OperationId: "op"}})
+			if gotErr := err != nil; gotErr != tc.wantErr {
+				t.Fatalf("ChangeDatabaseIdentity(ctx, %q) got error %v, wanted an error: %v", tc.cdbName, err, tc.wantErr)
+			}
+			if tc.wantErr {
+				if len(dbdServer.changeDatabaseIdentityReqs) != 0 {
+					t.Errorf("ChangeDatabaseIdentity(ctx, %q) started an LRO for an invalid name", tc.cdbName)
+				}
+				return
+			}
+			if len(dbdServer.changeDatabaseIdentityReqs) != 1 {
+				t.Fatalf("ChangeDatabaseIdentity(ctx, %q) started %d LROs, want one", tc.cdbName, len(dbdServer.changeDatabaseIdentityReqs))
+			}
+			if diff := cmp.Diff(tc.want, dbdServer.changeDatabaseIdentityReqs[0], protocmp.Transform()); diff != "" {
+				t.Errorf("ChangeDatabaseIdentity(ctx, %q) got unexpected request: -want +got %v", tc.cdbName, diff)
+			}
+		})
+	}
+}
+
+func TestConfigServerSyncTablespaces(t *testing.T) {
+	const datafilesSQL = `alter session set container="MYDB";` + datafilesQuery
+	ctx := context.Background()
+	testCases := []struct {
+		name       string
+		req        *pb.SyncTablespacesRequest
+		envRows    []string
+		wantSQL    []string
+		wantUsages []*pb.SyncTablespacesResponse_Usage
+		wantErr    bool
+	}{
+		{
+			name: "create tablespaces",
+			req: &pb.SyncTablespacesRequest{
+				PdbName: "mydb",
+				Tablespaces: []*pb.Tablespace{
+					{Name: "app", InitialSizeBytes: 1048576, AutoExtend: true, MaxSizeBytes: 2097152},
+					{Name: "blobs", InitialSizeBytes: 1048576, Bigfile: true},
+				},
+			},
+			wantSQL: []string{
+				`alter session set container="MYDB"`,
+				`create tablespace "APP" datafile size 1048576 autoextend on maxsize 2097152`,
+				`create bigfile tablespace "BLOBS" datafile size 1048576 autoextend off`,
+			},
+		},
+		{
+			name: "tablespaces in sync",
+			req: &pb.SyncTablespacesRequest{
+				PdbName: "mydb",
+				Tablespaces: []*pb.Tablespace{
+					{Name: "app", InitialSizeBytes: 1048576, AutoExtend: true},
+					{Name: "blobs", InitialSizeBytes: 1048576, AutoExtend: true, MaxSizeBytes: 4194304},
+				},
+			},
+			envRows: []string{
+				`{"TABLESPACE_NAME": "APP", "FILE_ID": "12", "BYTES": "1048576", "AUTOEXTENSIBLE": "YES", "MAXBYTES": "34359721984", "MAXBLOCKS": "4194302", "BIGFILE": "NO", "FREE_BYTES": "524288"}`,
+				`{"TABLESPACE_NAME": "APP", "FILE_ID": "13", "BYTES": "2097152", "AUTOEXTENSIBLE": "YES", "MAXBYTES": "34359721984", "MAXBLOCKS": "4194302", "BIGFILE": "NO", "FREE_BYTES": "0"}`,
+				`{"TABLESPACE_NAME": "BLOBS", "FILE_ID": "14", "BYTES": "2097152", "AUTOEXTENSIBLE": "YES", "MAXBYTES": "4194304", "MAXBLOCKS": "512", "BIGFILE": "YES", "FREE_BYTES": "1048576"}`,
+				`{"TABLESPACE_NAME": "USERS", "FILE_ID": "15", "BYTES": "5242880", "AUTOEXTENSIBLE": "NO", "MAXBYTES": "0", "MAXBLOCKS": "0", "BIGFILE": "NO", "FREE_BYTES": "0"}`,
+			},
+			wantUsages: []*pb.SyncTablespacesResponse_Usage{
+				{Name: "APP", SizeBytes: 3145728, UsedBytes: 2621440, MaxSizeBytes: 68719443968},
+				{Name: "BLOBS", SizeBytes: 2097152, UsedBytes: 1048576, MaxSizeBytes: 4194304},
+			},
+		},
+		{
+			name: "alter autoextend",
+			req: &pb.SyncTablespacesRequest{
+				PdbName: "mydb",
+				Tablespaces: []*pb.Tablespace{
+					{Name: "app", InitialSizeBytes: 1048576, AutoExtend: true, MaxSizeBytes: 8388608},
+					{Name: "blobs", InitialSizeBytes: 1048576},
+				},
+			},
+			envRows: []string{
+				`{"TABLESPACE_NAME": "APP", "FILE_ID": "12", "BYTES": "1048576", "AUTOEXTENSIBLE": "YES", "MAXBYTES": "34359721984", "MAXBLOCKS": "4194302", "BIGFILE": "NO", "FREE_BYTES": "524288"}`,
+				`{"TABLESPACE_NAME": "BLOBS", "FILE_ID": "14", "BYTES": "2097152", "AUTOEXTENSIBLE": "YES", "MAXBYTES": "4194304", "MAXBLOCKS": "512", "BIGFILE": "YES", "FREE_BYTES": "1048576"}`,
+			},
+			wantSQL: []string{
+				`alter session set container="MYDB"`,
+				`alter database datafile 12 autoextend on maxsize 8388608`,
+				`alter database datafile 14 autoextend off`,
+			},
+			// The fake reports the datafiles as they were.
+			wantUsages: []*pb.SyncTablespacesResponse_Usage{
+				{Name: "APP", SizeBytes: 1048576, UsedBytes: 524288, MaxSizeBytes: 34359721984},
+				{Name: "BLOBS", SizeBytes: 2097152, UsedBytes: 1048576, MaxSizeBytes: 4194304},
+			},
+		},
+		{
+			name: "invalid initial size",
+			req: &pb.SyncTablespacesRequest{
+				PdbName:     "mydb",
+				Tablespaces: []*pb.Tablespace{{Name: "app"}},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			dbdServer := &fakeServer{}
+			client, cleanup := newFakeDatabaseDaemonClient(t, dbdServer)
+			newDBDClientBak := newDBDClient
+			newDBDClient = func(context.Context, *ConfigServer) (dbdpb.DatabaseDaemonClient, func() error, error) {
+				return client, func() error { return nil }, nil
+			}
+			defer func() {
+				newDBDClient = newDBDClientBak
+				cleanup()
+			}()
+			dbdServer.fakeRunSQLPlusFormatted = func(ctx context.Context, request *dbdpb.RunSQLPlusCMDRequest) (*dbdpb.RunCMDResponse, error) {
+				if sql := strings.Join(request.GetCommands(), ";"); sql != datafilesSQL {
+					return nil, fmt.Errorf("failed to find mock sql resp for %q", sql)
+				}
+				return &dbdpb.RunCMDResponse{Msg: tc.envRows}, nil
+			}
+			var gotSQL []string
+			dbdServer.fakeRunSQLPlus = func(ctx context.Context, request *dbdpb.RunSQLPlusCMDRequest) (*dbdpb.RunCMDResponse, error) {
+				gotSQL = append(gotSQL, request.GetCommands()...)
+				return &dbdpb.RunCMDResponse{}, nil
+			}
+
+			configServer := &ConfigServer{}
+			resp, err := configServer.SyncTablespaces(ctx, tc.req)
+			if gotErr := err != nil; gotErr != tc.wantErr {
+				t.Fatalf("SyncTablespaces(ctx, %v) got error %v, wanted an error: %v", tc.req, err, tc.wantErr)
+			}
+			if tc.wantErr {
+				return
+			}
+			if diff := cmp.Diff(tc.wantSQL, gotSQL); diff != "" {
+				t.Errorf("SyncTablespaces got unexpected SQL: -want +got %v", diff)
+			}
+			if diff := cmp.Diff(tc.wantUsages, resp.GetUsages(), protocmp.Transform()); diff != "" {
+				t.Errorf("SyncTablespaces got unexpected usages: -want +got %v", diff)
+			}
+		})
+	}
+}
+
+func TestConfigServerSyncRoles(t *testing.T) {
+	ctx := context.Background()
+	testCases := []struct {
+		name      string
+		req       *pb.SyncRolesRequest
+		sqlToResp map[string][]string
+		wantSQL   []string
+		wantErr   bool
+	}{
+		{
+			name: "create a role",
+			req: &pb.SyncRolesRequest{
+				PdbName: "mydb",
+				Roles: []*pb.Role{
+					{
+						Name:             "app_reader",
+						Privileges:       []string{"create session"},
+						ObjectPrivileges: []*pb.ObjectPrivilege{{Privilege: "select", Schema: "scott", Object: "emp"}},
+					},
+				},
+			},
+			sqlToResp: map[string][]string{
+				`alter session set container="MYDB";select role from dba_roles where oracle_maintained='N'`: {},
+			},
+			wantSQL: []string{
+				`alter session set container="MYDB"`,
+				`create role "APP_READER"`,
+				`grant CREATE SESSION to "APP_READER"`,
+				`grant SELECT on "SCOTT"."EMP" to "APP_READER"`,
+			},
+		},
+		{
+			name: "roles in sync",
+			req: &pb.SyncRolesRequest{
+				PdbName: "mydb",
+				Roles: []*pb.Role{
+					{
+						Name:             "app_reader",
+						Privileges:       []string{"create session"},
+						ObjectPrivileges: []*pb.ObjectPrivilege{{Privilege: "select", Schema: "scott", Object: "emp"}},
+					},
+				},
+			},
+			sqlToResp: map[string][]string{
+				`alter session set container="MYDB";select role from dba_roles where oracle_maintained='N'`: {
+					`{"ROLE": "APP_READER"}`,
+				},
+				`alter session set container="MYDB";select privilege from dba_sys_privs where grantee='APP_READER'`: {
+					`{"PRIVILEGE": "CREATE SESSION"}`,
+				},
+				`alter session set container="MYDB";select granted_role from dba_role_privs where grantee='APP_READER'`: {},
+				`alter session set container="MYDB";select owner, table_name, privilege from dba_tab_privs where grantee='APP_READER'`: {
+					`{"OWNER": "SCOTT", "TABLE_NAME": "EMP", "PRIVILEGE": "SELECT"}`,
+				},
+			},
+		},
+		{
+			name: "alter the privileges of a role",
+			req: &pb.SyncRolesRequest{
+				PdbName: "mydb",
+				Roles: []*pb.Role{
+					{
+						Name:             "app_reader",
+						Privileges:       []string{"create session"},
+						ObjectPrivileges: []*pb.ObjectPrivilege{{Privilege: "select", Schema: "scott", Object: "dept"}},
+					},
+				},
+			},
+			sqlToResp: map[string][]string{
+				`alter session set container="MYDB";select role from dba_roles where oracle_maintained='N'`: {
+					`{"ROLE": "APP_READER"}`,
+				},
+				`alter session set container="MYDB";select privilege from dba_sys_privs where grantee='APP_READER'`: {},
+				`alter session set container="MYDB";select granted_role from dba_role_privs where grantee='APP_READER'`: {
+					`{"GRANTED_ROLE": "RESOURCE"}`,
+				},
+				`alter session set container="MYDB";select owner, table_name, privilege from dba_tab_privs where grantee='APP_READER'`: {
+					`{"OWNER": "SCOTT", "TABLE_NAME": "EMP", "PRIVILEGE": "SELECT"}`,
+				},
+			},
+			wantSQL: []string{
+				`alter session set container="MYDB"`,
+				`grant CREATE SESSION to "APP_READER"`,
+				`revoke RESOURCE from "APP_READER"`,
+				`grant SELECT on "SCOTT"."DEPT" to "APP_READER"`,
+				`revoke SELECT on "SCOTT"."EMP" from "APP_READER"`,
+			},
+		},
+		{
+			name: "invalid object privilege",
+			req: &pb.SyncRolesRequest{
+				PdbName: "mydb",
+				Roles: []*pb.Role{
+					{
+						Name:             "app_reader",
+						ObjectPrivileges: []*pb.ObjectPrivilege{{Privilege: "select, delete", Schema: "scott", Object: "emp"}},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "missing object",
+			req: &pb.SyncRolesRequest{
+				PdbName: "mydb",
+				Roles: []*pb.Role{
+					{
+						Name:             "app_reader",
+						ObjectPrivileges: []*pb.ObjectPrivilege{{Privilege: "select", Schema: "scott"}},
+					},
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			dbdServer := &fakeServer{}
+			client, cleanup := newFakeDatabaseDaemonClient(t, dbdServer)
+			newDBDClientBak := newDBDClient
+			newDBDClient = func(context.Context, *ConfigServer) (dbdpb.DatabaseDaemonClient, func() error, error) {
+				return client, func() error { return nil }, nil
+			}
+			defer func() {
+				newDBDClient = newDBDClientBak
+				cleanup()
+			}()
+			dbdServer.fakeRunSQLPlusFormatted = func(ctx context.Context, request *dbdpb.RunSQLPlusCMDRequest) (*dbdpb.RunCMDResponse, error) {
+				sql := strings.Join(request.GetCommands(), ";")
+				resp, ok := tc.sqlToResp[sql]
+				if !ok {
+					return nil, fmt.Errorf("failed to find mock sql resp for %q", sql)
+				}
+				return &dbdpb.RunCMDResponse{Msg: resp}, nil
+			}
+			var gotSQL []string
+			dbdServer.fakeRunSQLPlus = func(ctx context.Context, request *dbdpb.RunSQLPlusCMDRequest) (*dbdpb.RunCMDResponse, error) {
+				gotSQL = append(gotSQL, request.GetCommands()...)
+				return &dbdpb.RunCMDResponse{}, nil
+			}
+
+			configServer := &ConfigServer{}
+			_, err := configServer.SyncRoles(ctx, tc.req)
+			if gotErr := err != nil; gotErr != tc.wantErr {
+				t.Fatalf("SyncRoles(ctx, %v) got error %v, wanted an error: %v", tc.req, err, tc.wantErr)
+			}
+			if diff := cmp.Diff(tc.wantSQL, gotSQL); diff != "" {
+				t.Errorf("SyncRoles got unexpected SQL: -want +got %v", diff)
+			}
+		})
+	}
+}
+
+func TestConfigServerSyncProfiles(t *testing.T) {
+	ctx := context.Background()
+	testCases := []struct {
+		name      string
+		req       *pb.SyncProfilesRequest
+		sqlToResp map[string][]string
+		wantSQL   []string
+		wantErr   bool
+	}{
+		{
+			name: "create a profile",
+			req: &pb.SyncProfilesRequest{
+				PdbName: "mydb",
+				Profiles: []*pb.Profile{
+					{
+						Name:   "app_users",
+						Limits: map[string]string{"password_life_time": "90", "failed_login_attempts": "5"},
+					},
+				},
+			},
+			sqlToResp: map[string][]string{
+				`alter session set container="MYDB";select resource_name, limit from dba_profiles where profile='APP_USERS'`: {},
+			},
+			wantSQL: []string{
+				`alter session set container="MYDB"`,
+				`create profile "APP_USERS" limit FAILED_LOGIN_ATTEMPTS 5 PASSWORD_LIFE_TIME 90`,
+			},
+		},
+		{
+			name: "profiles in sync",
+			req: &pb.SyncProfilesRequest{
+				PdbName: "mydb",
+				Profiles: []*pb.Profile{
+					{
+						Name:   "app_users",
+						Limits: map[string]string{"PASSWORD_GRACE_TIME": "0.5", "FAILED_LOGIN_ATTEMPTS": "unlimited"},
+					},
+				},
+			},
+			sqlToResp: map[string][]string{
+				`alter session set container="MYDB";select resource_name, limit from dba_profiles where profile='APP_USERS'`: {
+					`{"RESOURCE_NAME": "PASSWORD_GRACE_TIME", "LIMIT": ".5"}`,
+					`{"RESOURCE_NAME": "FAILED_LOGIN_ATTEMPTS", "LIMIT": "UNLIMITED"}`,
+					`{"RESOURCE_NAME": "PASSWORD_LIFE_TIME", "LIMIT": "DEFAULT"}`,
+				},
+			},
+		},
+		{
+			name: "alter the limits of a profile",
+			req: &pb.SyncProfilesRequest{
+				PdbName: "mydb",
+				Profiles: []*pb.Profile{
+					{
+						Name:   "app_users",
+						Limits: map[string]string{"PASSWORD_LIFE_TIME": "30", "FAILED_LOGIN_ATTEMPTS": "5"},
+					},
+				},
+			},
+			sqlToResp: map[string][]string{
+				`alter session set container="MYDB";select resource_name, limit from dba_profiles where profile='APP_USERS'`: {
+					`{"RESOURCE_NAME": "PASSWORD_LIFE_TIME", "LIMIT": "180"}`,
+					`{"RESOURCE_NAME": "FAILED_LOGIN_ATTEMPTS", "LIMIT": "5"}`,
+				},
+			},
+			wantSQL: []string{
+				`alter session set container="MYDB"`,
+				`alter profile "APP_USERS" limit PASSWORD_LIFE_TIME 30`,
+			},
+		},
+		{
+			name: "invalid limit",
+			req: &pb.SyncProfilesRequest{
+				PdbName: "mydb",
+				Profiles: []*pb.Profile{
+					{
+						Name:   "app_users",
+						Limits: map[string]string{"PASSWORD_LIFE_TIME": "30; drop user scott"},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "unknown resource",
+			req: &pb.SyncProfilesRequest{
+				PdbName: "mydb",
+				Profiles: []*pb.Profile{
+					{
+						Name:   "app_users",
+						Limits: map[string]string{"CPU_PER_CALL": "100"},
+					},
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			dbdServer := &fakeServer{}
+			client, cleanup := newFakeDatabaseDaemonClient(t, dbdServer)
+			newDBDClientBak := newDBDClient
+			newDBDClient = func(context.Context, *ConfigServer) (dbdpb.DatabaseDaemonClient, func() error, error) {
+				return client, func() error { return nil }, nil
+			}
+			defer func() {
+				newDBDClient = newDBDClientBak
+				cleanup()
+			}()
+			dbdServer.fakeRunSQLPlusFormatted = func(ctx context.Context, request *dbdpb.RunSQLPlusCMDRequest) (*dbdpb.RunCMDResponse, error) {
+				sql := strings.Join(request.GetCommands(), ";")
+				resp, ok := tc.sqlToResp[sql]
+				if !ok {
+					return nil, fmt.Errorf("failed to find mock sql resp for %q", sql)
+				}
+				return &dbdpb.RunCMDResponse{Msg: resp}, nil
+			}
+			var gotSQL []string
+			dbdServer.fakeRunSQLPlus = func(ctx context.Context, request *dbdpb.RunSQLPlusCMDRequest) (*dbdpb.RunCMDResponse, error) {
+				gotSQL = append(gotSQL, request.GetCommands()...)
+				return &dbdpb.RunCMDResponse{}, nil
+			}
+
+			configServer := &ConfigServer{}
+			_, err := configServer.SyncProfiles(ctx, tc.req)
+			if gotErr := err != nil; gotErr != tc.wantErr {
+				t.Fatalf("SyncProfiles(ctx, %v) got error %v, wanted an error: %v", tc.req, err, tc.wantErr)
+			}
+			if diff := cmp.Diff(tc.wantSQL, gotSQL); diff != "" {
+				t.Errorf("SyncProfiles got unexpected SQL: -want +got %v", diff)
+			}
+		})
+	}
+}
+
+func TestConfigServerApplyDatapatch(t *testing.T) {
+	ctx := context.Background()
+	testCases := []struct {
+		name        string
+		rows        []string
+		wantPatches []*pb.ApplyDatapatchResponse_SqlPatch
+		wantErr     bool
+	}{
+		{
+			name: "patches applied",
+			rows: []string{
+				`{"PATCH_ID": "31741641", "ACTION": "APPLY", "STATUS": "SUCCESS", "DESCRIPTION": "Database Release Update : 19.9.0.0.201020"}`,
+			},
+			wantPatches: []*pb.ApplyDatapatchResponse_SqlPatch{
+				{PatchId: 31741641, Action: "APPLY", Status: "SUCCESS", Description: "Database Release Update : 19.9.0.0.201020"},
+			},
+		},
+		{
+			name: "patch applied with errors",
+			rows: []string{
+				`{"PATCH_ID": "31741641", "ACTION": "APPLY", "STATUS": "SUCCESS", "DESCRIPTION": "Database Release Update : 19.9.0.0.201020"}`,
+				`{"PATCH_ID": "32218454", "ACTION": "APPLY", "STATUS": "WITH ERRORS", "DESCRIPTION": "Database Release Update : 19.10.0.0.210119"}`,
+			},
+			wantErr: true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			dbdServer := &fakeServer{}
+			client, cleanup := newFakeDatabaseDaemonClient(t, dbdServer)
+			newDBDClientBak := newDBDClient
+			newDBDClient = func(context.Context, *ConfigServer) (dbdpb.DatabaseDaemonClient, func() error, error) {
+				return client, func() error { return nil }, nil
+			}
+			defer func() {
+				newDBDClient = newDBDClientBak
+				cleanup()
+			}()
+			dbdServer.fakeRunSQLPlusFormatted = func(ctx context.Context, request *dbdpb.RunSQLPlusCMDRequest) (*dbdpb.RunCMDResponse, error) {
+				if dbdServer.runDatapatchCnt != 1 {
+					t.Errorf("dba_registry_sqlpatch queried after %d datapatch runs, want 1", dbdServer.runDatapatchCnt)
+				}
+				return &dbdpb.RunCMDResponse{Msg: tc.rows}, nil
+			}
+
+			configServer := &ConfigServer{}
+			resp, err := configServer.ApplyDatapatch(ctx, &pb.ApplyDatapatchRequest{})
+			if gotErr := err != nil; gotErr != tc.wantErr {
+				t.Fatalf("ApplyDatapatch got error %v, wanted an error: %v", err, tc.wantErr)
+			}
+			if tc.wantErr {
+				return
+			}
+			if diff := cmp.Diff(tc.wantPatches, resp.GetPatches(), protocmp.Transform()); diff != "" {
+				t.Errorf("ApplyDatapatch got unexpected patches: -want +got %v", diff)
+			}
+		})
+	}
+}
+
+func TestConfigServerSetMemoryTargets(t *testing.T) {
+	ctx := context.Background()
+	dbdServer := &fakeServer{}
+	client, cleanup := newFakeDatabaseDaemonClient(t, dbdServer)
+	newDBDClientBak := newDBDClient
+	newDBDClient = func(context.Context, *ConfigServer) (dbdpb.DatabaseDaemonClient, func() error, error) {
+		return client, func() error { return nil }, nil
+	}
+	defer func() {
+		newDBDClient = newDBDClientBak
+		cleanup()
+	}()
+	var gotCommands []string
+	dbdServer.fakeRunSQLPlus = func(ctx context.Context, request *dbdpb.RunSQLPlusCMDRequest) (*dbdpb.RunCMDResponse, error) {
+		gotCommands = append(gotCommands, request.GetCommands()...)
+		return &dbdpb.RunCMDResponse{}, nil
+	}
+
+	configServer := &ConfigServer{}
+	if _, err := configServer.SetMemoryTargets(ctx, &pb.SetMemoryTargetsRequest{SgaTargetMb: 3072, PgaAggregateTargetMb: 2048}); err != nil {
+		t.Fatalf("SetMemoryTargets failed: %v", err)
+	}
+	wantCommands := []string{
+		"alter system set sga_max_size=3072M scope=spfile",
+		"alter system set sga_target=3072M scope=spfile",
+		"alter system set pga_aggregate_target=2048M scope=spfile",
+	}
+	if diff := cmp.Diff(wantCommands, gotCommands); diff != "" {
+		t.Errorf("SetMemoryTargets got unexpected commands: -want +got %v", diff)
+	}
+
+	if _, err := configServer.SetMemoryTargets(ctx, &pb.SetMemoryTargetsRequest{SgaTargetMb: 3072}); err == nil {
+		t.Errorf("SetMemoryTargets without a PGA size succeeded, want an error")
+	}
+}
+
 type fakeServer struct {
 	*dbdpb.UnimplementedDatabaseDaemonServer
-	fakeRunSQLPlus          func(context.Context, *dbdpb.RunSQLPlusCMDRequest) (*dbdpb.RunCMDResponse, error)
-	fakeRunSQLPlusFormatted func(context.Context, *dbdpb.RunSQLPlusCMDRequest) (*dbdpb.RunCMDResponse, error)
+	fakeRunSQLPlus             func(context.Context, *dbdpb.RunSQLPlusCMDRequest) (*dbdpb.RunCMDResponse, error)
+	fakeRunSQLPlusFormatted    func(context.Context, *dbdpb.RunSQLPlusCMDRequest) (*dbdpb.RunCMDResponse, error)
+	bounceDatabaseReqs         []*dbdpb.BounceDatabaseRequest
+	changeDatabaseIdentityReqs []*dbdpb.ChangeDatabaseIdentityAsyncRequest
+	runDatapatchCnt            int
 }
 
 func (f *fakeServer) RunSQLPlus(ctx context.Context, req *dbdpb.RunSQLPlusCMDRequest) (*dbdpb.RunCMDResponse, error) {
@@ -913,6 +2161,21 @@ func (f *fakeServer) RunSQLPlusFormatted(ctx context.Context, req *dbdpb.RunSQLP
 	}
 	return f.fakeRunSQLPlusFormatted(ctx, req)
 }
+func (f *fakeServer) BounceDatabase(ctx context.Context, req *dbdpb.BounceDatabaseRequest) (*dbdpb.BounceDatabaseResponse, error) {
+	f.bounceDatabaseReqs = append(f.bounceDatabaseReqs, req)
+	return &dbdpb.BounceDatabaseResponse{}, nil
+}
+
+func (f *fakeServer) ChangeDatabaseIdentityAsync(ctx context.Context, req *dbdpb.ChangeDatabaseIdentityAsyncRequest) (*lropb.Operation, error) {
+	f.changeDatabaseIdentityReqs = append(f.changeDatabaseIdentityReqs, req)
+	return &lropb.Operation{Name: req.GetLroInput().GetOperationId()}, nil
+}
+
+func (f *fakeServer) RunDatapatch(ctx context.Context, req *dbdpb.RunDatapatchRequest) (*dbdpb.RunDatapatchResponse, error) {
+	f.runDatapatchCnt++
+	return &dbdpb.RunDatapatchResponse{}, nil
+}
+
 func (f *fakeServer) CheckDatabaseState(context.Context, *dbdpb.CheckDatabaseStateRequest) (*dbdpb.CheckDatabaseStateResponse, error) {
 	return &dbdpb.CheckDatabaseStateResponse{}, nil
 }
@@ -0,0 +1,425 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package configagent
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	lropb "google.golang.org/genproto/googleapis/longrunning"
+	"k8s.io/klog/v2"
+
+	pb "github.com/GoogleCloudPlatform/elcarro-oracle-operator/oracle/pkg/agents/config_agent/protos"
+	"github.com/GoogleCloudPlatform/elcarro-oracle-operator/oracle/pkg/agents/consts"
+	dbdpb "github.com/GoogleCloudPlatform/elcarro-oracle-operator/oracle/pkg/agents/oracle"
+)
+
+const (
+	primaryRole         = "PRIMARY"
+	physicalStandbyRole = "PHYSICAL STANDBY"
+
+	// duplicateForStandbyCmd builds a physical standby from an active primary,
+	// the spfile is copied from the primary and adjusted for the standby.
+	duplicateForStandbyCmd = `run {
+allocate channel c1 type disk;
+allocate auxiliary channel a1 type disk;
+duplicate target database for standby from active database
+spfile
+set db_unique_name='%s'
+set fal_server='%s'
+dorecover nofilenamecheck;
+}`
+
+	databaseRoleSQL     = "select db_unique_name, database_role, open_mode from v$database"
+	dataGuardStatsSQL   = "select name, value from v$dataguard_stats where name in ('apply lag', 'transport lag')"
+	applyRunningSQL     = "select count(*) cnt from v$managed_standby where process like 'MRP%'"
+	standbyLogCountSQL  = "select count(*) cnt from v$standby_log"
+	onlineLogsSQL       = "select count(*) cnt, max(bytes) bytes from v$log"
+	startApplySQL       = "alter database recover managed standby database disconnect from session"
+	cancelApplySQL      = "alter database recover managed standby database cancel"
+	openDatabaseSQL     = "alter database open"
+	switchoverVerifySQL = "alter database switchover to %s verify"
+	switchoverSQL       = "alter database switchover to %s"
+	failoverSQL         = "alter database failover to %s"
+	deferTransportSQL   = "alter system set log_archive_dest_state_2=defer scope=both"
+)
+
+var (
+	// connectStringRegexp matches easy connect strings without credentials.
+	connectStringRegexp = regexp.MustCompile(`^//[A-Za-z0-9.-]+:[0-9]+/[A-Za-z0-9_.$#]+$`)
+	// dbUniqueNameRegexp matches valid db_unique_name values.
+	dbUniqueNameRegexp = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_$#]{0,29}$`)
+	// dataGuardLagRegexp matches the +DD HH:MI:SS interval values of
+	// v$dataguard_stats.
+	dataGuardLagRegexp = regexp.MustCompile(`^\+?(\d+) (\d{2}):(\d{2}):(\d{2})$`)
+)
+
+// CreateStandby builds a physical standby database of a primary with RMAN
+// active duplication. The local instance is restarted in nomount and its
+// database files are overwritten.
+func (s *ConfigServer) CreateStandby(ctx context.Context, req *pb.CreateStandbyRequest) (*lropb.Operation, error) {
+	klog.InfoS("configagent/CreateStandby", "cdbName", req.GetCdbName(), "dbUniqueName", req.GetDbUniqueName(),
+		"primary", req.GetPrimaryConnectString(), "standby", req.GetStandbyConnectString(), "lroInput", req.GetLroInput())
+
+	if !dbUniqueNameRegexp.MatchString(req.GetDbUniqueName()) {
+		return nil, fmt.Errorf("configagent/CreateStandby: invalid db_unique_name %q", req.GetDbUniqueName())
+	}
+	for _, cs := range []string{req.GetPrimaryConnectString(), req.GetStandbyConnectString()} {
+		if !connectStringRegexp.MatchString(cs) {
+			return nil, fmt.Errorf("configagent/CreateStandby: invalid connect string %q", cs)
+		}
+	}
+	if req.GetSysPassword() == "" {
+		return nil, fmt.Errorf("configagent/CreateStandby: missing SYS password")
+	}
+
+	client, closeConn, err := newDBDClient(ctx, s)
+	if err != nil {
+		return nil, fmt.Errorf("configagent/CreateStandby: failed to create database daemon client: %v", err)
+	}
+	defer closeConn()
+
+	if _, err := client.CreatePasswordFile(ctx, &dbdpb.CreatePasswordFileRequest{
+		DatabaseName: req.GetCdbName(),
+		SysPassword:  req.GetSysPassword(),
+		Dir:          fmt.Sprintf(consts.ConfigDir, consts.DataMount, req.GetCdbName()),
+	}); err != nil {
+		return nil, fmt.Errorf("configagent/CreateStandby: failed to create a password file: %v", err)
+	}
+
+	// The database may already be down after a failed attempt.
+	if _, err := client.BounceDatabase(ctx, &dbdpb.BounceDatabaseRequest{
+		Operation:    dbdpb.BounceDatabaseRequest_SHUTDOWN,
+		DatabaseName: req.GetCdbName(),
+		Option:       "abort",
+	}); err != nil {
+		klog.InfoS("configagent/CreateStandby: failed to shut down the database", "err", err)
+	}
+	if _, err := client.BounceDatabase(ctx, &dbdpb.BounceDatabaseRequest{
+		Operation:         dbdpb.BounceDatabaseRequest_STARTUP,
+		DatabaseName:      req.GetCdbName(),
+		Option:            "nomount",
+		AvoidConfigBackup: true,
+	}); err != nil {
+		return nil, fmt.Errorf("configagent/CreateStandby: failed to start the database in nomount: %v", err)
+	}
+
+	sysConnect := func(cs string) string {
+		return fmt.Sprintf("sys/%s@%s", req.GetSysPassword(), cs)
+	}
+	return client.RunRMANAsync(ctx, &dbdpb.RunRMANAsyncRequest{
+		SyncRequest: &dbdpb.RunRMANRequest{
+			Scripts:   []string{fmt.Sprintf(duplicateForStandbyCmd, req.GetDbUniqueName(), req.GetPrimaryConnectString())},
+			Target:    sysConnect(req.GetPrimaryConnectString()),
+			Auxiliary: sysConnect(req.GetStandbyConnectString()),
+			// The connect strings contain the SYS password.
+			Suppress: true,
+		},
+		LroInput: &dbdpb.LROInput{OperationId: req.GetLroInput().GetOperationId()},
+	})
+}
+
+// SetUpDataGuard configures the redo transport to the peer database and
+// starts the redo apply of a standby, or opens a primary that is left
+// mounted by a role transition. It can be called repeatedly and is used for
+// both databases of a Data Guard configuration.
+func (s *ConfigServer) SetUpDataGuard(ctx context.Context, req *pb.SetUpDataGuardRequest) (*pb.SetUpDataGuardResponse, error) {
+	klog.InfoS("configagent/SetUpDataGuard", "req", req)
+
+	if !dbUniqueNameRegexp.MatchString(req.GetPeerDbUniqueName()) {
+		return nil, fmt.Errorf("configagent/SetUpDataGuard: invalid peer db_unique_name %q", req.GetPeerDbUniqueName())
+	}
+	if !connectStringRegexp.MatchString(req.GetPeerConnectString()) {
+		return nil, fmt.Errorf("configagent/SetUpDataGuard: invalid peer connect string %q", req.GetPeerConnectString())
+	}
+
+	client, closeConn, err := newDBDClient(ctx, s)
+	if err != nil {
+		return nil, fmt.Errorf("configagent/SetUpDataGuard: failed to create database daemon client: %v", err)
+	}
+	defer closeConn()
+
+	db, err := queryRow(ctx, client, databaseRoleSQL)
+	if err != nil {
+		return nil, fmt.Errorf("configagent/SetUpDataGuard: failed to query the database role: %v", err)
+	}
+
+	transport := "async noaffirm"
+	if req.GetSync() {
+		transport = "sync affirm"
+	}
+	cmds := []string{
+		fmt.Sprintf("alter system set log_archive_config='dg_config=(%s,%s)' scope=both", db["DB_UNIQUE_NAME"], req.GetPeerDbUniqueName()),
+		fmt.Sprintf(`alter system set log_archive_dest_2='service="%s" %s valid_for=(online_logfiles,primary_role) db_unique_name=%s' scope=both`,
+			req.GetPeerConnectString(), transport, req.GetPeerDbUniqueName()),
+		"alter system set log_archive_dest_state_2=enable scope=both",
+		fmt.Sprintf("alter system set fal_server='%s' scope=both", req.GetPeerConnectString()),
+		"alter system set standby_file_management=auto scope=both",
+	}
+
+	// Standby redo logs are needed for real-time apply and are created on
+	// both sides so that the roles can be switched.
+	srl, err := queryRow(ctx, client, standbyLogCountSQL)
+	if err != nil {
+		return nil, fmt.Errorf("configagent/SetUpDataGuard: failed to count standby redo logs: %v", err)
+	}
+	if srl["CNT"] == "0" {
+		logs, err := queryRow(ctx, client, onlineLogsSQL)
+		if err != nil {
+			return nil, fmt.Errorf("configagent/SetUpDataGuard: failed to query online redo logs: %v", err)
+		}
+		cnt, err := strconv.Atoi(logs["CNT"])
+		if err != nil {
+			return nil, fmt.Errorf("configagent/SetUpDataGuard: failed to parse the online redo log count %q: %v", logs["CNT"], err)
+		}
+		// One more standby redo log group than online redo log groups is
+		// recommended.
+		for i := 0; i <= cnt; i++ {
+			cmds = append(cmds, fmt.Sprintf("alter database add standby logfile size %s", logs["BYTES"]))
+		}
+	}
+
+	switch db["DATABASE_ROLE"] {
+	case physicalStandbyRole:
+		apply, err := queryRow(ctx, client, applyRunningSQL)
+		if err != nil {
+			return nil, fmt.Errorf("configagent/SetUpDataGuard: failed to check the redo apply: %v", err)
+		}
+		if apply["CNT"] == "0" {
+			cmds = append(cmds, startApplySQL)
+		}
+	case primaryRole:
+		if db["OPEN_MODE"] == "MOUNTED" {
+			cmds = append(cmds, openDatabaseSQL)
+		}
+	}
+
+	if _, err := client.RunSQLPlus(ctx, &dbdpb.RunSQLPlusCMDRequest{Commands: cmds}); err != nil {
+		return nil, fmt.Errorf("configagent/SetUpDataGuard: failed to configure Data Guard: %v", err)
+	}
+	klog.InfoS("configagent/SetUpDataGuard: done", "role", db["DATABASE_ROLE"])
+	return &pb.SetUpDataGuardResponse{}, nil
+}
+
+// DataGuardStatus returns the role of the database and, for a standby, the
+// lag behind the primary.
+func (s *ConfigServer) DataGuardStatus(ctx context.Context, req *pb.DataGuardStatusRequest) (*pb.DataGuardStatusResponse, error) {
+	client, closeConn, err := newDBDClient(ctx, s)
+	if err != nil {
+		return nil, fmt.Errorf("configagent/DataGuardStatus: failed to create database daemon client: %v", err)
+	}
+	defer closeConn()
+
+	db, err := queryRow(ctx, client, databaseRoleSQL)
+	if err != nil {
+		return nil, fmt.Errorf("configagent/DataGuardStatus: failed to query the database role: %v", err)
+	}
+	resp := &pb.DataGuardStatusResponse{
+		DbUniqueName: db["DB_UNIQUE_NAME"],
+		DatabaseRole: db["DATABASE_ROLE"],
+		OpenMode:     db["OPEN_MODE"],
+	}
+	if resp.DatabaseRole != physicalStandbyRole {
+		return resp, nil
+	}
+
+	stats, err := client.RunSQLPlusFormatted(ctx, &dbdpb.RunSQLPlusCMDRequest{Commands: []string{dataGuardStatsSQL}})
+	if err != nil {
+		return nil, fmt.Errorf("configagent/DataGuardStatus: failed to query Data Guard stats: %v", err)
+	}
+	for _, msg := range stats.GetMsg() {
+		row := make(map[string]string)
+		if err := json.Unmarshal([]byte(msg), &row); err != nil {
+			return nil, fmt.Errorf("configagent/DataGuardStatus: failed to parse %q: %v", msg, err)
+		}
+		lag, err := parseDataGuardLag(row["VALUE"])
+		if err != nil {
+			return nil, fmt.Errorf("configagent/DataGuardStatus: %v", err)
+		}
+		switch row["NAME"] {
+		case "apply lag":
+			resp.ApplyLagSeconds = lag
+		case "transport lag":
+			resp.TransportLagSeconds = lag
+		}
+	}
+
+	apply, err := queryRow(ctx, client, applyRunningSQL)
+	if err != nil {
+		return nil, fmt.Errorf("configagent/DataGuardStatus: failed to check the redo apply: %v", err)
+	}
+	resp.ApplyRunning = apply["CNT"] != "0"
+	return resp, nil
+}
+
+// Switchover switches the local primary database to the standby role and the
+// target standby database to the primary role. The former primary is
+// restarted in mount, SetUpDataGuard opens the new primary and starts the
+// redo apply of the new standby.
+func (s *ConfigServer) Switchover(ctx context.Context, req *pb.SwitchoverRequest) (*pb.SwitchoverResponse, error) {
+	klog.InfoS("configagent/Switchover", "req", req)
+
+	if !dbUniqueNameRegexp.MatchString(req.GetTargetDbUniqueName()) {
+		return nil, fmt.Errorf("configagent/Switchover: invalid target db_unique_name %q", req.GetTargetDbUniqueName())
+	}
+
+	client, closeConn, err := newDBDClient(ctx, s)
+	if err != nil {
+		return nil, fmt.Errorf("configagent/Switchover: failed to create database daemon client: %v", err)
+	}
+	defer closeConn()
+
+	db, err := queryRow(ctx, client, databaseRoleSQL)
+	if err != nil {
+		return nil, fmt.Errorf("configagent/Switchover: failed to query the database role: %v", err)
+	}
+	if db["DATABASE_ROLE"] != primaryRole {
+		return nil, fmt.Errorf("configagent/Switchover: the local database is a %s database, not a primary", strings.ToLower(db["DATABASE_ROLE"]))
+	}
+
+	if _, err := client.RunSQLPlus(ctx, &dbdpb.RunSQLPlusCMDRequest{Commands: []string{fmt.Sprintf(switchoverVerifySQL, req.GetTargetDbUniqueName())}}); err != nil {
+		return nil, fmt.Errorf("configagent/Switchover: switchover to %s is not possible: %v", req.GetTargetDbUniqueName(), err)
+	}
+	if _, err := client.RunSQLPlus(ctx, &dbdpb.RunSQLPlusCMDRequest{Commands: []string{fmt.Sprintf(switchoverSQL, req.GetTargetDbUniqueName())}}); err != nil {
+		return nil, fmt.Errorf("configagent/Switchover: failed to switch over to %s: %v", req.GetTargetDbUniqueName(), err)
+	}
+
+	// The former primary is shut down by the switchover.
+	if _, err := client.BounceDatabase(ctx, &dbdpb.BounceDatabaseRequest{
+		Operation:    dbdpb.BounceDatabaseRequest_STARTUP,
+		DatabaseName: req.GetCdbName(),
+		Option:       "mount",
+	}); err != nil {
+		return nil, fmt.Errorf("configagent/Switchover: failed to mount the new standby database: %v", err)
+	}
+	klog.InfoS("configagent/Switchover: done", "target", req.GetTargetDbUniqueName())
+	return &pb.SwitchoverResponse{}, nil
+}
+
+// Failover turns the local standby database into a primary database without
+// the involvement of the primary database.
+func (s *ConfigServer) Failover(ctx context.Context, req *pb.FailoverRequest) (*pb.FailoverResponse, error) {
+	klog.InfoS("configagent/Failover", "req", req)
+
+	if !dbUniqueNameRegexp.MatchString(req.GetTargetDbUniqueName()) {
+		return nil, fmt.Errorf("configagent/Failover: invalid target db_unique_name %q", req.GetTargetDbUniqueName())
+	}
+
+	client, closeConn, err := newDBDClient(ctx, s)
+	if err != nil {
+		return nil, fmt.Errorf("configagent/Failover: failed to create database daemon client: %v", err)
+	}
+	defer closeConn()
+
+	db, err := queryRow(ctx, client, databaseRoleSQL)
+	if err != nil {
+		return nil, fmt.Errorf("configagent/Failover: failed to query the database role: %v", err)
+	}
+	if db["DATABASE_ROLE"] == primaryRole {
+		klog.InfoS("configagent/Failover: the local database is already a primary")
+		return &pb.FailoverResponse{}, nil
+	}
+
+	// The redo apply may not be running, e.g. if the standby is lagging.
+	if _, err := client.RunSQLPlus(ctx, &dbdpb.RunSQLPlusCMDRequest{Commands: []string{cancelApplySQL}}); err != nil {
+		klog.InfoS("configagent/Failover: failed to cancel the redo apply", "err", err)
+	}
+	cmds := []string{fmt.Sprintf(failoverSQL, req.GetTargetDbUniqueName()), openDatabaseSQL}
+	if _, err := client.RunSQLPlus(ctx, &dbdpb.RunSQLPlusCMDRequest{Commands: cmds}); err != nil {
+		return nil, fmt.Errorf("configagent/Failover: failed to fail over to %s: %v", req.GetTargetDbUniqueName(), err)
+	}
+	klog.InfoS("configagent/Failover: done", "target", req.GetTargetDbUniqueName())
+	return &pb.FailoverResponse{}, nil
+}
+
+// FenceDatabase shuts down the local primary database before its standby
+// takes over the primary role with a failover, so that the two databases
+// don't accept writes at the same time. The redo transport is deferred in the
+// spfile, a restarted database doesn't ship redo to the new primary. The
+// database is shut down with abort, a hung primary is the usual reason for a
+// failover. A database that is down or not a primary is left as it is.
+func (s *ConfigServer) FenceDatabase(ctx context.Context, req *pb.FenceDatabaseRequest) (*pb.FenceDatabaseResponse, error) {
+	klog.InfoS("configagent/FenceDatabase", "req", req)
+
+	client, closeConn, err := newDBDClient(ctx, s)
+	if err != nil {
+		return nil, fmt.Errorf("configagent/FenceDatabase: failed to create database daemon client: %v", err)
+	}
+	defer closeConn()
+
+	db, err := queryRow(ctx, client, databaseRoleSQL)
+	if err != nil {
+		klog.InfoS("configagent/FenceDatabase: the database is not running", "err", err)
+		return &pb.FenceDatabaseResponse{}, nil
+	}
+	if db["DATABASE_ROLE"] != primaryRole {
+		klog.InfoS("configagent/FenceDatabase: the local database is not a primary", "role", db["DATABASE_ROLE"])
+		return &pb.FenceDatabaseResponse{}, nil
+	}
+
+	if _, err := client.RunSQLPlus(ctx, &dbdpb.RunSQLPlusCMDRequest{Commands: []string{deferTransportSQL}}); err != nil {
+		klog.InfoS("configagent/FenceDatabase: failed to defer the redo transport", "err", err)
+	}
+	if _, err := client.BounceDatabase(ctx, &dbdpb.BounceDatabaseRequest{
+		Operation:    dbdpb.BounceDatabaseRequest_SHUTDOWN,
+		DatabaseName: req.GetCdbName(),
+		Option:       "abort",
+	}); err != nil {
+		return nil, fmt.Errorf("configagent/FenceDatabase: failed to shut down the database: %v", err)
+	}
+	klog.InfoS("configagent/FenceDatabase: done")
+	return &pb.FenceDatabaseResponse{}, nil
+}
+
+// queryRow runs a query expected to return a single row.
+func queryRow(ctx context.Context, client dbdpb.DatabaseDaemonClient, query string) (map[string]string, error) {
+	resp, err := client.RunSQLPlusFormatted(ctx, &dbdpb.RunSQLPlusCMDRequest{Commands: []string{query}})
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.GetMsg()) != 1 {
+		return nil, fmt.Errorf("%q returned %d rows, want 1", query, len(resp.GetMsg()))
+	}
+	row := make(map[string]string)
+	if err := json.Unmarshal([]byte(resp.GetMsg()[0]), &row); err != nil {
+		return nil, fmt.Errorf("failed to parse %q: %v", resp.GetMsg()[0], err)
+	}
+	return row, nil
+}
+
+// parseDataGuardLag parses a +DD HH:MI:SS lag of v$dataguard_stats into
+// seconds. An empty value, reported if the lag is unknown, is 0.
+func parseDataGuardLag(v string) (int64, error) {
+	if v == "" {
+		return 0, nil
+	}
+	m := dataGuardLagRegexp.FindStringSubmatch(v)
+	if m == nil {
+		return 0, fmt.Errorf("failed to parse Data Guard lag %q", v)
+	}
+	var parts [4]int64
+	for i := range parts {
+		n, err := strconv.ParseInt(m[i+1], 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("failed to parse Data Guard lag %q: %v", v, err)
+		}
+		parts[i] = n
+	}
+	return ((parts[0]*24+parts[1])*60+parts[2])*60 + parts[3], nil
+}
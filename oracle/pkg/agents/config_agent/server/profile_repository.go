@@ -0,0 +1,118 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package configagent
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/GoogleCloudPlatform/elcarro-oracle-operator/oracle/pkg/agents/common/sql"
+	pb "github.com/GoogleCloudPlatform/elcarro-oracle-operator/oracle/pkg/agents/config_agent/protos"
+	dbdpb "github.com/GoogleCloudPlatform/elcarro-oracle-operator/oracle/pkg/agents/oracle"
+)
+
+const profileLimitsQuery = "select resource_name, limit from dba_profiles where profile='%s'"
+
+// profiles describe the managed profiles of an Oracle PDB.
+type profiles struct {
+	databaseName string
+	nameToSpec   map[string]*pb.Profile
+}
+
+func newProfiles(databaseName string, specs []*pb.Profile) *profiles {
+	nameToSpec := make(map[string]*pb.Profile)
+	for _, s := range specs {
+		nameToSpec[strings.ToUpper(s.GetName())] = s
+	}
+	return &profiles{
+		databaseName: strings.ToUpper(databaseName),
+		nameToSpec:   nameToSpec,
+	}
+}
+
+// diff returns statements, which create the missing profiles and alter the
+// limits of the existing ones, by comparing k8s spec with real environment
+// (the dba_profiles table). Limits and profiles not in the spec are left as
+// is.
+func (ps *profiles) diff(ctx context.Context, client dbdpb.DatabaseDaemonClient) ([]string, error) {
+	var names []string
+	for name := range ps.nameToSpec {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var cmds []string
+	for _, name := range names {
+		spec := ps.nameToSpec[name]
+		rows, err := queryRows(ctx, client, ps.databaseName, fmt.Sprintf(profileLimitsQuery, sql.StringParam(name)))
+		if err != nil {
+			return nil, fmt.Errorf("failed to load the limits of profile %s from DB %v", name, err)
+		}
+		if len(rows) == 0 {
+			cmds = append(cmds, sql.QueryCreateProfile(name, spec.GetLimits()))
+			continue
+		}
+		envLimits := make(map[string]string)
+		for _, row := range rows {
+			envLimits[row["RESOURCE_NAME"]] = row["LIMIT"]
+		}
+		toAlter := make(map[string]string)
+		for resource, limit := range spec.GetLimits() {
+			if !sameLimit(envLimits[strings.ToUpper(resource)], limit) {
+				toAlter[resource] = limit
+			}
+		}
+		if len(toAlter) != 0 {
+			cmds = append(cmds, sql.QueryAlterProfile(name, toAlter))
+		}
+	}
+	return cmds, nil
+}
+
+// sameLimit returns true if a limit of dba_profiles equals the limit of the
+// spec. Oracle reports fractions of days without a leading zero, like .5.
+func sameLimit(env, spec string) bool {
+	if strings.EqualFold(env, spec) {
+		return true
+	}
+	e, err := strconv.ParseFloat(env, 64)
+	if err != nil {
+		return false
+	}
+	s, err := strconv.ParseFloat(spec, 64)
+	if err != nil {
+		return false
+	}
+	return e == s
+}
+
+// validateProfile returns an error if a profile can't be created safely.
+func validateProfile(p *pb.Profile) error {
+	if _, err := sql.ObjectName(p.GetName()); err != nil || p.GetName() == "" {
+		return fmt.Errorf("invalid profile name %q", p.GetName())
+	}
+	if len(p.GetLimits()) == 0 {
+		return fmt.Errorf("missing limits of profile %q", p.GetName())
+	}
+	for resource, limit := range p.GetLimits() {
+		if !sql.IsProfileLimit(resource, limit) {
+			return fmt.Errorf("invalid limit %q of resource %q of profile %q", limit, resource, p.GetName())
+		}
+	}
+	return nil
+}
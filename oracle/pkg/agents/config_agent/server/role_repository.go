@@ -0,0 +1,181 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package configagent
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/GoogleCloudPlatform/elcarro-oracle-operator/oracle/pkg/agents/common/sql"
+	pb "github.com/GoogleCloudPlatform/elcarro-oracle-operator/oracle/pkg/agents/config_agent/protos"
+	dbdpb "github.com/GoogleCloudPlatform/elcarro-oracle-operator/oracle/pkg/agents/oracle"
+)
+
+const (
+	customRolesQuery = "select role from dba_roles where oracle_maintained='N'"
+	objectPrivsQuery = "select owner, table_name, privilege from dba_tab_privs where grantee='%s'"
+)
+
+// roles describe the managed custom roles of an Oracle PDB.
+type roles struct {
+	databaseName string
+	nameToSpec   map[string]*pb.Role
+	// envRoles keeps track of the existing custom roles.
+	// The value will be initialized/refreshed with method roles.readEnv
+	envRoles map[string]bool
+}
+
+func newRoles(databaseName string, specs []*pb.Role) *roles {
+	nameToSpec := make(map[string]*pb.Role)
+	for _, s := range specs {
+		nameToSpec[strings.ToUpper(s.GetName())] = s
+	}
+	return &roles{
+		databaseName: strings.ToUpper(databaseName),
+		nameToSpec:   nameToSpec,
+	}
+}
+
+func (rs *roles) readEnv(ctx context.Context, client dbdpb.DatabaseDaemonClient) error {
+	envRoles, err := queryDB(ctx, client, rs.databaseName, customRolesQuery, "ROLE", func(string) bool { return true })
+	if err != nil {
+		return fmt.Errorf("failed to load roles from DB %v", err)
+	}
+	rs.envRoles = make(map[string]bool)
+	for _, r := range envRoles {
+		rs.envRoles[r] = true
+	}
+	return nil
+}
+
+// diff returns statements, which create the missing roles and grant and
+// revoke the privileges of the roles, by comparing k8s spec with real
+// environment. Roles not in the spec are left as is.
+func (rs *roles) diff(ctx context.Context, client dbdpb.DatabaseDaemonClient) ([]string, error) {
+	if err := rs.readEnv(ctx, client); err != nil {
+		return nil, fmt.Errorf("failed to read the env roles: %v", err)
+	}
+	var cmds []string
+	for _, name := range rs.specNames() {
+		spec := rs.nameToSpec[name]
+		var specPrivs []string
+		for _, p := range spec.GetPrivileges() {
+			specPrivs = append(specPrivs, strings.ToUpper(p))
+		}
+		if !rs.envRoles[name] {
+			cmds = append(cmds, sql.QueryCreateRole(name))
+			for _, p := range specPrivs {
+				cmds = append(cmds, sql.QueryGrantPrivileges(p, name))
+			}
+			for _, op := range spec.GetObjectPrivileges() {
+				cmds = append(cmds, sql.QueryGrantObjectPrivilege(strings.ToUpper(op.GetPrivilege()), op.GetSchema(), op.GetObject(), name))
+			}
+			continue
+		}
+		// A role is granted privileges like a user.
+		r := newNoSpecUser(rs.databaseName, name)
+		if err := r.readEnv(ctx, client); err != nil {
+			return nil, fmt.Errorf("failed to read the env role %s: %v", name, err)
+		}
+		toGrant, _, toRevoke := compare(specPrivs, r.GetUserEnvPrivs())
+		for _, p := range toGrant {
+			cmds = append(cmds, sql.QueryGrantPrivileges(p, name))
+		}
+		for _, p := range toRevoke {
+			cmds = append(cmds, sql.QueryRevokePrivileges(p, name))
+		}
+		objCmds, err := objectPrivsDiff(ctx, client, rs.databaseName, name, spec.GetObjectPrivileges())
+		if err != nil {
+			return nil, fmt.Errorf("failed to read the env object privileges of role %s: %v", name, err)
+		}
+		cmds = append(cmds, objCmds...)
+	}
+	return cmds, nil
+}
+
+func (rs *roles) specNames() []string {
+	var names []string
+	for name := range rs.nameToSpec {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// objectPrivKey identifies an object privilege in the comparison of k8s
+// spec and real environment.
+func objectPrivKey(p *pb.ObjectPrivilege) string {
+	return fmt.Sprintf("%s ON %s.%s", strings.ToUpper(p.GetPrivilege()), strings.ToUpper(p.GetSchema()), strings.ToUpper(p.GetObject()))
+}
+
+// objectPrivsDiff returns statements, which grant and revoke the object
+// privileges of grantee by comparing k8s spec with real environment (the
+// dba_tab_privs table). Object privileges are only managed for grantees with
+// object privileges in the spec.
+func objectPrivsDiff(ctx context.Context, client dbdpb.DatabaseDaemonClient, databaseName, grantee string, specPrivs []*pb.ObjectPrivilege) ([]string, error) {
+	if len(specPrivs) == 0 {
+		return nil, nil
+	}
+	rows, err := queryRows(ctx, client, databaseName, fmt.Sprintf(objectPrivsQuery, sql.StringParam(grantee)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to query dba tab privileges: %v", err)
+	}
+	keyToPriv := make(map[string]*pb.ObjectPrivilege)
+	var specKeys, envKeys []string
+	for _, p := range specPrivs {
+		key := objectPrivKey(p)
+		keyToPriv[key] = p
+		specKeys = append(specKeys, key)
+	}
+	for _, row := range rows {
+		p := &pb.ObjectPrivilege{Privilege: row["PRIVILEGE"], Schema: row["OWNER"], Object: row["TABLE_NAME"]}
+		key := objectPrivKey(p)
+		keyToPriv[key] = p
+		envKeys = append(envKeys, key)
+	}
+	toGrant, _, toRevoke := compare(specKeys, envKeys)
+	var cmds []string
+	for _, key := range toGrant {
+		p := keyToPriv[key]
+		cmds = append(cmds, sql.QueryGrantObjectPrivilege(strings.ToUpper(p.GetPrivilege()), p.GetSchema(), p.GetObject(), grantee))
+	}
+	for _, key := range toRevoke {
+		p := keyToPriv[key]
+		cmds = append(cmds, sql.QueryRevokeObjectPrivilege(p.GetPrivilege(), p.GetSchema(), p.GetObject(), grantee))
+	}
+	return cmds, nil
+}
+
+// validateObjectPrivileges returns an error if an object privilege can't be
+// granted safely.
+func validateObjectPrivileges(privs []*pb.ObjectPrivilege) error {
+	for _, p := range privs {
+		if !sql.IsPrivilege(p.GetPrivilege()) || strings.Contains(p.GetPrivilege(), ",") {
+			return fmt.Errorf("invalid object privilege %q", p.GetPrivilege())
+		}
+		if p.GetSchema() == "" || p.GetObject() == "" {
+			return fmt.Errorf("missing schema or object of object privilege %s", objectPrivKey(p))
+		}
+		if _, err := sql.ObjectName(p.GetSchema()); err != nil {
+			return fmt.Errorf("invalid schema %q of object privilege %s: %v", p.GetSchema(), objectPrivKey(p), err)
+		}
+		if _, err := sql.ObjectName(p.GetObject()); err != nil {
+			return fmt.Errorf("invalid object %q of object privilege %s: %v", p.GetObject(), objectPrivKey(p), err)
+		}
+	}
+	return nil
+}
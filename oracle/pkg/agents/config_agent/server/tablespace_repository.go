@@ -0,0 +1,176 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package configagent
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/GoogleCloudPlatform/elcarro-oracle-operator/oracle/pkg/agents/common/sql"
+	pb "github.com/GoogleCloudPlatform/elcarro-oracle-operator/oracle/pkg/agents/config_agent/protos"
+	dbdpb "github.com/GoogleCloudPlatform/elcarro-oracle-operator/oracle/pkg/agents/oracle"
+)
+
+const (
+	datafilesQuery = "select df.tablespace_name, df.file_id, df.bytes, df.autoextensible, df.maxbytes, df.maxblocks, t.bigfile, nvl(fs.bytes, 0) as free_bytes " +
+		"from dba_data_files df join dba_tablespaces t on t.tablespace_name = df.tablespace_name " +
+		"left join (select file_id, sum(bytes) as bytes from dba_free_space group by file_id) fs on fs.file_id = df.file_id"
+
+	// Oracle reports the largest number of blocks of a datafile as the
+	// maxblocks of a datafile which may grow unlimited.
+	smallfileUnlimitedBlocks = 4194302
+	bigfileUnlimitedBlocks   = 4294967293
+	// maxBlockSize is the largest Oracle block size. Sizes are rounded to
+	// blocks by Oracle, sizes that differ by less than a block are equal.
+	maxBlockSize = 32768
+)
+
+// tablespaces describe the managed tablespaces of an Oracle PDB.
+type tablespaces struct {
+	databaseName string
+	nameToSpec   map[string]*pb.Tablespace
+	// nameToFiles keeps track of the datafiles of the existing tablespaces.
+	// The value will be initialized/refreshed with method tablespaces.readEnv
+	nameToFiles map[string][]*datafile
+}
+
+// datafile describes a datafile of a tablespace.
+type datafile struct {
+	id         int64
+	bytes      int64
+	freeBytes  int64
+	maxBytes   int64
+	autoExtend bool
+	unlimited  bool
+}
+
+func newTablespaces(databaseName string, specs []*pb.Tablespace) *tablespaces {
+	nameToSpec := make(map[string]*pb.Tablespace)
+	for _, s := range specs {
+		nameToSpec[strings.ToUpper(s.GetName())] = s
+	}
+	return &tablespaces{
+		databaseName: strings.ToUpper(databaseName),
+		nameToSpec:   nameToSpec,
+	}
+}
+
+func (ts *tablespaces) readEnv(ctx context.Context, client dbdpb.DatabaseDaemonClient) error {
+	rows, err := queryRows(ctx, client, ts.databaseName, datafilesQuery)
+	if err != nil {
+		return fmt.Errorf("failed to load datafiles from DB %v", err)
+	}
+	ts.nameToFiles = make(map[string][]*datafile)
+	for _, row := range rows {
+		var nums [5]int64
+		for i, key := range []string{"FILE_ID", "BYTES", "MAXBYTES", "MAXBLOCKS", "FREE_BYTES"} {
+			if nums[i], err = strconv.ParseInt(row[key], 10, 64); err != nil {
+				return fmt.Errorf("failed to parse %s of %v: %v", key, row, err)
+			}
+		}
+		unlimitedBlocks := int64(smallfileUnlimitedBlocks)
+		if row["BIGFILE"] == "YES" {
+			unlimitedBlocks = bigfileUnlimitedBlocks
+		}
+		name := row["TABLESPACE_NAME"]
+		ts.nameToFiles[name] = append(ts.nameToFiles[name], &datafile{
+			id:         nums[0],
+			bytes:      nums[1],
+			maxBytes:   nums[2],
+			freeBytes:  nums[4],
+			autoExtend: row["AUTOEXTENSIBLE"] == "YES",
+			unlimited:  nums[3] >= unlimitedBlocks,
+		})
+	}
+	return nil
+}
+
+// diff returns tablespaces, which should be created, and statements, which
+// alter the datafiles of existing tablespaces, by comparing k8s spec with
+// real environment. Tablespaces not in the spec are left as is.
+func (ts *tablespaces) diff(ctx context.Context, client dbdpb.DatabaseDaemonClient) (toCreate []*pb.Tablespace, toAlter []string, err error) {
+	if err := ts.readEnv(ctx, client); err != nil {
+		return nil, nil, fmt.Errorf("failed to read the env tablespaces: %v", err)
+	}
+	for _, name := range ts.specNames() {
+		spec := ts.nameToSpec[name]
+		files, ok := ts.nameToFiles[name]
+		if !ok {
+			toCreate = append(toCreate, spec)
+			continue
+		}
+		for _, f := range files {
+			if !f.matches(spec) {
+				toAlter = append(toAlter, sql.QueryAlterDatafileAutoExtend(f.id, spec.GetAutoExtend(), spec.GetMaxSizeBytes()))
+			}
+		}
+	}
+	return toCreate, toAlter, nil
+}
+
+// usage returns the usage of the existing tablespaces in the spec.
+func (ts *tablespaces) usage() []*pb.SyncTablespacesResponse_Usage {
+	var usages []*pb.SyncTablespacesResponse_Usage
+	for _, name := range ts.specNames() {
+		files, ok := ts.nameToFiles[name]
+		if !ok {
+			continue
+		}
+		u := &pb.SyncTablespacesResponse_Usage{Name: name}
+		for _, f := range files {
+			u.SizeBytes += f.bytes
+			u.UsedBytes += f.bytes - f.freeBytes
+			if f.autoExtend && f.maxBytes > f.bytes {
+				u.MaxSizeBytes += f.maxBytes
+			} else {
+				u.MaxSizeBytes += f.bytes
+			}
+		}
+		usages = append(usages, u)
+	}
+	return usages
+}
+
+func (ts *tablespaces) specNames() []string {
+	var names []string
+	for name := range ts.nameToSpec {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// matches returns true if the autoextend settings of the datafile are the
+// ones of the spec.
+func (f *datafile) matches(spec *pb.Tablespace) bool {
+	if f.autoExtend != spec.GetAutoExtend() {
+		return false
+	}
+	if !spec.GetAutoExtend() {
+		return true
+	}
+	if spec.GetMaxSizeBytes() == 0 {
+		return f.unlimited
+	}
+	return !f.unlimited && sameSize(f.maxBytes, spec.GetMaxSizeBytes())
+}
+
+func sameSize(a, b int64) bool {
+	d := a - b
+	return -maxBlockSize < d && d < maxBlockSize
+}
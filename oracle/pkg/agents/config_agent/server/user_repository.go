@@ -19,6 +19,8 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"sort"
+	"strconv"
 	"strings"
 
 	"bitbucket.org/creachadair/stringset"
@@ -29,6 +31,20 @@ import (
 	dbdpb "github.com/GoogleCloudPlatform/elcarro-oracle-operator/oracle/pkg/agents/oracle"
 )
 
+const (
+	usersQuery = "select username, account_status, profile from dba_users where ORACLE_MAINTAINED='N' and INHERITED='NO'"
+
+	accountLocked   = "LOCKED"
+	accountUnlocked = "UNLOCKED"
+)
+
+// account describes the account status and the profile of a user
+// (dba_users table).
+type account struct {
+	status  string
+	profile string
+}
+
 // users describe the managed Oracle PDB users.
 type users struct {
 	databaseName  string
@@ -37,6 +53,12 @@ type users struct {
 	// envUserNames keeps track of the managed users.
 	// The value will be initialized/refreshed with method users.readEnv
 	envUserNames []string
+	// envAccounts keeps track of the accounts of the managed users by name.
+	// The value will be initialized/refreshed with method users.readEnv
+	envAccounts map[string]account
+	// managedUsers are the users created for the database before. Only they
+	// are locked or dropped once they are removed from the spec.
+	managedUsers map[string]bool
 }
 
 // diff returns users, which should be created/updated/deleted by comparing k8s spec with real environment.
@@ -57,8 +79,13 @@ func (us *users) diff(ctx context.Context, client dbdpb.DatabaseDaemonClient) (t
 	if err != nil {
 		return nil, nil, nil, nil, err
 	}
+	for _, u := range toCheckUsers {
+		u.envAccountStatus = us.envAccounts[u.userName].status
+		u.envProfile = us.envAccounts[u.userName].profile
+	}
 	for _, d := range toDelete {
 		du := newNoSpecUser(us.databaseName, d)
+		du.envAccountStatus = us.envAccounts[d].status
 		if err := du.readEnv(ctx, client); err != nil {
 			return nil, nil, nil, nil, fmt.Errorf("failed to read the env user %v: %v", du, err)
 		}
@@ -69,7 +96,15 @@ func (us *users) diff(ctx context.Context, client dbdpb.DatabaseDaemonClient) (t
 		if err != nil {
 			return nil, nil, nil, nil, fmt.Errorf("failed to read the env user %v: %v", u, err)
 		}
-		if len(toGrant) != 0 || len(toRevoke) != 0 {
+		toAlterQuotas, err := u.quotaDiff(ctx, client)
+		if err != nil {
+			return nil, nil, nil, nil, fmt.Errorf("failed to read the env quotas of user %v: %v", u, err)
+		}
+		toAlterObjPrivs, err := objectPrivsDiff(ctx, client, u.databaseName, u.userName, u.specObjPrivs)
+		if err != nil {
+			return nil, nil, nil, nil, fmt.Errorf("failed to read the env object privileges of user %v: %v", u, err)
+		}
+		if len(toGrant) != 0 || len(toRevoke) != 0 || len(toAlterQuotas) != 0 || len(toAlterObjPrivs) != 0 || len(u.accountCmds()) != 0 {
 			toUpdateUsers = append(toUpdateUsers, u)
 		}
 		if toUpdatePwd {
@@ -81,20 +116,25 @@ func (us *users) diff(ctx context.Context, client dbdpb.DatabaseDaemonClient) (t
 }
 
 func (us *users) readEnv(ctx context.Context, client dbdpb.DatabaseDaemonClient) error {
-	envUserNames, err := queryDB(
-		ctx,
-		client,
-		us.databaseName,
-		"select username from dba_users where ORACLE_MAINTAINED='N' and INHERITED='NO'",
-		"USERNAME",
-		func(userName string) bool {
-			return userName != pdbAdmin
-		},
-	)
+	rows, err := queryRows(ctx, client, us.databaseName, usersQuery)
 	if err != nil {
 		return fmt.Errorf("failed to load users from DB %v", err)
 	}
+	var envUserNames []string
+	envAccounts := make(map[string]account)
+	for _, row := range rows {
+		userName, ok := row["USERNAME"]
+		if !ok {
+			return fmt.Errorf("failed to retrieve USERNAME from %v", row)
+		}
+		if userName == pdbAdmin {
+			continue
+		}
+		envUserNames = append(envUserNames, userName)
+		envAccounts[userName] = account{status: row["ACCOUNT_STATUS"], profile: row["PROFILE"]}
+	}
 	us.envUserNames = envUserNames
+	us.envAccounts = envAccounts
 	roles, err := queryDB(
 		ctx,
 		client,
@@ -115,6 +155,22 @@ func (us *users) readEnv(ctx context.Context, client dbdpb.DatabaseDaemonClient)
 	return nil
 }
 
+// accountStatuses returns the account statuses of the spec users found in the
+// environment by name. The users not in the spec are included if they are
+// locked by the deletion policy.
+func (us *users) accountStatuses(policy pb.UsersChangedRequest_DeletionPolicy) map[string]string {
+	statuses := make(map[string]string)
+	for name, a := range us.envAccounts {
+		if _, ok := us.nameToUser[name]; !ok && (policy != pb.UsersChangedRequest_LOCK || !us.managedUsers[name]) {
+			continue
+		}
+		if a.status != "" {
+			statuses[name] = a.status
+		}
+	}
+	return statuses
+}
+
 func (us *users) getUsers(names []string) ([]*user, error) {
 	var res []*user
 	for _, name := range names {
@@ -127,16 +183,31 @@ func (us *users) getUsers(names []string) ([]*user, error) {
 	return res, nil
 }
 
-func newUsers(databaseName string, userSpecs []*pb.User) *users {
+func newUsers(databaseName string, userSpecs []*pb.User, managedUsers []string) *users {
 	nameToUser := make(map[string]*user)
 	for _, us := range userSpecs {
 		nameToUser[strings.ToUpper(us.GetName())] = newUser(databaseName, us)
 	}
+	managed := make(map[string]bool)
+	for _, name := range managedUsers {
+		managed[strings.ToUpper(name)] = true
+	}
 
 	return &users{
 		databaseName: strings.ToUpper(databaseName),
 		nameToUser:   nameToUser,
+		managedUsers: managed,
+	}
+}
+
+// deletionPolicy returns the deletion policy which applies to a user not in
+// the spec: users which weren't created for the database, e.g. by a Data Pump
+// import or a DBA, are always retained.
+func (us *users) deletionPolicy(u *user, policy pb.UsersChangedRequest_DeletionPolicy) pb.UsersChangedRequest_DeletionPolicy {
+	if !us.managedUsers[u.userName] {
+		return pb.UsersChangedRequest_RETAIN
 	}
+	return policy
 }
 
 // user describe a managed Oracle PDB user.
@@ -144,6 +215,22 @@ type user struct {
 	databaseName string
 	userName     string
 	specPrivs    []string
+	specQuotas   []*pb.TablespaceQuota
+	specObjPrivs []*pb.ObjectPrivilege
+	// specProfile is the profile of the user, empty to leave it as is.
+	specProfile string
+	// specAccountStatus is LOCKED or UNLOCKED, empty to leave the account
+	// as is.
+	specAccountStatus string
+	// envProfile and envAccountStatus keep track of the profile and the
+	// account status of the user (dba_users table).
+	// The values will be initialized/refreshed with method users.diff
+	envProfile       string
+	envAccountStatus string
+	// envQuotas keeps track of the quotas of the user in bytes by tablespace,
+	// -1 for an unlimited quota (dba_ts_quotas table).
+	// The value will be initialized/refreshed with method user.quotaDiff
+	envQuotas map[string]int64
 	// envDbaSysPrivs keeps track of the privileges granted to the user (dba_sys_privs table).
 	// The value will be initialized/refreshed with method user.readEnv
 	envDbaSysPrivs []string
@@ -154,6 +241,12 @@ type user struct {
 	gsmSecNewVer string
 	// gsmSecCurVer is the current GSM secret version.
 	gsmSecCurVer string
+	// secretNewVer is the resource version of the k8s secret of the
+	// password from the spec.
+	secretNewVer string
+	// secretCurVer is the resource version of the k8s secret of the
+	// current password.
+	secretCurVer string
 	// newPassword is used by both gsm and plaintext;
 	// can be overwritten later if GSM is enabled.
 	newPassword string
@@ -215,7 +308,105 @@ func (u *user) diff(ctx context.Context, client dbdpb.DatabaseDaemonClient) (toG
 		u.newPassword = gsmPwd
 	}
 	toUpdatePlaintextPwd := u.curPassword != "" && u.curPassword != u.newPassword
-	return toGrant, toRevoke, toUpdateGsmPwd || toUpdatePlaintextPwd, nil
+	toUpdateSecretPwd := u.secretNewVer != "" && u.secretNewVer != u.secretCurVer
+	return toGrant, toRevoke, toUpdateGsmPwd || toUpdatePlaintextPwd || toUpdateSecretPwd, nil
+}
+
+// quotaDiff returns statements, which alter the quotas of the user by
+// comparing k8s spec with real environment. Quotas are only managed for users
+// with quotas in the spec.
+func (u *user) quotaDiff(ctx context.Context, client dbdpb.DatabaseDaemonClient) ([]string, error) {
+	if len(u.specQuotas) == 0 {
+		return nil, nil
+	}
+	rows, err := queryRows(ctx, client, u.databaseName, fmt.Sprintf("select tablespace_name, max_bytes from dba_ts_quotas where username='%s'", sql.StringParam(u.userName)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to query dba ts quotas: %v", err)
+	}
+	u.envQuotas = make(map[string]int64)
+	for _, row := range rows {
+		maxBytes, err := strconv.ParseInt(row["MAX_BYTES"], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse MAX_BYTES of %v: %v", row, err)
+		}
+		u.envQuotas[row["TABLESPACE_NAME"]] = maxBytes
+	}
+	return u.quotaCmds(), nil
+}
+
+// accountCmds returns statements, which alter the profile of the user and
+// lock or unlock the account by comparing k8s spec with real environment.
+// Accounts locked for a time after too many failed logins (LOCKED(TIMED))
+// aren't unlocked, they are unlocked by the database when the
+// PASSWORD_LOCK_TIME of the profile has passed.
+func (u *user) accountCmds() []string {
+	var cmds []string
+	if u.specProfile != "" && !strings.EqualFold(u.specProfile, u.envProfile) {
+		cmds = append(cmds, sql.QueryAlterUserProfile(u.userName, u.specProfile))
+	}
+	locked := lockedAccount(u.envAccountStatus)
+	switch {
+	case u.specAccountStatus == accountLocked && !locked:
+		cmds = append(cmds, sql.QueryAlterUserAccount(u.userName, true))
+	case u.specAccountStatus == accountUnlocked && locked:
+		cmds = append(cmds, sql.QueryAlterUserAccount(u.userName, false))
+	}
+	return cmds
+}
+
+// deleteCmds returns statements, which carry out the deletion policy for a
+// user not in the spec. Users are dropped or locked with an explicit policy
+// only; with RETAIN they are left as is.
+func (u *user) deleteCmds(policy pb.UsersChangedRequest_DeletionPolicy) []string {
+	switch policy {
+	case pb.UsersChangedRequest_LOCK:
+		if lockedAccount(u.envAccountStatus) {
+			return nil
+		}
+		return []string{sql.QueryAlterUserAccount(u.userName, true)}
+	case pb.UsersChangedRequest_DROP:
+		return []string{sql.QueryDropUser(u.userName, false)}
+	case pb.UsersChangedRequest_DROP_CASCADE:
+		return []string{sql.QueryDropUser(u.userName, true)}
+	}
+	return nil
+}
+
+// lockedAccount returns true if a dba_users.account_status is locked until
+// unlocked explicitly, unlike LOCKED(TIMED) after too many failed logins.
+func lockedAccount(status string) bool {
+	return strings.Contains(status, "LOCKED") && !strings.Contains(status, "LOCKED(TIMED)")
+}
+
+// quotaCmds returns statements, which alter the quotas of the user in the
+// environment to the ones of the spec.
+func (u *user) quotaCmds() []string {
+	var cmds []string
+	specTablespaces := make(map[string]bool)
+	for _, q := range u.specQuotas {
+		ts := strings.ToUpper(q.GetTablespace())
+		specTablespaces[ts] = true
+		want := q.GetMaxBytes()
+		if q.GetUnlimited() {
+			want = -1
+		}
+		env := u.envQuotas[ts]
+		if env == want || (env >= 0 && want >= 0 && sameSize(env, want)) {
+			continue
+		}
+		cmds = append(cmds, sql.QueryAlterUserQuota(u.userName, ts, q.GetUnlimited(), q.GetMaxBytes()))
+	}
+	var revoked []string
+	for ts, env := range u.envQuotas {
+		if !specTablespaces[ts] && env != 0 {
+			revoked = append(revoked, ts)
+		}
+	}
+	sort.Strings(revoked)
+	for _, ts := range revoked {
+		cmds = append(cmds, sql.QueryAlterUserQuota(u.userName, ts, false, 0))
+	}
+	return cmds
 }
 
 func (u *user) create(ctx context.Context, client dbdpb.DatabaseDaemonClient) error {
@@ -223,6 +414,11 @@ func (u *user) create(ctx context.Context, client dbdpb.DatabaseDaemonClient) er
 	for _, p := range u.specPrivs {
 		grantCmds = append(grantCmds, sql.QueryGrantPrivileges(p, u.userName))
 	}
+	for _, p := range u.specObjPrivs {
+		grantCmds = append(grantCmds, sql.QueryGrantObjectPrivilege(strings.ToUpper(p.GetPrivilege()), p.GetSchema(), p.GetObject(), u.userName))
+	}
+	grantCmds = append(grantCmds, u.quotaCmds()...)
+	grantCmds = append(grantCmds, u.accountCmds()...)
 	sqls := append(
 		[]string{
 			sql.QuerySetSessionContainer(u.databaseName),
@@ -245,10 +441,81 @@ func (u *user) update(ctx context.Context, client dbdpb.DatabaseDaemonClient, ro
 	if err := u.updateSysPrivs(ctx, client, roles); err != nil {
 		return err
 	}
+	if err := u.updateQuotas(ctx, client); err != nil {
+		return err
+	}
+	if err := u.updateObjectPrivs(ctx, client); err != nil {
+		return err
+	}
+	if err := u.updateAccount(ctx, client); err != nil {
+		return err
+	}
 	return nil
 
 }
 
+func (u *user) remove(ctx context.Context, client dbdpb.DatabaseDaemonClient, policy pb.UsersChangedRequest_DeletionPolicy) error {
+	toRun := u.deleteCmds(policy)
+	if len(toRun) == 0 {
+		return nil
+	}
+	sqls := append([]string{sql.QuerySetSessionContainer(u.databaseName)}, toRun...)
+	if _, err := client.RunSQLPlus(ctx, &dbdpb.RunSQLPlusCMDRequest{
+		Commands: sqls,
+	}); err != nil {
+		return fmt.Errorf("failed to remove user %s (%v): %v", u.userName, policy, err)
+	}
+	return nil
+}
+
+func (u *user) updateAccount(ctx context.Context, client dbdpb.DatabaseDaemonClient) error {
+	toAlter := u.accountCmds()
+	if len(toAlter) == 0 {
+		return nil
+	}
+	sqls := append([]string{sql.QuerySetSessionContainer(u.databaseName)}, toAlter...)
+	if _, err := client.RunSQLPlus(ctx, &dbdpb.RunSQLPlusCMDRequest{
+		Commands: sqls,
+	}); err != nil {
+		return fmt.Errorf("failed to alter account of user %s: %v", u.userName, err)
+	}
+	return nil
+}
+
+func (u *user) updateObjectPrivs(ctx context.Context, client dbdpb.DatabaseDaemonClient) error {
+	toAlter, err := objectPrivsDiff(ctx, client, u.databaseName, u.userName, u.specObjPrivs)
+	if err != nil {
+		return fmt.Errorf("failed to get object privilege diff to update user %v: %v", u, err)
+	}
+	if len(toAlter) == 0 {
+		return nil
+	}
+	sqls := append([]string{sql.QuerySetSessionContainer(u.databaseName)}, toAlter...)
+	if _, err := client.RunSQLPlus(ctx, &dbdpb.RunSQLPlusCMDRequest{
+		Commands: sqls,
+	}); err != nil {
+		return fmt.Errorf("failed to alter object privileges of user %s: %v", u.userName, err)
+	}
+	return nil
+}
+
+func (u *user) updateQuotas(ctx context.Context, client dbdpb.DatabaseDaemonClient) error {
+	toAlter, err := u.quotaDiff(ctx, client)
+	if err != nil {
+		return fmt.Errorf("failed to get quota diff to update user %v: %v", u, err)
+	}
+	if len(toAlter) == 0 {
+		return nil
+	}
+	sqls := append([]string{sql.QuerySetSessionContainer(u.databaseName)}, toAlter...)
+	if _, err := client.RunSQLPlus(ctx, &dbdpb.RunSQLPlusCMDRequest{
+		Commands: sqls,
+	}); err != nil {
+		return fmt.Errorf("failed to alter quotas of user %s: %v", u.userName, err)
+	}
+	return nil
+}
+
 func (u *user) updateUserPassword(ctx context.Context, client dbdpb.DatabaseDaemonClient) error {
 	_, _, toUpdate, err := u.diff(ctx, client)
 	if err != nil {
@@ -389,7 +656,7 @@ func newUser(databaseName string, specUser *pb.User) *user {
 		upperP := strings.ToUpper(p)
 		// example: GRANT SELECT ON TABLE t TO SCOTT
 		if strings.Contains(upperP, " ON ") {
-			klog.ErrorS(errors.New("object privileges not supported, will be omitted by operator"), "not supported privileges", "priv", p)
+			klog.ErrorS(errors.New("object privileges are specified in objectPrivileges, will be omitted by operator"), "not supported privileges", "priv", p)
 		} else {
 			privs = append(privs, upperP)
 		}
@@ -403,8 +670,18 @@ func newUser(databaseName string, specUser *pb.User) *user {
 		// Only used for plaintext status diff.
 		curPassword: specUser.GetLastPassword(),
 		specPrivs:   privs,
+		specQuotas:  specUser.GetQuotas(),
 		// Empty version is returned if PasswordGsmSecretRef is nil.
 		gsmSecCurVer: specUser.PasswordGsmSecretRef.GetLastVersion(),
+		specObjPrivs: specUser.GetObjectPrivileges(),
+		specProfile:  strings.ToUpper(specUser.GetProfile()),
+		// LOCKED, UNLOCKED or empty.
+		specAccountStatus: strings.ToUpper(specUser.GetAccountStatus()),
+	}
+	if ps := specUser.GetPasswordSecret(); ps != nil {
+		user.newPassword = ps.GetPassword()
+		user.secretNewVer = ps.GetVersion()
+		user.secretCurVer = ps.GetLastVersion()
 	}
 	if specUser.PasswordGsmSecretRef != nil {
 		user.gsmSecNewVer = fmt.Sprintf(gsmSecretStr, specUser.GetPasswordGsmSecretRef().GetProjectId(), specUser.GetPasswordGsmSecretRef().GetSecretId(), specUser.GetPasswordGsmSecretRef().GetVersion())
@@ -412,6 +689,28 @@ func newUser(databaseName string, specUser *pb.User) *user {
 	return user
 }
 
+// validateAccount returns an error if the profile or the account status of a
+// user spec is invalid.
+func validateAccount(u *pb.User) error {
+	if _, err := sql.ObjectName(u.GetProfile()); err != nil {
+		return fmt.Errorf("invalid profile %q: %v", u.GetProfile(), err)
+	}
+	switch strings.ToUpper(u.GetAccountStatus()) {
+	case "", accountLocked, accountUnlocked:
+		return nil
+	}
+	return fmt.Errorf("invalid account status %q", u.GetAccountStatus())
+}
+
+// validateDeletionPolicy returns an error if the deletion policy of a request
+// is unknown.
+func validateDeletionPolicy(policy pb.UsersChangedRequest_DeletionPolicy) error {
+	if _, ok := pb.UsersChangedRequest_DeletionPolicy_name[int32(policy)]; !ok {
+		return fmt.Errorf("invalid deletion policy %v", policy)
+	}
+	return nil
+}
+
 func newNoSpecUser(databaseName, userName string) *user {
 	return &user{
 		databaseName: strings.ToUpper(databaseName),
@@ -420,6 +719,20 @@ func newNoSpecUser(databaseName, userName string) *user {
 }
 
 func queryDB(ctx context.Context, client dbdpb.DatabaseDaemonClient, databaseName, sqlQuery, key string, filter func(val string) bool) ([]string, error) {
+	rows, err := queryRows(ctx, client, databaseName, sqlQuery)
+	if err != nil {
+		return nil, err
+	}
+	userNames, err := queryRowsByKey(rows, key, filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve %v from %v", key, rows)
+	}
+	return userNames, nil
+}
+
+// queryRows runs sqlQuery in the PDB databaseName and returns a list of rows
+// with column-value mapping.
+func queryRows(ctx context.Context, client dbdpb.DatabaseDaemonClient, databaseName, sqlQuery string) ([]map[string]string, error) {
 	resp, err := client.RunSQLPlusFormatted(ctx, &dbdpb.RunSQLPlusCMDRequest{
 		Commands: []string{
 			sql.QuerySetSessionContainer(databaseName),
@@ -433,11 +746,7 @@ func queryDB(ctx context.Context, client dbdpb.DatabaseDaemonClient, databaseNam
 	if err != nil {
 		return nil, fmt.Errorf("queryDB failed to parse data from %v: %v", resp, err)
 	}
-	userNames, err := queryRowsByKey(rows, key, filter)
-	if err != nil {
-		return nil, fmt.Errorf("failed to retrieve %v from %v", key, rows)
-	}
-	return userNames, nil
+	return rows, nil
 }
 
 // parseSQLResponse parses the JSON result-set (returned by runSQLPlus API) and
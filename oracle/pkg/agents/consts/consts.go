@@ -52,6 +52,14 @@ const (
 	// ProxyDomainSocketFile is meant for the database daemon to communicate to the database daemon proxy.
 	ProxyDomainSocketFile = "/var/tmp/dbdaemon_proxy.sock"
 
+	// AgentTLSDir is where the certificates used for the mutual TLS between
+	// the operator, the Config Agent and the Database Daemon are mounted.
+	AgentTLSDir = "/etc/elcarro/agent-tls"
+
+	// FileVolumesDir is where the file volumes of an Instance are mounted,
+	// which hold the file:// locations of backups, exports and imports.
+	FileVolumesDir = "/mnt"
+
 	// SecureListenerPort is a secure listener port number.
 	SecureListenerPort = 6021
 	// SSLListenerPort is an SSL listener port number.
@@ -122,6 +130,9 @@ const (
 
 	// RMANBackup is the oracle rman command for taking backups.
 	RMANBackup = "backup"
+
+	// RMANVerify is the oracle rman command for verifying backups.
+	RMANVerify = "verify"
 )
 
 var (
@@ -189,6 +200,14 @@ var (
 	// ListenerDir is the listener directory.
 	ListenerDir = "/%s/app/oracle/oraconfig/network"
 
+	// AuditLogFile is the audit log of the statements run by the Database
+	// Daemon, streamed by the audit log sidecar.
+	AuditLogFile = "/%s/app/oracle/audit/dbdaemon_audit.log"
+
+	// LROStoreDir is where the Database Daemon persists its long running
+	// operations.
+	LROStoreDir = "/%s/app/oracle/lro"
+
 	// ScriptDir is where the scripts are located on the container image.
 	ScriptDir = "/agents"
 
@@ -87,7 +87,7 @@ func (x GetDatabaseTypeResponse_DatabaseType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use GetDatabaseTypeResponse_DatabaseType.Descriptor instead.
 func (GetDatabaseTypeResponse_DatabaseType) EnumDescriptor() ([]byte, []int) {
-	return file_oracle_pkg_agents_oracle_dbdaemon_proto_rawDescGZIP(), []int{24, 0}
+	return file_oracle_pkg_agents_oracle_dbdaemon_proto_rawDescGZIP(), []int{28, 0}
 }
 
 type CreateDirRequest struct {
@@ -1108,16 +1108,22 @@ type RunRMANRequest struct {
 	// target is the primary database to connect to. This is usually
 	// the source database in clone operations. This would be the
 	// ES primary for the ES setup.
+	// The target and auxiliary connect strings are passed to RMAN on stdin,
+	// so they can hold a password.
 	Target string `protobuf:"bytes,4,opt,name=target,proto3" json:"target,omitempty"`
 	// auxiliary is the secondary database to connect to.
 	// this is the ES replica database in the ES setup
 	Auxiliary string `protobuf:"bytes,5,opt,name=auxiliary,proto3" json:"auxiliary,omitempty"`
 	// gcs_path is the destination gcs bucket for the backup
 	GcsPath string `protobuf:"bytes,6,opt,name=gcs_path,json=gcsPath,proto3" json:"gcs_path,omitempty"`
-	// local_path is the destination directory for the backup
+	// local_path is the destination directory for the backup, the staging
+	// directory of a backup to gcs_path.
 	LocalPath string `protobuf:"bytes,7,opt,name=local_path,json=localPath,proto3" json:"local_path,omitempty"`
 	// rman command to run, currently support backup and restore
 	Cmd string `protobuf:"bytes,8,opt,name=cmd,proto3" json:"cmd,omitempty"`
+	// storage_credentials authenticate access to the storage location,
+	// see the storage package for the supported keys.
+	StorageCredentials map[string]string `protobuf:"bytes,9,rep,name=storage_credentials,json=storageCredentials,proto3" json:"storage_credentials,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
 func (x *RunRMANRequest) Reset() {
@@ -1208,6 +1214,13 @@ func (x *RunRMANRequest) GetCmd() string {
 	return ""
 }
 
+func (x *RunRMANRequest) GetStorageCredentials() map[string]string {
+	if x != nil {
+		return x.StorageCredentials
+	}
+	return nil
+}
+
 // LROInput is a common part of input requests for all Async operations.
 type LROInput struct {
 	state         protoimpl.MessageState
@@ -1258,6 +1271,83 @@ func (x *LROInput) GetOperationId() string {
 	return ""
 }
 
+// OperationProgress is the metadata of the long running operations which
+// report their progress: RMAN, Data Pump and CDB creation.
+type OperationProgress struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Percentage of the work done, from 0 to 100.
+	PercentComplete int32 `protobuf:"varint,1,opt,name=percent_complete,json=percentComplete,proto3" json:"percent_complete,omitempty"`
+	// Estimated completion time, unset if it isn't known.
+	EstimatedCompletionTime *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=estimated_completion_time,json=estimatedCompletionTime,proto3" json:"estimated_completion_time,omitempty"`
+	// Current step of the operation, e.g. the DBCA step.
+	Message string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	// Time the progress was read.
+	UpdateTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+}
+
+func (x *OperationProgress) Reset() {
+	*x = OperationProgress{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OperationProgress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OperationProgress) ProtoMessage() {}
+
+func (x *OperationProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OperationProgress.ProtoReflect.Descriptor instead.
+func (*OperationProgress) Descriptor() ([]byte, []int) {
+	return file_oracle_pkg_agents_oracle_dbdaemon_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *OperationProgress) GetPercentComplete() int32 {
+	if x != nil {
+		return x.PercentComplete
+	}
+	return 0
+}
+
+func (x *OperationProgress) GetEstimatedCompletionTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EstimatedCompletionTime
+	}
+	return nil
+}
+
+func (x *OperationProgress) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *OperationProgress) GetUpdateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return nil
+}
+
 type RunRMANAsyncRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1270,7 +1360,7 @@ type RunRMANAsyncRequest struct {
 func (x *RunRMANAsyncRequest) Reset() {
 	*x = RunRMANAsyncRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[19]
+		mi := &file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1283,7 +1373,7 @@ func (x *RunRMANAsyncRequest) String() string {
 func (*RunRMANAsyncRequest) ProtoMessage() {}
 
 func (x *RunRMANAsyncRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[19]
+	mi := &file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1296,7 +1386,7 @@ func (x *RunRMANAsyncRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RunRMANAsyncRequest.ProtoReflect.Descriptor instead.
 func (*RunRMANAsyncRequest) Descriptor() ([]byte, []int) {
-	return file_oracle_pkg_agents_oracle_dbdaemon_proto_rawDescGZIP(), []int{19}
+	return file_oracle_pkg_agents_oracle_dbdaemon_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *RunRMANAsyncRequest) GetSyncRequest() *RunRMANRequest {
@@ -1325,7 +1415,7 @@ type RunRMANResponse struct {
 func (x *RunRMANResponse) Reset() {
 	*x = RunRMANResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[20]
+		mi := &file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1338,7 +1428,7 @@ func (x *RunRMANResponse) String() string {
 func (*RunRMANResponse) ProtoMessage() {}
 
 func (x *RunRMANResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[20]
+	mi := &file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1351,7 +1441,7 @@ func (x *RunRMANResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RunRMANResponse.ProtoReflect.Descriptor instead.
 func (*RunRMANResponse) Descriptor() ([]byte, []int) {
-	return file_oracle_pkg_agents_oracle_dbdaemon_proto_rawDescGZIP(), []int{20}
+	return file_oracle_pkg_agents_oracle_dbdaemon_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *RunRMANResponse) GetOutput() []string {
@@ -1375,7 +1465,7 @@ type NIDRequest struct {
 func (x *NIDRequest) Reset() {
 	*x = NIDRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[21]
+		mi := &file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1388,7 +1478,7 @@ func (x *NIDRequest) String() string {
 func (*NIDRequest) ProtoMessage() {}
 
 func (x *NIDRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[21]
+	mi := &file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1401,7 +1491,7 @@ func (x *NIDRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NIDRequest.ProtoReflect.Descriptor instead.
 func (*NIDRequest) Descriptor() ([]byte, []int) {
-	return file_oracle_pkg_agents_oracle_dbdaemon_proto_rawDescGZIP(), []int{21}
+	return file_oracle_pkg_agents_oracle_dbdaemon_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *NIDRequest) GetSid() string {
@@ -1427,7 +1517,7 @@ type NIDResponse struct {
 func (x *NIDResponse) Reset() {
 	*x = NIDResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[22]
+		mi := &file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1440,7 +1530,7 @@ func (x *NIDResponse) String() string {
 func (*NIDResponse) ProtoMessage() {}
 
 func (x *NIDResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[22]
+	mi := &file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1453,7 +1543,167 @@ func (x *NIDResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NIDResponse.ProtoReflect.Descriptor instead.
 func (*NIDResponse) Descriptor() ([]byte, []int) {
-	return file_oracle_pkg_agents_oracle_dbdaemon_proto_rawDescGZIP(), []int{22}
+	return file_oracle_pkg_agents_oracle_dbdaemon_proto_rawDescGZIP(), []int{23}
+}
+
+type ChangeDatabaseIdentityRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name of the CDB, the ORACLE_SID.
+	CdbName string `protobuf:"bytes,1,opt,name=cdb_name,json=cdbName,proto3" json:"cdb_name,omitempty"`
+	// rename sets db_name to cdb_name, the database has another name.
+	Rename bool `protobuf:"varint,2,opt,name=rename,proto3" json:"rename,omitempty"`
+}
+
+func (x *ChangeDatabaseIdentityRequest) Reset() {
+	*x = ChangeDatabaseIdentityRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChangeDatabaseIdentityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeDatabaseIdentityRequest) ProtoMessage() {}
+
+func (x *ChangeDatabaseIdentityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeDatabaseIdentityRequest.ProtoReflect.Descriptor instead.
+func (*ChangeDatabaseIdentityRequest) Descriptor() ([]byte, []int) {
+	return file_oracle_pkg_agents_oracle_dbdaemon_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ChangeDatabaseIdentityRequest) GetCdbName() string {
+	if x != nil {
+		return x.CdbName
+	}
+	return ""
+}
+
+func (x *ChangeDatabaseIdentityRequest) GetRename() bool {
+	if x != nil {
+		return x.Rename
+	}
+	return false
+}
+
+type ChangeDatabaseIdentityResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The new DBID of the database.
+	Dbid int64 `protobuf:"varint,1,opt,name=dbid,proto3" json:"dbid,omitempty"`
+}
+
+func (x *ChangeDatabaseIdentityResponse) Reset() {
+	*x = ChangeDatabaseIdentityResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChangeDatabaseIdentityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeDatabaseIdentityResponse) ProtoMessage() {}
+
+func (x *ChangeDatabaseIdentityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeDatabaseIdentityResponse.ProtoReflect.Descriptor instead.
+func (*ChangeDatabaseIdentityResponse) Descriptor() ([]byte, []int) {
+	return file_oracle_pkg_agents_oracle_dbdaemon_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ChangeDatabaseIdentityResponse) GetDbid() int64 {
+	if x != nil {
+		return x.Dbid
+	}
+	return 0
+}
+
+type ChangeDatabaseIdentityAsyncRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SyncRequest *ChangeDatabaseIdentityRequest `protobuf:"bytes,1,opt,name=sync_request,json=syncRequest,proto3" json:"sync_request,omitempty"`
+	LroInput    *LROInput                      `protobuf:"bytes,2,opt,name=lro_input,json=lroInput,proto3" json:"lro_input,omitempty"`
+}
+
+func (x *ChangeDatabaseIdentityAsyncRequest) Reset() {
+	*x = ChangeDatabaseIdentityAsyncRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChangeDatabaseIdentityAsyncRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeDatabaseIdentityAsyncRequest) ProtoMessage() {}
+
+func (x *ChangeDatabaseIdentityAsyncRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeDatabaseIdentityAsyncRequest.ProtoReflect.Descriptor instead.
+func (*ChangeDatabaseIdentityAsyncRequest) Descriptor() ([]byte, []int) {
+	return file_oracle_pkg_agents_oracle_dbdaemon_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ChangeDatabaseIdentityAsyncRequest) GetSyncRequest() *ChangeDatabaseIdentityRequest {
+	if x != nil {
+		return x.SyncRequest
+	}
+	return nil
+}
+
+func (x *ChangeDatabaseIdentityAsyncRequest) GetLroInput() *LROInput {
+	if x != nil {
+		return x.LroInput
+	}
+	return nil
 }
 
 type GetDatabaseTypeRequest struct {
@@ -1465,7 +1715,7 @@ type GetDatabaseTypeRequest struct {
 func (x *GetDatabaseTypeRequest) Reset() {
 	*x = GetDatabaseTypeRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[23]
+		mi := &file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1478,7 +1728,7 @@ func (x *GetDatabaseTypeRequest) String() string {
 func (*GetDatabaseTypeRequest) ProtoMessage() {}
 
 func (x *GetDatabaseTypeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[23]
+	mi := &file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1491,7 +1741,7 @@ func (x *GetDatabaseTypeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetDatabaseTypeRequest.ProtoReflect.Descriptor instead.
 func (*GetDatabaseTypeRequest) Descriptor() ([]byte, []int) {
-	return file_oracle_pkg_agents_oracle_dbdaemon_proto_rawDescGZIP(), []int{23}
+	return file_oracle_pkg_agents_oracle_dbdaemon_proto_rawDescGZIP(), []int{27}
 }
 
 type GetDatabaseTypeResponse struct {
@@ -1505,7 +1755,7 @@ type GetDatabaseTypeResponse struct {
 func (x *GetDatabaseTypeResponse) Reset() {
 	*x = GetDatabaseTypeResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[24]
+		mi := &file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1518,7 +1768,7 @@ func (x *GetDatabaseTypeResponse) String() string {
 func (*GetDatabaseTypeResponse) ProtoMessage() {}
 
 func (x *GetDatabaseTypeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[24]
+	mi := &file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1531,7 +1781,7 @@ func (x *GetDatabaseTypeResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetDatabaseTypeResponse.ProtoReflect.Descriptor instead.
 func (*GetDatabaseTypeResponse) Descriptor() ([]byte, []int) {
-	return file_oracle_pkg_agents_oracle_dbdaemon_proto_rawDescGZIP(), []int{24}
+	return file_oracle_pkg_agents_oracle_dbdaemon_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *GetDatabaseTypeResponse) GetDatabaseType() GetDatabaseTypeResponse_DatabaseType {
@@ -1550,7 +1800,7 @@ type GetDatabaseNameRequest struct {
 func (x *GetDatabaseNameRequest) Reset() {
 	*x = GetDatabaseNameRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[25]
+		mi := &file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1563,7 +1813,7 @@ func (x *GetDatabaseNameRequest) String() string {
 func (*GetDatabaseNameRequest) ProtoMessage() {}
 
 func (x *GetDatabaseNameRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[25]
+	mi := &file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1576,7 +1826,7 @@ func (x *GetDatabaseNameRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetDatabaseNameRequest.ProtoReflect.Descriptor instead.
 func (*GetDatabaseNameRequest) Descriptor() ([]byte, []int) {
-	return file_oracle_pkg_agents_oracle_dbdaemon_proto_rawDescGZIP(), []int{25}
+	return file_oracle_pkg_agents_oracle_dbdaemon_proto_rawDescGZIP(), []int{29}
 }
 
 type GetDatabaseNameResponse struct {
@@ -1590,7 +1840,7 @@ type GetDatabaseNameResponse struct {
 func (x *GetDatabaseNameResponse) Reset() {
 	*x = GetDatabaseNameResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[26]
+		mi := &file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1603,7 +1853,7 @@ func (x *GetDatabaseNameResponse) String() string {
 func (*GetDatabaseNameResponse) ProtoMessage() {}
 
 func (x *GetDatabaseNameResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[26]
+	mi := &file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1616,7 +1866,7 @@ func (x *GetDatabaseNameResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetDatabaseNameResponse.ProtoReflect.Descriptor instead.
 func (*GetDatabaseNameResponse) Descriptor() ([]byte, []int) {
-	return file_oracle_pkg_agents_oracle_dbdaemon_proto_rawDescGZIP(), []int{26}
+	return file_oracle_pkg_agents_oracle_dbdaemon_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *GetDatabaseNameResponse) GetDatabaseName() string {
@@ -1642,7 +1892,7 @@ type SetListenerRegistrationRequest struct {
 func (x *SetListenerRegistrationRequest) Reset() {
 	*x = SetListenerRegistrationRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[27]
+		mi := &file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1655,7 +1905,7 @@ func (x *SetListenerRegistrationRequest) String() string {
 func (*SetListenerRegistrationRequest) ProtoMessage() {}
 
 func (x *SetListenerRegistrationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[27]
+	mi := &file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1668,7 +1918,7 @@ func (x *SetListenerRegistrationRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetListenerRegistrationRequest.ProtoReflect.Descriptor instead.
 func (*SetListenerRegistrationRequest) Descriptor() ([]byte, []int) {
-	return file_oracle_pkg_agents_oracle_dbdaemon_proto_rawDescGZIP(), []int{27}
+	return file_oracle_pkg_agents_oracle_dbdaemon_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *SetListenerRegistrationRequest) GetGlobalDatabaseName() string {
@@ -1696,7 +1946,7 @@ type BootstrapStandbyRequest struct {
 func (x *BootstrapStandbyRequest) Reset() {
 	*x = BootstrapStandbyRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[28]
+		mi := &file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1709,7 +1959,7 @@ func (x *BootstrapStandbyRequest) String() string {
 func (*BootstrapStandbyRequest) ProtoMessage() {}
 
 func (x *BootstrapStandbyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[28]
+	mi := &file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1722,7 +1972,7 @@ func (x *BootstrapStandbyRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BootstrapStandbyRequest.ProtoReflect.Descriptor instead.
 func (*BootstrapStandbyRequest) Descriptor() ([]byte, []int) {
-	return file_oracle_pkg_agents_oracle_dbdaemon_proto_rawDescGZIP(), []int{28}
+	return file_oracle_pkg_agents_oracle_dbdaemon_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *BootstrapStandbyRequest) GetCdbName() string {
@@ -1741,7 +1991,7 @@ type BootstrapStandbyResponse struct {
 func (x *BootstrapStandbyResponse) Reset() {
 	*x = BootstrapStandbyResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[29]
+		mi := &file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1754,7 +2004,7 @@ func (x *BootstrapStandbyResponse) String() string {
 func (*BootstrapStandbyResponse) ProtoMessage() {}
 
 func (x *BootstrapStandbyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[29]
+	mi := &file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1767,7 +2017,7 @@ func (x *BootstrapStandbyResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BootstrapStandbyResponse.ProtoReflect.Descriptor instead.
 func (*BootstrapStandbyResponse) Descriptor() ([]byte, []int) {
-	return file_oracle_pkg_agents_oracle_dbdaemon_proto_rawDescGZIP(), []int{29}
+	return file_oracle_pkg_agents_oracle_dbdaemon_proto_rawDescGZIP(), []int{33}
 }
 
 type CreateCDBRequest struct {
@@ -1788,7 +2038,7 @@ type CreateCDBRequest struct {
 func (x *CreateCDBRequest) Reset() {
 	*x = CreateCDBRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[30]
+		mi := &file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1801,7 +2051,7 @@ func (x *CreateCDBRequest) String() string {
 func (*CreateCDBRequest) ProtoMessage() {}
 
 func (x *CreateCDBRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[30]
+	mi := &file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1814,7 +2064,7 @@ func (x *CreateCDBRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateCDBRequest.ProtoReflect.Descriptor instead.
 func (*CreateCDBRequest) Descriptor() ([]byte, []int) {
-	return file_oracle_pkg_agents_oracle_dbdaemon_proto_rawDescGZIP(), []int{30}
+	return file_oracle_pkg_agents_oracle_dbdaemon_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *CreateCDBRequest) GetOracleHome() string {
@@ -1885,7 +2135,7 @@ type CreateCDBAsyncRequest struct {
 func (x *CreateCDBAsyncRequest) Reset() {
 	*x = CreateCDBAsyncRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[31]
+		mi := &file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1898,7 +2148,7 @@ func (x *CreateCDBAsyncRequest) String() string {
 func (*CreateCDBAsyncRequest) ProtoMessage() {}
 
 func (x *CreateCDBAsyncRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[31]
+	mi := &file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1911,7 +2161,7 @@ func (x *CreateCDBAsyncRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateCDBAsyncRequest.ProtoReflect.Descriptor instead.
 func (*CreateCDBAsyncRequest) Descriptor() ([]byte, []int) {
-	return file_oracle_pkg_agents_oracle_dbdaemon_proto_rawDescGZIP(), []int{31}
+	return file_oracle_pkg_agents_oracle_dbdaemon_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *CreateCDBAsyncRequest) GetSyncRequest() *CreateCDBRequest {
@@ -1937,7 +2187,7 @@ type CreateCDBResponse struct {
 func (x *CreateCDBResponse) Reset() {
 	*x = CreateCDBResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[32]
+		mi := &file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1950,7 +2200,7 @@ func (x *CreateCDBResponse) String() string {
 func (*CreateCDBResponse) ProtoMessage() {}
 
 func (x *CreateCDBResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[32]
+	mi := &file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1963,7 +2213,7 @@ func (x *CreateCDBResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateCDBResponse.ProtoReflect.Descriptor instead.
 func (*CreateCDBResponse) Descriptor() ([]byte, []int) {
-	return file_oracle_pkg_agents_oracle_dbdaemon_proto_rawDescGZIP(), []int{32}
+	return file_oracle_pkg_agents_oracle_dbdaemon_proto_rawDescGZIP(), []int{36}
 }
 
 type CreateListenerRequest struct {
@@ -1981,7 +2231,7 @@ type CreateListenerRequest struct {
 func (x *CreateListenerRequest) Reset() {
 	*x = CreateListenerRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[33]
+		mi := &file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1994,7 +2244,7 @@ func (x *CreateListenerRequest) String() string {
 func (*CreateListenerRequest) ProtoMessage() {}
 
 func (x *CreateListenerRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[33]
+	mi := &file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2007,7 +2257,7 @@ func (x *CreateListenerRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateListenerRequest.ProtoReflect.Descriptor instead.
 func (*CreateListenerRequest) Descriptor() ([]byte, []int) {
-	return file_oracle_pkg_agents_oracle_dbdaemon_proto_rawDescGZIP(), []int{33}
+	return file_oracle_pkg_agents_oracle_dbdaemon_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *CreateListenerRequest) GetDatabaseName() string {
@@ -2054,7 +2304,7 @@ type CreateListenerResponse struct {
 func (x *CreateListenerResponse) Reset() {
 	*x = CreateListenerResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[34]
+		mi := &file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2067,7 +2317,7 @@ func (x *CreateListenerResponse) String() string {
 func (*CreateListenerResponse) ProtoMessage() {}
 
 func (x *CreateListenerResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[34]
+	mi := &file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2080,7 +2330,7 @@ func (x *CreateListenerResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateListenerResponse.ProtoReflect.Descriptor instead.
 func (*CreateListenerResponse) Descriptor() ([]byte, []int) {
-	return file_oracle_pkg_agents_oracle_dbdaemon_proto_rawDescGZIP(), []int{34}
+	return file_oracle_pkg_agents_oracle_dbdaemon_proto_rawDescGZIP(), []int{38}
 }
 
 type FileExistsRequest struct {
@@ -2094,7 +2344,7 @@ type FileExistsRequest struct {
 func (x *FileExistsRequest) Reset() {
 	*x = FileExistsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[35]
+		mi := &file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2107,7 +2357,7 @@ func (x *FileExistsRequest) String() string {
 func (*FileExistsRequest) ProtoMessage() {}
 
 func (x *FileExistsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[35]
+	mi := &file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2120,7 +2370,7 @@ func (x *FileExistsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FileExistsRequest.ProtoReflect.Descriptor instead.
 func (*FileExistsRequest) Descriptor() ([]byte, []int) {
-	return file_oracle_pkg_agents_oracle_dbdaemon_proto_rawDescGZIP(), []int{35}
+	return file_oracle_pkg_agents_oracle_dbdaemon_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *FileExistsRequest) GetName() string {
@@ -2141,7 +2391,7 @@ type FileExistsResponse struct {
 func (x *FileExistsResponse) Reset() {
 	*x = FileExistsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[36]
+		mi := &file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2154,7 +2404,7 @@ func (x *FileExistsResponse) String() string {
 func (*FileExistsResponse) ProtoMessage() {}
 
 func (x *FileExistsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[36]
+	mi := &file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2167,7 +2417,7 @@ func (x *FileExistsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FileExistsResponse.ProtoReflect.Descriptor instead.
 func (*FileExistsResponse) Descriptor() ([]byte, []int) {
-	return file_oracle_pkg_agents_oracle_dbdaemon_proto_rawDescGZIP(), []int{36}
+	return file_oracle_pkg_agents_oracle_dbdaemon_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *FileExistsResponse) GetExists() bool {
@@ -2184,13 +2434,35 @@ type PhysicalRestoreRequest struct {
 
 	RestoreStatement          string `protobuf:"bytes,1,opt,name=restore_statement,json=restoreStatement,proto3" json:"restore_statement,omitempty"`
 	LatestRecoverableScnQuery string `protobuf:"bytes,2,opt,name=latest_recoverable_scn_query,json=latestRecoverableScnQuery,proto3" json:"latest_recoverable_scn_query,omitempty"`
-	RecoverStatementTemplate  string `protobuf:"bytes,3,opt,name=recover_statement_template,json=recoverStatementTemplate,proto3" json:"recover_statement_template,omitempty"`
+	// recover_statement_template is applied to the latest recoverable SCN.
+	// For a point-in-time recovery it already carries the until clause and
+	// is run as is. Without a latest_recoverable_scn_query it is run as is
+	// for a complete recovery.
+	RecoverStatementTemplate string `protobuf:"bytes,3,opt,name=recover_statement_template,json=recoverStatementTemplate,proto3" json:"recover_statement_template,omitempty"`
+	// recoverable_range_query returns MIN_SCN, MAX_SCN, MIN_TIME and MAX_TIME
+	// (in UTC) a backup can be recovered to and, for a restore point target,
+	// TARGET_SCN. It runs before restore_statement, the target is validated
+	// before any datafile is restored.
+	RecoverableRangeQuery string `protobuf:"bytes,4,opt,name=recoverable_range_query,json=recoverableRangeQuery,proto3" json:"recoverable_range_query,omitempty"`
+	// Optional point-in-time recovery target, at most one can be set.
+	UntilTime         *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=until_time,json=untilTime,proto3" json:"until_time,omitempty"`
+	UntilScn          int64                  `protobuf:"varint,6,opt,name=until_scn,json=untilScn,proto3" json:"until_scn,omitempty"`
+	UntilRestorePoint string                 `protobuf:"bytes,7,opt,name=until_restore_point,json=untilRestorePoint,proto3" json:"until_restore_point,omitempty"`
+	// Optional mount_statement runs before recoverable_range_query, e.g. to
+	// mount the control file of the backup which records its backup sets and
+	// archived redo logs.
+	MountStatement string `protobuf:"bytes,8,opt,name=mount_statement,json=mountStatement,proto3" json:"mount_statement,omitempty"`
+	// Optional glob patterns of the datafiles of the database under the data
+	// directory. They are deleted once the recovery target is validated, right
+	// before restore_statement, so that no file missing from the backup is
+	// left behind.
+	DatafilePatterns []string `protobuf:"bytes,9,rep,name=datafile_patterns,json=datafilePatterns,proto3" json:"datafile_patterns,omitempty"`
 }
 
 func (x *PhysicalRestoreRequest) Reset() {
 	*x = PhysicalRestoreRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[37]
+		mi := &file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2203,7 +2475,7 @@ func (x *PhysicalRestoreRequest) String() string {
 func (*PhysicalRestoreRequest) ProtoMessage() {}
 
 func (x *PhysicalRestoreRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[37]
+	mi := &file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2216,7 +2488,7 @@ func (x *PhysicalRestoreRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PhysicalRestoreRequest.ProtoReflect.Descriptor instead.
 func (*PhysicalRestoreRequest) Descriptor() ([]byte, []int) {
-	return file_oracle_pkg_agents_oracle_dbdaemon_proto_rawDescGZIP(), []int{37}
+	return file_oracle_pkg_agents_oracle_dbdaemon_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *PhysicalRestoreRequest) GetRestoreStatement() string {
@@ -2240,6 +2512,48 @@ func (x *PhysicalRestoreRequest) GetRecoverStatementTemplate() string {
 	return ""
 }
 
+func (x *PhysicalRestoreRequest) GetRecoverableRangeQuery() string {
+	if x != nil {
+		return x.RecoverableRangeQuery
+	}
+	return ""
+}
+
+func (x *PhysicalRestoreRequest) GetUntilTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UntilTime
+	}
+	return nil
+}
+
+func (x *PhysicalRestoreRequest) GetUntilScn() int64 {
+	if x != nil {
+		return x.UntilScn
+	}
+	return 0
+}
+
+func (x *PhysicalRestoreRequest) GetUntilRestorePoint() string {
+	if x != nil {
+		return x.UntilRestorePoint
+	}
+	return ""
+}
+
+func (x *PhysicalRestoreRequest) GetMountStatement() string {
+	if x != nil {
+		return x.MountStatement
+	}
+	return ""
+}
+
+func (x *PhysicalRestoreRequest) GetDatafilePatterns() []string {
+	if x != nil {
+		return x.DatafilePatterns
+	}
+	return nil
+}
+
 type PhysicalRestoreAsyncRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -2252,7 +2566,7 @@ type PhysicalRestoreAsyncRequest struct {
 func (x *PhysicalRestoreAsyncRequest) Reset() {
 	*x = PhysicalRestoreAsyncRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[38]
+		mi := &file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2265,7 +2579,7 @@ func (x *PhysicalRestoreAsyncRequest) String() string {
 func (*PhysicalRestoreAsyncRequest) ProtoMessage() {}
 
 func (x *PhysicalRestoreAsyncRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[38]
+	mi := &file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2278,7 +2592,7 @@ func (x *PhysicalRestoreAsyncRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PhysicalRestoreAsyncRequest.ProtoReflect.Descriptor instead.
 func (*PhysicalRestoreAsyncRequest) Descriptor() ([]byte, []int) {
-	return file_oracle_pkg_agents_oracle_dbdaemon_proto_rawDescGZIP(), []int{38}
+	return file_oracle_pkg_agents_oracle_dbdaemon_proto_rawDescGZIP(), []int{42}
 }
 
 func (x *PhysicalRestoreAsyncRequest) GetSyncRequest() *PhysicalRestoreRequest {
@@ -2307,12 +2621,15 @@ type DataPumpImportRequest struct {
 	GcsPath string `protobuf:"bytes,4,opt,name=gcs_path,json=gcsPath,proto3" json:"gcs_path,omitempty"`
 	// GCS path to output log file
 	GcsLogPath string `protobuf:"bytes,5,opt,name=gcs_log_path,json=gcsLogPath,proto3" json:"gcs_log_path,omitempty"`
+	// storage_credentials authenticate access to the storage location,
+	// see the storage package for the supported keys.
+	StorageCredentials map[string]string `protobuf:"bytes,6,rep,name=storage_credentials,json=storageCredentials,proto3" json:"storage_credentials,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
 func (x *DataPumpImportRequest) Reset() {
 	*x = DataPumpImportRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[39]
+		mi := &file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2325,7 +2642,7 @@ func (x *DataPumpImportRequest) String() string {
 func (*DataPumpImportRequest) ProtoMessage() {}
 
 func (x *DataPumpImportRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[39]
+	mi := &file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2338,7 +2655,7 @@ func (x *DataPumpImportRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DataPumpImportRequest.ProtoReflect.Descriptor instead.
 func (*DataPumpImportRequest) Descriptor() ([]byte, []int) {
-	return file_oracle_pkg_agents_oracle_dbdaemon_proto_rawDescGZIP(), []int{39}
+	return file_oracle_pkg_agents_oracle_dbdaemon_proto_rawDescGZIP(), []int{43}
 }
 
 func (x *DataPumpImportRequest) GetPdbName() string {
@@ -2376,6 +2693,13 @@ func (x *DataPumpImportRequest) GetGcsLogPath() string {
 	return ""
 }
 
+func (x *DataPumpImportRequest) GetStorageCredentials() map[string]string {
+	if x != nil {
+		return x.StorageCredentials
+	}
+	return nil
+}
+
 type DataPumpImportAsyncRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -2388,7 +2712,7 @@ type DataPumpImportAsyncRequest struct {
 func (x *DataPumpImportAsyncRequest) Reset() {
 	*x = DataPumpImportAsyncRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[40]
+		mi := &file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2401,7 +2725,7 @@ func (x *DataPumpImportAsyncRequest) String() string {
 func (*DataPumpImportAsyncRequest) ProtoMessage() {}
 
 func (x *DataPumpImportAsyncRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[40]
+	mi := &file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2414,7 +2738,7 @@ func (x *DataPumpImportAsyncRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DataPumpImportAsyncRequest.ProtoReflect.Descriptor instead.
 func (*DataPumpImportAsyncRequest) Descriptor() ([]byte, []int) {
-	return file_oracle_pkg_agents_oracle_dbdaemon_proto_rawDescGZIP(), []int{40}
+	return file_oracle_pkg_agents_oracle_dbdaemon_proto_rawDescGZIP(), []int{44}
 }
 
 func (x *DataPumpImportAsyncRequest) GetSyncRequest() *DataPumpImportRequest {
@@ -2440,7 +2764,7 @@ type DataPumpImportResponse struct {
 func (x *DataPumpImportResponse) Reset() {
 	*x = DataPumpImportResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[41]
+		mi := &file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2453,7 +2777,7 @@ func (x *DataPumpImportResponse) String() string {
 func (*DataPumpImportResponse) ProtoMessage() {}
 
 func (x *DataPumpImportResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[41]
+	mi := &file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2466,7 +2790,7 @@ func (x *DataPumpImportResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DataPumpImportResponse.ProtoReflect.Descriptor instead.
 func (*DataPumpImportResponse) Descriptor() ([]byte, []int) {
-	return file_oracle_pkg_agents_oracle_dbdaemon_proto_rawDescGZIP(), []int{41}
+	return file_oracle_pkg_agents_oracle_dbdaemon_proto_rawDescGZIP(), []int{45}
 }
 
 type DataPumpExportRequest struct {
@@ -2482,12 +2806,15 @@ type DataPumpExportRequest struct {
 	GcsPath       string   `protobuf:"bytes,6,opt,name=gcs_path,json=gcsPath,proto3" json:"gcs_path,omitempty"`
 	GcsLogPath    string   `protobuf:"bytes,7,opt,name=gcs_log_path,json=gcsLogPath,proto3" json:"gcs_log_path,omitempty"`
 	FlashbackTime string   `protobuf:"bytes,8,opt,name=flashback_time,json=flashbackTime,proto3" json:"flashback_time,omitempty"`
+	// storage_credentials authenticate access to the storage location,
+	// see the storage package for the supported keys.
+	StorageCredentials map[string]string `protobuf:"bytes,9,rep,name=storage_credentials,json=storageCredentials,proto3" json:"storage_credentials,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
 func (x *DataPumpExportRequest) Reset() {
 	*x = DataPumpExportRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[42]
+		mi := &file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2500,7 +2827,7 @@ func (x *DataPumpExportRequest) String() string {
 func (*DataPumpExportRequest) ProtoMessage() {}
 
 func (x *DataPumpExportRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[42]
+	mi := &file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2513,7 +2840,7 @@ func (x *DataPumpExportRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DataPumpExportRequest.ProtoReflect.Descriptor instead.
 func (*DataPumpExportRequest) Descriptor() ([]byte, []int) {
-	return file_oracle_pkg_agents_oracle_dbdaemon_proto_rawDescGZIP(), []int{42}
+	return file_oracle_pkg_agents_oracle_dbdaemon_proto_rawDescGZIP(), []int{46}
 }
 
 func (x *DataPumpExportRequest) GetPdbName() string {
@@ -2572,6 +2899,13 @@ func (x *DataPumpExportRequest) GetFlashbackTime() string {
 	return ""
 }
 
+func (x *DataPumpExportRequest) GetStorageCredentials() map[string]string {
+	if x != nil {
+		return x.StorageCredentials
+	}
+	return nil
+}
+
 type DataPumpExportAsyncRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -2584,7 +2918,7 @@ type DataPumpExportAsyncRequest struct {
 func (x *DataPumpExportAsyncRequest) Reset() {
 	*x = DataPumpExportAsyncRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[43]
+		mi := &file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2597,7 +2931,7 @@ func (x *DataPumpExportAsyncRequest) String() string {
 func (*DataPumpExportAsyncRequest) ProtoMessage() {}
 
 func (x *DataPumpExportAsyncRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[43]
+	mi := &file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2610,7 +2944,7 @@ func (x *DataPumpExportAsyncRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DataPumpExportAsyncRequest.ProtoReflect.Descriptor instead.
 func (*DataPumpExportAsyncRequest) Descriptor() ([]byte, []int) {
-	return file_oracle_pkg_agents_oracle_dbdaemon_proto_rawDescGZIP(), []int{43}
+	return file_oracle_pkg_agents_oracle_dbdaemon_proto_rawDescGZIP(), []int{47}
 }
 
 func (x *DataPumpExportAsyncRequest) GetSyncRequest() *DataPumpExportRequest {
@@ -2636,7 +2970,7 @@ type DataPumpExportResponse struct {
 func (x *DataPumpExportResponse) Reset() {
 	*x = DataPumpExportResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[44]
+		mi := &file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2649,7 +2983,7 @@ func (x *DataPumpExportResponse) String() string {
 func (*DataPumpExportResponse) ProtoMessage() {}
 
 func (x *DataPumpExportResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[44]
+	mi := &file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2662,7 +2996,7 @@ func (x *DataPumpExportResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DataPumpExportResponse.ProtoReflect.Descriptor instead.
 func (*DataPumpExportResponse) Descriptor() ([]byte, []int) {
-	return file_oracle_pkg_agents_oracle_dbdaemon_proto_rawDescGZIP(), []int{44}
+	return file_oracle_pkg_agents_oracle_dbdaemon_proto_rawDescGZIP(), []int{48}
 }
 
 type RecoverConfigFileRequest struct {
@@ -2676,7 +3010,7 @@ type RecoverConfigFileRequest struct {
 func (x *RecoverConfigFileRequest) Reset() {
 	*x = RecoverConfigFileRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[45]
+		mi := &file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[49]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2689,7 +3023,7 @@ func (x *RecoverConfigFileRequest) String() string {
 func (*RecoverConfigFileRequest) ProtoMessage() {}
 
 func (x *RecoverConfigFileRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[45]
+	mi := &file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[49]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2702,7 +3036,7 @@ func (x *RecoverConfigFileRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RecoverConfigFileRequest.ProtoReflect.Descriptor instead.
 func (*RecoverConfigFileRequest) Descriptor() ([]byte, []int) {
-	return file_oracle_pkg_agents_oracle_dbdaemon_proto_rawDescGZIP(), []int{45}
+	return file_oracle_pkg_agents_oracle_dbdaemon_proto_rawDescGZIP(), []int{49}
 }
 
 func (x *RecoverConfigFileRequest) GetCdbName() string {
@@ -2721,7 +3055,7 @@ type RecoverConfigFileResponse struct {
 func (x *RecoverConfigFileResponse) Reset() {
 	*x = RecoverConfigFileResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[46]
+		mi := &file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[50]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2734,7 +3068,7 @@ func (x *RecoverConfigFileResponse) String() string {
 func (*RecoverConfigFileResponse) ProtoMessage() {}
 
 func (x *RecoverConfigFileResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[46]
+	mi := &file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[50]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2747,7 +3081,7 @@ func (x *RecoverConfigFileResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RecoverConfigFileResponse.ProtoReflect.Descriptor instead.
 func (*RecoverConfigFileResponse) Descriptor() ([]byte, []int) {
-	return file_oracle_pkg_agents_oracle_dbdaemon_proto_rawDescGZIP(), []int{46}
+	return file_oracle_pkg_agents_oracle_dbdaemon_proto_rawDescGZIP(), []int{50}
 }
 
 type DownloadDirectoryFromGCSRequest struct {
@@ -2757,12 +3091,15 @@ type DownloadDirectoryFromGCSRequest struct {
 
 	GcsPath   string `protobuf:"bytes,1,opt,name=gcs_path,json=gcsPath,proto3" json:"gcs_path,omitempty"`
 	LocalPath string `protobuf:"bytes,2,opt,name=local_path,json=localPath,proto3" json:"local_path,omitempty"`
+	// storage_credentials authenticate access to the storage location,
+	// see the storage package for the supported keys.
+	StorageCredentials map[string]string `protobuf:"bytes,3,rep,name=storage_credentials,json=storageCredentials,proto3" json:"storage_credentials,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
 func (x *DownloadDirectoryFromGCSRequest) Reset() {
 	*x = DownloadDirectoryFromGCSRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[47]
+		mi := &file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[51]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2775,7 +3112,7 @@ func (x *DownloadDirectoryFromGCSRequest) String() string {
 func (*DownloadDirectoryFromGCSRequest) ProtoMessage() {}
 
 func (x *DownloadDirectoryFromGCSRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[47]
+	mi := &file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[51]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2788,7 +3125,7 @@ func (x *DownloadDirectoryFromGCSRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DownloadDirectoryFromGCSRequest.ProtoReflect.Descriptor instead.
 func (*DownloadDirectoryFromGCSRequest) Descriptor() ([]byte, []int) {
-	return file_oracle_pkg_agents_oracle_dbdaemon_proto_rawDescGZIP(), []int{47}
+	return file_oracle_pkg_agents_oracle_dbdaemon_proto_rawDescGZIP(), []int{51}
 }
 
 func (x *DownloadDirectoryFromGCSRequest) GetGcsPath() string {
@@ -2805,6 +3142,13 @@ func (x *DownloadDirectoryFromGCSRequest) GetLocalPath() string {
 	return ""
 }
 
+func (x *DownloadDirectoryFromGCSRequest) GetStorageCredentials() map[string]string {
+	if x != nil {
+		return x.StorageCredentials
+	}
+	return nil
+}
+
 type DownloadDirectoryFromGCSResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -2814,7 +3158,7 @@ type DownloadDirectoryFromGCSResponse struct {
 func (x *DownloadDirectoryFromGCSResponse) Reset() {
 	*x = DownloadDirectoryFromGCSResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[48]
+		mi := &file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[52]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2827,7 +3171,7 @@ func (x *DownloadDirectoryFromGCSResponse) String() string {
 func (*DownloadDirectoryFromGCSResponse) ProtoMessage() {}
 
 func (x *DownloadDirectoryFromGCSResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[48]
+	mi := &file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[52]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2840,7 +3184,7 @@ func (x *DownloadDirectoryFromGCSResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DownloadDirectoryFromGCSResponse.ProtoReflect.Descriptor instead.
 func (*DownloadDirectoryFromGCSResponse) Descriptor() ([]byte, []int) {
-	return file_oracle_pkg_agents_oracle_dbdaemon_proto_rawDescGZIP(), []int{48}
+	return file_oracle_pkg_agents_oracle_dbdaemon_proto_rawDescGZIP(), []int{52}
 }
 
 type FetchServiceImageMetaDataRequest struct {
@@ -2849,23 +3193,539 @@ type FetchServiceImageMetaDataRequest struct {
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *FetchServiceImageMetaDataRequest) Reset() {
-	*x = FetchServiceImageMetaDataRequest{}
+func (x *FetchServiceImageMetaDataRequest) Reset() {
+	*x = FetchServiceImageMetaDataRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FetchServiceImageMetaDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FetchServiceImageMetaDataRequest) ProtoMessage() {}
+
+func (x *FetchServiceImageMetaDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FetchServiceImageMetaDataRequest.ProtoReflect.Descriptor instead.
+func (*FetchServiceImageMetaDataRequest) Descriptor() ([]byte, []int) {
+	return file_oracle_pkg_agents_oracle_dbdaemon_proto_rawDescGZIP(), []int{53}
+}
+
+type FetchServiceImageMetaDataResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Version    string `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	CdbName    string `protobuf:"bytes,2,opt,name=cdb_name,json=cdbName,proto3" json:"cdb_name,omitempty"`
+	OracleHome string `protobuf:"bytes,3,opt,name=oracle_home,json=oracleHome,proto3" json:"oracle_home,omitempty"`
+}
+
+func (x *FetchServiceImageMetaDataResponse) Reset() {
+	*x = FetchServiceImageMetaDataResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FetchServiceImageMetaDataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FetchServiceImageMetaDataResponse) ProtoMessage() {}
+
+func (x *FetchServiceImageMetaDataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FetchServiceImageMetaDataResponse.ProtoReflect.Descriptor instead.
+func (*FetchServiceImageMetaDataResponse) Descriptor() ([]byte, []int) {
+	return file_oracle_pkg_agents_oracle_dbdaemon_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *FetchServiceImageMetaDataResponse) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *FetchServiceImageMetaDataResponse) GetCdbName() string {
+	if x != nil {
+		return x.CdbName
+	}
+	return ""
+}
+
+func (x *FetchServiceImageMetaDataResponse) GetOracleHome() string {
+	if x != nil {
+		return x.OracleHome
+	}
+	return ""
+}
+
+type ShipArchivedLogsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GcsPath string `protobuf:"bytes,1,opt,name=gcs_path,json=gcsPath,proto3" json:"gcs_path,omitempty"`
+	// incarnation is the resetlogs id of the last shipped log. If it differs
+	// from the current database incarnation, after_sequence is ignored and
+	// all archived logs of the current incarnation are shipped.
+	Incarnation   int64 `protobuf:"varint,2,opt,name=incarnation,proto3" json:"incarnation,omitempty"`
+	AfterSequence int64 `protobuf:"varint,3,opt,name=after_sequence,json=afterSequence,proto3" json:"after_sequence,omitempty"`
+	// storage_credentials authenticate access to the storage location,
+	// see the storage package for the supported keys.
+	StorageCredentials map[string]string `protobuf:"bytes,4,rep,name=storage_credentials,json=storageCredentials,proto3" json:"storage_credentials,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *ShipArchivedLogsRequest) Reset() {
+	*x = ShipArchivedLogsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ShipArchivedLogsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShipArchivedLogsRequest) ProtoMessage() {}
+
+func (x *ShipArchivedLogsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShipArchivedLogsRequest.ProtoReflect.Descriptor instead.
+func (*ShipArchivedLogsRequest) Descriptor() ([]byte, []int) {
+	return file_oracle_pkg_agents_oracle_dbdaemon_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *ShipArchivedLogsRequest) GetGcsPath() string {
+	if x != nil {
+		return x.GcsPath
+	}
+	return ""
+}
+
+func (x *ShipArchivedLogsRequest) GetIncarnation() int64 {
+	if x != nil {
+		return x.Incarnation
+	}
+	return 0
+}
+
+func (x *ShipArchivedLogsRequest) GetAfterSequence() int64 {
+	if x != nil {
+		return x.AfterSequence
+	}
+	return 0
+}
+
+func (x *ShipArchivedLogsRequest) GetStorageCredentials() map[string]string {
+	if x != nil {
+		return x.StorageCredentials
+	}
+	return nil
+}
+
+type ShipArchivedLogsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Incarnation int64 `protobuf:"varint,1,opt,name=incarnation,proto3" json:"incarnation,omitempty"`
+	// last_sequence, last_scn and last_time describe the last shipped log,
+	// they are left unchanged if there was nothing to ship.
+	LastSequence int64 `protobuf:"varint,2,opt,name=last_sequence,json=lastSequence,proto3" json:"last_sequence,omitempty"`
+	// last_scn is the next_change# of the last shipped log, the highest SCN
+	// a database can be recovered to from the shipped logs.
+	LastScn      int64                  `protobuf:"varint,3,opt,name=last_scn,json=lastScn,proto3" json:"last_scn,omitempty"`
+	LastTime     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=last_time,json=lastTime,proto3" json:"last_time,omitempty"`
+	ShippedCount int32                  `protobuf:"varint,5,opt,name=shipped_count,json=shippedCount,proto3" json:"shipped_count,omitempty"`
+	// error_message is set if a log failed to upload, the fields above then
+	// describe the logs shipped before it.
+	ErrorMessage string `protobuf:"bytes,6,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+}
+
+func (x *ShipArchivedLogsResponse) Reset() {
+	*x = ShipArchivedLogsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ShipArchivedLogsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShipArchivedLogsResponse) ProtoMessage() {}
+
+func (x *ShipArchivedLogsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShipArchivedLogsResponse.ProtoReflect.Descriptor instead.
+func (*ShipArchivedLogsResponse) Descriptor() ([]byte, []int) {
+	return file_oracle_pkg_agents_oracle_dbdaemon_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *ShipArchivedLogsResponse) GetIncarnation() int64 {
+	if x != nil {
+		return x.Incarnation
+	}
+	return 0
+}
+
+func (x *ShipArchivedLogsResponse) GetLastSequence() int64 {
+	if x != nil {
+		return x.LastSequence
+	}
+	return 0
+}
+
+func (x *ShipArchivedLogsResponse) GetLastScn() int64 {
+	if x != nil {
+		return x.LastScn
+	}
+	return 0
+}
+
+func (x *ShipArchivedLogsResponse) GetLastTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastTime
+	}
+	return nil
+}
+
+func (x *ShipArchivedLogsResponse) GetShippedCount() int32 {
+	if x != nil {
+		return x.ShippedCount
+	}
+	return 0
+}
+
+func (x *ShipArchivedLogsResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+type ShipArchivedLogsAsyncRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SyncRequest *ShipArchivedLogsRequest `protobuf:"bytes,1,opt,name=sync_request,json=syncRequest,proto3" json:"sync_request,omitempty"`
+	LroInput    *LROInput                `protobuf:"bytes,2,opt,name=lro_input,json=lroInput,proto3" json:"lro_input,omitempty"`
+}
+
+func (x *ShipArchivedLogsAsyncRequest) Reset() {
+	*x = ShipArchivedLogsAsyncRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ShipArchivedLogsAsyncRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShipArchivedLogsAsyncRequest) ProtoMessage() {}
+
+func (x *ShipArchivedLogsAsyncRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShipArchivedLogsAsyncRequest.ProtoReflect.Descriptor instead.
+func (*ShipArchivedLogsAsyncRequest) Descriptor() ([]byte, []int) {
+	return file_oracle_pkg_agents_oracle_dbdaemon_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *ShipArchivedLogsAsyncRequest) GetSyncRequest() *ShipArchivedLogsRequest {
+	if x != nil {
+		return x.SyncRequest
+	}
+	return nil
+}
+
+func (x *ShipArchivedLogsAsyncRequest) GetLroInput() *LROInput {
+	if x != nil {
+		return x.LroInput
+	}
+	return nil
+}
+
+type DeleteBackupRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// tag is the RMAN tag of the backup.
+	Tag string `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	// gcs_path is the storage location the backup was uploaded to, if any.
+	GcsPath string `protobuf:"bytes,2,opt,name=gcs_path,json=gcsPath,proto3" json:"gcs_path,omitempty"`
+	// storage_credentials authenticate access to the storage location, see the
+	// storage package for the supported keys.
+	StorageCredentials map[string]string `protobuf:"bytes,3,rep,name=storage_credentials,json=storageCredentials,proto3" json:"storage_credentials,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *DeleteBackupRequest) Reset() {
+	*x = DeleteBackupRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteBackupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteBackupRequest) ProtoMessage() {}
+
+func (x *DeleteBackupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteBackupRequest.ProtoReflect.Descriptor instead.
+func (*DeleteBackupRequest) Descriptor() ([]byte, []int) {
+	return file_oracle_pkg_agents_oracle_dbdaemon_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *DeleteBackupRequest) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *DeleteBackupRequest) GetGcsPath() string {
+	if x != nil {
+		return x.GcsPath
+	}
+	return ""
+}
+
+func (x *DeleteBackupRequest) GetStorageCredentials() map[string]string {
+	if x != nil {
+		return x.StorageCredentials
+	}
+	return nil
+}
+
+type DeleteBackupResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// deleted_count is the number of deleted backup pieces.
+	DeletedCount int32 `protobuf:"varint,1,opt,name=deleted_count,json=deletedCount,proto3" json:"deleted_count,omitempty"`
+}
+
+func (x *DeleteBackupResponse) Reset() {
+	*x = DeleteBackupResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteBackupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteBackupResponse) ProtoMessage() {}
+
+func (x *DeleteBackupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteBackupResponse.ProtoReflect.Descriptor instead.
+func (*DeleteBackupResponse) Descriptor() ([]byte, []int) {
+	return file_oracle_pkg_agents_oracle_dbdaemon_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *DeleteBackupResponse) GetDeletedCount() int32 {
+	if x != nil {
+		return x.DeletedCount
+	}
+	return 0
+}
+
+type RunDatapatchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RunDatapatchRequest) Reset() {
+	*x = RunDatapatchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RunDatapatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunDatapatchRequest) ProtoMessage() {}
+
+func (x *RunDatapatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RunDatapatchRequest.ProtoReflect.Descriptor instead.
+func (*RunDatapatchRequest) Descriptor() ([]byte, []int) {
+	return file_oracle_pkg_agents_oracle_dbdaemon_proto_rawDescGZIP(), []int{60}
+}
+
+type RunDatapatchResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RunDatapatchResponse) Reset() {
+	*x = RunDatapatchResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RunDatapatchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunDatapatchResponse) ProtoMessage() {}
+
+func (x *RunDatapatchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RunDatapatchResponse.ProtoReflect.Descriptor instead.
+func (*RunDatapatchResponse) Descriptor() ([]byte, []int) {
+	return file_oracle_pkg_agents_oracle_dbdaemon_proto_rawDescGZIP(), []int{61}
+}
+
+type GetAuditLogHeadRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetAuditLogHeadRequest) Reset() {
+	*x = GetAuditLogHeadRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[49]
+		mi := &file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[62]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *FetchServiceImageMetaDataRequest) String() string {
+func (x *GetAuditLogHeadRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FetchServiceImageMetaDataRequest) ProtoMessage() {}
+func (*GetAuditLogHeadRequest) ProtoMessage() {}
 
-func (x *FetchServiceImageMetaDataRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[49]
+func (x *GetAuditLogHeadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[62]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2876,38 +3736,39 @@ func (x *FetchServiceImageMetaDataRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FetchServiceImageMetaDataRequest.ProtoReflect.Descriptor instead.
-func (*FetchServiceImageMetaDataRequest) Descriptor() ([]byte, []int) {
-	return file_oracle_pkg_agents_oracle_dbdaemon_proto_rawDescGZIP(), []int{49}
+// Deprecated: Use GetAuditLogHeadRequest.ProtoReflect.Descriptor instead.
+func (*GetAuditLogHeadRequest) Descriptor() ([]byte, []int) {
+	return file_oracle_pkg_agents_oracle_dbdaemon_proto_rawDescGZIP(), []int{62}
 }
 
-type FetchServiceImageMetaDataResponse struct {
+type GetAuditLogHeadResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Version    string `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
-	CdbName    string `protobuf:"bytes,2,opt,name=cdb_name,json=cdbName,proto3" json:"cdb_name,omitempty"`
-	OracleHome string `protobuf:"bytes,3,opt,name=oracle_home,json=oracleHome,proto3" json:"oracle_home,omitempty"`
+	// seq is the sequence number of the last entry, 0 if the log is empty.
+	Seq int64 `protobuf:"varint,1,opt,name=seq,proto3" json:"seq,omitempty"`
+	// hash is the hash of the last entry.
+	Hash string `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
 }
 
-func (x *FetchServiceImageMetaDataResponse) Reset() {
-	*x = FetchServiceImageMetaDataResponse{}
+func (x *GetAuditLogHeadResponse) Reset() {
+	*x = GetAuditLogHeadResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[50]
+		mi := &file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[63]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *FetchServiceImageMetaDataResponse) String() string {
+func (x *GetAuditLogHeadResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FetchServiceImageMetaDataResponse) ProtoMessage() {}
+func (*GetAuditLogHeadResponse) ProtoMessage() {}
 
-func (x *FetchServiceImageMetaDataResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[50]
+func (x *GetAuditLogHeadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[63]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2918,28 +3779,21 @@ func (x *FetchServiceImageMetaDataResponse) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FetchServiceImageMetaDataResponse.ProtoReflect.Descriptor instead.
-func (*FetchServiceImageMetaDataResponse) Descriptor() ([]byte, []int) {
-	return file_oracle_pkg_agents_oracle_dbdaemon_proto_rawDescGZIP(), []int{50}
-}
-
-func (x *FetchServiceImageMetaDataResponse) GetVersion() string {
-	if x != nil {
-		return x.Version
-	}
-	return ""
+// Deprecated: Use GetAuditLogHeadResponse.ProtoReflect.Descriptor instead.
+func (*GetAuditLogHeadResponse) Descriptor() ([]byte, []int) {
+	return file_oracle_pkg_agents_oracle_dbdaemon_proto_rawDescGZIP(), []int{63}
 }
 
-func (x *FetchServiceImageMetaDataResponse) GetCdbName() string {
+func (x *GetAuditLogHeadResponse) GetSeq() int64 {
 	if x != nil {
-		return x.CdbName
+		return x.Seq
 	}
-	return ""
+	return 0
 }
 
-func (x *FetchServiceImageMetaDataResponse) GetOracleHome() string {
+func (x *GetAuditLogHeadResponse) GetHash() string {
 	if x != nil {
-		return x.OracleHome
+		return x.Hash
 	}
 	return ""
 }
@@ -2961,7 +3815,7 @@ type ReadDirResponse_FileInfo struct {
 func (x *ReadDirResponse_FileInfo) Reset() {
 	*x = ReadDirResponse_FileInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[51]
+		mi := &file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[64]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2974,7 +3828,7 @@ func (x *ReadDirResponse_FileInfo) String() string {
 func (*ReadDirResponse_FileInfo) ProtoMessage() {}
 
 func (x *ReadDirResponse_FileInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[51]
+	mi := &file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[64]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3160,7 +4014,7 @@ var file_oracle_pkg_agents_oracle_dbdaemon_proto_rawDesc = []byte{
 	0x6e, 0x6f, 0x77, 0x6e, 0x50, 0x44, 0x42, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
 	0x12, 0x1d, 0x0a, 0x0a, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x5f, 0x70, 0x64, 0x62, 0x73, 0x18, 0x01,
 	0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x50, 0x64, 0x62, 0x73, 0x22,
-	0xe5, 0x01, 0x0a, 0x0e, 0x52, 0x75, 0x6e, 0x52, 0x4d, 0x41, 0x4e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x94, 0x03, 0x0a, 0x0e, 0x52, 0x75, 0x6e, 0x52, 0x4d, 0x41, 0x4e, 0x52, 0x65, 0x71, 0x75, 0x65,
 	0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x73, 0x18, 0x01, 0x20,
 	0x03, 0x28, 0x09, 0x52, 0x07, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x73, 0x12, 0x1b, 0x0a, 0x09,
 	0x74, 0x6e, 0x73, 0x5f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
@@ -3174,365 +4028,549 @@ var file_oracle_pkg_agents_oracle_dbdaemon_proto_rawDesc = []byte{
 	0x63, 0x73, 0x50, 0x61, 0x74, 0x68, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f,
 	0x70, 0x61, 0x74, 0x68, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x6f, 0x63, 0x61,
 	0x6c, 0x50, 0x61, 0x74, 0x68, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x6d, 0x64, 0x18, 0x08, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x03, 0x63, 0x6d, 0x64, 0x22, 0x2d, 0x0a, 0x08, 0x4c, 0x52, 0x4f, 0x49, 0x6e,
-	0x70, 0x75, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x70, 0x65, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x8d, 0x01, 0x0a, 0x13, 0x52, 0x75, 0x6e, 0x52, 0x4d,
-	0x41, 0x4e, 0x41, 0x73, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x40,
-	0x0a, 0x0c, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x6f, 0x72,
+	0x28, 0x09, 0x52, 0x03, 0x63, 0x6d, 0x64, 0x12, 0x66, 0x0a, 0x13, 0x73, 0x74, 0x6f, 0x72, 0x61,
+	0x67, 0x65, 0x5f, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x18, 0x09,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x6f, 0x72,
 	0x61, 0x63, 0x6c, 0x65, 0x2e, 0x52, 0x75, 0x6e, 0x52, 0x4d, 0x41, 0x4e, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x52, 0x0b, 0x73, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x34, 0x0a, 0x09, 0x6c, 0x72, 0x6f, 0x5f, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x6f, 0x72, 0x61,
-	0x63, 0x6c, 0x65, 0x2e, 0x4c, 0x52, 0x4f, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x52, 0x08, 0x6c, 0x72,
-	0x6f, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x22, 0x29, 0x0a, 0x0f, 0x52, 0x75, 0x6e, 0x52, 0x4d, 0x41,
-	0x4e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x75, 0x74,
-	0x70, 0x75, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75,
-	0x74, 0x22, 0x43, 0x0a, 0x0a, 0x4e, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x10, 0x0a, 0x03, 0x73, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x69,
-	0x64, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x6e, 0x61,
+	0x65, 0x73, 0x74, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x43, 0x72, 0x65, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x12, 0x73, 0x74, 0x6f,
+	0x72, 0x61, 0x67, 0x65, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x1a,
+	0x45, 0x0a, 0x17, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x61, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x2d, 0x0a, 0x08, 0x4c, 0x52, 0x4f, 0x49, 0x6e, 0x70,
+	0x75, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0xed, 0x01, 0x0a, 0x11, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x70,
+	0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x43, 0x6f,
+	0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x56, 0x0a, 0x19, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x17, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x64,
+	0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x3b, 0x0a, 0x0b, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x8d, 0x01, 0x0a, 0x13, 0x52, 0x75, 0x6e, 0x52, 0x4d, 0x41,
+	0x4e, 0x41, 0x73, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x40, 0x0a,
+	0x0c, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x6f, 0x72, 0x61,
+	0x63, 0x6c, 0x65, 0x2e, 0x52, 0x75, 0x6e, 0x52, 0x4d, 0x41, 0x4e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x52, 0x0b, 0x73, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x34, 0x0a, 0x09, 0x6c, 0x72, 0x6f, 0x5f, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x6f, 0x72, 0x61, 0x63,
+	0x6c, 0x65, 0x2e, 0x4c, 0x52, 0x4f, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x52, 0x08, 0x6c, 0x72, 0x6f,
+	0x49, 0x6e, 0x70, 0x75, 0x74, 0x22, 0x29, 0x0a, 0x0f, 0x52, 0x75, 0x6e, 0x52, 0x4d, 0x41, 0x4e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x75, 0x74, 0x70,
+	0x75, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74,
+	0x22, 0x43, 0x0a, 0x0a, 0x4e, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10,
+	0x0a, 0x03, 0x73, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x69, 0x64,
+	0x12, 0x23, 0x0a, 0x0d, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73,
+	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x0d, 0x0a, 0x0b, 0x4e, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x52, 0x0a, 0x1d, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x44, 0x61,
+	0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x64, 0x62, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x64, 0x62, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x06, 0x72, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x34, 0x0a, 0x1e, 0x43, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x62,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x64, 0x62, 0x69, 0x64, 0x22, 0xab,
+	0x01, 0x0a, 0x22, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73,
+	0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x41, 0x73, 0x79, 0x6e, 0x63, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4f, 0x0a, 0x0c, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x61, 0x67,
+	0x65, 0x6e, 0x74, 0x73, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x43, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0b, 0x73, 0x79, 0x6e, 0x63, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x34, 0x0a, 0x09, 0x6c, 0x72, 0x6f, 0x5f, 0x69, 0x6e,
+	0x70, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61, 0x67, 0x65, 0x6e,
+	0x74, 0x73, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x4c, 0x52, 0x4f, 0x49, 0x6e, 0x70,
+	0x75, 0x74, 0x52, 0x08, 0x6c, 0x72, 0x6f, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x22, 0x18, 0x0a, 0x16,
+	0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xdd, 0x01, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x44, 0x61,
+	0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x58, 0x0a, 0x0d, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x33, 0x2e, 0x61, 0x67, 0x65, 0x6e,
+	0x74, 0x73, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74,
+	0x61, 0x62, 0x61, 0x73, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0c,
+	0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0x68, 0x0a, 0x0c,
+	0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x19, 0x0a, 0x15,
+	0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x44, 0x41, 0x54, 0x41, 0x42, 0x41, 0x53, 0x45,
+	0x5f, 0x54, 0x59, 0x50, 0x45, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x4f, 0x52, 0x41, 0x43, 0x4c,
+	0x45, 0x5f, 0x31, 0x32, 0x5f, 0x32, 0x5f, 0x45, 0x4e, 0x54, 0x45, 0x52, 0x50, 0x52, 0x49, 0x53,
+	0x45, 0x10, 0x01, 0x12, 0x21, 0x0a, 0x1d, 0x4f, 0x52, 0x41, 0x43, 0x4c, 0x45, 0x5f, 0x31, 0x32,
+	0x5f, 0x32, 0x5f, 0x45, 0x4e, 0x54, 0x45, 0x52, 0x50, 0x52, 0x49, 0x53, 0x45, 0x5f, 0x4e, 0x4f,
+	0x4e, 0x43, 0x44, 0x42, 0x10, 0x02, 0x22, 0x18, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74,
+	0x61, 0x62, 0x61, 0x73, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x22, 0x3e, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x4e,
+	0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x64,
+	0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x4e, 0x61, 0x6d, 0x65,
+	0x22, 0x77, 0x0a, 0x1e, 0x53, 0x65, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x52,
+	0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x30, 0x0a, 0x14, 0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x5f, 0x64, 0x61, 0x74,
+	0x61, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x12, 0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x64, 0x61, 0x74,
+	0x61, 0x62, 0x61, 0x73, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x34, 0x0a, 0x17, 0x42, 0x6f, 0x6f,
+	0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x62, 0x79, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x64, 0x62, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x64, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x22,
+	0x1a, 0x0a, 0x18, 0x42, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x53, 0x74, 0x61, 0x6e,
+	0x64, 0x62, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xae, 0x02, 0x0a, 0x10,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x44, 0x42, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1f, 0x0a, 0x0b, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5f, 0x68, 0x6f, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x48, 0x6f, 0x6d,
+	0x65, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x6e, 0x61,
 	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61,
-	0x73, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x0d, 0x0a, 0x0b, 0x4e, 0x49, 0x44, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x18, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61,
-	0x62, 0x61, 0x73, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
-	0xdd, 0x01, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x54,
-	0x79, 0x70, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x58, 0x0a, 0x0d, 0x64,
-	0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x33, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x6f, 0x72, 0x61, 0x63,
-	0x6c, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x54, 0x79,
-	0x70, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x62,
-	0x61, 0x73, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0c, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73,
-	0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0x68, 0x0a, 0x0c, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73,
-	0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x19, 0x0a, 0x15, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e,
-	0x5f, 0x44, 0x41, 0x54, 0x41, 0x42, 0x41, 0x53, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x10, 0x00,
-	0x12, 0x1a, 0x0a, 0x16, 0x4f, 0x52, 0x41, 0x43, 0x4c, 0x45, 0x5f, 0x31, 0x32, 0x5f, 0x32, 0x5f,
-	0x45, 0x4e, 0x54, 0x45, 0x52, 0x50, 0x52, 0x49, 0x53, 0x45, 0x10, 0x01, 0x12, 0x21, 0x0a, 0x1d,
-	0x4f, 0x52, 0x41, 0x43, 0x4c, 0x45, 0x5f, 0x31, 0x32, 0x5f, 0x32, 0x5f, 0x45, 0x4e, 0x54, 0x45,
-	0x52, 0x50, 0x52, 0x49, 0x53, 0x45, 0x5f, 0x4e, 0x4f, 0x4e, 0x43, 0x44, 0x42, 0x10, 0x02, 0x22,
-	0x18, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x4e, 0x61,
-	0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x3e, 0x0a, 0x17, 0x47, 0x65, 0x74,
-	0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65,
-	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x64, 0x61, 0x74,
-	0x61, 0x62, 0x61, 0x73, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x77, 0x0a, 0x1e, 0x53, 0x65, 0x74,
-	0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x14, 0x67,
-	0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x67, 0x6c, 0x6f, 0x62, 0x61,
-	0x6c, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a,
-	0x0d, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x4e, 0x61,
-	0x6d, 0x65, 0x22, 0x34, 0x0a, 0x17, 0x42, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x53,
-	0x74, 0x61, 0x6e, 0x64, 0x62, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a,
-	0x08, 0x63, 0x64, 0x62, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x63, 0x64, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x1a, 0x0a, 0x18, 0x42, 0x6f, 0x6f, 0x74,
-	0x73, 0x74, 0x72, 0x61, 0x70, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x62, 0x79, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0xae, 0x02, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43,
-	0x44, 0x42, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6f, 0x72, 0x61,
-	0x63, 0x6c, 0x65, 0x5f, 0x68, 0x6f, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
-	0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x48, 0x6f, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x61,
-	0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0c, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12,
-	0x24, 0x0a, 0x0e, 0x64, 0x62, 0x5f, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x64, 0x62, 0x55, 0x6e, 0x69, 0x71, 0x75,
-	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74,
-	0x65, 0x72, 0x5f, 0x73, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x68,
-	0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x53, 0x65, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x6d, 0x65,
-	0x6d, 0x6f, 0x72, 0x79, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x0d, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e,
-	0x74, 0x12, 0x2b, 0x0a, 0x11, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f,
-	0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x61, 0x64,
-	0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x18,
-	0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x62, 0x5f, 0x64,
-	0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x62, 0x44,
-	0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x22, 0x91, 0x01, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x43, 0x44, 0x42, 0x41, 0x73, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x42, 0x0a, 0x0c, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x6f,
-	0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x44, 0x42, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0b, 0x73, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x34, 0x0a, 0x09, 0x6c, 0x72, 0x6f, 0x5f, 0x69, 0x6e, 0x70, 0x75, 0x74,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x2e,
-	0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x4c, 0x52, 0x4f, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x52,
-	0x08, 0x6c, 0x72, 0x6f, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x22, 0x13, 0x0a, 0x11, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x43, 0x44, 0x42, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xaa,
-	0x01, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65,
-	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x61, 0x74, 0x61,
-	0x62, 0x61, 0x73, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0c, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a,
-	0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x6f, 0x72,
-	0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x1f, 0x0a,
-	0x0b, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5f, 0x68, 0x6f, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0a, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x48, 0x6f, 0x6d, 0x65, 0x12, 0x1b,
-	0x0a, 0x09, 0x64, 0x62, 0x5f, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x64, 0x62, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x22, 0x18, 0x0a, 0x16, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x27, 0x0a, 0x11, 0x46, 0x69, 0x6c, 0x65, 0x45, 0x78, 0x69,
-	0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x2c,
-	0x0a, 0x12, 0x46, 0x69, 0x6c, 0x65, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x78, 0x69, 0x73, 0x74, 0x73, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x65, 0x78, 0x69, 0x73, 0x74, 0x73, 0x22, 0xc4, 0x01, 0x0a,
-	0x16, 0x50, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x11, 0x72, 0x65, 0x73, 0x74, 0x6f,
-	0x72, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x10, 0x72, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65,
-	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x3f, 0x0a, 0x1c, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x72,
-	0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x73, 0x63, 0x6e, 0x5f, 0x71,
-	0x75, 0x65, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x19, 0x6c, 0x61, 0x74, 0x65,
-	0x73, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x63, 0x6e,
-	0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x3c, 0x0a, 0x1a, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72,
-	0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x6c,
-	0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x18, 0x72, 0x65, 0x63, 0x6f, 0x76,
-	0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c,
-	0x61, 0x74, 0x65, 0x22, 0x9d, 0x01, 0x0a, 0x1b, 0x50, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c,
-	0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x41, 0x73, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x48, 0x0a, 0x0c, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x72, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x61, 0x67, 0x65, 0x6e,
-	0x74, 0x73, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x50, 0x68, 0x79, 0x73, 0x69, 0x63,
-	0x61, 0x6c, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x52, 0x0b, 0x73, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x34, 0x0a,
-	0x09, 0x6c, 0x72, 0x6f, 0x5f, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x17, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65,
-	0x2e, 0x4c, 0x52, 0x4f, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x52, 0x08, 0x6c, 0x72, 0x6f, 0x49, 0x6e,
-	0x70, 0x75, 0x74, 0x22, 0xb3, 0x01, 0x0a, 0x15, 0x44, 0x61, 0x74, 0x61, 0x50, 0x75, 0x6d, 0x70,
-	0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a,
-	0x08, 0x70, 0x64, 0x62, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x70, 0x64, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x62, 0x5f, 0x64,
-	0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x62, 0x44,
-	0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64,
-	0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x63,
-	0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x19, 0x0a, 0x08,
-	0x67, 0x63, 0x73, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x67, 0x63, 0x73, 0x50, 0x61, 0x74, 0x68, 0x12, 0x20, 0x0a, 0x0c, 0x67, 0x63, 0x73, 0x5f, 0x6c,
-	0x6f, 0x67, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x67,
-	0x63, 0x73, 0x4c, 0x6f, 0x67, 0x50, 0x61, 0x74, 0x68, 0x22, 0x9b, 0x01, 0x0a, 0x1a, 0x44, 0x61,
-	0x74, 0x61, 0x50, 0x75, 0x6d, 0x70, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x41, 0x73, 0x79, 0x6e,
-	0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x47, 0x0a, 0x0c, 0x73, 0x79, 0x6e, 0x63,
-	0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24,
-	0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x44,
-	0x61, 0x74, 0x61, 0x50, 0x75, 0x6d, 0x70, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x52, 0x0b, 0x73, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x34, 0x0a, 0x09, 0x6c, 0x72, 0x6f, 0x5f, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x6f, 0x72,
-	0x61, 0x63, 0x6c, 0x65, 0x2e, 0x4c, 0x52, 0x4f, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x52, 0x08, 0x6c,
-	0x72, 0x6f, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x22, 0x18, 0x0a, 0x16, 0x44, 0x61, 0x74, 0x61, 0x50,
-	0x75, 0x6d, 0x70, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x95, 0x02, 0x0a, 0x15, 0x44, 0x61, 0x74, 0x61, 0x50, 0x75, 0x6d, 0x70, 0x45, 0x78,
-	0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x70,
-	0x64, 0x62, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70,
-	0x64, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x62, 0x5f, 0x64, 0x6f, 0x6d,
-	0x61, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x62, 0x44, 0x6f, 0x6d,
-	0x61, 0x69, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x74, 0x79,
-	0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74,
-	0x54, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x12, 0x25,
-	0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73,
-	0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x50,
-	0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x63, 0x73, 0x5f, 0x70, 0x61, 0x74,
-	0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x63, 0x73, 0x50, 0x61, 0x74, 0x68,
-	0x12, 0x20, 0x0a, 0x0c, 0x67, 0x63, 0x73, 0x5f, 0x6c, 0x6f, 0x67, 0x5f, 0x70, 0x61, 0x74, 0x68,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x67, 0x63, 0x73, 0x4c, 0x6f, 0x67, 0x50, 0x61,
-	0x74, 0x68, 0x12, 0x25, 0x0a, 0x0e, 0x66, 0x6c, 0x61, 0x73, 0x68, 0x62, 0x61, 0x63, 0x6b, 0x5f,
-	0x74, 0x69, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x66, 0x6c, 0x61, 0x73,
-	0x68, 0x62, 0x61, 0x63, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x9b, 0x01, 0x0a, 0x1a, 0x44, 0x61,
-	0x74, 0x61, 0x50, 0x75, 0x6d, 0x70, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x41, 0x73, 0x79, 0x6e,
-	0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x47, 0x0a, 0x0c, 0x73, 0x79, 0x6e, 0x63,
-	0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24,
-	0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x44,
-	0x61, 0x74, 0x61, 0x50, 0x75, 0x6d, 0x70, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x52, 0x0b, 0x73, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x34, 0x0a, 0x09, 0x6c, 0x72, 0x6f, 0x5f, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x6f, 0x72,
-	0x61, 0x63, 0x6c, 0x65, 0x2e, 0x4c, 0x52, 0x4f, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x52, 0x08, 0x6c,
-	0x72, 0x6f, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x22, 0x18, 0x0a, 0x16, 0x44, 0x61, 0x74, 0x61, 0x50,
-	0x75, 0x6d, 0x70, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x34, 0x0a, 0x18, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a,
-	0x07, 0x63, 0x64, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x63, 0x64, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x1b, 0x0a, 0x19, 0x52, 0x65, 0x63, 0x6f, 0x76,
-	0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x5b, 0x0a, 0x1f, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64,
-	0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x46, 0x72, 0x6f, 0x6d, 0x47, 0x43, 0x53,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x63, 0x73, 0x5f, 0x70,
-	0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x63, 0x73, 0x50, 0x61,
-	0x74, 0x68, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x70, 0x61, 0x74, 0x68,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x50, 0x61, 0x74,
-	0x68, 0x22, 0x22, 0x0a, 0x20, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x44, 0x69, 0x72,
-	0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x46, 0x72, 0x6f, 0x6d, 0x47, 0x43, 0x53, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x22, 0x0a, 0x20, 0x46, 0x65, 0x74, 0x63, 0x68, 0x53, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x44, 0x61,
-	0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x79, 0x0a, 0x21, 0x46, 0x65, 0x74,
-	0x63, 0x68, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x4d, 0x65,
-	0x74, 0x61, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18,
-	0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x64, 0x62, 0x5f,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x64, 0x62, 0x4e,
-	0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5f, 0x68, 0x6f,
-	0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65,
-	0x48, 0x6f, 0x6d, 0x65, 0x32, 0xfb, 0x16, 0x0a, 0x0e, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73,
-	0x65, 0x44, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x12, 0x4e, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x73, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x24, 0x0a, 0x0e, 0x64, 0x62, 0x5f, 0x75, 0x6e, 0x69,
+	0x71, 0x75, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x64, 0x62, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d,
+	0x63, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x53, 0x65,
+	0x74, 0x12, 0x25, 0x0a, 0x0e, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x5f, 0x70, 0x65, 0x72, 0x63,
+	0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x6d, 0x65, 0x6d, 0x6f, 0x72,
+	0x79, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x2b, 0x0a, 0x11, 0x61, 0x64, 0x64, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x06, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x10, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x50,
+	0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x1b, 0x0a, 0x09, 0x64, 0x62, 0x5f, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x64, 0x62, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x22, 0x91, 0x01, 0x0a,
+	0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x44, 0x42, 0x41, 0x73, 0x79, 0x6e, 0x63, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x42, 0x0a, 0x0c, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x61,
+	0x67, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x43, 0x44, 0x42, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0b, 0x73,
+	0x79, 0x6e, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x34, 0x0a, 0x09, 0x6c, 0x72,
+	0x6f, 0x5f, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x4c, 0x52,
+	0x4f, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x52, 0x08, 0x6c, 0x72, 0x6f, 0x49, 0x6e, 0x70, 0x75, 0x74,
+	0x22, 0x13, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x44, 0x42, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xaa, 0x01, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x23, 0x0a, 0x0d, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x1f, 0x0a, 0x0b, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5f, 0x68,
+	0x6f, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6f, 0x72, 0x61, 0x63, 0x6c,
+	0x65, 0x48, 0x6f, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x62, 0x5f, 0x64, 0x6f, 0x6d, 0x61,
+	0x69, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x62, 0x44, 0x6f, 0x6d, 0x61,
+	0x69, 0x6e, 0x22, 0x18, 0x0a, 0x16, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x73, 0x74,
+	0x65, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x27, 0x0a, 0x11,
+	0x46, 0x69, 0x6c, 0x65, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x2c, 0x0a, 0x12, 0x46, 0x69, 0x6c, 0x65, 0x45, 0x78, 0x69,
+	0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x65,
+	0x78, 0x69, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x65, 0x78, 0x69,
+	0x73, 0x74, 0x73, 0x22, 0xda, 0x03, 0x0a, 0x16, 0x50, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c,
+	0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2b,
+	0x0a, 0x11, 0x72, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x72, 0x65, 0x73, 0x74, 0x6f,
+	0x72, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x3f, 0x0a, 0x1c, 0x6c,
+	0x61, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x61, 0x62, 0x6c,
+	0x65, 0x5f, 0x73, 0x63, 0x6e, 0x5f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x19, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72,
+	0x61, 0x62, 0x6c, 0x65, 0x53, 0x63, 0x6e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x3c, 0x0a, 0x1a,
+	0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x18, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x36, 0x0a, 0x17, 0x72, 0x65,
+	0x63, 0x6f, 0x76, 0x65, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x5f,
+	0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x72, 0x65, 0x63,
+	0x6f, 0x76, 0x65, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x09, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1b, 0x0a,
+	0x09, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x5f, 0x73, 0x63, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x08, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x53, 0x63, 0x6e, 0x12, 0x2e, 0x0a, 0x13, 0x75, 0x6e,
+	0x74, 0x69, 0x6c, 0x5f, 0x72, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x5f, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x52, 0x65,
+	0x73, 0x74, 0x6f, 0x72, 0x65, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0e, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x12, 0x2b, 0x0a, 0x11, 0x64, 0x61, 0x74, 0x61, 0x66, 0x69, 0x6c, 0x65, 0x5f,
+	0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10,
+	0x64, 0x61, 0x74, 0x61, 0x66, 0x69, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x73,
+	0x22, 0x9d, 0x01, 0x0a, 0x1b, 0x50, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x73,
+	0x74, 0x6f, 0x72, 0x65, 0x41, 0x73, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x48, 0x0a, 0x0c, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x2e,
+	0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x50, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x52,
+	0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0b, 0x73,
+	0x79, 0x6e, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x34, 0x0a, 0x09, 0x6c, 0x72,
+	0x6f, 0x5f, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x4c, 0x52,
+	0x4f, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x52, 0x08, 0x6c, 0x72, 0x6f, 0x49, 0x6e, 0x70, 0x75, 0x74,
+	0x22, 0xe9, 0x02, 0x0a, 0x15, 0x44, 0x61, 0x74, 0x61, 0x50, 0x75, 0x6d, 0x70, 0x49, 0x6d, 0x70,
+	0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x64,
+	0x62, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x64,
+	0x62, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x62, 0x5f, 0x64, 0x6f, 0x6d, 0x61,
+	0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x62, 0x44, 0x6f, 0x6d, 0x61,
+	0x69, 0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x61,
+	0x72, 0x61, 0x6d, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x6d, 0x6d,
+	0x61, 0x6e, 0x64, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x63, 0x73,
+	0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x63, 0x73,
+	0x50, 0x61, 0x74, 0x68, 0x12, 0x20, 0x0a, 0x0c, 0x67, 0x63, 0x73, 0x5f, 0x6c, 0x6f, 0x67, 0x5f,
+	0x70, 0x61, 0x74, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x67, 0x63, 0x73, 0x4c,
+	0x6f, 0x67, 0x50, 0x61, 0x74, 0x68, 0x12, 0x6d, 0x0a, 0x13, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67,
+	0x65, 0x5f, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x18, 0x06, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x3c, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x6f, 0x72, 0x61,
+	0x63, 0x6c, 0x65, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x50, 0x75, 0x6d, 0x70, 0x49, 0x6d, 0x70, 0x6f,
+	0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67,
+	0x65, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x12, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x61, 0x6c, 0x73, 0x1a, 0x45, 0x0a, 0x17, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
+	0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x9b, 0x01, 0x0a,
+	0x1a, 0x44, 0x61, 0x74, 0x61, 0x50, 0x75, 0x6d, 0x70, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x41,
+	0x73, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x47, 0x0a, 0x0c, 0x73,
+	0x79, 0x6e, 0x63, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x24, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c,
+	0x65, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x50, 0x75, 0x6d, 0x70, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0b, 0x73, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x34, 0x0a, 0x09, 0x6c, 0x72, 0x6f, 0x5f, 0x69, 0x6e, 0x70, 0x75,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73,
+	0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x4c, 0x52, 0x4f, 0x49, 0x6e, 0x70, 0x75, 0x74,
+	0x52, 0x08, 0x6c, 0x72, 0x6f, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x22, 0x18, 0x0a, 0x16, 0x44, 0x61,
+	0x74, 0x61, 0x50, 0x75, 0x6d, 0x70, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0xcb, 0x03, 0x0a, 0x15, 0x44, 0x61, 0x74, 0x61, 0x50, 0x75, 0x6d,
+	0x70, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19,
+	0x0a, 0x08, 0x70, 0x64, 0x62, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x70, 0x64, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x62, 0x5f,
+	0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x62,
+	0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74,
+	0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6f, 0x62, 0x6a,
+	0x65, 0x63, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x62, 0x6a, 0x65, 0x63,
+	0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74,
+	0x73, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x5f, 0x70, 0x61, 0x72,
+	0x61, 0x6d, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x6d, 0x6d, 0x61,
+	0x6e, 0x64, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x63, 0x73, 0x5f,
+	0x70, 0x61, 0x74, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x63, 0x73, 0x50,
+	0x61, 0x74, 0x68, 0x12, 0x20, 0x0a, 0x0c, 0x67, 0x63, 0x73, 0x5f, 0x6c, 0x6f, 0x67, 0x5f, 0x70,
+	0x61, 0x74, 0x68, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x67, 0x63, 0x73, 0x4c, 0x6f,
+	0x67, 0x50, 0x61, 0x74, 0x68, 0x12, 0x25, 0x0a, 0x0e, 0x66, 0x6c, 0x61, 0x73, 0x68, 0x62, 0x61,
+	0x63, 0x6b, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x66,
+	0x6c, 0x61, 0x73, 0x68, 0x62, 0x61, 0x63, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x6d, 0x0a, 0x13,
+	0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x61, 0x6c, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3c, 0x2e, 0x61, 0x67, 0x65, 0x6e,
+	0x74, 0x73, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x50, 0x75,
+	0x6d, 0x70, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e,
+	0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61,
+	0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x12, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
+	0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x1a, 0x45, 0x0a, 0x17, 0x53,
+	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x22, 0x9b, 0x01, 0x0a, 0x1a, 0x44, 0x61, 0x74, 0x61, 0x50, 0x75, 0x6d, 0x70, 0x45,
+	0x78, 0x70, 0x6f, 0x72, 0x74, 0x41, 0x73, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x47, 0x0a, 0x0c, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73,
+	0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x50, 0x75, 0x6d, 0x70,
+	0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0b, 0x73,
+	0x79, 0x6e, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x34, 0x0a, 0x09, 0x6c, 0x72,
+	0x6f, 0x5f, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x4c, 0x52,
+	0x4f, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x52, 0x08, 0x6c, 0x72, 0x6f, 0x49, 0x6e, 0x70, 0x75, 0x74,
+	0x22, 0x18, 0x0a, 0x16, 0x44, 0x61, 0x74, 0x61, 0x50, 0x75, 0x6d, 0x70, 0x45, 0x78, 0x70, 0x6f,
+	0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x34, 0x0a, 0x18, 0x52, 0x65,
+	0x63, 0x6f, 0x76, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x46, 0x69, 0x6c, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x64, 0x62, 0x4e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x64, 0x62, 0x4e, 0x61, 0x6d, 0x65,
+	0x22, 0x1b, 0x0a, 0x19, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x9b, 0x02,
+	0x0a, 0x1f, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x79, 0x46, 0x72, 0x6f, 0x6d, 0x47, 0x43, 0x53, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x63, 0x73, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x63, 0x73, 0x50, 0x61, 0x74, 0x68, 0x12, 0x1d, 0x0a, 0x0a,
+	0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x50, 0x61, 0x74, 0x68, 0x12, 0x77, 0x0a, 0x13, 0x73,
+	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61,
+	0x6c, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x46, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74,
+	0x73, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61,
+	0x64, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x46, 0x72, 0x6f, 0x6d, 0x47, 0x43,
+	0x53, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
+	0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x12, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x61, 0x6c, 0x73, 0x1a, 0x45, 0x0a, 0x17, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x43,
+	0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x22, 0x0a, 0x20, 0x44,
+	0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79,
+	0x46, 0x72, 0x6f, 0x6d, 0x47, 0x43, 0x53, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x22, 0x0a, 0x20, 0x46, 0x65, 0x74, 0x63, 0x68, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49,
+	0x6d, 0x61, 0x67, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x22, 0x79, 0x0a, 0x21, 0x46, 0x65, 0x74, 0x63, 0x68, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x44, 0x61, 0x74, 0x61,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x64, 0x62, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x64, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a,
+	0x0b, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5f, 0x68, 0x6f, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x48, 0x6f, 0x6d, 0x65, 0x22, 0xb5,
+	0x02, 0x0a, 0x17, 0x53, 0x68, 0x69, 0x70, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x4c,
+	0x6f, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x63,
+	0x73, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x63,
+	0x73, 0x50, 0x61, 0x74, 0x68, 0x12, 0x20, 0x0a, 0x0b, 0x69, 0x6e, 0x63, 0x61, 0x72, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x69, 0x6e, 0x63, 0x61,
+	0x72, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x61, 0x66, 0x74, 0x65, 0x72,
+	0x5f, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0d, 0x61, 0x66, 0x74, 0x65, 0x72, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x6f,
+	0x0a, 0x13, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x61, 0x6c, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x61, 0x67,
+	0x65, 0x6e, 0x74, 0x73, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x53, 0x68, 0x69, 0x70,
+	0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x43, 0x72, 0x65, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x12, 0x73, 0x74, 0x6f,
+	0x72, 0x61, 0x67, 0x65, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x1a,
+	0x45, 0x0a, 0x17, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x61, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xff, 0x01, 0x0a, 0x18, 0x53, 0x68, 0x69, 0x70, 0x41,
+	0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x69, 0x6e, 0x63, 0x61, 0x72, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x69, 0x6e, 0x63, 0x61, 0x72, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x73, 0x65,
+	0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x6c, 0x61,
+	0x73, 0x74, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6c, 0x61,
+	0x73, 0x74, 0x5f, 0x73, 0x63, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x6c, 0x61,
+	0x73, 0x74, 0x53, 0x63, 0x6e, 0x12, 0x37, 0x0a, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x23,
+	0x0a, 0x0d, 0x73, 0x68, 0x69, 0x70, 0x70, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x73, 0x68, 0x69, 0x70, 0x70, 0x65, 0x64, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x9f, 0x01, 0x0a, 0x1c, 0x53, 0x68, 0x69,
+	0x70, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x4c, 0x6f, 0x67, 0x73, 0x41, 0x73, 0x79,
+	0x6e, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x49, 0x0a, 0x0c, 0x73, 0x79, 0x6e,
+	0x63, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x26, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e,
+	0x53, 0x68, 0x69, 0x70, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x4c, 0x6f, 0x67, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0b, 0x73, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x34, 0x0a, 0x09, 0x6c, 0x72, 0x6f, 0x5f, 0x69, 0x6e, 0x70, 0x75,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73,
+	0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x4c, 0x52, 0x4f, 0x49, 0x6e, 0x70, 0x75, 0x74,
+	0x52, 0x08, 0x6c, 0x72, 0x6f, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x22, 0xf6, 0x01, 0x0a, 0x13, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x74, 0x61, 0x67, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x63, 0x73, 0x5f, 0x70, 0x61, 0x74, 0x68,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x63, 0x73, 0x50, 0x61, 0x74, 0x68, 0x12,
+	0x6b, 0x0a, 0x13, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x63, 0x72, 0x65, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3a, 0x2e, 0x61,
+	0x67, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x61, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x12, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67,
+	0x65, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x1a, 0x45, 0x0a, 0x17,
+	0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61,
+	0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x22, 0x3b, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x61, 0x63,
+	0x6b, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x64,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0c, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x22, 0x15, 0x0a, 0x13, 0x52, 0x75, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x70, 0x61, 0x74, 0x63, 0x68,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x16, 0x0a, 0x14, 0x52, 0x75, 0x6e, 0x44, 0x61,
+	0x74, 0x61, 0x70, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x18, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x48, 0x65,
+	0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x3f, 0x0a, 0x17, 0x47, 0x65, 0x74,
+	0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x48, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x65, 0x71, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x03, 0x73, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x32, 0xad, 0x1c, 0x0a, 0x0e, 0x44,
+	0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x44, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x12, 0x4e, 0x0a,
+	0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x69, 0x72, 0x12, 0x1f, 0x2e, 0x61, 0x67, 0x65,
+	0x6e, 0x74, 0x73, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x44, 0x69, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x61, 0x67,
+	0x65, 0x6e, 0x74, 0x73, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x44, 0x69, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a,
+	0x07, 0x52, 0x65, 0x61, 0x64, 0x44, 0x69, 0x72, 0x12, 0x1d, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74,
+	0x73, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x44, 0x69, 0x72,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73,
+	0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x44, 0x69, 0x72, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4e, 0x0a, 0x09, 0x44, 0x65, 0x6c, 0x65, 0x74,
 	0x65, 0x44, 0x69, 0x72, 0x12, 0x1f, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x6f, 0x72,
-	0x61, 0x63, 0x6c, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x69, 0x72, 0x52, 0x65,
+	0x61, 0x63, 0x6c, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x69, 0x72, 0x52, 0x65,
 	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x6f,
-	0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x69, 0x72, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x07, 0x52, 0x65, 0x61, 0x64, 0x44,
-	0x69, 0x72, 0x12, 0x1d, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x6f, 0x72, 0x61, 0x63,
-	0x6c, 0x65, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x44, 0x69, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x1e, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c,
-	0x65, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x44, 0x69, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x4e, 0x0a, 0x09, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x69, 0x72, 0x12, 0x1f,
-	0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x69, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x20, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x69, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x5d, 0x0a, 0x0e, 0x42, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x44, 0x61, 0x74, 0x61, 0x62,
-	0x61, 0x73, 0x65, 0x12, 0x24, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x6f, 0x72, 0x61,
-	0x63, 0x6c, 0x65, 0x2e, 0x42, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61,
-	0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x61, 0x67, 0x65, 0x6e,
+	0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x69, 0x72, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5d, 0x0a, 0x0e, 0x42, 0x6f, 0x75, 0x6e, 0x63,
+	0x65, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x12, 0x24, 0x2e, 0x61, 0x67, 0x65, 0x6e,
 	0x74, 0x73, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x42, 0x6f, 0x75, 0x6e, 0x63, 0x65,
-	0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x5d, 0x0a, 0x0e, 0x42, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e,
-	0x65, 0x72, 0x12, 0x24, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x6f, 0x72, 0x61, 0x63,
-	0x6c, 0x65, 0x2e, 0x42, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65,
-	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74,
+	0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x25, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e,
+	0x42, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5d, 0x0a, 0x0e, 0x42, 0x6f, 0x75, 0x6e, 0x63, 0x65,
+	0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x12, 0x24, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74,
 	0x73, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x42, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x4c,
-	0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x69, 0x0a, 0x12, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65,
-	0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x28, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x6f,
+	0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25,
+	0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x42,
+	0x6f, 0x75, 0x6e, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x69, 0x0a, 0x12, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x44, 0x61,
+	0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x28, 0x2e, 0x61, 0x67,
+	0x65, 0x6e, 0x74, 0x73, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x43, 0x68, 0x65, 0x63,
+	0x6b, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x6f,
 	0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x44, 0x61, 0x74, 0x61, 0x62,
-	0x61, 0x73, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x61, 0x73, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x50, 0x0a, 0x0a, 0x52, 0x75, 0x6e, 0x53, 0x51, 0x4c, 0x50, 0x6c, 0x75, 0x73, 0x12, 0x23,
+	0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x52,
+	0x75, 0x6e, 0x53, 0x51, 0x4c, 0x50, 0x6c, 0x75, 0x73, 0x43, 0x4d, 0x44, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x6f, 0x72, 0x61,
+	0x63, 0x6c, 0x65, 0x2e, 0x52, 0x75, 0x6e, 0x43, 0x4d, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x59, 0x0a, 0x13, 0x52, 0x75, 0x6e, 0x53, 0x51, 0x4c, 0x50, 0x6c, 0x75, 0x73,
+	0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x74, 0x65, 0x64, 0x12, 0x23, 0x2e, 0x61, 0x67, 0x65, 0x6e,
+	0x74, 0x73, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x52, 0x75, 0x6e, 0x53, 0x51, 0x4c,
+	0x50, 0x6c, 0x75, 0x73, 0x43, 0x4d, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d,
+	0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x52,
+	0x75, 0x6e, 0x43, 0x4d, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4e, 0x0a,
+	0x09, 0x4b, 0x6e, 0x6f, 0x77, 0x6e, 0x50, 0x44, 0x42, 0x73, 0x12, 0x1f, 0x2e, 0x61, 0x67, 0x65,
+	0x6e, 0x74, 0x73, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x4b, 0x6e, 0x6f, 0x77, 0x6e,
+	0x50, 0x44, 0x42, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x61, 0x67,
+	0x65, 0x6e, 0x74, 0x73, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x4b, 0x6e, 0x6f, 0x77,
+	0x6e, 0x50, 0x44, 0x42, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a,
+	0x07, 0x52, 0x75, 0x6e, 0x52, 0x4d, 0x41, 0x4e, 0x12, 0x1d, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74,
+	0x73, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x52, 0x75, 0x6e, 0x52, 0x4d, 0x41, 0x4e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73,
+	0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x52, 0x75, 0x6e, 0x52, 0x4d, 0x41, 0x4e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x51, 0x0a, 0x0c, 0x52, 0x75, 0x6e, 0x52, 0x4d,
+	0x41, 0x4e, 0x41, 0x73, 0x79, 0x6e, 0x63, 0x12, 0x22, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73,
+	0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x52, 0x75, 0x6e, 0x52, 0x4d, 0x41, 0x4e, 0x41,
+	0x73, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x6c, 0x6f, 0x6e, 0x67, 0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67,
+	0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3c, 0x0a, 0x03, 0x4e, 0x49,
+	0x44, 0x12, 0x19, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c,
+	0x65, 0x2e, 0x4e, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x61,
+	0x67, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x4e, 0x49, 0x44,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x71, 0x0a, 0x1b, 0x43, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x41, 0x73, 0x79, 0x6e, 0x63, 0x12, 0x31, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73,
+	0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x44, 0x61,
+	0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x41, 0x73,
+	0x79, 0x6e, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x6c, 0x6f, 0x6e, 0x67, 0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e,
+	0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x00, 0x12, 0x60, 0x0a, 0x0f, 0x47,
+	0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x25,
+	0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x47,
+	0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x6f,
+	0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73,
+	0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x60, 0x0a,
+	0x0f, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x25, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65,
+	0x2e, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x4e, 0x61, 0x6d, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73,
+	0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x62,
+	0x61, 0x73, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x69, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
+	0x64, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x28, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x6f,
+	0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x61, 0x73, 0x73,
+	0x77, 0x6f, 0x72, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
 	0x29, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e,
-	0x43, 0x68, 0x65, 0x63, 0x6b, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x53, 0x74, 0x61,
-	0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x0a, 0x52, 0x75,
-	0x6e, 0x53, 0x51, 0x4c, 0x50, 0x6c, 0x75, 0x73, 0x12, 0x23, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74,
-	0x73, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x52, 0x75, 0x6e, 0x53, 0x51, 0x4c, 0x50,
-	0x6c, 0x75, 0x73, 0x43, 0x4d, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e,
-	0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x52, 0x75,
-	0x6e, 0x43, 0x4d, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x59, 0x0a, 0x13,
-	0x52, 0x75, 0x6e, 0x53, 0x51, 0x4c, 0x50, 0x6c, 0x75, 0x73, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74,
-	0x74, 0x65, 0x64, 0x12, 0x23, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x6f, 0x72, 0x61,
-	0x63, 0x6c, 0x65, 0x2e, 0x52, 0x75, 0x6e, 0x53, 0x51, 0x4c, 0x50, 0x6c, 0x75, 0x73, 0x43, 0x4d,
-	0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74,
-	0x73, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x52, 0x75, 0x6e, 0x43, 0x4d, 0x44, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4e, 0x0a, 0x09, 0x4b, 0x6e, 0x6f, 0x77, 0x6e,
-	0x50, 0x44, 0x42, 0x73, 0x12, 0x1f, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x6f, 0x72,
-	0x61, 0x63, 0x6c, 0x65, 0x2e, 0x4b, 0x6e, 0x6f, 0x77, 0x6e, 0x50, 0x44, 0x42, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x6f,
-	0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x4b, 0x6e, 0x6f, 0x77, 0x6e, 0x50, 0x44, 0x42, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x07, 0x52, 0x75, 0x6e, 0x52, 0x4d,
-	0x41, 0x4e, 0x12, 0x1d, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x6f, 0x72, 0x61, 0x63,
-	0x6c, 0x65, 0x2e, 0x52, 0x75, 0x6e, 0x52, 0x4d, 0x41, 0x4e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x1e, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c,
-	0x65, 0x2e, 0x52, 0x75, 0x6e, 0x52, 0x4d, 0x41, 0x4e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x51, 0x0a, 0x0c, 0x52, 0x75, 0x6e, 0x52, 0x4d, 0x41, 0x4e, 0x41, 0x73, 0x79, 0x6e,
-	0x63, 0x12, 0x22, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c,
-	0x65, 0x2e, 0x52, 0x75, 0x6e, 0x52, 0x4d, 0x41, 0x4e, 0x41, 0x73, 0x79, 0x6e, 0x63, 0x52, 0x65,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x46, 0x69,
+	0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7b, 0x0a, 0x18, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x49, 0x6e, 0x69, 0x74, 0x4f,
+	0x72, 0x61, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x2e, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x2e,
+	0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x49, 0x6e, 0x69, 0x74, 0x4f, 0x72, 0x61, 0x46, 0x69, 0x6c, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x2e,
+	0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x49, 0x6e, 0x69, 0x74, 0x4f, 0x72, 0x61, 0x46, 0x69, 0x6c, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6f, 0x0a, 0x17, 0x53, 0x65, 0x74, 0x4c, 0x69,
+	0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x2d, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x6f, 0x72, 0x61, 0x63,
+	0x6c, 0x65, 0x2e, 0x53, 0x65, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x52, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x25, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c,
+	0x65, 0x2e, 0x42, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x63, 0x0a, 0x10, 0x42, 0x6f, 0x6f, 0x74,
+	0x73, 0x74, 0x72, 0x61, 0x70, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x62, 0x79, 0x12, 0x26, 0x2e, 0x61,
+	0x67, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x42, 0x6f, 0x6f,
+	0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x62, 0x79, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x6f, 0x72,
+	0x61, 0x63, 0x6c, 0x65, 0x2e, 0x42, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x53, 0x74,
+	0x61, 0x6e, 0x64, 0x62, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4e, 0x0a,
+	0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x44, 0x42, 0x12, 0x1f, 0x2e, 0x61, 0x67, 0x65,
+	0x6e, 0x74, 0x73, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x43, 0x44, 0x42, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x61, 0x67,
+	0x65, 0x6e, 0x74, 0x73, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x43, 0x44, 0x42, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x55, 0x0a,
+	0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x44, 0x42, 0x41, 0x73, 0x79, 0x6e, 0x63, 0x12,
+	0x24, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x44, 0x42, 0x41, 0x73, 0x79, 0x6e, 0x63, 0x52, 0x65,
 	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x6c,
 	0x6f, 0x6e, 0x67, 0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3c, 0x0a, 0x03, 0x4e, 0x49, 0x44, 0x12, 0x19, 0x2e, 0x61, 0x67,
-	0x65, 0x6e, 0x74, 0x73, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x4e, 0x49, 0x44, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x2e,
-	0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x4e, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x60, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73,
-	0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x25, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x6f,
-	0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73,
-	0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x61,
-	0x67, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x47, 0x65, 0x74,
-	0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x60, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x62,
-	0x61, 0x73, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x25, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73,
-	0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x62,
-	0x61, 0x73, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26,
-	0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x47,
-	0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x69, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x28, 0x2e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x5d, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4c, 0x69,
+	0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x12, 0x24, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x2e,
+	0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x73,
+	0x74, 0x65, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x61,
 	0x67, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x2e,
-	0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x61, 0x73,
-	0x73, 0x77, 0x6f, 0x72, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x7b, 0x0a, 0x18, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x69,
-	0x63, 0x61, 0x49, 0x6e, 0x69, 0x74, 0x4f, 0x72, 0x61, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x2e, 0x2e,
-	0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x49, 0x6e, 0x69, 0x74, 0x4f,
-	0x72, 0x61, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e,
-	0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x49, 0x6e, 0x69, 0x74, 0x4f,
-	0x72, 0x61, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6f,
-	0x0a, 0x17, 0x53, 0x65, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x67,
-	0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2d, 0x2e, 0x61, 0x67, 0x65, 0x6e,
-	0x74, 0x73, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x53, 0x65, 0x74, 0x4c, 0x69, 0x73,
-	0x74, 0x65, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74,
-	0x73, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x42, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x4c,
-	0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x63, 0x0a, 0x10, 0x42, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x53, 0x74, 0x61, 0x6e,
-	0x64, 0x62, 0x79, 0x12, 0x26, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x6f, 0x72, 0x61,
-	0x63, 0x6c, 0x65, 0x2e, 0x42, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x53, 0x74, 0x61,
-	0x6e, 0x64, 0x62, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x61, 0x67,
-	0x65, 0x6e, 0x74, 0x73, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x42, 0x6f, 0x6f, 0x74,
-	0x73, 0x74, 0x72, 0x61, 0x70, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x62, 0x79, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4e, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x44,
-	0x42, 0x12, 0x1f, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c,
-	0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x44, 0x42, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x20, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x6f, 0x72, 0x61, 0x63,
-	0x6c, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x44, 0x42, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x55, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x44,
-	0x42, 0x41, 0x73, 0x79, 0x6e, 0x63, 0x12, 0x24, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x2e,
-	0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x44, 0x42,
+	0x61, 0x74, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x51, 0x0a, 0x0a, 0x46, 0x69, 0x6c, 0x65, 0x45, 0x78, 0x69, 0x73, 0x74,
+	0x73, 0x12, 0x20, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c,
+	0x65, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x6f, 0x72, 0x61,
+	0x63, 0x6c, 0x65, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x61, 0x0a, 0x14, 0x50, 0x68, 0x79, 0x73, 0x69, 0x63,
+	0x61, 0x6c, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x41, 0x73, 0x79, 0x6e, 0x63, 0x12, 0x2a,
+	0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x50,
+	0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x41, 0x73,
+	0x79, 0x6e, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x6c, 0x6f, 0x6e, 0x67, 0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e,
+	0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x5f, 0x0a, 0x13, 0x44, 0x61, 0x74,
+	0x61, 0x50, 0x75, 0x6d, 0x70, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x41, 0x73, 0x79, 0x6e, 0x63,
+	0x12, 0x29, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65,
+	0x2e, 0x44, 0x61, 0x74, 0x61, 0x50, 0x75, 0x6d, 0x70, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x41,
+	0x73, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x6c, 0x6f, 0x6e, 0x67, 0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67,
+	0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x5f, 0x0a, 0x13, 0x44, 0x61,
+	0x74, 0x61, 0x50, 0x75, 0x6d, 0x70, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x41, 0x73, 0x79, 0x6e,
+	0x63, 0x12, 0x29, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c,
+	0x65, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x50, 0x75, 0x6d, 0x70, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74,
 	0x41, 0x73, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x67,
 	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x6c, 0x6f, 0x6e, 0x67, 0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e,
-	0x67, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x5d, 0x0a, 0x0e, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x12, 0x24, 0x2e,
-	0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x6f, 0x72, 0x61,
-	0x63, 0x6c, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e,
-	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x51, 0x0a, 0x0a, 0x46, 0x69,
-	0x6c, 0x65, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x12, 0x20, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74,
-	0x73, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x45, 0x78, 0x69,
-	0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x61, 0x67, 0x65,
-	0x6e, 0x74, 0x73, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x45,
-	0x78, 0x69, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x61, 0x0a,
-	0x14, 0x50, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65,
-	0x41, 0x73, 0x79, 0x6e, 0x63, 0x12, 0x2a, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x6f,
-	0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x50, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x52, 0x65,
-	0x73, 0x74, 0x6f, 0x72, 0x65, 0x41, 0x73, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x1d, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x6c, 0x6f, 0x6e, 0x67, 0x72,
-	0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x5f, 0x0a, 0x13, 0x44, 0x61, 0x74, 0x61, 0x50, 0x75, 0x6d, 0x70, 0x49, 0x6d, 0x70, 0x6f,
-	0x72, 0x74, 0x41, 0x73, 0x79, 0x6e, 0x63, 0x12, 0x29, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73,
-	0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x50, 0x75, 0x6d, 0x70,
-	0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x41, 0x73, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x6c, 0x6f, 0x6e, 0x67,
-	0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x5f, 0x0a, 0x13, 0x44, 0x61, 0x74, 0x61, 0x50, 0x75, 0x6d, 0x70, 0x45, 0x78, 0x70,
-	0x6f, 0x72, 0x74, 0x41, 0x73, 0x79, 0x6e, 0x63, 0x12, 0x29, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74,
-	0x73, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x50, 0x75, 0x6d,
-	0x70, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x41, 0x73, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x6c, 0x6f, 0x6e,
-	0x67, 0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x67, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x29, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x6c, 0x6f,
-	0x6e, 0x67, 0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x70,
-	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x67, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x67, 0x0a, 0x0e, 0x4c,
+	0x69, 0x73, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x29, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x6c, 0x6f, 0x6e, 0x67, 0x72, 0x75, 0x6e, 0x6e, 0x69,
+	0x6e, 0x67, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x6c, 0x6f, 0x6e, 0x67, 0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x27, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x6c, 0x6f,
+	0x6e, 0x67, 0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x6c, 0x6f, 0x6e, 0x67, 0x72, 0x75, 0x6e, 0x6e, 0x69,
+	0x6e, 0x67, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x55, 0x0a, 0x0f,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
 	0x2a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x6c, 0x6f, 0x6e, 0x67, 0x72, 0x75, 0x6e,
-	0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x0c, 0x47,
-	0x65, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x27, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x6c, 0x6f, 0x6e, 0x67, 0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67,
-	0x2e, 0x47, 0x65, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x6c, 0x6f,
-	0x6e, 0x67, 0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x55, 0x0a, 0x0f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4f, 0x70, 0x65,
+	0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x12, 0x55, 0x0a, 0x0f, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4f, 0x70, 0x65,
 	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x6c, 0x6f, 0x6e, 0x67, 0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x6c, 0x6f, 0x6e, 0x67, 0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x61, 0x6e, 0x63,
+	0x65, 0x6c, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
 	0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
 	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x66, 0x0a, 0x11, 0x52, 0x65,
 	0x63, 0x6f, 0x76, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x46, 0x69, 0x6c, 0x65, 0x12,
@@ -3557,13 +4595,43 @@ var file_oracle_pkg_agents_oracle_dbdaemon_proto_rawDesc = []byte{
 	0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x46,
 	0x65, 0x74, 0x63, 0x68, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65,
 	0x4d, 0x65, 0x74, 0x61, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x42, 0x58, 0x5a, 0x56, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
-	0x2f, 0x47, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x43, 0x6c, 0x6f, 0x75, 0x64, 0x50, 0x6c, 0x61, 0x74,
-	0x66, 0x6f, 0x72, 0x6d, 0x2f, 0x65, 0x6c, 0x63, 0x61, 0x72, 0x72, 0x6f, 0x2d, 0x6f, 0x72, 0x61,
-	0x63, 0x6c, 0x65, 0x2d, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2f, 0x6f, 0x72, 0x61,
-	0x63, 0x6c, 0x65, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x6f,
-	0x72, 0x61, 0x63, 0x6c, 0x65, 0x3b, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x62, 0x06, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x33,
+	0x22, 0x00, 0x12, 0x65, 0x0a, 0x10, 0x53, 0x68, 0x69, 0x70, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76,
+	0x65, 0x64, 0x4c, 0x6f, 0x67, 0x73, 0x12, 0x26, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x2e,
+	0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x53, 0x68, 0x69, 0x70, 0x41, 0x72, 0x63, 0x68, 0x69,
+	0x76, 0x65, 0x64, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27,
+	0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x53,
+	0x68, 0x69, 0x70, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x4c, 0x6f, 0x67, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x65, 0x0a, 0x15, 0x53, 0x68, 0x69,
+	0x70, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x4c, 0x6f, 0x67, 0x73, 0x41, 0x73, 0x79,
+	0x6e, 0x63, 0x12, 0x2b, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x6f, 0x72, 0x61, 0x63,
+	0x6c, 0x65, 0x2e, 0x53, 0x68, 0x69, 0x70, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x4c,
+	0x6f, 0x67, 0x73, 0x41, 0x73, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1d, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x6c, 0x6f, 0x6e, 0x67, 0x72, 0x75, 0x6e,
+	0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x00,
+	0x12, 0x59, 0x0a, 0x0c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70,
+	0x12, 0x22, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65,
+	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x6f, 0x72,
+	0x61, 0x63, 0x6c, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x61, 0x63, 0x6b, 0x75,
+	0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x59, 0x0a, 0x0c, 0x52,
+	0x75, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x70, 0x61, 0x74, 0x63, 0x68, 0x12, 0x22, 0x2e, 0x61, 0x67,
+	0x65, 0x6e, 0x74, 0x73, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x52, 0x75, 0x6e, 0x44,
+	0x61, 0x74, 0x61, 0x70, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x23, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e,
+	0x52, 0x75, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x70, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x62, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x41, 0x75, 0x64,
+	0x69, 0x74, 0x4c, 0x6f, 0x67, 0x48, 0x65, 0x61, 0x64, 0x12, 0x25, 0x2e, 0x61, 0x67, 0x65, 0x6e,
+	0x74, 0x73, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x75, 0x64,
+	0x69, 0x74, 0x4c, 0x6f, 0x67, 0x48, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x26, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65,
+	0x2e, 0x47, 0x65, 0x74, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x48, 0x65, 0x61, 0x64,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x58, 0x5a, 0x56, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x47, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x43,
+	0x6c, 0x6f, 0x75, 0x64, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x2f, 0x65, 0x6c, 0x63,
+	0x61, 0x72, 0x72, 0x6f, 0x2d, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2d, 0x6f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x6f, 0x72, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x70, 0x6b, 0x67, 0x2f,
+	0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x3b, 0x6f, 0x72,
+	0x61, 0x63, 0x6c, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -3579,7 +4647,7 @@ func file_oracle_pkg_agents_oracle_dbdaemon_proto_rawDescGZIP() []byte {
 }
 
 var file_oracle_pkg_agents_oracle_dbdaemon_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes = make([]protoimpl.MessageInfo, 52)
+var file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes = make([]protoimpl.MessageInfo, 71)
 var file_oracle_pkg_agents_oracle_dbdaemon_proto_goTypes = []interface{}{
 	(GetDatabaseTypeResponse_DatabaseType)(0),  // 0: agents.oracle.GetDatabaseTypeResponse.DatabaseType
 	(*CreateDirRequest)(nil),                   // 1: agents.oracle.CreateDirRequest
@@ -3601,134 +4669,182 @@ var file_oracle_pkg_agents_oracle_dbdaemon_proto_goTypes = []interface{}{
 	(*KnownPDBsResponse)(nil),                  // 17: agents.oracle.KnownPDBsResponse
 	(*RunRMANRequest)(nil),                     // 18: agents.oracle.RunRMANRequest
 	(*LROInput)(nil),                           // 19: agents.oracle.LROInput
-	(*RunRMANAsyncRequest)(nil),                // 20: agents.oracle.RunRMANAsyncRequest
-	(*RunRMANResponse)(nil),                    // 21: agents.oracle.RunRMANResponse
-	(*NIDRequest)(nil),                         // 22: agents.oracle.NIDRequest
-	(*NIDResponse)(nil),                        // 23: agents.oracle.NIDResponse
-	(*GetDatabaseTypeRequest)(nil),             // 24: agents.oracle.GetDatabaseTypeRequest
-	(*GetDatabaseTypeResponse)(nil),            // 25: agents.oracle.GetDatabaseTypeResponse
-	(*GetDatabaseNameRequest)(nil),             // 26: agents.oracle.GetDatabaseNameRequest
-	(*GetDatabaseNameResponse)(nil),            // 27: agents.oracle.GetDatabaseNameResponse
-	(*SetListenerRegistrationRequest)(nil),     // 28: agents.oracle.SetListenerRegistrationRequest
-	(*BootstrapStandbyRequest)(nil),            // 29: agents.oracle.BootstrapStandbyRequest
-	(*BootstrapStandbyResponse)(nil),           // 30: agents.oracle.BootstrapStandbyResponse
-	(*CreateCDBRequest)(nil),                   // 31: agents.oracle.CreateCDBRequest
-	(*CreateCDBAsyncRequest)(nil),              // 32: agents.oracle.CreateCDBAsyncRequest
-	(*CreateCDBResponse)(nil),                  // 33: agents.oracle.CreateCDBResponse
-	(*CreateListenerRequest)(nil),              // 34: agents.oracle.CreateListenerRequest
-	(*CreateListenerResponse)(nil),             // 35: agents.oracle.CreateListenerResponse
-	(*FileExistsRequest)(nil),                  // 36: agents.oracle.FileExistsRequest
-	(*FileExistsResponse)(nil),                 // 37: agents.oracle.FileExistsResponse
-	(*PhysicalRestoreRequest)(nil),             // 38: agents.oracle.PhysicalRestoreRequest
-	(*PhysicalRestoreAsyncRequest)(nil),        // 39: agents.oracle.PhysicalRestoreAsyncRequest
-	(*DataPumpImportRequest)(nil),              // 40: agents.oracle.DataPumpImportRequest
-	(*DataPumpImportAsyncRequest)(nil),         // 41: agents.oracle.DataPumpImportAsyncRequest
-	(*DataPumpImportResponse)(nil),             // 42: agents.oracle.DataPumpImportResponse
-	(*DataPumpExportRequest)(nil),              // 43: agents.oracle.DataPumpExportRequest
-	(*DataPumpExportAsyncRequest)(nil),         // 44: agents.oracle.DataPumpExportAsyncRequest
-	(*DataPumpExportResponse)(nil),             // 45: agents.oracle.DataPumpExportResponse
-	(*RecoverConfigFileRequest)(nil),           // 46: agents.oracle.RecoverConfigFileRequest
-	(*RecoverConfigFileResponse)(nil),          // 47: agents.oracle.RecoverConfigFileResponse
-	(*DownloadDirectoryFromGCSRequest)(nil),    // 48: agents.oracle.DownloadDirectoryFromGCSRequest
-	(*DownloadDirectoryFromGCSResponse)(nil),   // 49: agents.oracle.DownloadDirectoryFromGCSResponse
-	(*FetchServiceImageMetaDataRequest)(nil),   // 50: agents.oracle.FetchServiceImageMetaDataRequest
-	(*FetchServiceImageMetaDataResponse)(nil),  // 51: agents.oracle.FetchServiceImageMetaDataResponse
-	(*ReadDirResponse_FileInfo)(nil),           // 52: agents.oracle.ReadDirResponse.FileInfo
-	(*timestamppb.Timestamp)(nil),              // 53: google.protobuf.Timestamp
-	(*BounceDatabaseRequest)(nil),              // 54: agents.oracle.BounceDatabaseRequest
-	(*BounceListenerRequest)(nil),              // 55: agents.oracle.BounceListenerRequest
-	(*longrunning.ListOperationsRequest)(nil),  // 56: google.longrunning.ListOperationsRequest
-	(*longrunning.GetOperationRequest)(nil),    // 57: google.longrunning.GetOperationRequest
-	(*longrunning.DeleteOperationRequest)(nil), // 58: google.longrunning.DeleteOperationRequest
-	(*BounceDatabaseResponse)(nil),             // 59: agents.oracle.BounceDatabaseResponse
-	(*BounceListenerResponse)(nil),             // 60: agents.oracle.BounceListenerResponse
-	(*longrunning.Operation)(nil),              // 61: google.longrunning.Operation
-	(*longrunning.ListOperationsResponse)(nil), // 62: google.longrunning.ListOperationsResponse
-	(*emptypb.Empty)(nil),                      // 63: google.protobuf.Empty
+	(*OperationProgress)(nil),                  // 20: agents.oracle.OperationProgress
+	(*RunRMANAsyncRequest)(nil),                // 21: agents.oracle.RunRMANAsyncRequest
+	(*RunRMANResponse)(nil),                    // 22: agents.oracle.RunRMANResponse
+	(*NIDRequest)(nil),                         // 23: agents.oracle.NIDRequest
+	(*NIDResponse)(nil),                        // 24: agents.oracle.NIDResponse
+	(*ChangeDatabaseIdentityRequest)(nil),      // 25: agents.oracle.ChangeDatabaseIdentityRequest
+	(*ChangeDatabaseIdentityResponse)(nil),     // 26: agents.oracle.ChangeDatabaseIdentityResponse
+	(*ChangeDatabaseIdentityAsyncRequest)(nil), // 27: agents.oracle.ChangeDatabaseIdentityAsyncRequest
+	(*GetDatabaseTypeRequest)(nil),             // 28: agents.oracle.GetDatabaseTypeRequest
+	(*GetDatabaseTypeResponse)(nil),            // 29: agents.oracle.GetDatabaseTypeResponse
+	(*GetDatabaseNameRequest)(nil),             // 30: agents.oracle.GetDatabaseNameRequest
+	(*GetDatabaseNameResponse)(nil),            // 31: agents.oracle.GetDatabaseNameResponse
+	(*SetListenerRegistrationRequest)(nil),     // 32: agents.oracle.SetListenerRegistrationRequest
+	(*BootstrapStandbyRequest)(nil),            // 33: agents.oracle.BootstrapStandbyRequest
+	(*BootstrapStandbyResponse)(nil),           // 34: agents.oracle.BootstrapStandbyResponse
+	(*CreateCDBRequest)(nil),                   // 35: agents.oracle.CreateCDBRequest
+	(*CreateCDBAsyncRequest)(nil),              // 36: agents.oracle.CreateCDBAsyncRequest
+	(*CreateCDBResponse)(nil),                  // 37: agents.oracle.CreateCDBResponse
+	(*CreateListenerRequest)(nil),              // 38: agents.oracle.CreateListenerRequest
+	(*CreateListenerResponse)(nil),             // 39: agents.oracle.CreateListenerResponse
+	(*FileExistsRequest)(nil),                  // 40: agents.oracle.FileExistsRequest
+	(*FileExistsResponse)(nil),                 // 41: agents.oracle.FileExistsResponse
+	(*PhysicalRestoreRequest)(nil),             // 42: agents.oracle.PhysicalRestoreRequest
+	(*PhysicalRestoreAsyncRequest)(nil),        // 43: agents.oracle.PhysicalRestoreAsyncRequest
+	(*DataPumpImportRequest)(nil),              // 44: agents.oracle.DataPumpImportRequest
+	(*DataPumpImportAsyncRequest)(nil),         // 45: agents.oracle.DataPumpImportAsyncRequest
+	(*DataPumpImportResponse)(nil),             // 46: agents.oracle.DataPumpImportResponse
+	(*DataPumpExportRequest)(nil),              // 47: agents.oracle.DataPumpExportRequest
+	(*DataPumpExportAsyncRequest)(nil),         // 48: agents.oracle.DataPumpExportAsyncRequest
+	(*DataPumpExportResponse)(nil),             // 49: agents.oracle.DataPumpExportResponse
+	(*RecoverConfigFileRequest)(nil),           // 50: agents.oracle.RecoverConfigFileRequest
+	(*RecoverConfigFileResponse)(nil),          // 51: agents.oracle.RecoverConfigFileResponse
+	(*DownloadDirectoryFromGCSRequest)(nil),    // 52: agents.oracle.DownloadDirectoryFromGCSRequest
+	(*DownloadDirectoryFromGCSResponse)(nil),   // 53: agents.oracle.DownloadDirectoryFromGCSResponse
+	(*FetchServiceImageMetaDataRequest)(nil),   // 54: agents.oracle.FetchServiceImageMetaDataRequest
+	(*FetchServiceImageMetaDataResponse)(nil),  // 55: agents.oracle.FetchServiceImageMetaDataResponse
+	(*ShipArchivedLogsRequest)(nil),            // 56: agents.oracle.ShipArchivedLogsRequest
+	(*ShipArchivedLogsResponse)(nil),           // 57: agents.oracle.ShipArchivedLogsResponse
+	(*ShipArchivedLogsAsyncRequest)(nil),       // 58: agents.oracle.ShipArchivedLogsAsyncRequest
+	(*DeleteBackupRequest)(nil),                // 59: agents.oracle.DeleteBackupRequest
+	(*DeleteBackupResponse)(nil),               // 60: agents.oracle.DeleteBackupResponse
+	(*RunDatapatchRequest)(nil),                // 61: agents.oracle.RunDatapatchRequest
+	(*RunDatapatchResponse)(nil),               // 62: agents.oracle.RunDatapatchResponse
+	(*GetAuditLogHeadRequest)(nil),             // 63: agents.oracle.GetAuditLogHeadRequest
+	(*GetAuditLogHeadResponse)(nil),            // 64: agents.oracle.GetAuditLogHeadResponse
+	(*ReadDirResponse_FileInfo)(nil),           // 65: agents.oracle.ReadDirResponse.FileInfo
+	nil,                                        // 66: agents.oracle.RunRMANRequest.StorageCredentialsEntry
+	nil,                                        // 67: agents.oracle.DataPumpImportRequest.StorageCredentialsEntry
+	nil,                                        // 68: agents.oracle.DataPumpExportRequest.StorageCredentialsEntry
+	nil,                                        // 69: agents.oracle.DownloadDirectoryFromGCSRequest.StorageCredentialsEntry
+	nil,                                        // 70: agents.oracle.ShipArchivedLogsRequest.StorageCredentialsEntry
+	nil,                                        // 71: agents.oracle.DeleteBackupRequest.StorageCredentialsEntry
+	(*timestamppb.Timestamp)(nil),              // 72: google.protobuf.Timestamp
+	(*BounceDatabaseRequest)(nil),              // 73: agents.oracle.BounceDatabaseRequest
+	(*BounceListenerRequest)(nil),              // 74: agents.oracle.BounceListenerRequest
+	(*longrunning.ListOperationsRequest)(nil),  // 75: google.longrunning.ListOperationsRequest
+	(*longrunning.GetOperationRequest)(nil),    // 76: google.longrunning.GetOperationRequest
+	(*longrunning.DeleteOperationRequest)(nil), // 77: google.longrunning.DeleteOperationRequest
+	(*longrunning.CancelOperationRequest)(nil), // 78: google.longrunning.CancelOperationRequest
+	(*BounceDatabaseResponse)(nil),             // 79: agents.oracle.BounceDatabaseResponse
+	(*BounceListenerResponse)(nil),             // 80: agents.oracle.BounceListenerResponse
+	(*longrunning.Operation)(nil),              // 81: google.longrunning.Operation
+	(*longrunning.ListOperationsResponse)(nil), // 82: google.longrunning.ListOperationsResponse
+	(*emptypb.Empty)(nil),                      // 83: google.protobuf.Empty
 }
 var file_oracle_pkg_agents_oracle_dbdaemon_proto_depIdxs = []int32{
-	52, // 0: agents.oracle.ReadDirResponse.currPath:type_name -> agents.oracle.ReadDirResponse.FileInfo
-	52, // 1: agents.oracle.ReadDirResponse.subPaths:type_name -> agents.oracle.ReadDirResponse.FileInfo
+	65, // 0: agents.oracle.ReadDirResponse.currPath:type_name -> agents.oracle.ReadDirResponse.FileInfo
+	65, // 1: agents.oracle.ReadDirResponse.subPaths:type_name -> agents.oracle.ReadDirResponse.FileInfo
 	8,  // 2: agents.oracle.RunSQLPlusCMDRequest.local:type_name -> agents.oracle.LocalConnection
-	18, // 3: agents.oracle.RunRMANAsyncRequest.sync_request:type_name -> agents.oracle.RunRMANRequest
-	19, // 4: agents.oracle.RunRMANAsyncRequest.lro_input:type_name -> agents.oracle.LROInput
-	0,  // 5: agents.oracle.GetDatabaseTypeResponse.database_type:type_name -> agents.oracle.GetDatabaseTypeResponse.DatabaseType
-	31, // 6: agents.oracle.CreateCDBAsyncRequest.sync_request:type_name -> agents.oracle.CreateCDBRequest
-	19, // 7: agents.oracle.CreateCDBAsyncRequest.lro_input:type_name -> agents.oracle.LROInput
-	38, // 8: agents.oracle.PhysicalRestoreAsyncRequest.sync_request:type_name -> agents.oracle.PhysicalRestoreRequest
-	19, // 9: agents.oracle.PhysicalRestoreAsyncRequest.lro_input:type_name -> agents.oracle.LROInput
-	40, // 10: agents.oracle.DataPumpImportAsyncRequest.sync_request:type_name -> agents.oracle.DataPumpImportRequest
-	19, // 11: agents.oracle.DataPumpImportAsyncRequest.lro_input:type_name -> agents.oracle.LROInput
-	43, // 12: agents.oracle.DataPumpExportAsyncRequest.sync_request:type_name -> agents.oracle.DataPumpExportRequest
-	19, // 13: agents.oracle.DataPumpExportAsyncRequest.lro_input:type_name -> agents.oracle.LROInput
-	53, // 14: agents.oracle.ReadDirResponse.FileInfo.modTime:type_name -> google.protobuf.Timestamp
-	1,  // 15: agents.oracle.DatabaseDaemon.CreateDir:input_type -> agents.oracle.CreateDirRequest
-	3,  // 16: agents.oracle.DatabaseDaemon.ReadDir:input_type -> agents.oracle.ReadDirRequest
-	5,  // 17: agents.oracle.DatabaseDaemon.DeleteDir:input_type -> agents.oracle.DeleteDirRequest
-	54, // 18: agents.oracle.DatabaseDaemon.BounceDatabase:input_type -> agents.oracle.BounceDatabaseRequest
-	55, // 19: agents.oracle.DatabaseDaemon.BounceListener:input_type -> agents.oracle.BounceListenerRequest
-	10, // 20: agents.oracle.DatabaseDaemon.CheckDatabaseState:input_type -> agents.oracle.CheckDatabaseStateRequest
-	9,  // 21: agents.oracle.DatabaseDaemon.RunSQLPlus:input_type -> agents.oracle.RunSQLPlusCMDRequest
-	9,  // 22: agents.oracle.DatabaseDaemon.RunSQLPlusFormatted:input_type -> agents.oracle.RunSQLPlusCMDRequest
-	16, // 23: agents.oracle.DatabaseDaemon.KnownPDBs:input_type -> agents.oracle.KnownPDBsRequest
-	18, // 24: agents.oracle.DatabaseDaemon.RunRMAN:input_type -> agents.oracle.RunRMANRequest
-	20, // 25: agents.oracle.DatabaseDaemon.RunRMANAsync:input_type -> agents.oracle.RunRMANAsyncRequest
-	22, // 26: agents.oracle.DatabaseDaemon.NID:input_type -> agents.oracle.NIDRequest
-	24, // 27: agents.oracle.DatabaseDaemon.GetDatabaseType:input_type -> agents.oracle.GetDatabaseTypeRequest
-	26, // 28: agents.oracle.DatabaseDaemon.GetDatabaseName:input_type -> agents.oracle.GetDatabaseNameRequest
-	12, // 29: agents.oracle.DatabaseDaemon.CreatePasswordFile:input_type -> agents.oracle.CreatePasswordFileRequest
-	14, // 30: agents.oracle.DatabaseDaemon.CreateReplicaInitOraFile:input_type -> agents.oracle.CreateReplicaInitOraFileRequest
-	28, // 31: agents.oracle.DatabaseDaemon.SetListenerRegistration:input_type -> agents.oracle.SetListenerRegistrationRequest
-	29, // 32: agents.oracle.DatabaseDaemon.BootstrapStandby:input_type -> agents.oracle.BootstrapStandbyRequest
-	31, // 33: agents.oracle.DatabaseDaemon.CreateCDB:input_type -> agents.oracle.CreateCDBRequest
-	32, // 34: agents.oracle.DatabaseDaemon.CreateCDBAsync:input_type -> agents.oracle.CreateCDBAsyncRequest
-	34, // 35: agents.oracle.DatabaseDaemon.CreateListener:input_type -> agents.oracle.CreateListenerRequest
-	36, // 36: agents.oracle.DatabaseDaemon.FileExists:input_type -> agents.oracle.FileExistsRequest
-	39, // 37: agents.oracle.DatabaseDaemon.PhysicalRestoreAsync:input_type -> agents.oracle.PhysicalRestoreAsyncRequest
-	41, // 38: agents.oracle.DatabaseDaemon.DataPumpImportAsync:input_type -> agents.oracle.DataPumpImportAsyncRequest
-	44, // 39: agents.oracle.DatabaseDaemon.DataPumpExportAsync:input_type -> agents.oracle.DataPumpExportAsyncRequest
-	56, // 40: agents.oracle.DatabaseDaemon.ListOperations:input_type -> google.longrunning.ListOperationsRequest
-	57, // 41: agents.oracle.DatabaseDaemon.GetOperation:input_type -> google.longrunning.GetOperationRequest
-	58, // 42: agents.oracle.DatabaseDaemon.DeleteOperation:input_type -> google.longrunning.DeleteOperationRequest
-	46, // 43: agents.oracle.DatabaseDaemon.RecoverConfigFile:input_type -> agents.oracle.RecoverConfigFileRequest
-	48, // 44: agents.oracle.DatabaseDaemon.DownloadDirectoryFromGCS:input_type -> agents.oracle.DownloadDirectoryFromGCSRequest
-	50, // 45: agents.oracle.DatabaseDaemon.FetchServiceImageMetaData:input_type -> agents.oracle.FetchServiceImageMetaDataRequest
-	2,  // 46: agents.oracle.DatabaseDaemon.CreateDir:output_type -> agents.oracle.CreateDirResponse
-	4,  // 47: agents.oracle.DatabaseDaemon.ReadDir:output_type -> agents.oracle.ReadDirResponse
-	6,  // 48: agents.oracle.DatabaseDaemon.DeleteDir:output_type -> agents.oracle.DeleteDirResponse
-	59, // 49: agents.oracle.DatabaseDaemon.BounceDatabase:output_type -> agents.oracle.BounceDatabaseResponse
-	60, // 50: agents.oracle.DatabaseDaemon.BounceListener:output_type -> agents.oracle.BounceListenerResponse
-	11, // 51: agents.oracle.DatabaseDaemon.CheckDatabaseState:output_type -> agents.oracle.CheckDatabaseStateResponse
-	7,  // 52: agents.oracle.DatabaseDaemon.RunSQLPlus:output_type -> agents.oracle.RunCMDResponse
-	7,  // 53: agents.oracle.DatabaseDaemon.RunSQLPlusFormatted:output_type -> agents.oracle.RunCMDResponse
-	17, // 54: agents.oracle.DatabaseDaemon.KnownPDBs:output_type -> agents.oracle.KnownPDBsResponse
-	21, // 55: agents.oracle.DatabaseDaemon.RunRMAN:output_type -> agents.oracle.RunRMANResponse
-	61, // 56: agents.oracle.DatabaseDaemon.RunRMANAsync:output_type -> google.longrunning.Operation
-	23, // 57: agents.oracle.DatabaseDaemon.NID:output_type -> agents.oracle.NIDResponse
-	25, // 58: agents.oracle.DatabaseDaemon.GetDatabaseType:output_type -> agents.oracle.GetDatabaseTypeResponse
-	27, // 59: agents.oracle.DatabaseDaemon.GetDatabaseName:output_type -> agents.oracle.GetDatabaseNameResponse
-	13, // 60: agents.oracle.DatabaseDaemon.CreatePasswordFile:output_type -> agents.oracle.CreatePasswordFileResponse
-	15, // 61: agents.oracle.DatabaseDaemon.CreateReplicaInitOraFile:output_type -> agents.oracle.CreateReplicaInitOraFileResponse
-	60, // 62: agents.oracle.DatabaseDaemon.SetListenerRegistration:output_type -> agents.oracle.BounceListenerResponse
-	30, // 63: agents.oracle.DatabaseDaemon.BootstrapStandby:output_type -> agents.oracle.BootstrapStandbyResponse
-	33, // 64: agents.oracle.DatabaseDaemon.CreateCDB:output_type -> agents.oracle.CreateCDBResponse
-	61, // 65: agents.oracle.DatabaseDaemon.CreateCDBAsync:output_type -> google.longrunning.Operation
-	35, // 66: agents.oracle.DatabaseDaemon.CreateListener:output_type -> agents.oracle.CreateListenerResponse
-	37, // 67: agents.oracle.DatabaseDaemon.FileExists:output_type -> agents.oracle.FileExistsResponse
-	61, // 68: agents.oracle.DatabaseDaemon.PhysicalRestoreAsync:output_type -> google.longrunning.Operation
-	61, // 69: agents.oracle.DatabaseDaemon.DataPumpImportAsync:output_type -> google.longrunning.Operation
-	61, // 70: agents.oracle.DatabaseDaemon.DataPumpExportAsync:output_type -> google.longrunning.Operation
-	62, // 71: agents.oracle.DatabaseDaemon.ListOperations:output_type -> google.longrunning.ListOperationsResponse
-	61, // 72: agents.oracle.DatabaseDaemon.GetOperation:output_type -> google.longrunning.Operation
-	63, // 73: agents.oracle.DatabaseDaemon.DeleteOperation:output_type -> google.protobuf.Empty
-	47, // 74: agents.oracle.DatabaseDaemon.RecoverConfigFile:output_type -> agents.oracle.RecoverConfigFileResponse
-	49, // 75: agents.oracle.DatabaseDaemon.DownloadDirectoryFromGCS:output_type -> agents.oracle.DownloadDirectoryFromGCSResponse
-	51, // 76: agents.oracle.DatabaseDaemon.FetchServiceImageMetaData:output_type -> agents.oracle.FetchServiceImageMetaDataResponse
-	46, // [46:77] is the sub-list for method output_type
-	15, // [15:46] is the sub-list for method input_type
-	15, // [15:15] is the sub-list for extension type_name
-	15, // [15:15] is the sub-list for extension extendee
-	0,  // [0:15] is the sub-list for field type_name
+	66, // 3: agents.oracle.RunRMANRequest.storage_credentials:type_name -> agents.oracle.RunRMANRequest.StorageCredentialsEntry
+	72, // 4: agents.oracle.OperationProgress.estimated_completion_time:type_name -> google.protobuf.Timestamp
+	72, // 5: agents.oracle.OperationProgress.update_time:type_name -> google.protobuf.Timestamp
+	18, // 6: agents.oracle.RunRMANAsyncRequest.sync_request:type_name -> agents.oracle.RunRMANRequest
+	19, // 7: agents.oracle.RunRMANAsyncRequest.lro_input:type_name -> agents.oracle.LROInput
+	25, // 8: agents.oracle.ChangeDatabaseIdentityAsyncRequest.sync_request:type_name -> agents.oracle.ChangeDatabaseIdentityRequest
+	19, // 9: agents.oracle.ChangeDatabaseIdentityAsyncRequest.lro_input:type_name -> agents.oracle.LROInput
+	0,  // 10: agents.oracle.GetDatabaseTypeResponse.database_type:type_name -> agents.oracle.GetDatabaseTypeResponse.DatabaseType
+	35, // 11: agents.oracle.CreateCDBAsyncRequest.sync_request:type_name -> agents.oracle.CreateCDBRequest
+	19, // 12: agents.oracle.CreateCDBAsyncRequest.lro_input:type_name -> agents.oracle.LROInput
+	72, // 13: agents.oracle.PhysicalRestoreRequest.until_time:type_name -> google.protobuf.Timestamp
+	42, // 14: agents.oracle.PhysicalRestoreAsyncRequest.sync_request:type_name -> agents.oracle.PhysicalRestoreRequest
+	19, // 15: agents.oracle.PhysicalRestoreAsyncRequest.lro_input:type_name -> agents.oracle.LROInput
+	67, // 16: agents.oracle.DataPumpImportRequest.storage_credentials:type_name -> agents.oracle.DataPumpImportRequest.StorageCredentialsEntry
+	44, // 17: agents.oracle.DataPumpImportAsyncRequest.sync_request:type_name -> agents.oracle.DataPumpImportRequest
+	19, // 18: agents.oracle.DataPumpImportAsyncRequest.lro_input:type_name -> agents.oracle.LROInput
+	68, // 19: agents.oracle.DataPumpExportRequest.storage_credentials:type_name -> agents.oracle.DataPumpExportRequest.StorageCredentialsEntry
+	47, // 20: agents.oracle.DataPumpExportAsyncRequest.sync_request:type_name -> agents.oracle.DataPumpExportRequest
+	19, // 21: agents.oracle.DataPumpExportAsyncRequest.lro_input:type_name -> agents.oracle.LROInput
+	69, // 22: agents.oracle.DownloadDirectoryFromGCSRequest.storage_credentials:type_name -> agents.oracle.DownloadDirectoryFromGCSRequest.StorageCredentialsEntry
+	70, // 23: agents.oracle.ShipArchivedLogsRequest.storage_credentials:type_name -> agents.oracle.ShipArchivedLogsRequest.StorageCredentialsEntry
+	72, // 24: agents.oracle.ShipArchivedLogsResponse.last_time:type_name -> google.protobuf.Timestamp
+	56, // 25: agents.oracle.ShipArchivedLogsAsyncRequest.sync_request:type_name -> agents.oracle.ShipArchivedLogsRequest
+	19, // 26: agents.oracle.ShipArchivedLogsAsyncRequest.lro_input:type_name -> agents.oracle.LROInput
+	71, // 27: agents.oracle.DeleteBackupRequest.storage_credentials:type_name -> agents.oracle.DeleteBackupRequest.StorageCredentialsEntry
+	72, // 28: agents.oracle.ReadDirResponse.FileInfo.modTime:type_name -> google.protobuf.Timestamp
+	1,  // 29: agents.oracle.DatabaseDaemon.CreateDir:input_type -> agents.oracle.CreateDirRequest
+	3,  // 30: agents.oracle.DatabaseDaemon.ReadDir:input_type -> agents.oracle.ReadDirRequest
+	5,  // 31: agents.oracle.DatabaseDaemon.DeleteDir:input_type -> agents.oracle.DeleteDirRequest
+	73, // 32: agents.oracle.DatabaseDaemon.BounceDatabase:input_type -> agents.oracle.BounceDatabaseRequest
+	74, // 33: agents.oracle.DatabaseDaemon.BounceListener:input_type -> agents.oracle.BounceListenerRequest
+	10, // 34: agents.oracle.DatabaseDaemon.CheckDatabaseState:input_type -> agents.oracle.CheckDatabaseStateRequest
+	9,  // 35: agents.oracle.DatabaseDaemon.RunSQLPlus:input_type -> agents.oracle.RunSQLPlusCMDRequest
+	9,  // 36: agents.oracle.DatabaseDaemon.RunSQLPlusFormatted:input_type -> agents.oracle.RunSQLPlusCMDRequest
+	16, // 37: agents.oracle.DatabaseDaemon.KnownPDBs:input_type -> agents.oracle.KnownPDBsRequest
+	18, // 38: agents.oracle.DatabaseDaemon.RunRMAN:input_type -> agents.oracle.RunRMANRequest
+	21, // 39: agents.oracle.DatabaseDaemon.RunRMANAsync:input_type -> agents.oracle.RunRMANAsyncRequest
+	23, // 40: agents.oracle.DatabaseDaemon.NID:input_type -> agents.oracle.NIDRequest
+	27, // 41: agents.oracle.DatabaseDaemon.ChangeDatabaseIdentityAsync:input_type -> agents.oracle.ChangeDatabaseIdentityAsyncRequest
+	28, // 42: agents.oracle.DatabaseDaemon.GetDatabaseType:input_type -> agents.oracle.GetDatabaseTypeRequest
+	30, // 43: agents.oracle.DatabaseDaemon.GetDatabaseName:input_type -> agents.oracle.GetDatabaseNameRequest
+	12, // 44: agents.oracle.DatabaseDaemon.CreatePasswordFile:input_type -> agents.oracle.CreatePasswordFileRequest
+	14, // 45: agents.oracle.DatabaseDaemon.CreateReplicaInitOraFile:input_type -> agents.oracle.CreateReplicaInitOraFileRequest
+	32, // 46: agents.oracle.DatabaseDaemon.SetListenerRegistration:input_type -> agents.oracle.SetListenerRegistrationRequest
+	33, // 47: agents.oracle.DatabaseDaemon.BootstrapStandby:input_type -> agents.oracle.BootstrapStandbyRequest
+	35, // 48: agents.oracle.DatabaseDaemon.CreateCDB:input_type -> agents.oracle.CreateCDBRequest
+	36, // 49: agents.oracle.DatabaseDaemon.CreateCDBAsync:input_type -> agents.oracle.CreateCDBAsyncRequest
+	38, // 50: agents.oracle.DatabaseDaemon.CreateListener:input_type -> agents.oracle.CreateListenerRequest
+	40, // 51: agents.oracle.DatabaseDaemon.FileExists:input_type -> agents.oracle.FileExistsRequest
+	43, // 52: agents.oracle.DatabaseDaemon.PhysicalRestoreAsync:input_type -> agents.oracle.PhysicalRestoreAsyncRequest
+	45, // 53: agents.oracle.DatabaseDaemon.DataPumpImportAsync:input_type -> agents.oracle.DataPumpImportAsyncRequest
+	48, // 54: agents.oracle.DatabaseDaemon.DataPumpExportAsync:input_type -> agents.oracle.DataPumpExportAsyncRequest
+	75, // 55: agents.oracle.DatabaseDaemon.ListOperations:input_type -> google.longrunning.ListOperationsRequest
+	76, // 56: agents.oracle.DatabaseDaemon.GetOperation:input_type -> google.longrunning.GetOperationRequest
+	77, // 57: agents.oracle.DatabaseDaemon.DeleteOperation:input_type -> google.longrunning.DeleteOperationRequest
+	78, // 58: agents.oracle.DatabaseDaemon.CancelOperation:input_type -> google.longrunning.CancelOperationRequest
+	50, // 59: agents.oracle.DatabaseDaemon.RecoverConfigFile:input_type -> agents.oracle.RecoverConfigFileRequest
+	52, // 60: agents.oracle.DatabaseDaemon.DownloadDirectoryFromGCS:input_type -> agents.oracle.DownloadDirectoryFromGCSRequest
+	54, // 61: agents.oracle.DatabaseDaemon.FetchServiceImageMetaData:input_type -> agents.oracle.FetchServiceImageMetaDataRequest
+	56, // 62: agents.oracle.DatabaseDaemon.ShipArchivedLogs:input_type -> agents.oracle.ShipArchivedLogsRequest
+	58, // 63: agents.oracle.DatabaseDaemon.ShipArchivedLogsAsync:input_type -> agents.oracle.ShipArchivedLogsAsyncRequest
+	59, // 64: agents.oracle.DatabaseDaemon.DeleteBackup:input_type -> agents.oracle.DeleteBackupRequest
+	61, // 65: agents.oracle.DatabaseDaemon.RunDatapatch:input_type -> agents.oracle.RunDatapatchRequest
+	63, // 66: agents.oracle.DatabaseDaemon.GetAuditLogHead:input_type -> agents.oracle.GetAuditLogHeadRequest
+	2,  // 67: agents.oracle.DatabaseDaemon.CreateDir:output_type -> agents.oracle.CreateDirResponse
+	4,  // 68: agents.oracle.DatabaseDaemon.ReadDir:output_type -> agents.oracle.ReadDirResponse
+	6,  // 69: agents.oracle.DatabaseDaemon.DeleteDir:output_type -> agents.oracle.DeleteDirResponse
+	79, // 70: agents.oracle.DatabaseDaemon.BounceDatabase:output_type -> agents.oracle.BounceDatabaseResponse
+	80, // 71: agents.oracle.DatabaseDaemon.BounceListener:output_type -> agents.oracle.BounceListenerResponse
+	11, // 72: agents.oracle.DatabaseDaemon.CheckDatabaseState:output_type -> agents.oracle.CheckDatabaseStateResponse
+	7,  // 73: agents.oracle.DatabaseDaemon.RunSQLPlus:output_type -> agents.oracle.RunCMDResponse
+	7,  // 74: agents.oracle.DatabaseDaemon.RunSQLPlusFormatted:output_type -> agents.oracle.RunCMDResponse
+	17, // 75: agents.oracle.DatabaseDaemon.KnownPDBs:output_type -> agents.oracle.KnownPDBsResponse
+	22, // 76: agents.oracle.DatabaseDaemon.RunRMAN:output_type -> agents.oracle.RunRMANResponse
+	81, // 77: agents.oracle.DatabaseDaemon.RunRMANAsync:output_type -> google.longrunning.Operation
+	24, // 78: agents.oracle.DatabaseDaemon.NID:output_type -> agents.oracle.NIDResponse
+	81, // 79: agents.oracle.DatabaseDaemon.ChangeDatabaseIdentityAsync:output_type -> google.longrunning.Operation
+	29, // 80: agents.oracle.DatabaseDaemon.GetDatabaseType:output_type -> agents.oracle.GetDatabaseTypeResponse
+	31, // 81: agents.oracle.DatabaseDaemon.GetDatabaseName:output_type -> agents.oracle.GetDatabaseNameResponse
+	13, // 82: agents.oracle.DatabaseDaemon.CreatePasswordFile:output_type -> agents.oracle.CreatePasswordFileResponse
+	15, // 83: agents.oracle.DatabaseDaemon.CreateReplicaInitOraFile:output_type -> agents.oracle.CreateReplicaInitOraFileResponse
+	80, // 84: agents.oracle.DatabaseDaemon.SetListenerRegistration:output_type -> agents.oracle.BounceListenerResponse
+	34, // 85: agents.oracle.DatabaseDaemon.BootstrapStandby:output_type -> agents.oracle.BootstrapStandbyResponse
+	37, // 86: agents.oracle.DatabaseDaemon.CreateCDB:output_type -> agents.oracle.CreateCDBResponse
+	81, // 87: agents.oracle.DatabaseDaemon.CreateCDBAsync:output_type -> google.longrunning.Operation
+	39, // 88: agents.oracle.DatabaseDaemon.CreateListener:output_type -> agents.oracle.CreateListenerResponse
+	41, // 89: agents.oracle.DatabaseDaemon.FileExists:output_type -> agents.oracle.FileExistsResponse
+	81, // 90: agents.oracle.DatabaseDaemon.PhysicalRestoreAsync:output_type -> google.longrunning.Operation
+	81, // 91: agents.oracle.DatabaseDaemon.DataPumpImportAsync:output_type -> google.longrunning.Operation
+	81, // 92: agents.oracle.DatabaseDaemon.DataPumpExportAsync:output_type -> google.longrunning.Operation
+	82, // 93: agents.oracle.DatabaseDaemon.ListOperations:output_type -> google.longrunning.ListOperationsResponse
+	81, // 94: agents.oracle.DatabaseDaemon.GetOperation:output_type -> google.longrunning.Operation
+	83, // 95: agents.oracle.DatabaseDaemon.DeleteOperation:output_type -> google.protobuf.Empty
+	83, // 96: agents.oracle.DatabaseDaemon.CancelOperation:output_type -> google.protobuf.Empty
+	51, // 97: agents.oracle.DatabaseDaemon.RecoverConfigFile:output_type -> agents.oracle.RecoverConfigFileResponse
+	53, // 98: agents.oracle.DatabaseDaemon.DownloadDirectoryFromGCS:output_type -> agents.oracle.DownloadDirectoryFromGCSResponse
+	55, // 99: agents.oracle.DatabaseDaemon.FetchServiceImageMetaData:output_type -> agents.oracle.FetchServiceImageMetaDataResponse
+	57, // 100: agents.oracle.DatabaseDaemon.ShipArchivedLogs:output_type -> agents.oracle.ShipArchivedLogsResponse
+	81, // 101: agents.oracle.DatabaseDaemon.ShipArchivedLogsAsync:output_type -> google.longrunning.Operation
+	60, // 102: agents.oracle.DatabaseDaemon.DeleteBackup:output_type -> agents.oracle.DeleteBackupResponse
+	62, // 103: agents.oracle.DatabaseDaemon.RunDatapatch:output_type -> agents.oracle.RunDatapatchResponse
+	64, // 104: agents.oracle.DatabaseDaemon.GetAuditLogHead:output_type -> agents.oracle.GetAuditLogHeadResponse
+	67, // [67:105] is the sub-list for method output_type
+	29, // [29:67] is the sub-list for method input_type
+	29, // [29:29] is the sub-list for extension type_name
+	29, // [29:29] is the sub-list for extension extendee
+	0,  // [0:29] is the sub-list for field type_name
 }
 
 func init() { file_oracle_pkg_agents_oracle_dbdaemon_proto_init() }
@@ -3967,7 +5083,7 @@ func file_oracle_pkg_agents_oracle_dbdaemon_proto_init() {
 			}
 		}
 		file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RunRMANAsyncRequest); i {
+			switch v := v.(*OperationProgress); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3979,7 +5095,7 @@ func file_oracle_pkg_agents_oracle_dbdaemon_proto_init() {
 			}
 		}
 		file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RunRMANResponse); i {
+			switch v := v.(*RunRMANAsyncRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3991,7 +5107,7 @@ func file_oracle_pkg_agents_oracle_dbdaemon_proto_init() {
 			}
 		}
 		file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NIDRequest); i {
+			switch v := v.(*RunRMANResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4003,7 +5119,7 @@ func file_oracle_pkg_agents_oracle_dbdaemon_proto_init() {
 			}
 		}
 		file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NIDResponse); i {
+			switch v := v.(*NIDRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4015,7 +5131,7 @@ func file_oracle_pkg_agents_oracle_dbdaemon_proto_init() {
 			}
 		}
 		file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetDatabaseTypeRequest); i {
+			switch v := v.(*NIDResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4027,7 +5143,7 @@ func file_oracle_pkg_agents_oracle_dbdaemon_proto_init() {
 			}
 		}
 		file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetDatabaseTypeResponse); i {
+			switch v := v.(*ChangeDatabaseIdentityRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4039,7 +5155,7 @@ func file_oracle_pkg_agents_oracle_dbdaemon_proto_init() {
 			}
 		}
 		file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetDatabaseNameRequest); i {
+			switch v := v.(*ChangeDatabaseIdentityResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4051,7 +5167,7 @@ func file_oracle_pkg_agents_oracle_dbdaemon_proto_init() {
 			}
 		}
 		file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetDatabaseNameResponse); i {
+			switch v := v.(*ChangeDatabaseIdentityAsyncRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4063,7 +5179,7 @@ func file_oracle_pkg_agents_oracle_dbdaemon_proto_init() {
 			}
 		}
 		file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetListenerRegistrationRequest); i {
+			switch v := v.(*GetDatabaseTypeRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4075,7 +5191,7 @@ func file_oracle_pkg_agents_oracle_dbdaemon_proto_init() {
 			}
 		}
 		file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BootstrapStandbyRequest); i {
+			switch v := v.(*GetDatabaseTypeResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4087,7 +5203,7 @@ func file_oracle_pkg_agents_oracle_dbdaemon_proto_init() {
 			}
 		}
 		file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BootstrapStandbyResponse); i {
+			switch v := v.(*GetDatabaseNameRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4099,7 +5215,7 @@ func file_oracle_pkg_agents_oracle_dbdaemon_proto_init() {
 			}
 		}
 		file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateCDBRequest); i {
+			switch v := v.(*GetDatabaseNameResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4111,7 +5227,7 @@ func file_oracle_pkg_agents_oracle_dbdaemon_proto_init() {
 			}
 		}
 		file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateCDBAsyncRequest); i {
+			switch v := v.(*SetListenerRegistrationRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4123,7 +5239,7 @@ func file_oracle_pkg_agents_oracle_dbdaemon_proto_init() {
 			}
 		}
 		file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateCDBResponse); i {
+			switch v := v.(*BootstrapStandbyRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4135,7 +5251,7 @@ func file_oracle_pkg_agents_oracle_dbdaemon_proto_init() {
 			}
 		}
 		file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateListenerRequest); i {
+			switch v := v.(*BootstrapStandbyResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4147,7 +5263,7 @@ func file_oracle_pkg_agents_oracle_dbdaemon_proto_init() {
 			}
 		}
 		file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateListenerResponse); i {
+			switch v := v.(*CreateCDBRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4159,7 +5275,7 @@ func file_oracle_pkg_agents_oracle_dbdaemon_proto_init() {
 			}
 		}
 		file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FileExistsRequest); i {
+			switch v := v.(*CreateCDBAsyncRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4171,7 +5287,7 @@ func file_oracle_pkg_agents_oracle_dbdaemon_proto_init() {
 			}
 		}
 		file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FileExistsResponse); i {
+			switch v := v.(*CreateCDBResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4183,7 +5299,7 @@ func file_oracle_pkg_agents_oracle_dbdaemon_proto_init() {
 			}
 		}
 		file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PhysicalRestoreRequest); i {
+			switch v := v.(*CreateListenerRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4195,7 +5311,7 @@ func file_oracle_pkg_agents_oracle_dbdaemon_proto_init() {
 			}
 		}
 		file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PhysicalRestoreAsyncRequest); i {
+			switch v := v.(*CreateListenerResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4207,7 +5323,7 @@ func file_oracle_pkg_agents_oracle_dbdaemon_proto_init() {
 			}
 		}
 		file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DataPumpImportRequest); i {
+			switch v := v.(*FileExistsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4219,7 +5335,7 @@ func file_oracle_pkg_agents_oracle_dbdaemon_proto_init() {
 			}
 		}
 		file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DataPumpImportAsyncRequest); i {
+			switch v := v.(*FileExistsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4231,7 +5347,7 @@ func file_oracle_pkg_agents_oracle_dbdaemon_proto_init() {
 			}
 		}
 		file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DataPumpImportResponse); i {
+			switch v := v.(*PhysicalRestoreRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4243,7 +5359,7 @@ func file_oracle_pkg_agents_oracle_dbdaemon_proto_init() {
 			}
 		}
 		file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DataPumpExportRequest); i {
+			switch v := v.(*PhysicalRestoreAsyncRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4255,7 +5371,7 @@ func file_oracle_pkg_agents_oracle_dbdaemon_proto_init() {
 			}
 		}
 		file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DataPumpExportAsyncRequest); i {
+			switch v := v.(*DataPumpImportRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4267,7 +5383,7 @@ func file_oracle_pkg_agents_oracle_dbdaemon_proto_init() {
 			}
 		}
 		file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DataPumpExportResponse); i {
+			switch v := v.(*DataPumpImportAsyncRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4279,7 +5395,7 @@ func file_oracle_pkg_agents_oracle_dbdaemon_proto_init() {
 			}
 		}
 		file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RecoverConfigFileRequest); i {
+			switch v := v.(*DataPumpImportResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4291,7 +5407,7 @@ func file_oracle_pkg_agents_oracle_dbdaemon_proto_init() {
 			}
 		}
 		file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RecoverConfigFileResponse); i {
+			switch v := v.(*DataPumpExportRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4303,7 +5419,7 @@ func file_oracle_pkg_agents_oracle_dbdaemon_proto_init() {
 			}
 		}
 		file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DownloadDirectoryFromGCSRequest); i {
+			switch v := v.(*DataPumpExportAsyncRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4315,7 +5431,7 @@ func file_oracle_pkg_agents_oracle_dbdaemon_proto_init() {
 			}
 		}
 		file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DownloadDirectoryFromGCSResponse); i {
+			switch v := v.(*DataPumpExportResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4327,7 +5443,7 @@ func file_oracle_pkg_agents_oracle_dbdaemon_proto_init() {
 			}
 		}
 		file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FetchServiceImageMetaDataRequest); i {
+			switch v := v.(*RecoverConfigFileRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4339,7 +5455,7 @@ func file_oracle_pkg_agents_oracle_dbdaemon_proto_init() {
 			}
 		}
 		file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FetchServiceImageMetaDataResponse); i {
+			switch v := v.(*RecoverConfigFileResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4351,6 +5467,162 @@ func file_oracle_pkg_agents_oracle_dbdaemon_proto_init() {
 			}
 		}
 		file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DownloadDirectoryFromGCSRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DownloadDirectoryFromGCSResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FetchServiceImageMetaDataRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FetchServiceImageMetaDataResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ShipArchivedLogsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ShipArchivedLogsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ShipArchivedLogsAsyncRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteBackupRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteBackupResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RunDatapatchRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RunDatapatchResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAuditLogHeadRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAuditLogHeadResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_oracle_pkg_agents_oracle_dbdaemon_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ReadDirResponse_FileInfo); i {
 			case 0:
 				return &v.state
@@ -4374,7 +5646,7 @@ func file_oracle_pkg_agents_oracle_dbdaemon_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_oracle_pkg_agents_oracle_dbdaemon_proto_rawDesc,
 			NumEnums:      1,
-			NumMessages:   52,
+			NumMessages:   71,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -46,6 +46,9 @@ type DatabaseDaemonClient interface {
 	RunRMANAsync(ctx context.Context, in *RunRMANAsyncRequest, opts ...grpc.CallOption) (*longrunning.Operation, error)
 	// NID changes a database id and/or database name.
 	NID(ctx context.Context, in *NIDRequest, opts ...grpc.CallOption) (*NIDResponse, error)
+	// ChangeDatabaseIdentityAsync gives a mounted database a new DBID with NID,
+	// renames it if requested and opens it with resetlogs asynchronously.
+	ChangeDatabaseIdentityAsync(ctx context.Context, in *ChangeDatabaseIdentityAsyncRequest, opts ...grpc.CallOption) (*longrunning.Operation, error)
 	// GetDatabaseType returns database type(eg. ORACLE_12_2_ENTERPRISE_NONCDB)
 	GetDatabaseType(ctx context.Context, in *GetDatabaseTypeRequest, opts ...grpc.CallOption) (*GetDatabaseTypeResponse, error)
 	// GetDatabaseName returns database name.
@@ -89,6 +92,10 @@ type DatabaseDaemonClient interface {
 	// that the client is no longer interested in the operation result. It does
 	// not cancel the operation.
 	DeleteOperation(ctx context.Context, in *longrunning.DeleteOperationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// CancelOperation starts the cancellation of a long-running operation. The
+	// child process of the operation is terminated and the operation completes
+	// with a CANCELLED error.
+	CancelOperation(ctx context.Context, in *longrunning.CancelOperationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// RecoverConfigFile creates a binary pfile from the backed up spfile
 	RecoverConfigFile(ctx context.Context, in *RecoverConfigFileRequest, opts ...grpc.CallOption) (*RecoverConfigFileResponse, error)
 	// DownloadDirectoryFromGCS downloads a directory from GCS bucket to local
@@ -96,6 +103,20 @@ type DatabaseDaemonClient interface {
 	DownloadDirectoryFromGCS(ctx context.Context, in *DownloadDirectoryFromGCSRequest, opts ...grpc.CallOption) (*DownloadDirectoryFromGCSResponse, error)
 	// FetchServiceImageMetaData returns the service image metadata.
 	FetchServiceImageMetaData(ctx context.Context, in *FetchServiceImageMetaDataRequest, opts ...grpc.CallOption) (*FetchServiceImageMetaDataResponse, error)
+	// ShipArchivedLogs uploads archived redo logs generated since the last
+	// shipped sequence to a storage location.
+	ShipArchivedLogs(ctx context.Context, in *ShipArchivedLogsRequest, opts ...grpc.CallOption) (*ShipArchivedLogsResponse, error)
+	// ShipArchivedLogsAsync turns ShipArchivedLogs into an async call.
+	ShipArchivedLogsAsync(ctx context.Context, in *ShipArchivedLogsAsyncRequest, opts ...grpc.CallOption) (*longrunning.Operation, error)
+	// DeleteBackup deletes the RMAN backup pieces with a given tag, including
+	// the copies uploaded to a storage location.
+	DeleteBackup(ctx context.Context, in *DeleteBackupRequest, opts ...grpc.CallOption) (*DeleteBackupResponse, error)
+	// RunDatapatch opens all the PDBs and runs datapatch to apply the SQL
+	// changes of the patches installed in the Oracle home.
+	RunDatapatch(ctx context.Context, in *RunDatapatchRequest, opts ...grpc.CallOption) (*RunDatapatchResponse, error)
+	// GetAuditLogHead returns the sequence number and the hash of the last
+	// entry of the audit log, which the operator anchors outside of the Pod.
+	GetAuditLogHead(ctx context.Context, in *GetAuditLogHeadRequest, opts ...grpc.CallOption) (*GetAuditLogHeadResponse, error)
 }
 
 type databaseDaemonClient struct {
@@ -214,6 +235,15 @@ func (c *databaseDaemonClient) NID(ctx context.Context, in *NIDRequest, opts ...
 	return out, nil
 }
 
+func (c *databaseDaemonClient) ChangeDatabaseIdentityAsync(ctx context.Context, in *ChangeDatabaseIdentityAsyncRequest, opts ...grpc.CallOption) (*longrunning.Operation, error) {
+	out := new(longrunning.Operation)
+	err := c.cc.Invoke(ctx, "/agents.oracle.DatabaseDaemon/ChangeDatabaseIdentityAsync", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *databaseDaemonClient) GetDatabaseType(ctx context.Context, in *GetDatabaseTypeRequest, opts ...grpc.CallOption) (*GetDatabaseTypeResponse, error) {
 	out := new(GetDatabaseTypeResponse)
 	err := c.cc.Invoke(ctx, "/agents.oracle.DatabaseDaemon/GetDatabaseType", in, out, opts...)
@@ -358,6 +388,15 @@ func (c *databaseDaemonClient) DeleteOperation(ctx context.Context, in *longrunn
 	return out, nil
 }
 
+func (c *databaseDaemonClient) CancelOperation(ctx context.Context, in *longrunning.CancelOperationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/agents.oracle.DatabaseDaemon/CancelOperation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *databaseDaemonClient) RecoverConfigFile(ctx context.Context, in *RecoverConfigFileRequest, opts ...grpc.CallOption) (*RecoverConfigFileResponse, error) {
 	out := new(RecoverConfigFileResponse)
 	err := c.cc.Invoke(ctx, "/agents.oracle.DatabaseDaemon/RecoverConfigFile", in, out, opts...)
@@ -385,6 +424,51 @@ func (c *databaseDaemonClient) FetchServiceImageMetaData(ctx context.Context, in
 	return out, nil
 }
 
+func (c *databaseDaemonClient) ShipArchivedLogs(ctx context.Context, in *ShipArchivedLogsRequest, opts ...grpc.CallOption) (*ShipArchivedLogsResponse, error) {
+	out := new(ShipArchivedLogsResponse)
+	err := c.cc.Invoke(ctx, "/agents.oracle.DatabaseDaemon/ShipArchivedLogs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *databaseDaemonClient) ShipArchivedLogsAsync(ctx context.Context, in *ShipArchivedLogsAsyncRequest, opts ...grpc.CallOption) (*longrunning.Operation, error) {
+	out := new(longrunning.Operation)
+	err := c.cc.Invoke(ctx, "/agents.oracle.DatabaseDaemon/ShipArchivedLogsAsync", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *databaseDaemonClient) DeleteBackup(ctx context.Context, in *DeleteBackupRequest, opts ...grpc.CallOption) (*DeleteBackupResponse, error) {
+	out := new(DeleteBackupResponse)
+	err := c.cc.Invoke(ctx, "/agents.oracle.DatabaseDaemon/DeleteBackup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *databaseDaemonClient) RunDatapatch(ctx context.Context, in *RunDatapatchRequest, opts ...grpc.CallOption) (*RunDatapatchResponse, error) {
+	out := new(RunDatapatchResponse)
+	err := c.cc.Invoke(ctx, "/agents.oracle.DatabaseDaemon/RunDatapatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *databaseDaemonClient) GetAuditLogHead(ctx context.Context, in *GetAuditLogHeadRequest, opts ...grpc.CallOption) (*GetAuditLogHeadResponse, error) {
+	out := new(GetAuditLogHeadResponse)
+	err := c.cc.Invoke(ctx, "/agents.oracle.DatabaseDaemon/GetAuditLogHead", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DatabaseDaemonServer is the server API for DatabaseDaemon service.
 // All implementations must embed UnimplementedDatabaseDaemonServer
 // for forward compatibility
@@ -415,6 +499,9 @@ type DatabaseDaemonServer interface {
 	RunRMANAsync(context.Context, *RunRMANAsyncRequest) (*longrunning.Operation, error)
 	// NID changes a database id and/or database name.
 	NID(context.Context, *NIDRequest) (*NIDResponse, error)
+	// ChangeDatabaseIdentityAsync gives a mounted database a new DBID with NID,
+	// renames it if requested and opens it with resetlogs asynchronously.
+	ChangeDatabaseIdentityAsync(context.Context, *ChangeDatabaseIdentityAsyncRequest) (*longrunning.Operation, error)
 	// GetDatabaseType returns database type(eg. ORACLE_12_2_ENTERPRISE_NONCDB)
 	GetDatabaseType(context.Context, *GetDatabaseTypeRequest) (*GetDatabaseTypeResponse, error)
 	// GetDatabaseName returns database name.
@@ -458,6 +545,10 @@ type DatabaseDaemonServer interface {
 	// that the client is no longer interested in the operation result. It does
 	// not cancel the operation.
 	DeleteOperation(context.Context, *longrunning.DeleteOperationRequest) (*emptypb.Empty, error)
+	// CancelOperation starts the cancellation of a long-running operation. The
+	// child process of the operation is terminated and the operation completes
+	// with a CANCELLED error.
+	CancelOperation(context.Context, *longrunning.CancelOperationRequest) (*emptypb.Empty, error)
 	// RecoverConfigFile creates a binary pfile from the backed up spfile
 	RecoverConfigFile(context.Context, *RecoverConfigFileRequest) (*RecoverConfigFileResponse, error)
 	// DownloadDirectoryFromGCS downloads a directory from GCS bucket to local
@@ -465,6 +556,20 @@ type DatabaseDaemonServer interface {
 	DownloadDirectoryFromGCS(context.Context, *DownloadDirectoryFromGCSRequest) (*DownloadDirectoryFromGCSResponse, error)
 	// FetchServiceImageMetaData returns the service image metadata.
 	FetchServiceImageMetaData(context.Context, *FetchServiceImageMetaDataRequest) (*FetchServiceImageMetaDataResponse, error)
+	// ShipArchivedLogs uploads archived redo logs generated since the last
+	// shipped sequence to a storage location.
+	ShipArchivedLogs(context.Context, *ShipArchivedLogsRequest) (*ShipArchivedLogsResponse, error)
+	// ShipArchivedLogsAsync turns ShipArchivedLogs into an async call.
+	ShipArchivedLogsAsync(context.Context, *ShipArchivedLogsAsyncRequest) (*longrunning.Operation, error)
+	// DeleteBackup deletes the RMAN backup pieces with a given tag, including
+	// the copies uploaded to a storage location.
+	DeleteBackup(context.Context, *DeleteBackupRequest) (*DeleteBackupResponse, error)
+	// RunDatapatch opens all the PDBs and runs datapatch to apply the SQL
+	// changes of the patches installed in the Oracle home.
+	RunDatapatch(context.Context, *RunDatapatchRequest) (*RunDatapatchResponse, error)
+	// GetAuditLogHead returns the sequence number and the hash of the last
+	// entry of the audit log, which the operator anchors outside of the Pod.
+	GetAuditLogHead(context.Context, *GetAuditLogHeadRequest) (*GetAuditLogHeadResponse, error)
 	mustEmbedUnimplementedDatabaseDaemonServer()
 }
 
@@ -508,6 +613,9 @@ func (UnimplementedDatabaseDaemonServer) RunRMANAsync(context.Context, *RunRMANA
 func (UnimplementedDatabaseDaemonServer) NID(context.Context, *NIDRequest) (*NIDResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method NID not implemented")
 }
+func (UnimplementedDatabaseDaemonServer) ChangeDatabaseIdentityAsync(context.Context, *ChangeDatabaseIdentityAsyncRequest) (*longrunning.Operation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangeDatabaseIdentityAsync not implemented")
+}
 func (UnimplementedDatabaseDaemonServer) GetDatabaseType(context.Context, *GetDatabaseTypeRequest) (*GetDatabaseTypeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetDatabaseType not implemented")
 }
@@ -556,6 +664,9 @@ func (UnimplementedDatabaseDaemonServer) GetOperation(context.Context, *longrunn
 func (UnimplementedDatabaseDaemonServer) DeleteOperation(context.Context, *longrunning.DeleteOperationRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteOperation not implemented")
 }
+func (UnimplementedDatabaseDaemonServer) CancelOperation(context.Context, *longrunning.CancelOperationRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelOperation not implemented")
+}
 func (UnimplementedDatabaseDaemonServer) RecoverConfigFile(context.Context, *RecoverConfigFileRequest) (*RecoverConfigFileResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RecoverConfigFile not implemented")
 }
@@ -565,6 +676,21 @@ func (UnimplementedDatabaseDaemonServer) DownloadDirectoryFromGCS(context.Contex
 func (UnimplementedDatabaseDaemonServer) FetchServiceImageMetaData(context.Context, *FetchServiceImageMetaDataRequest) (*FetchServiceImageMetaDataResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FetchServiceImageMetaData not implemented")
 }
+func (UnimplementedDatabaseDaemonServer) ShipArchivedLogs(context.Context, *ShipArchivedLogsRequest) (*ShipArchivedLogsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ShipArchivedLogs not implemented")
+}
+func (UnimplementedDatabaseDaemonServer) ShipArchivedLogsAsync(context.Context, *ShipArchivedLogsAsyncRequest) (*longrunning.Operation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ShipArchivedLogsAsync not implemented")
+}
+func (UnimplementedDatabaseDaemonServer) DeleteBackup(context.Context, *DeleteBackupRequest) (*DeleteBackupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteBackup not implemented")
+}
+func (UnimplementedDatabaseDaemonServer) RunDatapatch(context.Context, *RunDatapatchRequest) (*RunDatapatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RunDatapatch not implemented")
+}
+func (UnimplementedDatabaseDaemonServer) GetAuditLogHead(context.Context, *GetAuditLogHeadRequest) (*GetAuditLogHeadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAuditLogHead not implemented")
+}
 func (UnimplementedDatabaseDaemonServer) mustEmbedUnimplementedDatabaseDaemonServer() {}
 
 // UnsafeDatabaseDaemonServer may be embedded to opt out of forward compatibility for this service.
@@ -794,6 +920,24 @@ func _DatabaseDaemon_NID_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DatabaseDaemon_ChangeDatabaseIdentityAsync_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeDatabaseIdentityAsyncRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseDaemonServer).ChangeDatabaseIdentityAsync(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/agents.oracle.DatabaseDaemon/ChangeDatabaseIdentityAsync",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseDaemonServer).ChangeDatabaseIdentityAsync(ctx, req.(*ChangeDatabaseIdentityAsyncRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _DatabaseDaemon_GetDatabaseType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetDatabaseTypeRequest)
 	if err := dec(in); err != nil {
@@ -1082,6 +1226,24 @@ func _DatabaseDaemon_DeleteOperation_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DatabaseDaemon_CancelOperation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(longrunning.CancelOperationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseDaemonServer).CancelOperation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/agents.oracle.DatabaseDaemon/CancelOperation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseDaemonServer).CancelOperation(ctx, req.(*longrunning.CancelOperationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _DatabaseDaemon_RecoverConfigFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(RecoverConfigFileRequest)
 	if err := dec(in); err != nil {
@@ -1136,6 +1298,96 @@ func _DatabaseDaemon_FetchServiceImageMetaData_Handler(srv interface{}, ctx cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DatabaseDaemon_ShipArchivedLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShipArchivedLogsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseDaemonServer).ShipArchivedLogs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/agents.oracle.DatabaseDaemon/ShipArchivedLogs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseDaemonServer).ShipArchivedLogs(ctx, req.(*ShipArchivedLogsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DatabaseDaemon_ShipArchivedLogsAsync_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShipArchivedLogsAsyncRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseDaemonServer).ShipArchivedLogsAsync(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/agents.oracle.DatabaseDaemon/ShipArchivedLogsAsync",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseDaemonServer).ShipArchivedLogsAsync(ctx, req.(*ShipArchivedLogsAsyncRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DatabaseDaemon_DeleteBackup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteBackupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseDaemonServer).DeleteBackup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/agents.oracle.DatabaseDaemon/DeleteBackup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseDaemonServer).DeleteBackup(ctx, req.(*DeleteBackupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DatabaseDaemon_RunDatapatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RunDatapatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseDaemonServer).RunDatapatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/agents.oracle.DatabaseDaemon/RunDatapatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseDaemonServer).RunDatapatch(ctx, req.(*RunDatapatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DatabaseDaemon_GetAuditLogHead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAuditLogHeadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseDaemonServer).GetAuditLogHead(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/agents.oracle.DatabaseDaemon/GetAuditLogHead",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseDaemonServer).GetAuditLogHead(ctx, req.(*GetAuditLogHeadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // DatabaseDaemon_ServiceDesc is the grpc.ServiceDesc for DatabaseDaemon service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1191,6 +1443,10 @@ var DatabaseDaemon_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "NID",
 			Handler:    _DatabaseDaemon_NID_Handler,
 		},
+		{
+			MethodName: "ChangeDatabaseIdentityAsync",
+			Handler:    _DatabaseDaemon_ChangeDatabaseIdentityAsync_Handler,
+		},
 		{
 			MethodName: "GetDatabaseType",
 			Handler:    _DatabaseDaemon_GetDatabaseType_Handler,
@@ -1255,6 +1511,10 @@ var DatabaseDaemon_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteOperation",
 			Handler:    _DatabaseDaemon_DeleteOperation_Handler,
 		},
+		{
+			MethodName: "CancelOperation",
+			Handler:    _DatabaseDaemon_CancelOperation_Handler,
+		},
 		{
 			MethodName: "RecoverConfigFile",
 			Handler:    _DatabaseDaemon_RecoverConfigFile_Handler,
@@ -1267,6 +1527,26 @@ var DatabaseDaemon_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "FetchServiceImageMetaData",
 			Handler:    _DatabaseDaemon_FetchServiceImageMetaData_Handler,
 		},
+		{
+			MethodName: "ShipArchivedLogs",
+			Handler:    _DatabaseDaemon_ShipArchivedLogs_Handler,
+		},
+		{
+			MethodName: "ShipArchivedLogsAsync",
+			Handler:    _DatabaseDaemon_ShipArchivedLogsAsync_Handler,
+		},
+		{
+			MethodName: "DeleteBackup",
+			Handler:    _DatabaseDaemon_DeleteBackup_Handler,
+		},
+		{
+			MethodName: "RunDatapatch",
+			Handler:    _DatabaseDaemon_RunDatapatch_Handler,
+		},
+		{
+			MethodName: "GetAuditLogHead",
+			Handler:    _DatabaseDaemon_GetAuditLogHead_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "oracle/pkg/agents/oracle/dbdaemon.proto",
@@ -81,6 +81,46 @@ func (s *Security) Close() error {
 	return nil
 }
 
+// RandStrongOraclePassword returns a random password which passes the common
+// password verify functions, e.g. ORA12C_STRONG_VERIFY_FUNCTION: it starts
+// with a letter and has at least two uppercase letters, lowercase letters,
+// digits and special characters. The special characters need no escaping in
+// a quoted identifier or a connect string.
+func RandStrongOraclePassword() (string, error) {
+	const (
+		upper   = "ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+		lower   = "abcdefghijklmnopqrstuvwxyz"
+		digits  = "0123456789"
+		special = "#_"
+		length  = 16
+	)
+	classes := []string{upper, upper, lower, lower, digits, digits, special, special}
+	for len(classes) < length-1 {
+		classes = append(classes, upper+lower+digits+special)
+	}
+	result := make([]byte, len(classes))
+	for i, class := range classes {
+		n, err := randInt(len(class))
+		if err != nil {
+			return "", err
+		}
+		result[i] = class[n]
+	}
+	for i := len(result) - 1; i > 0; i-- {
+		j, err := randInt(i + 1)
+		if err != nil {
+			return "", err
+		}
+		result[i], result[j] = result[j], result[i]
+	}
+
+	first, err := randInt(len(upper + lower))
+	if err != nil {
+		return "", err
+	}
+	return string((upper + lower)[first]) + string(result), nil
+}
+
 // RandOraclePassword returns a random password containing letters and numbers.
 // It is caller's responsibility to handle the error.
 func RandOraclePassword() (string, error) {
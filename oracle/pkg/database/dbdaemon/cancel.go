@@ -0,0 +1,81 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbdaemon
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"k8s.io/klog/v2"
+
+	"github.com/GoogleCloudPlatform/elcarro-oracle-operator/oracle/pkg/agents/common/sql"
+	"github.com/GoogleCloudPlatform/elcarro-oracle-operator/oracle/pkg/agents/consts"
+	dbdpb "github.com/GoogleCloudPlatform/elcarro-oracle-operator/oracle/pkg/agents/oracle"
+)
+
+const (
+	// killDataPumpJobSQL stops a Data Pump job of the PDB loader user like
+	// the KILL_JOB command of expdp/impdp does: the job is aborted and its
+	// master table is dropped. Terminating the expdp/impdp client alone
+	// leaves the job running in the database.
+	killDataPumpJobSQL = `declare
+  h number;
+begin
+  for j in (select job_name, owner_name from dba_datapump_jobs where owner_name = '%s' and job_name = '%s' and state <> 'NOT RUNNING') loop
+    h := dbms_datapump.attach(j.job_name, j.owner_name);
+    dbms_datapump.stop_job(h, immediate => 1, keep_master => 0);
+  end loop;
+end;`
+
+	// killDataPumpJobTimeout bounds the clean up of a cancelled Data Pump
+	// job, it doesn't run under the cancelled context of the operation.
+	killDataPumpJobTimeout = 5 * time.Minute
+)
+
+// dataPumpJobName returns the name of a Data Pump job of kind, IMPORT or
+// EXPORT, started at t. It's passed to expdp/impdp as JOB_NAME, so that a
+// cancelled job can be stopped without touching the other jobs in the PDB.
+// Data Pump jobs of the Database Daemon run one at a time, see
+// pdbLoadMutex. The name fits in the 30 bytes of older Oracle versions.
+func dataPumpJobName(kind string, t time.Time) string {
+	return fmt.Sprintf("ELCARRO_%s_%s", kind, t.UTC().Format("20060102150405"))
+}
+
+// killDataPumpJob stops the Data Pump job jobName of the PDB loader user in
+// the given PDB after the expdp/impdp client was terminated.
+func (s *Server) killDataPumpJob(pdbName, jobName string) {
+	ctx, cancel := context.WithTimeout(context.Background(), killDataPumpJobTimeout)
+	defer cancel()
+	if _, err := s.RunSQLPlus(ctx, &dbdpb.RunSQLPlusCMDRequest{Commands: []string{
+		fmt.Sprintf("alter session set container=%s", strings.ToUpper(pdbName)),
+		fmt.Sprintf(killDataPumpJobSQL, strings.ToUpper(consts.PDBLoaderUser), sql.StringParam(jobName)),
+	}}); err != nil {
+		klog.ErrorS(err, "dbdaemon/killDataPumpJob: failed to stop the Data Pump job", "pdb", pdbName, "job", jobName)
+		return
+	}
+	klog.InfoS("dbdaemon/killDataPumpJob: stopped the Data Pump job", "pdb", pdbName, "job", jobName)
+}
+
+// removeFiles removes the partial files of a cancelled operation.
+func removeFiles(files ...string) {
+	for _, f := range files {
+		if err := os.RemoveAll(f); err != nil {
+			klog.Warningf("failed to remove %s of a cancelled operation: %v", f, err)
+		}
+	}
+}
@@ -34,9 +34,6 @@ import (
 	"sync"
 	"time"
 
-	"cloud.google.com/go/storage"
-	"google.golang.org/api/iterator"
-
 	"github.com/godror/godror" // Register database/sql driver
 	"github.com/golang/protobuf/ptypes"
 	"github.com/golang/protobuf/ptypes/empty"
@@ -47,20 +44,45 @@ import (
 	"k8s.io/klog/v2"
 
 	"github.com/GoogleCloudPlatform/elcarro-oracle-operator/oracle/pkg/agents/common"
+	"github.com/GoogleCloudPlatform/elcarro-oracle-operator/oracle/pkg/agents/common/audit"
 	"github.com/GoogleCloudPlatform/elcarro-oracle-operator/oracle/pkg/agents/consts"
 	dbdpb "github.com/GoogleCloudPlatform/elcarro-oracle-operator/oracle/pkg/agents/oracle"
 	"github.com/GoogleCloudPlatform/elcarro-oracle-operator/oracle/pkg/agents/security"
 	"github.com/GoogleCloudPlatform/elcarro-oracle-operator/oracle/pkg/database/lib/lro"
+	"github.com/GoogleCloudPlatform/elcarro-oracle-operator/oracle/pkg/database/lib/storage"
 	"github.com/GoogleCloudPlatform/elcarro-oracle-operator/oracle/pkg/database/provision"
 )
 
 const (
 	listenerDir = "/u02/app/oracle/oraconfig/network"
+
+	// recoveryTimeLayout matches the 'YYYY-MM-DD HH24:MI:SS' Oracle date
+	// format used by a recoverable range query and archivedLogsSQL. Dates
+	// are converted from the time zone of the database host to UTC first.
+	recoveryTimeLayout = "2006-01-02 15:04:05"
+
+	currentIncarnationSQL = "select to_char(resetlogs_id) as incarnation from v$database_incarnation where status='CURRENT'"
+
+	archivedLogsSQL = `select to_char(thread#) as thread, to_char(sequence#) as sequence,
+			to_char(next_change#) as next_scn, to_char(sys_extract_utc(from_tz(cast(next_time as timestamp), to_char(systimestamp, 'TZH:TZM'))), 'YYYY-MM-DD HH24:MI:SS') as next_time,
+			name
+		from v$archived_log
+		where resetlogs_id=%d and sequence# > %d
+			and name is not null and standby_dest='NO' and deleted='NO' and status='A'
+		order by sequence#`
+
+	backupPiecesSQL = "select handle from v$backup_piece where tag='%s' and status='A'"
+
+	dbidSQL          = "select to_char(dbid) as dbid from v$database"
+	setDBNameSQL     = "alter system set db_name='%s' scope=spfile"
+	openResetlogsSQL = "alter database open resetlogs"
+	openAllPDBsSQL   = "alter pluggable database all open"
 )
 
 var (
 	oraDataDir = "/u02/app/oracle/oradata"
 
+
 	maxWalkFiles = 10000
 )
 
@@ -99,7 +121,10 @@ type Server struct {
 	dbdClientClose func() error
 	lroServer      *lro.Server
 	syncJobs       *syncJobs
-	gcsUtil        gcsUtil
+	storageUtil    storageUtil
+	// auditLog records the statements run by RunSQLPlus,
+	// RunSQLPlusFormatted and RunRMAN.
+	auditLog *audit.Logger
 }
 
 // Remove pdbConnStr from String(), as that may contain the pdb user/password
@@ -328,7 +353,7 @@ func (s *Server) CreatePasswordFile(ctx context.Context, req *dbdpb.CreatePasswo
 		klog.Warningf("failed to remove %v: %v", passwordFile, err)
 	}
 
-	if err := s.osUtil.runCommand(orapwd(s.databaseHome), params); err != nil {
+	if err := s.osUtil.runCommand(ctx, orapwd(s.databaseHome), params); err != nil {
 		return nil, fmt.Errorf("orapwd cmd failed: %v", err)
 	}
 	return &dbdpb.CreatePasswordFileResponse{}, nil
@@ -346,17 +371,70 @@ func (s *Server) SetListenerRegistration(ctx context.Context, req *dbdpb.SetList
 }
 
 // physicalRestore runs
-//  1. RMAN restore command
-//  2. SQL to get latest SCN
-//  3. RMAN recover command, created by applying SCN value
+//  1. RMAN mount command, if any
+//  2. SQL to get the recoverable range of a point-in-time recovery
+//  3. Deletion of the datafiles matching the datafile patterns
+//  4. RMAN restore command
+//  5. SQL to get latest SCN
+//  6. RMAN recover command, created by applying SCN value
 // to the recover statement template passed as a parameter.
+// For a point-in-time recovery step 2 validates the requested target
+// against the recoverable range, before any datafile is deleted, step 5 is
+// skipped and the recover statement is run as is. Without a latest SCN
+// query, step 5 is skipped and the recover statement is run as is as well.
 func (s *Server) physicalRestore(ctx context.Context, req *dbdpb.PhysicalRestoreRequest) (*empty.Empty, error) {
 	errorPrefix := "dbdaemon/physicalRestore: "
 
+	if req.GetMountStatement() != "" {
+		if _, err := s.RunRMAN(ctx, &dbdpb.RunRMANRequest{Scripts: []string{req.GetMountStatement()}}); err != nil {
+			return nil, fmt.Errorf(errorPrefix+"failed to mount the backup: %v", err)
+		}
+	}
+
+	if req.GetRecoverableRangeQuery() != "" {
+		rangeResp, err := s.RunSQLPlusFormatted(ctx, &dbdpb.RunSQLPlusCMDRequest{Commands: []string{req.GetRecoverableRangeQuery()}})
+		if err != nil || len(rangeResp.GetMsg()) < 1 {
+			return nil, fmt.Errorf(errorPrefix+"failed to query the recoverable range, results: %v, err: %v", rangeResp, err)
+		}
+		row := make(map[string]string)
+		if err := json.Unmarshal([]byte(rangeResp.GetMsg()[0]), &row); err != nil {
+			return nil, err
+		}
+		if err := validateRecoveryTarget(row, req); err != nil {
+			return nil, fmt.Errorf(errorPrefix+"%v", err)
+		}
+	}
+
+	if err := removeDatafiles(req.GetDatafilePatterns()); err != nil {
+		return nil, fmt.Errorf(errorPrefix+"%v", err)
+	}
+
 	if _, err := s.RunRMAN(ctx, &dbdpb.RunRMANRequest{Scripts: []string{req.GetRestoreStatement()}}); err != nil {
 		return nil, fmt.Errorf(errorPrefix+"failed to restore a database: %v", err)
 	}
 
+	if req.GetRecoverableRangeQuery() != "" {
+		klog.InfoS(errorPrefix+"final point-in-time recovery request", "recoverStmt", req.GetRecoverStatementTemplate())
+		if _, err := s.RunRMAN(ctx, &dbdpb.RunRMANRequest{Scripts: []string{req.GetRecoverStatementTemplate()}}); err != nil {
+			return nil, fmt.Errorf(errorPrefix+"failed to recover a database: %v", err)
+		}
+		if err := os.RemoveAll(consts.RMANStagingDir); err != nil {
+			klog.Warningf("physicalRestore: can't cleanup staging dir from local disk.")
+		}
+		return &empty.Empty{}, nil
+	}
+
+	if req.GetLatestRecoverableScnQuery() == "" {
+		klog.InfoS(errorPrefix+"final complete recovery request", "recoverStmt", req.GetRecoverStatementTemplate())
+		if _, err := s.RunRMAN(ctx, &dbdpb.RunRMANRequest{Scripts: []string{req.GetRecoverStatementTemplate()}}); err != nil {
+			return nil, fmt.Errorf(errorPrefix+"failed to recover a database: %v", err)
+		}
+		if err := os.RemoveAll(consts.RMANStagingDir); err != nil {
+			klog.Warningf("physicalRestore: can't cleanup staging dir from local disk.")
+		}
+		return &empty.Empty{}, nil
+	}
+
 	scnResp, err := s.RunSQLPlusFormatted(ctx, &dbdpb.RunSQLPlusCMDRequest{Commands: []string{req.GetLatestRecoverableScnQuery()}})
 	if err != nil || len(scnResp.GetMsg()) < 1 {
 		return nil, fmt.Errorf(errorPrefix+"failed to query archive log SCNs, results: %v, err: %v", scnResp, err)
@@ -392,12 +470,85 @@ func (s *Server) physicalRestore(ctx context.Context, req *dbdpb.PhysicalRestore
 	return &empty.Empty{}, nil
 }
 
+// removeDatafiles deletes the files matching the glob patterns, which have to
+// be under the data directory. A file which can't be deleted is overwritten
+// by the restore if it's in the backup, so that only yields a warning.
+func removeDatafiles(patterns []string) error {
+	for _, pattern := range patterns {
+		if !strings.HasPrefix(filepath.Clean(pattern), oraDataDir+"/") {
+			return fmt.Errorf("datafile pattern %q is not under %s", pattern, oraDataDir)
+		}
+	}
+	for _, pattern := range patterns {
+		files, err := filepath.Glob(pattern)
+		if err != nil {
+			return fmt.Errorf("invalid datafile pattern %q: %v", pattern, err)
+		}
+		for _, f := range files {
+			if err := os.Remove(f); err != nil {
+				klog.Warningf("physicalRestore: failed to delete datafile %s before the restore: %v", f, err)
+			}
+		}
+	}
+	return nil
+}
+
+// validateRecoveryTarget checks that the point-in-time recovery target of
+// the request falls within the recoverable range returned by the
+// RecoverableRangeQuery: after the latest checkpoint of the backup and no
+// later than the last change in the archived redo logs. MIN_TIME and MAX_TIME
+// are in UTC.
+func validateRecoveryTarget(row map[string]string, req *dbdpb.PhysicalRestoreRequest) error {
+	minSCN, err := strconv.ParseInt(row["MIN_SCN"], 10, 64)
+	if err != nil {
+		return fmt.Errorf("failed to parse the lowest recoverable SCN %q: %v", row["MIN_SCN"], err)
+	}
+	maxSCN, err := strconv.ParseInt(row["MAX_SCN"], 10, 64)
+	if err != nil {
+		return fmt.Errorf("failed to parse the highest recoverable SCN %q (no archived redo logs?): %v", row["MAX_SCN"], err)
+	}
+
+	switch {
+	case req.GetUntilTime() != nil:
+		minTime, err := time.Parse(recoveryTimeLayout, row["MIN_TIME"])
+		if err != nil {
+			return fmt.Errorf("failed to parse the earliest recoverable time %q: %v", row["MIN_TIME"], err)
+		}
+		maxTime, err := time.Parse(recoveryTimeLayout, row["MAX_TIME"])
+		if err != nil {
+			return fmt.Errorf("failed to parse the latest recoverable time %q: %v", row["MAX_TIME"], err)
+		}
+		untilTime := req.GetUntilTime().AsTime()
+		if !untilTime.After(minTime) || untilTime.After(maxTime) {
+			return fmt.Errorf("until time %v is outside of the recoverable range (%v, %v]", untilTime, minTime, maxTime)
+		}
+	case req.GetUntilScn() != 0:
+		if req.GetUntilScn() <= minSCN || req.GetUntilScn() > maxSCN {
+			return fmt.Errorf("until SCN %d is outside of the recoverable range (%d, %d]", req.GetUntilScn(), minSCN, maxSCN)
+		}
+	case req.GetUntilRestorePoint() != "":
+		if row["TARGET_SCN"] == "" {
+			return fmt.Errorf("restore point %q not found in the restored control file", req.GetUntilRestorePoint())
+		}
+		scn, err := strconv.ParseInt(row["TARGET_SCN"], 10, 64)
+		if err != nil {
+			return fmt.Errorf("failed to parse the SCN %q of restore point %q: %v", row["TARGET_SCN"], req.GetUntilRestorePoint(), err)
+		}
+		if scn < minSCN || scn >= maxSCN {
+			return fmt.Errorf("restore point %q (SCN %d) is outside of the recoverable range [%d, %d)", req.GetUntilRestorePoint(), scn, minSCN, maxSCN)
+		}
+	default:
+		return fmt.Errorf("a recoverable range query requires a recovery target")
+	}
+	return nil
+}
+
 // PhysicalRestoreAsync turns physicalRestore into an async call.
 func (s *Server) PhysicalRestoreAsync(ctx context.Context, req *dbdpb.PhysicalRestoreAsyncRequest) (*lropb.Operation, error) {
-	job, err := lro.CreateAndRunLROJobWithID(ctx, req.GetLroInput().GetOperationId(), "PhysicalRestore", s.lroServer,
+	job, err := lro.CreateAndRunLROJobWithProgress(ctx, req.GetLroInput().GetOperationId(), "PhysicalRestore", s.lroServer,
 		func(ctx context.Context) (proto.Message, error) {
 			return s.physicalRestore(ctx, req.SyncRequest)
-		})
+		}, s.rmanProgress)
 
 	if err != nil {
 		klog.ErrorS(err, "dbdaemon/PhysicalRestoreAsync failed to create an LRO job", "request", req)
@@ -420,15 +571,15 @@ func (s *Server) dataPumpImport(ctx context.Context, req *dbdpb.DataPumpImportRe
 	dumpDir := filepath.Join(pdbPath, consts.DpdumpDir.Linux)
 	klog.InfoS("dbdaemon/dataPumpImport", "dumpDir", dumpDir)
 
-	dmpReader, err := s.gcsUtil.download(ctx, req.GcsPath)
+	dmpReader, err := s.storageUtil.download(ctx, req.GcsPath, req.GetStorageCredentials())
 	if err != nil {
-		return nil, fmt.Errorf("dbdaemon/dataPumpImport: initiating GCS download failed: %v", err)
+		return nil, fmt.Errorf("dbdaemon/dataPumpImport: initiating download failed: %v", err)
 	}
 	defer dmpReader.Close()
 
 	importFileFullPath := filepath.Join(dumpDir, importFilename)
 	if err := s.osUtil.createFile(importFileFullPath, dmpReader); err != nil {
-		return nil, fmt.Errorf("dbdaemon/dataPumpImport: download from GCS failed: %v", err)
+		return nil, fmt.Errorf("dbdaemon/dataPumpImport: download from %s failed: %v", req.GcsPath, err)
 	}
 	klog.Infof("dbdaemon/dataPumpImport: downloaded import dmp file from %s to %s", req.GcsPath, importFileFullPath)
 	defer func() {
@@ -442,13 +593,19 @@ func (s *Server) dataPumpImport(ctx context.Context, req *dbdpb.DataPumpImportRe
 		return nil, fmt.Errorf("dbdaemon/dataPumpImport: failed to alter user %s", consts.PDBLoaderUser)
 	}
 
+	jobName := dataPumpJobName("IMPORT", time.Now())
 	params := []string{impdpTarget}
 	params = append(params, req.CommandParams...)
 	params = append(params, fmt.Sprintf("directory=%s", consts.DpdumpDir.Oracle))
 	params = append(params, "dumpfile="+importFilename)
 	params = append(params, "logfile="+logFilename)
+	params = append(params, "job_name="+jobName)
 
-	if err := s.runCommand(impdp(s.databaseHome), params); err != nil {
+	if err := s.runCommand(ctx, impdp(s.databaseHome), params); err != nil {
+		if ctx.Err() != nil {
+			s.killDataPumpJob(req.PdbName, jobName)
+			return nil, fmt.Errorf("data pump import was cancelled: %v", err)
+		}
 		// On error code 5 (EX_SUCC_ERR), process completed reached the
 		// end but data in the DMP might have been skipped (foreign
 		// schemas, already imported tables, even failed schema imports
@@ -463,8 +620,8 @@ func (s *Server) dataPumpImport(ctx context.Context, req *dbdpb.DataPumpImportRe
 	if len(req.GcsLogPath) > 0 {
 		logFullPath := filepath.Join(dumpDir, logFilename)
 
-		if err := s.gcsUtil.uploadFile(ctx, req.GcsLogPath, logFullPath, contentTypePlainText); err != nil {
-			return nil, fmt.Errorf("dbdaemon/dataPumpImport: import completed successfully, failed to upload import log to GCS: %v", err)
+		if err := s.storageUtil.uploadFile(ctx, req.GcsLogPath, logFullPath, contentTypePlainText, req.GetStorageCredentials()); err != nil {
+			return nil, fmt.Errorf("dbdaemon/dataPumpImport: import completed successfully, failed to upload import log to %s: %v", req.GcsLogPath, err)
 		}
 
 		klog.Infof("dbdaemon/dataPumpImport: uploaded import log to %s", req.GcsLogPath)
@@ -475,13 +632,13 @@ func (s *Server) dataPumpImport(ctx context.Context, req *dbdpb.DataPumpImportRe
 
 // DataPumpImportAsync turns dataPumpImport into an async call.
 func (s *Server) DataPumpImportAsync(ctx context.Context, req *dbdpb.DataPumpImportAsyncRequest) (*lropb.Operation, error) {
-	job, err := lro.CreateAndRunLROJobWithID(ctx, req.GetLroInput().GetOperationId(), "DataPumpImport", s.lroServer,
+	job, err := lro.CreateAndRunLROJobWithProgress(ctx, req.GetLroInput().GetOperationId(), "DataPumpImport", s.lroServer,
 		func(ctx context.Context) (proto.Message, error) {
 			return s.dataPumpImport(ctx, req.SyncRequest)
-		})
+		}, s.dataPumpProgress)
 
 	if err != nil {
-		klog.ErrorS(err, "dbdaemon/DataPumpImportAsync failed to create an LRO job", "request", req)
+		klog.ErrorS(err, "dbdaemon/DataPumpImportAsync failed to create an LRO job", "request", common.RedactStorageCredentials(req))
 		return nil, err
 	}
 
@@ -528,6 +685,8 @@ func (s *Server) dataPumpExport(ctx context.Context, req *dbdpb.DataPumpExportRe
 	params = append(params, fmt.Sprintf("DIRECTORY=%s", consts.DpdumpDir.Oracle))
 	params = append(params, fmt.Sprintf("DUMPFILE=%s", dmpFile))
 	params = append(params, fmt.Sprintf("LOGFILE=%s", dmpLogFile))
+	jobName := dataPumpJobName("EXPORT", time.Now())
+	params = append(params, fmt.Sprintf("JOB_NAME=%s", jobName))
 	params = append(params, req.CommandParams...)
 	if len(req.FlashbackTime) != 0 {
 		params = append(params, fmt.Sprintf("FLASHBACK_TIME=%q", req.FlashbackTime))
@@ -540,7 +699,12 @@ func (s *Server) dataPumpExport(ctx context.Context, req *dbdpb.DataPumpExportRe
 
 	cmdParams := []string{expdpTarget}
 	cmdParams = append(cmdParams, fmt.Sprintf("parfile=%s", parPath))
-	if err := s.runCommand(expdp(s.databaseHome), cmdParams); err != nil {
+	if err := s.runCommand(ctx, expdp(s.databaseHome), cmdParams); err != nil {
+		if ctx.Err() != nil {
+			s.killDataPumpJob(req.PdbName, jobName)
+			removeFiles(dmpPath, parPath, filepath.Join(pdbPath, consts.DpdumpDir.Linux, dmpLogFile))
+			return nil, fmt.Errorf("data pump export was cancelled: %v", err)
+		}
 		if s.osUtil.isReturnCodeEqual(err, 5) { // see dataPumpImport for an explanation of error code 5
 			return nil, fmt.Errorf("data pump export failed, err = %v", err)
 		}
@@ -548,7 +712,7 @@ func (s *Server) dataPumpExport(ctx context.Context, req *dbdpb.DataPumpExportRe
 	}
 	klog.Infof("dbdaemon/dataPumpExport: export to %s completed successfully", dmpPath)
 
-	if err := s.gcsUtil.uploadFile(ctx, req.GcsPath, dmpPath, contentTypePlainText); err != nil {
+	if err := s.storageUtil.uploadFile(ctx, req.GcsPath, dmpPath, contentTypePlainText, req.GetStorageCredentials()); err != nil {
 		return nil, fmt.Errorf("dbdaemon/dataPumpExport: failed to upload dmp file to %s: %v", req.GcsPath, err)
 	}
 	klog.Infof("dbdaemon/dataPumpExport: uploaded dmp file to %s", req.GcsPath)
@@ -556,7 +720,7 @@ func (s *Server) dataPumpExport(ctx context.Context, req *dbdpb.DataPumpExportRe
 	if len(req.GcsLogPath) > 0 {
 		logPath := filepath.Join(pdbPath, consts.DpdumpDir.Linux, dmpLogFile)
 
-		if err := s.gcsUtil.uploadFile(ctx, req.GcsLogPath, logPath, contentTypePlainText); err != nil {
+		if err := s.storageUtil.uploadFile(ctx, req.GcsLogPath, logPath, contentTypePlainText, req.GetStorageCredentials()); err != nil {
 			return nil, fmt.Errorf("dbdaemon/dataPumpExport: failed to upload log file to %s: %v", req.GcsLogPath, err)
 		}
 		klog.Infof("dbdaemon/dataPumpExport: uploaded log file to %s", req.GcsLogPath)
@@ -586,13 +750,13 @@ func writeParFile(parPath string, params []string) error {
 
 // DataPumpExportAsync turns dataPumpExport into an async call.
 func (s *Server) DataPumpExportAsync(ctx context.Context, req *dbdpb.DataPumpExportAsyncRequest) (*lropb.Operation, error) {
-	job, err := lro.CreateAndRunLROJobWithID(ctx, req.GetLroInput().GetOperationId(), "DataPumpExport", s.lroServer,
+	job, err := lro.CreateAndRunLROJobWithProgress(ctx, req.GetLroInput().GetOperationId(), "DataPumpExport", s.lroServer,
 		func(ctx context.Context) (proto.Message, error) {
 			return s.dataPumpExport(ctx, req.SyncRequest)
-		})
+		}, s.dataPumpProgress)
 
 	if err != nil {
-		klog.ErrorS(err, "dbdaemon/DataPumpExportAsync failed to create an LRO job", "request", req)
+		klog.ErrorS(err, "dbdaemon/DataPumpExportAsync failed to create an LRO job", "request", common.RedactStorageCredentials(req))
 		return nil, err
 	}
 	return &lropb.Operation{Name: job.ID(), Done: false}, nil
@@ -613,7 +777,14 @@ func (s *Server) DeleteOperation(ctx context.Context, req *lropb.DeleteOperation
 	return s.lroServer.DeleteOperation(ctx, req)
 }
 
-func (s *Server) runCommand(bin string, params []string) error {
+// CancelOperation cancels a long running operation by its id. The context
+// of the operation is cancelled, which terminates its child process.
+func (s *Server) CancelOperation(ctx context.Context, req *lropb.CancelOperationRequest) (*empty.Empty, error) {
+	klog.InfoS("dbdaemon/CancelOperation", "name", req.GetName())
+	return s.lroServer.CancelOperation(ctx, req)
+}
+
+func (s *Server) runCommand(ctx context.Context, bin string, params []string) error {
 	// Sets env to bounce a database|listener.
 	if err := os.Setenv("ORACLE_SID", s.databaseSid.val); err != nil {
 		return fmt.Errorf("failed to set env variable: %v", err)
@@ -622,7 +793,7 @@ func (s *Server) runCommand(bin string, params []string) error {
 		return fmt.Errorf("failed to set env variable: %v", err)
 	}
 
-	return s.osUtil.runCommand(bin, params)
+	return s.osUtil.runCommand(ctx, bin, params)
 }
 
 var newDB = func(driverName, dataSourceName string) (oracleDatabase, error) {
@@ -840,7 +1011,10 @@ func (s *Server) RunSQLPlus(ctx context.Context, req *dbdpb.RunSQLPlusCMDRequest
 	// Only add lock in top level API to avoid deadlock.
 	s.databaseSid.Lock()
 	defer s.databaseSid.Unlock()
-	return s.runSQLPlusHelper(ctx, req, false)
+	start := time.Now()
+	resp, err := s.runSQLPlusHelper(ctx, req, false)
+	s.auditLog.Log(ctx, "RunSQLPlus", req.GetCommands(), start, err)
+	return resp, err
 }
 
 // RunSQLPlusFormatted executes a SQL command and returns the row results.
@@ -858,7 +1032,10 @@ func (s *Server) RunSQLPlusFormatted(ctx context.Context, req *dbdpb.RunSQLPlusC
 	s.databaseSid.Lock()
 	defer s.databaseSid.Unlock()
 
-	return s.runSQLPlusHelper(ctx, req, true)
+	start := time.Now()
+	resp, err := s.runSQLPlusHelper(ctx, req, true)
+	s.auditLog.Log(ctx, "RunSQLPlusFormatted", req.GetCommands(), start, err)
+	return resp, err
 }
 
 // KnownPDBs runs a database query returning a list of PDBs known
@@ -988,18 +1165,22 @@ func (s *Server) CheckDatabaseState(ctx context.Context, req *dbdpb.CheckDatabas
 }
 
 // RunRMAN will run the script to execute RMAN and create a physical backup in the target directory, then back it up to GCS if requested
-func (s *Server) RunRMAN(ctx context.Context, req *dbdpb.RunRMANRequest) (*dbdpb.RunRMANResponse, error) {
+func (s *Server) RunRMAN(ctx context.Context, req *dbdpb.RunRMANRequest) (_ *dbdpb.RunRMANResponse, err error) {
 	// Required for local connections (when no SID is specified on connect string).
 	// Add lock to protect server state "databaseSid" and os env variable "ORACLE_SID".
 	// Only add lock in top level API to avoid deadlock.
 	if req.GetSuppress() {
 		klog.Info("RunRMAN", "request", "suppressed")
 	} else {
-		klog.Info("RunRMAN", "request", req)
+		klog.Info("RunRMAN", "request", common.RedactStorageCredentials(req))
 	}
 
 	s.databaseSid.RLock()
 	defer s.databaseSid.RUnlock()
+	start := time.Now()
+	defer func() {
+		s.auditLog.Log(ctx, "RunRMAN", req.GetScripts(), start, err)
+	}()
 	if err := os.Setenv("ORACLE_SID", s.databaseSid.val); err != nil {
 		return nil, fmt.Errorf("failed to set env variable: %v", err)
 	}
@@ -1021,31 +1202,49 @@ func (s *Server) RunRMAN(ctx context.Context, req *dbdpb.RunRMANRequest) (*dbdpb
 	if len(scripts) < 1 {
 		return nil, fmt.Errorf("RunRMAN requires at least 1 script to run, provided: %d", len(scripts))
 	}
-	var res []string
-	for _, script := range scripts {
-		target := "/"
-		if req.GetTarget() != "" {
-			target = req.GetTarget()
-		}
-		args := []string{fmt.Sprintf("target=%s", target)}
-
-		if req.GetAuxiliary() != "" {
-			args = append(args, fmt.Sprintf("auxiliary=%s", req.Auxiliary))
+	stagingDir := rmanStagingDir(req)
+	if req.GetGcsPath() != "" && req.GetCmd() == consts.RMANVerify {
+		downloaded, err := s.downloadBackupPieces(ctx, req.GetGcsPath(), stagingDir, req.GetStorageCredentials())
+		// Only the downloaded pieces are removed, the staging dir holds the
+		// pieces of the backups in progress as well.
+		defer removeFiles(downloaded...)
+		if err != nil {
+			return nil, fmt.Errorf("RunRMAN: failed to download the backup pieces to verify: %v", err)
 		}
+	}
+	// The target and auxiliary connect strings may hold passwords, they're
+	// passed on stdin rather than on the command line, which is visible to
+	// every process of the container.
+	var args []string
+	var connect string
+	if req.GetTarget() != "" {
+		connect += fmt.Sprintf("connect target %s;\n", req.GetTarget())
+	} else {
+		args = append(args, "target=/")
+	}
+	if req.GetAuxiliary() != "" {
+		connect += fmt.Sprintf("connect auxiliary %s;\n", req.GetAuxiliary())
+	}
+	args = append(args, "@/dev/stdin")
 
-		args = append(args, "@/dev/stdin")
-
-		cmd := exec.Command(rman(s.databaseHome), args...)
-		cmd.Stdin = strings.NewReader(script)
+	var res []string
+	for _, script := range scripts {
+		// The RMAN process is killed when the context is cancelled.
+		cmd := exec.CommandContext(ctx, rman(s.databaseHome), args...)
+		cmd.Stdin = strings.NewReader(connect + script)
 		out, err := cmd.CombinedOutput()
 		if err != nil {
+			if ctx.Err() != nil && req.GetGcsPath() != "" && req.GetCmd() == consts.RMANBackup {
+				// The pieces of a cancelled backup to GCS were not uploaded.
+				removeFiles(stagingDir)
+			}
 			return nil, fmt.Errorf("RunRMAN failed,\nscript: %q\nFailed with: %v\nErr: %v", script, string(out), err)
 		}
 		res = append(res, string(out))
 
 		if req.GetGcsPath() != "" && req.GetCmd() == consts.RMANBackup {
-			if err = s.uploadDirectoryContentsToGCS(ctx, consts.RMANStagingDir, req.GetGcsPath()); err != nil {
-				klog.ErrorS(err, "GCS Upload error:")
+			if err = s.uploadDirectoryContents(ctx, stagingDir, req.GetGcsPath(), req.GetStorageCredentials()); err != nil {
+				klog.ErrorS(err, "Backup upload error:")
 				return nil, err
 			}
 		}
@@ -1056,22 +1255,37 @@ func (s *Server) RunRMAN(ctx context.Context, req *dbdpb.RunRMANRequest) (*dbdpb
 
 // RunRMANAsync turns RunRMAN into an async call.
 func (s *Server) RunRMANAsync(ctx context.Context, req *dbdpb.RunRMANAsyncRequest) (*lropb.Operation, error) {
-	job, err := lro.CreateAndRunLROJobWithID(ctx, req.GetLroInput().GetOperationId(), "RMAN", s.lroServer,
+	// The job runs outside of the gRPC call, its caller is kept for the audit log.
+	caller := audit.Caller(ctx)
+	job, err := lro.CreateAndRunLROJobWithProgress(ctx, req.GetLroInput().GetOperationId(), "RMAN", s.lroServer,
 		func(ctx context.Context) (proto.Message, error) {
-			return s.RunRMAN(ctx, req.SyncRequest)
-		})
+			return s.RunRMAN(audit.WithCaller(ctx, caller), req.SyncRequest)
+		}, s.rmanProgress)
 
 	if err != nil {
-		klog.ErrorS(err, "dbdaemon/RunRMANAsync failed to create an LRO job", "request", req)
+		if req.GetSyncRequest().GetSuppress() {
+			klog.ErrorS(err, "dbdaemon/RunRMANAsync failed to create an LRO job", "request", "suppressed")
+		} else {
+			klog.ErrorS(err, "dbdaemon/RunRMANAsync failed to create an LRO job", "request", common.RedactStorageCredentials(req))
+		}
 		return nil, err
 	}
 
 	return &lropb.Operation{Name: job.ID(), Done: false}, nil
 }
 
-func (s *Server) uploadDirectoryContentsToGCS(ctx context.Context, backupDir, gcsPath string) error {
-	klog.InfoS("RunRMAN: uploadDirectoryContentsToGCS", "backupdir", backupDir, "gcsPath", gcsPath)
-	err := filepath.Walk(backupDir, func(fpath string, info os.FileInfo, errInner error) error {
+// uploadDirectoryContents uploads the files in backupDir, the RMAN staging
+// dir or a dir in it, to the storage location at gcsPath, e.g.
+// gs://bucket/dir, s3://bucket/dir or file:///dir, and removes backupDir
+// afterwards. The objects are named after the path of the files in the RMAN
+// staging dir, where a download puts them back to.
+func (s *Server) uploadDirectoryContents(ctx context.Context, backupDir, gcsPath string, creds storage.Credentials) error {
+	klog.InfoS("RunRMAN: uploadDirectoryContents", "backupdir", backupDir, "gcsPath", gcsPath)
+	target, err := storage.Parse(gcsPath)
+	if err != nil {
+		return errors.Errorf("invalid GcsPath err: %v", err)
+	}
+	err = filepath.Walk(backupDir, func(fpath string, info os.FileInfo, errInner error) error {
 		klog.InfoS("RunRMAN: walking...", "fpath", fpath, "info", info, "errInner", errInner)
 		if errInner != nil {
 			return errInner
@@ -1080,34 +1294,89 @@ func (s *Server) uploadDirectoryContentsToGCS(ctx context.Context, backupDir, gc
 			return nil
 		}
 
-		relPath, err := filepath.Rel(backupDir, fpath)
-		if err != nil {
-			return errors.Errorf("filepath.Rel(%s, %s) returned err: %s", backupDir, fpath, err)
-		}
-		gcsTarget, err := url.Parse(gcsPath)
+		relPath, err := filepath.Rel(consts.RMANStagingDir, fpath)
 		if err != nil {
-			return errors.Errorf("invalid GcsPath err: %v", err)
+			return errors.Errorf("filepath.Rel(%s, %s) returned err: %s", consts.RMANStagingDir, fpath, err)
 		}
-		gcsTarget.Path = path.Join(gcsTarget.Path, relPath)
-		klog.InfoS("gcs", "target", gcsTarget)
+		objTarget := target.Join(filepath.ToSlash(relPath))
+		klog.InfoS("storage", "target", objTarget)
 		start := time.Now()
-		err = s.gcsUtil.uploadFile(ctx, gcsTarget.String(), fpath, contentTypePlainText)
+		err = s.storageUtil.uploadFile(ctx, objTarget.String(), fpath, contentTypePlainText, creds)
 		if err != nil {
 			return err
 		}
 		end := time.Now()
 		rate := float64(info.Size()) / (end.Sub(start).Seconds())
-		klog.Infof("Uploaded %s (%f MB/s)\n", gcsTarget.String(), rate/1024/1024)
+		klog.Infof("Uploaded %s (%f MB/s)\n", objTarget, rate/1024/1024)
 
 		return nil
 	})
 
-	if err := os.RemoveAll(consts.RMANStagingDir); err != nil {
-		klog.Warningf("uploadDirectoryContentsToGCS: can't cleanup staging dir from local disk.")
+	if err := os.RemoveAll(backupDir); err != nil {
+		klog.Warningf("uploadDirectoryContents: can't cleanup staging dir from local disk.")
 	}
 	return err
 }
 
+// downloadBackupPieces downloads the pieces of a backup from a storage
+// location back to the staging dir they were uploaded from, which restores
+// the locations recorded in the control file. The objects of a backup staged
+// in a dir of its own are prefixed with the name of that dir, those of a
+// backup staged in the staging dir itself aren't. It returns what was
+// downloaded to be removed afterwards, also on error: the dir of the backup,
+// or its files.
+func (s *Server) downloadBackupPieces(ctx context.Context, gcsPath, stagingDir string, creds storage.Credentials) ([]string, error) {
+	prefix, err := storage.Parse(gcsPath)
+	if err != nil {
+		return nil, fmt.Errorf("invalid GcsPath %q: %v", gcsPath, err)
+	}
+	objects, err := s.storageUtil.list(ctx, gcsPath, creds)
+	if err != nil {
+		return nil, err
+	}
+	baseDir, dest := prefix.Name, consts.RMANStagingDir
+	if rel, err := filepath.Rel(consts.RMANStagingDir, stagingDir); err == nil && rel != "." {
+		staged := prefix.Join(filepath.ToSlash(rel))
+		var pieces []*storage.URI
+		for _, obj := range objects {
+			if strings.HasPrefix(obj.Name, staged.Name+"/") {
+				pieces = append(pieces, obj)
+			}
+		}
+		if len(pieces) > 0 {
+			objects, baseDir, dest = pieces, staged.Name, stagingDir
+		}
+	}
+
+	var downloaded []string
+	if dest != consts.RMANStagingDir {
+		downloaded = []string{dest}
+	}
+	for _, obj := range objects {
+		relPath, err := filepath.Rel(baseDir, obj.Name)
+		if err != nil || strings.HasPrefix(relPath, "..") {
+			continue
+		}
+		if err := s.downloadFile(ctx, obj, baseDir, dest, creds); err != nil {
+			return downloaded, err
+		}
+		if dest == consts.RMANStagingDir {
+			downloaded = append(downloaded, filepath.Join(dest, relPath))
+		}
+	}
+	return downloaded, nil
+}
+
+// rmanStagingDir returns the staging dir of a backup to a storage location,
+// the local path of the request if it's a dir in the RMAN staging dir.
+func rmanStagingDir(req *dbdpb.RunRMANRequest) string {
+	dir := filepath.Clean(req.GetLocalPath())
+	if strings.HasPrefix(dir, consts.RMANStagingDir+string(filepath.Separator)) {
+		return dir
+	}
+	return consts.RMANStagingDir
+}
+
 // NID changes a database id and/or database name.
 func (s *Server) NID(ctx context.Context, req *dbdpb.NIDRequest) (*dbdpb.NIDResponse, error) {
 	params := []string{"target=/"}
@@ -1141,6 +1410,108 @@ func (s *Server) NID(ctx context.Context, req *dbdpb.NIDRequest) (*dbdpb.NIDResp
 	return &dbdpb.NIDResponse{}, nil
 }
 
+// changeDatabaseIdentity generates a new DBID for the database with NID and
+// renames it to req.CdbName if requested, e.g. after a backup of another CDB
+// was restored. The database is restarted and opened with resetlogs.
+func (s *Server) changeDatabaseIdentity(ctx context.Context, req *dbdpb.ChangeDatabaseIdentityRequest) (*dbdpb.ChangeDatabaseIdentityResponse, error) {
+	klog.InfoS("dbdaemon/changeDatabaseIdentity", "req", req)
+	errorPrefix := "dbdaemon/changeDatabaseIdentity: "
+	cdbName := req.GetCdbName()
+
+	// NID requires a database that was shut down consistently and mounted.
+	bounce := func(op dbdpb.BounceDatabaseRequest_Operation, option string) error {
+		_, err := s.BounceDatabase(ctx, &dbdpb.BounceDatabaseRequest{
+			Operation:         op,
+			DatabaseName:      cdbName,
+			Option:            option,
+			AvoidConfigBackup: true,
+		})
+		return err
+	}
+	if err := bounce(dbdpb.BounceDatabaseRequest_SHUTDOWN, "immediate"); err != nil {
+		return nil, fmt.Errorf(errorPrefix+"failed to shut down the database: %v", err)
+	}
+	if err := bounce(dbdpb.BounceDatabaseRequest_STARTUP, "mount"); err != nil {
+		return nil, fmt.Errorf(errorPrefix+"failed to mount the database: %v", err)
+	}
+	nidReq := &dbdpb.NIDRequest{Sid: cdbName}
+	if req.GetRename() {
+		nidReq.DatabaseName = cdbName
+	}
+	if _, err := s.NID(ctx, nidReq); err != nil {
+		return nil, fmt.Errorf(errorPrefix+"%v", err)
+	}
+
+	// NID leaves the database shut down.
+	if req.GetRename() {
+		if err := bounce(dbdpb.BounceDatabaseRequest_STARTUP, "nomount"); err != nil {
+			return nil, fmt.Errorf(errorPrefix+"failed to start the database in nomount: %v", err)
+		}
+		if _, err := s.RunSQLPlus(ctx, &dbdpb.RunSQLPlusCMDRequest{Commands: []string{fmt.Sprintf(setDBNameSQL, cdbName)}}); err != nil {
+			return nil, fmt.Errorf(errorPrefix+"failed to set db_name: %v", err)
+		}
+		if err := bounce(dbdpb.BounceDatabaseRequest_SHUTDOWN, "immediate"); err != nil {
+			return nil, fmt.Errorf(errorPrefix+"failed to shut down the database: %v", err)
+		}
+	}
+	if err := bounce(dbdpb.BounceDatabaseRequest_STARTUP, "mount"); err != nil {
+		return nil, fmt.Errorf(errorPrefix+"failed to mount the database: %v", err)
+	}
+	if _, err := s.RunSQLPlus(ctx, &dbdpb.RunSQLPlusCMDRequest{Commands: []string{openResetlogsSQL, openAllPDBsSQL}}); err != nil {
+		return nil, fmt.Errorf(errorPrefix+"failed to open the database: %v", err)
+	}
+
+	resp, err := s.RunSQLPlusFormatted(ctx, &dbdpb.RunSQLPlusCMDRequest{Commands: []string{dbidSQL}})
+	if err != nil || len(resp.GetMsg()) != 1 {
+		return nil, fmt.Errorf(errorPrefix+"failed to query the new DBID, results: %v, err: %v", resp, err)
+	}
+	row := make(map[string]string)
+	if err := json.Unmarshal([]byte(resp.GetMsg()[0]), &row); err != nil {
+		return nil, err
+	}
+	dbid, err := strconv.ParseInt(row["DBID"], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf(errorPrefix+"failed to parse the DBID %q: %v", row["DBID"], err)
+	}
+	klog.InfoS("dbdaemon/changeDatabaseIdentity: done", "cdbName", cdbName, "dbid", dbid)
+	return &dbdpb.ChangeDatabaseIdentityResponse{Dbid: dbid}, nil
+}
+
+// ChangeDatabaseIdentityAsync turns changeDatabaseIdentity into an async call.
+func (s *Server) ChangeDatabaseIdentityAsync(ctx context.Context, req *dbdpb.ChangeDatabaseIdentityAsyncRequest) (*lropb.Operation, error) {
+	job, err := lro.CreateAndRunLROJobWithID(ctx, req.GetLroInput().GetOperationId(), "ChangeDatabaseIdentity", s.lroServer,
+		func(ctx context.Context) (proto.Message, error) {
+			return s.changeDatabaseIdentity(ctx, req.SyncRequest)
+		})
+
+	if err != nil {
+		klog.ErrorS(err, "dbdaemon/ChangeDatabaseIdentityAsync failed to create an LRO job", "request", req)
+		return nil, err
+	}
+
+	return &lropb.Operation{Name: job.ID(), Done: false}, nil
+}
+
+// GetAuditLogHead returns the head of the audit log.
+func (s *Server) GetAuditLogHead(ctx context.Context, req *dbdpb.GetAuditLogHeadRequest) (*dbdpb.GetAuditLogHeadResponse, error) {
+	head := s.auditLog.Head()
+	return &dbdpb.GetAuditLogHeadResponse{Seq: head.Seq, Hash: head.Hash}, nil
+}
+
+// RunDatapatch opens all the PDBs and runs datapatch, which applies the SQL
+// changes of the patches installed in the Oracle home to the CDB and PDBs.
+func (s *Server) RunDatapatch(ctx context.Context, req *dbdpb.RunDatapatchRequest) (*dbdpb.RunDatapatchResponse, error) {
+	klog.InfoS("dbdaemon/RunDatapatch", "req", req)
+	if err := s.database.openPDBs(ctx); err != nil {
+		return nil, fmt.Errorf("dbdaemon/RunDatapatch: failed to open PDBs: %v", err)
+	}
+	if err := s.runCommand(ctx, datapatch(s.databaseHome), []string{"-verbose"}); err != nil {
+		return nil, fmt.Errorf("dbdaemon/RunDatapatch: datapatch failed: %v", err)
+	}
+	klog.InfoS("dbdaemon/RunDatapatch: DONE")
+	return &dbdpb.RunDatapatchResponse{}, nil
+}
+
 // GetDatabaseType returns database type, eg. ORACLE_12_2_ENTERPRISE_NONCDB
 func (s *Server) GetDatabaseType(ctx context.Context, req *dbdpb.GetDatabaseTypeRequest) (*dbdpb.GetDatabaseTypeResponse, error) {
 	f, err := os.Open(consts.OraTab)
@@ -1366,10 +1737,10 @@ func (s *Server) CreateCDB(ctx context.Context, req *dbdpb.CreateCDBRequest) (*d
 
 // CreateCDBAsync turns CreateCDB into an async call.
 func (s *Server) CreateCDBAsync(ctx context.Context, req *dbdpb.CreateCDBAsyncRequest) (*lropb.Operation, error) {
-	job, err := lro.CreateAndRunLROJobWithID(ctx, req.GetLroInput().GetOperationId(), "CreateCDB", s.lroServer,
+	job, err := lro.CreateAndRunLROJobWithProgress(ctx, req.GetLroInput().GetOperationId(), "CreateCDB", s.lroServer,
 		func(ctx context.Context) (proto.Message, error) {
 			return s.CreateCDB(ctx, req.SyncRequest)
-		})
+		}, dbcaProgressFunc(req.GetSyncRequest(), time.Now()))
 
 	if err != nil {
 		klog.ErrorS(err, "dbdaemon/CreateCDBAsync failed to create an LRO job", "request", req)
@@ -1713,6 +2084,21 @@ func New(ctx context.Context, cdbNameFromYaml string) (*Server, error) {
 		return nil, fmt.Errorf("failed to get hostname: %v", err)
 	}
 
+	auditLog, err := audit.NewLogger(fmt.Sprintf(consts.AuditLogFile, consts.DataMount))
+	if err != nil {
+		return nil, fmt.Errorf("failed to open the audit log: %v", err)
+	}
+
+	// The operations survive restarts of the Database Daemon.
+	lroStore, err := lro.NewFileStore(fmt.Sprintf(consts.LROStoreDir, consts.DataMount))
+	if err != nil {
+		return nil, err
+	}
+	lroServer, err := lro.NewServerWithStore(ctx, lroStore)
+	if err != nil {
+		return nil, err
+	}
+
 	s := &Server{
 		hostName:       hostname,
 		database:       &DB{},
@@ -1720,9 +2106,10 @@ func New(ctx context.Context, cdbNameFromYaml string) (*Server, error) {
 		databaseSid:    &syncState{},
 		dbdClient:      dbdpb.NewDatabaseDaemonProxyClient(conn),
 		dbdClientClose: conn.Close,
-		lroServer:      lro.NewServer(ctx),
+		lroServer:      lroServer,
 		syncJobs:       &syncJobs{},
-		gcsUtil:        &gcsUtilImpl{},
+		storageUtil:    &storageUtilImpl{},
+		auditLog:       auditLog,
 	}
 
 	oracleHome, _, _, err := provision.FetchMetaDataFromImage(provision.MetaDataFile)
@@ -1735,35 +2122,25 @@ func New(ctx context.Context, cdbNameFromYaml string) (*Server, error) {
 	return s, nil
 }
 
-// DownloadDirectoryFromGCS downloads objects from GCS bucket using prefix
+// DownloadDirectoryFromGCS downloads objects from a storage location using
+// prefix, e.g. gs://bucket/dir, s3://bucket/dir or file:///dir.
 func (s *Server) DownloadDirectoryFromGCS(ctx context.Context, req *dbdpb.DownloadDirectoryFromGCSRequest) (*dbdpb.DownloadDirectoryFromGCSResponse, error) {
 
-	klog.Infof("dbdaemon/DownloadDirectoryFromGCS: req %v", req)
-	bucket, prefix, err := s.gcsUtil.splitURI(req.GcsPath)
+	klog.InfoS("dbdaemon/DownloadDirectoryFromGCS", "gcsPath", req.GetGcsPath(), "localPath", req.GetLocalPath())
+	prefix, err := storage.Parse(req.GetGcsPath())
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse gcs path %s", err)
 	}
 
 	klog.Infof("dbdaemon/downloadDirectoryFromGCS: destination path is %s", req.GetLocalPath())
-	client, err := storage.NewClient(ctx)
-	if err != nil {
-		return nil, fmt.Errorf("storage.NewClient: %v", err)
-	}
-	defer client.Close()
 	ctx, cancel := context.WithTimeout(ctx, time.Second*3600)
 	defer cancel()
-	it := client.Bucket(bucket).Objects(ctx, &storage.Query{
-		Prefix: prefix,
-	})
-	for {
-		attrs, err := it.Next()
-		if err == iterator.Done {
-			break
-		}
-		if err != nil {
-			return nil, fmt.Errorf("Bucket(%q).Objects(): %v", bucket, err)
-		}
-		if err := s.downloadFile(ctx, client, bucket, attrs.Name, prefix, req.GetLocalPath()); err != nil {
+	objects, err := s.storageUtil.list(ctx, req.GetGcsPath(), req.GetStorageCredentials())
+	if err != nil {
+		return nil, err
+	}
+	for _, obj := range objects {
+		if err := s.downloadFile(ctx, obj, prefix.Name, req.GetLocalPath(), req.GetStorageCredentials()); err != nil {
 			return nil, fmt.Errorf("failed to download file %s", err)
 		}
 	}
@@ -1779,16 +2156,175 @@ func (s *Server) FetchServiceImageMetaData(ctx context.Context, req *dbdpb.Fetch
 	return &dbdpb.FetchServiceImageMetaDataResponse{Version: version, CdbName: cdbName, OracleHome: oracleHome}, nil
 }
 
-func (s *Server) downloadFile(ctx context.Context, c *storage.Client, bucket, gcsPath, baseDir, dest string) error {
-	reader, err := c.Bucket(bucket).Object(gcsPath).NewReader(ctx)
+// ShipArchivedLogs uploads archived redo logs of the current incarnation
+// with a sequence greater than the requested one to a storage location.
+// Logs are stored as <gcs_path>/<incarnation>/thread_<thread>_seq_<sequence>.arc
+// and shipped in sequence order, so that a failed upload can be retried
+// from the last shipped sequence. A failed upload ends the round with the
+// error in the response, which still records the logs shipped before it.
+func (s *Server) ShipArchivedLogs(ctx context.Context, req *dbdpb.ShipArchivedLogsRequest) (*dbdpb.ShipArchivedLogsResponse, error) {
+	klog.InfoS("dbdaemon/ShipArchivedLogs", "req", common.RedactStorageCredentials(req))
+	errorPrefix := "dbdaemon/ShipArchivedLogs: "
+
+	incResp, err := s.RunSQLPlusFormatted(ctx, &dbdpb.RunSQLPlusCMDRequest{Commands: []string{currentIncarnationSQL}})
+	if err != nil || len(incResp.GetMsg()) != 1 {
+		return nil, fmt.Errorf(errorPrefix+"failed to query the current incarnation, results: %v, err: %v", incResp, err)
+	}
+	row := make(map[string]string)
+	if err := json.Unmarshal([]byte(incResp.GetMsg()[0]), &row); err != nil {
+		return nil, err
+	}
+	incarnation, err := strconv.ParseInt(row["INCARNATION"], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf(errorPrefix+"failed to parse the current incarnation %q: %v", row["INCARNATION"], err)
+	}
+
+	resp := &dbdpb.ShipArchivedLogsResponse{Incarnation: incarnation}
+	if incarnation == req.GetIncarnation() {
+		resp.LastSequence = req.GetAfterSequence()
+	} else {
+		klog.InfoS(errorPrefix+"incarnation changed, shipping all archived logs", "old", req.GetIncarnation(), "new", incarnation)
+	}
+
+	logsResp, err := s.RunSQLPlusFormatted(ctx, &dbdpb.RunSQLPlusCMDRequest{Commands: []string{fmt.Sprintf(archivedLogsSQL, incarnation, resp.LastSequence)}})
+	if err != nil {
+		return nil, fmt.Errorf(errorPrefix+"failed to query archived logs: %v", err)
+	}
+
+	for _, msg := range logsResp.GetMsg() {
+		row := make(map[string]string)
+		if err := json.Unmarshal([]byte(msg), &row); err != nil {
+			return nil, err
+		}
+		sequence, err := strconv.ParseInt(row["SEQUENCE"], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf(errorPrefix+"failed to parse the archived log sequence %q: %v", row["SEQUENCE"], err)
+		}
+		// The same sequence can be archived to multiple destinations.
+		if sequence <= resp.LastSequence {
+			continue
+		}
+		nextSCN, err := strconv.ParseInt(row["NEXT_SCN"], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf(errorPrefix+"failed to parse the archived log next SCN %q: %v", row["NEXT_SCN"], err)
+		}
+		nextTime, err := time.Parse(recoveryTimeLayout, row["NEXT_TIME"])
+		if err != nil {
+			return nil, fmt.Errorf(errorPrefix+"failed to parse the archived log next time %q: %v", row["NEXT_TIME"], err)
+		}
+
+		gcsTarget, err := archivedLogGCSPath(req.GetGcsPath(), incarnation, row["THREAD"], sequence)
+		if err != nil {
+			return nil, fmt.Errorf(errorPrefix+"%v", err)
+		}
+		start := time.Now()
+		if err := s.storageUtil.uploadFile(ctx, gcsTarget, row["NAME"], contentTypePlainText, req.GetStorageCredentials()); err != nil {
+			klog.ErrorS(err, errorPrefix+"failed to upload an archived log", "name", row["NAME"], "target", gcsTarget, "shipped", resp.ShippedCount)
+			resp.ErrorMessage = fmt.Sprintf("failed to upload %s to %s: %v", row["NAME"], gcsTarget, err)
+			return resp, nil
+		}
+		klog.InfoS(errorPrefix+"shipped archived log", "name", row["NAME"], "target", gcsTarget, "elapsed", time.Since(start))
+
+		lastTime, err := ptypes.TimestampProto(nextTime)
+		if err != nil {
+			return nil, fmt.Errorf(errorPrefix+"failed to convert %v: %v", nextTime, err)
+		}
+		resp.LastSequence = sequence
+		resp.LastScn = nextSCN
+		resp.LastTime = lastTime
+		resp.ShippedCount++
+	}
+	return resp, nil
+}
+
+// ShipArchivedLogsAsync turns ShipArchivedLogs into an async call.
+func (s *Server) ShipArchivedLogsAsync(ctx context.Context, req *dbdpb.ShipArchivedLogsAsyncRequest) (*lropb.Operation, error) {
+	job, err := lro.CreateAndRunLROJobWithID(ctx, req.GetLroInput().GetOperationId(), "ShipArchivedLogs", s.lroServer,
+		func(ctx context.Context) (proto.Message, error) {
+			return s.ShipArchivedLogs(ctx, req.SyncRequest)
+		})
+
 	if err != nil {
-		return fmt.Errorf("failed to read URL %s: %v", gcsPath, err)
+		klog.ErrorS(err, "dbdaemon/ShipArchivedLogsAsync failed to create an LRO job", "request", common.RedactStorageCredentials(req))
+		return nil, err
+	}
+
+	return &lropb.Operation{Name: job.ID(), Done: false}, nil
+}
+
+// archivedLogGCSPath returns the GCS location of a shipped archived log.
+func archivedLogGCSPath(gcsPath string, incarnation int64, thread string, sequence int64) (string, error) {
+	gcsTarget, err := url.Parse(gcsPath)
+	if err != nil {
+		return "", errors.Errorf("invalid GcsPath err: %v", err)
+	}
+	gcsTarget.Path = path.Join(gcsTarget.Path, strconv.FormatInt(incarnation, 10), fmt.Sprintf("thread_%s_seq_%d.arc", thread, sequence))
+	return gcsTarget.String(), nil
+}
+
+// DeleteBackup deletes the RMAN backup pieces with the tag given in the
+// request. Pieces taken to a staging dir and uploaded to req.GcsPath are
+// deleted from the storage location as well.
+func (s *Server) DeleteBackup(ctx context.Context, req *dbdpb.DeleteBackupRequest) (*dbdpb.DeleteBackupResponse, error) {
+	klog.InfoS("dbdaemon/DeleteBackup", "req", common.RedactStorageCredentials(req))
+	errorPrefix := "dbdaemon/DeleteBackup: "
+
+	if !common.RMANTagRegexp.MatchString(req.GetTag()) {
+		return nil, fmt.Errorf(errorPrefix+"invalid RMAN tag %q", req.GetTag())
+	}
+	tag := strings.ToUpper(req.GetTag())
+
+	piecesResp, err := s.RunSQLPlusFormatted(ctx, &dbdpb.RunSQLPlusCMDRequest{Commands: []string{fmt.Sprintf(backupPiecesSQL, tag)}})
+	if err != nil {
+		return nil, fmt.Errorf(errorPrefix+"failed to query backup pieces: %v", err)
+	}
+
+	var target *storage.URI
+	if req.GetGcsPath() != "" {
+		if target, err = storage.Parse(req.GetGcsPath()); err != nil {
+			return nil, fmt.Errorf(errorPrefix+"invalid GcsPath: %v", err)
+		}
+	}
+	resp := &dbdpb.DeleteBackupResponse{}
+	for _, msg := range piecesResp.GetMsg() {
+		row := make(map[string]string)
+		if err := json.Unmarshal([]byte(msg), &row); err != nil {
+			return nil, err
+		}
+		resp.DeletedCount++
+		relPath, err := filepath.Rel(consts.RMANStagingDir, row["HANDLE"])
+		if target == nil || err != nil || strings.HasPrefix(relPath, "..") {
+			continue
+		}
+		// The staging dir is removed after the upload, RMAN only deletes
+		// the catalog records of these pieces.
+		obj := target.Join(filepath.ToSlash(relPath))
+		if err := s.storageUtil.delete(ctx, obj.String(), req.GetStorageCredentials()); err != nil {
+			return nil, fmt.Errorf(errorPrefix+"failed to delete %s: %v", obj, err)
+		}
+		klog.InfoS(errorPrefix+"deleted uploaded backup piece", "handle", row["HANDLE"], "object", obj)
+	}
+
+	if resp.DeletedCount == 0 {
+		klog.InfoS(errorPrefix+"no backup pieces found", "tag", tag)
+		return resp, nil
+	}
+	if _, err := s.RunRMAN(ctx, &dbdpb.RunRMANRequest{Scripts: []string{fmt.Sprintf("delete noprompt force backup tag '%s';", tag)}}); err != nil {
+		return nil, fmt.Errorf(errorPrefix+"failed to delete backup pieces: %v", err)
+	}
+	return resp, nil
+}
+
+func (s *Server) downloadFile(ctx context.Context, obj *storage.URI, baseDir, dest string, creds storage.Credentials) error {
+	reader, err := s.storageUtil.download(ctx, obj.String(), creds)
+	if err != nil {
+		return err
 	}
 	defer reader.Close()
 
-	relPath, err := filepath.Rel(baseDir, gcsPath)
+	relPath, err := filepath.Rel(baseDir, obj.Name)
 	if err != nil {
-		return fmt.Errorf("failed to parse relPath for gcsPath %s", gcsPath)
+		return fmt.Errorf("failed to parse relPath for %s", obj)
 	}
 
 	f := filepath.Join(dest, relPath)
@@ -16,6 +16,9 @@ package dbdaemon
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
 	"fmt"
 	"io"
 	"io/ioutil"
@@ -25,13 +28,22 @@ import (
 	"strings"
 	"syscall"
 	"testing"
+	"time"
 
 	"github.com/godror/godror"
 	"github.com/google/go-cmp/cmp"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
 	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/GoogleCloudPlatform/elcarro-oracle-operator/oracle/pkg/agents/common/audit"
+	"github.com/GoogleCloudPlatform/elcarro-oracle-operator/oracle/pkg/agents/common/mtls"
+	"github.com/GoogleCloudPlatform/elcarro-oracle-operator/oracle/pkg/agents/consts"
 	dbdpb "github.com/GoogleCloudPlatform/elcarro-oracle-operator/oracle/pkg/agents/oracle"
+	"github.com/GoogleCloudPlatform/elcarro-oracle-operator/oracle/pkg/database/lib/storage"
 )
 
 func TestServerCreateDir(t *testing.T) {
@@ -383,6 +395,346 @@ func TestServerString(t *testing.T) {
 	}
 }
 
+func TestValidateRecoveryTarget(t *testing.T) {
+	row := map[string]string{
+		"MIN_SCN":  "1000",
+		"MAX_SCN":  "2000",
+		"MIN_TIME": "2021-06-01 10:00:00",
+		"MAX_TIME": "2021-06-01 12:00:00",
+	}
+	rowWithRestorePoint := func(scn string) map[string]string {
+		r := map[string]string{"TARGET_SCN": scn}
+		for k, v := range row {
+			r[k] = v
+		}
+		return r
+	}
+	testCases := []struct {
+		name    string
+		row     map[string]string
+		req     *dbdpb.PhysicalRestoreRequest
+		wantErr bool
+	}{
+		{
+			name: "until scn in range",
+			row:  row,
+			req:  &dbdpb.PhysicalRestoreRequest{UntilScn: 1500},
+		},
+		{
+			name: "until scn at the last archived change",
+			row:  row,
+			req:  &dbdpb.PhysicalRestoreRequest{UntilScn: 2000},
+		},
+		{
+			name:    "until scn before the backup checkpoint",
+			row:     row,
+			req:     &dbdpb.PhysicalRestoreRequest{UntilScn: 1000},
+			wantErr: true,
+		},
+		{
+			name:    "until scn after the last archived change",
+			row:     row,
+			req:     &dbdpb.PhysicalRestoreRequest{UntilScn: 2001},
+			wantErr: true,
+		},
+		{
+			name: "until time in range",
+			row:  row,
+			req: &dbdpb.PhysicalRestoreRequest{
+				UntilTime: timestamppb.New(time.Date(2021, 6, 1, 11, 0, 0, 0, time.UTC)),
+			},
+		},
+		{
+			name: "until time after the last archived change",
+			row:  row,
+			req: &dbdpb.PhysicalRestoreRequest{
+				UntilTime: timestamppb.New(time.Date(2021, 6, 1, 12, 0, 1, 0, time.UTC)),
+			},
+			wantErr: true,
+		},
+		{
+			name: "restore point in range",
+			row:  rowWithRestorePoint("1200"),
+			req:  &dbdpb.PhysicalRestoreRequest{UntilRestorePoint: "before_deploy"},
+		},
+		{
+			name:    "restore point not found",
+			row:     rowWithRestorePoint(""),
+			req:     &dbdpb.PhysicalRestoreRequest{UntilRestorePoint: "before_deploy"},
+			wantErr: true,
+		},
+		{
+			name:    "restore point out of range",
+			row:     rowWithRestorePoint("900"),
+			req:     &dbdpb.PhysicalRestoreRequest{UntilRestorePoint: "before_deploy"},
+			wantErr: true,
+		},
+		{
+			name:    "no archived logs",
+			row:     map[string]string{"MIN_SCN": "1000", "MAX_SCN": ""},
+			req:     &dbdpb.PhysicalRestoreRequest{UntilScn: 1500},
+			wantErr: true,
+		},
+		{
+			name:    "no target",
+			row:     row,
+			req:     &dbdpb.PhysicalRestoreRequest{},
+			wantErr: true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validateRecoveryTarget(tc.row, tc.req)
+			if gotErr := err != nil; gotErr != tc.wantErr {
+				t.Errorf("validateRecoveryTarget(%v, %v) got err %v, want err %v", tc.row, tc.req, err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestRemoveDatafiles(t *testing.T) {
+	for _, pattern := range []string{"/u03/app/oracle/rman/*.dbf", oraDataDir + "/../*.dbf", oraDataDir} {
+		if err := removeDatafiles([]string{pattern}); err == nil {
+			t.Errorf("removeDatafiles(%q) succeeded, want an error for a pattern outside of %s", pattern, oraDataDir)
+		}
+	}
+	if err := removeDatafiles([]string{oraDataDir + "/NOSUCHCDB/*.dbf"}); err != nil {
+		t.Errorf("removeDatafiles() failed for a pattern without matches: %v", err)
+	}
+}
+
+// fakeStorageUtil lists a fixed set of objects with empty contents.
+type fakeStorageUtil struct {
+	storageUtilImpl
+	objects []string
+}
+
+func (f *fakeStorageUtil) list(ctx context.Context, uri string, creds storage.Credentials) ([]*storage.URI, error) {
+	var objects []*storage.URI
+	for _, o := range f.objects {
+		if strings.HasPrefix(o, uri) {
+			u, err := storage.Parse(o)
+			if err != nil {
+				return nil, err
+			}
+			objects = append(objects, u)
+		}
+	}
+	return objects, nil
+}
+
+func (f *fakeStorageUtil) download(ctx context.Context, uri string, creds storage.Credentials) (io.ReadCloser, error) {
+	return ioutil.NopCloser(strings.NewReader("")), nil
+}
+
+// createFileOsUtil records the files created.
+type createFileOsUtil struct {
+	mockOsUtil
+	files []string
+}
+
+func (c *createFileOsUtil) createFile(file string, content io.Reader) error {
+	c.files = append(c.files, file)
+	return nil
+}
+
+func TestDownloadBackupPieces(t *testing.T) {
+	testCases := []struct {
+		name           string
+		objects        []string
+		stagingDir     string
+		wantFiles      []string
+		wantDownloaded []string
+	}{
+		{
+			name:           "staged in the dir of the backup",
+			objects:        []string{"gs://bucket/backup/EL_TAG/GCLOUD/backupset/a.bkp", "gs://bucket/backup/EL_TAG2/GCLOUD/backupset/b.bkp"},
+			stagingDir:     consts.RMANStagingDir + "/EL_TAG",
+			wantFiles:      []string{consts.RMANStagingDir + "/EL_TAG/GCLOUD/backupset/a.bkp"},
+			wantDownloaded: []string{consts.RMANStagingDir + "/EL_TAG"},
+		},
+		{
+			name:           "staged in the staging dir",
+			objects:        []string{"gs://bucket/backup/GCLOUD/backupset/a.bkp"},
+			stagingDir:     consts.RMANStagingDir + "/EL_TAG",
+			wantFiles:      []string{consts.RMANStagingDir + "/GCLOUD/backupset/a.bkp"},
+			wantDownloaded: []string{consts.RMANStagingDir + "/GCLOUD/backupset/a.bkp"},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			osUtil := &createFileOsUtil{}
+			s := &Server{osUtil: osUtil, storageUtil: &fakeStorageUtil{objects: tc.objects}}
+			downloaded, err := s.downloadBackupPieces(context.Background(), "gs://bucket/backup", tc.stagingDir, nil)
+			if err != nil {
+				t.Fatalf("downloadBackupPieces() failed: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantFiles, osUtil.files); diff != "" {
+				t.Errorf("downloadBackupPieces() created files diff (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.wantDownloaded, downloaded); diff != "" {
+				t.Errorf("downloadBackupPieces() returned diff (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestArchivedLogGCSPath(t *testing.T) {
+	testCases := []struct {
+		gcsPath string
+		want    string
+	}{
+		{
+			gcsPath: "gs://bucket",
+			want:    "gs://bucket/1066408372/thread_1_seq_42.arc",
+		},
+		{
+			gcsPath: "gs://bucket/archivelogs/",
+			want:    "gs://bucket/archivelogs/1066408372/thread_1_seq_42.arc",
+		},
+	}
+	for _, tc := range testCases {
+		got, err := archivedLogGCSPath(tc.gcsPath, 1066408372, "1", 42)
+		if err != nil {
+			t.Fatalf("archivedLogGCSPath(%q) failed: %v", tc.gcsPath, err)
+		}
+		if got != tc.want {
+			t.Errorf("archivedLogGCSPath(%q) got %q, want %q", tc.gcsPath, got, tc.want)
+		}
+	}
+}
+
+func TestRMANStagingDir(t *testing.T) {
+	testCases := []struct {
+		localPath string
+		want      string
+	}{
+		{
+			localPath: "",
+			want:      consts.RMANStagingDir,
+		},
+		{
+			localPath: consts.RMANStagingDir + "/EL_TAG",
+			want:      consts.RMANStagingDir + "/EL_TAG",
+		},
+		{
+			localPath: consts.RMANStagingDir + "/../oradata",
+			want:      consts.RMANStagingDir,
+		},
+		{
+			localPath: "/u03/app/oracle/rman",
+			want:      consts.RMANStagingDir,
+		},
+	}
+	for _, tc := range testCases {
+		if got := rmanStagingDir(&dbdpb.RunRMANRequest{LocalPath: tc.localPath}); got != tc.want {
+			t.Errorf("rmanStagingDir(%q) got %q, want %q", tc.localPath, got, tc.want)
+		}
+	}
+}
+
+func TestServerRunDatapatch(t *testing.T) {
+	s, _ := NewMockServer(context.Background(), "MOCK_DB")
+	if _, err := s.RunDatapatch(context.Background(), &dbdpb.RunDatapatchRequest{}); err != nil {
+		t.Fatalf("RunDatapatch failed: %v", err)
+	}
+	if got := s.database.(*mockDB).openPDBsCount; got != 1 {
+		t.Errorf("RunDatapatch opened PDBs %d times, want 1", got)
+	}
+	want := []string{datapatch("DBHOME")}
+	if diff := cmp.Diff(want, s.osUtil.(*mockOsUtil).commands); diff != "" {
+		t.Errorf("RunDatapatch ran unexpected commands: -want +got %v", diff)
+	}
+}
+
+func TestDataPumpJobName(t *testing.T) {
+	got := dataPumpJobName("EXPORT", time.Date(2021, 10, 17, 12, 30, 45, 0, time.UTC))
+	if want := "ELCARRO_EXPORT_20211017123045"; got != want {
+		t.Errorf("dataPumpJobName() = %q, want %q", got, want)
+	}
+	if len(got) > 30 {
+		t.Errorf("dataPumpJobName() = %q is longer than 30 bytes", got)
+	}
+}
+
+func TestServerRunRMANAudit(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.log")
+	auditLog, err := audit.NewLogger(path)
+	if err != nil {
+		t.Fatalf("audit.NewLogger(%q) failed: %v", path, err)
+	}
+	defer auditLog.Close()
+	s, _ := NewMockServer(context.Background(), "MOCK_DB")
+	s.auditLog = auditLog
+
+	// The Config Agent calls on behalf of the operator.
+	cert := &x509.Certificate{Subject: pkix.Name{CommonName: "inst.ns config-agent", OrganizationalUnit: []string{mtls.RoleConfigAgent}}}
+	ctx := peer.NewContext(
+		metadata.NewIncomingContext(context.Background(), metadata.Pairs(audit.CallerMetadataKey, "cn=inst.ns operator")),
+		&peer.Peer{AuthInfo: credentials.TLSInfo{State: tls.ConnectionState{
+			PeerCertificates: []*x509.Certificate{cert},
+			VerifiedChains:   [][]*x509.Certificate{{cert}},
+		}}})
+	script := "connect target sys/secret@GCLOUD\nbackup database;"
+	// RMAN doesn't exist in the mock database home.
+	if _, err := s.RunRMAN(ctx, &dbdpb.RunRMANRequest{Scripts: []string{script}, Suppress: true}); err == nil {
+		t.Fatal("RunRMAN succeeded, want an error")
+	}
+
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatalf("ReadFile(%q) failed: %v", path, err)
+	}
+	if n, err := audit.Verify(strings.NewReader(string(b)), auditLog.Head()); err != nil || n != 1 {
+		t.Fatalf("audit.Verify() = %d, %v, want 1, nil", n, err)
+	}
+	for _, want := range []string{`"caller":"cn=inst.ns operator > cn=inst.ns config-agent"`, `"rpc":"RunRMAN"`, `"outcome":"FAILURE"`, "sys/<REDACTED>@GCLOUD"} {
+		if !strings.Contains(string(b), want) {
+			t.Errorf("audit log %s doesn't contain %s", b, want)
+		}
+	}
+	if strings.Contains(string(b), "secret") {
+		t.Errorf("audit log %s contains the password", b)
+	}
+}
+
+func TestServerRunRMANConnect(t *testing.T) {
+	dir := t.TempDir()
+	fakeRMAN := filepath.Join(dir, "rman")
+	script := fmt.Sprintf("#!/bin/sh\necho \"$@\" > %[1]s/args\ncat > %[1]s/stdin\n", dir)
+	if err := ioutil.WriteFile(fakeRMAN, []byte(script), 0750); err != nil {
+		t.Fatalf("failed to write the fake RMAN: %v", err)
+	}
+	defer func(f func(string) string) { rman = f }(rman)
+	rman = func(string) string { return fakeRMAN }
+
+	s, _ := NewMockServer(context.Background(), "MOCK_DB")
+	if _, err := s.RunRMAN(context.Background(), &dbdpb.RunRMANRequest{
+		Scripts:   []string{"duplicate target database for standby;"},
+		Target:    "sys/secret@primary",
+		Auxiliary: "sys/secret@standby",
+		Suppress:  true,
+	}); err != nil {
+		t.Fatalf("RunRMAN failed: %v", err)
+	}
+
+	args, err := ioutil.ReadFile(filepath.Join(dir, "args"))
+	if err != nil {
+		t.Fatalf("failed to read the RMAN arguments: %v", err)
+	}
+	if strings.Contains(string(args), "secret") {
+		t.Errorf("RMAN arguments %q contain the password", args)
+	}
+	stdin, err := ioutil.ReadFile(filepath.Join(dir, "stdin"))
+	if err != nil {
+		t.Fatalf("failed to read the RMAN input: %v", err)
+	}
+	want := "connect target sys/secret@primary;\nconnect auxiliary sys/secret@standby;\nduplicate target database for standby;"
+	if string(stdin) != want {
+		t.Errorf("RMAN input = %q, want %q", stdin, want)
+	}
+}
+
 // Mock DB ('dbdaemon' interface)
 type mockDB struct {
 	setDatabaseUpgradeModeCount int
@@ -451,7 +803,7 @@ type mockOsUtil struct {
 	commands []string
 }
 
-func (m *mockOsUtil) runCommand(bin string, params []string) error {
+func (m *mockOsUtil) runCommand(ctx context.Context, bin string, params []string) error {
 	m.commands = append(m.commands, bin)
 	return nil
 }
@@ -479,7 +831,7 @@ func NewMockServer(ctx context.Context, cdbNameFromYaml string) (*Server, error)
 		dbdClientClose: nil,
 		lroServer:      nil,
 		syncJobs:       &syncJobs{},
-		gcsUtil:        &gcsUtilImpl{},
+		storageUtil:    &storageUtilImpl{},
 	}
 	s.databaseHome = "DBHOME"
 	s.databaseSid.val = "MOCK_DB"
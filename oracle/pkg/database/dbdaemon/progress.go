@@ -0,0 +1,183 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbdaemon
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"k8s.io/klog/v2"
+
+	"github.com/GoogleCloudPlatform/elcarro-oracle-operator/oracle/pkg/agents/consts"
+	dbdpb "github.com/GoogleCloudPlatform/elcarro-oracle-operator/oracle/pkg/agents/oracle"
+	"github.com/GoogleCloudPlatform/elcarro-oracle-operator/oracle/pkg/database/common"
+	"github.com/GoogleCloudPlatform/elcarro-oracle-operator/oracle/pkg/database/lib/lro"
+)
+
+const (
+	// rmanProgressSQL returns the progress of the running RMAN backup or
+	// restore. The aggregate input row covers all the files of the job.
+	rmanProgressSQL = `select to_char(sofar) as sofar, to_char(totalwork) as totalwork, to_char(time_remaining) as time_remaining
+		from v$session_longops
+		where opname like 'RMAN: aggregate input%' and totalwork > 0 and sofar < totalwork
+		order by start_time desc fetch first 1 rows only`
+
+	// dataPumpProgressSQL returns the progress of the running Data Pump job
+	// of the PDB loader user, the work is measured in megabytes.
+	dataPumpProgressSQL = `select to_char(l.sofar) as sofar, to_char(l.totalwork) as totalwork, to_char(l.time_remaining) as time_remaining
+		from v$session_longops l join cdb_datapump_jobs j on l.opname = j.job_name and l.username = j.owner_name
+		where j.owner_name = '%s' and j.state = 'EXECUTING' and l.totalwork > 0 and l.sofar < l.totalwork
+		order by l.start_time desc fetch first 1 rows only`
+)
+
+// dbcaProgressRegex matches the progress lines of DBCA, e.g. "40% complete".
+var dbcaProgressRegex = regexp.MustCompile(`^(\d{1,3})% complete$`)
+
+// rmanProgress returns the progress of the running RMAN job.
+func (s *Server) rmanProgress(ctx context.Context) (proto.Message, error) {
+	return s.longOpsProgress(ctx, rmanProgressSQL)
+}
+
+// dataPumpProgress returns the progress of the running Data Pump job.
+func (s *Server) dataPumpProgress(ctx context.Context) (proto.Message, error) {
+	return s.longOpsProgress(ctx, fmt.Sprintf(dataPumpProgressSQL, strings.ToUpper(consts.PDBLoaderUser)))
+}
+
+// longOpsProgress runs a v$session_longops query which returns the sofar,
+// totalwork and time_remaining columns of an operation. It takes the read
+// lock of the database SID only, so that it runs alongside the RMAN job it
+// reports on. It returns nil if the operation has no long running step at
+// the moment.
+func (s *Server) longOpsProgress(ctx context.Context, query string) (proto.Message, error) {
+	s.databaseSid.RLock()
+	defer s.databaseSid.RUnlock()
+	if err := os.Setenv("ORACLE_SID", s.databaseSid.val); err != nil {
+		return nil, fmt.Errorf("failed to set env variable: %v", err)
+	}
+	if err := os.Setenv("ORACLE_HOME", s.databaseHome); err != nil {
+		return nil, fmt.Errorf("failed to set env variable: %v", err)
+	}
+	db, err := open(ctx, "oracle://?sysdba=1", false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open a database connection: %v", err)
+	}
+	defer func() {
+		if err := db.Close(); err != nil {
+			klog.Warningf("failed to close db connection: %v", err)
+		}
+	}()
+
+	rows, err := s.database.runQuery(ctx, []string{query}, db)
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return nil, nil
+	}
+	row := make(map[string]string)
+	if err := json.Unmarshal([]byte(rows[0]), &row); err != nil {
+		return nil, err
+	}
+	return parseLongOpsProgress(row, time.Now())
+}
+
+// parseLongOpsProgress converts a v$session_longops row to the progress of
+// an operation, the ETA is unset if Oracle didn't estimate it yet.
+func parseLongOpsProgress(row map[string]string, now time.Time) (*dbdpb.OperationProgress, error) {
+	sofar, err := strconv.ParseFloat(row["SOFAR"], 64)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse sofar %q: %v", row["SOFAR"], err)
+	}
+	totalwork, err := strconv.ParseFloat(row["TOTALWORK"], 64)
+	if err != nil || totalwork <= 0 {
+		return nil, fmt.Errorf("failed to parse totalwork %q: %v", row["TOTALWORK"], err)
+	}
+	progress := &dbdpb.OperationProgress{
+		PercentComplete: int32(sofar * 100 / totalwork),
+		UpdateTime:      timestamppb.New(now),
+	}
+	if remaining, err := strconv.ParseInt(row["TIME_REMAINING"], 10, 64); err == nil {
+		progress.EstimatedCompletionTime = timestamppb.New(now.Add(time.Duration(remaining) * time.Second))
+	}
+	return progress, nil
+}
+
+// dbcaProgressFunc returns the progress of a CDB created by DBCA from its
+// log. DBCA doesn't estimate the completion time, it's extrapolated from the
+// time spent since start.
+func dbcaProgressFunc(req *dbdpb.CreateCDBRequest, start time.Time) lro.ProgressFunc {
+	oracleBase := os.Getenv("ORACLE_BASE")
+	if oracleBase == "" {
+		oracleBase = common.GetSourceOracleBase(req.GetVersion())
+	}
+	sid := req.GetDatabaseName()
+	logFile := filepath.Join(oracleBase, "cfgtoollogs", "dbca", sid, sid+".log")
+	return func(context.Context) (proto.Message, error) {
+		f, err := os.Open(logFile)
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+		return parseDBCAProgress(bufio.NewScanner(f), start, time.Now())
+	}
+}
+
+// parseDBCAProgress returns the progress of the last "N% complete" line of
+// a DBCA log, with the step which follows it as the message. It returns nil
+// if DBCA reported no progress yet.
+func parseDBCAProgress(scanner *bufio.Scanner, start, now time.Time) (*dbdpb.OperationProgress, error) {
+	percent := -1
+	var step string
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		if m := dbcaProgressRegex.FindStringSubmatch(line); m != nil {
+			percent, _ = strconv.Atoi(m[1])
+			continue
+		}
+		step = line
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if percent < 0 {
+		return nil, nil
+	}
+	progress := &dbdpb.OperationProgress{
+		PercentComplete: int32(percent),
+		Message:         step,
+		UpdateTime:      timestamppb.New(now),
+	}
+	if percent > 0 && percent < 100 {
+		elapsed := now.Sub(start)
+		progress.EstimatedCompletionTime = timestamppb.New(start.Add(elapsed * 100 / time.Duration(percent)))
+	}
+	return progress, nil
+}
@@ -0,0 +1,125 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbdaemon
+
+import (
+	"bufio"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	dbdpb "github.com/GoogleCloudPlatform/elcarro-oracle-operator/oracle/pkg/agents/oracle"
+)
+
+func TestParseLongOpsProgress(t *testing.T) {
+	now := time.Date(2021, 6, 1, 10, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name    string
+		row     map[string]string
+		want    *dbdpb.OperationProgress
+		wantErr bool
+	}{
+		{
+			name: "with ETA",
+			row:  map[string]string{"SOFAR": "4215", "TOTALWORK": "10000", "TIME_REMAINING": "600"},
+			want: &dbdpb.OperationProgress{
+				PercentComplete:         42,
+				EstimatedCompletionTime: timestamppb.New(now.Add(10 * time.Minute)),
+				UpdateTime:              timestamppb.New(now),
+			},
+		},
+		{
+			name: "without ETA",
+			row:  map[string]string{"SOFAR": "0", "TOTALWORK": "512", "TIME_REMAINING": ""},
+			want: &dbdpb.OperationProgress{
+				PercentComplete: 0,
+				UpdateTime:      timestamppb.New(now),
+			},
+		},
+		{
+			name:    "no total work",
+			row:     map[string]string{"SOFAR": "0", "TOTALWORK": "0"},
+			wantErr: true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := parseLongOpsProgress(tc.row, now)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("parseLongOpsProgress(%v) error = %v, wantErr %v", tc.row, err, tc.wantErr)
+			}
+			if diff := cmp.Diff(tc.want, got, protocmp.Transform()); diff != "" {
+				t.Errorf("parseLongOpsProgress(%v) returned diff (-want +got):\n%v", tc.row, diff)
+			}
+		})
+	}
+}
+
+func TestParseDBCAProgress(t *testing.T) {
+	start := time.Date(2021, 6, 1, 10, 0, 0, 0, time.UTC)
+	now := start.Add(10 * time.Minute)
+	tests := []struct {
+		name string
+		log  string
+		want *dbdpb.OperationProgress
+	}{
+		{
+			name: "not started",
+			log:  "[WARNING] [DBT-06208] The 'SYS' password entered does not conform to the Oracle recommended standards.\n",
+		},
+		{
+			name: "in progress",
+			log: `Prepare for db operation
+10% complete
+Copying database files
+40% complete
+Creating and starting Oracle instance
+`,
+			want: &dbdpb.OperationProgress{
+				PercentComplete:         40,
+				EstimatedCompletionTime: timestamppb.New(start.Add(25 * time.Minute)),
+				Message:                 "Creating and starting Oracle instance",
+				UpdateTime:              timestamppb.New(now),
+			},
+		},
+		{
+			name: "complete",
+			log: `Executing Post Configuration Actions
+100% complete
+Database creation complete. For details check the logfiles at:
+`,
+			want: &dbdpb.OperationProgress{
+				PercentComplete: 100,
+				Message:         "Database creation complete. For details check the logfiles at:",
+				UpdateTime:      timestamppb.New(now),
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := parseDBCAProgress(bufio.NewScanner(strings.NewReader(tc.log)), start, now)
+			if err != nil {
+				t.Fatalf("parseDBCAProgress() failed: %v", err)
+			}
+			if diff := cmp.Diff(tc.want, got, protocmp.Transform()); diff != "" {
+				t.Errorf("parseDBCAProgress() returned diff (-want +got):\n%v", diff)
+			}
+		})
+	}
+}
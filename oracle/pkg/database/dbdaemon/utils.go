@@ -24,11 +24,10 @@ import (
 	"path/filepath"
 	"strings"
 
-	"cloud.google.com/go/storage"
 	"k8s.io/klog/v2"
-)
 
-const gsPrefix = "gs://"
+	"github.com/GoogleCloudPlatform/elcarro-oracle-operator/oracle/pkg/database/lib/storage"
+)
 
 // Override library functions for the benefit of unit tests.
 var (
@@ -47,6 +46,9 @@ var (
 	expdp = func(databaseHome string) string {
 		return filepath.Join(databaseHome, "bin", "expdp")
 	}
+	datapatch = func(databaseHome string) string {
+		return filepath.Join(databaseHome, "OPatch", "datapatch")
+	}
 )
 
 const (
@@ -56,7 +58,7 @@ const (
 
 // osUtil was defined for tests.
 type osUtil interface {
-	runCommand(bin string, params []string) error
+	runCommand(ctx context.Context, bin string, params []string) error
 	isReturnCodeEqual(err error, code int) bool
 	createFile(file string, content io.Reader) error
 	removeFile(file string) error
@@ -65,17 +67,17 @@ type osUtil interface {
 type osUtilImpl struct {
 }
 
-func (o *osUtilImpl) runCommand(bin string, params []string) error {
+// runCommand runs bin, the process is killed when ctx is cancelled.
+func (o *osUtilImpl) runCommand(ctx context.Context, bin string, params []string) error {
 	ohome := os.Getenv("ORACLE_HOME")
 	klog.InfoS("executing command with args", "cmd", bin, "params", params, "ORACLE_SID", os.Getenv("ORACLE_SID"), "ORACLE_HOME", ohome, "TNS_ADMIN", os.Getenv("TNS_ADMIN"))
 	switch bin {
-	case lsnrctl(ohome), rman(ohome), orapwd(ohome), impdp(ohome), expdp(ohome):
+	case lsnrctl(ohome), rman(ohome), orapwd(ohome), impdp(ohome), expdp(ohome), datapatch(ohome):
 	default:
 		klog.InfoS("command not supported", "bin", bin)
 		return fmt.Errorf("command %q is not supported", bin)
 	}
-	cmd := exec.Command(bin)
-	cmd.Args = append(cmd.Args, params...)
+	cmd := exec.CommandContext(ctx, bin, params...)
 	cmd.Stdout = os.Stdout
 	cmd.Stderr = os.Stderr
 	return cmd.Run()
@@ -112,44 +114,31 @@ func (o *osUtilImpl) removeFile(file string) error {
 	return os.Remove(file)
 }
 
-// gcsUtil contains helper methods for reading/writing GCS objects.
-type gcsUtil interface {
-	// download returns an io.ReadCloser for GCS object at given gcsPath.
-	download(ctx context.Context, gcsPath string) (io.ReadCloser, error)
-	// uploadFile uploads contents of a file at filepath to gcsPath location in
-	// GCS and sets object's contentType.
-	// If gcsPath ends with .gz it also compresses the uploaded contents
+// storageUtil contains helper methods for reading/writing objects in a
+// remote storage location, e.g. gs://bucket/object, s3://bucket/object or
+// file:///path/to/object.
+type storageUtil interface {
+	// download returns an io.ReadCloser for the object at given uri.
+	download(ctx context.Context, uri string, creds storage.Credentials) (io.ReadCloser, error)
+	// uploadFile uploads contents of a file at filepath to uri location
+	// and sets object's contentType.
+	// If uri ends with .gz it also compresses the uploaded contents
 	// and sets object's content type to application/gzip.
-	uploadFile(ctx context.Context, gcsPath, filepath, contentType string) error
-	// splitURI takes a GCS URI and splits it into bucket and object names. If the URI does not have
-	// the gs:// scheme, or the URI doesn't specify both a bucket and an object name, returns an error.
-	splitURI(url string) (bucket, name string, err error)
+	uploadFile(ctx context.Context, uri, filepath, contentType string, creds storage.Credentials) error
+	// list returns the URIs of all objects with the uri prefix.
+	list(ctx context.Context, uri string, creds storage.Credentials) ([]*storage.URI, error)
+	// delete deletes the object at uri.
+	delete(ctx context.Context, uri string, creds storage.Credentials) error
 }
 
-type gcsUtilImpl struct{}
+type storageUtilImpl struct{}
 
-func (g *gcsUtilImpl) download(ctx context.Context, gcsPath string) (io.ReadCloser, error) {
-	bucket, name, err := g.splitURI(gcsPath)
-	if err != nil {
-		return nil, err
-	}
-
-	client, err := storage.NewClient(ctx)
-	if err != nil {
-		return nil, fmt.Errorf("failed to init GCS client: %v", err)
-	}
-	defer client.Close()
-
-	reader, err := client.Bucket(bucket).Object(name).NewReader(ctx)
-	if err != nil {
-		return nil, fmt.Errorf("failed to read URL %s: %v", gcsPath, err)
-	}
-
-	return reader, nil
+func (g *storageUtilImpl) download(ctx context.Context, uri string, creds storage.Credentials) (io.ReadCloser, error) {
+	return storage.Download(ctx, uri, creds)
 }
 
-func (g *gcsUtilImpl) uploadFile(ctx context.Context, gcsPath, filePath, contentType string) error {
-	bucket, name, err := g.splitURI(gcsPath)
+func (g *storageUtilImpl) uploadFile(ctx context.Context, uri, filePath, contentType string, creds storage.Credentials) error {
+	u, err := storage.Parse(uri)
 	if err != nil {
 		return err
 	}
@@ -164,44 +153,46 @@ func (g *gcsUtilImpl) uploadFile(ctx context.Context, gcsPath, filePath, content
 		}
 	}()
 
-	client, err := storage.NewClient(ctx)
+	driver, err := storage.NewDriver(ctx, u, creds)
 	if err != nil {
-		return fmt.Errorf("failed to init GCS client: %v", err)
+		return err
 	}
-	defer client.Close()
+	defer driver.Close()
 
-	b := client.Bucket(bucket)
-	// check if bucket exists and it is accessible
-	if _, err := b.Attrs(ctx); err != nil {
+	compress := strings.HasSuffix(uri, ".gz")
+	if compress {
+		contentType = contentTypeGZ
+	}
+	objWriter, err := driver.NewWriter(ctx, u, contentType)
+	if err != nil {
 		return err
 	}
 
-	gcsWriter := b.Object(name).NewWriter(ctx)
-	gcsWriter.ContentType = contentType
-	defer gcsWriter.Close()
-
-	var writer io.WriteCloser = gcsWriter
-	if strings.HasSuffix(gcsPath, ".gz") {
-		gcsWriter.ContentType = contentTypeGZ
-		writer = gzip.NewWriter(gcsWriter)
-		defer writer.Close()
+	var writer io.WriteCloser = objWriter
+	if compress {
+		writer = gzip.NewWriter(objWriter)
 	}
-
-	_, err = io.Copy(writer, f)
-	if err != nil {
-		return fmt.Errorf("failed to write file %s to %s: %v", filePath, gcsPath, err)
+	if _, err := io.Copy(writer, f); err != nil {
+		storage.Abort(objWriter, err)
+		return fmt.Errorf("failed to write file %s to %s: %v", filePath, uri, err)
+	}
+	if compress {
+		if err := writer.Close(); err != nil {
+			storage.Abort(objWriter, err)
+			return fmt.Errorf("failed to write file %s to %s: %v", filePath, uri, err)
+		}
+	}
+	if err := objWriter.Close(); err != nil {
+		return fmt.Errorf("failed to write file %s to %s: %v", filePath, uri, err)
 	}
 
 	return nil
 }
 
-func (g *gcsUtilImpl) splitURI(url string) (bucket, name string, err error) {
-	u := strings.TrimPrefix(url, gsPrefix)
-	if u == url {
-		return "", "", fmt.Errorf("URL %q is missing the %q prefix", url, gsPrefix)
-	}
-	if i := strings.Index(u, "/"); i >= 2 {
-		return u[:i], u[i+1:], nil
-	}
-	return "", "", fmt.Errorf("URL %q does not specify a bucket and a name", url)
+func (g *storageUtilImpl) list(ctx context.Context, uri string, creds storage.Credentials) ([]*storage.URI, error) {
+	return storage.List(ctx, uri, creds)
+}
+
+func (g *storageUtilImpl) delete(ctx context.Context, uri string, creds storage.Credentials) error {
+	return storage.Delete(ctx, uri, creds)
 }
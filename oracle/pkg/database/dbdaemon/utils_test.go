@@ -15,45 +15,100 @@
 package dbdaemon
 
 import (
+	"compress/gzip"
+	"context"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"testing"
+	"time"
+
+	"github.com/GoogleCloudPlatform/elcarro-oracle-operator/oracle/pkg/database/lib/storage"
 )
 
-func TestGcsUtilImplSplitURI(t *testing.T) {
+func TestStorageUtilImplUploadFile(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	oldRoot := storage.FileRoot
+	storage.FileRoot = dir
+	defer func() { storage.FileRoot = oldRoot }()
+	src := filepath.Join(dir, "export.log")
+	content := "export completed"
+	if err := ioutil.WriteFile(src, []byte(content), 0600); err != nil {
+		t.Fatalf("failed to write %s: %v", src, err)
+	}
+
 	tests := []struct {
-		url        string
-		wantBucket string
-		wantName   string
+		name     string
+		uri      string
+		compress bool
 	}{
-		{"gs://path/to/a/file", "path", "to/a/file"},
-		{"gs://bucket/file.ext", "bucket", "file.ext"},
+		{"plain", "file://" + filepath.Join(dir, "out", "export.log"), false},
+		{"gzip", "file://" + filepath.Join(dir, "out", "export.log.gz"), true},
 	}
 
+	g := &storageUtilImpl{}
 	for _, test := range tests {
-		g := &gcsUtilImpl{}
-		gotBucket, gotName, err := g.splitURI(test.url)
-
-		if err != nil || gotBucket != test.wantBucket || gotName != test.wantName {
-			t.Errorf("gcsUtilImpl.splitURI(%q)=(%q, %q, %q); wanted (%q, %q, nil)",
-				test.url, gotBucket, gotName, err, test.wantBucket, test.wantName)
-		}
+		t.Run(test.name, func(t *testing.T) {
+			if err := g.uploadFile(ctx, test.uri, src, contentTypePlainText, nil); err != nil {
+				t.Fatalf("storageUtilImpl.uploadFile(%q) failed: %v", test.uri, err)
+			}
+			r, err := g.download(ctx, test.uri, nil)
+			if err != nil {
+				t.Fatalf("storageUtilImpl.download(%q) failed: %v", test.uri, err)
+			}
+			defer r.Close()
+			var reader io.Reader = r
+			if test.compress {
+				if reader, err = gzip.NewReader(r); err != nil {
+					t.Fatalf("gzip.NewReader failed: %v", err)
+				}
+			}
+			got, err := ioutil.ReadAll(reader)
+			if err != nil || string(got) != content {
+				t.Errorf("storageUtilImpl.download(%q) got %q, %v; wanted %q", test.uri, got, err, content)
+			}
+		})
 	}
 }
 
-func TestGcsUtilImplSplitURIError(t *testing.T) {
+func TestStorageUtilImplUploadFileError(t *testing.T) {
 	tests := []struct {
-		url string
+		uri string
 	}{
 		{"missing/prefix/in/url"},
 		{"gs://missing-bucket"},
 	}
 
+	g := &storageUtilImpl{}
 	for _, test := range tests {
-		g := &gcsUtilImpl{}
-		gotBucket, gotName, err := g.splitURI(test.url)
-
-		if err == nil {
-			t.Errorf("gcsUtilImpl.splitURI(%q)=(%q, %q, nil); wanted an error",
-				test.url, gotBucket, gotName)
+		if err := g.uploadFile(context.Background(), test.uri, os.DevNull, contentTypePlainText, nil); err == nil {
+			t.Errorf("storageUtilImpl.uploadFile(%q)=nil; wanted an error", test.uri)
 		}
 	}
 }
+
+func TestOsUtilImplRunCommandCancel(t *testing.T) {
+	home := t.TempDir()
+	bin := datapatch(home)
+	if err := os.MkdirAll(filepath.Dir(bin), 0750); err != nil {
+		t.Fatalf("failed to create %s: %v", filepath.Dir(bin), err)
+	}
+	if err := ioutil.WriteFile(bin, []byte("#!/bin/sh\nsleep 60\n"), 0750); err != nil {
+		t.Fatalf("failed to write %s: %v", bin, err)
+	}
+	oldHome := os.Getenv("ORACLE_HOME")
+	os.Setenv("ORACLE_HOME", home)
+	defer os.Setenv("ORACLE_HOME", oldHome)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(100*time.Millisecond, cancel)
+	start := time.Now()
+	if err := (&osUtilImpl{}).runCommand(ctx, bin, nil); err == nil {
+		t.Fatal("runCommand succeeded, want an error")
+	}
+	if elapsed := time.Since(start); elapsed > 30*time.Second {
+		t.Errorf("runCommand returned after %v, want the process to be killed on cancel", elapsed)
+	}
+}
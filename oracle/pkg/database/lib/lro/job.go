@@ -16,7 +16,9 @@ package lro
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/google/uuid"
@@ -45,6 +47,13 @@ const (
 	completionStatusError = "Error"
 )
 
+// progressInterval is how often the progress of a running job is refreshed.
+var progressInterval = 30 * time.Second
+
+// ProgressFunc returns the progress of a running job, which becomes the
+// metadata of its operation. It returns nil if the progress isn't known yet.
+type ProgressFunc func(ctx context.Context) (proto.Message, error)
+
 // Job represents a long running operation and its metadata.
 type Job struct {
 	id   string
@@ -57,6 +66,10 @@ type Job struct {
 
 	call func(ctx context.Context) (proto.Message, error)
 	task *detach.Task
+
+	progress ProgressFunc
+	mu       sync.Mutex
+	metadata *anypb.Any
 }
 
 // Cancel cancels the job.
@@ -152,6 +165,12 @@ func (j *Job) start(ctx context.Context) {
 		}
 
 		resp, j.err = j.call(jobCtx)
+		if j.err != nil && errors.Is(jobCtx.Err(), context.Canceled) {
+			// The job was cancelled through Cancel, its error is most likely
+			// the one of the terminated child process.
+			log.Infof("Job with ID %q was cancelled: %v", j.id, j.err)
+			j.err = status.Errorf(codes.Canceled, "operation %q was cancelled", j.id)
+		}
 		if resp == nil {
 			j.resp = nil
 		} else if any, ok := resp.(*anypb.Any); ok {
@@ -170,6 +189,54 @@ func (j *Job) start(ctx context.Context) {
 		}
 	}))
 	j.task = &task
+	if j.progress != nil {
+		go j.reportProgress(task.Finished(), progressInterval)
+	}
+}
+
+// reportProgress refreshes the progress of the job until it's finished.
+func (j *Job) reportProgress(finished <-chan struct{}, interval time.Duration) {
+	tick := time.NewTicker(interval)
+	defer tick.Stop()
+
+	for {
+		select {
+		case <-finished:
+			return
+		case <-tick.C:
+			j.refreshProgress(interval)
+		}
+	}
+}
+
+func (j *Job) refreshProgress(timeout time.Duration) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	p, err := j.progress(ctx)
+	if err != nil {
+		log.Warningf("Job %v: failed to get the progress: %v", j.id, err)
+		return
+	}
+	if p == nil {
+		return
+	}
+	md, err := anypb.New(p)
+	if err != nil {
+		log.Warningf("Job %v: failed to marshal the progress: %v", j.id, err)
+		return
+	}
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	j.metadata = md
+}
+
+// Metadata returns the last known progress of the job, nil if the job
+// doesn't report its progress or it isn't known yet.
+func (j *Job) Metadata() *anypb.Any {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	return j.metadata
 }
 
 // IsDone returns whether the job is done.
@@ -208,6 +275,7 @@ func addAndStartJob(ctx context.Context, lro *Server, job *Job) (*Job, error) {
 	}
 	log.Infof(JobStartIndicator, job.id)
 	job.start(ctx)
+	lro.persist(job.id)
 	return job, nil
 
 }
@@ -217,14 +285,23 @@ func addAndStartJob(ctx context.Context, lro *Server, job *Job) (*Job, error) {
 // adding into the job set and then starting it.
 // It uses the given  lro job id as the id.
 var CreateAndRunLROJobWithID = func(ctx context.Context, id, name string, lro *Server, call func(ctx context.Context) (proto.Message, error)) (*Job, error) {
+	return CreateAndRunLROJobWithProgress(ctx, id, name, lro, call, nil)
+}
+
+// CreateAndRunLROJobWithProgress creates an LRO job like
+// CreateAndRunLROJobWithID, the progress of the job is refreshed
+// periodically while it runs and reported in the metadata of its operation.
+// A nil progress reports no metadata.
+var CreateAndRunLROJobWithProgress = func(ctx context.Context, id, name string, lro *Server, call func(ctx context.Context) (proto.Message, error), progress ProgressFunc) (*Job, error) {
 	if id == "" {
 		id = CreateJobID()
 	}
 	job := &Job{
-		id:   id,
-		name: name,
-		call: call,
-		lro:  lro,
+		id:       id,
+		name:     name,
+		call:     call,
+		lro:      lro,
+		progress: progress,
 	}
 
 	return addAndStartJob(ctx, lro, job)
@@ -249,3 +326,59 @@ func CreateAndRunLROJobWithContext(ctx context.Context, name string, lro *Server
 	}
 	return CreateAndRunLROJobWithID(ctx, id, name, lro, call)
 }
+
+// restoredJob is a job restored from a Store, it's done.
+type restoredJob struct {
+	name     string
+	result   *anypb.Any
+	err      error
+	metadata *anypb.Any
+}
+
+func newRestoredJob(r *Record) *restoredJob {
+	j := &restoredJob{name: r.JobName, metadata: r.Operation.GetMetadata()}
+	switch {
+	case !r.Operation.GetDone():
+		j.err = status.Errorf(codes.Aborted, "LRO with ID %q was interrupted by a restart before it completed", r.Operation.GetName())
+	case r.Operation.GetError() != nil:
+		j.err = status.ErrorProto(r.Operation.GetError())
+	default:
+		j.result = r.Operation.GetResponse()
+	}
+	return j
+}
+
+// Cancel is a no-op, the job is done.
+func (j *restoredJob) Cancel() error {
+	return nil
+}
+
+// Delete is a no-op, the job holds no resources.
+func (j *restoredJob) Delete() error {
+	return nil
+}
+
+// Status returns the persisted result or error of the job.
+func (j *restoredJob) Status() (bool, *anypb.Any, error) {
+	return true, j.result, j.err
+}
+
+// Wait returns immediately, the job is done.
+func (j *restoredJob) Wait(time.Duration) error {
+	return nil
+}
+
+// IsDone returns true.
+func (j *restoredJob) IsDone() bool {
+	return true
+}
+
+// Name returns the name of the job.
+func (j *restoredJob) Name() string {
+	return j.name
+}
+
+// Metadata returns the persisted metadata of the job.
+func (j *restoredJob) Metadata() *anypb.Any {
+	return j.metadata
+}
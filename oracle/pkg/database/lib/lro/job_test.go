@@ -16,11 +16,13 @@ package lro
 
 import (
 	"context"
+	"errors"
 	"strings"
 	"testing"
 	"time"
 
 	"github.com/google/go-cmp/cmp"
+	opspb "google.golang.org/genproto/googleapis/longrunning"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
@@ -75,6 +77,44 @@ func TestCreateAndRunLROJobWithID(t *testing.T) {
 	}
 }
 
+func TestCreateAndRunLROJobWithProgress(t *testing.T) {
+	defer func(interval time.Duration) { progressInterval = interval }(progressInterval)
+	progressInterval = 10 * time.Millisecond
+
+	ctx := context.Background()
+	lro := NewServer(ctx)
+	id := "TestCreateAndRunLROJobWithProgress"
+	done := make(chan struct{})
+	jobFunc := func(context.Context) (proto.Message, error) {
+		<-done
+		return nil, nil
+	}
+	progressFunc := func(context.Context) (proto.Message, error) {
+		return &dbdpb.OperationProgress{PercentComplete: 42}, nil
+	}
+
+	if _, err := CreateAndRunLROJobWithProgress(ctx, id, "Test", lro, jobFunc, progressFunc); err != nil {
+		t.Fatalf("CreateAndRunLROJobWithProgress failed to create LRO job with err=%v.", err)
+	}
+
+	var op *opspb.Operation
+	for start := time.Now(); op.GetMetadata() == nil && time.Since(start) < 5*time.Second; time.Sleep(progressInterval) {
+		var err error
+		if op, err = lro.GetOperation(ctx, &opspb.GetOperationRequest{Name: id}); err != nil {
+			t.Fatalf("GetOperation(%q) failed: %v", id, err)
+		}
+	}
+	close(done)
+
+	progress := &dbdpb.OperationProgress{}
+	if err := op.GetMetadata().UnmarshalTo(progress); err != nil {
+		t.Fatalf("failed to unmarshal the metadata %v: %v", op.GetMetadata(), err)
+	}
+	if got, want := progress.GetPercentComplete(), int32(42); got != want {
+		t.Errorf("GetOperation(%q) progress = %d%%, want %d%%", id, got, want)
+	}
+}
+
 func TestCreateAndRunLROJobWithContext(t *testing.T) {
 	lro := NewServer(context.Background())
 	id := "TestCreateAndRunLROJob"
@@ -160,6 +200,31 @@ func TestCancelJob(t *testing.T) {
 	}
 }
 
+func TestCancelJobStatus(t *testing.T) {
+	ctx := context.Background()
+	lro := NewServer(ctx)
+	started := make(chan struct{})
+	job, err := CreateAndRunLROJobWithID(ctx, "Cancelled", "Test", lro, func(ctx context.Context) (proto.Message, error) {
+		close(started)
+		<-ctx.Done()
+		return nil, errors.New("signal: killed")
+	})
+	if err != nil {
+		t.Fatalf("CreateAndRunLROJobWithID failed: %v", err)
+	}
+	<-started
+	if err := job.Cancel(); err != nil {
+		t.Fatalf("Cancel failed: %v", err)
+	}
+	if err := job.Wait(fakeJobWaitTime); err != nil {
+		t.Fatalf("Wait failed: %v", err)
+	}
+	done, _, err := job.Status()
+	if !done || status.Code(err) != codes.Canceled {
+		t.Errorf("Status() = %v, %v, want true, %v error", done, err, codes.Canceled)
+	}
+}
+
 func TestDeleteJob(t *testing.T) {
 	tests := []struct {
 		name              string
@@ -60,6 +60,13 @@ type job interface {
 	Name() string
 }
 
+// metadataJob is a job which reports the metadata of its operation, e.g.
+// its progress.
+type metadataJob interface {
+	// Metadata returns the metadata of the operation, nil if there is none.
+	Metadata() *anypb.Any
+}
+
 type ttlJob struct {
 	job          job
 	startTime    time.Time
@@ -74,6 +81,8 @@ type ttlJob struct {
 type Server struct {
 	mu   sync.Mutex
 	jobs map[string]*ttlJob
+	// store persists the operations, it's nil for an in-memory server.
+	store Store
 }
 
 // GetOperation gets the status of the LRO operation.
@@ -137,8 +146,9 @@ func (s *Server) DeleteOperation(_ context.Context, request *opspb.DeleteOperati
 	}
 
 	job.mu.Lock()
-	defer job.mu.Unlock()
 	job.deleteTime = time.Now()
+	job.mu.Unlock()
+	s.save(request.GetName(), job)
 
 	return &emptypb.Empty{}, nil
 }
@@ -196,6 +206,11 @@ func (s *Server) DeleteExpiredJobs(ttlAfterDelete time.Duration, ttlAfterComplet
 
 		if shouldDelete {
 			delete(s.jobs, id)
+			if s.store != nil {
+				if err := s.store.Delete(id); err != nil {
+					log.Warningf("Job %v deletion from the store returned an error: %v", id, err)
+				}
+			}
 			if err := j.job.Delete(); err != nil {
 				log.Warning("Job %v deletion returned an error: %v", id, err)
 			} else {
@@ -243,9 +258,54 @@ func (s *Server) deleteJob(id string) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 	delete(s.jobs, id)
+	if s.store != nil {
+		if err := s.store.Delete(id); err != nil {
+			log.Warningf("Job %v deletion from the store returned an error: %v", id, err)
+		}
+	}
 	log.Infof("Job %v has been deleted.", id)
 }
 
+// save persists the current state of a job, failures are logged only as
+// the job itself is not affected.
+func (s *Server) save(id string, j *ttlJob) {
+	if s.store == nil {
+		return
+	}
+	j.mu.Lock()
+	r := &Record{
+		Operation:    GetOperationData(id, j.job),
+		JobName:      j.job.Name(),
+		StartTime:    j.startTime,
+		CompleteTime: j.completeTime,
+		DeleteTime:   j.deleteTime,
+	}
+	j.mu.Unlock()
+	if r.Operation.GetDone() && r.CompleteTime.IsZero() {
+		r.CompleteTime = time.Now()
+	}
+	if err := s.store.Put(r); err != nil {
+		log.Errorf("Failed to persist job %v: %v", id, err)
+	}
+}
+
+// persist persists a started job, and its result once it's done.
+func (s *Server) persist(id string) {
+	j, ok := s.getJob(id)
+	if !ok || s.store == nil {
+		return
+	}
+	s.save(id, j)
+	started, ok := j.job.(*Job)
+	if !ok {
+		return
+	}
+	go func() {
+		<-started.task.Finished()
+		s.save(id, j)
+	}()
+}
+
 func cleanup(ctx context.Context, lro *Server) {
 	log.Info("Starting cleanup goroutine.")
 	tick := time.NewTicker(jobCleanupInterval)
@@ -270,6 +330,38 @@ func NewServer(ctx context.Context) *Server {
 	return lro
 }
 
+// NewServerWithStore returns a Long running operation server which persists
+// its operations in the store, and restores the operations of a previous
+// server from it. Operations that were still running when the previous
+// server stopped are reported as failed.
+func NewServerWithStore(ctx context.Context, store Store) (*Server, error) {
+	records, err := store.List()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load the operations from the store: %v", err)
+	}
+	lro := &Server{
+		jobs:  make(map[string]*ttlJob),
+		store: store,
+	}
+	for _, r := range records {
+		id := r.Operation.GetName()
+		j := &ttlJob{
+			job:          newRestoredJob(r),
+			startTime:    r.StartTime,
+			completeTime: r.CompleteTime,
+			deleteTime:   r.DeleteTime,
+		}
+		lro.jobs[id] = j
+		if !r.Operation.GetDone() {
+			log.Warningf("Job %v was interrupted by a restart", id)
+			lro.save(id, j)
+		}
+	}
+	log.Infof("Restored %d jobs from the store", len(records))
+	go cleanup(ctx, lro)
+	return lro, nil
+}
+
 // EndOperation records the result of the operation.
 func (s *Server) EndOperation(id string, status string) {
 	if job, ok := s.getJob(id); ok {
@@ -300,7 +392,11 @@ func (s *Server) startOperation(name string) {
 // GetOperationData fills in the operation data for this specific job.
 func GetOperationData(id string, j job) *opspb.Operation {
 	done, result, e := j.Status()
-	return BuildOperation(id, done, result, e)
+	op := BuildOperation(id, done, result, e)
+	if mj, ok := j.(metadataJob); ok {
+		op.Metadata = mj.Metadata()
+	}
+	return op
 }
 
 // BuildOperation builds the operation response for this specific grpcstatus.
@@ -0,0 +1,193 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lro
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	opspb "google.golang.org/genproto/googleapis/longrunning"
+	"google.golang.org/protobuf/proto"
+	log "k8s.io/klog/v2"
+)
+
+const (
+	recordFileExt = ".json"
+	// corruptFileExt is appended to the name of a record file which can't be
+	// read, so that it's kept for inspection but not read again.
+	corruptFileExt = ".corrupt"
+)
+
+// Record is the persisted state of an operation.
+type Record struct {
+	// Operation holds the name, the metadata and, once done, the result or
+	// the error of the operation.
+	Operation *opspb.Operation
+	// JobName is the name of the job for metrics/logging purposes.
+	JobName      string
+	StartTime    time.Time
+	CompleteTime time.Time
+	DeleteTime   time.Time
+}
+
+// Store persists the operations of a Server, so that they survive restarts
+// of the server.
+type Store interface {
+	// Put creates or replaces the record of an operation.
+	Put(r *Record) error
+	// Delete removes the record of an operation, if it exists.
+	Delete(id string) error
+	// List returns the records of all operations.
+	List() ([]*Record, error)
+}
+
+// FileStore is a Store which keeps a file per operation in a directory.
+type FileStore struct {
+	mu  sync.Mutex
+	dir string
+}
+
+// fileRecord is the JSON content of a record file.
+type fileRecord struct {
+	JobName      string    `json:"jobName"`
+	StartTime    time.Time `json:"startTime"`
+	CompleteTime time.Time `json:"completeTime,omitempty"`
+	DeleteTime   time.Time `json:"deleteTime,omitempty"`
+	// Operation is the binary encoded operation, its metadata and result
+	// are kept as is even if their types are unknown.
+	Operation []byte `json:"operation"`
+}
+
+// NewFileStore returns a store of the operations in dir, which is created
+// if it doesn't exist.
+func NewFileStore(dir string) (*FileStore, error) {
+	if err := os.MkdirAll(dir, 0750); err != nil {
+		return nil, fmt.Errorf("failed to create the LRO store directory %q: %v", dir, err)
+	}
+	return &FileStore{dir: dir}, nil
+}
+
+// Put writes the record to a temporary file, which replaces the record file
+// of the operation, so that a crash never leaves a partial record.
+func (fs *FileStore) Put(r *Record) error {
+	op, err := proto.Marshal(r.Operation)
+	if err != nil {
+		return fmt.Errorf("failed to marshal operation %q: %v", r.Operation.GetName(), err)
+	}
+	b, err := json.Marshal(fileRecord{
+		JobName:      r.JobName,
+		StartTime:    r.StartTime,
+		CompleteTime: r.CompleteTime,
+		DeleteTime:   r.DeleteTime,
+		Operation:    op,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to marshal the record of operation %q: %v", r.Operation.GetName(), err)
+	}
+
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+	tmp, err := ioutil.TempFile(fs.dir, ".tmp-")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(b); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), fs.path(r.Operation.GetName()))
+}
+
+// Delete removes the record file of the operation.
+func (fs *FileStore) Delete(id string) error {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+	if err := os.Remove(fs.path(id)); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+// List reads all record files. Record files which can't be parsed, e.g.
+// after a disk failure, are logged and renamed with a .corrupt extension, so
+// that a bad record doesn't prevent the server from starting.
+func (fs *FileStore) List() ([]*Record, error) {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+	files, err := ioutil.ReadDir(fs.dir)
+	if err != nil {
+		return nil, err
+	}
+	var records []*Record
+	for _, f := range files {
+		if f.IsDir() || !strings.HasSuffix(f.Name(), recordFileExt) {
+			continue
+		}
+		path := filepath.Join(fs.dir, f.Name())
+		r, err := readRecord(path)
+		if err != nil {
+			log.Errorf("Skipping the LRO record %q: %v", path, err)
+			if err := os.Rename(path, path+corruptFileExt); err != nil {
+				log.Errorf("Failed to quarantine the LRO record %q: %v", path, err)
+			}
+			continue
+		}
+		records = append(records, r)
+	}
+	return records, nil
+}
+
+func readRecord(path string) (*Record, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var fr fileRecord
+	if err := json.Unmarshal(b, &fr); err != nil {
+		return nil, fmt.Errorf("failed to parse the LRO record %q: %v", path, err)
+	}
+	op := &opspb.Operation{}
+	if err := proto.Unmarshal(fr.Operation, op); err != nil {
+		return nil, fmt.Errorf("failed to parse the operation of the LRO record %q: %v", path, err)
+	}
+	return &Record{
+		Operation:    op,
+		JobName:      fr.JobName,
+		StartTime:    fr.StartTime,
+		CompleteTime: fr.CompleteTime,
+		DeleteTime:   fr.DeleteTime,
+	}, nil
+}
+
+// path returns the record file of an operation, the ID is escaped as it's
+// chosen by the clients.
+func (fs *FileStore) path(id string) string {
+	return filepath.Join(fs.dir, url.PathEscape(id)+recordFileExt)
+}
@@ -0,0 +1,226 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lro
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	opspb "google.golang.org/genproto/googleapis/longrunning"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+func TestFileStore(t *testing.T) {
+	fs, err := NewFileStore(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewFileStore failed: %v", err)
+	}
+	response, err := anypb.New(&opspb.OperationInfo{ResponseType: "frog"})
+	if err != nil {
+		t.Fatalf("failed to marshal the response: %v", err)
+	}
+	start := time.Date(2021, 4, 1, 10, 0, 0, 0, time.UTC)
+	want := []*Record{
+		{
+			Operation: &opspb.Operation{Name: "Backup/../1", Done: true, Result: &opspb.Operation_Response{Response: response}},
+			JobName:   "RMAN",
+			StartTime: start,
+		},
+		{
+			Operation: &opspb.Operation{Name: "Job_2"},
+			JobName:   "DataPump",
+			StartTime: start,
+		},
+	}
+	for _, r := range want {
+		if err := fs.Put(r); err != nil {
+			t.Fatalf("Put(%v) failed: %v", r.Operation.GetName(), err)
+		}
+	}
+	// A replaced record is listed once.
+	if err := fs.Put(want[1]); err != nil {
+		t.Fatalf("Put(%v) failed: %v", want[1].Operation.GetName(), err)
+	}
+
+	got, err := fs.List()
+	if err != nil {
+		t.Fatalf("List failed: %v", err)
+	}
+	if diff := cmp.Diff(want, got, protocmp.Transform()); diff != "" {
+		t.Errorf("List returned unexpected records: -want +got %v", diff)
+	}
+
+	if err := fs.Delete("Backup/../1"); err != nil {
+		t.Fatalf("Delete failed: %v", err)
+	}
+	if err := fs.Delete("unknown"); err != nil {
+		t.Fatalf("Delete of an unknown record failed: %v", err)
+	}
+	got, err = fs.List()
+	if err != nil {
+		t.Fatalf("List failed: %v", err)
+	}
+	if diff := cmp.Diff(want[1:], got, protocmp.Transform()); diff != "" {
+		t.Errorf("List returned unexpected records after Delete: -want +got %v", diff)
+	}
+}
+
+func TestFileStoreCorruptRecord(t *testing.T) {
+	dir := t.TempDir()
+	fs, err := NewFileStore(dir)
+	if err != nil {
+		t.Fatalf("NewFileStore failed: %v", err)
+	}
+	want := []*Record{{Operation: &opspb.Operation{Name: "Job_1", Done: true}, JobName: "RMAN"}}
+	if err := fs.Put(want[0]); err != nil {
+		t.Fatalf("Put failed: %v", err)
+	}
+	// A record torn by a crash or a full disk.
+	corrupt := filepath.Join(dir, "Job_2"+recordFileExt)
+	if err := ioutil.WriteFile(corrupt, []byte(`{"Operation": {"name": "Jo`), 0640); err != nil {
+		t.Fatalf("failed to write the corrupt record: %v", err)
+	}
+
+	if _, err := NewServerWithStore(context.Background(), fs); err != nil {
+		t.Fatalf("NewServerWithStore failed: %v", err)
+	}
+	got, err := fs.List()
+	if err != nil {
+		t.Fatalf("List failed: %v", err)
+	}
+	if diff := cmp.Diff(want, got, protocmp.Transform()); diff != "" {
+		t.Errorf("List returned unexpected records: -want +got %v", diff)
+	}
+	if _, err := os.Stat(corrupt + corruptFileExt); err != nil {
+		t.Errorf("the corrupt record wasn't quarantined: %v", err)
+	}
+}
+
+func TestNewServerWithStore(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	fs, err := NewFileStore(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewFileStore failed: %v", err)
+	}
+	server, err := NewServerWithStore(ctx, fs)
+	if err != nil {
+		t.Fatalf("NewServerWithStore failed: %v", err)
+	}
+
+	success := testEnv{resp: &opspb.OperationInfo{ResponseType: "frog"}}
+	failure := testEnv{err: errors.New("RMAN-03009")}
+	running := testEnv{done: make(chan struct{})}
+	defer close(running.done)
+	for id, env := range map[string]testEnv{"success": success, "failure": failure, "running": running} {
+		if _, err := CreateAndRunLROJobWithID(ctx, id, "RMAN", server, env.fakeJob); err != nil {
+			t.Fatalf("CreateAndRunLROJobWithID(%q) failed: %v", id, err)
+		}
+	}
+	for _, id := range []string{"success", "failure"} {
+		if _, err := server.WaitOperation(ctx, &opspb.WaitOperationRequest{Name: id}); err != nil {
+			t.Fatalf("WaitOperation(%q) failed: %v", id, err)
+		}
+	}
+
+	// The results are persisted asynchronously.
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		records, err := fs.List()
+		if err != nil {
+			t.Fatalf("List failed: %v", err)
+		}
+		done := 0
+		for _, r := range records {
+			if r.Operation.GetDone() {
+				done++
+			}
+		}
+		if done == 2 {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("the results weren't persisted in time, records: %v", records)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	// The server restarts while the running operation is still running.
+	restored, err := NewServerWithStore(ctx, fs)
+	if err != nil {
+		t.Fatalf("NewServerWithStore failed: %v", err)
+	}
+
+	response, err := anypb.New(&opspb.OperationInfo{ResponseType: "frog"})
+	if err != nil {
+		t.Fatalf("failed to marshal the response: %v", err)
+	}
+	tests := []struct {
+		id   string
+		want *opspb.Operation
+	}{
+		{
+			id:   "success",
+			want: &opspb.Operation{Name: "success", Done: true, Result: &opspb.Operation_Response{Response: response}},
+		},
+		{
+			id: "failure",
+			want: &opspb.Operation{Name: "failure", Done: true, Result: &opspb.Operation_Error{
+				Error: status.New(codes.Unknown, "RMAN-03009").Proto(),
+			}},
+		},
+		{
+			id: "running",
+			want: &opspb.Operation{Name: "running", Done: true, Result: &opspb.Operation_Error{
+				Error: status.New(codes.Aborted, `LRO with ID "running" was interrupted by a restart before it completed`).Proto(),
+			}},
+		},
+	}
+	for _, tc := range tests {
+		got, err := restored.GetOperation(ctx, &opspb.GetOperationRequest{Name: tc.id})
+		if err != nil {
+			t.Fatalf("GetOperation(%q) failed: %v", tc.id, err)
+		}
+		if diff := cmp.Diff(tc.want, got, protocmp.Transform()); diff != "" {
+			t.Errorf("GetOperation(%q) returned an unexpected operation: -want +got %v", tc.id, diff)
+		}
+	}
+
+	// The interrupted operation is persisted as failed.
+	records, err := fs.List()
+	if err != nil {
+		t.Fatalf("List failed: %v", err)
+	}
+	for _, r := range records {
+		if !r.Operation.GetDone() {
+			t.Errorf("operation %q is not done in the store", r.Operation.GetName())
+		}
+	}
+
+	// Deleted operations are removed from the store.
+	restored.deleteJob("success")
+	if records, _ := fs.List(); len(records) != 2 {
+		t.Errorf("List returned %d records after a delete, want 2", len(records))
+	}
+}
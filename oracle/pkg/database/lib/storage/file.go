@@ -0,0 +1,155 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/GoogleCloudPlatform/elcarro-oracle-operator/oracle/pkg/agents/consts"
+)
+
+// FileRoot is the directory file:// locations are confined to. The file
+// volumes of the Instance are mounted below it into the database container,
+// so that a location can't address the datafiles, the configuration or the
+// credentials of the database.
+var FileRoot = consts.FileVolumesDir
+
+// fileDriver accesses file:// locations, e.g. an NFS share mounted into
+// the database container below FileRoot. Credentials are not used.
+type fileDriver struct{}
+
+func (d *fileDriver) NewReader(ctx context.Context, u *URI) (io.ReadCloser, error) {
+	p, err := localPath(u)
+	if err != nil {
+		return nil, err
+	}
+	return os.Open(p)
+}
+
+func (d *fileDriver) NewWriter(ctx context.Context, u *URI, contentType string) (io.WriteCloser, error) {
+	p, err := localPath(u)
+	if err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(filepath.Dir(p), 0750); err != nil {
+		return nil, err
+	}
+	// Check the created directories again, a symlink may have been created
+	// in the meantime.
+	if _, err := localPath(u); err != nil {
+		return nil, err
+	}
+	f, err := os.Create(p)
+	if err != nil {
+		return nil, err
+	}
+	return &fileWriter{File: f}, nil
+}
+
+func (d *fileDriver) List(ctx context.Context, u *URI) ([]string, error) {
+	if _, err := localPath(u); err != nil {
+		return nil, err
+	}
+	// Like object storage prefixes, u.Name does not need to be a directory.
+	root := u.Name
+	if info, err := os.Stat(root); err != nil || !info.IsDir() {
+		root = filepath.Dir(root)
+	}
+	var names []string
+	err := filepath.Walk(root, func(fpath string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !info.IsDir() && strings.HasPrefix(fpath, u.Name) {
+			names = append(names, fpath)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return names, nil
+}
+
+func (d *fileDriver) Delete(ctx context.Context, u *URI) error {
+	p, err := localPath(u)
+	if err != nil {
+		return err
+	}
+	return os.Remove(p)
+}
+
+func (d *fileDriver) Close() error {
+	return nil
+}
+
+// localPath returns the path of the file:// location u. Paths with ..
+// elements and paths outside of FileRoot, also through symlinks, are
+// rejected.
+func localPath(u *URI) (string, error) {
+	for _, elem := range strings.Split(u.Name, "/") {
+		if elem == ".." {
+			return "", fmt.Errorf("path %q must not contain .. elements", u.Name)
+		}
+	}
+	p := filepath.Clean(u.Name)
+	root := filepath.Clean(FileRoot)
+	if !within(root, p) {
+		return "", fmt.Errorf("path %q is outside of %s", u.Name, root)
+	}
+	// Resolve the symlinks of the longest existing part of the path.
+	existing := p
+	for {
+		if _, err := os.Lstat(existing); err == nil || existing == root {
+			break
+		}
+		existing = filepath.Dir(existing)
+	}
+	resolved, err := filepath.EvalSymlinks(existing)
+	if err != nil {
+		if os.IsNotExist(err) && existing == root {
+			return p, nil
+		}
+		return "", err
+	}
+	resolvedRoot, err := filepath.EvalSymlinks(root)
+	if err != nil {
+		return "", err
+	}
+	if !within(resolvedRoot, resolved) {
+		return "", fmt.Errorf("path %q resolves to %s outside of %s", u.Name, resolved, root)
+	}
+	return p, nil
+}
+
+// within returns true if the clean path p is root or below it.
+func within(root, p string) bool {
+	return p == root || strings.HasPrefix(p, strings.TrimSuffix(root, "/")+"/")
+}
+
+// fileWriter is a writer to a file, which removes the file when aborted.
+type fileWriter struct {
+	*os.File
+}
+
+func (w *fileWriter) Abort(err error) error {
+	w.File.Close()
+	return os.Remove(w.File.Name())
+}
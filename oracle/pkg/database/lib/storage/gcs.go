@@ -0,0 +1,99 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	gcs "cloud.google.com/go/storage"
+	"google.golang.org/api/iterator"
+	"google.golang.org/api/option"
+)
+
+// gcsDriver accesses gs:// locations.
+type gcsDriver struct {
+	client *gcs.Client
+}
+
+func newGCSDriver(ctx context.Context, creds Credentials) (*gcsDriver, error) {
+	var opts []option.ClientOption
+	if key := creds[GCSServiceAccountKey]; key != "" {
+		opts = append(opts, option.WithCredentialsJSON([]byte(key)))
+	}
+	client, err := gcs.NewClient(ctx, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to init GCS client: %v", err)
+	}
+	return &gcsDriver{client: client}, nil
+}
+
+func (d *gcsDriver) NewReader(ctx context.Context, u *URI) (io.ReadCloser, error) {
+	return d.client.Bucket(u.Bucket).Object(u.Name).NewReader(ctx)
+}
+
+func (d *gcsDriver) NewWriter(ctx context.Context, u *URI, contentType string) (io.WriteCloser, error) {
+	b := d.client.Bucket(u.Bucket)
+	// check if bucket exists and it is accessible
+	if _, err := b.Attrs(ctx); err != nil {
+		return nil, err
+	}
+	// Canceling the context of a writer aborts the upload.
+	ctx, cancel := context.WithCancel(ctx)
+	w := b.Object(u.Name).NewWriter(ctx)
+	w.ContentType = contentType
+	return &gcsWriter{Writer: w, cancel: cancel}, nil
+}
+
+func (d *gcsDriver) List(ctx context.Context, u *URI) ([]string, error) {
+	var names []string
+	it := d.client.Bucket(u.Bucket).Objects(ctx, &gcs.Query{Prefix: u.Name})
+	for {
+		attrs, err := it.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("Bucket(%q).Objects(): %v", u.Bucket, err)
+		}
+		names = append(names, attrs.Name)
+	}
+	return names, nil
+}
+
+func (d *gcsDriver) Delete(ctx context.Context, u *URI) error {
+	return d.client.Bucket(u.Bucket).Object(u.Name).Delete(ctx)
+}
+
+func (d *gcsDriver) Close() error {
+	return d.client.Close()
+}
+
+// gcsWriter is a writer to a GCS object, which can be aborted.
+type gcsWriter struct {
+	*gcs.Writer
+	cancel context.CancelFunc
+}
+
+func (w *gcsWriter) Close() error {
+	defer w.cancel()
+	return w.Writer.Close()
+}
+
+func (w *gcsWriter) Abort(err error) error {
+	w.cancel()
+	return w.Writer.Close()
+}
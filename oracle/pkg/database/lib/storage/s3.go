@@ -0,0 +1,133 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
+)
+
+const defaultS3Region = "us-east-1"
+
+// s3Driver accesses s3:// locations of Amazon S3 or an S3 compatible
+// object storage.
+type s3Driver struct {
+	sess *session.Session
+}
+
+func newS3Driver(creds Credentials) (*s3Driver, error) {
+	cfg := aws.NewConfig().WithRegion(defaultS3Region)
+	if region := creds[S3Region]; region != "" {
+		cfg = cfg.WithRegion(region)
+	}
+	if endpoint := creds[S3Endpoint]; endpoint != "" {
+		cfg = cfg.WithEndpoint(endpoint).WithS3ForcePathStyle(true)
+	}
+	if id := creds[S3AccessKeyID]; id != "" {
+		cfg = cfg.WithCredentials(credentials.NewStaticCredentials(id, creds[S3SecretAccessKey], creds[S3SessionToken]))
+	}
+	sess, err := session.NewSession(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to init S3 session: %v", err)
+	}
+	return &s3Driver{sess: sess}, nil
+}
+
+func (d *s3Driver) NewReader(ctx context.Context, u *URI) (io.ReadCloser, error) {
+	out, err := s3.New(d.sess).GetObjectWithContext(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(u.Bucket),
+		Key:    aws.String(u.Name),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return out.Body, nil
+}
+
+func (d *s3Driver) NewWriter(ctx context.Context, u *URI, contentType string) (io.WriteCloser, error) {
+	pr, pw := io.Pipe()
+	w := &s3Writer{pw: pw, done: make(chan error, 1)}
+	uploader := s3manager.NewUploader(d.sess)
+	go func() {
+		_, err := uploader.UploadWithContext(ctx, &s3manager.UploadInput{
+			Bucket:      aws.String(u.Bucket),
+			Key:         aws.String(u.Name),
+			ContentType: aws.String(contentType),
+			Body:        pr,
+		})
+		// Unblock the writer if the upload failed before reading everything.
+		pr.CloseWithError(err)
+		w.done <- err
+	}()
+	return w, nil
+}
+
+func (d *s3Driver) List(ctx context.Context, u *URI) ([]string, error) {
+	var names []string
+	err := s3.New(d.sess).ListObjectsV2PagesWithContext(ctx, &s3.ListObjectsV2Input{
+		Bucket: aws.String(u.Bucket),
+		Prefix: aws.String(u.Name),
+	}, func(page *s3.ListObjectsV2Output, lastPage bool) bool {
+		for _, obj := range page.Contents {
+			names = append(names, aws.StringValue(obj.Key))
+		}
+		return true
+	})
+	if err != nil {
+		return nil, fmt.Errorf("ListObjectsV2(%q): %v", u.Bucket, err)
+	}
+	return names, nil
+}
+
+func (d *s3Driver) Delete(ctx context.Context, u *URI) error {
+	_, err := s3.New(d.sess).DeleteObjectWithContext(ctx, &s3.DeleteObjectInput{
+		Bucket: aws.String(u.Bucket),
+		Key:    aws.String(u.Name),
+	})
+	return err
+}
+
+func (d *s3Driver) Close() error {
+	return nil
+}
+
+// s3Writer streams the written data to a multipart upload running in
+// the background. Close waits for the upload to complete, Abort fails the
+// upload, so that no object is created from the data written so far.
+type s3Writer struct {
+	pw   *io.PipeWriter
+	done chan error
+}
+
+func (w *s3Writer) Write(p []byte) (int, error) {
+	return w.pw.Write(p)
+}
+
+func (w *s3Writer) Close() error {
+	w.pw.Close()
+	return <-w.done
+}
+
+func (w *s3Writer) Abort(err error) error {
+	w.pw.CloseWithError(err)
+	return <-w.done
+}
@@ -0,0 +1,269 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package storage provides access to objects in remote storage locations.
+// A location is addressed by a URI and the scheme of the URI selects the
+// storage driver:
+//
+//	gs://bucket/object   Google Cloud Storage
+//	s3://bucket/object   Amazon S3 or an S3 compatible object storage
+//	file:///path/to/file a local (or mounted) filesystem
+package storage
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"path"
+	"sort"
+	"strings"
+)
+
+// Supported URI schemes.
+const (
+	SchemeGCS  = "gs"
+	SchemeS3   = "s3"
+	SchemeFile = "file"
+)
+
+// Keys of the Credentials map, which is usually populated from the data of
+// a Kubernetes Secret. Drivers fall back to their default credentials
+// (e.g. workload identity for GCS) if no credentials are provided.
+const (
+	// GCSServiceAccountKey is a JSON key of a service account used to access gs:// locations.
+	GCSServiceAccountKey = "serviceAccountKey"
+	// S3AccessKeyID is an access key ID used to access s3:// locations.
+	S3AccessKeyID = "accessKeyId"
+	// S3SecretAccessKey is a secret access key used to access s3:// locations.
+	S3SecretAccessKey = "secretAccessKey"
+	// S3SessionToken is an optional session token for temporary credentials.
+	S3SessionToken = "sessionToken"
+	// S3Region is a region of the bucket, us-east-1 is assumed if omitted.
+	S3Region = "region"
+	// S3Endpoint is an optional endpoint of an S3 compatible object storage,
+	// e.g. https://minio.example.com:9000. Path style addressing is used
+	// if it is set.
+	S3Endpoint = "endpoint"
+)
+
+// Credentials are driver specific credentials to access a storage location.
+type Credentials map[string]string
+
+// String returns the keys of the credentials only, so that they are not
+// leaked to logs.
+func (c Credentials) String() string {
+	keys := make([]string, 0, len(c))
+	for k := range c {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return fmt.Sprintf("%v", keys)
+}
+
+// URI is a parsed storage location.
+type URI struct {
+	// Scheme selects the storage driver.
+	Scheme string
+	// Bucket is the bucket of an object storage, it is empty for file URIs.
+	Bucket string
+	// Name is the object name within the bucket, or an absolute path for
+	// file URIs.
+	Name string
+}
+
+// Parse parses a storage URI. It returns an error if the scheme is not
+// supported, if an object storage URI does not specify a bucket, or if a
+// file URI does not specify an absolute path.
+func Parse(uri string) (*URI, error) {
+	i := strings.Index(uri, "://")
+	if i < 0 {
+		return nil, fmt.Errorf("URI %q is missing a scheme, one of %s://, %s:// or %s:// is expected", uri, SchemeGCS, SchemeS3, SchemeFile)
+	}
+	scheme, rest := uri[:i], uri[i+len("://"):]
+	switch scheme {
+	case SchemeGCS, SchemeS3:
+		if j := strings.Index(rest, "/"); j >= 2 {
+			return &URI{Scheme: scheme, Bucket: rest[:j], Name: rest[j+1:]}, nil
+		}
+		return nil, fmt.Errorf("URI %q does not specify a bucket and a name", uri)
+	case SchemeFile:
+		if !strings.HasPrefix(rest, "/") {
+			return nil, fmt.Errorf("URI %q does not specify an absolute path", uri)
+		}
+		return &URI{Scheme: scheme, Name: rest}, nil
+	default:
+		return nil, fmt.Errorf("URI %q has an unsupported scheme %q", uri, scheme)
+	}
+}
+
+// String returns the URI in its textual form.
+func (u *URI) String() string {
+	if u.Scheme == SchemeFile {
+		return u.Scheme + "://" + u.Name
+	}
+	return u.Scheme + "://" + u.Bucket + "/" + u.Name
+}
+
+// Join returns a new URI with elem appended to the name.
+func (u *URI) Join(elem ...string) *URI {
+	return &URI{Scheme: u.Scheme, Bucket: u.Bucket, Name: path.Join(append([]string{u.Name}, elem...)...)}
+}
+
+// Driver reads and writes objects of a single storage backend.
+type Driver interface {
+	// NewReader returns a reader of the object at u.
+	NewReader(ctx context.Context, u *URI) (io.ReadCloser, error)
+	// NewWriter returns a writer to the object at u. The object is
+	// complete only once the writer is closed without an error. A writer
+	// implementing Abort(error) error discards the partially written object
+	// instead.
+	NewWriter(ctx context.Context, u *URI, contentType string) (io.WriteCloser, error)
+	// List returns the names of the objects in u.Bucket whose
+	// name starts with u.Name.
+	List(ctx context.Context, u *URI) ([]string, error)
+	// Delete deletes the object at u.
+	Delete(ctx context.Context, u *URI) error
+	// Close releases the resources held by the driver.
+	Close() error
+}
+
+// NewDriver returns a driver for the scheme of u authenticated with creds.
+func NewDriver(ctx context.Context, u *URI, creds Credentials) (Driver, error) {
+	switch u.Scheme {
+	case SchemeGCS:
+		return newGCSDriver(ctx, creds)
+	case SchemeS3:
+		return newS3Driver(creds)
+	case SchemeFile:
+		return &fileDriver{}, nil
+	default:
+		return nil, fmt.Errorf("unsupported storage scheme %q", u.Scheme)
+	}
+}
+
+// Download returns a reader of the object at uri.
+func Download(ctx context.Context, uri string, creds Credentials) (io.ReadCloser, error) {
+	u, err := Parse(uri)
+	if err != nil {
+		return nil, err
+	}
+	d, err := NewDriver(ctx, u, creds)
+	if err != nil {
+		return nil, err
+	}
+	r, err := d.NewReader(ctx, u)
+	if err != nil {
+		d.Close()
+		return nil, fmt.Errorf("failed to read URI %s: %v", uri, err)
+	}
+	return &driverReader{ReadCloser: r, d: d}, nil
+}
+
+// Upload writes the content of r to the object at uri. If reading r fails,
+// the partially written object is discarded rather than completed.
+func Upload(ctx context.Context, uri string, r io.Reader, contentType string, creds Credentials) error {
+	u, err := Parse(uri)
+	if err != nil {
+		return err
+	}
+	d, err := NewDriver(ctx, u, creds)
+	if err != nil {
+		return err
+	}
+	defer d.Close()
+
+	w, err := d.NewWriter(ctx, u, contentType)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(w, r); err != nil {
+		Abort(w, err)
+		return fmt.Errorf("failed to write to %s: %v", uri, err)
+	}
+	if err := w.Close(); err != nil {
+		return fmt.Errorf("failed to write to %s: %v", uri, err)
+	}
+	return nil
+}
+
+// List returns the URIs of all objects whose URI starts with uri.
+func List(ctx context.Context, uri string, creds Credentials) ([]*URI, error) {
+	u, err := Parse(uri)
+	if err != nil {
+		return nil, err
+	}
+	d, err := NewDriver(ctx, u, creds)
+	if err != nil {
+		return nil, err
+	}
+	defer d.Close()
+
+	names, err := d.List(ctx, u)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list %s: %v", uri, err)
+	}
+	var uris []*URI
+	for _, name := range names {
+		uris = append(uris, &URI{Scheme: u.Scheme, Bucket: u.Bucket, Name: name})
+	}
+	return uris, nil
+}
+
+// Delete deletes the object at uri.
+func Delete(ctx context.Context, uri string, creds Credentials) error {
+	u, err := Parse(uri)
+	if err != nil {
+		return err
+	}
+	d, err := NewDriver(ctx, u, creds)
+	if err != nil {
+		return err
+	}
+	defer d.Close()
+
+	if err := d.Delete(ctx, u); err != nil {
+		return fmt.Errorf("failed to delete %s: %v", uri, err)
+	}
+	return nil
+}
+
+// aborter is implemented by writers which can discard a partially written
+// object instead of completing it on Close.
+type aborter interface {
+	Abort(err error) error
+}
+
+// Abort discards the object written by w, a writer returned by a driver, if
+// the driver supports it and closes w otherwise.
+func Abort(w io.WriteCloser, err error) {
+	if a, ok := w.(aborter); ok {
+		a.Abort(err)
+		return
+	}
+	w.Close()
+}
+
+// driverReader closes the driver along with the reader.
+type driverReader struct {
+	io.ReadCloser
+	d Driver
+}
+
+func (r *driverReader) Close() error {
+	err := r.ReadCloser.Close()
+	if dErr := r.d.Close(); err == nil {
+		err = dErr
+	}
+	return err
+}
@@ -0,0 +1,246 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+	"errors"
+	"io"
+	"io/ioutil"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		uri  string
+		want URI
+	}{
+		{"gs://path/to/a/file", URI{Scheme: SchemeGCS, Bucket: "path", Name: "to/a/file"}},
+		{"gs://bucket/file.ext", URI{Scheme: SchemeGCS, Bucket: "bucket", Name: "file.ext"}},
+		{"gs://bucket/", URI{Scheme: SchemeGCS, Bucket: "bucket", Name: ""}},
+		{"s3://bucket/dir/file.ext", URI{Scheme: SchemeS3, Bucket: "bucket", Name: "dir/file.ext"}},
+		{"file:///mnt/backups/file.ext", URI{Scheme: SchemeFile, Name: "/mnt/backups/file.ext"}},
+	}
+
+	for _, test := range tests {
+		got, err := Parse(test.uri)
+		if err != nil {
+			t.Errorf("Parse(%q) failed: %v", test.uri, err)
+			continue
+		}
+		if diff := cmp.Diff(test.want, *got); diff != "" {
+			t.Errorf("Parse(%q) got unexpected URI: -want +got %v", test.uri, diff)
+		}
+		if got.String() != test.uri {
+			t.Errorf("Parse(%q).String()=%q; wanted %q", test.uri, got.String(), test.uri)
+		}
+	}
+}
+
+func TestParseError(t *testing.T) {
+	tests := []struct {
+		uri string
+	}{
+		{"missing/prefix/in/url"},
+		{"gs://missing-bucket"},
+		{"s3://missing-bucket"},
+		{"file://relative/path"},
+		{"ftp://bucket/file"},
+	}
+
+	for _, test := range tests {
+		if got, err := Parse(test.uri); err == nil {
+			t.Errorf("Parse(%q)=%+v, nil; wanted an error", test.uri, got)
+		}
+	}
+}
+
+func TestURIJoin(t *testing.T) {
+	tests := []struct {
+		uri  string
+		elem []string
+		want string
+	}{
+		{"gs://bucket/backups", []string{"1", "file.bkp"}, "gs://bucket/backups/1/file.bkp"},
+		{"gs://bucket/", []string{"file.bkp"}, "gs://bucket/file.bkp"},
+		{"s3://bucket/backups/", []string{"file.bkp"}, "s3://bucket/backups/file.bkp"},
+		{"file:///mnt/backups", []string{"dir", "file.bkp"}, "file:///mnt/backups/dir/file.bkp"},
+	}
+
+	for _, test := range tests {
+		u, err := Parse(test.uri)
+		if err != nil {
+			t.Fatalf("Parse(%q) failed: %v", test.uri, err)
+		}
+		if got := u.Join(test.elem...).String(); got != test.want {
+			t.Errorf("Parse(%q).Join(%q)=%q; wanted %q", test.uri, test.elem, got, test.want)
+		}
+	}
+}
+
+// setFileRoot confines file:// locations to root for the duration of a test.
+func setFileRoot(t *testing.T, root string) {
+	oldRoot := FileRoot
+	FileRoot = root
+	t.Cleanup(func() { FileRoot = oldRoot })
+}
+
+func TestFileDriver(t *testing.T) {
+	ctx := context.Background()
+	root := t.TempDir()
+	setFileRoot(t, root)
+	dir := "file://" + root
+	files := map[string]string{
+		dir + "/backup/a.bkp":     "a",
+		dir + "/backup/sub/b.bkp": "b",
+		dir + "/other/c.bkp":      "c",
+	}
+	for uri, content := range files {
+		if err := Upload(ctx, uri, strings.NewReader(content), "text/plain", nil); err != nil {
+			t.Fatalf("Upload(%q) failed: %v", uri, err)
+		}
+	}
+
+	for uri, want := range files {
+		r, err := Download(ctx, uri, nil)
+		if err != nil {
+			t.Fatalf("Download(%q) failed: %v", uri, err)
+		}
+		got, err := ioutil.ReadAll(r)
+		r.Close()
+		if err != nil || string(got) != want {
+			t.Errorf("Download(%q) got %q, %v; wanted %q", uri, got, err, want)
+		}
+	}
+
+	listTests := []struct {
+		prefix string
+		want   []string
+	}{
+		{dir + "/backup", []string{dir + "/backup/a.bkp", dir + "/backup/sub/b.bkp"}},
+		{dir + "/backup/", []string{dir + "/backup/a.bkp", dir + "/backup/sub/b.bkp"}},
+		{dir + "/oth", []string{dir + "/other/c.bkp"}},
+	}
+	for _, test := range listTests {
+		uris, err := List(ctx, test.prefix, nil)
+		if err != nil {
+			t.Fatalf("List(%q) failed: %v", test.prefix, err)
+		}
+		var got []string
+		for _, u := range uris {
+			got = append(got, u.String())
+		}
+		if diff := cmp.Diff(test.want, got); diff != "" {
+			t.Errorf("List(%q) got unexpected URIs: -want +got %v", test.prefix, diff)
+		}
+	}
+
+	for uri := range files {
+		if err := Delete(ctx, uri, nil); err != nil {
+			t.Fatalf("Delete(%q) failed: %v", uri, err)
+		}
+	}
+	if uris, err := List(ctx, dir, nil); err != nil || len(uris) != 0 {
+		t.Errorf("List(%q) after Delete got %v, %v; wanted no URIs", dir, uris, err)
+	}
+}
+
+// failingReader returns an error after the content of its reader.
+type failingReader struct {
+	io.Reader
+	err error
+}
+
+func (r *failingReader) Read(p []byte) (int, error) {
+	n, err := r.Reader.Read(p)
+	if err == io.EOF {
+		return n, r.err
+	}
+	return n, err
+}
+
+func TestUploadFailedRead(t *testing.T) {
+	ctx := context.Background()
+	root := t.TempDir()
+	setFileRoot(t, root)
+	path := root + "/backup/a.bkp"
+	r := &failingReader{Reader: strings.NewReader("partial"), err: errors.New("read failed")}
+	if err := Upload(ctx, "file://"+path, r, "text/plain", nil); err == nil {
+		t.Fatalf("Upload() succeeded, wanted an error")
+	}
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		t.Errorf("Upload() left a partial file %s: %v", path, err)
+	}
+}
+
+func TestS3WriterAbort(t *testing.T) {
+	pr, pw := io.Pipe()
+	w := &s3Writer{pw: pw, done: make(chan error, 1)}
+	go func() {
+		// Stands in for the uploader, which fails the upload if reading its
+		// body fails.
+		_, err := ioutil.ReadAll(pr)
+		w.done <- err
+	}()
+	if _, err := w.Write([]byte("partial")); err != nil {
+		t.Fatalf("Write() failed: %v", err)
+	}
+	readErr := errors.New("read failed")
+	if err := w.Abort(readErr); err != readErr {
+		t.Errorf("Abort() got %v, wanted the upload to fail with %v", err, readErr)
+	}
+}
+
+func TestFileDriverConfined(t *testing.T) {
+	ctx := context.Background()
+	root := t.TempDir()
+	outside := t.TempDir()
+	setFileRoot(t, root)
+	if err := os.Symlink(outside, root+"/link"); err != nil {
+		t.Fatalf("failed to create a symlink: %v", err)
+	}
+	if err := ioutil.WriteFile(outside+"/secret", []byte("secret"), 0600); err != nil {
+		t.Fatalf("failed to write a file: %v", err)
+	}
+
+	for _, uri := range []string{
+		"file://" + outside + "/secret",
+		"file://" + root + "/../" + outside + "/secret",
+		"file://" + root + "/backup/../../secret",
+		"file://" + root + "/link/secret",
+		"file://" + root + "/link/sub/new",
+	} {
+		if err := Upload(ctx, uri, strings.NewReader("data"), "text/plain", nil); err == nil {
+			t.Errorf("Upload(%q) succeeded, wanted an error", uri)
+		}
+		if r, err := Download(ctx, uri, nil); err == nil {
+			r.Close()
+			t.Errorf("Download(%q) succeeded, wanted an error", uri)
+		}
+		if _, err := List(ctx, uri, nil); err == nil {
+			t.Errorf("List(%q) succeeded, wanted an error", uri)
+		}
+		if err := Delete(ctx, uri, nil); err == nil {
+			t.Errorf("Delete(%q) succeeded, wanted an error", uri)
+		}
+	}
+	if got, err := ioutil.ReadFile(outside + "/secret"); err != nil || string(got) != "secret" {
+		t.Errorf("file outside of the root got %q, %v; wanted it unchanged", got, err)
+	}
+}
@@ -29,13 +29,18 @@ const (
 	DatabaseInstanceTimeout = "DatabaseInstanceTimeout"
 	UserReady               = "UserReady"
 	StandbyReady            = "StandbyReady"
+	ReplicationReady        = "ReplicationReady"
+	Verified                = "Verified"
+	DisksReady              = "DisksReady"
+	ArchiveLogShippingReady = "ArchiveLogShippingReady"
 
 	// Condition Reasons
 	// Backup schedule concurrent policy is relying on the backup ready condition’s reason,
-	// BackupReady and BackupFailed means backup job is not running and scheduler will continue creating backup.
+	// BackupReady, BackupFailed and BackupCancelled means backup job is not running and scheduler will continue creating backup.
 	BackupReady                    = "BackupReady"
 	BackupInProgress               = "BackupInProgress"
 	BackupFailed                   = "BackupFailed"
+	BackupCancelled                = "BackupCancelled"
 	CreateComplete                 = "CreateComplete"
 	CreateFailed                   = "CreateFailed"
 	CreateInProgress               = "CreateInProgress"
@@ -44,11 +49,14 @@ const (
 	ImportFailed                   = "ImportFailed"
 	ImportInProgress               = "ImportInProgress"
 	ImportPending                  = "ImportPending"
+	ImportCancelled                = "ImportCancelled"
 	RestoreComplete                = "RestoreComplete"
 	RestoreFailed                  = "RestoreFailed"
 	RestorePreparationInProgress   = "RestorePreparationInProgress"
 	RestorePreparationComplete     = "RestorePreparationComplete"
 	RestoreInProgress              = "RestoreInProgress"
+	RestoreNewIdentityInProgress   = "RestoreNewIdentityInProgress"
+	RestoreCancelled               = "RestoreCancelled"
 	SyncInProgress                 = "SyncInProgress"
 	UserOutOfSync                  = "UserOutOfSync"
 	SyncComplete                   = "SyncComplete"
@@ -56,14 +64,43 @@ const (
 	PromoteStandbyInProgress       = "PromoteStandbyInProgress"
 	PromoteStandbyComplete         = "PromoteStandbyComplete"
 	PromoteStandbyFailed           = "PromoteStandbyFailed"
+	RoleTransitionComplete         = "RoleTransitionComplete"
+	RoleTransitionFailed           = "RoleTransitionFailed"
+	ReinstateRequired              = "ReinstateRequired"
 
 	ExportComplete   = "ExportComplete"
 	ExportFailed     = "ExportFailed"
 	ExportInProgress = "ExportInProgress"
 	ExportPending    = "ExportPending"
+	ExportCancelled  = "ExportCancelled"
 
 	ParameterUpdateInProgress = "ParameterUpdateInProgress"
 	ParameterUpdateRollback   = "ParameterUpdateRollback"
+
+	PatchingBackupInProgress          = "PatchingBackupInProgress"
+	StatefulSetPatchingInProgress     = "StatefulSetPatchingInProgress"
+	DatabasePatchingInProgress        = "DatabasePatchingInProgress"
+	PatchingRollbackInProgress        = "PatchingRollbackInProgress"
+	PatchingRollbackRestoreInProgress = "PatchingRollbackRestoreInProgress"
+	PatchingRollbackFailed            = "PatchingRollbackFailed"
+
+	ResourcesUpdateInProgress = "ResourcesUpdateInProgress"
+
+	DeleteInProgress = "DeleteInProgress"
+	DeleteFailed     = "DeleteFailed"
+
+	VerifyInProgress = "VerifyInProgress"
+	VerifyComplete   = "VerifyComplete"
+	VerifyFailed     = "VerifyFailed"
+
+	DiskResizeInProgress      = "DiskResizeInProgress"
+	DiskResizeComplete        = "DiskResizeComplete"
+	DiskResizeFailed          = "DiskResizeFailed"
+	DiskResizeRestartRequired = "DiskResizeRestartRequired"
+
+	ArchiveLogShippingComplete = "ArchiveLogShippingComplete"
+	ArchiveLogShippingFailed   = "ArchiveLogShippingFailed"
+	ArchiveLogShippingDisabled = "ArchiveLogShippingDisabled"
 )
 
 var (
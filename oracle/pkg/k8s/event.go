@@ -16,12 +16,18 @@ package k8s
 
 // database event reason list
 const (
-	CreatingDatabase      = "Creating"
-	CreatedDatabase       = "Created"
-	DatabaseAlreadyExists = "DatabaseAlreadyExists"
-	CreatingUser          = "Creating"
-	CreatedUser           = "Created"
-	SyncingUser           = "Syncing"
-	SyncedUser            = "Synced"
-	FailedToSyncUser      = "Failed"
+	CreatingDatabase        = "Creating"
+	CreatedDatabase         = "Created"
+	DatabaseAlreadyExists   = "DatabaseAlreadyExists"
+	CreatingUser            = "Creating"
+	CreatedUser             = "Created"
+	SyncingUser             = "Syncing"
+	SyncedUser              = "Synced"
+	FailedToSyncUser        = "Failed"
+	FailedToSyncTablespaces = "Failed"
+	FailedToSyncRoles       = "Failed"
+	FailedToSyncProfiles    = "Failed"
+	RotatedPassword         = "PasswordRotated"
+	DroppedUser             = "Dropped"
+	LockedUser              = "Locked"
 )
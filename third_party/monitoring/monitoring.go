@@ -28,10 +28,13 @@ import (
 
 	"github.com/prometheus/client_golang/prometheus"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	"gopkg.in/yaml.v2"
 	"k8s.io/klog/v2"
 
 	"github.com/GoogleCloudPlatform/elcarro-oracle-operator/oracle/pkg/agents/common"
+	"github.com/GoogleCloudPlatform/elcarro-oracle-operator/oracle/pkg/agents/common/mtls"
+	"github.com/GoogleCloudPlatform/elcarro-oracle-operator/oracle/pkg/agents/consts"
 	dbdpb "github.com/GoogleCloudPlatform/elcarro-oracle-operator/oracle/pkg/agents/oracle"
 )
 
@@ -99,7 +102,15 @@ func atoi(stringValue string) int {
 
 func createDBDClient(ctx context.Context, service string, port int) (dbdpb.DatabaseDaemonClient, func() error, error) {
 	klog.InfoS("connecting to DB:%s, port: %d", service, port)
-	conn, err := common.DatabaseDaemonDialService(ctx, fmt.Sprintf("%s:%d", service, port), grpc.WithBlock())
+	// Use mutual TLS if the agent certificates are mounted into the container.
+	var creds credentials.TransportCredentials
+	if mtls.Available(consts.AgentTLSDir) {
+		var err error
+		if creds, err = mtls.NewClientCredentials(consts.AgentTLSDir, service); err != nil {
+			return nil, func() error { return nil }, err
+		}
+	}
+	conn, err := common.DatabaseDaemonDialService(ctx, fmt.Sprintf("%s:%d", service, port), creds, grpc.WithBlock())
 	if err != nil {
 		return nil, func() error { return nil }, err
 	}